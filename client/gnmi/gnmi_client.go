@@ -46,6 +46,7 @@ type Client struct {
 	targetAddress string
 	targetName    string
 	encodingName  string
+	user          *userCredentials
 }
 
 // NewClient returns an instance of GNMIClient struct.
@@ -57,8 +58,37 @@ func NewClient(targetAddress, targetName, encodingName string) *Client {
 	}
 }
 
-func (c *Client) Capabilities(ctx context.Context) (*pb.CapabilityResponse, error) {
+// WithUser returns a copy of the client authenticating as another local user than the one given by the flags.
+func (c *Client) WithUser(username, password string) *Client {
+	u := *c
+	u.user = &userCredentials{username: username, password: password}
+	return &u
+}
+
+func (c *Client) dialOptions() []grpc.DialOption {
 	opts := credentials.ClientCredentials(c.targetName)
+	if c.user != nil {
+		// Later per RPC credentials replace the metadata of earlier ones.
+		opts = append(opts, grpc.WithPerRPCCredentials(c.user))
+	}
+	return opts
+}
+
+type userCredentials struct {
+	username string
+	password string
+}
+
+func (u *userCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"username": u.username, "password": u.password}, nil
+}
+
+func (u *userCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+func (c *Client) Capabilities(ctx context.Context) (*pb.CapabilityResponse, error) {
+	opts := c.dialOptions()
 	conn, err := grpc.Dial(c.targetAddress, opts...)
 	if err != nil {
 		return nil, err
@@ -84,7 +114,7 @@ func (c *Client) Capabilities(ctx context.Context) (*pb.CapabilityResponse, erro
 }
 
 func (c *Client) Get(ctx context.Context, getXPaths []string) (*pb.GetResponse, error) {
-	opts := credentials.ClientCredentials(c.targetName)
+	opts := c.dialOptions()
 	conn, err := grpc.Dial(c.targetAddress, opts...)
 	if err != nil {
 		return nil, err
@@ -199,7 +229,7 @@ func buildPbUpdateList(pathValuePairs []string) ([]*pb.Update, error) {
 }
 
 func (c *Client) Set(ctx context.Context, deleteXPaths, replaceXPaths, updateXPaths []string) (*pb.SetResponse, error) {
-	opts := credentials.ClientCredentials(c.targetName)
+	opts := c.dialOptions()
 	conn, err := grpc.Dial(c.targetAddress, opts...)
 	if err != nil {
 		return nil, err
@@ -246,7 +276,7 @@ func (c *Client) Set(ctx context.Context, deleteXPaths, replaceXPaths, updateXPa
 }
 
 func (c *Client) SubscribeOnce(ctx context.Context, subscribeXPaths []string) (*pb.SubscribeResponse, error) {
-	opts := credentials.ClientCredentials(c.targetName)
+	opts := c.dialOptions()
 	conn, err := grpc.Dial(c.targetAddress, opts...)
 	if err != nil {
 		return nil, err
@@ -311,7 +341,7 @@ func (c *Client) SubscribeOnce(ctx context.Context, subscribeXPaths []string) (*
 }
 
 func (c *Client) SubscribePoll(ctx context.Context, subscribeXPaths []string, respChan chan<- *pb.SubscribeResponse, errChan chan<- error) {
-	opts := credentials.ClientCredentials(c.targetName)
+	opts := c.dialOptions()
 	conn, err := grpc.Dial(c.targetAddress, opts...)
 	if err != nil {
 		errChan <- err
@@ -398,7 +428,7 @@ func (c *Client) SubscribePoll(ctx context.Context, subscribeXPaths []string, re
 }
 
 func (c *Client) SubscribeStream(ctx context.Context, subscribeXPaths []string, respChan chan<- *pb.SubscribeResponse, errChan chan<- error) {
-	opts := credentials.ClientCredentials(c.targetName)
+	opts := c.dialOptions()
 	conn, err := grpc.Dial(c.targetAddress, opts...)
 	if err != nil {
 		errChan <- err
@@ -31,10 +31,23 @@ var CapabilitiesTests = []struct {
 	Desc:       "retrieve system capabilities",
 	ExpVersion: "0.7.0",
 	ExpModels: []*gnmi.ModelData{
+		{Name: "openconfig-if-aggregate", Organization: "OpenConfig working group", Version: "2.3.1"},
+		{Name: "openconfig-if-ethernet", Organization: "OpenConfig working group", Version: "2.6.1"},
 		{Name: "openconfig-interfaces", Organization: "OpenConfig working group", Version: "2.0.0"},
+		{Name: "openconfig-lacp", Organization: "OpenConfig working group", Version: "1.1.0"},
+		{Name: "openconfig-lldp", Organization: "OpenConfig working group", Version: "0.2.0"},
 		{Name: "openconfig-openflow", Organization: "OpenConfig working group", Version: "0.1.0"},
 		{Name: "openconfig-platform", Organization: "OpenConfig working group", Version: "0.5.0"},
+		{Name: "openconfig-spanning-tree", Organization: "OpenConfig working group", Version: "0.2.0"},
 		{Name: "openconfig-system", Organization: "OpenConfig working group", Version: "0.2.0"},
+		{Name: "openconfig-vlan", Organization: "OpenConfig working group", Version: "3.0.1"},
+		{Name: "ovs-bridges", Organization: "ovs-gnxi", Version: "0.1.0"},
+		{Name: "ovs-flows", Organization: "ovs-gnxi", Version: "0.1.0"},
+		{Name: "ovs-interfaces", Organization: "ovs-gnxi", Version: "0.1.0"},
+		{Name: "ovs-mirrors", Organization: "ovs-gnxi", Version: "0.1.0"},
+		{Name: "ovs-qos", Organization: "ovs-gnxi", Version: "0.1.0"},
+		{Name: "ovs-stp", Organization: "ovs-gnxi", Version: "0.1.0"},
+		{Name: "ovs-system", Organization: "ovs-gnxi", Version: "0.1.0"},
 	},
 	ExpEncodings: []gnmi.Encoding{
 		gnmi.Encoding(gnmi.Encoding_JSON),
@@ -65,13 +78,13 @@ var GetTests = []struct {
 	},
 	{
 		Desc:            "get system openflow controller connection config address",
-		XPaths:          []string{"/system/openflow/controllers/controller[name=main]/connections/connection[aux-id=0]/config/address"},
+		XPaths:          []string{"/system/openflow/controllers/controller[name=sw1]/connections/connection[aux-id=0]/config/address"},
 		ExtractorString: ExtractSingleStringValueFromResponse,
 		ExpResp:         "172.18.0.2",
 	},
 	{
 		Desc:          "get system openflow controller connection config port",
-		XPaths:        []string{"/system/openflow/controllers/controller[name=main]/connections/connection[aux-id=0]/config/port"},
+		XPaths:        []string{"/system/openflow/controllers/controller[name=sw1]/connections/connection[aux-id=0]/config/port"},
 		ExtractorUInt: ExtractSingleUintValueFromResponse,
 		ExpResp:       uint64(6653),
 	},
@@ -89,6 +102,22 @@ var GetTests = []struct {
 	},
 }
 
+var GetAuthorizationTests = []struct {
+	Desc        string
+	Username    string
+	Password    string
+	XPaths      []string
+	HiddenElems []string
+}{
+	{
+		Desc:        "get root as read-only user without aaa",
+		Username:    "monitor",
+		Password:    "testpassword",
+		XPaths:      []string{"/"},
+		HiddenElems: []string{"aaa", "password-hashed", "admin-password-hashed"},
+	},
+}
+
 var SetTests = []struct {
 	Desc                  string
 	DeleteXPaths          []string
@@ -109,8 +138,8 @@ var SetTests = []struct {
 }{
 	{
 		Desc:                 "set system openflow controller connection config address",
-		UpdateXPaths:         []string{"/system/openflow/controllers/controller[name=main]/connections/connection[aux-id=0]/config/address:172.18.0.3"},
-		RollbackUpdateXPaths: []string{"/system/openflow/controllers/controller[name=main]/connections/connection[aux-id=0]/config/address:172.18.0.2"},
+		UpdateXPaths:         []string{"/system/openflow/controllers/controller[name=sw1]/connections/connection[aux-id=0]/config/address:172.18.0.3"},
+		RollbackUpdateXPaths: []string{"/system/openflow/controllers/controller[name=sw1]/connections/connection[aux-id=0]/config/address:172.18.0.2"},
 		ExtractorString:      ExtractSingleStringValueFromResponse,
 		ExpResp:              "172.18.0.3",
 		RollbackExpResp:      "172.18.0.2",
@@ -121,8 +150,8 @@ var SetTests = []struct {
 	},
 	{
 		Desc:                 "set system openflow controller connection config port",
-		UpdateXPaths:         []string{"/system/openflow/controllers/controller[name=main]/connections/connection[aux-id=0]/config/port:6654"},
-		RollbackUpdateXPaths: []string{"/system/openflow/controllers/controller[name=main]/connections/connection[aux-id=0]/config/port:6653"},
+		UpdateXPaths:         []string{"/system/openflow/controllers/controller[name=sw1]/connections/connection[aux-id=0]/config/port:6654"},
+		RollbackUpdateXPaths: []string{"/system/openflow/controllers/controller[name=sw1]/connections/connection[aux-id=0]/config/port:6653"},
 		ExtractorUInt:        ExtractSingleUintValueFromResponse,
 		ExpResp:              uint64(6654),
 		RollbackExpResp:      uint64(6653),
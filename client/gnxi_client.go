@@ -213,6 +213,7 @@ func main() {
 	default:
 		RunGNMICapabilitiesTests(gnmiClient)
 		RunGNMIGetTests(gnmiClient)
+		RunGNMIGetAuthorizationTests(gnmiClient)
 		RunGNOIRebootTests(gnoiClient)
 		RunGNMIGetTests(gnmiClient)
 		RunGNOIGetCertificatesTests(gnoiClient)
@@ -332,6 +333,55 @@ func RunGNMIGetTests(c *gnmi.Client) {
 	}
 }
 
+// RunGNMIGetAuthorizationTests verifies that subtrees denied to the role of a user are removed from the responses to
+// their ancestors.
+func RunGNMIGetAuthorizationTests(c *gnmi.Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeOut)
+	defer cancel()
+
+	for _, td := range gnmi.GetAuthorizationTests {
+		log.Infof("Testing GNMI Get(%v) as %v...", td.XPaths, td.Username)
+
+		resp, err := c.WithUser(td.Username, td.Password).Get(ctx, td.XPaths)
+		if err != nil {
+			log.Fatal(err)
+			continue
+		}
+
+		if elem := hiddenElem(resp.Notification, td.HiddenElems); elem != "" {
+			log.Errorf("Get(%v) as %v: expected %v to be hidden", td.XPaths, td.Username, elem)
+		} else {
+			log.Infof("Successfully verified GNMI Get(%v) as %v hides %v", td.XPaths, td.Username, td.HiddenElems)
+		}
+	}
+}
+
+// hiddenElem returns the first of the hidden elements found in the update paths or JSON values of notifications.
+func hiddenElem(notifications []*pb.Notification, hidden []string) string {
+	for _, n := range notifications {
+		for _, u := range n.GetUpdate() {
+			var elems []*pb.PathElem
+			elems = append(elems, n.GetPrefix().GetElem()...)
+			elems = append(elems, u.GetPath().GetElem()...)
+
+			json := string(u.GetVal().GetJsonIetfVal()) + string(u.GetVal().GetJsonVal())
+
+			for _, h := range hidden {
+				for _, e := range elems {
+					if e.GetName() == h {
+						return h
+					}
+				}
+				if strings.Contains(json, "\""+h+"\":") || strings.Contains(json, ":"+h+"\":") {
+					return h
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
 func RunGNMISetTests(c *gnmi.Client) {
 	ctx, cancel := context.WithTimeout(context.Background(), *timeOut)
 	defer cancel()
@@ -15,6 +15,7 @@ MODEL_FOLDER=$OVSGNXI/yang
 # OpenConfig modules
 IGNORED_MODULES=ietf-interfaces
 OC_MODELS=$MODEL_FOLDER/openconfig
+OVS_MODELS=$MODEL_FOLDER/ovs
 IETF_MODELS=$MODEL_FOLDER/ietf
 
 # Output path
@@ -29,7 +30,20 @@ go run $YANG_CONVERTER \
 -package_name=ocstruct \
 -exclude_modules=$IGNORED_MODULES \
 -output_file=$OUTPUT_FILE_PATH \
+$OC_MODELS/openconfig-if-aggregate.yang \
+$OC_MODELS/openconfig-if-ethernet.yang \
 $OC_MODELS/openconfig-interfaces.yang \
+$OC_MODELS/openconfig-lacp.yang \
+$OC_MODELS/openconfig-lldp.yang \
 $OC_MODELS/openconfig-openflow.yang \
 $OC_MODELS/openconfig-platform.yang \
+$OC_MODELS/openconfig-spanning-tree.yang \
 $OC_MODELS/openconfig-system.yang \
+$OC_MODELS/openconfig-vlan.yang \
+$OVS_MODELS/ovs-bridges.yang \
+$OVS_MODELS/ovs-flows.yang \
+$OVS_MODELS/ovs-interfaces.yang \
+$OVS_MODELS/ovs-mirrors.yang \
+$OVS_MODELS/ovs-qos.yang \
+$OVS_MODELS/ovs-stp.yang \
+$OVS_MODELS/ovs-system.yang \
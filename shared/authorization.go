@@ -0,0 +1,647 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/openconfig/ygot/ygot"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	pbg "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+
+	RPCWildcard  = "*"
+	KeyWildcard  = "*"
+	implicitRule = "implicit-deny"
+)
+
+// prunedRPCs are the RPCs whose responses have the subtrees of deny rules removed, so that an ancestor of a denied path
+// may still be read.
+var prunedRPCs = map[string]bool{
+	"/gnmi.gNMI/Get":       true,
+	"/gnmi.gNMI/Subscribe": true,
+}
+
+// Rule grants or denies a set of RPCs, optionally restricted to gNMI path prefixes. RPCs are full gRPC method names
+// such as "/gnmi.gNMI/Set", "/gnoi.system.System/*" or "*".
+type Rule struct {
+	Name   string   `json:"name"`
+	Effect string   `json:"effect"`
+	RPCs   []string `json:"rpcs"`
+	Paths  []string `json:"paths,omitempty"`
+
+	paths []*pbg.Path
+}
+
+func (r *Rule) String() string {
+	return fmt.Sprintf("Rule(Name: \"%v\", Effect: \"%v\", RPCs: \"%v\", Paths: \"%v\")", r.Name, r.Effect, r.RPCs, r.Paths)
+}
+
+func (r *Rule) matchesRPC(rpc string) bool {
+	for _, pattern := range r.RPCs {
+		switch {
+		case pattern == RPCWildcard, pattern == rpc:
+			return true
+		case strings.HasSuffix(pattern, "/"+RPCWildcard) && strings.HasPrefix(rpc, strings.TrimSuffix(pattern, RPCWildcard)):
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesPath reports whether the rule applies to path. A nil path stands for the RPC itself, which path restricted
+// rules only allow, so that a role may open a Subscribe or Set whose paths are checked afterwards. Deny rules also
+// apply to the ancestors of their paths, as these include the denied subtree, unless the subtree is pruned from the
+// response instead.
+func (r *Rule) matchesPath(path *pbg.Path, prune bool) bool {
+	if len(r.paths) == 0 {
+		return true
+	}
+
+	if path == nil {
+		return r.Effect == EffectAllow
+	}
+
+	for _, prefix := range r.paths {
+		if isPathPrefix(prefix, path) {
+			return true
+		}
+		if r.Effect == EffectDeny && !prune && isPathAncestor(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Role is an ordered list of rules, the first rule matching a request decides about it.
+type Role struct {
+	Name  string  `json:"name"`
+	Rules []*Rule `json:"rules"`
+}
+
+type Policy struct {
+	Roles       []*Role           `json:"roles"`
+	Users       map[string]string `json:"users"`
+	DefaultRole string            `json:"default-role,omitempty"`
+
+	roles map[string]*Role
+}
+
+// NewPolicyFromFile reads and validates a JSON policy file.
+func NewPolicyFromFile(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read policy file %v: %v", path, err)
+	}
+
+	p := &Policy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("unable to parse policy file %v: %v", path, err)
+	}
+
+	if err := p.compile(); err != nil {
+		return nil, fmt.Errorf("invalid policy file %v: %v", path, err)
+	}
+
+	return p, nil
+}
+
+func (p *Policy) compile() error {
+	p.roles = make(map[string]*Role)
+
+	for _, role := range p.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("role without name")
+		}
+		if _, ok := p.roles[role.Name]; ok {
+			return fmt.Errorf("duplicate role %q", role.Name)
+		}
+
+		for _, rule := range role.Rules {
+			switch rule.Effect {
+			case EffectAllow, EffectDeny:
+			default:
+				return fmt.Errorf("rule %q of role %q has invalid effect %q", rule.Name, role.Name, rule.Effect)
+			}
+
+			if len(rule.RPCs) == 0 {
+				return fmt.Errorf("rule %q of role %q has no rpcs", rule.Name, role.Name)
+			}
+
+			rule.paths = nil
+			for _, path := range rule.Paths {
+				p, err := ygot.StringToStructuredPath(path)
+				if err != nil {
+					return fmt.Errorf("rule %q of role %q has invalid path %q: %v", rule.Name, role.Name, path, err)
+				}
+				rule.paths = append(rule.paths, p)
+			}
+		}
+
+		p.roles[role.Name] = role
+	}
+
+	for user, role := range p.Users {
+		if _, ok := p.roles[role]; !ok {
+			return fmt.Errorf("user %q is bound to unknown role %q", user, role)
+		}
+	}
+
+	if _, ok := p.roles[p.DefaultRole]; p.DefaultRole != "" && !ok {
+		return fmt.Errorf("unknown default role %q", p.DefaultRole)
+	}
+
+	return nil
+}
+
+// DeniedCallback is called with the context, RPC and paths of every request the interceptors deny.
+type DeniedCallback func(ctx context.Context, rpc string, paths []*pbg.Path, err error)
+
+// Authorizer decides whether an authenticated identity may call an RPC on a set of gNMI paths.
+type Authorizer struct {
+	policy   *Policy
+	callback DeniedCallback
+	mu       sync.RWMutex
+}
+
+func NewAuthorizer(policy *Policy) *Authorizer {
+	return &Authorizer{policy: policy}
+}
+
+func (a *Authorizer) OverwritePolicy(policy *Policy) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.policy = policy
+}
+
+func (a *Authorizer) OverwriteDeniedCallback(callback DeniedCallback) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.callback = callback
+}
+
+// denied logs a denied request and passes it on to the denied callback.
+func (a *Authorizer) denied(ctx context.Context, rpc string, paths []*pbg.Path, err error) {
+	log.Infof("denied a %v request: %v", rpc, err)
+
+	a.mu.RLock()
+	callback := a.callback
+	a.mu.RUnlock()
+
+	if callback != nil {
+		callback(ctx, rpc, paths, err)
+	}
+}
+
+// HasRole reports whether the policy defines the role.
+func (a *Authorizer) HasRole(role string) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	_, ok := a.policy.roles[role]
+	return ok
+}
+
+// RoleOf returns the role bound to the identity, which is empty if the identity has no role. A role carried by the
+// identity itself takes precedence over the user bindings of the policy.
+func (a *Authorizer) RoleOf(identity *Identity) string {
+	if identity.Role != "" {
+		return identity.Role
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if role, ok := a.policy.Users[identity.Username]; ok {
+		return role
+	}
+
+	return a.policy.DefaultRole
+}
+
+// AuthorizeRPC checks the RPC against every given path, or against the RPC alone if no paths are given. A denial is
+// returned as PermissionDenied status error naming the rule that matched.
+func (a *Authorizer) AuthorizeRPC(identity *Identity, rpc string, paths ...*pbg.Path) error {
+	if len(paths) == 0 {
+		paths = []*pbg.Path{nil}
+	}
+
+	roleName := a.RoleOf(identity)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	role, ok := a.policy.roles[roleName]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "user %q has no role", identity.Username)
+	}
+
+	for _, path := range paths {
+		rule := role.match(rpc, path, prunedRPCs[rpc])
+
+		if rule == nil {
+			return status.Errorf(codes.PermissionDenied, "user %q with role %q denied %v on %v by rule %q", identity.Username, role.Name, rpc, pathString(path), implicitRule)
+		}
+
+		if rule.Effect != EffectAllow {
+			return status.Errorf(codes.PermissionDenied, "user %q with role %q denied %v on %v by rule %q", identity.Username, role.Name, rpc, pathString(path), rule.Name)
+		}
+	}
+
+	return nil
+}
+
+func (r *Role) match(rpc string, path *pbg.Path, prune bool) *Rule {
+	for _, rule := range r.Rules {
+		if rule.matchesRPC(rpc) && rule.matchesPath(path, prune) {
+			return rule
+		}
+	}
+
+	return nil
+}
+
+// PruneNotifications removes the updates the identity may not read through the RPC from notifications. JSON values of
+// allowed updates have the subtrees of earlier deny rules removed. Allow rules below such a subtree are not applied, so
+// that a denied subtree is always removed as a whole.
+func (a *Authorizer) PruneNotifications(identity *Identity, rpc string, notifications []*pbg.Notification) {
+	roleName := a.RoleOf(identity)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	role, ok := a.policy.roles[roleName]
+
+	for _, n := range notifications {
+		if n == nil {
+			continue
+		}
+
+		var updates []*pbg.Update
+		for _, update := range n.GetUpdate() {
+			path := joinPaths(n.GetPrefix(), update.GetPath())
+			if !ok || !role.pruneUpdate(rpc, path, update) {
+				continue
+			}
+			updates = append(updates, update)
+		}
+		n.Update = updates
+
+		var deletes []*pbg.Path
+		for _, path := range n.GetDelete() {
+			if rule := role.match(rpc, joinPaths(n.GetPrefix(), path), true); ok && rule != nil && rule.Effect == EffectAllow {
+				deletes = append(deletes, path)
+			}
+		}
+		n.Delete = deletes
+	}
+}
+
+// pruneUpdate removes the denied subtrees from the JSON value of an update and reports whether the update may be sent
+// at all.
+func (r *Role) pruneUpdate(rpc string, path *pbg.Path, update *pbg.Update) bool {
+	var data []byte
+	switch v := update.GetVal().GetValue().(type) {
+	case *pbg.TypedValue_JsonIetfVal:
+		data = v.JsonIetfVal
+	case *pbg.TypedValue_JsonVal:
+		data = v.JsonVal
+	}
+
+	var tree interface{}
+	var matched, pruned bool
+	var allowed []*pbg.Path
+
+	for _, rule := range r.Rules {
+		if !rule.matchesRPC(rpc) {
+			continue
+		}
+
+		if rule.matchesPath(path, true) {
+			if rule.Effect != EffectAllow {
+				return false
+			}
+			matched = true
+			break
+		}
+
+		if rule.Effect == EffectAllow {
+			allowed = append(allowed, rule.paths...)
+			continue
+		}
+
+		for _, prefix := range rule.paths {
+			if !isPathAncestor(path, prefix) || isShadowed(prefix, allowed) {
+				continue
+			}
+
+			// Only JSON trees can be pruned, the denied entries of a list cannot be told apart at the list itself.
+			if data == nil || len(prefix.GetElem()) == len(path.GetElem()) {
+				return false
+			}
+
+			if tree == nil {
+				decoder := json.NewDecoder(bytes.NewReader(data))
+				decoder.UseNumber()
+				if err := decoder.Decode(&tree); err != nil {
+					log.Errorf("unable to prune %v from response: %v", pathString(prefix), err)
+					return false
+				}
+			}
+
+			pruneJSON(tree, prefix.GetElem()[len(path.GetElem()):])
+			pruned = true
+		}
+	}
+
+	if !matched || !pruned {
+		return matched
+	}
+
+	data, err := json.Marshal(tree)
+	if err != nil {
+		log.Errorf("unable to prune %v from response: %v", pathString(path), err)
+		return false
+	}
+
+	switch update.GetVal().GetValue().(type) {
+	case *pbg.TypedValue_JsonIetfVal:
+		update.Val = &pbg.TypedValue{Value: &pbg.TypedValue_JsonIetfVal{JsonIetfVal: data}}
+	case *pbg.TypedValue_JsonVal:
+		update.Val = &pbg.TypedValue{Value: &pbg.TypedValue_JsonVal{JsonVal: data}}
+	}
+
+	return true
+}
+
+// isShadowed reports whether an earlier allow rule covers the whole subtree of path, so that later deny rules do not
+// apply to it.
+func isShadowed(path *pbg.Path, allowed []*pbg.Path) bool {
+	for _, prefix := range allowed {
+		if isPathPrefix(prefix, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pruneJSON removes the nodes at the relative path elems from a JSON tree. Members are matched by their name without
+// module prefix, list entries by the leaves named by the keys of an element.
+func pruneJSON(tree interface{}, elems []*pbg.PathElem) {
+	object, ok := tree.(map[string]interface{})
+	if !ok || len(elems) == 0 {
+		return
+	}
+
+	elem, last := elems[0], len(elems) == 1
+
+	for name, value := range object {
+		if name[strings.Index(name, ":")+1:] != elem.GetName() {
+			continue
+		}
+
+		entries, isList := value.([]interface{})
+		if !isList {
+			if last && len(elem.GetKey()) == 0 {
+				delete(object, name)
+			} else {
+				pruneJSON(value, elems[1:])
+			}
+			continue
+		}
+
+		var kept []interface{}
+		for _, entry := range entries {
+			if !matchesJSONKeys(entry, elem.GetKey()) {
+				kept = append(kept, entry)
+				continue
+			}
+			if !last {
+				pruneJSON(entry, elems[1:])
+				kept = append(kept, entry)
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(object, name)
+		} else {
+			object[name] = kept
+		}
+	}
+}
+
+func matchesJSONKeys(entry interface{}, keys map[string]string) bool {
+	object, ok := entry.(map[string]interface{})
+	if !ok {
+		return len(keys) == 0
+	}
+
+	for k, v := range keys {
+		if v == KeyWildcard {
+			continue
+		}
+
+		found := false
+		for name, value := range object {
+			if name[strings.Index(name, ":")+1:] == k && fmt.Sprint(value) == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UnaryServerInterceptor authorizes unary RPCs. It must run after the Authenticator interceptor.
+func (a *Authorizer) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "request is not authenticated")
+	}
+
+	paths := requestPaths(req)
+	if err := a.AuthorizeRPC(identity, info.FullMethod, paths...); err != nil {
+		a.denied(ctx, info.FullMethod, paths, err)
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+	if r, ok := resp.(*pbg.GetResponse); ok && err == nil && prunedRPCs[info.FullMethod] {
+		a.PruneNotifications(identity, info.FullMethod, r.GetNotification())
+	}
+
+	return resp, err
+}
+
+// StreamServerInterceptor authorizes streaming RPCs once when opened and for every received gNMI subscription. It
+// must run after the Authenticator interceptor.
+func (a *Authorizer) StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	identity, ok := IdentityFromContext(stream.Context())
+	if !ok {
+		return status.Error(codes.Unauthenticated, "request is not authenticated")
+	}
+
+	if err := a.AuthorizeRPC(identity, info.FullMethod); err != nil {
+		a.denied(stream.Context(), info.FullMethod, nil, err)
+		return err
+	}
+
+	return handler(srv, &authorizedServerStream{ServerStream: stream, authorizer: a, identity: identity, rpc: info.FullMethod})
+}
+
+type authorizedServerStream struct {
+	grpc.ServerStream
+	authorizer *Authorizer
+	identity   *Identity
+	rpc        string
+}
+
+func (s *authorizedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if paths := requestPaths(m); len(paths) != 0 {
+		if err := s.authorizer.AuthorizeRPC(s.identity, s.rpc, paths...); err != nil {
+			s.authorizer.denied(s.Context(), s.rpc, paths, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *authorizedServerStream) SendMsg(m interface{}) error {
+	if r, ok := m.(*pbg.SubscribeResponse); ok && r.GetUpdate() != nil && prunedRPCs[s.rpc] {
+		s.authorizer.PruneNotifications(s.identity, s.rpc, []*pbg.Notification{r.GetUpdate()})
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
+
+// requestPaths returns the full gNMI paths a request operates on.
+func requestPaths(req interface{}) []*pbg.Path {
+	var paths []*pbg.Path
+
+	switch r := req.(type) {
+	case *pbg.GetRequest:
+		for _, path := range r.GetPath() {
+			paths = append(paths, joinPaths(r.GetPrefix(), path))
+		}
+	case *pbg.SetRequest:
+		for _, path := range r.GetDelete() {
+			paths = append(paths, joinPaths(r.GetPrefix(), path))
+		}
+		for _, update := range r.GetReplace() {
+			paths = append(paths, joinPaths(r.GetPrefix(), update.GetPath()))
+		}
+		for _, update := range r.GetUpdate() {
+			paths = append(paths, joinPaths(r.GetPrefix(), update.GetPath()))
+		}
+	case *pbg.SubscribeRequest:
+		for _, subscription := range r.GetSubscribe().GetSubscription() {
+			paths = append(paths, joinPaths(r.GetSubscribe().GetPrefix(), subscription.GetPath()))
+		}
+	}
+
+	return paths
+}
+
+func joinPaths(prefix, path *pbg.Path) *pbg.Path {
+	elems := make([]*pbg.PathElem, 0, len(prefix.GetElem())+len(path.GetElem()))
+	elems = append(elems, prefix.GetElem()...)
+	elems = append(elems, path.GetElem()...)
+	return &pbg.Path{Elem: elems}
+}
+
+// isPathPrefix reports whether prefix matches the beginning of path. Keys of the prefix have to be present in the
+// path with the same value, unless the prefix uses the "*" wildcard value.
+func isPathPrefix(prefix, path *pbg.Path) bool {
+	if len(prefix.GetElem()) > len(path.GetElem()) {
+		return false
+	}
+
+	for i, elem := range prefix.GetElem() {
+		if elem.GetName() != path.GetElem()[i].GetName() {
+			return false
+		}
+
+		for k, v := range elem.GetKey() {
+			if pv, ok := path.GetElem()[i].GetKey()[k]; v != KeyWildcard && (!ok || pv != v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// isPathAncestor reports whether path includes the subtree of descendant, which is the case if it is not longer and
+// every key present in both elements is equal or the "*" wildcard.
+func isPathAncestor(path, descendant *pbg.Path) bool {
+	if len(path.GetElem()) > len(descendant.GetElem()) {
+		return false
+	}
+
+	for i, elem := range path.GetElem() {
+		d := descendant.GetElem()[i]
+		if elem.GetName() != d.GetName() {
+			return false
+		}
+
+		for k, v := range elem.GetKey() {
+			if dv, ok := d.GetKey()[k]; ok && v != KeyWildcard && dv != KeyWildcard && v != dv {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func pathString(path *pbg.Path) string {
+	if path == nil {
+		return "any path"
+	}
+
+	s, err := ygot.PathToString(path)
+	if err != nil {
+		return fmt.Sprint(path)
+	}
+
+	return s
+}
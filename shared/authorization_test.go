@@ -0,0 +1,92 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"github.com/openconfig/ygot/ygot"
+	"testing"
+
+	pbg "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func mustPath(t *testing.T, s string) *pbg.Path {
+	p, err := ygot.StringToStructuredPath(s)
+	if err != nil {
+		t.Fatalf("invalid path %q: %v", s, err)
+	}
+
+	return p
+}
+
+func TestIsPathPrefix(t *testing.T) {
+	tests := []struct {
+		desc   string
+		prefix string
+		path   string
+		want   bool
+	}{
+		{desc: "root", prefix: "/", path: "/system/config/hostname", want: true},
+		{desc: "equal", prefix: "/system/config", path: "/system/config", want: true},
+		{desc: "parent", prefix: "/system", path: "/system/config/hostname", want: true},
+		{desc: "longer", prefix: "/system/config/hostname", path: "/system/config", want: false},
+		{desc: "different name", prefix: "/system/state", path: "/system/config/hostname", want: false},
+		{desc: "same key", prefix: "/interfaces/interface[name=eth0]", path: "/interfaces/interface[name=eth0]/config/mtu", want: true},
+		{desc: "different key", prefix: "/interfaces/interface[name=eth0]", path: "/interfaces/interface[name=eth1]/config/mtu", want: false},
+		{desc: "wildcard key", prefix: "/interfaces/interface[name=*]", path: "/interfaces/interface[name=eth1]/config/mtu", want: true},
+		{desc: "key missing in path", prefix: "/interfaces/interface[name=eth0]", path: "/interfaces/interface/config/mtu", want: false},
+		{desc: "wildcard missing in path", prefix: "/interfaces/interface[name=*]", path: "/interfaces/interface/config/mtu", want: true},
+		{desc: "unkeyed prefix", prefix: "/interfaces/interface", path: "/interfaces/interface[name=eth0]/config/mtu", want: true},
+		{
+			desc:   "one of several keys differs",
+			prefix: "/system/openflow/controllers/controller[name=sw1]/connections/connection[aux-id=0]",
+			path:   "/system/openflow/controllers/controller[name=sw1]/connections/connection[aux-id=1]/config/port",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := isPathPrefix(mustPath(t, tt.prefix), mustPath(t, tt.path)); got != tt.want {
+			t.Errorf("%v: isPathPrefix(%q, %q) = %v, want %v", tt.desc, tt.prefix, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestIsPathAncestor(t *testing.T) {
+	tests := []struct {
+		desc       string
+		path       string
+		descendant string
+		want       bool
+	}{
+		{desc: "root", path: "/", descendant: "/system/config/hostname", want: true},
+		{desc: "equal", path: "/system/config", descendant: "/system/config", want: true},
+		{desc: "parent", path: "/system", descendant: "/system/config/hostname", want: true},
+		{desc: "longer", path: "/system/config/hostname", descendant: "/system", want: false},
+		{desc: "different name", path: "/interfaces", descendant: "/system/config", want: false},
+		{desc: "same key", path: "/interfaces/interface[name=eth0]", descendant: "/interfaces/interface[name=eth0]/config", want: true},
+		{desc: "different key", path: "/interfaces/interface[name=eth0]", descendant: "/interfaces/interface[name=eth1]/config", want: false},
+		{desc: "wildcard in path", path: "/interfaces/interface[name=*]", descendant: "/interfaces/interface[name=eth1]/config", want: true},
+		{desc: "wildcard in descendant", path: "/interfaces/interface[name=eth0]", descendant: "/interfaces/interface[name=*]/config", want: true},
+		{desc: "key missing in descendant", path: "/interfaces/interface[name=eth0]", descendant: "/interfaces/interface/config", want: true},
+		{desc: "key missing in path", path: "/interfaces/interface", descendant: "/interfaces/interface[name=eth0]/config", want: true},
+	}
+
+	for _, tt := range tests {
+		if got := isPathAncestor(mustPath(t, tt.path), mustPath(t, tt.descendant)); got != tt.want {
+			t.Errorf("%v: isPathAncestor(%q, %q) = %v, want %v", tt.desc, tt.path, tt.descendant, got, tt.want)
+		}
+	}
+}
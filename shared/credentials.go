@@ -18,57 +18,241 @@ package shared
 import (
 	"errors"
 	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"ovs-gnxi/shared/logging"
+	"sync"
 )
 
-type User struct {
-	username string
-	password string
+const (
+	// authenticationFailedMessage is all that clients learn about a failed authentication.
+	authenticationFailedMessage = "authentication failed"
+
+	failureReasonInvalid = "invalid"
+	failureReasonLocked  = "locked"
+	failureReasonMissing = "missing"
+)
+
+var log = logging.New("ovs-gnxi")
+
+// ErrNoCredentials is returned by an AuthBackend if the request does not carry the kind of credentials it handles,
+// so that the Authenticator can move on to the next backend.
+var ErrNoCredentials = errors.New("no credentials found")
+
+// FailedAuthenticationsMetric counts the requests which failed authentication by backend and reason.
+var FailedAuthenticationsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "ovsgnxi_failed_authentications_total",
+	Help: "The number of gRPC requests that failed authentication.",
+}, []string{"backend", "reason"})
+
+// AuthenticationError describes a failed authentication. It never contains the presented password or token, but it
+// may name the user and is therefore only logged, clients are answered with a generic message.
+type AuthenticationError struct {
+	Backend  string
+	Username string
+	Reason   string
+	Locked   bool
 }
 
-type Authenticator struct {
-	users map[string]User
+func (e *AuthenticationError) Error() string {
+	switch {
+	case e.Backend != "" && e.Username != "":
+		return fmt.Sprintf("%v authentication of user %q failed: %v", e.Backend, e.Username, e.Reason)
+	case e.Username != "":
+		return fmt.Sprintf("authentication of user %q failed: %v", e.Username, e.Reason)
+	case e.Backend != "":
+		return fmt.Sprintf("%v authentication failed: %v", e.Backend, e.Reason)
+	}
+
+	return fmt.Sprintf("authentication failed: %v", e.Reason)
 }
 
-func NewAuthenticator(adminUsername, adminPassword string) *Authenticator {
-	a := &Authenticator{users: make(map[string]User)}
-	a.AddUser(adminUsername, adminPassword)
-	return a
+func (e *AuthenticationError) GetUsername() string {
+	if e == nil {
+		return ""
+	}
+	return e.Username
 }
 
-func (a *Authenticator) AddUser(username, password string) {
-	a.users[username] = User{
-		username: username,
-		password: password,
+func (e *AuthenticationError) GetBackend() string {
+	if e == nil {
+		return ""
 	}
+	return e.Backend
+}
+
+// Identity is the authenticated principal of an incoming gRPC request. Role is only set by backends which manage
+// roles along with their users.
+type Identity struct {
+	Username string
+	Role     string
+	Backend  string
 }
 
-func (a *Authenticator) AuthorizeUser(ctx context.Context) (bool, error) {
-	headers, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return false, errors.New("no Metadata found")
+// AuthBackend verifies one kind of credentials carried by an incoming gRPC request.
+type AuthBackend interface {
+	Name() string
+	Authenticate(ctx context.Context) (*Identity, error)
+}
+
+type identityContextKey struct{}
+
+// NewContextWithIdentity returns a copy of ctx that carries the given identity.
+func NewContextWithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity stored in ctx by the Authenticator interceptors.
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey{}).(*Identity)
+	return identity, ok
+}
+
+// Authenticator asks its backends in order to authenticate a request. The first backend which finds its kind of
+// credentials in the request decides about the outcome. With a Lockout, repeated failures of a user from the same peer
+// host lock the user out for that peer.
+type Authenticator struct {
+	backends []AuthBackend
+	lockout  *Lockout
+	mu       sync.RWMutex
+}
+
+func NewAuthenticator(backends ...AuthBackend) *Authenticator {
+	return &Authenticator{backends: backends}
+}
+
+func (a *Authenticator) AddBackend(backend AuthBackend) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.backends = append(a.backends, backend)
+}
+
+func (a *Authenticator) SetLockout(lockout *Lockout) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.lockout = lockout
+}
+
+// AuthenticateUser returns the identity of the request or an *AuthenticationError. A locked user is rejected even if
+// the presented credentials are valid.
+func (a *Authenticator) AuthenticateUser(ctx context.Context) (*Identity, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	identity, authErr := a.authenticate(ctx)
+	if authErr != nil && authErr.Backend == "" {
+		FailedAuthenticationsMetric.WithLabelValues("", failureReasonMissing).Inc()
+		return nil, authErr
 	}
 
-	u, ok := headers["username"]
-	if !ok || len(u) == 0 {
-		return false, errors.New("no username in Metadata")
+	username, backend := authErr.GetUsername(), authErr.GetBackend()
+	if identity != nil {
+		username, backend = identity.Username, identity.Backend
 	}
 
-	username := u[0]
+	host := peerHost(ctx)
+
+	if a.lockout != nil {
+		if lockErr := a.lockout.Check(username, host); lockErr != nil {
+			if authErr != nil {
+				a.lockout.Failure(username, host)
+			}
+			lockErr.Backend = backend
+			FailedAuthenticationsMetric.WithLabelValues(backend, failureReasonLocked).Inc()
+			return nil, lockErr
+		}
+	}
+
+	if authErr != nil {
+		if a.lockout != nil {
+			a.lockout.Failure(username, host)
+		}
+		FailedAuthenticationsMetric.WithLabelValues(backend, failureReasonInvalid).Inc()
+		return nil, authErr
+	}
 
-	p, ok := headers["password"]
-	if !ok || len(p) == 0 {
-		return false, errors.New(fmt.Sprintf("found username \"%s\" but no password in Metadata", username))
+	if a.lockout != nil {
+		a.lockout.Success(username, host)
 	}
 
-	password := p[0]
+	return identity, nil
+}
 
-	if _, ok := a.users[username]; ok {
-		if password == a.users[username].password && username == a.users[username].username {
-			return true, nil
+// authenticate asks the backends in order. Errors of backends are turned into an *AuthenticationError carrying the
+// backend name, an error without backend means that no backend found its kind of credentials.
+func (a *Authenticator) authenticate(ctx context.Context) (*Identity, *AuthenticationError) {
+	for _, backend := range a.backends {
+		identity, err := backend.Authenticate(ctx)
+		switch {
+		case err == ErrNoCredentials:
+			continue
+		case err != nil:
+			authErr, ok := err.(*AuthenticationError)
+			if !ok {
+				authErr = &AuthenticationError{Reason: err.Error()}
+			}
+			authErr.Backend = backend.Name()
+			return nil, authErr
 		}
+
+		return identity, nil
+	}
+
+	return nil, &AuthenticationError{Reason: "no supported credentials found in request"}
+}
+
+// UnaryServerInterceptor authenticates unary RPCs and passes the identity on to the handler through the context.
+func (a *Authenticator) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	identity, err := a.AuthenticateUser(ctx)
+	if err != nil {
+		log.Infof("denied a %v request from %v: %v", info.FullMethod, peerHost(ctx), err)
+		return nil, status.Error(codes.Unauthenticated, authenticationFailedMessage)
 	}
+	log.Infof("allowed a %v request by user %v", info.FullMethod, identity.Username)
+
+	return handler(NewContextWithIdentity(ctx, identity), req)
+}
+
+// StreamServerInterceptor authenticates streaming RPCs and passes the identity on to the handler through the stream
+// context.
+func (a *Authenticator) StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	identity, err := a.AuthenticateUser(stream.Context())
+	if err != nil {
+		log.Infof("denied a %v request from %v: %v", info.FullMethod, peerHost(stream.Context()), err)
+		return status.Error(codes.Unauthenticated, authenticationFailedMessage)
+	}
+	log.Infof("allowed a %v request by user %v", info.FullMethod, identity.Username)
+
+	return handler(srv, &authenticatedServerStream{ServerStream: stream, ctx: NewContextWithIdentity(stream.Context(), identity)})
+}
+
+// peerHost returns the address of the peer without its port, as a client gets a new port for every connection.
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown peer"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-	return false, errors.New(fmt.Sprintf("not authorized with \"%s:%s\"", username, password))
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
 }
@@ -0,0 +1,62 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	CertificateBackendName = "certificate"
+)
+
+// CertificateBackend takes the identity of a request from the common name of the client certificate that has been
+// verified during the mTLS handshake.
+type CertificateBackend struct{}
+
+func NewCertificateBackend() *CertificateBackend {
+	return &CertificateBackend{}
+}
+
+func (b *CertificateBackend) Name() string {
+	return CertificateBackendName
+}
+
+func (b *CertificateBackend) Authenticate(ctx context.Context) (*Identity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil, ErrNoCredentials
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	chains := tlsInfo.State.VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	commonName := chains[0][0].Subject.CommonName
+	if commonName == "" {
+		return nil, &AuthenticationError{Reason: "verified client certificate has no common name"}
+	}
+
+	return &Identity{Username: commonName, Backend: b.Name()}, nil
+}
@@ -0,0 +1,238 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const (
+	LocalBackendName   = "local"
+	usernameMetadata   = "username"
+	passwordMetadata   = "password"
+	dummyPasswordInput = "ovs-gnxi"
+)
+
+// dummyPasswordHash is compared against for unknown users, so that they take as long to reject as known ones.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte(dummyPasswordInput), bcrypt.DefaultCost)
+
+type LocalUser struct {
+	Username     string `json:"username"`
+	PasswordHash string `json:"password-hash"`
+	Role         string `json:"role,omitempty"`
+}
+
+type localUserFile struct {
+	Users []LocalUser `json:"users"`
+}
+
+// LocalUserBackend authenticates the username and password gRPC metadata against bcrypt hashed local users. Users
+// loaded from a file are written back to it by Save.
+type LocalUserBackend struct {
+	users  map[string]LocalUser
+	path   string
+	mu     sync.RWMutex
+	saveMu sync.Mutex
+}
+
+func NewLocalUserBackend() *LocalUserBackend {
+	return &LocalUserBackend{users: make(map[string]LocalUser)}
+}
+
+// NewLocalUserBackendFromFile creates a LocalUserBackend with the users of a JSON users file.
+func NewLocalUserBackendFromFile(path string) (*LocalUserBackend, error) {
+	b := NewLocalUserBackend()
+	b.path = path
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read users file %v: %v", path, err)
+	}
+
+	var f localUserFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("unable to parse users file %v: %v", path, err)
+	}
+
+	if err := b.ReplaceUsers(f.Users); err != nil {
+		return nil, fmt.Errorf("invalid user in users file %v: %v", path, err)
+	}
+
+	return b, nil
+}
+
+// Save writes the users to the file they have been loaded from. The file is replaced atomically, concurrent saves are
+// serialized so that the last one writes the latest users.
+func (b *LocalUserBackend) Save() error {
+	if b.path == "" {
+		return fmt.Errorf("users have not been loaded from a file")
+	}
+
+	b.saveMu.Lock()
+	defer b.saveMu.Unlock()
+
+	data, err := json.MarshalIndent(&localUserFile{Users: b.Users()}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(b.path), filepath.Base(b.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("unable to create temporary users file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write users file %v: %v", tmp.Name(), err)
+	}
+
+	if err := os.Rename(tmp.Name(), b.path); err != nil {
+		return fmt.Errorf("unable to replace users file %v: %v", b.path, err)
+	}
+
+	return nil
+}
+
+func (b *LocalUserBackend) Name() string {
+	return LocalBackendName
+}
+
+// AddUser hashes the password and adds or replaces the user.
+func (b *LocalUserBackend) AddUser(username, password, role string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	return b.AddUserHash(username, string(hash), role)
+}
+
+// AddUserHash adds or replaces the user with an already bcrypt hashed password.
+func (b *LocalUserBackend) AddUserHash(username, passwordHash, role string) error {
+	user := LocalUser{Username: username, PasswordHash: passwordHash, Role: role}
+	if err := validateLocalUser(user); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.users[username] = user
+
+	return nil
+}
+
+func (b *LocalUserBackend) RemoveUser(username string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.users, username)
+}
+
+// ReplaceUsers validates all users first and then replaces the complete user list with them.
+func (b *LocalUserBackend) ReplaceUsers(users []LocalUser) error {
+	m := make(map[string]LocalUser)
+	for _, u := range users {
+		if err := validateLocalUser(u); err != nil {
+			return err
+		}
+		if _, ok := m[u.Username]; ok {
+			return fmt.Errorf("duplicate user %q", u.Username)
+		}
+		m[u.Username] = u
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.users = m
+
+	return nil
+}
+
+// Users returns a copy of all users ordered by username.
+func (b *LocalUserBackend) Users() []LocalUser {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	users := make([]LocalUser, 0, len(b.users))
+	for _, u := range b.users {
+		users = append(users, u)
+	}
+
+	sort.Slice(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+
+	return users
+}
+
+func validateLocalUser(user LocalUser) error {
+	if user.Username == "" {
+		return fmt.Errorf("username must not be empty")
+	}
+
+	if _, err := bcrypt.Cost([]byte(user.PasswordHash)); err != nil {
+		return fmt.Errorf("password hash of user %q is not a bcrypt hash: %v", user.Username, err)
+	}
+
+	return nil
+}
+
+func (b *LocalUserBackend) Authenticate(ctx context.Context) (*Identity, error) {
+	headers, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	u, ok := headers[usernameMetadata]
+	if !ok || len(u) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	username := u[0]
+
+	p, ok := headers[passwordMetadata]
+	if !ok || len(p) == 0 {
+		return nil, &AuthenticationError{Username: username, Reason: "no password in metadata"}
+	}
+
+	b.mu.RLock()
+	user, ok := b.users[username]
+	b.mu.RUnlock()
+
+	if !ok {
+		bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(p[0]))
+		return nil, &AuthenticationError{Username: username, Reason: "invalid credentials"}
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(p[0])); err != nil {
+		return nil, &AuthenticationError{Username: username, Reason: "invalid credentials"}
+	}
+
+	return &Identity{Username: username, Role: user.Role, Backend: b.Name()}, nil
+}
@@ -0,0 +1,126 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type lockoutKey struct {
+	username string
+	peer     string
+}
+
+type failedAttempts struct {
+	count       int
+	last        time.Time
+	lockedUntil time.Time
+}
+
+// Lockout counts failed authentications per username and peer host. Once MaxFailures consecutive attempts have
+// failed, the pair is locked for BaseDelay, which doubles with every further failure up to MaxDelay. A successful
+// authentication outside of a lockout resets the count.
+type Lockout struct {
+	MaxFailures int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+
+	attempts map[lockoutKey]*failedAttempts
+	mu       sync.Mutex
+}
+
+func NewLockout(maxFailures int, baseDelay, maxDelay time.Duration) *Lockout {
+	return &Lockout{
+		MaxFailures: maxFailures,
+		BaseDelay:   baseDelay,
+		MaxDelay:    maxDelay,
+		attempts:    make(map[lockoutKey]*failedAttempts),
+	}
+}
+
+// Check returns an error if the username is currently locked for the peer.
+func (l *Lockout) Check(username, peer string) *AuthenticationError {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	a, ok := l.attempts[lockoutKey{username, peer}]
+	if !ok {
+		return nil
+	}
+
+	if remaining := time.Until(a.lockedUntil); remaining > 0 {
+		return &AuthenticationError{
+			Username: username,
+			Reason:   fmt.Sprintf("locked for another %v after %d failed attempts", remaining.Round(time.Second), a.count),
+			Locked:   true,
+		}
+	}
+
+	return nil
+}
+
+// Failure counts a failed attempt and locks the username for the peer once there have been too many.
+func (l *Lockout) Failure(username, peer string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.expire(now)
+
+	key := lockoutKey{username, peer}
+	a, ok := l.attempts[key]
+	if !ok {
+		a = &failedAttempts{}
+		l.attempts[key] = a
+	}
+
+	a.count++
+	a.last = now
+
+	if l.MaxFailures <= 0 || a.count < l.MaxFailures {
+		return
+	}
+
+	delay := l.BaseDelay
+	for i := l.MaxFailures; i < a.count && delay < l.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > l.MaxDelay {
+		delay = l.MaxDelay
+	}
+
+	a.lockedUntil = now.Add(delay)
+	log.Warningf("Locked user %q for peer %v for %v after %d failed authentication attempts", username, peer, delay, a.count)
+}
+
+// Success resets the failed attempts of the username for the peer.
+func (l *Lockout) Success(username, peer string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.attempts, lockoutKey{username, peer})
+}
+
+// expire forgets failed attempts which are neither locked nor recent, so that the map cannot grow without bounds.
+func (l *Lockout) expire(now time.Time) {
+	for key, a := range l.attempts {
+		if now.After(a.lockedUntil) && now.Sub(a.last) > l.MaxDelay {
+			delete(l.attempts, key)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"io/ioutil"
+	"strings"
+	"sync"
+)
+
+const (
+	TokenBackendName      = "token"
+	authorizationMetadata = "authorization"
+	bearerScheme          = "bearer"
+)
+
+type Token struct {
+	Username    string `json:"username"`
+	TokenSHA256 string `json:"token-sha256"`
+}
+
+type tokenFile struct {
+	Tokens []Token `json:"tokens"`
+}
+
+// TokenBackend authenticates bearer tokens sent as "authorization: Bearer <token>" gRPC metadata. Only the SHA-256
+// digest of each token is kept.
+type TokenBackend struct {
+	tokens map[[sha256.Size]byte]string
+	mu     sync.RWMutex
+}
+
+func NewTokenBackend() *TokenBackend {
+	return &TokenBackend{tokens: make(map[[sha256.Size]byte]string)}
+}
+
+// NewTokenBackendFromFile creates a TokenBackend with the tokens of a JSON tokens file.
+func NewTokenBackendFromFile(path string) (*TokenBackend, error) {
+	b := NewTokenBackend()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tokens file %v: %v", path, err)
+	}
+
+	var f tokenFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("unable to parse tokens file %v: %v", path, err)
+	}
+
+	for _, t := range f.Tokens {
+		if err := b.AddTokenHash(t.Username, t.TokenSHA256); err != nil {
+			return nil, fmt.Errorf("invalid token in tokens file %v: %v", path, err)
+		}
+	}
+
+	return b, nil
+}
+
+func (b *TokenBackend) Name() string {
+	return TokenBackendName
+}
+
+func (b *TokenBackend) AddToken(username, token string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens[sha256.Sum256([]byte(token))] = username
+}
+
+// AddTokenHash adds a token by its hex encoded SHA-256 digest.
+func (b *TokenBackend) AddTokenHash(username, tokenSHA256 string) error {
+	if username == "" {
+		return errors.New("username must not be empty")
+	}
+
+	digest, err := hex.DecodeString(tokenSHA256)
+	if err != nil || len(digest) != sha256.Size {
+		return fmt.Errorf("token of user %q is not a hex encoded SHA-256 digest", username)
+	}
+
+	var key [sha256.Size]byte
+	copy(key[:], digest)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens[key] = username
+
+	return nil
+}
+
+func (b *TokenBackend) Authenticate(ctx context.Context) (*Identity, error) {
+	headers, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	a, ok := headers[authorizationMetadata]
+	if !ok || len(a) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	s := strings.SplitN(strings.TrimSpace(a[0]), " ", 2)
+	if len(s) != 2 || strings.ToLower(s[0]) != bearerScheme {
+		return nil, ErrNoCredentials
+	}
+
+	b.mu.RLock()
+	username, ok := b.tokens[sha256.Sum256([]byte(strings.TrimSpace(s[1])))]
+	b.mu.RUnlock()
+
+	if !ok {
+		return nil, &AuthenticationError{Reason: "invalid bearer token"}
+	}
+
+	return &Identity{Username: username, Backend: b.Name()}, nil
+}
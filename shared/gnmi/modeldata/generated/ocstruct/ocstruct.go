@@ -6,10 +6,23 @@ in this case).
 
 This package was generated by /root/go/src/ovs-gnxi/vendor/github.com/openconfig/ygot/ygen/commongen.go
 using the following YANG input files:
+	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-if-aggregate.yang
+	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-if-ethernet.yang
 	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-interfaces.yang
+	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-lacp.yang
+	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-lldp.yang
 	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-openflow.yang
 	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-platform.yang
+	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-spanning-tree.yang
 	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-system.yang
+	- /root/go/src/ovs-gnxi/yang/openconfig/openconfig-vlan.yang
+	- /root/go/src/ovs-gnxi/yang/ovs/ovs-bridges.yang
+	- /root/go/src/ovs-gnxi/yang/ovs/ovs-flows.yang
+	- /root/go/src/ovs-gnxi/yang/ovs/ovs-interfaces.yang
+	- /root/go/src/ovs-gnxi/yang/ovs/ovs-mirrors.yang
+	- /root/go/src/ovs-gnxi/yang/ovs/ovs-qos.yang
+	- /root/go/src/ovs-gnxi/yang/ovs/ovs-stp.yang
+	- /root/go/src/ovs-gnxi/yang/ovs/ovs-system.yang
 Imported modules were sourced from:
 	- yang/...
 */
@@ -91,6 +104,249 @@ func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOp
 	return ytypes.Unmarshal(schema, destStruct, jsonTree, opts...)
 }
 
+// Bridge represents the /ovs-bridges/bridges/bridge YANG schema element.
+type Bridge struct {
+	FlowTable map[uint8]*Bridge_FlowTable `path:"flow-tables/flow-table" module:"ovs-flows"`
+	Ipfix     *Bridge_Ipfix               `path:"ipfix" module:"ovs-bridges"`
+	Name      *string                     `path:"config/name|name" module:"ovs-bridges"`
+	Netflow   *Bridge_Netflow             `path:"netflow" module:"ovs-bridges"`
+	Sflow     *Bridge_Sflow               `path:"sflow" module:"ovs-bridges"`
+}
+
+// IsYANGGoStruct ensures that Bridge implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Bridge) IsYANGGoStruct() {}
+
+// NewFlowTable creates a new entry in the FlowTable list of the
+// Bridge struct. The keys of the list are populated from the input
+// arguments.
+func (t *Bridge) NewFlowTable(Id uint8) (*Bridge_FlowTable, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.FlowTable == nil {
+		t.FlowTable = make(map[uint8]*Bridge_FlowTable)
+	}
+
+	key := Id
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.FlowTable[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list FlowTable", key)
+	}
+
+	t.FlowTable[key] = &Bridge_FlowTable{
+		Id: &Id,
+	}
+
+	return t.FlowTable[key], nil
+}
+
+// ΛListKeyMap returns the keys of the Bridge struct, which is a YANG list entry.
+func (t *Bridge) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Bridge) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Bridge"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Bridge) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Bridge_FlowTable represents the /ovs-bridges/bridges/bridge/flow-tables/flow-table YANG schema element.
+type Bridge_FlowTable struct {
+	Flow map[string]*Bridge_FlowTable_Flow `path:"flows/flow" module:"ovs-flows"`
+	Id   *uint8                            `path:"state/id|id" module:"ovs-flows"`
+}
+
+// IsYANGGoStruct ensures that Bridge_FlowTable implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Bridge_FlowTable) IsYANGGoStruct() {}
+
+// NewFlow creates a new entry in the Flow list of the
+// Bridge_FlowTable struct. The keys of the list are populated from the input
+// arguments.
+func (t *Bridge_FlowTable) NewFlow(Id string) (*Bridge_FlowTable_Flow, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Flow == nil {
+		t.Flow = make(map[string]*Bridge_FlowTable_Flow)
+	}
+
+	key := Id
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Flow[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Flow", key)
+	}
+
+	t.Flow[key] = &Bridge_FlowTable_Flow{
+		Id: &Id,
+	}
+
+	return t.Flow[key], nil
+}
+
+// ΛListKeyMap returns the keys of the Bridge_FlowTable struct, which is a YANG list entry.
+func (t *Bridge_FlowTable) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	return map[string]interface{}{
+		"id": *t.Id,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Bridge_FlowTable) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Bridge_FlowTable"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Bridge_FlowTable) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Bridge_FlowTable_Flow represents the /ovs-bridges/bridges/bridge/flow-tables/flow-table/flows/flow YANG schema element.
+type Bridge_FlowTable_Flow struct {
+	Actions     *string `path:"state/actions" module:"ovs-flows"`
+	ByteCount   *uint64 `path:"state/byte-count" module:"ovs-flows"`
+	Cookie      *uint64 `path:"state/cookie" module:"ovs-flows"`
+	Duration    *uint64 `path:"state/duration" module:"ovs-flows"`
+	HardTimeout *uint16 `path:"state/hard-timeout" module:"ovs-flows"`
+	Id          *string `path:"state/id|id" module:"ovs-flows"`
+	IdleTimeout *uint16 `path:"state/idle-timeout" module:"ovs-flows"`
+	Match       *string `path:"state/match" module:"ovs-flows"`
+	PacketCount *uint64 `path:"state/packet-count" module:"ovs-flows"`
+	Priority    *uint16 `path:"state/priority" module:"ovs-flows"`
+}
+
+// IsYANGGoStruct ensures that Bridge_FlowTable_Flow implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Bridge_FlowTable_Flow) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Bridge_FlowTable_Flow struct, which is a YANG list entry.
+func (t *Bridge_FlowTable_Flow) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	return map[string]interface{}{
+		"id": *t.Id,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Bridge_FlowTable_Flow) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Bridge_FlowTable_Flow"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Bridge_FlowTable_Flow) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Bridge_Ipfix represents the /ovs-bridges/bridges/bridge/ipfix YANG schema element.
+type Bridge_Ipfix struct {
+	ObsDomainId *uint32  `path:"config/obs-domain-id" module:"ovs-bridges"`
+	ObsPointId  *uint32  `path:"config/obs-point-id" module:"ovs-bridges"`
+	Sampling    *uint32  `path:"config/sampling" module:"ovs-bridges"`
+	Targets     []string `path:"config/targets" module:"ovs-bridges"`
+}
+
+// IsYANGGoStruct ensures that Bridge_Ipfix implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Bridge_Ipfix) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Bridge_Ipfix) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Bridge_Ipfix"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Bridge_Ipfix) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Bridge_Netflow represents the /ovs-bridges/bridges/bridge/netflow YANG schema element.
+type Bridge_Netflow struct {
+	ActiveTimeout *int32   `path:"config/active-timeout" module:"ovs-bridges"`
+	EngineId      *uint8   `path:"config/engine-id" module:"ovs-bridges"`
+	EngineType    *uint8   `path:"config/engine-type" module:"ovs-bridges"`
+	Targets       []string `path:"config/targets" module:"ovs-bridges"`
+}
+
+// IsYANGGoStruct ensures that Bridge_Netflow implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Bridge_Netflow) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Bridge_Netflow) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Bridge_Netflow"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Bridge_Netflow) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Bridge_Sflow represents the /ovs-bridges/bridges/bridge/sflow YANG schema element.
+type Bridge_Sflow struct {
+	Agent    *string  `path:"config/agent" module:"ovs-bridges"`
+	Header   *uint32  `path:"config/header" module:"ovs-bridges"`
+	Polling  *uint32  `path:"config/polling" module:"ovs-bridges"`
+	Sampling *uint32  `path:"config/sampling" module:"ovs-bridges"`
+	Targets  []string `path:"config/targets" module:"ovs-bridges"`
+}
+
+// IsYANGGoStruct ensures that Bridge_Sflow implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Bridge_Sflow) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Bridge_Sflow) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Bridge_Sflow"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Bridge_Sflow) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
 // Component represents the /openconfig-platform/components/component YANG schema element.
 type Component struct {
 	AllocatedPower    *uint32                                         `path:"state/allocated-power" module:"openconfig-platform"`
@@ -641,8 +897,13 @@ func (t *Component_Temperature) ΛEnumTypeMap() map[string][]reflect.Type { retu
 
 // Device represents the /device YANG schema element.
 type Device struct {
+	Bridge    map[string]*Bridge    `path:"bridges/bridge" module:"ovs-bridges"`
 	Component map[string]*Component `path:"components/component" module:"openconfig-platform"`
 	Interface map[string]*Interface `path:"interfaces/interface" module:"openconfig-interfaces"`
+	Lacp      *Lacp                 `path:"lacp" module:"openconfig-lacp"`
+	Lldp      *Lldp                 `path:"lldp" module:"openconfig-lldp"`
+	Mirror    map[string]*Mirror    `path:"mirrors/mirror" module:"ovs-mirrors"`
+	Stp       *Stp                  `path:"stp" module:"openconfig-spanning-tree"`
 	System    *System               `path:"system" module:"openconfig-system"`
 }
 
@@ -651,6 +912,33 @@ type Device struct {
 // identify it as being generated by ygen.
 func (*Device) IsYANGGoStruct() {}
 
+// NewBridge creates a new entry in the Bridge list of the
+// Device struct. The keys of the list are populated from the input
+// arguments.
+func (t *Device) NewBridge(Name string) (*Bridge, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Bridge == nil {
+		t.Bridge = make(map[string]*Bridge)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Bridge[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Bridge", key)
+	}
+
+	t.Bridge[key] = &Bridge{
+		Name: &Name,
+	}
+
+	return t.Bridge[key], nil
+}
+
 // NewComponent creates a new entry in the Component list of the
 // Device struct. The keys of the list are populated from the input
 // arguments.
@@ -705,6 +993,33 @@ func (t *Device) NewInterface(Name string) (*Interface, error) {
 	return t.Interface[key], nil
 }
 
+// NewMirror creates a new entry in the Mirror list of the
+// Device struct. The keys of the list are populated from the input
+// arguments.
+func (t *Device) NewMirror(Name string) (*Mirror, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Mirror == nil {
+		t.Mirror = make(map[string]*Mirror)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Mirror[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Mirror", key)
+	}
+
+	t.Mirror[key] = &Mirror{
+		Name: &Name,
+	}
+
+	return t.Mirror[key], nil
+}
+
 // Validate validates s against the YANG schema corresponding to its type.
 func (t *Device) Validate(opts ...ygot.ValidationOption) error {
 	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
@@ -720,9 +1035,11 @@ func (t *Device) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes
 // Interface represents the /openconfig-interfaces/interfaces/interface YANG schema element.
 type Interface struct {
 	AdminStatus  E_OpenconfigInterfaces_Interface_AdminStatus `path:"state/admin-status" module:"openconfig-interfaces"`
+	Aggregation  *Interface_Aggregation                       `path:"aggregation" module:"openconfig-if-aggregate"`
 	Counters     *Interface_Counters                          `path:"state/counters" module:"openconfig-interfaces"`
 	Description  *string                                      `path:"config/description" module:"openconfig-interfaces"`
 	Enabled      *bool                                        `path:"config/enabled" module:"openconfig-interfaces"`
+	Ethernet     *Interface_Ethernet                          `path:"ethernet" module:"openconfig-if-ethernet"`
 	HoldTime     *Interface_HoldTime                          `path:"hold-time" module:"openconfig-interfaces"`
 	Ifindex      *uint32                                      `path:"state/ifindex" module:"openconfig-interfaces"`
 	LastChange   *uint64                                      `path:"state/last-change" module:"openconfig-interfaces"`
@@ -731,7 +1048,11 @@ type Interface struct {
 	Mtu          *uint16                                      `path:"config/mtu" module:"openconfig-interfaces"`
 	Name         *string                                      `path:"config/name|name" module:"openconfig-interfaces"`
 	OperStatus   E_OpenconfigInterfaces_Interface_OperStatus  `path:"state/oper-status" module:"openconfig-interfaces"`
+	Ovs          *Interface_Ovs                               `path:"ovs" module:"ovs-interfaces"`
+	Qos          *Interface_Qos                               `path:"qos" module:"ovs-qos"`
+	RoutedVlan   *Interface_RoutedVlan                        `path:"routed-vlan" module:"openconfig-vlan"`
 	Subinterface map[uint32]*Interface_Subinterface           `path:"subinterfaces/subinterface" module:"openconfig-interfaces"`
+	Tpid         E_OpenconfigVlanTypes_TPID_TYPES             `path:"config/tpid" module:"openconfig-vlan"`
 	Type         E_IETFInterfaces_InterfaceType               `path:"config/type" module:"openconfig-interfaces"`
 }
 
@@ -790,36 +1111,23 @@ func (t *Interface) Validate(opts ...ygot.ValidationOption) error {
 // that are included in the generated code.
 func (t *Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
 
-// Interface_Counters represents the /openconfig-interfaces/interfaces/interface/state/counters YANG schema element.
-type Interface_Counters struct {
-	CarrierTransitions *uint64 `path:"carrier-transitions" module:"openconfig-interfaces"`
-	InBroadcastPkts    *uint64 `path:"in-broadcast-pkts" module:"openconfig-interfaces"`
-	InDiscards         *uint64 `path:"in-discards" module:"openconfig-interfaces"`
-	InErrors           *uint64 `path:"in-errors" module:"openconfig-interfaces"`
-	InFcsErrors        *uint64 `path:"in-fcs-errors" module:"openconfig-interfaces"`
-	InMulticastPkts    *uint64 `path:"in-multicast-pkts" module:"openconfig-interfaces"`
-	InOctets           *uint64 `path:"in-octets" module:"openconfig-interfaces"`
-	InPkts             *uint64 `path:"in-pkts" module:"openconfig-interfaces"`
-	InUnicastPkts      *uint64 `path:"in-unicast-pkts" module:"openconfig-interfaces"`
-	InUnknownProtos    *uint64 `path:"in-unknown-protos" module:"openconfig-interfaces"`
-	LastClear          *uint64 `path:"last-clear" module:"openconfig-interfaces"`
-	OutBroadcastPkts   *uint64 `path:"out-broadcast-pkts" module:"openconfig-interfaces"`
-	OutDiscards        *uint64 `path:"out-discards" module:"openconfig-interfaces"`
-	OutErrors          *uint64 `path:"out-errors" module:"openconfig-interfaces"`
-	OutMulticastPkts   *uint64 `path:"out-multicast-pkts" module:"openconfig-interfaces"`
-	OutOctets          *uint64 `path:"out-octets" module:"openconfig-interfaces"`
-	OutPkts            *uint64 `path:"out-pkts" module:"openconfig-interfaces"`
-	OutUnicastPkts     *uint64 `path:"out-unicast-pkts" module:"openconfig-interfaces"`
+// Interface_Aggregation represents the /openconfig-interfaces/interfaces/interface/aggregation YANG schema element.
+type Interface_Aggregation struct {
+	LagSpeed     *uint32                                 `path:"state/lag-speed" module:"openconfig-if-aggregate"`
+	LagType      E_OpenconfigIfAggregate_AggregationType `path:"config/lag-type" module:"openconfig-if-aggregate"`
+	Member       []string                                `path:"state/member" module:"openconfig-if-aggregate"`
+	MinLinks     *uint16                                 `path:"config/min-links" module:"openconfig-if-aggregate"`
+	SwitchedVlan *Interface_Aggregation_SwitchedVlan     `path:"switched-vlan" module:"openconfig-vlan"`
 }
 
-// IsYANGGoStruct ensures that Interface_Counters implements the yang.GoStruct
+// IsYANGGoStruct ensures that Interface_Aggregation implements the yang.GoStruct
 // interface. This allows functions that need to handle this struct to
 // identify it as being generated by ygen.
-func (*Interface_Counters) IsYANGGoStruct() {}
+func (*Interface_Aggregation) IsYANGGoStruct() {}
 
 // Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Counters"], t, opts...); err != nil {
+func (t *Interface_Aggregation) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Aggregation"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -827,22 +1135,24 @@ func (t *Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
 
 // ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
 // that are included in the generated code.
-func (t *Interface_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+func (t *Interface_Aggregation) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
 
-// Interface_HoldTime represents the /openconfig-interfaces/interfaces/interface/hold-time YANG schema element.
-type Interface_HoldTime struct {
-	Down *uint32 `path:"config/down" module:"openconfig-interfaces"`
-	Up   *uint32 `path:"config/up" module:"openconfig-interfaces"`
+// Interface_Aggregation_SwitchedVlan represents the /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan YANG schema element.
+type Interface_Aggregation_SwitchedVlan struct {
+	AccessVlan    *uint16                                               `path:"config/access-vlan" module:"openconfig-vlan"`
+	InterfaceMode E_OpenconfigVlan_VlanModeType                         `path:"config/interface-mode" module:"openconfig-vlan"`
+	NativeVlan    *uint16                                               `path:"config/native-vlan" module:"openconfig-vlan"`
+	TrunkVlans    []Interface_Aggregation_SwitchedVlan_TrunkVlans_Union `path:"config/trunk-vlans" module:"openconfig-vlan"`
 }
 
-// IsYANGGoStruct ensures that Interface_HoldTime implements the yang.GoStruct
+// IsYANGGoStruct ensures that Interface_Aggregation_SwitchedVlan implements the yang.GoStruct
 // interface. This allows functions that need to handle this struct to
 // identify it as being generated by ygen.
-func (*Interface_HoldTime) IsYANGGoStruct() {}
+func (*Interface_Aggregation_SwitchedVlan) IsYANGGoStruct() {}
 
 // Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_HoldTime) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_HoldTime"], t, opts...); err != nil {
+func (t *Interface_Aggregation_SwitchedVlan) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Aggregation_SwitchedVlan"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -850,49 +1160,624 @@ func (t *Interface_HoldTime) Validate(opts ...ygot.ValidationOption) error {
 
 // ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
 // that are included in the generated code.
-func (t *Interface_HoldTime) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// Interface_Subinterface represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface YANG schema element.
-type Interface_Subinterface struct {
-	AdminStatus E_OpenconfigInterfaces_Interface_AdminStatus `path:"state/admin-status" module:"openconfig-interfaces"`
-	Counters    *Interface_Subinterface_Counters             `path:"state/counters" module:"openconfig-interfaces"`
-	Description *string                                      `path:"config/description" module:"openconfig-interfaces"`
-	Enabled     *bool                                        `path:"config/enabled" module:"openconfig-interfaces"`
-	Ifindex     *uint32                                      `path:"state/ifindex" module:"openconfig-interfaces"`
-	Index       *uint32                                      `path:"config/index|index" module:"openconfig-interfaces"`
-	LastChange  *uint64                                      `path:"state/last-change" module:"openconfig-interfaces"`
-	Logical     *bool                                        `path:"state/logical" module:"openconfig-interfaces"`
-	Name        *string                                      `path:"state/name" module:"openconfig-interfaces"`
-	OperStatus  E_OpenconfigInterfaces_Interface_OperStatus  `path:"state/oper-status" module:"openconfig-interfaces"`
+func (t *Interface_Aggregation_SwitchedVlan) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
 }
 
-// IsYANGGoStruct ensures that Interface_Subinterface implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface) IsYANGGoStruct() {}
+// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/trunk-vlans within the YANG schema.
+type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface {
+	Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union()
+}
 
-// ΛListKeyMap returns the keys of the Interface_Subinterface struct, which is a YANG list entry.
-func (t *Interface_Subinterface) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Index == nil {
-		return nil, fmt.Errorf("nil value for key Index")
-	}
+// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/trunk-vlans
+// is to be set to a string value.
+type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String struct {
+	String string
+}
 
-	return map[string]interface{}{
-		"index": *t.Index,
-	}, nil
+// Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union ensures that Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String
+// implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface.
+func (*Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String) Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union() {
 }
 
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface"], t, opts...); err != nil {
-		return err
-	}
-	return nil
+// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/trunk-vlans
+// is to be set to a uint16 value.
+type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16 struct {
+	Uint16 uint16
 }
 
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+// Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union ensures that Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16
+// implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface.
+func (*Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16) Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union() {
+}
+
+// To_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Interface_Aggregation_SwitchedVlan) To_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union(i interface{}) (Interface_Aggregation_SwitchedVlan_TrunkVlans_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String{v}, nil
+	case uint16:
+		return &Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Interface_Aggregation_SwitchedVlan_TrunkVlans_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Interface_Counters represents the /openconfig-interfaces/interfaces/interface/state/counters YANG schema element.
+type Interface_Counters struct {
+	CarrierTransitions *uint64                                  `path:"carrier-transitions" module:"openconfig-interfaces"`
+	InBroadcastPkts    *uint64                                  `path:"in-broadcast-pkts" module:"openconfig-interfaces"`
+	InDiscards         *uint64                                  `path:"in-discards" module:"openconfig-interfaces"`
+	InErrors           *uint64                                  `path:"in-errors" module:"openconfig-interfaces"`
+	InFcsErrors        *uint64                                  `path:"in-fcs-errors" module:"openconfig-interfaces"`
+	InMulticastPkts    *uint64                                  `path:"in-multicast-pkts" module:"openconfig-interfaces"`
+	InOctets           *uint64                                  `path:"in-octets" module:"openconfig-interfaces"`
+	InPkts             *uint64                                  `path:"in-pkts" module:"openconfig-interfaces"`
+	InUnicastPkts      *uint64                                  `path:"in-unicast-pkts" module:"openconfig-interfaces"`
+	InUnknownProtos    *uint64                                  `path:"in-unknown-protos" module:"openconfig-interfaces"`
+	LastClear          *uint64                                  `path:"last-clear" module:"openconfig-interfaces"`
+	OutBroadcastPkts   *uint64                                  `path:"out-broadcast-pkts" module:"openconfig-interfaces"`
+	OutDiscards        *uint64                                  `path:"out-discards" module:"openconfig-interfaces"`
+	OutErrors          *uint64                                  `path:"out-errors" module:"openconfig-interfaces"`
+	OutMulticastPkts   *uint64                                  `path:"out-multicast-pkts" module:"openconfig-interfaces"`
+	OutOctets          *uint64                                  `path:"out-octets" module:"openconfig-interfaces"`
+	OutPkts            *uint64                                  `path:"out-pkts" module:"openconfig-interfaces"`
+	OutUnicastPkts     *uint64                                  `path:"out-unicast-pkts" module:"openconfig-interfaces"`
+	Statistic          map[string]*Interface_Counters_Statistic `path:"statistics/statistic" module:"ovs-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Counters) IsYANGGoStruct() {}
+
+// NewStatistic creates a new entry in the Statistic list of the
+// Interface_Counters struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Counters) NewStatistic(Name string) (*Interface_Counters_Statistic, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Statistic == nil {
+		t.Statistic = make(map[string]*Interface_Counters_Statistic)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Statistic[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Statistic", key)
+	}
+
+	t.Statistic[key] = &Interface_Counters_Statistic{
+		Name: &Name,
+	}
+
+	return t.Statistic[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Counters_Statistic represents the /openconfig-interfaces/interfaces/interface/state/counters/statistics/statistic YANG schema element.
+type Interface_Counters_Statistic struct {
+	Name  *string `path:"state/name|name" module:"ovs-interfaces"`
+	Value *uint64 `path:"state/value" module:"ovs-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_Counters_Statistic implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Counters_Statistic) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Interface_Counters_Statistic struct, which is a YANG list entry.
+func (t *Interface_Counters_Statistic) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Counters_Statistic) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Counters_Statistic"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Counters_Statistic) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Ethernet represents the /openconfig-interfaces/interfaces/interface/ethernet YANG schema element.
+type Interface_Ethernet struct {
+	AggregateId          *string                                              `path:"config/aggregate-id" module:"openconfig-if-aggregate"`
+	AutoNegotiate        *bool                                                `path:"config/auto-negotiate" module:"openconfig-if-ethernet"`
+	Counters             *Interface_Ethernet_Counters                         `path:"state/counters" module:"openconfig-if-ethernet"`
+	DuplexMode           E_OpenconfigIfEthernet_Ethernet_DuplexMode           `path:"config/duplex-mode" module:"openconfig-if-ethernet"`
+	EnableFlowControl    *bool                                                `path:"config/enable-flow-control" module:"openconfig-if-ethernet"`
+	HwMacAddress         *string                                              `path:"state/hw-mac-address" module:"openconfig-if-ethernet"`
+	MacAddress           *string                                              `path:"config/mac-address" module:"openconfig-if-ethernet"`
+	NegotiatedDuplexMode E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode `path:"state/negotiated-duplex-mode" module:"openconfig-if-ethernet"`
+	NegotiatedPortSpeed  E_OpenconfigIfEthernet_ETHERNET_SPEED                `path:"state/negotiated-port-speed" module:"openconfig-if-ethernet"`
+	PortSpeed            E_OpenconfigIfEthernet_ETHERNET_SPEED                `path:"config/port-speed" module:"openconfig-if-ethernet"`
+	SwitchedVlan         *Interface_Ethernet_SwitchedVlan                     `path:"switched-vlan" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ethernet implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ethernet) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Ethernet) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Ethernet"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Ethernet) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Ethernet_Counters represents the /openconfig-interfaces/interfaces/interface/ethernet/state/counters YANG schema element.
+type Interface_Ethernet_Counters struct {
+	In_8021QFrames      *uint64 `path:"in-8021q-frames" module:"openconfig-if-ethernet"`
+	InBlockErrors       *uint64 `path:"in-block-errors" module:"openconfig-if-ethernet"`
+	InCrcErrors         *uint64 `path:"in-crc-errors" module:"openconfig-if-ethernet"`
+	InFragmentFrames    *uint64 `path:"in-fragment-frames" module:"openconfig-if-ethernet"`
+	InJabberFrames      *uint64 `path:"in-jabber-frames" module:"openconfig-if-ethernet"`
+	InMacControlFrames  *uint64 `path:"in-mac-control-frames" module:"openconfig-if-ethernet"`
+	InMacPauseFrames    *uint64 `path:"in-mac-pause-frames" module:"openconfig-if-ethernet"`
+	InOversizeFrames    *uint64 `path:"in-oversize-frames" module:"openconfig-if-ethernet"`
+	InUndersizeFrames   *uint64 `path:"in-undersize-frames" module:"openconfig-if-ethernet"`
+	Out_8021QFrames     *uint64 `path:"out-8021q-frames" module:"openconfig-if-ethernet"`
+	OutMacControlFrames *uint64 `path:"out-mac-control-frames" module:"openconfig-if-ethernet"`
+	OutMacPauseFrames   *uint64 `path:"out-mac-pause-frames" module:"openconfig-if-ethernet"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ethernet_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ethernet_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Ethernet_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Ethernet_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Ethernet_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Ethernet_SwitchedVlan represents the /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan YANG schema element.
+type Interface_Ethernet_SwitchedVlan struct {
+	AccessVlan    *uint16                                            `path:"config/access-vlan" module:"openconfig-vlan"`
+	InterfaceMode E_OpenconfigVlan_VlanModeType                      `path:"config/interface-mode" module:"openconfig-vlan"`
+	NativeVlan    *uint16                                            `path:"config/native-vlan" module:"openconfig-vlan"`
+	TrunkVlans    []Interface_Ethernet_SwitchedVlan_TrunkVlans_Union `path:"config/trunk-vlans" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ethernet_SwitchedVlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ethernet_SwitchedVlan) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Ethernet_SwitchedVlan) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Ethernet_SwitchedVlan"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Ethernet_SwitchedVlan) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/trunk-vlans within the YANG schema.
+type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface {
+	Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union()
+}
+
+// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/trunk-vlans
+// is to be set to a string value.
+type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String struct {
+	String string
+}
+
+// Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union ensures that Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String
+// implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface.
+func (*Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String) Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union() {
+}
+
+// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/trunk-vlans
+// is to be set to a uint16 value.
+type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union ensures that Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16
+// implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface.
+func (*Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16) Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union() {
+}
+
+// To_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Interface_Ethernet_SwitchedVlan) To_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union(i interface{}) (Interface_Ethernet_SwitchedVlan_TrunkVlans_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String{v}, nil
+	case uint16:
+		return &Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Interface_Ethernet_SwitchedVlan_TrunkVlans_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Interface_HoldTime represents the /openconfig-interfaces/interfaces/interface/hold-time YANG schema element.
+type Interface_HoldTime struct {
+	Down *uint32 `path:"config/down" module:"openconfig-interfaces"`
+	Up   *uint32 `path:"config/up" module:"openconfig-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_HoldTime implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_HoldTime) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_HoldTime) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_HoldTime"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_HoldTime) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Ovs represents the /openconfig-interfaces/interfaces/interface/ovs YANG schema element.
+type Interface_Ovs struct {
+	Bond   *Interface_Ovs_Bond   `path:"bond" module:"ovs-interfaces"`
+	Bridge *string               `path:"config/bridge" module:"ovs-interfaces"`
+	Tunnel *Interface_Ovs_Tunnel `path:"tunnel" module:"ovs-interfaces"`
+	Type   *string               `path:"config/type" module:"ovs-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ovs implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ovs) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Ovs) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Ovs"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Ovs) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Ovs_Bond represents the /openconfig-interfaces/interfaces/interface/ovs/bond YANG schema element.
+type Interface_Ovs_Bond struct {
+	ActiveMember *string `path:"state/active-member" module:"ovs-interfaces"`
+	Downdelay    *uint32 `path:"config/downdelay" module:"ovs-interfaces"`
+	Mode         *string `path:"config/mode" module:"ovs-interfaces"`
+	Updelay      *uint32 `path:"config/updelay" module:"ovs-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ovs_Bond implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ovs_Bond) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Ovs_Bond) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Ovs_Bond"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Ovs_Bond) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Ovs_Tunnel represents the /openconfig-interfaces/interfaces/interface/ovs/tunnel YANG schema element.
+type Interface_Ovs_Tunnel struct {
+	DstPort  *uint16 `path:"config/dst-port" module:"ovs-interfaces"`
+	Key      *string `path:"config/key" module:"ovs-interfaces"`
+	LocalIp  *string `path:"config/local-ip" module:"ovs-interfaces"`
+	RemoteIp *string `path:"config/remote-ip" module:"ovs-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ovs_Tunnel implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ovs_Tunnel) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Ovs_Tunnel) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Ovs_Tunnel"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Ovs_Tunnel) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Qos represents the /openconfig-interfaces/interfaces/interface/qos YANG schema element.
+type Interface_Qos struct {
+	IngressPolicing *Interface_Qos_IngressPolicing  `path:"ingress-policing" module:"ovs-qos"`
+	MaxRate         *uint64                         `path:"config/max-rate" module:"ovs-qos"`
+	Queue           map[uint32]*Interface_Qos_Queue `path:"queues/queue" module:"ovs-qos"`
+	Type            *string                         `path:"config/type" module:"ovs-qos"`
+}
+
+// IsYANGGoStruct ensures that Interface_Qos implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Qos) IsYANGGoStruct() {}
+
+// NewQueue creates a new entry in the Queue list of the
+// Interface_Qos struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Qos) NewQueue(Id uint32) (*Interface_Qos_Queue, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Queue == nil {
+		t.Queue = make(map[uint32]*Interface_Qos_Queue)
+	}
+
+	key := Id
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Queue[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Queue", key)
+	}
+
+	t.Queue[key] = &Interface_Qos_Queue{
+		Id: &Id,
+	}
+
+	return t.Queue[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Qos) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Qos"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Qos) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Qos_IngressPolicing represents the /openconfig-interfaces/interfaces/interface/qos/ingress-policing YANG schema element.
+type Interface_Qos_IngressPolicing struct {
+	Burst *uint32 `path:"config/burst" module:"ovs-qos"`
+	Rate  *uint32 `path:"config/rate" module:"ovs-qos"`
+}
+
+// IsYANGGoStruct ensures that Interface_Qos_IngressPolicing implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Qos_IngressPolicing) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Qos_IngressPolicing) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Qos_IngressPolicing"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Qos_IngressPolicing) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Qos_Queue represents the /openconfig-interfaces/interfaces/interface/qos/queues/queue YANG schema element.
+type Interface_Qos_Queue struct {
+	Counters *Interface_Qos_Queue_Counters `path:"state/counters" module:"ovs-qos"`
+	Id       *uint32                       `path:"config/id|id" module:"ovs-qos"`
+	MaxRate  *uint64                       `path:"config/max-rate" module:"ovs-qos"`
+	MinRate  *uint64                       `path:"config/min-rate" module:"ovs-qos"`
+}
+
+// IsYANGGoStruct ensures that Interface_Qos_Queue implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Qos_Queue) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Interface_Qos_Queue struct, which is a YANG list entry.
+func (t *Interface_Qos_Queue) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	return map[string]interface{}{
+		"id": *t.Id,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Qos_Queue) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Qos_Queue"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Qos_Queue) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Qos_Queue_Counters represents the /openconfig-interfaces/interfaces/interface/qos/queues/queue/state/counters YANG schema element.
+type Interface_Qos_Queue_Counters struct {
+	TxBytes   *uint64 `path:"tx-bytes" module:"ovs-qos"`
+	TxErrors  *uint64 `path:"tx-errors" module:"ovs-qos"`
+	TxPackets *uint64 `path:"tx-packets" module:"ovs-qos"`
+}
+
+// IsYANGGoStruct ensures that Interface_Qos_Queue_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Qos_Queue_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Qos_Queue_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Qos_Queue_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Qos_Queue_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_RoutedVlan represents the /openconfig-interfaces/interfaces/interface/routed-vlan YANG schema element.
+type Interface_RoutedVlan struct {
+	Vlan Interface_RoutedVlan_Vlan_Union `path:"config/vlan" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_RoutedVlan) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_RoutedVlan) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_RoutedVlan_Vlan_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-interfaces/interfaces/interface/routed-vlan/config/vlan within the YANG schema.
+type Interface_RoutedVlan_Vlan_Union interface {
+	Is_Interface_RoutedVlan_Vlan_Union()
+}
+
+// Interface_RoutedVlan_Vlan_Union_String is used when /openconfig-interfaces/interfaces/interface/routed-vlan/config/vlan
+// is to be set to a string value.
+type Interface_RoutedVlan_Vlan_Union_String struct {
+	String string
+}
+
+// Is_Interface_RoutedVlan_Vlan_Union ensures that Interface_RoutedVlan_Vlan_Union_String
+// implements the Interface_RoutedVlan_Vlan_Union interface.
+func (*Interface_RoutedVlan_Vlan_Union_String) Is_Interface_RoutedVlan_Vlan_Union() {}
+
+// Interface_RoutedVlan_Vlan_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/routed-vlan/config/vlan
+// is to be set to a uint16 value.
+type Interface_RoutedVlan_Vlan_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_Interface_RoutedVlan_Vlan_Union ensures that Interface_RoutedVlan_Vlan_Union_Uint16
+// implements the Interface_RoutedVlan_Vlan_Union interface.
+func (*Interface_RoutedVlan_Vlan_Union_Uint16) Is_Interface_RoutedVlan_Vlan_Union() {}
+
+// To_Interface_RoutedVlan_Vlan_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Interface_RoutedVlan_Vlan_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Interface_RoutedVlan) To_Interface_RoutedVlan_Vlan_Union(i interface{}) (Interface_RoutedVlan_Vlan_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Interface_RoutedVlan_Vlan_Union_String{v}, nil
+	case uint16:
+		return &Interface_RoutedVlan_Vlan_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Interface_RoutedVlan_Vlan_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Interface_Subinterface represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface YANG schema element.
+type Interface_Subinterface struct {
+	AdminStatus E_OpenconfigInterfaces_Interface_AdminStatus `path:"state/admin-status" module:"openconfig-interfaces"`
+	Counters    *Interface_Subinterface_Counters             `path:"state/counters" module:"openconfig-interfaces"`
+	Description *string                                      `path:"config/description" module:"openconfig-interfaces"`
+	Enabled     *bool                                        `path:"config/enabled" module:"openconfig-interfaces"`
+	Ifindex     *uint32                                      `path:"state/ifindex" module:"openconfig-interfaces"`
+	Index       *uint32                                      `path:"config/index|index" module:"openconfig-interfaces"`
+	LastChange  *uint64                                      `path:"state/last-change" module:"openconfig-interfaces"`
+	Logical     *bool                                        `path:"state/logical" module:"openconfig-interfaces"`
+	Name        *string                                      `path:"state/name" module:"openconfig-interfaces"`
+	OperStatus  E_OpenconfigInterfaces_Interface_OperStatus  `path:"state/oper-status" module:"openconfig-interfaces"`
+	Vlan        *Interface_Subinterface_Vlan                 `path:"vlan" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface struct, which is a YANG list entry.
+func (t *Interface_Subinterface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Index == nil {
+		return nil, fmt.Errorf("nil value for key Index")
+	}
+
+	return map[string]interface{}{
+		"index": *t.Index,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Subinterface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Subinterface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Subinterface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
 
 // Interface_Subinterface_Counters represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/state/counters YANG schema element.
 type Interface_Subinterface_Counters struct {
@@ -916,14 +1801,1199 @@ type Interface_Subinterface_Counters struct {
 	OutUnicastPkts     *uint64 `path:"out-unicast-pkts" module:"openconfig-interfaces"`
 }
 
-// IsYANGGoStruct ensures that Interface_Subinterface_Counters implements the yang.GoStruct
+// IsYANGGoStruct ensures that Interface_Subinterface_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Subinterface_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Subinterface_Counters) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// Interface_Subinterface_Vlan represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan YANG schema element.
+type Interface_Subinterface_Vlan struct {
+	VlanId Interface_Subinterface_Vlan_VlanId_Union `path:"config/vlan-id" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Interface_Subinterface_Vlan) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Interface_Subinterface_Vlan) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Interface_Subinterface_Vlan_VlanId_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/config/vlan-id within the YANG schema.
+type Interface_Subinterface_Vlan_VlanId_Union interface {
+	Is_Interface_Subinterface_Vlan_VlanId_Union()
+}
+
+// Interface_Subinterface_Vlan_VlanId_Union_String is used when /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/config/vlan-id
+// is to be set to a string value.
+type Interface_Subinterface_Vlan_VlanId_Union_String struct {
+	String string
+}
+
+// Is_Interface_Subinterface_Vlan_VlanId_Union ensures that Interface_Subinterface_Vlan_VlanId_Union_String
+// implements the Interface_Subinterface_Vlan_VlanId_Union interface.
+func (*Interface_Subinterface_Vlan_VlanId_Union_String) Is_Interface_Subinterface_Vlan_VlanId_Union() {
+}
+
+// Interface_Subinterface_Vlan_VlanId_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/config/vlan-id
+// is to be set to a uint16 value.
+type Interface_Subinterface_Vlan_VlanId_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_Interface_Subinterface_Vlan_VlanId_Union ensures that Interface_Subinterface_Vlan_VlanId_Union_Uint16
+// implements the Interface_Subinterface_Vlan_VlanId_Union interface.
+func (*Interface_Subinterface_Vlan_VlanId_Union_Uint16) Is_Interface_Subinterface_Vlan_VlanId_Union() {
+}
+
+// To_Interface_Subinterface_Vlan_VlanId_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Interface_Subinterface_Vlan_VlanId_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Interface_Subinterface_Vlan) To_Interface_Subinterface_Vlan_VlanId_Union(i interface{}) (Interface_Subinterface_Vlan_VlanId_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Interface_Subinterface_Vlan_VlanId_Union_String{v}, nil
+	case uint16:
+		return &Interface_Subinterface_Vlan_VlanId_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Interface_Subinterface_Vlan_VlanId_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Lacp represents the /openconfig-lacp/lacp YANG schema element.
+type Lacp struct {
+	Interface      map[string]*Lacp_Interface `path:"interfaces/interface" module:"openconfig-lacp"`
+	SystemPriority *uint16                    `path:"config/system-priority" module:"openconfig-lacp"`
+}
+
+// IsYANGGoStruct ensures that Lacp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lacp) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Lacp struct. The keys of the list are populated from the input
+// arguments.
+func (t *Lacp) NewInterface(Name string) (*Lacp_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Lacp_Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Lacp_Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lacp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lacp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lacp) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lacp_Interface represents the /openconfig-lacp/lacp/interfaces/interface YANG schema element.
+type Lacp_Interface struct {
+	Interval       E_OpenconfigLacp_LacpPeriodType   `path:"config/interval" module:"openconfig-lacp"`
+	LacpMode       E_OpenconfigLacp_LacpActivityType `path:"config/lacp-mode" module:"openconfig-lacp"`
+	Member         map[string]*Lacp_Interface_Member `path:"members/member" module:"openconfig-lacp"`
+	Name           *string                           `path:"config/name|name" module:"openconfig-lacp"`
+	SystemIdMac    *string                           `path:"config/system-id-mac" module:"openconfig-lacp"`
+	SystemPriority *uint16                           `path:"config/system-priority" module:"openconfig-lacp"`
+}
+
+// IsYANGGoStruct ensures that Lacp_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lacp_Interface) IsYANGGoStruct() {}
+
+// NewMember creates a new entry in the Member list of the
+// Lacp_Interface struct. The keys of the list are populated from the input
+// arguments.
+func (t *Lacp_Interface) NewMember(Interface string) (*Lacp_Interface_Member, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Member == nil {
+		t.Member = make(map[string]*Lacp_Interface_Member)
+	}
+
+	key := Interface
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Member[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Member", key)
+	}
+
+	t.Member[key] = &Lacp_Interface_Member{
+		Interface: &Interface,
+	}
+
+	return t.Member[key], nil
+}
+
+// ΛListKeyMap returns the keys of the Lacp_Interface struct, which is a YANG list entry.
+func (t *Lacp_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lacp_Interface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lacp_Interface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lacp_Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lacp_Interface_Member represents the /openconfig-lacp/lacp/interfaces/interface/members/member YANG schema element.
+type Lacp_Interface_Member struct {
+	Activity        E_OpenconfigLacp_LacpActivityType        `path:"state/activity" module:"openconfig-lacp"`
+	Aggregatable    *bool                                    `path:"state/aggregatable" module:"openconfig-lacp"`
+	Collecting      *bool                                    `path:"state/collecting" module:"openconfig-lacp"`
+	Counters        *Lacp_Interface_Member_Counters          `path:"state/counters" module:"openconfig-lacp"`
+	Distributing    *bool                                    `path:"state/distributing" module:"openconfig-lacp"`
+	Interface       *string                                  `path:"state/interface|interface" module:"openconfig-lacp"`
+	OperKey         *uint16                                  `path:"state/oper-key" module:"openconfig-lacp"`
+	PartnerId       *string                                  `path:"state/partner-id" module:"openconfig-lacp"`
+	PartnerKey      *uint16                                  `path:"state/partner-key" module:"openconfig-lacp"`
+	PartnerPortNum  *uint16                                  `path:"state/partner-port-num" module:"openconfig-lacp"`
+	PortNum         *uint16                                  `path:"state/port-num" module:"openconfig-lacp"`
+	Synchronization E_OpenconfigLacp_LacpSynchronizationType `path:"state/synchronization" module:"openconfig-lacp"`
+	SystemId        *string                                  `path:"state/system-id" module:"openconfig-lacp"`
+	Timeout         E_OpenconfigLacp_LacpTimeoutType         `path:"state/timeout" module:"openconfig-lacp"`
+}
+
+// IsYANGGoStruct ensures that Lacp_Interface_Member implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lacp_Interface_Member) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Lacp_Interface_Member struct, which is a YANG list entry.
+func (t *Lacp_Interface_Member) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Interface == nil {
+		return nil, fmt.Errorf("nil value for key Interface")
+	}
+
+	return map[string]interface{}{
+		"interface": *t.Interface,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lacp_Interface_Member) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lacp_Interface_Member"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lacp_Interface_Member) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lacp_Interface_Member_Counters represents the /openconfig-lacp/lacp/interfaces/interface/members/member/state/counters YANG schema element.
+type Lacp_Interface_Member_Counters struct {
+	LacpErrors        *uint64 `path:"lacp-errors" module:"openconfig-lacp"`
+	LacpInPkts        *uint64 `path:"lacp-in-pkts" module:"openconfig-lacp"`
+	LacpOutPkts       *uint64 `path:"lacp-out-pkts" module:"openconfig-lacp"`
+	LacpRxErrors      *uint64 `path:"lacp-rx-errors" module:"openconfig-lacp"`
+	LacpTxErrors      *uint64 `path:"lacp-tx-errors" module:"openconfig-lacp"`
+	LacpUnknownErrors *uint64 `path:"lacp-unknown-errors" module:"openconfig-lacp"`
+}
+
+// IsYANGGoStruct ensures that Lacp_Interface_Member_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lacp_Interface_Member_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lacp_Interface_Member_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lacp_Interface_Member_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lacp_Interface_Member_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lldp represents the /openconfig-lldp/lldp YANG schema element.
+type Lldp struct {
+	ChassisId                *string                          `path:"config/chassis-id" module:"openconfig-lldp"`
+	ChassisIdType            E_OpenconfigLldp_ChassisIdType   `path:"config/chassis-id-type" module:"openconfig-lldp"`
+	Counters                 *Lldp_Counters                   `path:"state/counters" module:"openconfig-lldp"`
+	Enabled                  *bool                            `path:"config/enabled" module:"openconfig-lldp"`
+	HelloTimer               *uint64                          `path:"config/hello-timer" module:"openconfig-lldp"`
+	Interface                map[string]*Lldp_Interface       `path:"interfaces/interface" module:"openconfig-lldp"`
+	SuppressTlvAdvertisement []E_OpenconfigLldpTypes_LLDP_TLV `path:"config/suppress-tlv-advertisement" module:"openconfig-lldp"`
+	SystemDescription        *string                          `path:"config/system-description" module:"openconfig-lldp"`
+	SystemName               *string                          `path:"config/system-name" module:"openconfig-lldp"`
+}
+
+// IsYANGGoStruct ensures that Lldp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lldp) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Lldp struct. The keys of the list are populated from the input
+// arguments.
+func (t *Lldp) NewInterface(Name string) (*Lldp_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Lldp_Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Lldp_Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lldp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lldp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lldp) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lldp_Counters represents the /openconfig-lldp/lldp/state/counters YANG schema element.
+type Lldp_Counters struct {
+	EntriesAgedOut *uint64 `path:"entries-aged-out" module:"openconfig-lldp"`
+	FrameDiscard   *uint64 `path:"frame-discard" module:"openconfig-lldp"`
+	FrameErrorIn   *uint64 `path:"frame-error-in" module:"openconfig-lldp"`
+	FrameIn        *uint64 `path:"frame-in" module:"openconfig-lldp"`
+	FrameOut       *uint64 `path:"frame-out" module:"openconfig-lldp"`
+	LastClear      *string `path:"last-clear" module:"openconfig-lldp"`
+	TlvAccepted    *uint64 `path:"tlv-accepted" module:"openconfig-lldp"`
+	TlvDiscard     *uint64 `path:"tlv-discard" module:"openconfig-lldp"`
+	TlvUnknown     *uint64 `path:"tlv-unknown" module:"openconfig-lldp"`
+}
+
+// IsYANGGoStruct ensures that Lldp_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lldp_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lldp_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lldp_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lldp_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lldp_Interface represents the /openconfig-lldp/lldp/interfaces/interface YANG schema element.
+type Lldp_Interface struct {
+	Counters *Lldp_Interface_Counters            `path:"state/counters" module:"openconfig-lldp"`
+	Enabled  *bool                               `path:"config/enabled" module:"openconfig-lldp"`
+	Name     *string                             `path:"config/name|name" module:"openconfig-lldp"`
+	Neighbor map[string]*Lldp_Interface_Neighbor `path:"neighbors/neighbor" module:"openconfig-lldp"`
+}
+
+// IsYANGGoStruct ensures that Lldp_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lldp_Interface) IsYANGGoStruct() {}
+
+// NewNeighbor creates a new entry in the Neighbor list of the
+// Lldp_Interface struct. The keys of the list are populated from the input
+// arguments.
+func (t *Lldp_Interface) NewNeighbor(Id string) (*Lldp_Interface_Neighbor, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Lldp_Interface_Neighbor)
+	}
+
+	key := Id
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Neighbor[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
+	}
+
+	t.Neighbor[key] = &Lldp_Interface_Neighbor{
+		Id: &Id,
+	}
+
+	return t.Neighbor[key], nil
+}
+
+// ΛListKeyMap returns the keys of the Lldp_Interface struct, which is a YANG list entry.
+func (t *Lldp_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lldp_Interface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lldp_Interface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lldp_Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lldp_Interface_Counters represents the /openconfig-lldp/lldp/interfaces/interface/state/counters YANG schema element.
+type Lldp_Interface_Counters struct {
+	FrameDiscard  *uint64 `path:"frame-discard" module:"openconfig-lldp"`
+	FrameErrorIn  *uint64 `path:"frame-error-in" module:"openconfig-lldp"`
+	FrameErrorOut *uint64 `path:"frame-error-out" module:"openconfig-lldp"`
+	FrameIn       *uint64 `path:"frame-in" module:"openconfig-lldp"`
+	FrameOut      *uint64 `path:"frame-out" module:"openconfig-lldp"`
+	LastClear     *string `path:"last-clear" module:"openconfig-lldp"`
+	TlvDiscard    *uint64 `path:"tlv-discard" module:"openconfig-lldp"`
+	TlvUnknown    *uint64 `path:"tlv-unknown" module:"openconfig-lldp"`
+}
+
+// IsYANGGoStruct ensures that Lldp_Interface_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lldp_Interface_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lldp_Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lldp_Interface_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lldp_Interface_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lldp_Interface_Neighbor represents the /openconfig-lldp/lldp/interfaces/interface/neighbors/neighbor YANG schema element.
+type Lldp_Interface_Neighbor struct {
+	Age                   *uint64                                                                              `path:"state/age" module:"openconfig-lldp"`
+	Capability            map[E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY]*Lldp_Interface_Neighbor_Capability `path:"capabilities/capability" module:"openconfig-lldp"`
+	ChassisId             *string                                                                              `path:"state/chassis-id" module:"openconfig-lldp"`
+	ChassisIdType         E_OpenconfigLldp_ChassisIdType                                                       `path:"state/chassis-id-type" module:"openconfig-lldp"`
+	Id                    *string                                                                              `path:"state/id|id" module:"openconfig-lldp"`
+	LastUpdate            *int64                                                                               `path:"state/last-update" module:"openconfig-lldp"`
+	ManagementAddress     *string                                                                              `path:"state/management-address" module:"openconfig-lldp"`
+	ManagementAddressType *string                                                                              `path:"state/management-address-type" module:"openconfig-lldp"`
+	PortDescription       *string                                                                              `path:"state/port-description" module:"openconfig-lldp"`
+	PortId                *string                                                                              `path:"state/port-id" module:"openconfig-lldp"`
+	PortIdType            E_OpenconfigLldp_PortIdType                                                          `path:"state/port-id-type" module:"openconfig-lldp"`
+	SystemDescription     *string                                                                              `path:"state/system-description" module:"openconfig-lldp"`
+	SystemName            *string                                                                              `path:"state/system-name" module:"openconfig-lldp"`
+	Tlv                   map[Lldp_Interface_Neighbor_Tlv_Key]*Lldp_Interface_Neighbor_Tlv                     `path:"custom-tlvs/tlv" module:"openconfig-lldp"`
+	Ttl                   *uint16                                                                              `path:"state/ttl" module:"openconfig-lldp"`
+}
+
+// IsYANGGoStruct ensures that Lldp_Interface_Neighbor implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lldp_Interface_Neighbor) IsYANGGoStruct() {}
+
+// Lldp_Interface_Neighbor_Tlv_Key represents the key for list Tlv of element /openconfig-lldp/lldp/interfaces/interface/neighbors/neighbor.
+type Lldp_Interface_Neighbor_Tlv_Key struct {
+	Type       int32  `path:"type"`
+	Oui        string `path:"oui"`
+	OuiSubtype string `path:"oui-subtype"`
+}
+
+// NewCapability creates a new entry in the Capability list of the
+// Lldp_Interface_Neighbor struct. The keys of the list are populated from the input
+// arguments.
+func (t *Lldp_Interface_Neighbor) NewCapability(Name E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY) (*Lldp_Interface_Neighbor_Capability, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Capability == nil {
+		t.Capability = make(map[E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY]*Lldp_Interface_Neighbor_Capability)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Capability[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Capability", key)
+	}
+
+	t.Capability[key] = &Lldp_Interface_Neighbor_Capability{
+		Name: Name,
+	}
+
+	return t.Capability[key], nil
+}
+
+// NewTlv creates a new entry in the Tlv list of the
+// Lldp_Interface_Neighbor struct. The keys of the list are populated from the input
+// arguments.
+func (t *Lldp_Interface_Neighbor) NewTlv(Type int32, Oui string, OuiSubtype string) (*Lldp_Interface_Neighbor_Tlv, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Tlv == nil {
+		t.Tlv = make(map[Lldp_Interface_Neighbor_Tlv_Key]*Lldp_Interface_Neighbor_Tlv)
+	}
+
+	key := Lldp_Interface_Neighbor_Tlv_Key{
+		Type:       Type,
+		Oui:        Oui,
+		OuiSubtype: OuiSubtype,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Tlv[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Tlv", key)
+	}
+
+	t.Tlv[key] = &Lldp_Interface_Neighbor_Tlv{
+		Type:       &Type,
+		Oui:        &Oui,
+		OuiSubtype: &OuiSubtype,
+	}
+
+	return t.Tlv[key], nil
+}
+
+// ΛListKeyMap returns the keys of the Lldp_Interface_Neighbor struct, which is a YANG list entry.
+func (t *Lldp_Interface_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	return map[string]interface{}{
+		"id": *t.Id,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lldp_Interface_Neighbor) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lldp_Interface_Neighbor"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lldp_Interface_Neighbor) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Lldp_Interface_Neighbor_Capability represents the /openconfig-lldp/lldp/interfaces/interface/neighbors/neighbor/capabilities/capability YANG schema element.
+type Lldp_Interface_Neighbor_Capability struct {
+	Enabled *bool                                        `path:"state/enabled" module:"openconfig-lldp"`
+	Name    E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY `path:"state/name|name" module:"openconfig-lldp"`
+}
+
+// IsYANGGoStruct ensures that Lldp_Interface_Neighbor_Capability implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lldp_Interface_Neighbor_Capability) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Lldp_Interface_Neighbor_Capability struct, which is a YANG list entry.
+func (t *Lldp_Interface_Neighbor_Capability) ΛListKeyMap() (map[string]interface{}, error) {
+
+	return map[string]interface{}{
+		"name": t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lldp_Interface_Neighbor_Capability) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lldp_Interface_Neighbor_Capability"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lldp_Interface_Neighbor_Capability) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// Lldp_Interface_Neighbor_Tlv represents the /openconfig-lldp/lldp/interfaces/interface/neighbors/neighbor/custom-tlvs/tlv YANG schema element.
+type Lldp_Interface_Neighbor_Tlv struct {
+	Oui        *string `path:"state/oui|oui" module:"openconfig-lldp"`
+	OuiSubtype *string `path:"state/oui-subtype|oui-subtype" module:"openconfig-lldp"`
+	Type       *int32  `path:"state/type|type" module:"openconfig-lldp"`
+	Value      Binary  `path:"state/value" module:"openconfig-lldp"`
+}
+
+// IsYANGGoStruct ensures that Lldp_Interface_Neighbor_Tlv implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Lldp_Interface_Neighbor_Tlv) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Lldp_Interface_Neighbor_Tlv struct, which is a YANG list entry.
+func (t *Lldp_Interface_Neighbor_Tlv) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Oui == nil {
+		return nil, fmt.Errorf("nil value for key Oui")
+	}
+
+	if t.OuiSubtype == nil {
+		return nil, fmt.Errorf("nil value for key OuiSubtype")
+	}
+
+	if t.Type == nil {
+		return nil, fmt.Errorf("nil value for key Type")
+	}
+
+	return map[string]interface{}{
+		"oui":         *t.Oui,
+		"oui-subtype": *t.OuiSubtype,
+		"type":        *t.Type,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Lldp_Interface_Neighbor_Tlv) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Lldp_Interface_Neighbor_Tlv"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Lldp_Interface_Neighbor_Tlv) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Mirror represents the /ovs-mirrors/mirrors/mirror YANG schema element.
+type Mirror struct {
+	Bridge        *string          `path:"config/bridge" module:"ovs-mirrors"`
+	Counters      *Mirror_Counters `path:"state/counters" module:"ovs-mirrors"`
+	Name          *string          `path:"config/name|name" module:"ovs-mirrors"`
+	OutputPort    *string          `path:"config/output-port" module:"ovs-mirrors"`
+	OutputVlan    *uint16          `path:"config/output-vlan" module:"ovs-mirrors"`
+	SelectAll     *bool            `path:"config/select-all" module:"ovs-mirrors"`
+	SelectDstPort []string         `path:"config/select-dst-port" module:"ovs-mirrors"`
+	SelectSrcPort []string         `path:"config/select-src-port" module:"ovs-mirrors"`
+	SelectVlan    []uint16         `path:"config/select-vlan" module:"ovs-mirrors"`
+}
+
+// IsYANGGoStruct ensures that Mirror implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Mirror) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Mirror struct, which is a YANG list entry.
+func (t *Mirror) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Mirror) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Mirror"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Mirror) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Mirror_Counters represents the /ovs-mirrors/mirrors/mirror/state/counters YANG schema element.
+type Mirror_Counters struct {
+	TxBytes   *uint64 `path:"tx-bytes" module:"ovs-mirrors"`
+	TxPackets *uint64 `path:"tx-packets" module:"ovs-mirrors"`
+}
+
+// IsYANGGoStruct ensures that Mirror_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Mirror_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Mirror_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Mirror_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Mirror_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp represents the /openconfig-spanning-tree/stp YANG schema element.
+type Stp struct {
+	Global    *Stp_Global               `path:"global" module:"openconfig-spanning-tree"`
+	Interface map[string]*Stp_Interface `path:"interfaces/interface" module:"openconfig-spanning-tree"`
+	Mstp      *Stp_Mstp                 `path:"mstp" module:"openconfig-spanning-tree"`
+	Rstp      *Stp_Rstp                 `path:"rstp" module:"openconfig-spanning-tree"`
+	Vlan      map[uint16]*Stp_Vlan      `path:"rapid-pvst/vlan" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Stp struct. The keys of the list are populated from the input
+// arguments.
+func (t *Stp) NewInterface(Name string) (*Stp_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Stp_Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Stp_Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// NewVlan creates a new entry in the Vlan list of the
+// Stp struct. The keys of the list are populated from the input
+// arguments.
+func (t *Stp) NewVlan(VlanId uint16) (*Stp_Vlan, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Vlan == nil {
+		t.Vlan = make(map[uint16]*Stp_Vlan)
+	}
+
+	key := VlanId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Vlan[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Vlan", key)
+	}
+
+	t.Vlan[key] = &Stp_Vlan{
+		VlanId: &VlanId,
+	}
+
+	return t.Vlan[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Global represents the /openconfig-spanning-tree/stp/global YANG schema element.
+type Stp_Global struct {
+	BpduFilter                 *bool                                        `path:"config/bpdu-filter" module:"openconfig-spanning-tree"`
+	BpduGuard                  *bool                                        `path:"config/bpdu-guard" module:"openconfig-spanning-tree"`
+	BpduguardTimeoutRecovery   *uint8                                       `path:"config/bpduguard-timeout-recovery" module:"openconfig-spanning-tree"`
+	BridgeAssurance            *bool                                        `path:"config/bridge-assurance" module:"openconfig-spanning-tree"`
+	EnabledProtocol            []E_OpenconfigSpanningTreeTypes_STP_PROTOCOL `path:"config/enabled-protocol" module:"openconfig-spanning-tree"`
+	EtherchannelMisconfigGuard *bool                                        `path:"config/etherchannel-misconfig-guard" module:"openconfig-spanning-tree"`
+	LoopGuard                  *bool                                        `path:"config/loop-guard" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Global implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Global) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Global) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Global"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Global) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Interface represents the /openconfig-spanning-tree/stp/interfaces/interface YANG schema element.
+type Stp_Interface struct {
+	BpduFilter *bool                                       `path:"config/bpdu-filter" module:"openconfig-spanning-tree"`
+	BpduGuard  *bool                                       `path:"config/bpdu-guard" module:"openconfig-spanning-tree"`
+	EdgePort   E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT `path:"config/edge-port" module:"openconfig-spanning-tree"`
+	Guard      E_OpenconfigSpanningTree_StpGuardType       `path:"config/guard" module:"openconfig-spanning-tree"`
+	LinkType   E_OpenconfigSpanningTree_StpLinkType        `path:"config/link-type" module:"openconfig-spanning-tree"`
+	Name       *string                                     `path:"config/name|name" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Interface) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Stp_Interface struct, which is a YANG list entry.
+func (t *Stp_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Interface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Interface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Mstp represents the /openconfig-spanning-tree/stp/mstp YANG schema element.
+type Stp_Mstp struct {
+	ForwardingDelay *uint8                           `path:"config/forwarding-delay" module:"openconfig-spanning-tree"`
+	HelloTime       *uint8                           `path:"config/hello-time" module:"openconfig-spanning-tree"`
+	HoldCount       *uint8                           `path:"config/hold-count" module:"openconfig-spanning-tree"`
+	MaxAge          *uint8                           `path:"config/max-age" module:"openconfig-spanning-tree"`
+	MaxHop          *uint8                           `path:"config/max-hop" module:"openconfig-spanning-tree"`
+	MstInstance     map[uint16]*Stp_Mstp_MstInstance `path:"mst-instances/mst-instance" module:"openconfig-spanning-tree"`
+	Name            *string                          `path:"config/name" module:"openconfig-spanning-tree"`
+	Revision        *uint32                          `path:"config/revision" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Mstp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Mstp) IsYANGGoStruct() {}
+
+// NewMstInstance creates a new entry in the MstInstance list of the
+// Stp_Mstp struct. The keys of the list are populated from the input
+// arguments.
+func (t *Stp_Mstp) NewMstInstance(MstId uint16) (*Stp_Mstp_MstInstance, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.MstInstance == nil {
+		t.MstInstance = make(map[uint16]*Stp_Mstp_MstInstance)
+	}
+
+	key := MstId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.MstInstance[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list MstInstance", key)
+	}
+
+	t.MstInstance[key] = &Stp_Mstp_MstInstance{
+		MstId: &MstId,
+	}
+
+	return t.MstInstance[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Mstp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Mstp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Mstp) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Mstp_MstInstance represents the /openconfig-spanning-tree/stp/mstp/mst-instances/mst-instance YANG schema element.
+type Stp_Mstp_MstInstance struct {
+	BridgeAddress           *string                                    `path:"state/bridge-address" module:"openconfig-spanning-tree"`
+	BridgePriority          *uint32                                    `path:"config/bridge-priority" module:"openconfig-spanning-tree"`
+	DesignatedRootAddress   *string                                    `path:"state/designated-root-address" module:"openconfig-spanning-tree"`
+	DesignatedRootPriority  *uint32                                    `path:"state/designated-root-priority" module:"openconfig-spanning-tree"`
+	HoldTime                *uint8                                     `path:"state/hold-time" module:"openconfig-spanning-tree"`
+	Interface               map[string]*Stp_Mstp_MstInstance_Interface `path:"interfaces/interface" module:"openconfig-spanning-tree"`
+	MstId                   *uint16                                    `path:"config/mst-id|mst-id" module:"openconfig-spanning-tree"`
+	RootCost                *uint32                                    `path:"state/root-cost" module:"openconfig-spanning-tree"`
+	RootPort                *uint16                                    `path:"state/root-port" module:"openconfig-spanning-tree"`
+	TimeSinceTopologyChange *uint64                                    `path:"state/time-since-topology-change" module:"openconfig-spanning-tree"`
+	TopologyChanges         *uint64                                    `path:"state/topology-changes" module:"openconfig-spanning-tree"`
+	Vlan                    []Stp_Mstp_MstInstance_Vlan_Union          `path:"config/vlan" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Mstp_MstInstance implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Mstp_MstInstance) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Stp_Mstp_MstInstance struct. The keys of the list are populated from the input
+// arguments.
+func (t *Stp_Mstp_MstInstance) NewInterface(Name string) (*Stp_Mstp_MstInstance_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Stp_Mstp_MstInstance_Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Stp_Mstp_MstInstance_Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// ΛListKeyMap returns the keys of the Stp_Mstp_MstInstance struct, which is a YANG list entry.
+func (t *Stp_Mstp_MstInstance) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.MstId == nil {
+		return nil, fmt.Errorf("nil value for key MstId")
+	}
+
+	return map[string]interface{}{
+		"mst-id": *t.MstId,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Mstp_MstInstance) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Mstp_MstInstance"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Mstp_MstInstance) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Mstp_MstInstance_Vlan_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-spanning-tree/stp/mstp/mst-instances/mst-instance/config/vlan within the YANG schema.
+type Stp_Mstp_MstInstance_Vlan_Union interface {
+	Is_Stp_Mstp_MstInstance_Vlan_Union()
+}
+
+// Stp_Mstp_MstInstance_Vlan_Union_String is used when /openconfig-spanning-tree/stp/mstp/mst-instances/mst-instance/config/vlan
+// is to be set to a string value.
+type Stp_Mstp_MstInstance_Vlan_Union_String struct {
+	String string
+}
+
+// Is_Stp_Mstp_MstInstance_Vlan_Union ensures that Stp_Mstp_MstInstance_Vlan_Union_String
+// implements the Stp_Mstp_MstInstance_Vlan_Union interface.
+func (*Stp_Mstp_MstInstance_Vlan_Union_String) Is_Stp_Mstp_MstInstance_Vlan_Union() {}
+
+// Stp_Mstp_MstInstance_Vlan_Union_Uint16 is used when /openconfig-spanning-tree/stp/mstp/mst-instances/mst-instance/config/vlan
+// is to be set to a uint16 value.
+type Stp_Mstp_MstInstance_Vlan_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_Stp_Mstp_MstInstance_Vlan_Union ensures that Stp_Mstp_MstInstance_Vlan_Union_Uint16
+// implements the Stp_Mstp_MstInstance_Vlan_Union interface.
+func (*Stp_Mstp_MstInstance_Vlan_Union_Uint16) Is_Stp_Mstp_MstInstance_Vlan_Union() {}
+
+// To_Stp_Mstp_MstInstance_Vlan_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Stp_Mstp_MstInstance_Vlan_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Stp_Mstp_MstInstance) To_Stp_Mstp_MstInstance_Vlan_Union(i interface{}) (Stp_Mstp_MstInstance_Vlan_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Stp_Mstp_MstInstance_Vlan_Union_String{v}, nil
+	case uint16:
+		return &Stp_Mstp_MstInstance_Vlan_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Stp_Mstp_MstInstance_Vlan_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Stp_Mstp_MstInstance_Interface represents the /openconfig-spanning-tree/stp/mstp/mst-instances/mst-instance/interfaces/interface YANG schema element.
+type Stp_Mstp_MstInstance_Interface struct {
+	Cost                     *uint32                                      `path:"config/cost" module:"openconfig-spanning-tree"`
+	Counters                 *Stp_Mstp_MstInstance_Interface_Counters     `path:"state/counters" module:"openconfig-spanning-tree"`
+	DesignatedBridgeAddress  *string                                      `path:"state/designated-bridge-address" module:"openconfig-spanning-tree"`
+	DesignatedBridgePriority *uint32                                      `path:"state/designated-bridge-priority" module:"openconfig-spanning-tree"`
+	DesignatedCost           *uint32                                      `path:"state/designated-cost" module:"openconfig-spanning-tree"`
+	DesignatedPortNum        *uint16                                      `path:"state/designated-port-num" module:"openconfig-spanning-tree"`
+	DesignatedPortPriority   *uint8                                       `path:"state/designated-port-priority" module:"openconfig-spanning-tree"`
+	DesignatedRootAddress    *string                                      `path:"state/designated-root-address" module:"openconfig-spanning-tree"`
+	DesignatedRootPriority   *uint32                                      `path:"state/designated-root-priority" module:"openconfig-spanning-tree"`
+	ForwardTransisitions     *uint64                                      `path:"state/forward-transisitions" module:"openconfig-spanning-tree"`
+	Name                     *string                                      `path:"config/name|name" module:"openconfig-spanning-tree"`
+	PortNum                  *uint16                                      `path:"state/port-num" module:"openconfig-spanning-tree"`
+	PortPriority             *uint8                                       `path:"config/port-priority" module:"openconfig-spanning-tree"`
+	PortState                E_OpenconfigSpanningTreeTypes_STP_PORT_STATE `path:"state/port-state" module:"openconfig-spanning-tree"`
+	Role                     E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE  `path:"state/role" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Mstp_MstInstance_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Mstp_MstInstance_Interface) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Stp_Mstp_MstInstance_Interface struct, which is a YANG list entry.
+func (t *Stp_Mstp_MstInstance_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Mstp_MstInstance_Interface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Mstp_MstInstance_Interface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Mstp_MstInstance_Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Mstp_MstInstance_Interface_Counters represents the /openconfig-spanning-tree/stp/mstp/mst-instances/mst-instance/interfaces/interface/state/counters YANG schema element.
+type Stp_Mstp_MstInstance_Interface_Counters struct {
+	BpduReceived *uint64 `path:"bpdu-received" module:"openconfig-spanning-tree"`
+	BpduSent     *uint64 `path:"bpdu-sent" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Mstp_MstInstance_Interface_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Mstp_MstInstance_Interface_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Mstp_MstInstance_Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Mstp_MstInstance_Interface_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Mstp_MstInstance_Interface_Counters) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// Stp_Rstp represents the /openconfig-spanning-tree/stp/rstp YANG schema element.
+type Stp_Rstp struct {
+	Bridge                  *string                        `path:"state/bridge" module:"ovs-stp"`
+	BridgeAddress           *string                        `path:"state/bridge-address" module:"openconfig-spanning-tree"`
+	BridgePriority          *uint32                        `path:"config/bridge-priority" module:"openconfig-spanning-tree"`
+	DesignatedRootAddress   *string                        `path:"state/designated-root-address" module:"openconfig-spanning-tree"`
+	DesignatedRootPriority  *uint32                        `path:"state/designated-root-priority" module:"openconfig-spanning-tree"`
+	ForwardingDelay         *uint8                         `path:"config/forwarding-delay" module:"openconfig-spanning-tree"`
+	HelloTime               *uint8                         `path:"config/hello-time" module:"openconfig-spanning-tree"`
+	HoldCount               *uint8                         `path:"config/hold-count" module:"openconfig-spanning-tree"`
+	HoldTime                *uint8                         `path:"state/hold-time" module:"openconfig-spanning-tree"`
+	Interface               map[string]*Stp_Rstp_Interface `path:"interfaces/interface" module:"openconfig-spanning-tree"`
+	MaxAge                  *uint8                         `path:"config/max-age" module:"openconfig-spanning-tree"`
+	RootCost                *uint32                        `path:"state/root-cost" module:"openconfig-spanning-tree"`
+	RootPort                *uint16                        `path:"state/root-port" module:"openconfig-spanning-tree"`
+	TimeSinceTopologyChange *uint64                        `path:"state/time-since-topology-change" module:"openconfig-spanning-tree"`
+	TopologyChanges         *uint64                        `path:"state/topology-changes" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Rstp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Rstp) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Stp_Rstp struct. The keys of the list are populated from the input
+// arguments.
+func (t *Stp_Rstp) NewInterface(Name string) (*Stp_Rstp_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Stp_Rstp_Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Stp_Rstp_Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Rstp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Rstp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Rstp) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Rstp_Interface represents the /openconfig-spanning-tree/stp/rstp/interfaces/interface YANG schema element.
+type Stp_Rstp_Interface struct {
+	Cost                     *uint32                                      `path:"config/cost" module:"openconfig-spanning-tree"`
+	Counters                 *Stp_Rstp_Interface_Counters                 `path:"state/counters" module:"openconfig-spanning-tree"`
+	DesignatedBridgeAddress  *string                                      `path:"state/designated-bridge-address" module:"openconfig-spanning-tree"`
+	DesignatedBridgePriority *uint32                                      `path:"state/designated-bridge-priority" module:"openconfig-spanning-tree"`
+	DesignatedCost           *uint32                                      `path:"state/designated-cost" module:"openconfig-spanning-tree"`
+	DesignatedPortNum        *uint16                                      `path:"state/designated-port-num" module:"openconfig-spanning-tree"`
+	DesignatedPortPriority   *uint8                                       `path:"state/designated-port-priority" module:"openconfig-spanning-tree"`
+	DesignatedRootAddress    *string                                      `path:"state/designated-root-address" module:"openconfig-spanning-tree"`
+	DesignatedRootPriority   *uint32                                      `path:"state/designated-root-priority" module:"openconfig-spanning-tree"`
+	ForwardTransisitions     *uint64                                      `path:"state/forward-transisitions" module:"openconfig-spanning-tree"`
+	Name                     *string                                      `path:"config/name|name" module:"openconfig-spanning-tree"`
+	PortNum                  *uint16                                      `path:"state/port-num" module:"openconfig-spanning-tree"`
+	PortPriority             *uint8                                       `path:"config/port-priority" module:"openconfig-spanning-tree"`
+	PortState                E_OpenconfigSpanningTreeTypes_STP_PORT_STATE `path:"state/port-state" module:"openconfig-spanning-tree"`
+	Role                     E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE  `path:"state/role" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Rstp_Interface implements the yang.GoStruct
 // interface. This allows functions that need to handle this struct to
 // identify it as being generated by ygen.
-func (*Interface_Subinterface_Counters) IsYANGGoStruct() {}
+func (*Stp_Rstp_Interface) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Stp_Rstp_Interface struct, which is a YANG list entry.
+func (t *Stp_Rstp_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
 
 // Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Counters"], t, opts...); err != nil {
+func (t *Stp_Rstp_Interface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Rstp_Interface"], t, opts...); err != nil {
 		return err
 	}
 	return nil
@@ -931,10 +3001,175 @@ func (t *Interface_Subinterface_Counters) Validate(opts ...ygot.ValidationOption
 
 // ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
 // that are included in the generated code.
-func (t *Interface_Subinterface_Counters) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
+func (t *Stp_Rstp_Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Rstp_Interface_Counters represents the /openconfig-spanning-tree/stp/rstp/interfaces/interface/state/counters YANG schema element.
+type Stp_Rstp_Interface_Counters struct {
+	BpduReceived *uint64 `path:"bpdu-received" module:"openconfig-spanning-tree"`
+	BpduSent     *uint64 `path:"bpdu-sent" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Rstp_Interface_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Rstp_Interface_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Rstp_Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Rstp_Interface_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Rstp_Interface_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Vlan represents the /openconfig-spanning-tree/stp/rapid-pvst/vlan YANG schema element.
+type Stp_Vlan struct {
+	BridgeAddress           *string                        `path:"state/bridge-address" module:"openconfig-spanning-tree"`
+	BridgePriority          *uint32                        `path:"config/bridge-priority" module:"openconfig-spanning-tree"`
+	DesignatedRootAddress   *string                        `path:"state/designated-root-address" module:"openconfig-spanning-tree"`
+	DesignatedRootPriority  *uint32                        `path:"state/designated-root-priority" module:"openconfig-spanning-tree"`
+	ForwardingDelay         *uint8                         `path:"config/forwarding-delay" module:"openconfig-spanning-tree"`
+	HelloTime               *uint8                         `path:"config/hello-time" module:"openconfig-spanning-tree"`
+	HoldCount               *uint8                         `path:"config/hold-count" module:"openconfig-spanning-tree"`
+	HoldTime                *uint8                         `path:"state/hold-time" module:"openconfig-spanning-tree"`
+	Interface               map[string]*Stp_Vlan_Interface `path:"interfaces/interface" module:"openconfig-spanning-tree"`
+	MaxAge                  *uint8                         `path:"config/max-age" module:"openconfig-spanning-tree"`
+	RootCost                *uint32                        `path:"state/root-cost" module:"openconfig-spanning-tree"`
+	RootPort                *uint16                        `path:"state/root-port" module:"openconfig-spanning-tree"`
+	TimeSinceTopologyChange *uint64                        `path:"state/time-since-topology-change" module:"openconfig-spanning-tree"`
+	TopologyChanges         *uint64                        `path:"state/topology-changes" module:"openconfig-spanning-tree"`
+	VlanId                  *uint16                        `path:"config/vlan-id|vlan-id" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Vlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Vlan) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Stp_Vlan struct. The keys of the list are populated from the input
+// arguments.
+func (t *Stp_Vlan) NewInterface(Name string) (*Stp_Vlan_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Stp_Vlan_Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Stp_Vlan_Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// ΛListKeyMap returns the keys of the Stp_Vlan struct, which is a YANG list entry.
+func (t *Stp_Vlan) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.VlanId == nil {
+		return nil, fmt.Errorf("nil value for key VlanId")
+	}
+
+	return map[string]interface{}{
+		"vlan-id": *t.VlanId,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Vlan) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Vlan"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Vlan) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Vlan_Interface represents the /openconfig-spanning-tree/stp/rapid-pvst/vlan/interfaces/interface YANG schema element.
+type Stp_Vlan_Interface struct {
+	Cost                     *uint32                                      `path:"config/cost" module:"openconfig-spanning-tree"`
+	Counters                 *Stp_Vlan_Interface_Counters                 `path:"state/counters" module:"openconfig-spanning-tree"`
+	DesignatedBridgeAddress  *string                                      `path:"state/designated-bridge-address" module:"openconfig-spanning-tree"`
+	DesignatedBridgePriority *uint32                                      `path:"state/designated-bridge-priority" module:"openconfig-spanning-tree"`
+	DesignatedCost           *uint32                                      `path:"state/designated-cost" module:"openconfig-spanning-tree"`
+	DesignatedPortNum        *uint16                                      `path:"state/designated-port-num" module:"openconfig-spanning-tree"`
+	DesignatedPortPriority   *uint8                                       `path:"state/designated-port-priority" module:"openconfig-spanning-tree"`
+	DesignatedRootAddress    *string                                      `path:"state/designated-root-address" module:"openconfig-spanning-tree"`
+	DesignatedRootPriority   *uint32                                      `path:"state/designated-root-priority" module:"openconfig-spanning-tree"`
+	ForwardTransisitions     *uint64                                      `path:"state/forward-transisitions" module:"openconfig-spanning-tree"`
+	Name                     *string                                      `path:"config/name|name" module:"openconfig-spanning-tree"`
+	PortNum                  *uint16                                      `path:"state/port-num" module:"openconfig-spanning-tree"`
+	PortPriority             *uint8                                       `path:"config/port-priority" module:"openconfig-spanning-tree"`
+	PortState                E_OpenconfigSpanningTreeTypes_STP_PORT_STATE `path:"state/port-state" module:"openconfig-spanning-tree"`
+	Role                     E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE  `path:"state/role" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Vlan_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Vlan_Interface) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Stp_Vlan_Interface struct, which is a YANG list entry.
+func (t *Stp_Vlan_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Vlan_Interface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Vlan_Interface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Vlan_Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// Stp_Vlan_Interface_Counters represents the /openconfig-spanning-tree/stp/rapid-pvst/vlan/interfaces/interface/state/counters YANG schema element.
+type Stp_Vlan_Interface_Counters struct {
+	BpduReceived *uint64 `path:"bpdu-received" module:"openconfig-spanning-tree"`
+	BpduSent     *uint64 `path:"bpdu-sent" module:"openconfig-spanning-tree"`
+}
+
+// IsYANGGoStruct ensures that Stp_Vlan_Interface_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Stp_Vlan_Interface_Counters) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Stp_Vlan_Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Stp_Vlan_Interface_Counters"], t, opts...); err != nil {
+		return err
+	}
+	return nil
 }
 
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Stp_Vlan_Interface_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
 // System represents the /openconfig-system/system YANG schema element.
 type System struct {
 	Aaa             *System_Aaa                            `path:"aaa" module:"openconfig-system"`
@@ -953,6 +3188,7 @@ type System struct {
 	MotdBanner      *string                                `path:"config/motd-banner" module:"openconfig-system"`
 	Ntp             *System_Ntp                            `path:"ntp" module:"openconfig-system"`
 	Openflow        *System_Openflow                       `path:"openflow" module:"openconfig-openflow"`
+	Ovsdb           *System_Ovsdb                          `path:"ovsdb" module:"ovs-system"`
 	Process         map[uint64]*System_Process             `path:"processes/process" module:"openconfig-system"`
 	SshServer       *System_SshServer                      `path:"ssh-server" module:"openconfig-system"`
 	TelnetServer    *System_TelnetServer                   `path:"telnet-server" module:"openconfig-system"`
@@ -2793,6 +5029,7 @@ func (t *System_Ntp_Server) ΛEnumTypeMap() map[string][]reflect.Type { return 
 // System_Openflow represents the /openconfig-system/system/openflow YANG schema element.
 type System_Openflow struct {
 	Agent      *System_Openflow_Agent                 `path:"agent" module:"openconfig-openflow"`
+	Bridge     map[string]*System_Openflow_Bridge     `path:"bridges/bridge" module:"ovs-system"`
 	Controller map[string]*System_Openflow_Controller `path:"controllers/controller" module:"openconfig-openflow"`
 }
 
@@ -2801,6 +5038,33 @@ type System_Openflow struct {
 // identify it as being generated by ygen.
 func (*System_Openflow) IsYANGGoStruct() {}
 
+// NewBridge creates a new entry in the Bridge list of the
+// System_Openflow struct. The keys of the list are populated from the input
+// arguments.
+func (t *System_Openflow) NewBridge(Name string) (*System_Openflow_Bridge, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Bridge == nil {
+		t.Bridge = make(map[string]*System_Openflow_Bridge)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Bridge[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Bridge", key)
+	}
+
+	t.Bridge[key] = &System_Openflow_Bridge{
+		Name: &Name,
+	}
+
+	return t.Bridge[key], nil
+}
+
 // NewController creates a new entry in the Controller list of the
 // System_Openflow struct. The keys of the list are populated from the input
 // arguments.
@@ -2866,6 +5130,40 @@ func (t *System_Openflow_Agent) Validate(opts ...ygot.ValidationOption) error {
 // that are included in the generated code.
 func (t *System_Openflow_Agent) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
 
+// System_Openflow_Bridge represents the /openconfig-system/system/openflow/bridges/bridge YANG schema element.
+type System_Openflow_Bridge struct {
+	Name      *string  `path:"state/name|name" module:"ovs-system"`
+	Protocols []string `path:"state/protocols" module:"ovs-system"`
+}
+
+// IsYANGGoStruct ensures that System_Openflow_Bridge implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*System_Openflow_Bridge) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the System_Openflow_Bridge struct, which is a YANG list entry.
+func (t *System_Openflow_Bridge) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *System_Openflow_Bridge) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["System_Openflow_Bridge"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *System_Openflow_Bridge) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
 // System_Openflow_Controller represents the /openconfig-system/system/openflow/controllers/controller YANG schema element.
 type System_Openflow_Controller struct {
 	Connection map[uint8]*System_Openflow_Controller_Connection `path:"connections/connection" module:"openconfig-openflow"`
@@ -2929,14 +5227,15 @@ func (t *System_Openflow_Controller) ΛEnumTypeMap() map[string][]reflect.Type {
 
 // System_Openflow_Controller_Connection represents the /openconfig-system/system/openflow/controllers/controller/connections/connection YANG schema element.
 type System_Openflow_Controller_Connection struct {
-	Address         *string                        `path:"config/address" module:"openconfig-openflow"`
-	AuxId           *uint8                         `path:"config/aux-id|aux-id" module:"openconfig-openflow"`
-	CertificateId   *string                        `path:"config/certificate-id" module:"openconfig-openflow"`
-	Connected       *bool                          `path:"state/connected" module:"openconfig-openflow"`
-	Port            *uint16                        `path:"config/port" module:"openconfig-openflow"`
-	Priority        *uint8                         `path:"config/priority" module:"openconfig-openflow"`
-	SourceInterface *string                        `path:"config/source-interface" module:"openconfig-openflow"`
-	Transport       E_OpenconfigOpenflow_Transport `path:"config/transport" module:"openconfig-openflow"`
+	Address         *string                                    `path:"config/address" module:"openconfig-openflow"`
+	AuxId           *uint8                                     `path:"config/aux-id|aux-id" module:"openconfig-openflow"`
+	CertificateId   *string                                    `path:"config/certificate-id" module:"openconfig-openflow"`
+	Connected       *bool                                      `path:"state/connected" module:"openconfig-openflow"`
+	Ovs             *System_Openflow_Controller_Connection_Ovs `path:"ovs" module:"ovs-system"`
+	Port            *uint16                                    `path:"config/port" module:"openconfig-openflow"`
+	Priority        *uint8                                     `path:"config/priority" module:"openconfig-openflow"`
+	SourceInterface *string                                    `path:"config/source-interface" module:"openconfig-openflow"`
+	Transport       E_OpenconfigOpenflow_Transport             `path:"config/transport" module:"openconfig-openflow"`
 }
 
 // IsYANGGoStruct ensures that System_Openflow_Controller_Connection implements the yang.GoStruct
@@ -2969,6 +5268,62 @@ func (t *System_Openflow_Controller_Connection) ΛEnumTypeMap() map[string][]ref
 	return ΛEnumTypes
 }
 
+// System_Openflow_Controller_Connection_Ovs represents the /openconfig-system/system/openflow/controllers/controller/connections/connection/ovs YANG schema element.
+type System_Openflow_Controller_Connection_Ovs struct {
+	LastError          *string               `path:"state/last-error" module:"ovs-system"`
+	Passive            *bool                 `path:"config/passive" module:"ovs-system"`
+	Role               E_OvsSystem_Ovs_Role  `path:"state/role" module:"ovs-system"`
+	SecSinceConnect    *uint64               `path:"state/sec-since-connect" module:"ovs-system"`
+	SecSinceDisconnect *uint64               `path:"state/sec-since-disconnect" module:"ovs-system"`
+	State              E_OvsSystem_Ovs_State `path:"state/state" module:"ovs-system"`
+	Target             *string               `path:"state/target" module:"ovs-system"`
+	UnixSocket         *string               `path:"config/unix-socket" module:"ovs-system"`
+}
+
+// IsYANGGoStruct ensures that System_Openflow_Controller_Connection_Ovs implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*System_Openflow_Controller_Connection_Ovs) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *System_Openflow_Controller_Connection_Ovs) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["System_Openflow_Controller_Connection_Ovs"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *System_Openflow_Controller_Connection_Ovs) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// System_Ovsdb represents the /openconfig-system/system/ovsdb YANG schema element.
+type System_Ovsdb struct {
+	Address           *string `path:"state/address" module:"ovs-system"`
+	Connected         *bool   `path:"state/connected" module:"ovs-system"`
+	LastError         *string `path:"state/last-error" module:"ovs-system"`
+	ReconnectAttempts *uint32 `path:"state/reconnect-attempts" module:"ovs-system"`
+}
+
+// IsYANGGoStruct ensures that System_Ovsdb implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*System_Ovsdb) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *System_Ovsdb) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["System_Ovsdb"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *System_Ovsdb) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
 // System_Process represents the /openconfig-system/system/processes/process YANG schema element.
 type System_Process struct {
 	Args              []string `path:"state/args" module:"openconfig-system"`
@@ -3080,6 +5435,552 @@ func (E_IETFInterfaces_InterfaceType) ΛMap() map[string]map[int64]ygot.EnumDefi
 const (
 	// IETFInterfaces_InterfaceType_UNSET corresponds to the value UNSET of IETFInterfaces_InterfaceType
 	IETFInterfaces_InterfaceType_UNSET E_IETFInterfaces_InterfaceType = 0
+	// IETFInterfaces_InterfaceType_a12MppSwitch corresponds to the value a12MppSwitch of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_a12MppSwitch E_IETFInterfaces_InterfaceType = 1
+	// IETFInterfaces_InterfaceType_aal2 corresponds to the value aal2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aal2 E_IETFInterfaces_InterfaceType = 2
+	// IETFInterfaces_InterfaceType_aal5 corresponds to the value aal5 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aal5 E_IETFInterfaces_InterfaceType = 3
+	// IETFInterfaces_InterfaceType_actelisMetaLOOP corresponds to the value actelisMetaLOOP of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_actelisMetaLOOP E_IETFInterfaces_InterfaceType = 4
+	// IETFInterfaces_InterfaceType_adsl corresponds to the value adsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_adsl E_IETFInterfaces_InterfaceType = 5
+	// IETFInterfaces_InterfaceType_adsl2 corresponds to the value adsl2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_adsl2 E_IETFInterfaces_InterfaceType = 6
+	// IETFInterfaces_InterfaceType_adsl2plus corresponds to the value adsl2plus of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_adsl2plus E_IETFInterfaces_InterfaceType = 7
+	// IETFInterfaces_InterfaceType_aflane8023 corresponds to the value aflane8023 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aflane8023 E_IETFInterfaces_InterfaceType = 8
+	// IETFInterfaces_InterfaceType_aflane8025 corresponds to the value aflane8025 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aflane8025 E_IETFInterfaces_InterfaceType = 9
+	// IETFInterfaces_InterfaceType_aluELP corresponds to the value aluELP of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluELP E_IETFInterfaces_InterfaceType = 10
+	// IETFInterfaces_InterfaceType_aluEpon corresponds to the value aluEpon of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluEpon E_IETFInterfaces_InterfaceType = 11
+	// IETFInterfaces_InterfaceType_aluEponLogicalLink corresponds to the value aluEponLogicalLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluEponLogicalLink E_IETFInterfaces_InterfaceType = 12
+	// IETFInterfaces_InterfaceType_aluEponOnu corresponds to the value aluEponOnu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluEponOnu E_IETFInterfaces_InterfaceType = 13
+	// IETFInterfaces_InterfaceType_aluEponPhysicalUni corresponds to the value aluEponPhysicalUni of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluEponPhysicalUni E_IETFInterfaces_InterfaceType = 14
+	// IETFInterfaces_InterfaceType_aluGponOnu corresponds to the value aluGponOnu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluGponOnu E_IETFInterfaces_InterfaceType = 15
+	// IETFInterfaces_InterfaceType_aluGponPhysicalUni corresponds to the value aluGponPhysicalUni of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluGponPhysicalUni E_IETFInterfaces_InterfaceType = 16
+	// IETFInterfaces_InterfaceType_arap corresponds to the value arap of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_arap E_IETFInterfaces_InterfaceType = 17
+	// IETFInterfaces_InterfaceType_arcnet corresponds to the value arcnet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_arcnet E_IETFInterfaces_InterfaceType = 18
+	// IETFInterfaces_InterfaceType_arcnetPlus corresponds to the value arcnetPlus of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_arcnetPlus E_IETFInterfaces_InterfaceType = 19
+	// IETFInterfaces_InterfaceType_async corresponds to the value async of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_async E_IETFInterfaces_InterfaceType = 20
+	// IETFInterfaces_InterfaceType_atm corresponds to the value atm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atm E_IETFInterfaces_InterfaceType = 21
+	// IETFInterfaces_InterfaceType_atmDxi corresponds to the value atmDxi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmDxi E_IETFInterfaces_InterfaceType = 22
+	// IETFInterfaces_InterfaceType_atmFuni corresponds to the value atmFuni of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmFuni E_IETFInterfaces_InterfaceType = 23
+	// IETFInterfaces_InterfaceType_atmIma corresponds to the value atmIma of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmIma E_IETFInterfaces_InterfaceType = 24
+	// IETFInterfaces_InterfaceType_atmLogical corresponds to the value atmLogical of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmLogical E_IETFInterfaces_InterfaceType = 25
+	// IETFInterfaces_InterfaceType_atmRadio corresponds to the value atmRadio of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmRadio E_IETFInterfaces_InterfaceType = 26
+	// IETFInterfaces_InterfaceType_atmSubInterface corresponds to the value atmSubInterface of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmSubInterface E_IETFInterfaces_InterfaceType = 27
+	// IETFInterfaces_InterfaceType_atmVciEndPt corresponds to the value atmVciEndPt of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmVciEndPt E_IETFInterfaces_InterfaceType = 28
+	// IETFInterfaces_InterfaceType_atmVirtual corresponds to the value atmVirtual of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmVirtual E_IETFInterfaces_InterfaceType = 29
+	// IETFInterfaces_InterfaceType_atmbond corresponds to the value atmbond of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmbond E_IETFInterfaces_InterfaceType = 30
+	// IETFInterfaces_InterfaceType_aviciOpticalEther corresponds to the value aviciOpticalEther of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aviciOpticalEther E_IETFInterfaces_InterfaceType = 31
+	// IETFInterfaces_InterfaceType_basicISDN corresponds to the value basicISDN of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_basicISDN E_IETFInterfaces_InterfaceType = 32
+	// IETFInterfaces_InterfaceType_bgppolicyaccounting corresponds to the value bgppolicyaccounting of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_bgppolicyaccounting E_IETFInterfaces_InterfaceType = 33
+	// IETFInterfaces_InterfaceType_bits corresponds to the value bits of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_bits E_IETFInterfaces_InterfaceType = 34
+	// IETFInterfaces_InterfaceType_bridge corresponds to the value bridge of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_bridge E_IETFInterfaces_InterfaceType = 35
+	// IETFInterfaces_InterfaceType_bsc corresponds to the value bsc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_bsc E_IETFInterfaces_InterfaceType = 36
+	// IETFInterfaces_InterfaceType_cableDownstreamRfPort corresponds to the value cableDownstreamRfPort of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_cableDownstreamRfPort E_IETFInterfaces_InterfaceType = 37
+	// IETFInterfaces_InterfaceType_capwapDot11Bss corresponds to the value capwapDot11Bss of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_capwapDot11Bss E_IETFInterfaces_InterfaceType = 38
+	// IETFInterfaces_InterfaceType_capwapDot11Profile corresponds to the value capwapDot11Profile of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_capwapDot11Profile E_IETFInterfaces_InterfaceType = 39
+	// IETFInterfaces_InterfaceType_capwapWtpVirtualRadio corresponds to the value capwapWtpVirtualRadio of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_capwapWtpVirtualRadio E_IETFInterfaces_InterfaceType = 40
+	// IETFInterfaces_InterfaceType_cblVectaStar corresponds to the value cblVectaStar of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_cblVectaStar E_IETFInterfaces_InterfaceType = 41
+	// IETFInterfaces_InterfaceType_cctEmul corresponds to the value cctEmul of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_cctEmul E_IETFInterfaces_InterfaceType = 42
+	// IETFInterfaces_InterfaceType_ces corresponds to the value ces of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ces E_IETFInterfaces_InterfaceType = 43
+	// IETFInterfaces_InterfaceType_channel corresponds to the value channel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_channel E_IETFInterfaces_InterfaceType = 44
+	// IETFInterfaces_InterfaceType_ciscoISLvlan corresponds to the value ciscoISLvlan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ciscoISLvlan E_IETFInterfaces_InterfaceType = 45
+	// IETFInterfaces_InterfaceType_cnr corresponds to the value cnr of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_cnr E_IETFInterfaces_InterfaceType = 46
+	// IETFInterfaces_InterfaceType_coffee corresponds to the value coffee of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_coffee E_IETFInterfaces_InterfaceType = 47
+	// IETFInterfaces_InterfaceType_compositeLink corresponds to the value compositeLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_compositeLink E_IETFInterfaces_InterfaceType = 48
+	// IETFInterfaces_InterfaceType_dcn corresponds to the value dcn of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dcn E_IETFInterfaces_InterfaceType = 49
+	// IETFInterfaces_InterfaceType_ddnX25 corresponds to the value ddnX25 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ddnX25 E_IETFInterfaces_InterfaceType = 50
+	// IETFInterfaces_InterfaceType_digitalPowerline corresponds to the value digitalPowerline of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_digitalPowerline E_IETFInterfaces_InterfaceType = 51
+	// IETFInterfaces_InterfaceType_digitalWrapperOverheadChannel corresponds to the value digitalWrapperOverheadChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_digitalWrapperOverheadChannel E_IETFInterfaces_InterfaceType = 52
+	// IETFInterfaces_InterfaceType_dlsw corresponds to the value dlsw of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dlsw E_IETFInterfaces_InterfaceType = 53
+	// IETFInterfaces_InterfaceType_docsCableDownstream corresponds to the value docsCableDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableDownstream E_IETFInterfaces_InterfaceType = 54
+	// IETFInterfaces_InterfaceType_docsCableMCmtsDownstream corresponds to the value docsCableMCmtsDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableMCmtsDownstream E_IETFInterfaces_InterfaceType = 55
+	// IETFInterfaces_InterfaceType_docsCableMaclayer corresponds to the value docsCableMaclayer of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableMaclayer E_IETFInterfaces_InterfaceType = 56
+	// IETFInterfaces_InterfaceType_docsCableUpstream corresponds to the value docsCableUpstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableUpstream E_IETFInterfaces_InterfaceType = 57
+	// IETFInterfaces_InterfaceType_docsCableUpstreamChannel corresponds to the value docsCableUpstreamChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableUpstreamChannel E_IETFInterfaces_InterfaceType = 58
+	// IETFInterfaces_InterfaceType_docsCableUpstreamRfPort corresponds to the value docsCableUpstreamRfPort of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableUpstreamRfPort E_IETFInterfaces_InterfaceType = 59
+	// IETFInterfaces_InterfaceType_ds0 corresponds to the value ds0 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds0 E_IETFInterfaces_InterfaceType = 60
+	// IETFInterfaces_InterfaceType_ds0Bundle corresponds to the value ds0Bundle of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds0Bundle E_IETFInterfaces_InterfaceType = 61
+	// IETFInterfaces_InterfaceType_ds1 corresponds to the value ds1 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds1 E_IETFInterfaces_InterfaceType = 62
+	// IETFInterfaces_InterfaceType_ds1FDL corresponds to the value ds1FDL of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds1FDL E_IETFInterfaces_InterfaceType = 63
+	// IETFInterfaces_InterfaceType_ds3 corresponds to the value ds3 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds3 E_IETFInterfaces_InterfaceType = 64
+	// IETFInterfaces_InterfaceType_dtm corresponds to the value dtm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dtm E_IETFInterfaces_InterfaceType = 65
+	// IETFInterfaces_InterfaceType_dvbAsiIn corresponds to the value dvbAsiIn of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbAsiIn E_IETFInterfaces_InterfaceType = 66
+	// IETFInterfaces_InterfaceType_dvbAsiOut corresponds to the value dvbAsiOut of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbAsiOut E_IETFInterfaces_InterfaceType = 67
+	// IETFInterfaces_InterfaceType_dvbRccDownstream corresponds to the value dvbRccDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRccDownstream E_IETFInterfaces_InterfaceType = 68
+	// IETFInterfaces_InterfaceType_dvbRccMacLayer corresponds to the value dvbRccMacLayer of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRccMacLayer E_IETFInterfaces_InterfaceType = 69
+	// IETFInterfaces_InterfaceType_dvbRccUpstream corresponds to the value dvbRccUpstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRccUpstream E_IETFInterfaces_InterfaceType = 70
+	// IETFInterfaces_InterfaceType_dvbRcsMacLayer corresponds to the value dvbRcsMacLayer of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRcsMacLayer E_IETFInterfaces_InterfaceType = 71
+	// IETFInterfaces_InterfaceType_dvbRcsTdma corresponds to the value dvbRcsTdma of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRcsTdma E_IETFInterfaces_InterfaceType = 72
+	// IETFInterfaces_InterfaceType_dvbTdm corresponds to the value dvbTdm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbTdm E_IETFInterfaces_InterfaceType = 73
+	// IETFInterfaces_InterfaceType_e1 corresponds to the value e1 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_e1 E_IETFInterfaces_InterfaceType = 74
+	// IETFInterfaces_InterfaceType_econet corresponds to the value econet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_econet E_IETFInterfaces_InterfaceType = 75
+	// IETFInterfaces_InterfaceType_eon corresponds to the value eon of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_eon E_IETFInterfaces_InterfaceType = 76
+	// IETFInterfaces_InterfaceType_eplrs corresponds to the value eplrs of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_eplrs E_IETFInterfaces_InterfaceType = 77
+	// IETFInterfaces_InterfaceType_escon corresponds to the value escon of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_escon E_IETFInterfaces_InterfaceType = 78
+	// IETFInterfaces_InterfaceType_ethernet3Mbit corresponds to the value ethernet3Mbit of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ethernet3Mbit E_IETFInterfaces_InterfaceType = 79
+	// IETFInterfaces_InterfaceType_ethernetCsmacd corresponds to the value ethernetCsmacd of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ethernetCsmacd E_IETFInterfaces_InterfaceType = 80
+	// IETFInterfaces_InterfaceType_fast corresponds to the value fast of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fast E_IETFInterfaces_InterfaceType = 81
+	// IETFInterfaces_InterfaceType_fastEther corresponds to the value fastEther of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fastEther E_IETFInterfaces_InterfaceType = 82
+	// IETFInterfaces_InterfaceType_fastEtherFX corresponds to the value fastEtherFX of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fastEtherFX E_IETFInterfaces_InterfaceType = 83
+	// IETFInterfaces_InterfaceType_fcipLink corresponds to the value fcipLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fcipLink E_IETFInterfaces_InterfaceType = 84
+	// IETFInterfaces_InterfaceType_fddi corresponds to the value fddi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fddi E_IETFInterfaces_InterfaceType = 85
+	// IETFInterfaces_InterfaceType_fibreChannel corresponds to the value fibreChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fibreChannel E_IETFInterfaces_InterfaceType = 86
+	// IETFInterfaces_InterfaceType_frDlciEndPt corresponds to the value frDlciEndPt of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frDlciEndPt E_IETFInterfaces_InterfaceType = 87
+	// IETFInterfaces_InterfaceType_frForward corresponds to the value frForward of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frForward E_IETFInterfaces_InterfaceType = 88
+	// IETFInterfaces_InterfaceType_frameRelay corresponds to the value frameRelay of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frameRelay E_IETFInterfaces_InterfaceType = 89
+	// IETFInterfaces_InterfaceType_frameRelayInterconnect corresponds to the value frameRelayInterconnect of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frameRelayInterconnect E_IETFInterfaces_InterfaceType = 90
+	// IETFInterfaces_InterfaceType_frameRelayMPI corresponds to the value frameRelayMPI of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frameRelayMPI E_IETFInterfaces_InterfaceType = 91
+	// IETFInterfaces_InterfaceType_frameRelayService corresponds to the value frameRelayService of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frameRelayService E_IETFInterfaces_InterfaceType = 92
+	// IETFInterfaces_InterfaceType_frf16MfrBundle corresponds to the value frf16MfrBundle of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frf16MfrBundle E_IETFInterfaces_InterfaceType = 93
+	// IETFInterfaces_InterfaceType_g703at2mb corresponds to the value g703at2mb of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g703at2mb E_IETFInterfaces_InterfaceType = 94
+	// IETFInterfaces_InterfaceType_g703at64k corresponds to the value g703at64k of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g703at64k E_IETFInterfaces_InterfaceType = 95
+	// IETFInterfaces_InterfaceType_g9981 corresponds to the value g9981 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g9981 E_IETFInterfaces_InterfaceType = 96
+	// IETFInterfaces_InterfaceType_g9982 corresponds to the value g9982 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g9982 E_IETFInterfaces_InterfaceType = 97
+	// IETFInterfaces_InterfaceType_g9983 corresponds to the value g9983 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g9983 E_IETFInterfaces_InterfaceType = 98
+	// IETFInterfaces_InterfaceType_gfp corresponds to the value gfp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gfp E_IETFInterfaces_InterfaceType = 99
+	// IETFInterfaces_InterfaceType_gigabitEthernet corresponds to the value gigabitEthernet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gigabitEthernet E_IETFInterfaces_InterfaceType = 100
+	// IETFInterfaces_InterfaceType_gpon corresponds to the value gpon of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gpon E_IETFInterfaces_InterfaceType = 101
+	// IETFInterfaces_InterfaceType_gr303IDT corresponds to the value gr303IDT of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gr303IDT E_IETFInterfaces_InterfaceType = 102
+	// IETFInterfaces_InterfaceType_gr303RDT corresponds to the value gr303RDT of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gr303RDT E_IETFInterfaces_InterfaceType = 103
+	// IETFInterfaces_InterfaceType_gtp corresponds to the value gtp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gtp E_IETFInterfaces_InterfaceType = 104
+	// IETFInterfaces_InterfaceType_h323Gatekeeper corresponds to the value h323Gatekeeper of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_h323Gatekeeper E_IETFInterfaces_InterfaceType = 105
+	// IETFInterfaces_InterfaceType_h323Proxy corresponds to the value h323Proxy of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_h323Proxy E_IETFInterfaces_InterfaceType = 106
+	// IETFInterfaces_InterfaceType_hdh1822 corresponds to the value hdh1822 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hdh1822 E_IETFInterfaces_InterfaceType = 107
+	// IETFInterfaces_InterfaceType_hdlc corresponds to the value hdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hdlc E_IETFInterfaces_InterfaceType = 108
+	// IETFInterfaces_InterfaceType_hdsl2 corresponds to the value hdsl2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hdsl2 E_IETFInterfaces_InterfaceType = 109
+	// IETFInterfaces_InterfaceType_hiperlan2 corresponds to the value hiperlan2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hiperlan2 E_IETFInterfaces_InterfaceType = 110
+	// IETFInterfaces_InterfaceType_hippi corresponds to the value hippi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hippi E_IETFInterfaces_InterfaceType = 111
+	// IETFInterfaces_InterfaceType_hippiInterface corresponds to the value hippiInterface of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hippiInterface E_IETFInterfaces_InterfaceType = 112
+	// IETFInterfaces_InterfaceType_homepna corresponds to the value homepna of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_homepna E_IETFInterfaces_InterfaceType = 113
+	// IETFInterfaces_InterfaceType_hostPad corresponds to the value hostPad of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hostPad E_IETFInterfaces_InterfaceType = 114
+	// IETFInterfaces_InterfaceType_hssi corresponds to the value hssi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hssi E_IETFInterfaces_InterfaceType = 115
+	// IETFInterfaces_InterfaceType_hyperchannel corresponds to the value hyperchannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hyperchannel E_IETFInterfaces_InterfaceType = 116
+	// IETFInterfaces_InterfaceType_iana_interface_type corresponds to the value iana_interface_type of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iana_interface_type E_IETFInterfaces_InterfaceType = 117
+	// IETFInterfaces_InterfaceType_ibm370parChan corresponds to the value ibm370parChan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ibm370parChan E_IETFInterfaces_InterfaceType = 118
+	// IETFInterfaces_InterfaceType_idsl corresponds to the value idsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_idsl E_IETFInterfaces_InterfaceType = 119
+	// IETFInterfaces_InterfaceType_ieee1394 corresponds to the value ieee1394 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee1394 E_IETFInterfaces_InterfaceType = 120
+	// IETFInterfaces_InterfaceType_ieee80211 corresponds to the value ieee80211 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee80211 E_IETFInterfaces_InterfaceType = 121
+	// IETFInterfaces_InterfaceType_ieee80212 corresponds to the value ieee80212 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee80212 E_IETFInterfaces_InterfaceType = 122
+	// IETFInterfaces_InterfaceType_ieee802154 corresponds to the value ieee802154 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee802154 E_IETFInterfaces_InterfaceType = 123
+	// IETFInterfaces_InterfaceType_ieee80216WMAN corresponds to the value ieee80216WMAN of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee80216WMAN E_IETFInterfaces_InterfaceType = 124
+	// IETFInterfaces_InterfaceType_ieee8023adLag corresponds to the value ieee8023adLag of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee8023adLag E_IETFInterfaces_InterfaceType = 125
+	// IETFInterfaces_InterfaceType_if_gsn corresponds to the value if_gsn of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_if_gsn E_IETFInterfaces_InterfaceType = 126
+	// IETFInterfaces_InterfaceType_ifPwType corresponds to the value ifPwType of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ifPwType E_IETFInterfaces_InterfaceType = 127
+	// IETFInterfaces_InterfaceType_ifVfiType corresponds to the value ifVfiType of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ifVfiType E_IETFInterfaces_InterfaceType = 128
+	// IETFInterfaces_InterfaceType_ilan corresponds to the value ilan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ilan E_IETFInterfaces_InterfaceType = 129
+	// IETFInterfaces_InterfaceType_imt corresponds to the value imt of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_imt E_IETFInterfaces_InterfaceType = 130
+	// IETFInterfaces_InterfaceType_infiniband corresponds to the value infiniband of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_infiniband E_IETFInterfaces_InterfaceType = 131
+	// IETFInterfaces_InterfaceType_interleave corresponds to the value interleave of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_interleave E_IETFInterfaces_InterfaceType = 132
+	// IETFInterfaces_InterfaceType_ip corresponds to the value ip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ip E_IETFInterfaces_InterfaceType = 133
+	// IETFInterfaces_InterfaceType_ipForward corresponds to the value ipForward of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipForward E_IETFInterfaces_InterfaceType = 134
+	// IETFInterfaces_InterfaceType_ipOverAtm corresponds to the value ipOverAtm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipOverAtm E_IETFInterfaces_InterfaceType = 135
+	// IETFInterfaces_InterfaceType_ipOverCdlc corresponds to the value ipOverCdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipOverCdlc E_IETFInterfaces_InterfaceType = 136
+	// IETFInterfaces_InterfaceType_ipOverClaw corresponds to the value ipOverClaw of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipOverClaw E_IETFInterfaces_InterfaceType = 137
+	// IETFInterfaces_InterfaceType_ipSwitch corresponds to the value ipSwitch of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipSwitch E_IETFInterfaces_InterfaceType = 138
+	// IETFInterfaces_InterfaceType_isdn corresponds to the value isdn of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_isdn E_IETFInterfaces_InterfaceType = 139
+	// IETFInterfaces_InterfaceType_isdns corresponds to the value isdns of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_isdns E_IETFInterfaces_InterfaceType = 140
+	// IETFInterfaces_InterfaceType_isdnu corresponds to the value isdnu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_isdnu E_IETFInterfaces_InterfaceType = 141
+	// IETFInterfaces_InterfaceType_iso88022llc corresponds to the value iso88022llc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88022llc E_IETFInterfaces_InterfaceType = 142
+	// IETFInterfaces_InterfaceType_iso88023Csmacd corresponds to the value iso88023Csmacd of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88023Csmacd E_IETFInterfaces_InterfaceType = 143
+	// IETFInterfaces_InterfaceType_iso88024TokenBus corresponds to the value iso88024TokenBus of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88024TokenBus E_IETFInterfaces_InterfaceType = 144
+	// IETFInterfaces_InterfaceType_iso88025CRFPInt corresponds to the value iso88025CRFPInt of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88025CRFPInt E_IETFInterfaces_InterfaceType = 145
+	// IETFInterfaces_InterfaceType_iso88025Dtr corresponds to the value iso88025Dtr of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88025Dtr E_IETFInterfaces_InterfaceType = 146
+	// IETFInterfaces_InterfaceType_iso88025Fiber corresponds to the value iso88025Fiber of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88025Fiber E_IETFInterfaces_InterfaceType = 147
+	// IETFInterfaces_InterfaceType_iso88025TokenRing corresponds to the value iso88025TokenRing of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88025TokenRing E_IETFInterfaces_InterfaceType = 148
+	// IETFInterfaces_InterfaceType_iso88026Man corresponds to the value iso88026Man of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88026Man E_IETFInterfaces_InterfaceType = 149
+	// IETFInterfaces_InterfaceType_isup corresponds to the value isup of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_isup E_IETFInterfaces_InterfaceType = 150
+	// IETFInterfaces_InterfaceType_l2vlan corresponds to the value l2vlan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_l2vlan E_IETFInterfaces_InterfaceType = 151
+	// IETFInterfaces_InterfaceType_l3ipvlan corresponds to the value l3ipvlan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_l3ipvlan E_IETFInterfaces_InterfaceType = 152
+	// IETFInterfaces_InterfaceType_l3ipxvlan corresponds to the value l3ipxvlan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_l3ipxvlan E_IETFInterfaces_InterfaceType = 153
+	// IETFInterfaces_InterfaceType_lapb corresponds to the value lapb of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_lapb E_IETFInterfaces_InterfaceType = 154
+	// IETFInterfaces_InterfaceType_lapd corresponds to the value lapd of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_lapd E_IETFInterfaces_InterfaceType = 155
+	// IETFInterfaces_InterfaceType_lapf corresponds to the value lapf of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_lapf E_IETFInterfaces_InterfaceType = 156
+	// IETFInterfaces_InterfaceType_linegroup corresponds to the value linegroup of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_linegroup E_IETFInterfaces_InterfaceType = 157
+	// IETFInterfaces_InterfaceType_lmp corresponds to the value lmp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_lmp E_IETFInterfaces_InterfaceType = 158
+	// IETFInterfaces_InterfaceType_localTalk corresponds to the value localTalk of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_localTalk E_IETFInterfaces_InterfaceType = 159
+	// IETFInterfaces_InterfaceType_macSecControlledIF corresponds to the value macSecControlledIF of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_macSecControlledIF E_IETFInterfaces_InterfaceType = 160
+	// IETFInterfaces_InterfaceType_macSecUncontrolledIF corresponds to the value macSecUncontrolledIF of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_macSecUncontrolledIF E_IETFInterfaces_InterfaceType = 161
+	// IETFInterfaces_InterfaceType_mediaMailOverIp corresponds to the value mediaMailOverIp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mediaMailOverIp E_IETFInterfaces_InterfaceType = 162
+	// IETFInterfaces_InterfaceType_mfSigLink corresponds to the value mfSigLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mfSigLink E_IETFInterfaces_InterfaceType = 163
+	// IETFInterfaces_InterfaceType_miox25 corresponds to the value miox25 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_miox25 E_IETFInterfaces_InterfaceType = 164
+	// IETFInterfaces_InterfaceType_mocaVersion1 corresponds to the value mocaVersion1 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mocaVersion1 E_IETFInterfaces_InterfaceType = 165
+	// IETFInterfaces_InterfaceType_modem corresponds to the value modem of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_modem E_IETFInterfaces_InterfaceType = 166
+	// IETFInterfaces_InterfaceType_mpc corresponds to the value mpc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mpc E_IETFInterfaces_InterfaceType = 167
+	// IETFInterfaces_InterfaceType_mpegTransport corresponds to the value mpegTransport of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mpegTransport E_IETFInterfaces_InterfaceType = 168
+	// IETFInterfaces_InterfaceType_mpls corresponds to the value mpls of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mpls E_IETFInterfaces_InterfaceType = 169
+	// IETFInterfaces_InterfaceType_mplsTunnel corresponds to the value mplsTunnel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mplsTunnel E_IETFInterfaces_InterfaceType = 170
+	// IETFInterfaces_InterfaceType_msdsl corresponds to the value msdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_msdsl E_IETFInterfaces_InterfaceType = 171
+	// IETFInterfaces_InterfaceType_mvl corresponds to the value mvl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mvl E_IETFInterfaces_InterfaceType = 172
+	// IETFInterfaces_InterfaceType_myrinet corresponds to the value myrinet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_myrinet E_IETFInterfaces_InterfaceType = 173
+	// IETFInterfaces_InterfaceType_nfas corresponds to the value nfas of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_nfas E_IETFInterfaces_InterfaceType = 174
+	// IETFInterfaces_InterfaceType_nsip corresponds to the value nsip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_nsip E_IETFInterfaces_InterfaceType = 175
+	// IETFInterfaces_InterfaceType_opticalChannel corresponds to the value opticalChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_opticalChannel E_IETFInterfaces_InterfaceType = 176
+	// IETFInterfaces_InterfaceType_opticalChannelGroup corresponds to the value opticalChannelGroup of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_opticalChannelGroup E_IETFInterfaces_InterfaceType = 177
+	// IETFInterfaces_InterfaceType_opticalTransport corresponds to the value opticalTransport of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_opticalTransport E_IETFInterfaces_InterfaceType = 178
+	// IETFInterfaces_InterfaceType_other corresponds to the value other of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_other E_IETFInterfaces_InterfaceType = 179
+	// IETFInterfaces_InterfaceType_otnOdu corresponds to the value otnOdu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_otnOdu E_IETFInterfaces_InterfaceType = 180
+	// IETFInterfaces_InterfaceType_otnOtu corresponds to the value otnOtu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_otnOtu E_IETFInterfaces_InterfaceType = 181
+	// IETFInterfaces_InterfaceType_para corresponds to the value para of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_para E_IETFInterfaces_InterfaceType = 182
+	// IETFInterfaces_InterfaceType_pdnEtherLoop1 corresponds to the value pdnEtherLoop1 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pdnEtherLoop1 E_IETFInterfaces_InterfaceType = 183
+	// IETFInterfaces_InterfaceType_pdnEtherLoop2 corresponds to the value pdnEtherLoop2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pdnEtherLoop2 E_IETFInterfaces_InterfaceType = 184
+	// IETFInterfaces_InterfaceType_pip corresponds to the value pip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pip E_IETFInterfaces_InterfaceType = 185
+	// IETFInterfaces_InterfaceType_plc corresponds to the value plc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_plc E_IETFInterfaces_InterfaceType = 186
+	// IETFInterfaces_InterfaceType_pon155 corresponds to the value pon155 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pon155 E_IETFInterfaces_InterfaceType = 187
+	// IETFInterfaces_InterfaceType_pon622 corresponds to the value pon622 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pon622 E_IETFInterfaces_InterfaceType = 188
+	// IETFInterfaces_InterfaceType_pos corresponds to the value pos of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pos E_IETFInterfaces_InterfaceType = 189
+	// IETFInterfaces_InterfaceType_ppp corresponds to the value ppp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ppp E_IETFInterfaces_InterfaceType = 190
+	// IETFInterfaces_InterfaceType_pppMultilinkBundle corresponds to the value pppMultilinkBundle of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pppMultilinkBundle E_IETFInterfaces_InterfaceType = 191
+	// IETFInterfaces_InterfaceType_primaryISDN corresponds to the value primaryISDN of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_primaryISDN E_IETFInterfaces_InterfaceType = 192
+	// IETFInterfaces_InterfaceType_propAtm corresponds to the value propAtm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propAtm E_IETFInterfaces_InterfaceType = 193
+	// IETFInterfaces_InterfaceType_propBWAp2Mp corresponds to the value propBWAp2Mp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propBWAp2Mp E_IETFInterfaces_InterfaceType = 194
+	// IETFInterfaces_InterfaceType_propCnls corresponds to the value propCnls of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propCnls E_IETFInterfaces_InterfaceType = 195
+	// IETFInterfaces_InterfaceType_propDocsWirelessDownstream corresponds to the value propDocsWirelessDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propDocsWirelessDownstream E_IETFInterfaces_InterfaceType = 196
+	// IETFInterfaces_InterfaceType_propDocsWirelessMaclayer corresponds to the value propDocsWirelessMaclayer of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propDocsWirelessMaclayer E_IETFInterfaces_InterfaceType = 197
+	// IETFInterfaces_InterfaceType_propDocsWirelessUpstream corresponds to the value propDocsWirelessUpstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propDocsWirelessUpstream E_IETFInterfaces_InterfaceType = 198
+	// IETFInterfaces_InterfaceType_propMultiplexor corresponds to the value propMultiplexor of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propMultiplexor E_IETFInterfaces_InterfaceType = 199
+	// IETFInterfaces_InterfaceType_propPointToPointSerial corresponds to the value propPointToPointSerial of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propPointToPointSerial E_IETFInterfaces_InterfaceType = 200
+	// IETFInterfaces_InterfaceType_propVirtual corresponds to the value propVirtual of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propVirtual E_IETFInterfaces_InterfaceType = 201
+	// IETFInterfaces_InterfaceType_propWirelessP2P corresponds to the value propWirelessP2P of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propWirelessP2P E_IETFInterfaces_InterfaceType = 202
+	// IETFInterfaces_InterfaceType_proteon10Mbit corresponds to the value proteon10Mbit of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_proteon10Mbit E_IETFInterfaces_InterfaceType = 203
+	// IETFInterfaces_InterfaceType_proteon80Mbit corresponds to the value proteon80Mbit of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_proteon80Mbit E_IETFInterfaces_InterfaceType = 204
+	// IETFInterfaces_InterfaceType_q2931 corresponds to the value q2931 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_q2931 E_IETFInterfaces_InterfaceType = 205
+	// IETFInterfaces_InterfaceType_qam corresponds to the value qam of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_qam E_IETFInterfaces_InterfaceType = 206
+	// IETFInterfaces_InterfaceType_qllc corresponds to the value qllc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_qllc E_IETFInterfaces_InterfaceType = 207
+	// IETFInterfaces_InterfaceType_radioMAC corresponds to the value radioMAC of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_radioMAC E_IETFInterfaces_InterfaceType = 208
+	// IETFInterfaces_InterfaceType_radsl corresponds to the value radsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_radsl E_IETFInterfaces_InterfaceType = 209
+	// IETFInterfaces_InterfaceType_reachDSL corresponds to the value reachDSL of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_reachDSL E_IETFInterfaces_InterfaceType = 210
+	// IETFInterfaces_InterfaceType_regular1822 corresponds to the value regular1822 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_regular1822 E_IETFInterfaces_InterfaceType = 211
+	// IETFInterfaces_InterfaceType_rfc1483 corresponds to the value rfc1483 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rfc1483 E_IETFInterfaces_InterfaceType = 212
+	// IETFInterfaces_InterfaceType_rfc877x25 corresponds to the value rfc877x25 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rfc877x25 E_IETFInterfaces_InterfaceType = 213
+	// IETFInterfaces_InterfaceType_rpr corresponds to the value rpr of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rpr E_IETFInterfaces_InterfaceType = 214
+	// IETFInterfaces_InterfaceType_rs232 corresponds to the value rs232 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rs232 E_IETFInterfaces_InterfaceType = 215
+	// IETFInterfaces_InterfaceType_rsrb corresponds to the value rsrb of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rsrb E_IETFInterfaces_InterfaceType = 216
+	// IETFInterfaces_InterfaceType_sdlc corresponds to the value sdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sdlc E_IETFInterfaces_InterfaceType = 217
+	// IETFInterfaces_InterfaceType_sdsl corresponds to the value sdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sdsl E_IETFInterfaces_InterfaceType = 218
+	// IETFInterfaces_InterfaceType_shdsl corresponds to the value shdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_shdsl E_IETFInterfaces_InterfaceType = 219
+	// IETFInterfaces_InterfaceType_sip corresponds to the value sip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sip E_IETFInterfaces_InterfaceType = 220
+	// IETFInterfaces_InterfaceType_sipSig corresponds to the value sipSig of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sipSig E_IETFInterfaces_InterfaceType = 221
+	// IETFInterfaces_InterfaceType_sipTg corresponds to the value sipTg of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sipTg E_IETFInterfaces_InterfaceType = 222
+	// IETFInterfaces_InterfaceType_sixToFour corresponds to the value sixToFour of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sixToFour E_IETFInterfaces_InterfaceType = 223
+	// IETFInterfaces_InterfaceType_slip corresponds to the value slip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_slip E_IETFInterfaces_InterfaceType = 224
+	// IETFInterfaces_InterfaceType_smdsDxi corresponds to the value smdsDxi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_smdsDxi E_IETFInterfaces_InterfaceType = 225
+	// IETFInterfaces_InterfaceType_smdsIcip corresponds to the value smdsIcip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_smdsIcip E_IETFInterfaces_InterfaceType = 226
+	// IETFInterfaces_InterfaceType_softwareLoopback corresponds to the value softwareLoopback of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_softwareLoopback E_IETFInterfaces_InterfaceType = 227
+	// IETFInterfaces_InterfaceType_sonet corresponds to the value sonet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sonet E_IETFInterfaces_InterfaceType = 228
+	// IETFInterfaces_InterfaceType_sonetOverheadChannel corresponds to the value sonetOverheadChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sonetOverheadChannel E_IETFInterfaces_InterfaceType = 229
+	// IETFInterfaces_InterfaceType_sonetPath corresponds to the value sonetPath of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sonetPath E_IETFInterfaces_InterfaceType = 230
+	// IETFInterfaces_InterfaceType_sonetVT corresponds to the value sonetVT of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sonetVT E_IETFInterfaces_InterfaceType = 231
+	// IETFInterfaces_InterfaceType_srp corresponds to the value srp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_srp E_IETFInterfaces_InterfaceType = 232
+	// IETFInterfaces_InterfaceType_ss7SigLink corresponds to the value ss7SigLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ss7SigLink E_IETFInterfaces_InterfaceType = 233
+	// IETFInterfaces_InterfaceType_stackToStack corresponds to the value stackToStack of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_stackToStack E_IETFInterfaces_InterfaceType = 234
+	// IETFInterfaces_InterfaceType_starLan corresponds to the value starLan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_starLan E_IETFInterfaces_InterfaceType = 235
+	// IETFInterfaces_InterfaceType_tdlc corresponds to the value tdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_tdlc E_IETFInterfaces_InterfaceType = 236
+	// IETFInterfaces_InterfaceType_teLink corresponds to the value teLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_teLink E_IETFInterfaces_InterfaceType = 237
+	// IETFInterfaces_InterfaceType_termPad corresponds to the value termPad of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_termPad E_IETFInterfaces_InterfaceType = 238
+	// IETFInterfaces_InterfaceType_tr008 corresponds to the value tr008 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_tr008 E_IETFInterfaces_InterfaceType = 239
+	// IETFInterfaces_InterfaceType_transpHdlc corresponds to the value transpHdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_transpHdlc E_IETFInterfaces_InterfaceType = 240
+	// IETFInterfaces_InterfaceType_tunnel corresponds to the value tunnel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_tunnel E_IETFInterfaces_InterfaceType = 241
+	// IETFInterfaces_InterfaceType_ultra corresponds to the value ultra of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ultra E_IETFInterfaces_InterfaceType = 242
+	// IETFInterfaces_InterfaceType_usb corresponds to the value usb of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_usb E_IETFInterfaces_InterfaceType = 243
+	// IETFInterfaces_InterfaceType_v11 corresponds to the value v11 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_v11 E_IETFInterfaces_InterfaceType = 244
+	// IETFInterfaces_InterfaceType_v35 corresponds to the value v35 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_v35 E_IETFInterfaces_InterfaceType = 245
+	// IETFInterfaces_InterfaceType_v36 corresponds to the value v36 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_v36 E_IETFInterfaces_InterfaceType = 246
+	// IETFInterfaces_InterfaceType_v37 corresponds to the value v37 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_v37 E_IETFInterfaces_InterfaceType = 247
+	// IETFInterfaces_InterfaceType_vdsl corresponds to the value vdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_vdsl E_IETFInterfaces_InterfaceType = 248
+	// IETFInterfaces_InterfaceType_vdsl2 corresponds to the value vdsl2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_vdsl2 E_IETFInterfaces_InterfaceType = 249
+	// IETFInterfaces_InterfaceType_virtualIpAddress corresponds to the value virtualIpAddress of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_virtualIpAddress E_IETFInterfaces_InterfaceType = 250
+	// IETFInterfaces_InterfaceType_virtualTg corresponds to the value virtualTg of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_virtualTg E_IETFInterfaces_InterfaceType = 251
+	// IETFInterfaces_InterfaceType_vmwareNicTeam corresponds to the value vmwareNicTeam of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_vmwareNicTeam E_IETFInterfaces_InterfaceType = 252
+	// IETFInterfaces_InterfaceType_vmwareVirtualNic corresponds to the value vmwareVirtualNic of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_vmwareVirtualNic E_IETFInterfaces_InterfaceType = 253
+	// IETFInterfaces_InterfaceType_voiceDID corresponds to the value voiceDID of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceDID E_IETFInterfaces_InterfaceType = 254
+	// IETFInterfaces_InterfaceType_voiceEBS corresponds to the value voiceEBS of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceEBS E_IETFInterfaces_InterfaceType = 255
+	// IETFInterfaces_InterfaceType_voiceEM corresponds to the value voiceEM of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceEM E_IETFInterfaces_InterfaceType = 256
+	// IETFInterfaces_InterfaceType_voiceEMFGD corresponds to the value voiceEMFGD of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceEMFGD E_IETFInterfaces_InterfaceType = 257
+	// IETFInterfaces_InterfaceType_voiceEncap corresponds to the value voiceEncap of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceEncap E_IETFInterfaces_InterfaceType = 258
+	// IETFInterfaces_InterfaceType_voiceFGDEANA corresponds to the value voiceFGDEANA of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceFGDEANA E_IETFInterfaces_InterfaceType = 259
+	// IETFInterfaces_InterfaceType_voiceFGDOS corresponds to the value voiceFGDOS of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceFGDOS E_IETFInterfaces_InterfaceType = 260
+	// IETFInterfaces_InterfaceType_voiceFXO corresponds to the value voiceFXO of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceFXO E_IETFInterfaces_InterfaceType = 261
+	// IETFInterfaces_InterfaceType_voiceFXS corresponds to the value voiceFXS of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceFXS E_IETFInterfaces_InterfaceType = 262
+	// IETFInterfaces_InterfaceType_voiceOverAtm corresponds to the value voiceOverAtm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceOverAtm E_IETFInterfaces_InterfaceType = 263
+	// IETFInterfaces_InterfaceType_voiceOverCable corresponds to the value voiceOverCable of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceOverCable E_IETFInterfaces_InterfaceType = 264
+	// IETFInterfaces_InterfaceType_voiceOverFrameRelay corresponds to the value voiceOverFrameRelay of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceOverFrameRelay E_IETFInterfaces_InterfaceType = 265
+	// IETFInterfaces_InterfaceType_voiceOverIp corresponds to the value voiceOverIp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceOverIp E_IETFInterfaces_InterfaceType = 266
+	// IETFInterfaces_InterfaceType_wwanPP corresponds to the value wwanPP of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_wwanPP E_IETFInterfaces_InterfaceType = 267
+	// IETFInterfaces_InterfaceType_wwanPP2 corresponds to the value wwanPP2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_wwanPP2 E_IETFInterfaces_InterfaceType = 268
+	// IETFInterfaces_InterfaceType_x213 corresponds to the value x213 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x213 E_IETFInterfaces_InterfaceType = 269
+	// IETFInterfaces_InterfaceType_x25huntGroup corresponds to the value x25huntGroup of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x25huntGroup E_IETFInterfaces_InterfaceType = 270
+	// IETFInterfaces_InterfaceType_x25mlp corresponds to the value x25mlp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x25mlp E_IETFInterfaces_InterfaceType = 271
+	// IETFInterfaces_InterfaceType_x25ple corresponds to the value x25ple of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x25ple E_IETFInterfaces_InterfaceType = 272
+	// IETFInterfaces_InterfaceType_x86Laps corresponds to the value x86Laps of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x86Laps E_IETFInterfaces_InterfaceType = 273
 )
 
 // E_OpenconfigAaaTypes_AAA_ACCOUNTING_EVENT_TYPE is a derived int64 type which is used to represent
@@ -3273,29 +6174,151 @@ const (
 // OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_UNSET is added to the enumeration which is used as
 // the nil value, indicating that the enumeration was not explicitly set by
 // the program importing the generated structures.
-type E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID int64
+type E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID int64
+
+// IsYANGGoEnum ensures that OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID implements the yang.GoEnum
+// interface. This ensures that OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID.
+func (E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_UNSET corresponds to the value UNSET of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
+	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_UNSET E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 0
+	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_AIS corresponds to the value AIS of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
+	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_AIS E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 1
+	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_EQPT corresponds to the value EQPT of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
+	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_EQPT E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 2
+	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_LOS corresponds to the value LOS of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
+	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_LOS E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 3
+	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_OTS corresponds to the value OTS of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
+	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_OTS E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 4
+)
+
+// E_OpenconfigIfAggregate_AggregationType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfAggregate_AggregationType. An additional value named
+// OpenconfigIfAggregate_AggregationType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfAggregate_AggregationType int64
+
+// IsYANGGoEnum ensures that OpenconfigIfAggregate_AggregationType implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfAggregate_AggregationType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfAggregate_AggregationType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfAggregate_AggregationType.
+func (E_OpenconfigIfAggregate_AggregationType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigIfAggregate_AggregationType_UNSET corresponds to the value UNSET of OpenconfigIfAggregate_AggregationType
+	OpenconfigIfAggregate_AggregationType_UNSET E_OpenconfigIfAggregate_AggregationType = 0
+	// OpenconfigIfAggregate_AggregationType_LACP corresponds to the value LACP of OpenconfigIfAggregate_AggregationType
+	OpenconfigIfAggregate_AggregationType_LACP E_OpenconfigIfAggregate_AggregationType = 1
+	// OpenconfigIfAggregate_AggregationType_STATIC corresponds to the value STATIC of OpenconfigIfAggregate_AggregationType
+	OpenconfigIfAggregate_AggregationType_STATIC E_OpenconfigIfAggregate_AggregationType = 2
+)
+
+// E_OpenconfigIfEthernet_ETHERNET_SPEED is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfEthernet_ETHERNET_SPEED. An additional value named
+// OpenconfigIfEthernet_ETHERNET_SPEED_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfEthernet_ETHERNET_SPEED int64
+
+// IsYANGGoEnum ensures that OpenconfigIfEthernet_ETHERNET_SPEED implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfEthernet_ETHERNET_SPEED can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfEthernet_ETHERNET_SPEED) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfEthernet_ETHERNET_SPEED.
+func (E_OpenconfigIfEthernet_ETHERNET_SPEED) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigIfEthernet_ETHERNET_SPEED_UNSET corresponds to the value UNSET of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_UNSET E_OpenconfigIfEthernet_ETHERNET_SPEED = 0
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB corresponds to the value SPEED_100GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 1
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100MB corresponds to the value SPEED_100MB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 2
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB corresponds to the value SPEED_10GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 3
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10MB corresponds to the value SPEED_10MB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 4
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB corresponds to the value SPEED_1GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 5
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB corresponds to the value SPEED_2500MB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 6
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB corresponds to the value SPEED_25GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 7
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB corresponds to the value SPEED_40GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 8
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB corresponds to the value SPEED_50GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 9
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_5GB corresponds to the value SPEED_5GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_5GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 10
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN corresponds to the value SPEED_UNKNOWN of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN E_OpenconfigIfEthernet_ETHERNET_SPEED = 11
+)
+
+// E_OpenconfigIfEthernet_Ethernet_DuplexMode is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfEthernet_Ethernet_DuplexMode. An additional value named
+// OpenconfigIfEthernet_Ethernet_DuplexMode_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfEthernet_Ethernet_DuplexMode int64
+
+// IsYANGGoEnum ensures that OpenconfigIfEthernet_Ethernet_DuplexMode implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfEthernet_Ethernet_DuplexMode can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfEthernet_Ethernet_DuplexMode) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfEthernet_Ethernet_DuplexMode.
+func (E_OpenconfigIfEthernet_Ethernet_DuplexMode) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigIfEthernet_Ethernet_DuplexMode_UNSET corresponds to the value UNSET of OpenconfigIfEthernet_Ethernet_DuplexMode
+	OpenconfigIfEthernet_Ethernet_DuplexMode_UNSET E_OpenconfigIfEthernet_Ethernet_DuplexMode = 0
+	// OpenconfigIfEthernet_Ethernet_DuplexMode_FULL corresponds to the value FULL of OpenconfigIfEthernet_Ethernet_DuplexMode
+	OpenconfigIfEthernet_Ethernet_DuplexMode_FULL E_OpenconfigIfEthernet_Ethernet_DuplexMode = 1
+	// OpenconfigIfEthernet_Ethernet_DuplexMode_HALF corresponds to the value HALF of OpenconfigIfEthernet_Ethernet_DuplexMode
+	OpenconfigIfEthernet_Ethernet_DuplexMode_HALF E_OpenconfigIfEthernet_Ethernet_DuplexMode = 2
+)
+
+// E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode. An additional value named
+// OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode int64
 
-// IsYANGGoEnum ensures that OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID implements the yang.GoEnum
-// interface. This ensures that OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID can be identified as a
+// IsYANGGoEnum ensures that OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode can be identified as a
 // mapped type for a YANG enumeration.
-func (E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID) IsYANGGoEnum() {}
+func (E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode) IsYANGGoEnum() {}
 
-// ΛMap returns the value lookup map associated with  OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID.
-func (E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+// ΛMap returns the value lookup map associated with  OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode.
+func (E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode) ΛMap() map[string]map[int64]ygot.EnumDefinition {
 	return ΛEnum
 }
 
 const (
-	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_UNSET corresponds to the value UNSET of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
-	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_UNSET E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 0
-	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_AIS corresponds to the value AIS of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
-	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_AIS E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 1
-	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_EQPT corresponds to the value EQPT of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
-	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_EQPT E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 2
-	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_LOS corresponds to the value LOS of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
-	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_LOS E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 3
-	// OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_OTS corresponds to the value OTS of OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID
-	OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID_OTS E_OpenconfigAlarmTypes_OPENCONFIG_ALARM_TYPE_ID = 4
+	// OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_UNSET corresponds to the value UNSET of OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode
+	OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_UNSET E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode = 0
+	// OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_FULL corresponds to the value FULL of OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode
+	OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_FULL E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode = 1
+	// OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_HALF corresponds to the value HALF of OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode
+	OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_HALF E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode = 2
 )
 
 // E_OpenconfigInterfaces_Interface_AdminStatus is a derived int64 type which is used to represent
@@ -3362,6 +6385,256 @@ const (
 	OpenconfigInterfaces_Interface_OperStatus_LOWER_LAYER_DOWN E_OpenconfigInterfaces_Interface_OperStatus = 8
 )
 
+// E_OpenconfigLacp_LacpActivityType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigLacp_LacpActivityType. An additional value named
+// OpenconfigLacp_LacpActivityType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigLacp_LacpActivityType int64
+
+// IsYANGGoEnum ensures that OpenconfigLacp_LacpActivityType implements the yang.GoEnum
+// interface. This ensures that OpenconfigLacp_LacpActivityType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigLacp_LacpActivityType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigLacp_LacpActivityType.
+func (E_OpenconfigLacp_LacpActivityType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigLacp_LacpActivityType_UNSET corresponds to the value UNSET of OpenconfigLacp_LacpActivityType
+	OpenconfigLacp_LacpActivityType_UNSET E_OpenconfigLacp_LacpActivityType = 0
+	// OpenconfigLacp_LacpActivityType_ACTIVE corresponds to the value ACTIVE of OpenconfigLacp_LacpActivityType
+	OpenconfigLacp_LacpActivityType_ACTIVE E_OpenconfigLacp_LacpActivityType = 1
+	// OpenconfigLacp_LacpActivityType_PASSIVE corresponds to the value PASSIVE of OpenconfigLacp_LacpActivityType
+	OpenconfigLacp_LacpActivityType_PASSIVE E_OpenconfigLacp_LacpActivityType = 2
+)
+
+// E_OpenconfigLacp_LacpPeriodType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigLacp_LacpPeriodType. An additional value named
+// OpenconfigLacp_LacpPeriodType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigLacp_LacpPeriodType int64
+
+// IsYANGGoEnum ensures that OpenconfigLacp_LacpPeriodType implements the yang.GoEnum
+// interface. This ensures that OpenconfigLacp_LacpPeriodType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigLacp_LacpPeriodType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigLacp_LacpPeriodType.
+func (E_OpenconfigLacp_LacpPeriodType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigLacp_LacpPeriodType_UNSET corresponds to the value UNSET of OpenconfigLacp_LacpPeriodType
+	OpenconfigLacp_LacpPeriodType_UNSET E_OpenconfigLacp_LacpPeriodType = 0
+	// OpenconfigLacp_LacpPeriodType_FAST corresponds to the value FAST of OpenconfigLacp_LacpPeriodType
+	OpenconfigLacp_LacpPeriodType_FAST E_OpenconfigLacp_LacpPeriodType = 1
+	// OpenconfigLacp_LacpPeriodType_SLOW corresponds to the value SLOW of OpenconfigLacp_LacpPeriodType
+	OpenconfigLacp_LacpPeriodType_SLOW E_OpenconfigLacp_LacpPeriodType = 2
+)
+
+// E_OpenconfigLacp_LacpSynchronizationType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigLacp_LacpSynchronizationType. An additional value named
+// OpenconfigLacp_LacpSynchronizationType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigLacp_LacpSynchronizationType int64
+
+// IsYANGGoEnum ensures that OpenconfigLacp_LacpSynchronizationType implements the yang.GoEnum
+// interface. This ensures that OpenconfigLacp_LacpSynchronizationType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigLacp_LacpSynchronizationType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigLacp_LacpSynchronizationType.
+func (E_OpenconfigLacp_LacpSynchronizationType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigLacp_LacpSynchronizationType_UNSET corresponds to the value UNSET of OpenconfigLacp_LacpSynchronizationType
+	OpenconfigLacp_LacpSynchronizationType_UNSET E_OpenconfigLacp_LacpSynchronizationType = 0
+	// OpenconfigLacp_LacpSynchronizationType_IN_SYNC corresponds to the value IN_SYNC of OpenconfigLacp_LacpSynchronizationType
+	OpenconfigLacp_LacpSynchronizationType_IN_SYNC E_OpenconfigLacp_LacpSynchronizationType = 1
+	// OpenconfigLacp_LacpSynchronizationType_OUT_SYNC corresponds to the value OUT_SYNC of OpenconfigLacp_LacpSynchronizationType
+	OpenconfigLacp_LacpSynchronizationType_OUT_SYNC E_OpenconfigLacp_LacpSynchronizationType = 2
+)
+
+// E_OpenconfigLacp_LacpTimeoutType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigLacp_LacpTimeoutType. An additional value named
+// OpenconfigLacp_LacpTimeoutType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigLacp_LacpTimeoutType int64
+
+// IsYANGGoEnum ensures that OpenconfigLacp_LacpTimeoutType implements the yang.GoEnum
+// interface. This ensures that OpenconfigLacp_LacpTimeoutType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigLacp_LacpTimeoutType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigLacp_LacpTimeoutType.
+func (E_OpenconfigLacp_LacpTimeoutType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigLacp_LacpTimeoutType_UNSET corresponds to the value UNSET of OpenconfigLacp_LacpTimeoutType
+	OpenconfigLacp_LacpTimeoutType_UNSET E_OpenconfigLacp_LacpTimeoutType = 0
+	// OpenconfigLacp_LacpTimeoutType_LONG corresponds to the value LONG of OpenconfigLacp_LacpTimeoutType
+	OpenconfigLacp_LacpTimeoutType_LONG E_OpenconfigLacp_LacpTimeoutType = 1
+	// OpenconfigLacp_LacpTimeoutType_SHORT corresponds to the value SHORT of OpenconfigLacp_LacpTimeoutType
+	OpenconfigLacp_LacpTimeoutType_SHORT E_OpenconfigLacp_LacpTimeoutType = 2
+)
+
+// E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY is a derived int64 type which is used to represent
+// the enumerated node OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY. An additional value named
+// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY int64
+
+// IsYANGGoEnum ensures that OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY implements the yang.GoEnum
+// interface. This ensures that OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY.
+func (E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_UNSET corresponds to the value UNSET of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_UNSET E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 0
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_C_VLAN corresponds to the value C_VLAN of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_C_VLAN E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 1
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_DOCSIS_CABLE_DEVICE corresponds to the value DOCSIS_CABLE_DEVICE of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_DOCSIS_CABLE_DEVICE E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 2
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_MAC_BRIDGE corresponds to the value MAC_BRIDGE of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_MAC_BRIDGE E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 3
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_OTHER corresponds to the value OTHER of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_OTHER E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 4
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_REPEATER corresponds to the value REPEATER of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_REPEATER E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 5
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_ROUTER corresponds to the value ROUTER of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_ROUTER E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 6
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_STATION_ONLY corresponds to the value STATION_ONLY of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_STATION_ONLY E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 7
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_S_VLAN corresponds to the value S_VLAN of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_S_VLAN E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 8
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_TELEPHONE corresponds to the value TELEPHONE of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_TELEPHONE E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 9
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_TWO_PORT_MAC_RELAY corresponds to the value TWO_PORT_MAC_RELAY of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_TWO_PORT_MAC_RELAY E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 10
+	// OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_WLAN_ACCESS_POINT corresponds to the value WLAN_ACCESS_POINT of OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY
+	OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_WLAN_ACCESS_POINT E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY = 11
+)
+
+// E_OpenconfigLldpTypes_LLDP_TLV is a derived int64 type which is used to represent
+// the enumerated node OpenconfigLldpTypes_LLDP_TLV. An additional value named
+// OpenconfigLldpTypes_LLDP_TLV_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigLldpTypes_LLDP_TLV int64
+
+// IsYANGGoEnum ensures that OpenconfigLldpTypes_LLDP_TLV implements the yang.GoEnum
+// interface. This ensures that OpenconfigLldpTypes_LLDP_TLV can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigLldpTypes_LLDP_TLV) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigLldpTypes_LLDP_TLV.
+func (E_OpenconfigLldpTypes_LLDP_TLV) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+const (
+	// OpenconfigLldpTypes_LLDP_TLV_UNSET corresponds to the value UNSET of OpenconfigLldpTypes_LLDP_TLV
+	OpenconfigLldpTypes_LLDP_TLV_UNSET E_OpenconfigLldpTypes_LLDP_TLV = 0
+	// OpenconfigLldpTypes_LLDP_TLV_CHASSIS_ID corresponds to the value CHASSIS_ID of OpenconfigLldpTypes_LLDP_TLV
+	OpenconfigLldpTypes_LLDP_TLV_CHASSIS_ID E_OpenconfigLldpTypes_LLDP_TLV = 1
+	// OpenconfigLldpTypes_LLDP_TLV_MANAGEMENT_ADDRESS corresponds to the value MANAGEMENT_ADDRESS of OpenconfigLldpTypes_LLDP_TLV
+	OpenconfigLldpTypes_LLDP_TLV_MANAGEMENT_ADDRESS E_OpenconfigLldpTypes_LLDP_TLV = 2
+	// OpenconfigLldpTypes_LLDP_TLV_PORT_DESCRIPTION corresponds to the value PORT_DESCRIPTION of OpenconfigLldpTypes_LLDP_TLV
+	OpenconfigLldpTypes_LLDP_TLV_PORT_DESCRIPTION E_OpenconfigLldpTypes_LLDP_TLV = 3
+	// OpenconfigLldpTypes_LLDP_TLV_PORT_ID corresponds to the value PORT_ID of OpenconfigLldpTypes_LLDP_TLV
+	OpenconfigLldpTypes_LLDP_TLV_PORT_ID E_OpenconfigLldpTypes_LLDP_TLV = 4
+	// OpenconfigLldpTypes_LLDP_TLV_SYSTEM_CAPABILITIES corresponds to the value SYSTEM_CAPABILITIES of OpenconfigLldpTypes_LLDP_TLV
+	OpenconfigLldpTypes_LLDP_TLV_SYSTEM_CAPABILITIES E_OpenconfigLldpTypes_LLDP_TLV = 5
+	// OpenconfigLldpTypes_LLDP_TLV_SYSTEM_DESCRIPTION corresponds to the value SYSTEM_DESCRIPTION of OpenconfigLldpTypes_LLDP_TLV
+	OpenconfigLldpTypes_LLDP_TLV_SYSTEM_DESCRIPTION E_OpenconfigLldpTypes_LLDP_TLV = 6
+	// OpenconfigLldpTypes_LLDP_TLV_SYSTEM_NAME corresponds to the value SYSTEM_NAME of OpenconfigLldpTypes_LLDP_TLV
+	OpenconfigLldpTypes_LLDP_TLV_SYSTEM_NAME E_OpenconfigLldpTypes_LLDP_TLV = 7
+)
+
+// E_OpenconfigLldp_ChassisIdType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigLldp_ChassisIdType. An additional value named
+// OpenconfigLldp_ChassisIdType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigLldp_ChassisIdType int64
+
+// IsYANGGoEnum ensures that OpenconfigLldp_ChassisIdType implements the yang.GoEnum
+// interface. This ensures that OpenconfigLldp_ChassisIdType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigLldp_ChassisIdType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigLldp_ChassisIdType.
+func (E_OpenconfigLldp_ChassisIdType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+const (
+	// OpenconfigLldp_ChassisIdType_UNSET corresponds to the value UNSET of OpenconfigLldp_ChassisIdType
+	OpenconfigLldp_ChassisIdType_UNSET E_OpenconfigLldp_ChassisIdType = 0
+	// OpenconfigLldp_ChassisIdType_CHASSIS_COMPONENT corresponds to the value CHASSIS_COMPONENT of OpenconfigLldp_ChassisIdType
+	OpenconfigLldp_ChassisIdType_CHASSIS_COMPONENT E_OpenconfigLldp_ChassisIdType = 1
+	// OpenconfigLldp_ChassisIdType_INTERFACE_ALIAS corresponds to the value INTERFACE_ALIAS of OpenconfigLldp_ChassisIdType
+	OpenconfigLldp_ChassisIdType_INTERFACE_ALIAS E_OpenconfigLldp_ChassisIdType = 2
+	// OpenconfigLldp_ChassisIdType_PORT_COMPONENT corresponds to the value PORT_COMPONENT of OpenconfigLldp_ChassisIdType
+	OpenconfigLldp_ChassisIdType_PORT_COMPONENT E_OpenconfigLldp_ChassisIdType = 3
+	// OpenconfigLldp_ChassisIdType_MAC_ADDRESS corresponds to the value MAC_ADDRESS of OpenconfigLldp_ChassisIdType
+	OpenconfigLldp_ChassisIdType_MAC_ADDRESS E_OpenconfigLldp_ChassisIdType = 4
+	// OpenconfigLldp_ChassisIdType_NETWORK_ADDRESS corresponds to the value NETWORK_ADDRESS of OpenconfigLldp_ChassisIdType
+	OpenconfigLldp_ChassisIdType_NETWORK_ADDRESS E_OpenconfigLldp_ChassisIdType = 5
+	// OpenconfigLldp_ChassisIdType_INTERFACE_NAME corresponds to the value INTERFACE_NAME of OpenconfigLldp_ChassisIdType
+	OpenconfigLldp_ChassisIdType_INTERFACE_NAME E_OpenconfigLldp_ChassisIdType = 6
+	// OpenconfigLldp_ChassisIdType_LOCAL corresponds to the value LOCAL of OpenconfigLldp_ChassisIdType
+	OpenconfigLldp_ChassisIdType_LOCAL E_OpenconfigLldp_ChassisIdType = 7
+)
+
+// E_OpenconfigLldp_PortIdType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigLldp_PortIdType. An additional value named
+// OpenconfigLldp_PortIdType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigLldp_PortIdType int64
+
+// IsYANGGoEnum ensures that OpenconfigLldp_PortIdType implements the yang.GoEnum
+// interface. This ensures that OpenconfigLldp_PortIdType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigLldp_PortIdType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigLldp_PortIdType.
+func (E_OpenconfigLldp_PortIdType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+const (
+	// OpenconfigLldp_PortIdType_UNSET corresponds to the value UNSET of OpenconfigLldp_PortIdType
+	OpenconfigLldp_PortIdType_UNSET E_OpenconfigLldp_PortIdType = 0
+	// OpenconfigLldp_PortIdType_INTERFACE_ALIAS corresponds to the value INTERFACE_ALIAS of OpenconfigLldp_PortIdType
+	OpenconfigLldp_PortIdType_INTERFACE_ALIAS E_OpenconfigLldp_PortIdType = 1
+	// OpenconfigLldp_PortIdType_PORT_COMPONENT corresponds to the value PORT_COMPONENT of OpenconfigLldp_PortIdType
+	OpenconfigLldp_PortIdType_PORT_COMPONENT E_OpenconfigLldp_PortIdType = 2
+	// OpenconfigLldp_PortIdType_MAC_ADDRESS corresponds to the value MAC_ADDRESS of OpenconfigLldp_PortIdType
+	OpenconfigLldp_PortIdType_MAC_ADDRESS E_OpenconfigLldp_PortIdType = 3
+	// OpenconfigLldp_PortIdType_NETWORK_ADDRESS corresponds to the value NETWORK_ADDRESS of OpenconfigLldp_PortIdType
+	OpenconfigLldp_PortIdType_NETWORK_ADDRESS E_OpenconfigLldp_PortIdType = 4
+	// OpenconfigLldp_PortIdType_INTERFACE_NAME corresponds to the value INTERFACE_NAME of OpenconfigLldp_PortIdType
+	OpenconfigLldp_PortIdType_INTERFACE_NAME E_OpenconfigLldp_PortIdType = 5
+	// OpenconfigLldp_PortIdType_AGENT_CIRCUIT_ID corresponds to the value AGENT_CIRCUIT_ID of OpenconfigLldp_PortIdType
+	OpenconfigLldp_PortIdType_AGENT_CIRCUIT_ID E_OpenconfigLldp_PortIdType = 6
+	// OpenconfigLldp_PortIdType_LOCAL corresponds to the value LOCAL of OpenconfigLldp_PortIdType
+	OpenconfigLldp_PortIdType_LOCAL E_OpenconfigLldp_PortIdType = 7
+)
+
 // E_OpenconfigOpenflow_FailureMode is a derived int64 type which is used to represent
 // the enumerated node OpenconfigOpenflow_FailureMode. An additional value named
 // OpenconfigOpenflow_FailureMode_UNSET is added to the enumeration which is used as
@@ -3514,6 +6787,180 @@ const (
 	OpenconfigPlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_OPERATING_SYSTEM E_OpenconfigPlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT = 1
 )
 
+// E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT is a derived int64 type which is used to represent
+// the enumerated node OpenconfigSpanningTreeTypes_STP_EDGE_PORT. An additional value named
+// OpenconfigSpanningTreeTypes_STP_EDGE_PORT_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT int64
+
+// IsYANGGoEnum ensures that OpenconfigSpanningTreeTypes_STP_EDGE_PORT implements the yang.GoEnum
+// interface. This ensures that OpenconfigSpanningTreeTypes_STP_EDGE_PORT can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigSpanningTreeTypes_STP_EDGE_PORT.
+func (E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigSpanningTreeTypes_STP_EDGE_PORT_UNSET corresponds to the value UNSET of OpenconfigSpanningTreeTypes_STP_EDGE_PORT
+	OpenconfigSpanningTreeTypes_STP_EDGE_PORT_UNSET E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT = 0
+	// OpenconfigSpanningTreeTypes_STP_EDGE_PORT_EDGE_AUTO corresponds to the value EDGE_AUTO of OpenconfigSpanningTreeTypes_STP_EDGE_PORT
+	OpenconfigSpanningTreeTypes_STP_EDGE_PORT_EDGE_AUTO E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT = 1
+	// OpenconfigSpanningTreeTypes_STP_EDGE_PORT_EDGE_DISABLE corresponds to the value EDGE_DISABLE of OpenconfigSpanningTreeTypes_STP_EDGE_PORT
+	OpenconfigSpanningTreeTypes_STP_EDGE_PORT_EDGE_DISABLE E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT = 2
+	// OpenconfigSpanningTreeTypes_STP_EDGE_PORT_EDGE_ENABLE corresponds to the value EDGE_ENABLE of OpenconfigSpanningTreeTypes_STP_EDGE_PORT
+	OpenconfigSpanningTreeTypes_STP_EDGE_PORT_EDGE_ENABLE E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT = 3
+)
+
+// E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE is a derived int64 type which is used to represent
+// the enumerated node OpenconfigSpanningTreeTypes_STP_PORT_ROLE. An additional value named
+// OpenconfigSpanningTreeTypes_STP_PORT_ROLE_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE int64
+
+// IsYANGGoEnum ensures that OpenconfigSpanningTreeTypes_STP_PORT_ROLE implements the yang.GoEnum
+// interface. This ensures that OpenconfigSpanningTreeTypes_STP_PORT_ROLE can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigSpanningTreeTypes_STP_PORT_ROLE.
+func (E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigSpanningTreeTypes_STP_PORT_ROLE_UNSET corresponds to the value UNSET of OpenconfigSpanningTreeTypes_STP_PORT_ROLE
+	OpenconfigSpanningTreeTypes_STP_PORT_ROLE_UNSET E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE = 0
+	// OpenconfigSpanningTreeTypes_STP_PORT_ROLE_ALTERNATE corresponds to the value ALTERNATE of OpenconfigSpanningTreeTypes_STP_PORT_ROLE
+	OpenconfigSpanningTreeTypes_STP_PORT_ROLE_ALTERNATE E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE = 1
+	// OpenconfigSpanningTreeTypes_STP_PORT_ROLE_BACKUP corresponds to the value BACKUP of OpenconfigSpanningTreeTypes_STP_PORT_ROLE
+	OpenconfigSpanningTreeTypes_STP_PORT_ROLE_BACKUP E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE = 2
+	// OpenconfigSpanningTreeTypes_STP_PORT_ROLE_DESIGNATED corresponds to the value DESIGNATED of OpenconfigSpanningTreeTypes_STP_PORT_ROLE
+	OpenconfigSpanningTreeTypes_STP_PORT_ROLE_DESIGNATED E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE = 3
+	// OpenconfigSpanningTreeTypes_STP_PORT_ROLE_ROOT corresponds to the value ROOT of OpenconfigSpanningTreeTypes_STP_PORT_ROLE
+	OpenconfigSpanningTreeTypes_STP_PORT_ROLE_ROOT E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE = 4
+)
+
+// E_OpenconfigSpanningTreeTypes_STP_PORT_STATE is a derived int64 type which is used to represent
+// the enumerated node OpenconfigSpanningTreeTypes_STP_PORT_STATE. An additional value named
+// OpenconfigSpanningTreeTypes_STP_PORT_STATE_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigSpanningTreeTypes_STP_PORT_STATE int64
+
+// IsYANGGoEnum ensures that OpenconfigSpanningTreeTypes_STP_PORT_STATE implements the yang.GoEnum
+// interface. This ensures that OpenconfigSpanningTreeTypes_STP_PORT_STATE can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigSpanningTreeTypes_STP_PORT_STATE) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigSpanningTreeTypes_STP_PORT_STATE.
+func (E_OpenconfigSpanningTreeTypes_STP_PORT_STATE) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigSpanningTreeTypes_STP_PORT_STATE_UNSET corresponds to the value UNSET of OpenconfigSpanningTreeTypes_STP_PORT_STATE
+	OpenconfigSpanningTreeTypes_STP_PORT_STATE_UNSET E_OpenconfigSpanningTreeTypes_STP_PORT_STATE = 0
+	// OpenconfigSpanningTreeTypes_STP_PORT_STATE_BLOCKING corresponds to the value BLOCKING of OpenconfigSpanningTreeTypes_STP_PORT_STATE
+	OpenconfigSpanningTreeTypes_STP_PORT_STATE_BLOCKING E_OpenconfigSpanningTreeTypes_STP_PORT_STATE = 1
+	// OpenconfigSpanningTreeTypes_STP_PORT_STATE_DISABLED corresponds to the value DISABLED of OpenconfigSpanningTreeTypes_STP_PORT_STATE
+	OpenconfigSpanningTreeTypes_STP_PORT_STATE_DISABLED E_OpenconfigSpanningTreeTypes_STP_PORT_STATE = 2
+	// OpenconfigSpanningTreeTypes_STP_PORT_STATE_FORWARDING corresponds to the value FORWARDING of OpenconfigSpanningTreeTypes_STP_PORT_STATE
+	OpenconfigSpanningTreeTypes_STP_PORT_STATE_FORWARDING E_OpenconfigSpanningTreeTypes_STP_PORT_STATE = 3
+	// OpenconfigSpanningTreeTypes_STP_PORT_STATE_LEARNING corresponds to the value LEARNING of OpenconfigSpanningTreeTypes_STP_PORT_STATE
+	OpenconfigSpanningTreeTypes_STP_PORT_STATE_LEARNING E_OpenconfigSpanningTreeTypes_STP_PORT_STATE = 4
+	// OpenconfigSpanningTreeTypes_STP_PORT_STATE_LISTENING corresponds to the value LISTENING of OpenconfigSpanningTreeTypes_STP_PORT_STATE
+	OpenconfigSpanningTreeTypes_STP_PORT_STATE_LISTENING E_OpenconfigSpanningTreeTypes_STP_PORT_STATE = 5
+)
+
+// E_OpenconfigSpanningTreeTypes_STP_PROTOCOL is a derived int64 type which is used to represent
+// the enumerated node OpenconfigSpanningTreeTypes_STP_PROTOCOL. An additional value named
+// OpenconfigSpanningTreeTypes_STP_PROTOCOL_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigSpanningTreeTypes_STP_PROTOCOL int64
+
+// IsYANGGoEnum ensures that OpenconfigSpanningTreeTypes_STP_PROTOCOL implements the yang.GoEnum
+// interface. This ensures that OpenconfigSpanningTreeTypes_STP_PROTOCOL can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigSpanningTreeTypes_STP_PROTOCOL) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigSpanningTreeTypes_STP_PROTOCOL.
+func (E_OpenconfigSpanningTreeTypes_STP_PROTOCOL) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigSpanningTreeTypes_STP_PROTOCOL_UNSET corresponds to the value UNSET of OpenconfigSpanningTreeTypes_STP_PROTOCOL
+	OpenconfigSpanningTreeTypes_STP_PROTOCOL_UNSET E_OpenconfigSpanningTreeTypes_STP_PROTOCOL = 0
+	// OpenconfigSpanningTreeTypes_STP_PROTOCOL_MSTP corresponds to the value MSTP of OpenconfigSpanningTreeTypes_STP_PROTOCOL
+	OpenconfigSpanningTreeTypes_STP_PROTOCOL_MSTP E_OpenconfigSpanningTreeTypes_STP_PROTOCOL = 1
+	// OpenconfigSpanningTreeTypes_STP_PROTOCOL_RAPID_PVST corresponds to the value RAPID_PVST of OpenconfigSpanningTreeTypes_STP_PROTOCOL
+	OpenconfigSpanningTreeTypes_STP_PROTOCOL_RAPID_PVST E_OpenconfigSpanningTreeTypes_STP_PROTOCOL = 2
+	// OpenconfigSpanningTreeTypes_STP_PROTOCOL_RSTP corresponds to the value RSTP of OpenconfigSpanningTreeTypes_STP_PROTOCOL
+	OpenconfigSpanningTreeTypes_STP_PROTOCOL_RSTP E_OpenconfigSpanningTreeTypes_STP_PROTOCOL = 3
+	// OpenconfigSpanningTreeTypes_STP_PROTOCOL_STP corresponds to the value STP of OpenconfigSpanningTreeTypes_STP_PROTOCOL
+	OpenconfigSpanningTreeTypes_STP_PROTOCOL_STP E_OpenconfigSpanningTreeTypes_STP_PROTOCOL = 4
+)
+
+// E_OpenconfigSpanningTree_StpGuardType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigSpanningTree_StpGuardType. An additional value named
+// OpenconfigSpanningTree_StpGuardType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigSpanningTree_StpGuardType int64
+
+// IsYANGGoEnum ensures that OpenconfigSpanningTree_StpGuardType implements the yang.GoEnum
+// interface. This ensures that OpenconfigSpanningTree_StpGuardType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigSpanningTree_StpGuardType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigSpanningTree_StpGuardType.
+func (E_OpenconfigSpanningTree_StpGuardType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigSpanningTree_StpGuardType_UNSET corresponds to the value UNSET of OpenconfigSpanningTree_StpGuardType
+	OpenconfigSpanningTree_StpGuardType_UNSET E_OpenconfigSpanningTree_StpGuardType = 0
+	// OpenconfigSpanningTree_StpGuardType_ROOT corresponds to the value ROOT of OpenconfigSpanningTree_StpGuardType
+	OpenconfigSpanningTree_StpGuardType_ROOT E_OpenconfigSpanningTree_StpGuardType = 1
+	// OpenconfigSpanningTree_StpGuardType_LOOP corresponds to the value LOOP of OpenconfigSpanningTree_StpGuardType
+	OpenconfigSpanningTree_StpGuardType_LOOP E_OpenconfigSpanningTree_StpGuardType = 2
+	// OpenconfigSpanningTree_StpGuardType_NONE corresponds to the value NONE of OpenconfigSpanningTree_StpGuardType
+	OpenconfigSpanningTree_StpGuardType_NONE E_OpenconfigSpanningTree_StpGuardType = 3
+)
+
+// E_OpenconfigSpanningTree_StpLinkType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigSpanningTree_StpLinkType. An additional value named
+// OpenconfigSpanningTree_StpLinkType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigSpanningTree_StpLinkType int64
+
+// IsYANGGoEnum ensures that OpenconfigSpanningTree_StpLinkType implements the yang.GoEnum
+// interface. This ensures that OpenconfigSpanningTree_StpLinkType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigSpanningTree_StpLinkType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigSpanningTree_StpLinkType.
+func (E_OpenconfigSpanningTree_StpLinkType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigSpanningTree_StpLinkType_UNSET corresponds to the value UNSET of OpenconfigSpanningTree_StpLinkType
+	OpenconfigSpanningTree_StpLinkType_UNSET E_OpenconfigSpanningTree_StpLinkType = 0
+	// OpenconfigSpanningTree_StpLinkType_P2P corresponds to the value P2P of OpenconfigSpanningTree_StpLinkType
+	OpenconfigSpanningTree_StpLinkType_P2P E_OpenconfigSpanningTree_StpLinkType = 1
+	// OpenconfigSpanningTree_StpLinkType_SHARED corresponds to the value SHARED of OpenconfigSpanningTree_StpLinkType
+	OpenconfigSpanningTree_StpLinkType_SHARED E_OpenconfigSpanningTree_StpLinkType = 2
+)
+
 // E_OpenconfigSystemLogging_SYSLOG_FACILITY is a derived int64 type which is used to represent
 // the enumerated node OpenconfigSystemLogging_SYSLOG_FACILITY. An additional value named
 // OpenconfigSystemLogging_SYSLOG_FACILITY_UNSET is added to the enumeration which is used as
@@ -3738,13 +7185,397 @@ const (
 	OpenconfigSystem_Server_AssociationType_POOL E_OpenconfigSystem_Server_AssociationType = 3
 )
 
+// E_OpenconfigVlanTypes_TPID_TYPES is a derived int64 type which is used to represent
+// the enumerated node OpenconfigVlanTypes_TPID_TYPES. An additional value named
+// OpenconfigVlanTypes_TPID_TYPES_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigVlanTypes_TPID_TYPES int64
+
+// IsYANGGoEnum ensures that OpenconfigVlanTypes_TPID_TYPES implements the yang.GoEnum
+// interface. This ensures that OpenconfigVlanTypes_TPID_TYPES can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigVlanTypes_TPID_TYPES) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigVlanTypes_TPID_TYPES.
+func (E_OpenconfigVlanTypes_TPID_TYPES) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+const (
+	// OpenconfigVlanTypes_TPID_TYPES_UNSET corresponds to the value UNSET of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_UNSET E_OpenconfigVlanTypes_TPID_TYPES = 0
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X8100 corresponds to the value TPID_0X8100 of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_0X8100 E_OpenconfigVlanTypes_TPID_TYPES = 1
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X88A8 corresponds to the value TPID_0X88A8 of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_0X88A8 E_OpenconfigVlanTypes_TPID_TYPES = 2
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X9100 corresponds to the value TPID_0X9100 of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_0X9100 E_OpenconfigVlanTypes_TPID_TYPES = 3
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X9200 corresponds to the value TPID_0X9200 of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_0X9200 E_OpenconfigVlanTypes_TPID_TYPES = 4
+)
+
+// E_OpenconfigVlan_VlanModeType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigVlan_VlanModeType. An additional value named
+// OpenconfigVlan_VlanModeType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigVlan_VlanModeType int64
+
+// IsYANGGoEnum ensures that OpenconfigVlan_VlanModeType implements the yang.GoEnum
+// interface. This ensures that OpenconfigVlan_VlanModeType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigVlan_VlanModeType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigVlan_VlanModeType.
+func (E_OpenconfigVlan_VlanModeType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+const (
+	// OpenconfigVlan_VlanModeType_UNSET corresponds to the value UNSET of OpenconfigVlan_VlanModeType
+	OpenconfigVlan_VlanModeType_UNSET E_OpenconfigVlan_VlanModeType = 0
+	// OpenconfigVlan_VlanModeType_ACCESS corresponds to the value ACCESS of OpenconfigVlan_VlanModeType
+	OpenconfigVlan_VlanModeType_ACCESS E_OpenconfigVlan_VlanModeType = 1
+	// OpenconfigVlan_VlanModeType_TRUNK corresponds to the value TRUNK of OpenconfigVlan_VlanModeType
+	OpenconfigVlan_VlanModeType_TRUNK E_OpenconfigVlan_VlanModeType = 2
+)
+
+// E_OvsSystem_Ovs_Role is a derived int64 type which is used to represent
+// the enumerated node OvsSystem_Ovs_Role. An additional value named
+// OvsSystem_Ovs_Role_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OvsSystem_Ovs_Role int64
+
+// IsYANGGoEnum ensures that OvsSystem_Ovs_Role implements the yang.GoEnum
+// interface. This ensures that OvsSystem_Ovs_Role can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OvsSystem_Ovs_Role) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OvsSystem_Ovs_Role.
+func (E_OvsSystem_Ovs_Role) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+const (
+	// OvsSystem_Ovs_Role_UNSET corresponds to the value UNSET of OvsSystem_Ovs_Role
+	OvsSystem_Ovs_Role_UNSET E_OvsSystem_Ovs_Role = 0
+	// OvsSystem_Ovs_Role_EQUAL corresponds to the value EQUAL of OvsSystem_Ovs_Role
+	OvsSystem_Ovs_Role_EQUAL E_OvsSystem_Ovs_Role = 1
+	// OvsSystem_Ovs_Role_MASTER corresponds to the value MASTER of OvsSystem_Ovs_Role
+	OvsSystem_Ovs_Role_MASTER E_OvsSystem_Ovs_Role = 2
+	// OvsSystem_Ovs_Role_SLAVE corresponds to the value SLAVE of OvsSystem_Ovs_Role
+	OvsSystem_Ovs_Role_SLAVE E_OvsSystem_Ovs_Role = 3
+)
+
+// E_OvsSystem_Ovs_State is a derived int64 type which is used to represent
+// the enumerated node OvsSystem_Ovs_State. An additional value named
+// OvsSystem_Ovs_State_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OvsSystem_Ovs_State int64
+
+// IsYANGGoEnum ensures that OvsSystem_Ovs_State implements the yang.GoEnum
+// interface. This ensures that OvsSystem_Ovs_State can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OvsSystem_Ovs_State) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OvsSystem_Ovs_State.
+func (E_OvsSystem_Ovs_State) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+const (
+	// OvsSystem_Ovs_State_UNSET corresponds to the value UNSET of OvsSystem_Ovs_State
+	OvsSystem_Ovs_State_UNSET E_OvsSystem_Ovs_State = 0
+	// OvsSystem_Ovs_State_VOID corresponds to the value VOID of OvsSystem_Ovs_State
+	OvsSystem_Ovs_State_VOID E_OvsSystem_Ovs_State = 1
+	// OvsSystem_Ovs_State_BACKOFF corresponds to the value BACKOFF of OvsSystem_Ovs_State
+	OvsSystem_Ovs_State_BACKOFF E_OvsSystem_Ovs_State = 2
+	// OvsSystem_Ovs_State_CONNECTING corresponds to the value CONNECTING of OvsSystem_Ovs_State
+	OvsSystem_Ovs_State_CONNECTING E_OvsSystem_Ovs_State = 3
+	// OvsSystem_Ovs_State_ACTIVE corresponds to the value ACTIVE of OvsSystem_Ovs_State
+	OvsSystem_Ovs_State_ACTIVE E_OvsSystem_Ovs_State = 4
+	// OvsSystem_Ovs_State_IDLE corresponds to the value IDLE of OvsSystem_Ovs_State
+	OvsSystem_Ovs_State_IDLE E_OvsSystem_Ovs_State = 5
+)
+
 // ΛEnum is a map, keyed by the name of the type defined for each enum in the
 // generated Go code, which provides a mapping between the constant int64 value
 // of each value of the enumeration, and the string that is used to represent it
 // in the YANG schema. The map is named ΛEnum in order to avoid clash with any
 // valid YANG identifier.
 var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
-	"E_IETFInterfaces_InterfaceType": {},
+	"E_IETFInterfaces_InterfaceType": {
+		1:   {Name: "a12MppSwitch", DefiningModule: "iana-if-type"},
+		2:   {Name: "aal2", DefiningModule: "iana-if-type"},
+		3:   {Name: "aal5", DefiningModule: "iana-if-type"},
+		4:   {Name: "actelisMetaLOOP", DefiningModule: "iana-if-type"},
+		5:   {Name: "adsl", DefiningModule: "iana-if-type"},
+		6:   {Name: "adsl2", DefiningModule: "iana-if-type"},
+		7:   {Name: "adsl2plus", DefiningModule: "iana-if-type"},
+		8:   {Name: "aflane8023", DefiningModule: "iana-if-type"},
+		9:   {Name: "aflane8025", DefiningModule: "iana-if-type"},
+		10:  {Name: "aluELP", DefiningModule: "iana-if-type"},
+		11:  {Name: "aluEpon", DefiningModule: "iana-if-type"},
+		12:  {Name: "aluEponLogicalLink", DefiningModule: "iana-if-type"},
+		13:  {Name: "aluEponOnu", DefiningModule: "iana-if-type"},
+		14:  {Name: "aluEponPhysicalUni", DefiningModule: "iana-if-type"},
+		15:  {Name: "aluGponOnu", DefiningModule: "iana-if-type"},
+		16:  {Name: "aluGponPhysicalUni", DefiningModule: "iana-if-type"},
+		17:  {Name: "arap", DefiningModule: "iana-if-type"},
+		18:  {Name: "arcnet", DefiningModule: "iana-if-type"},
+		19:  {Name: "arcnetPlus", DefiningModule: "iana-if-type"},
+		20:  {Name: "async", DefiningModule: "iana-if-type"},
+		21:  {Name: "atm", DefiningModule: "iana-if-type"},
+		22:  {Name: "atmDxi", DefiningModule: "iana-if-type"},
+		23:  {Name: "atmFuni", DefiningModule: "iana-if-type"},
+		24:  {Name: "atmIma", DefiningModule: "iana-if-type"},
+		25:  {Name: "atmLogical", DefiningModule: "iana-if-type"},
+		26:  {Name: "atmRadio", DefiningModule: "iana-if-type"},
+		27:  {Name: "atmSubInterface", DefiningModule: "iana-if-type"},
+		28:  {Name: "atmVciEndPt", DefiningModule: "iana-if-type"},
+		29:  {Name: "atmVirtual", DefiningModule: "iana-if-type"},
+		30:  {Name: "atmbond", DefiningModule: "iana-if-type"},
+		31:  {Name: "aviciOpticalEther", DefiningModule: "iana-if-type"},
+		32:  {Name: "basicISDN", DefiningModule: "iana-if-type"},
+		33:  {Name: "bgppolicyaccounting", DefiningModule: "iana-if-type"},
+		34:  {Name: "bits", DefiningModule: "iana-if-type"},
+		35:  {Name: "bridge", DefiningModule: "iana-if-type"},
+		36:  {Name: "bsc", DefiningModule: "iana-if-type"},
+		37:  {Name: "cableDownstreamRfPort", DefiningModule: "iana-if-type"},
+		38:  {Name: "capwapDot11Bss", DefiningModule: "iana-if-type"},
+		39:  {Name: "capwapDot11Profile", DefiningModule: "iana-if-type"},
+		40:  {Name: "capwapWtpVirtualRadio", DefiningModule: "iana-if-type"},
+		41:  {Name: "cblVectaStar", DefiningModule: "iana-if-type"},
+		42:  {Name: "cctEmul", DefiningModule: "iana-if-type"},
+		43:  {Name: "ces", DefiningModule: "iana-if-type"},
+		44:  {Name: "channel", DefiningModule: "iana-if-type"},
+		45:  {Name: "ciscoISLvlan", DefiningModule: "iana-if-type"},
+		46:  {Name: "cnr", DefiningModule: "iana-if-type"},
+		47:  {Name: "coffee", DefiningModule: "iana-if-type"},
+		48:  {Name: "compositeLink", DefiningModule: "iana-if-type"},
+		49:  {Name: "dcn", DefiningModule: "iana-if-type"},
+		50:  {Name: "ddnX25", DefiningModule: "iana-if-type"},
+		51:  {Name: "digitalPowerline", DefiningModule: "iana-if-type"},
+		52:  {Name: "digitalWrapperOverheadChannel", DefiningModule: "iana-if-type"},
+		53:  {Name: "dlsw", DefiningModule: "iana-if-type"},
+		54:  {Name: "docsCableDownstream", DefiningModule: "iana-if-type"},
+		55:  {Name: "docsCableMCmtsDownstream", DefiningModule: "iana-if-type"},
+		56:  {Name: "docsCableMaclayer", DefiningModule: "iana-if-type"},
+		57:  {Name: "docsCableUpstream", DefiningModule: "iana-if-type"},
+		58:  {Name: "docsCableUpstreamChannel", DefiningModule: "iana-if-type"},
+		59:  {Name: "docsCableUpstreamRfPort", DefiningModule: "iana-if-type"},
+		60:  {Name: "ds0", DefiningModule: "iana-if-type"},
+		61:  {Name: "ds0Bundle", DefiningModule: "iana-if-type"},
+		62:  {Name: "ds1", DefiningModule: "iana-if-type"},
+		63:  {Name: "ds1FDL", DefiningModule: "iana-if-type"},
+		64:  {Name: "ds3", DefiningModule: "iana-if-type"},
+		65:  {Name: "dtm", DefiningModule: "iana-if-type"},
+		66:  {Name: "dvbAsiIn", DefiningModule: "iana-if-type"},
+		67:  {Name: "dvbAsiOut", DefiningModule: "iana-if-type"},
+		68:  {Name: "dvbRccDownstream", DefiningModule: "iana-if-type"},
+		69:  {Name: "dvbRccMacLayer", DefiningModule: "iana-if-type"},
+		70:  {Name: "dvbRccUpstream", DefiningModule: "iana-if-type"},
+		71:  {Name: "dvbRcsMacLayer", DefiningModule: "iana-if-type"},
+		72:  {Name: "dvbRcsTdma", DefiningModule: "iana-if-type"},
+		73:  {Name: "dvbTdm", DefiningModule: "iana-if-type"},
+		74:  {Name: "e1", DefiningModule: "iana-if-type"},
+		75:  {Name: "econet", DefiningModule: "iana-if-type"},
+		76:  {Name: "eon", DefiningModule: "iana-if-type"},
+		77:  {Name: "eplrs", DefiningModule: "iana-if-type"},
+		78:  {Name: "escon", DefiningModule: "iana-if-type"},
+		79:  {Name: "ethernet3Mbit", DefiningModule: "iana-if-type"},
+		80:  {Name: "ethernetCsmacd", DefiningModule: "iana-if-type"},
+		81:  {Name: "fast", DefiningModule: "iana-if-type"},
+		82:  {Name: "fastEther", DefiningModule: "iana-if-type"},
+		83:  {Name: "fastEtherFX", DefiningModule: "iana-if-type"},
+		84:  {Name: "fcipLink", DefiningModule: "iana-if-type"},
+		85:  {Name: "fddi", DefiningModule: "iana-if-type"},
+		86:  {Name: "fibreChannel", DefiningModule: "iana-if-type"},
+		87:  {Name: "frDlciEndPt", DefiningModule: "iana-if-type"},
+		88:  {Name: "frForward", DefiningModule: "iana-if-type"},
+		89:  {Name: "frameRelay", DefiningModule: "iana-if-type"},
+		90:  {Name: "frameRelayInterconnect", DefiningModule: "iana-if-type"},
+		91:  {Name: "frameRelayMPI", DefiningModule: "iana-if-type"},
+		92:  {Name: "frameRelayService", DefiningModule: "iana-if-type"},
+		93:  {Name: "frf16MfrBundle", DefiningModule: "iana-if-type"},
+		94:  {Name: "g703at2mb", DefiningModule: "iana-if-type"},
+		95:  {Name: "g703at64k", DefiningModule: "iana-if-type"},
+		96:  {Name: "g9981", DefiningModule: "iana-if-type"},
+		97:  {Name: "g9982", DefiningModule: "iana-if-type"},
+		98:  {Name: "g9983", DefiningModule: "iana-if-type"},
+		99:  {Name: "gfp", DefiningModule: "iana-if-type"},
+		100: {Name: "gigabitEthernet", DefiningModule: "iana-if-type"},
+		101: {Name: "gpon", DefiningModule: "iana-if-type"},
+		102: {Name: "gr303IDT", DefiningModule: "iana-if-type"},
+		103: {Name: "gr303RDT", DefiningModule: "iana-if-type"},
+		104: {Name: "gtp", DefiningModule: "iana-if-type"},
+		105: {Name: "h323Gatekeeper", DefiningModule: "iana-if-type"},
+		106: {Name: "h323Proxy", DefiningModule: "iana-if-type"},
+		107: {Name: "hdh1822", DefiningModule: "iana-if-type"},
+		108: {Name: "hdlc", DefiningModule: "iana-if-type"},
+		109: {Name: "hdsl2", DefiningModule: "iana-if-type"},
+		110: {Name: "hiperlan2", DefiningModule: "iana-if-type"},
+		111: {Name: "hippi", DefiningModule: "iana-if-type"},
+		112: {Name: "hippiInterface", DefiningModule: "iana-if-type"},
+		113: {Name: "homepna", DefiningModule: "iana-if-type"},
+		114: {Name: "hostPad", DefiningModule: "iana-if-type"},
+		115: {Name: "hssi", DefiningModule: "iana-if-type"},
+		116: {Name: "hyperchannel", DefiningModule: "iana-if-type"},
+		117: {Name: "iana-interface-type", DefiningModule: "iana-if-type"},
+		118: {Name: "ibm370parChan", DefiningModule: "iana-if-type"},
+		119: {Name: "idsl", DefiningModule: "iana-if-type"},
+		120: {Name: "ieee1394", DefiningModule: "iana-if-type"},
+		121: {Name: "ieee80211", DefiningModule: "iana-if-type"},
+		122: {Name: "ieee80212", DefiningModule: "iana-if-type"},
+		123: {Name: "ieee802154", DefiningModule: "iana-if-type"},
+		124: {Name: "ieee80216WMAN", DefiningModule: "iana-if-type"},
+		125: {Name: "ieee8023adLag", DefiningModule: "iana-if-type"},
+		126: {Name: "if-gsn", DefiningModule: "iana-if-type"},
+		127: {Name: "ifPwType", DefiningModule: "iana-if-type"},
+		128: {Name: "ifVfiType", DefiningModule: "iana-if-type"},
+		129: {Name: "ilan", DefiningModule: "iana-if-type"},
+		130: {Name: "imt", DefiningModule: "iana-if-type"},
+		131: {Name: "infiniband", DefiningModule: "iana-if-type"},
+		132: {Name: "interleave", DefiningModule: "iana-if-type"},
+		133: {Name: "ip", DefiningModule: "iana-if-type"},
+		134: {Name: "ipForward", DefiningModule: "iana-if-type"},
+		135: {Name: "ipOverAtm", DefiningModule: "iana-if-type"},
+		136: {Name: "ipOverCdlc", DefiningModule: "iana-if-type"},
+		137: {Name: "ipOverClaw", DefiningModule: "iana-if-type"},
+		138: {Name: "ipSwitch", DefiningModule: "iana-if-type"},
+		139: {Name: "isdn", DefiningModule: "iana-if-type"},
+		140: {Name: "isdns", DefiningModule: "iana-if-type"},
+		141: {Name: "isdnu", DefiningModule: "iana-if-type"},
+		142: {Name: "iso88022llc", DefiningModule: "iana-if-type"},
+		143: {Name: "iso88023Csmacd", DefiningModule: "iana-if-type"},
+		144: {Name: "iso88024TokenBus", DefiningModule: "iana-if-type"},
+		145: {Name: "iso88025CRFPInt", DefiningModule: "iana-if-type"},
+		146: {Name: "iso88025Dtr", DefiningModule: "iana-if-type"},
+		147: {Name: "iso88025Fiber", DefiningModule: "iana-if-type"},
+		148: {Name: "iso88025TokenRing", DefiningModule: "iana-if-type"},
+		149: {Name: "iso88026Man", DefiningModule: "iana-if-type"},
+		150: {Name: "isup", DefiningModule: "iana-if-type"},
+		151: {Name: "l2vlan", DefiningModule: "iana-if-type"},
+		152: {Name: "l3ipvlan", DefiningModule: "iana-if-type"},
+		153: {Name: "l3ipxvlan", DefiningModule: "iana-if-type"},
+		154: {Name: "lapb", DefiningModule: "iana-if-type"},
+		155: {Name: "lapd", DefiningModule: "iana-if-type"},
+		156: {Name: "lapf", DefiningModule: "iana-if-type"},
+		157: {Name: "linegroup", DefiningModule: "iana-if-type"},
+		158: {Name: "lmp", DefiningModule: "iana-if-type"},
+		159: {Name: "localTalk", DefiningModule: "iana-if-type"},
+		160: {Name: "macSecControlledIF", DefiningModule: "iana-if-type"},
+		161: {Name: "macSecUncontrolledIF", DefiningModule: "iana-if-type"},
+		162: {Name: "mediaMailOverIp", DefiningModule: "iana-if-type"},
+		163: {Name: "mfSigLink", DefiningModule: "iana-if-type"},
+		164: {Name: "miox25", DefiningModule: "iana-if-type"},
+		165: {Name: "mocaVersion1", DefiningModule: "iana-if-type"},
+		166: {Name: "modem", DefiningModule: "iana-if-type"},
+		167: {Name: "mpc", DefiningModule: "iana-if-type"},
+		168: {Name: "mpegTransport", DefiningModule: "iana-if-type"},
+		169: {Name: "mpls", DefiningModule: "iana-if-type"},
+		170: {Name: "mplsTunnel", DefiningModule: "iana-if-type"},
+		171: {Name: "msdsl", DefiningModule: "iana-if-type"},
+		172: {Name: "mvl", DefiningModule: "iana-if-type"},
+		173: {Name: "myrinet", DefiningModule: "iana-if-type"},
+		174: {Name: "nfas", DefiningModule: "iana-if-type"},
+		175: {Name: "nsip", DefiningModule: "iana-if-type"},
+		176: {Name: "opticalChannel", DefiningModule: "iana-if-type"},
+		177: {Name: "opticalChannelGroup", DefiningModule: "iana-if-type"},
+		178: {Name: "opticalTransport", DefiningModule: "iana-if-type"},
+		179: {Name: "other", DefiningModule: "iana-if-type"},
+		180: {Name: "otnOdu", DefiningModule: "iana-if-type"},
+		181: {Name: "otnOtu", DefiningModule: "iana-if-type"},
+		182: {Name: "para", DefiningModule: "iana-if-type"},
+		183: {Name: "pdnEtherLoop1", DefiningModule: "iana-if-type"},
+		184: {Name: "pdnEtherLoop2", DefiningModule: "iana-if-type"},
+		185: {Name: "pip", DefiningModule: "iana-if-type"},
+		186: {Name: "plc", DefiningModule: "iana-if-type"},
+		187: {Name: "pon155", DefiningModule: "iana-if-type"},
+		188: {Name: "pon622", DefiningModule: "iana-if-type"},
+		189: {Name: "pos", DefiningModule: "iana-if-type"},
+		190: {Name: "ppp", DefiningModule: "iana-if-type"},
+		191: {Name: "pppMultilinkBundle", DefiningModule: "iana-if-type"},
+		192: {Name: "primaryISDN", DefiningModule: "iana-if-type"},
+		193: {Name: "propAtm", DefiningModule: "iana-if-type"},
+		194: {Name: "propBWAp2Mp", DefiningModule: "iana-if-type"},
+		195: {Name: "propCnls", DefiningModule: "iana-if-type"},
+		196: {Name: "propDocsWirelessDownstream", DefiningModule: "iana-if-type"},
+		197: {Name: "propDocsWirelessMaclayer", DefiningModule: "iana-if-type"},
+		198: {Name: "propDocsWirelessUpstream", DefiningModule: "iana-if-type"},
+		199: {Name: "propMultiplexor", DefiningModule: "iana-if-type"},
+		200: {Name: "propPointToPointSerial", DefiningModule: "iana-if-type"},
+		201: {Name: "propVirtual", DefiningModule: "iana-if-type"},
+		202: {Name: "propWirelessP2P", DefiningModule: "iana-if-type"},
+		203: {Name: "proteon10Mbit", DefiningModule: "iana-if-type"},
+		204: {Name: "proteon80Mbit", DefiningModule: "iana-if-type"},
+		205: {Name: "q2931", DefiningModule: "iana-if-type"},
+		206: {Name: "qam", DefiningModule: "iana-if-type"},
+		207: {Name: "qllc", DefiningModule: "iana-if-type"},
+		208: {Name: "radioMAC", DefiningModule: "iana-if-type"},
+		209: {Name: "radsl", DefiningModule: "iana-if-type"},
+		210: {Name: "reachDSL", DefiningModule: "iana-if-type"},
+		211: {Name: "regular1822", DefiningModule: "iana-if-type"},
+		212: {Name: "rfc1483", DefiningModule: "iana-if-type"},
+		213: {Name: "rfc877x25", DefiningModule: "iana-if-type"},
+		214: {Name: "rpr", DefiningModule: "iana-if-type"},
+		215: {Name: "rs232", DefiningModule: "iana-if-type"},
+		216: {Name: "rsrb", DefiningModule: "iana-if-type"},
+		217: {Name: "sdlc", DefiningModule: "iana-if-type"},
+		218: {Name: "sdsl", DefiningModule: "iana-if-type"},
+		219: {Name: "shdsl", DefiningModule: "iana-if-type"},
+		220: {Name: "sip", DefiningModule: "iana-if-type"},
+		221: {Name: "sipSig", DefiningModule: "iana-if-type"},
+		222: {Name: "sipTg", DefiningModule: "iana-if-type"},
+		223: {Name: "sixToFour", DefiningModule: "iana-if-type"},
+		224: {Name: "slip", DefiningModule: "iana-if-type"},
+		225: {Name: "smdsDxi", DefiningModule: "iana-if-type"},
+		226: {Name: "smdsIcip", DefiningModule: "iana-if-type"},
+		227: {Name: "softwareLoopback", DefiningModule: "iana-if-type"},
+		228: {Name: "sonet", DefiningModule: "iana-if-type"},
+		229: {Name: "sonetOverheadChannel", DefiningModule: "iana-if-type"},
+		230: {Name: "sonetPath", DefiningModule: "iana-if-type"},
+		231: {Name: "sonetVT", DefiningModule: "iana-if-type"},
+		232: {Name: "srp", DefiningModule: "iana-if-type"},
+		233: {Name: "ss7SigLink", DefiningModule: "iana-if-type"},
+		234: {Name: "stackToStack", DefiningModule: "iana-if-type"},
+		235: {Name: "starLan", DefiningModule: "iana-if-type"},
+		236: {Name: "tdlc", DefiningModule: "iana-if-type"},
+		237: {Name: "teLink", DefiningModule: "iana-if-type"},
+		238: {Name: "termPad", DefiningModule: "iana-if-type"},
+		239: {Name: "tr008", DefiningModule: "iana-if-type"},
+		240: {Name: "transpHdlc", DefiningModule: "iana-if-type"},
+		241: {Name: "tunnel", DefiningModule: "iana-if-type"},
+		242: {Name: "ultra", DefiningModule: "iana-if-type"},
+		243: {Name: "usb", DefiningModule: "iana-if-type"},
+		244: {Name: "v11", DefiningModule: "iana-if-type"},
+		245: {Name: "v35", DefiningModule: "iana-if-type"},
+		246: {Name: "v36", DefiningModule: "iana-if-type"},
+		247: {Name: "v37", DefiningModule: "iana-if-type"},
+		248: {Name: "vdsl", DefiningModule: "iana-if-type"},
+		249: {Name: "vdsl2", DefiningModule: "iana-if-type"},
+		250: {Name: "virtualIpAddress", DefiningModule: "iana-if-type"},
+		251: {Name: "virtualTg", DefiningModule: "iana-if-type"},
+		252: {Name: "vmwareNicTeam", DefiningModule: "iana-if-type"},
+		253: {Name: "vmwareVirtualNic", DefiningModule: "iana-if-type"},
+		254: {Name: "voiceDID", DefiningModule: "iana-if-type"},
+		255: {Name: "voiceEBS", DefiningModule: "iana-if-type"},
+		256: {Name: "voiceEM", DefiningModule: "iana-if-type"},
+		257: {Name: "voiceEMFGD", DefiningModule: "iana-if-type"},
+		258: {Name: "voiceEncap", DefiningModule: "iana-if-type"},
+		259: {Name: "voiceFGDEANA", DefiningModule: "iana-if-type"},
+		260: {Name: "voiceFGDOS", DefiningModule: "iana-if-type"},
+		261: {Name: "voiceFXO", DefiningModule: "iana-if-type"},
+		262: {Name: "voiceFXS", DefiningModule: "iana-if-type"},
+		263: {Name: "voiceOverAtm", DefiningModule: "iana-if-type"},
+		264: {Name: "voiceOverCable", DefiningModule: "iana-if-type"},
+		265: {Name: "voiceOverFrameRelay", DefiningModule: "iana-if-type"},
+		266: {Name: "voiceOverIp", DefiningModule: "iana-if-type"},
+		267: {Name: "wwanPP", DefiningModule: "iana-if-type"},
+		268: {Name: "wwanPP2", DefiningModule: "iana-if-type"},
+		269: {Name: "x213", DefiningModule: "iana-if-type"},
+		270: {Name: "x25huntGroup", DefiningModule: "iana-if-type"},
+		271: {Name: "x25mlp", DefiningModule: "iana-if-type"},
+		272: {Name: "x25ple", DefiningModule: "iana-if-type"},
+		273: {Name: "x86Laps", DefiningModule: "iana-if-type"},
+	},
 	"E_OpenconfigAaaTypes_AAA_ACCOUNTING_EVENT_TYPE": {
 		1: {Name: "AAA_ACCOUNTING_EVENT_COMMAND", DefiningModule: "openconfig-aaa-types"},
 		2: {Name: "AAA_ACCOUNTING_EVENT_LOGIN", DefiningModule: "openconfig-aaa-types"},
@@ -3782,6 +7613,31 @@ var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
 		3: {Name: "LOS", DefiningModule: "openconfig-alarm-types"},
 		4: {Name: "OTS", DefiningModule: "openconfig-alarm-types"},
 	},
+	"E_OpenconfigIfAggregate_AggregationType": {
+		1: {Name: "LACP"},
+		2: {Name: "STATIC"},
+	},
+	"E_OpenconfigIfEthernet_ETHERNET_SPEED": {
+		1:  {Name: "SPEED_100GB", DefiningModule: "openconfig-if-ethernet"},
+		2:  {Name: "SPEED_100MB", DefiningModule: "openconfig-if-ethernet"},
+		3:  {Name: "SPEED_10GB", DefiningModule: "openconfig-if-ethernet"},
+		4:  {Name: "SPEED_10MB", DefiningModule: "openconfig-if-ethernet"},
+		5:  {Name: "SPEED_1GB", DefiningModule: "openconfig-if-ethernet"},
+		6:  {Name: "SPEED_2500MB", DefiningModule: "openconfig-if-ethernet"},
+		7:  {Name: "SPEED_25GB", DefiningModule: "openconfig-if-ethernet"},
+		8:  {Name: "SPEED_40GB", DefiningModule: "openconfig-if-ethernet"},
+		9:  {Name: "SPEED_50GB", DefiningModule: "openconfig-if-ethernet"},
+		10: {Name: "SPEED_5GB", DefiningModule: "openconfig-if-ethernet"},
+		11: {Name: "SPEED_UNKNOWN", DefiningModule: "openconfig-if-ethernet"},
+	},
+	"E_OpenconfigIfEthernet_Ethernet_DuplexMode": {
+		1: {Name: "FULL"},
+		2: {Name: "HALF"},
+	},
+	"E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode": {
+		1: {Name: "FULL"},
+		2: {Name: "HALF"},
+	},
 	"E_OpenconfigInterfaces_Interface_AdminStatus": {
 		1: {Name: "UP"},
 		2: {Name: "DOWN"},
@@ -3796,6 +7652,62 @@ var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
 		7: {Name: "NOT_PRESENT"},
 		8: {Name: "LOWER_LAYER_DOWN"},
 	},
+	"E_OpenconfigLacp_LacpActivityType": {
+		1: {Name: "ACTIVE"},
+		2: {Name: "PASSIVE"},
+	},
+	"E_OpenconfigLacp_LacpPeriodType": {
+		1: {Name: "FAST"},
+		2: {Name: "SLOW"},
+	},
+	"E_OpenconfigLacp_LacpSynchronizationType": {
+		1: {Name: "IN_SYNC"},
+		2: {Name: "OUT_SYNC"},
+	},
+	"E_OpenconfigLacp_LacpTimeoutType": {
+		1: {Name: "LONG"},
+		2: {Name: "SHORT"},
+	},
+	"E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY": {
+		1:  {Name: "C_VLAN", DefiningModule: "openconfig-lldp-types"},
+		2:  {Name: "DOCSIS_CABLE_DEVICE", DefiningModule: "openconfig-lldp-types"},
+		3:  {Name: "MAC_BRIDGE", DefiningModule: "openconfig-lldp-types"},
+		4:  {Name: "OTHER", DefiningModule: "openconfig-lldp-types"},
+		5:  {Name: "REPEATER", DefiningModule: "openconfig-lldp-types"},
+		6:  {Name: "ROUTER", DefiningModule: "openconfig-lldp-types"},
+		7:  {Name: "STATION_ONLY", DefiningModule: "openconfig-lldp-types"},
+		8:  {Name: "S_VLAN", DefiningModule: "openconfig-lldp-types"},
+		9:  {Name: "TELEPHONE", DefiningModule: "openconfig-lldp-types"},
+		10: {Name: "TWO_PORT_MAC_RELAY", DefiningModule: "openconfig-lldp-types"},
+		11: {Name: "WLAN_ACCESS_POINT", DefiningModule: "openconfig-lldp-types"},
+	},
+	"E_OpenconfigLldpTypes_LLDP_TLV": {
+		1: {Name: "CHASSIS_ID", DefiningModule: "openconfig-lldp-types"},
+		2: {Name: "MANAGEMENT_ADDRESS", DefiningModule: "openconfig-lldp-types"},
+		3: {Name: "PORT_DESCRIPTION", DefiningModule: "openconfig-lldp-types"},
+		4: {Name: "PORT_ID", DefiningModule: "openconfig-lldp-types"},
+		5: {Name: "SYSTEM_CAPABILITIES", DefiningModule: "openconfig-lldp-types"},
+		6: {Name: "SYSTEM_DESCRIPTION", DefiningModule: "openconfig-lldp-types"},
+		7: {Name: "SYSTEM_NAME", DefiningModule: "openconfig-lldp-types"},
+	},
+	"E_OpenconfigLldp_ChassisIdType": {
+		1: {Name: "CHASSIS_COMPONENT"},
+		2: {Name: "INTERFACE_ALIAS"},
+		3: {Name: "PORT_COMPONENT"},
+		4: {Name: "MAC_ADDRESS"},
+		5: {Name: "NETWORK_ADDRESS"},
+		6: {Name: "INTERFACE_NAME"},
+		7: {Name: "LOCAL"},
+	},
+	"E_OpenconfigLldp_PortIdType": {
+		1: {Name: "INTERFACE_ALIAS"},
+		2: {Name: "PORT_COMPONENT"},
+		3: {Name: "MAC_ADDRESS"},
+		4: {Name: "NETWORK_ADDRESS"},
+		5: {Name: "INTERFACE_NAME"},
+		6: {Name: "AGENT_CIRCUIT_ID"},
+		7: {Name: "LOCAL"},
+	},
 	"E_OpenconfigOpenflow_FailureMode": {
 		1: {Name: "SECURE"},
 		2: {Name: "STANDALONE"},
@@ -3828,6 +7740,39 @@ var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
 	"E_OpenconfigPlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT": {
 		1: {Name: "OPERATING_SYSTEM", DefiningModule: "openconfig-platform-types"},
 	},
+	"E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT": {
+		1: {Name: "EDGE_AUTO", DefiningModule: "openconfig-spanning-tree-types"},
+		2: {Name: "EDGE_DISABLE", DefiningModule: "openconfig-spanning-tree-types"},
+		3: {Name: "EDGE_ENABLE", DefiningModule: "openconfig-spanning-tree-types"},
+	},
+	"E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE": {
+		1: {Name: "ALTERNATE", DefiningModule: "openconfig-spanning-tree-types"},
+		2: {Name: "BACKUP", DefiningModule: "openconfig-spanning-tree-types"},
+		3: {Name: "DESIGNATED", DefiningModule: "openconfig-spanning-tree-types"},
+		4: {Name: "ROOT", DefiningModule: "openconfig-spanning-tree-types"},
+	},
+	"E_OpenconfigSpanningTreeTypes_STP_PORT_STATE": {
+		1: {Name: "BLOCKING", DefiningModule: "openconfig-spanning-tree-types"},
+		2: {Name: "DISABLED", DefiningModule: "openconfig-spanning-tree-types"},
+		3: {Name: "FORWARDING", DefiningModule: "openconfig-spanning-tree-types"},
+		4: {Name: "LEARNING", DefiningModule: "openconfig-spanning-tree-types"},
+		5: {Name: "LISTENING", DefiningModule: "openconfig-spanning-tree-types"},
+	},
+	"E_OpenconfigSpanningTreeTypes_STP_PROTOCOL": {
+		1: {Name: "MSTP", DefiningModule: "openconfig-spanning-tree-types"},
+		2: {Name: "RAPID_PVST", DefiningModule: "openconfig-spanning-tree-types"},
+		3: {Name: "RSTP", DefiningModule: "openconfig-spanning-tree-types"},
+		4: {Name: "STP", DefiningModule: "ovs-stp"},
+	},
+	"E_OpenconfigSpanningTree_StpGuardType": {
+		1: {Name: "ROOT"},
+		2: {Name: "LOOP"},
+		3: {Name: "NONE"},
+	},
+	"E_OpenconfigSpanningTree_StpLinkType": {
+		1: {Name: "P2P"},
+		2: {Name: "SHARED"},
+	},
 	"E_OpenconfigSystemLogging_SYSLOG_FACILITY": {
 		1:  {Name: "ALL", DefiningModule: "openconfig-system-logging"},
 		2:  {Name: "AUDIT", DefiningModule: "openconfig-system-logging"},
@@ -3878,6 +7823,28 @@ var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
 		2: {Name: "PEER"},
 		3: {Name: "POOL"},
 	},
+	"E_OpenconfigVlanTypes_TPID_TYPES": {
+		1: {Name: "TPID_0X8100", DefiningModule: "openconfig-vlan-types"},
+		2: {Name: "TPID_0X88A8", DefiningModule: "openconfig-vlan-types"},
+		3: {Name: "TPID_0X9100", DefiningModule: "openconfig-vlan-types"},
+		4: {Name: "TPID_0X9200", DefiningModule: "openconfig-vlan-types"},
+	},
+	"E_OpenconfigVlan_VlanModeType": {
+		1: {Name: "ACCESS"},
+		2: {Name: "TRUNK"},
+	},
+	"E_OvsSystem_Ovs_Role": {
+		1: {Name: "EQUAL"},
+		2: {Name: "MASTER"},
+		3: {Name: "SLAVE"},
+	},
+	"E_OvsSystem_Ovs_State": {
+		1: {Name: "VOID"},
+		2: {Name: "BACKOFF"},
+		3: {Name: "CONNECTING"},
+		4: {Name: "ACTIVE"},
+		5: {Name: "IDLE"},
+	},
 }
 
 var (
@@ -3888,1562 +7855,3901 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xfd, 0x6b, 0x73, 0xda, 0xc8,
-		0xf6, 0x36, 0x0e, 0xbf, 0xf7, 0xa7, 0xa0, 0xa8, 0xfd, 0xc2, 0xde, 0xb7, 0x65, 0x03, 0x06, 0x1c,
-		0xe7, 0xcd, 0x14, 0x71, 0x3c, 0x33, 0xbe, 0xc7, 0xa7, 0xc7, 0x76, 0x66, 0xd7, 0xec, 0x98, 0x4d,
-		0xc9, 0xd0, 0x38, 0x7a, 0x02, 0x12, 0xb7, 0x24, 0x32, 0xf1, 0x24, 0x7c, 0xf7, 0x7f, 0x21, 0x84,
-		0x00, 0x83, 0xa0, 0x0f, 0xab, 0x75, 0x80, 0x2b, 0xf5, 0xfb, 0xed, 0x71, 0x1c, 0xba, 0x91, 0xba,
-		0xd7, 0xe1, 0x5a, 0x57, 0xaf, 0xb5, 0xfa, 0xc7, 0x5e, 0xa1, 0x50, 0x28, 0x14, 0x6f, 0xcc, 0x3e,
-		0x2b, 0xbe, 0x2f, 0x14, 0x3b, 0xec, 0x9b, 0xd5, 0x66, 0xc5, 0xc3, 0xc9, 0x6f, 0xff, 0xb0, 0xec,
-		0x4e, 0xf1, 0x7d, 0xa1, 0x1c, 0xfe, 0xf5, 0xdc, 0xb1, 0xbb, 0xd6, 0x4b, 0xf1, 0x7d, 0xa1, 0x14,
-		0xfe, 0xe2, 0xa3, 0xe5, 0x16, 0xdf, 0x17, 0x26, 0x53, 0x04, 0xbf, 0x68, 0x3b, 0xfd, 0x81, 0x63,
-		0x33, 0xdb, 0xf7, 0x16, 0x7e, 0xbf, 0xf0, 0x15, 0x73, 0x9f, 0x39, 0x5c, 0xfc, 0xc4, 0xe2, 0xd7,
-		0x45, 0xbf, 0x7e, 0xfb, 0xb5, 0xd1, 0x3f, 0xdc, 0xb9, 0xac, 0x6b, 0x7d, 0x5f, 0xfa, 0xa6, 0x85,
-		0x6f, 0x73, 0xda, 0xc6, 0xa0, 0x67, 0xfa, 0x5d, 0xc7, 0xed, 0xbf, 0xf9, 0xba, 0xe0, 0x63, 0x0f,
-		0xce, 0xd0, 0x6d, 0xb3, 0x95, 0x53, 0x4c, 0x1e, 0x89, 0xbd, 0xfe, 0xed, 0xb8, 0xe3, 0xa7, 0x2a,
-		0x0e, 0x26, 0xdf, 0x76, 0xb8, 0xfa, 0x83, 0xbf, 0x9b, 0x5e, 0xc3, 0x7d, 0x19, 0xf6, 0x99, 0xed,
-		0x17, 0xdf, 0x17, 0x7c, 0x77, 0xc8, 0x62, 0x3e, 0x38, 0xf7, 0xa9, 0x85, 0x87, 0x5b, 0xfa, 0xf4,
-		0x68, 0xe1, 0x37, 0xa3, 0x37, 0xef, 0xfe, 0x76, 0xe9, 0x97, 0xb7, 0x20, 0xfe, 0xa5, 0x96, 0x76,
-		0x22, 0xee, 0xa5, 0x56, 0x6f, 0xc8, 0xc6, 0x8d, 0xe1, 0xd9, 0x20, 0xc1, 0x8d, 0xe2, 0xdd, 0x30,
-		0xe1, 0x8d, 0x13, 0xde, 0x40, 0xf1, 0x8d, 0x5c, 0xbd, 0xa1, 0x31, 0x1b, 0xbb, 0x71, 0x83, 0xa3,
-		0x0f, 0x3c, 0x9b, 0xed, 0xaf, 0x83, 0x9e, 0x69, 0x73, 0x2c, 0xc6, 0x74, 0x8d, 0x67, 0x43, 0x36,
-		0xbc, 0xdb, 0xfa, 0x8d, 0xe7, 0x16, 0x00, 0x11, 0x41, 0x90, 0x14, 0x08, 0x51, 0xc1, 0x90, 0x16,
-		0x10, 0x69, 0x41, 0x91, 0x17, 0x98, 0xf5, 0x82, 0xb3, 0x41, 0x80, 0xb8, 0x05, 0x69, 0xce, 0x72,
-		0x84, 0xbb, 0xc9, 0xb9, 0x82, 0x33, 0x33, 0x12, 0x8c, 0xe3, 0x5c, 0x05, 0x3e, 0xd1, 0x12, 0x16,
-		0x31, 0x19, 0x51, 0x53, 0x14, 0x39, 0x59, 0xd1, 0x53, 0x16, 0x41, 0x65, 0x51, 0x54, 0x17, 0x49,
-		0x3e, 0xd1, 0xe4, 0x14, 0xd1, 0xd9, 0xe3, 0xd8, 0xb6, 0xe3, 0x9b, 0xbe, 0xe5, 0xd8, 0x62, 0xfb,
-		0xe7, 0xb5, 0xbf, 0xb0, 0xbe, 0x39, 0x30, 0xfd, 0x2f, 0xe3, 0x17, 0x39, 0x76, 0x06, 0xcc, 0x9e,
-		0x08, 0x65, 0xf4, 0x46, 0xc7, 0x33, 0xd4, 0x31, 0xfb, 0xf1, 0x38, 0xb2, 0x87, 0xc7, 0xa1, 0x0c,
-		0xef, 0xd1, 0xbc, 0x33, 0xc7, 0xfb, 0x16, 0x3d, 0xdf, 0xf4, 0x99, 0xb8, 0xb2, 0x4d, 0x86, 0x69,
-		0xd6, 0xb5, 0x0a, 0x74, 0x0d, 0xba, 0xa6, 0x4d, 0xd7, 0x26, 0x22, 0x4c, 0xa5, 0x6a, 0x4a, 0xbe,
-		0x51, 0x70, 0x11, 0xd4, 0x5f, 0xbe, 0xc8, 0x65, 0x19, 0xdc, 0x61, 0xdb, 0xb7, 0x43, 0x3d, 0x39,
-		0x9f, 0x4e, 0xd2, 0xfa, 0x10, 0x4d, 0xb2, 0x27, 0xb7, 0x22, 0x6b, 0x56, 0xa3, 0xd8, 0xfe, 0x62,
-		0x7a, 0x9e, 0xe5, 0xf1, 0x63, 0xc9, 0xe9, 0x00, 0x20, 0x49, 0x20, 0x49, 0x20, 0x49, 0x78, 0xb7,
-		0xdd, 0xf6, 0x6e, 0xa1, 0x35, 0x04, 0x8e, 0x04, 0x8e, 0x84, 0xa6, 0x25, 0xa2, 0x69, 0x3b, 0x88,
-		0x22, 0xf9, 0x20, 0xd7, 0x5a, 0x0c, 0x79, 0x1e, 0x4e, 0xa1, 0x03, 0x41, 0xf2, 0x39, 0x7d, 0x31,
-		0x67, 0x0f, 0xfc, 0xb8, 0x8b, 0xf8, 0x31, 0x94, 0x5b, 0x41, 0x97, 0x16, 0x8c, 0x12, 0xf3, 0x68,
-		0x25, 0x60, 0x47, 0x78, 0xb4, 0xb7, 0x8f, 0xf3, 0xf8, 0x3a, 0x60, 0x72, 0x3b, 0xe7, 0xf9, 0xae,
-		0x65, 0xbf, 0x88, 0x6c, 0xda, 0xd4, 0xbc, 0xbd, 0xdb, 0x2d, 0x47, 0xc6, 0x81, 0x92, 0x25, 0x9d,
-		0xd0, 0x60, 0x28, 0xe0, 0x81, 0x06, 0x43, 0xb8, 0x1f, 0xb8, 0x1f, 0xd0, 0x17, 0x70, 0x41, 0xbb,
-		0x1e, 0x54, 0x0d, 0x86, 0xa0, 0x2e, 0x40, 0x5d, 0x40, 0xcb, 0xb4, 0x6b, 0xd9, 0x2e, 0xd2, 0x16,
-		0x1b, 0x61, 0xd6, 0x7a, 0xca, 0x62, 0x30, 0xd4, 0x81, 0x14, 0xbb, 0xe6, 0xb3, 0x6b, 0xb5, 0xf9,
-		0xc1, 0x62, 0xf8, 0x79, 0xe0, 0x45, 0xe0, 0x45, 0xe0, 0x45, 0x78, 0xb2, 0xdd, 0xf6, 0x64, 0x13,
-		0x63, 0x08, 0xc8, 0x08, 0xc8, 0x08, 0x45, 0x4b, 0x42, 0xd1, 0x76, 0x10, 0x35, 0x72, 0xe1, 0xad,
-		0xb5, 0xc0, 0xf1, 0xd7, 0xc9, 0x0c, 0x5a, 0xb0, 0xa3, 0x2d, 0x02, 0x1c, 0x6d, 0xa0, 0x46, 0xa0,
-		0x46, 0xa0, 0x46, 0x38, 0xb3, 0x9d, 0x77, 0x66, 0x36, 0x20, 0x23, 0x20, 0x23, 0xb4, 0x4c, 0xbb,
-		0x96, 0xed, 0x24, 0x5e, 0xb4, 0xd5, 0xc0, 0xa2, 0xad, 0x03, 0x29, 0x5a, 0xb6, 0xcf, 0x5e, 0x5c,
-		0xd3, 0x67, 0x1d, 0xa3, 0x6d, 0xb9, 0xed, 0xa1, 0xe5, 0xf3, 0x03, 0xc7, 0x15, 0x63, 0x81, 0x23,
-		0x81, 0x23, 0x81, 0x23, 0xe1, 0xe1, 0x76, 0xdb, 0xc3, 0x2d, 0x1b, 0x46, 0xc0, 0x4a, 0xc0, 0x4a,
-		0x28, 0x5d, 0xd2, 0x4a, 0xb7, 0x83, 0x28, 0x53, 0x18, 0x93, 0xad, 0x05, 0x9d, 0x97, 0xd1, 0x6c,
-		0xe7, 0xe1, 0x64, 0x1a, 0x20, 0x28, 0x57, 0x32, 0xb5, 0x48, 0x12, 0x35, 0x67, 0xf2, 0x34, 0x60,
-		0x66, 0x1e, 0x60, 0x26, 0x77, 0xb2, 0x73, 0xb4, 0xf2, 0x3d, 0x66, 0x76, 0x5d, 0xd6, 0xe5, 0x59,
-		0xf5, 0xa9, 0xff, 0x3a, 0xe5, 0xf8, 0xec, 0x5d, 0xa8, 0x8b, 0x47, 0x47, 0xa1, 0x33, 0x3f, 0x0e,
-		0x44, 0x51, 0x83, 0x42, 0x0c, 0x1c, 0x57, 0x20, 0x0a, 0x0b, 0x3e, 0x8d, 0xb8, 0x0b, 0x71, 0x17,
-		0xe2, 0x2e, 0x40, 0xc0, 0xdd, 0x86, 0x80, 0x63, 0x53, 0x88, 0x48, 0x0b, 0x91, 0x16, 0xd4, 0x4c,
-		0xbf, 0x9a, 0xed, 0x60, 0x6c, 0xc5, 0x81, 0xb4, 0xd6, 0x46, 0x53, 0x77, 0xe3, 0xf1, 0x5a, 0xf0,
-		0xe2, 0xdf, 0xcc, 0x35, 0xbc, 0xe1, 0x60, 0xd0, 0x7b, 0x15, 0xc1, 0x8d, 0x73, 0xa3, 0x80, 0x1f,
-		0x81, 0x1f, 0x81, 0x1f, 0xe1, 0xd8, 0x76, 0xdd, 0xb1, 0xcd, 0x4c, 0x22, 0x70, 0x24, 0x70, 0x24,
-		0xd4, 0x2d, 0x39, 0x75, 0xdb, 0x49, 0x3c, 0xc9, 0x8d, 0xc0, 0x36, 0xe0, 0xca, 0xbf, 0x99, 0xfb,
-		0x30, 0x99, 0x46, 0x07, 0xbc, 0x74, 0x9d, 0x01, 0x73, 0x7d, 0x8b, 0x09, 0x34, 0x5f, 0x9c, 0x1b,
-		0x03, 0x68, 0x09, 0x68, 0x19, 0x23, 0x52, 0xaf, 0xe2, 0xce, 0x2e, 0x1a, 0x09, 0x78, 0x09, 0x7f,
-		0xa7, 0xe8, 0xef, 0x78, 0xc5, 0x55, 0x36, 0x22, 0x52, 0x8b, 0x8c, 0x24, 0x45, 0x58, 0x5a, 0x94,
-		0x55, 0x44, 0x9a, 0x48, 0xb4, 0x55, 0x45, 0x9c, 0x4c, 0xd4, 0xc9, 0x44, 0x9e, 0x4e, 0xf4, 0xc5,
-		0x54, 0x40, 0x50, 0x15, 0xa4, 0x55, 0x22, 0x1a, 0x28, 0xd4, 0x11, 0x2d, 0x56, 0x6c, 0x04, 0x3a,
-		0xa4, 0xc5, 0xa9, 0x49, 0x49, 0x72, 0xb8, 0xac, 0xba, 0x50, 0xa8, 0x0d, 0xb1, 0xfa, 0x50, 0xa9,
-		0x11, 0xb9, 0x3a, 0x91, 0xab, 0x15, 0xbd, 0x7a, 0xc9, 0xa9, 0x99, 0xa4, 0xba, 0x45, 0x8f, 0x2f,
-		0xdc, 0xd1, 0x6d, 0x0d, 0x19, 0x20, 0xd8, 0xe1, 0x2d, 0xd6, 0xdd, 0xbc, 0xdb, 0x4b, 0x66, 0xdd,
-		0x24, 0xd6, 0xac, 0xf8, 0xcd, 0xec, 0x0d, 0x09, 0xcc, 0xcd, 0x64, 0x1a, 0xd8, 0x1b, 0xd8, 0x1b,
-		0xd8, 0x1b, 0x49, 0xc9, 0x19, 0xda, 0x96, 0x63, 0x53, 0x98, 0x9b, 0x33, 0x85, 0x39, 0xc2, 0xd7,
-		0xf9, 0xac, 0xb4, 0xa7, 0x6a, 0x32, 0x4b, 0x6b, 0x84, 0x89, 0x8c, 0xb1, 0xa2, 0x98, 0x68, 0x58,
-		0x99, 0x67, 0xc7, 0xe9, 0x31, 0xd3, 0xa6, 0x5c, 0x9a, 0xf2, 0x96, 0x2c, 0x8d, 0x65, 0xfb, 0xf5,
-		0x2a, 0xe1, 0xc2, 0x54, 0x09, 0xa6, 0xba, 0x37, 0xed, 0x17, 0x75, 0xcd, 0xa2, 0x5b, 0xac, 0xe8,
-		0xc1, 0xae, 0x2d, 0x5b, 0xd9, 0xcd, 0x10, 0x05, 0xda, 0x1b, 0xa7, 0xfd, 0x33, 0x04, 0x2b, 0x67,
-		0x95, 0xca, 0xc9, 0xc9, 0x69, 0xa5, 0x74, 0x52, 0x7f, 0x57, 0xab, 0x9e, 0x9e, 0xd6, 0xde, 0x95,
-		0xde, 0x11, 0x7f, 0xd3, 0xaf, 0xae, 0xd9, 0xf6, 0x2d, 0xc7, 0xfe, 0x68, 0xbd, 0x58, 0xc1, 0x0d,
-		0xa7, 0x25, 0xb2, 0xf9, 0x47, 0x87, 0x84, 0x9b, 0x67, 0x7e, 0xd7, 0xb6, 0x79, 0xa5, 0xc4, 0x36,
-		0xef, 0x34, 0x47, 0x9b, 0xb7, 0x97, 0x8d, 0x59, 0x9a, 0x5b, 0x62, 0xaa, 0x87, 0xd4, 0xb6, 0xfa,
-		0x1d, 0x6c, 0x75, 0xb6, 0xd4, 0xbd, 0x04, 0xcb, 0x9c, 0x97, 0xad, 0x2a, 0xbf, 0xab, 0x56, 0xeb,
-		0xa7, 0xd5, 0x6a, 0xe9, 0xf4, 0xe4, 0xb4, 0x74, 0x56, 0xab, 0x95, 0xeb, 0xe5, 0x1a, 0x4c, 0xf3,
-		0xae, 0x9a, 0xe6, 0x0e, 0x6b, 0x5b, 0x7d, 0xb3, 0x47, 0x6a, 0x9d, 0xcb, 0x15, 0x82, 0xb9, 0x96,
-		0x64, 0xa8, 0x02, 0x9b, 0x2f, 0xb7, 0x1d, 0x15, 0xd8, 0xfc, 0xbc, 0xd8, 0xfc, 0x13, 0x6c, 0xd5,
-		0x96, 0x18, 0xf8, 0xbd, 0x64, 0xbf, 0x77, 0x94, 0xb1, 0x73, 0x51, 0xc9, 0xd4, 0xb8, 0x68, 0xbc,
-		0x74, 0x8a, 0x58, 0x94, 0x47, 0x35, 0xfd, 0x51, 0x2c, 0x31, 0x55, 0x7c, 0x75, 0x04, 0x56, 0x46,
-		0xee, 0xd0, 0x57, 0xe5, 0xb0, 0x57, 0x12, 0x4b, 0x22, 0x17, 0x02, 0xb9, 0x10, 0xe2, 0x3a, 0x2f,
-		0x7d, 0x48, 0x22, 0x51, 0x89, 0x1e, 0x0b, 0x3d, 0x25, 0x58, 0x26, 0xa9, 0x4a, 0xf5, 0x64, 0x0c,
-		0x86, 0x58, 0x86, 0xfb, 0xd2, 0x7a, 0x8a, 0x64, 0xba, 0x2f, 0xad, 0xa4, 0xac, 0xc9, 0xa8, 0xc0,
-		0x64, 0xc0, 0x64, 0x70, 0x3e, 0xa6, 0x74, 0xfa, 0xd4, 0x44, 0x4f, 0x87, 0xae, 0xf9, 0xdc, 0x23,
-		0xc8, 0x6b, 0x58, 0x98, 0x0d, 0xe9, 0x0d, 0x48, 0x6f, 0x48, 0x4d, 0xdd, 0xd4, 0xe2, 0x87, 0xf4,
-		0xd3, 0x1b, 0xd4, 0xcf, 0xab, 0x15, 0xcf, 0xa9, 0x93, 0xc9, 0xa7, 0x42, 0xf6, 0x26, 0xcc, 0x0d,
-		0xcc, 0x0d, 0xb2, 0x37, 0x91, 0xbd, 0x09, 0x7b, 0x03, 0x7b, 0xb3, 0x3b, 0xf6, 0x06, 0xd9, 0x9b,
-		0x3a, 0x8c, 0x30, 0x91, 0x31, 0x56, 0x14, 0x13, 0x0d, 0x2b, 0x83, 0xec, 0xcd, 0xd8, 0xa5, 0x41,
-		0xf6, 0xa6, 0xc0, 0x83, 0x21, 0x7b, 0x73, 0xd5, 0x37, 0xe1, 0xbc, 0x18, 0xd9, 0x9b, 0x74, 0xe0,
-		0x82, 0x7e, 0x16, 0x64, 0x6f, 0xc6, 0x48, 0x2d, 0xb2, 0x37, 0x33, 0xa6, 0xee, 0xc8, 0xe4, 0xc9,
-		0xcd, 0x56, 0x21, 0x7b, 0x13, 0xa6, 0x19, 0xd9, 0x9b, 0x3b, 0x64, 0xf3, 0x91, 0xbd, 0x89, 0xec,
-		0x4d, 0x18, 0xf8, 0x84, 0x0d, 0x3c, 0xb2, 0x37, 0x33, 0x93, 0xbd, 0x29, 0xd0, 0xe7, 0x50, 0x7c,
-		0x71, 0x68, 0xfb, 0x63, 0xfd, 0xc1, 0x5e, 0x05, 0x8f, 0x75, 0x8b, 0x57, 0x96, 0xe7, 0x37, 0x7c,
-		0x5f, 0xb0, 0xaf, 0xd6, 0xb5, 0x65, 0x5f, 0xf4, 0x58, 0x7f, 0xbc, 0x7a, 0xc5, 0xf7, 0x05, 0x7b,
-		0xd8, 0xeb, 0x09, 0xe4, 0x94, 0x5d, 0x9b, 0xdf, 0xe5, 0x07, 0xdf, 0xba, 0x1d, 0xe6, 0xb2, 0xce,
-		0x87, 0xd7, 0x70, 0x68, 0xbe, 0xfb, 0x69, 0x2e, 0x8b, 0x5b, 0x51, 0x28, 0x39, 0x2f, 0xa6, 0xbd,
-		0xe4, 0x74, 0xae, 0xdd, 0x6a, 0xce, 0x39, 0xeb, 0x60, 0xa9, 0xa1, 0xa7, 0x26, 0x5f, 0x22, 0xa4,
-		0x50, 0xe2, 0xa3, 0x70, 0x27, 0xcd, 0x0a, 0x3a, 0x69, 0x72, 0xe8, 0x70, 0xc6, 0x3b, 0x69, 0x9a,
-		0xbd, 0x9e, 0xd3, 0x0e, 0xae, 0x7b, 0x0b, 0xba, 0xc9, 0x8a, 0x37, 0xd4, 0x7c, 0x3b, 0x81, 0x58,
-		0x5f, 0xcd, 0x12, 0xfa, 0x6a, 0xa2, 0xaf, 0xe6, 0xdb, 0xc7, 0x11, 0x3e, 0x9f, 0x5e, 0xa0, 0x66,
-		0x4f, 0x2a, 0x22, 0x9b, 0x16, 0xca, 0xa1, 0x00, 0x9d, 0x2f, 0x19, 0x76, 0x4b, 0xc0, 0x46, 0x95,
-		0x30, 0x5a, 0x35, 0x41, 0x45, 0x31, 0xd6, 0xa2, 0x88, 0xa9, 0x64, 0x52, 0x83, 0x54, 0xc2, 0x59,
-		0xaa, 0x25, 0xab, 0x56, 0xce, 0xaa, 0x67, 0xf5, 0xd3, 0xca, 0x59, 0x2d, 0xc5, 0xb5, 0xd3, 0x14,
-		0x20, 0x34, 0x13, 0xbc, 0xce, 0xa0, 0xc3, 0xbc, 0xb6, 0x6b, 0x0d, 0x84, 0xf0, 0xef, 0x1c, 0x13,
-		0x38, 0x1b, 0x0c, 0x97, 0x04, 0x97, 0x94, 0x9a, 0x4b, 0x12, 0xce, 0x06, 0x12, 0xcc, 0xfa, 0xa1,
-		0xd1, 0x35, 0xd6, 0x1f, 0xc8, 0x74, 0x53, 0x9f, 0x0c, 0xe3, 0x6d, 0x97, 0xcd, 0xba, 0xe6, 0xb0,
-		0x17, 0xec, 0x68, 0xd7, 0xec, 0x79, 0x0c, 0x7a, 0x09, 0xbd, 0x4c, 0x4f, 0x2f, 0xc5, 0x73, 0xd1,
-		0x04, 0x73, 0xce, 0x88, 0x14, 0xf3, 0xff, 0x0d, 0xad, 0xc1, 0x78, 0xc1, 0x8d, 0xae, 0x69, 0xf5,
-		0x86, 0xae, 0xc4, 0xfd, 0x3e, 0xcb, 0x53, 0xec, 0xb6, 0xc2, 0x9a, 0x3d, 0xd3, 0xed, 0x7b, 0x3b,
-		0xa9, 0xae, 0xe1, 0xab, 0x43, 0x59, 0x75, 0x2b, 0x6b, 0xdf, 0xf2, 0xfa, 0xa6, 0xdf, 0xfe, 0xa2,
-		0xa2, 0xad, 0xd1, 0x1c, 0x50, 0x57, 0xa8, 0x2b, 0xd4, 0x95, 0x5e, 0x5d, 0xbb, 0x96, 0xdb, 0xff,
-		0xdb, 0x74, 0x99, 0xf1, 0x8d, 0xb9, 0x9e, 0x54, 0x94, 0xb9, 0x34, 0x03, 0x20, 0x2d, 0x20, 0x2d,
-		0x42, 0xcd, 0xb5, 0xdf, 0xf9, 0xc5, 0x74, 0x3b, 0x6a, 0x5a, 0xb7, 0x34, 0x03, 0xb4, 0x0e, 0x5a,
-		0x07, 0xad, 0x5b, 0xfb, 0x9d, 0x56, 0x47, 0x5c, 0xcf, 0xac, 0x0e, 0x34, 0x0b, 0x9a, 0x05, 0xcd,
-		0x5a, 0xff, 0x9d, 0xc1, 0xf1, 0xb7, 0x94, 0x1f, 0x8b, 0x46, 0x42, 0xcb, 0xa0, 0x65, 0xd0, 0xb2,
-		0xb5, 0xdf, 0xd9, 0x67, 0x7d, 0xc7, 0x95, 0x38, 0xa1, 0x08, 0xc7, 0xe1, 0xb6, 0x57, 0x68, 0x98,
-		0xa2, 0x86, 0x09, 0xdf, 0xf6, 0x6a, 0x7e, 0x33, 0xad, 0x9e, 0x54, 0x43, 0xb6, 0x59, 0x72, 0x55,
-		0x34, 0x05, 0xfa, 0x9c, 0x6a, 0x12, 0x74, 0x32, 0x81, 0x27, 0x13, 0x7c, 0x3a, 0x05, 0x10, 0x53,
-		0x04, 0x41, 0x85, 0x90, 0x77, 0x3d, 0x4b, 0x3b, 0x2f, 0x5d, 0x51, 0xab, 0x50, 0x41, 0xab, 0x58,
-		0x3d, 0xa5, 0xd0, 0x3c, 0x85, 0xa2, 0x3a, 0x8a, 0xa8, 0xac, 0x92, 0xaa, 0xa4, 0x86, 0xb2, 0x84,
-		0x46, 0xa1, 0xba, 0x89, 0xa4, 0x9a, 0x89, 0x7a, 0x69, 0xe9, 0x2b, 0x54, 0x49, 0x57, 0x3b, 0xa1,
-		0x02, 0x9d, 0x66, 0x06, 0xba, 0x07, 0x0f, 0x7d, 0xab, 0x67, 0xfd, 0xc3, 0x3a, 0xf2, 0xfe, 0x38,
-		0x9a, 0x01, 0xee, 0x18, 0xee, 0x18, 0xee, 0x18, 0xee, 0x18, 0xee, 0x18, 0xee, 0x18, 0xee, 0x58,
-		0x57, 0xc8, 0x9d, 0x74, 0x01, 0x64, 0x50, 0x26, 0x77, 0x2c, 0x44, 0x19, 0x15, 0xd6, 0x55, 0x3e,
-		0x5e, 0x4f, 0x66, 0x4a, 0x92, 0x26, 0xeb, 0xbe, 0x18, 0x1d, 0x91, 0x3b, 0x12, 0x66, 0x44, 0xd9,
-		0x74, 0x24, 0xc8, 0x68, 0x50, 0x65, 0x8a, 0x7a, 0x2b, 0x4f, 0x46, 0x77, 0xc4, 0x2e, 0xe8, 0x98,
-		0x51, 0xd1, 0x02, 0x63, 0xee, 0x4c, 0xdf, 0x67, 0xae, 0x2d, 0x8c, 0x35, 0x8a, 0xff, 0xfb, 0x5c,
-		0x32, 0xce, 0x9a, 0x3f, 0xaa, 0xa3, 0xa7, 0x27, 0x63, 0xf2, 0x63, 0x65, 0xfe, 0xc7, 0x7f, 0x15,
-		0x33, 0x59, 0x46, 0x33, 0x56, 0x6c, 0xa1, 0xfe, 0xec, 0x0b, 0x26, 0x41, 0xa4, 0x78, 0x1f, 0x26,
-		0x01, 0x26, 0x81, 0xde, 0x24, 0xe4, 0xe3, 0x7c, 0x4a, 0x4e, 0xc3, 0xa0, 0x5d, 0xd0, 0x2e, 0x68,
-		0xd7, 0x66, 0xed, 0x72, 0x06, 0xcc, 0x35, 0xc6, 0xe8, 0x7c, 0xe8, 0x89, 0x2b, 0xd9, 0xfc, 0x60,
-		0xe8, 0x1a, 0x74, 0x2d, 0x35, 0x5d, 0xb3, 0x3a, 0xcc, 0xf6, 0x2d, 0xff, 0x55, 0xec, 0x4a, 0xbf,
-		0x48, 0xe1, 0x04, 0x68, 0x8a, 0xe2, 0x65, 0xf8, 0x55, 0x1f, 0x4c, 0x4f, 0xe1, 0xf8, 0xf9, 0xfc,
-		0xf6, 0xfa, 0xee, 0xf6, 0xe6, 0xe2, 0xe6, 0xb1, 0x75, 0x7b, 0x77, 0x71, 0xdf, 0x7a, 0x78, 0x6c,
-		0x3c, 0x7e, 0x7a, 0x10, 0xdd, 0xff, 0x80, 0x78, 0xf1, 0xa4, 0x98, 0x3d, 0xc5, 0x0b, 0x3e, 0x2e,
-		0x6f, 0x1a, 0xe7, 0x8f, 0x97, 0x7f, 0x5e, 0x14, 0x93, 0x68, 0x3d, 0xa0, 0xf8, 0xac, 0xf9, 0x79,
-		0xd2, 0x8f, 0x97, 0x0f, 0x8d, 0x0f, 0x57, 0x17, 0x1f, 0x75, 0xf3, 0xde, 0x4d, 0x6a, 0xfd, 0x26,
-		0xf1, 0x44, 0x03, 0xd3, 0x9d, 0x98, 0x22, 0x41, 0x27, 0x14, 0x8e, 0x83, 0xff, 0x81, 0xff, 0x49,
-		0xcd, 0xff, 0x88, 0x5f, 0x27, 0x2b, 0x73, 0x8d, 0xec, 0xfc, 0xf5, 0xb1, 0x32, 0x37, 0xc8, 0x92,
-		0xe9, 0xa9, 0x6f, 0xd8, 0x8e, 0x94, 0xa2, 0x06, 0x03, 0xa1, 0xa9, 0xd0, 0x54, 0x44, 0x65, 0x6b,
-		0xbf, 0xd3, 0x65, 0x7d, 0xe7, 0x9b, 0x50, 0x82, 0x63, 0xf4, 0x82, 0xb3, 0xa1, 0xd0, 0x33, 0xe8,
-		0x59, 0x6a, 0x7a, 0x96, 0x93, 0x42, 0x65, 0x8f, 0xb9, 0x96, 0xd9, 0x93, 0x72, 0x67, 0xb3, 0xa1,
-		0x50, 0x34, 0x28, 0x1a, 0x1c, 0xda, 0x7a, 0x3d, 0x73, 0xba, 0xbe, 0x5a, 0x69, 0xf2, 0xd2, 0x0c,
-		0xd0, 0x3a, 0x68, 0x1d, 0xb4, 0x6e, 0xed, 0x77, 0xfa, 0xac, 0x3f, 0x60, 0xae, 0xe9, 0x4b, 0x35,
-		0xb7, 0x9a, 0x1f, 0x8c, 0x22, 0x2f, 0xe8, 0x9a, 0xa2, 0xae, 0x89, 0x17, 0x79, 0xf5, 0x4c, 0xb7,
-		0x6f, 0x78, 0xec, 0x1b, 0x73, 0x2d, 0x81, 0x0e, 0x8a, 0x4b, 0x1b, 0xff, 0x66, 0x1e, 0xe4, 0x97,
-		0x6b, 0x12, 0x79, 0x32, 0xd1, 0x27, 0x53, 0x01, 0x3a, 0x55, 0x10, 0x53, 0x09, 0x41, 0xd5, 0x90,
-		0x77, 0x47, 0x4b, 0x3b, 0x2f, 0x77, 0x0e, 0xb6, 0x64, 0xbf, 0x25, 0xd2, 0x76, 0xd5, 0xce, 0xc5,
-		0x96, 0x5e, 0xe4, 0xf6, 0xee, 0xe2, 0xe6, 0xfc, 0xf6, 0xe6, 0xd7, 0xcb, 0xdf, 0x5a, 0x8d, 0xab,
-		0xc6, 0xfd, 0x75, 0xeb, 0xe1, 0xe2, 0xcf, 0x8b, 0xfb, 0xcb, 0xc7, 0xbf, 0x8a, 0x2a, 0x2d, 0xa4,
-		0x3d, 0xa5, 0x2b, 0xbd, 0x14, 0xb3, 0xad, 0xa7, 0xaf, 0xf6, 0xe9, 0xe6, 0x8f, 0x9b, 0xdb, 0xff,
-		0xdc, 0x14, 0xd3, 0xc8, 0x1b, 0x27, 0x7a, 0x85, 0xeb, 0xcb, 0x9b, 0xdb, 0xfb, 0x3c, 0xbf, 0xc0,
-		0x7f, 0x1a, 0xf7, 0x37, 0x97, 0x37, 0xbf, 0xe5, 0x7a, 0x0f, 0x1a, 0xff, 0x37, 0xdf, 0x7b, 0x70,
-		0x7e, 0x7f, 0xf9, 0x78, 0x79, 0xde, 0xb8, 0x4a, 0xfa, 0x6e, 0xff, 0x66, 0x66, 0x5a, 0xbe, 0x8b,
-		0x54, 0xd8, 0x85, 0x28, 0x46, 0x2c, 0x51, 0x27, 0x0e, 0x0b, 0x89, 0x64, 0xec, 0x00, 0x09, 0x01,
-		0x09, 0xe5, 0x10, 0x09, 0x89, 0xf3, 0xcf, 0x4b, 0x28, 0xa8, 0x9c, 0x19, 0xb5, 0xf7, 0xbf, 0xb8,
-		0xcc, 0xfb, 0xe2, 0xf4, 0x3a, 0xaa, 0x9a, 0x3f, 0x9b, 0x08, 0xca, 0x0f, 0xe5, 0xdf, 0xea, 0x32,
-		0x5b, 0xa1, 0xcb, 0x8a, 0xde, 0xca, 0xf9, 0x29, 0xca, 0x6c, 0x0b, 0xf2, 0x61, 0x16, 0xca, 0x6c,
-		0x75, 0x2e, 0xad, 0xea, 0x25, 0x48, 0x5a, 0xd6, 0x78, 0x87, 0x7a, 0x5d, 0x98, 0xdf, 0x5e, 0x54,
-		0xda, 0x4e, 0xbd, 0xec, 0x98, 0xeb, 0x35, 0xfc, 0xd7, 0x01, 0xf3, 0xe0, 0x80, 0xd7, 0x38, 0xe0,
-		0x70, 0x89, 0xb6, 0xce, 0x0d, 0x77, 0x58, 0xdb, 0xea, 0x9b, 0x3d, 0xa5, 0x86, 0x17, 0x65, 0x89,
-		0x5b, 0xe9, 0x97, 0x2d, 0x5b, 0x79, 0x57, 0xfd, 0x79, 0x05, 0xfe, 0x5c, 0x97, 0x3f, 0x3f, 0xd9,
-		0xc2, 0xa5, 0xdd, 0x21, 0x37, 0x6e, 0xd9, 0x9e, 0x6f, 0x0a, 0xd4, 0x1b, 0x2c, 0x59, 0xb7, 0xe9,
-		0x04, 0x70, 0xe7, 0x70, 0xe7, 0x70, 0xe7, 0x70, 0xe7, 0x70, 0xe7, 0x70, 0xe7, 0x70, 0xe7, 0xe9,
-		0xb9, 0x73, 0x9f, 0xb9, 0xdf, 0xcc, 0x9e, 0x8a, 0x3f, 0x0f, 0x67, 0xd8, 0x0d, 0x87, 0x0e, 0x3f,
-		0x1e, 0xe7, 0xc7, 0xb7, 0xd4, 0x7d, 0x7b, 0xbe, 0xe9, 0x1b, 0x92, 0x42, 0x5e, 0x50, 0x6c, 0x41,
-		0xf9, 0xc9, 0x9e, 0xd8, 0xb2, 0xa2, 0x6d, 0xda, 0x8e, 0xc7, 0xda, 0x8e, 0xdd, 0x91, 0x92, 0x3d,
-		0x70, 0xec, 0x70, 0xe2, 0x49, 0x2c, 0x2d, 0x5a, 0x59, 0x66, 0xc5, 0xaf, 0xf7, 0x25, 0xa4, 0x63,
-		0xd6, 0x66, 0xcd, 0xfc, 0x8e, 0xf0, 0x1c, 0x6e, 0x1d, 0xe1, 0x39, 0xc2, 0x73, 0x84, 0xe7, 0x08,
-		0xcf, 0x11, 0x9e, 0xa7, 0xe9, 0xc6, 0x0d, 0xdf, 0xea, 0x33, 0x25, 0x5f, 0x3e, 0x99, 0x01, 0xe1,
-		0x39, 0xc2, 0xf3, 0x2d, 0x74, 0xdf, 0x63, 0xd9, 0xf6, 0xad, 0xf6, 0x57, 0x0f, 0xf7, 0x43, 0x20,
-		0xa8, 0x46, 0x50, 0x0d, 0x6f, 0xac, 0xd3, 0x1b, 0x4b, 0x28, 0xde, 0xcc, 0x11, 0x5b, 0x36, 0x82,
-		0x6a, 0x38, 0x63, 0x04, 0xd5, 0x08, 0xaa, 0x11, 0x54, 0x23, 0xa8, 0x46, 0x50, 0x9d, 0xa6, 0x1b,
-		0x57, 0x0d, 0xaa, 0xa7, 0x33, 0x20, 0xa8, 0x46, 0x50, 0x8d, 0xa0, 0x1a, 0x41, 0x35, 0x82, 0x6a,
-		0x04, 0xd5, 0xf0, 0xc6, 0x82, 0x9f, 0xcc, 0xf6, 0xa5, 0x8b, 0xe2, 0x7d, 0xdc, 0x0a, 0xeb, 0x6e,
-		0x5e, 0x7c, 0x9c, 0x9b, 0x2e, 0xc9, 0x56, 0x76, 0x22, 0xbe, 0x60, 0x66, 0xff, 0xc7, 0xa3, 0xd0,
-		0x28, 0x12, 0xcd, 0xeb, 0x14, 0x35, 0x57, 0xbe, 0x51, 0xe4, 0xd0, 0xe6, 0xef, 0x55, 0xba, 0x40,
-		0x1a, 0x9c, 0x09, 0x8c, 0x09, 0x1f, 0x4f, 0x0c, 0x6b, 0xa0, 0xcd, 0xd8, 0x42, 0x9b, 0xb1, 0xdf,
-		0x1b, 0xf7, 0x1f, 0xff, 0xd3, 0xb8, 0xbf, 0x68, 0x45, 0x57, 0xf3, 0xe4, 0xbf, 0xd5, 0xd8, 0xdd,
-		0xed, 0xfd, 0x63, 0xae, 0x5b, 0x44, 0xfd, 0xde, 0x78, 0x78, 0xb8, 0x7c, 0xc8, 0xf3, 0x2b, 0x3c,
-		0x3c, 0xde, 0xde, 0x37, 0x7e, 0xbb, 0xc8, 0xf3, 0x2b, 0x7c, 0x68, 0x9c, 0xff, 0x71, 0x77, 0xd5,
-		0xb8, 0xc9, 0xf5, 0x4b, 0xfc, 0xda, 0xc8, 0x75, 0xc7, 0xbd, 0xf3, 0xbb, 0x4f, 0xb9, 0x5e, 0xfd,
-		0xfb, 0x5c, 0x3f, 0xfe, 0xe5, 0xcd, 0xe3, 0xc5, 0x6f, 0xf7, 0x8d, 0xc7, 0x8b, 0x8f, 0xad, 0xf3,
-		0xcb, 0xfb, 0xf3, 0x4f, 0x97, 0xb9, 0xb6, 0xaa, 0x77, 0xb7, 0xff, 0xb9, 0xb8, 0x6f, 0x3d, 0x7c,
-		0xba, 0xbb, 0xbb, 0xfa, 0x2b, 0xd7, 0xa6, 0xf5, 0xe2, 0xe6, 0x21, 0xdf, 0x2d, 0x10, 0xaf, 0x2e,
-		0x6f, 0x2e, 0xce, 0x1b, 0xf7, 0x1f, 0xf3, 0xfc, 0x0e, 0x8f, 0xf7, 0x8d, 0x9b, 0x87, 0xf3, 0x8b,
-		0xcb, 0x3f, 0x2f, 0xee, 0xf3, 0xed, 0x1f, 0x3e, 0xdc, 0x5f, 0x9e, 0xe7, 0xda, 0x45, 0xdc, 0xde,
-		0x3c, 0xde, 0xdf, 0x5e, 0x5d, 0x5d, 0xdc, 0xb7, 0x14, 0x65, 0x2a, 0x9b, 0x6d, 0x35, 0x0f, 0x11,
-		0xdd, 0xc8, 0x47, 0x37, 0x0f, 0xb7, 0xbf, 0x3e, 0x6e, 0x5b, 0x74, 0x73, 0x7b, 0x77, 0x71, 0xdf,
-		0x78, 0xbc, 0xbc, 0xf9, 0xad, 0xf5, 0xf0, 0xd7, 0xc3, 0xe3, 0xc5, 0xf5, 0xee, 0x36, 0x92, 0x6d,
-		0x26, 0x48, 0xc4, 0x0d, 0x3d, 0xd6, 0x31, 0x06, 0xce, 0xdf, 0xcc, 0x15, 0xa7, 0xe3, 0xe6, 0xc6,
-		0x82, 0x94, 0x03, 0x29, 0x97, 0x1e, 0x29, 0x27, 0xda, 0x1f, 0x52, 0xa2, 0x2f, 0xa4, 0xe4, 0x09,
-		0xa0, 0x84, 0xdf, 0x52, 0x39, 0xf1, 0x53, 0x3c, 0x8e, 0x52, 0x3d, 0xe1, 0xa3, 0x38, 0x6b, 0x92,
-		0x40, 0x5e, 0x4a, 0x27, 0x79, 0x54, 0x4b, 0xa6, 0xda, 0xc7, 0x91, 0x64, 0xed, 0xb2, 0xee, 0x90,
-		0xf6, 0x14, 0x76, 0x5e, 0xf4, 0xbc, 0x4d, 0xed, 0x9c, 0x6d, 0xbd, 0x15, 0x8c, 0x7f, 0x91, 0x35,
-		0x2f, 0x51, 0xf4, 0x7c, 0xc7, 0x35, 0x5f, 0x36, 0x1b, 0xb9, 0xb9, 0x1a, 0xff, 0xc9, 0x80, 0x0d,
-		0x0b, 0xc3, 0x77, 0x4f, 0x13, 0xb7, 0x37, 0x15, 0xf1, 0xa2, 0x92, 0xde, 0x53, 0xd4, 0x6b, 0x4a,
-		0x7b, 0x4b, 0x69, 0x2f, 0x29, 0xef, 0x1d, 0xd5, 0x84, 0x9c, 0xf7, 0x5e, 0xa5, 0x62, 0x7b, 0xba,
-		0x9b, 0x82, 0xa0, 0x2d, 0x1c, 0x87, 0x2b, 0xc0, 0x00, 0xd8, 0x14, 0x01, 0x5b, 0xf2, 0xf9, 0x0f,
-		0x81, 0x35, 0x0c, 0x2f, 0x4a, 0x4f, 0x32, 0x5b, 0x61, 0xe2, 0x11, 0xc4, 0xef, 0xb8, 0x0c, 0x86,
-		0x69, 0xd6, 0xb4, 0x0a, 0x34, 0x0d, 0x9a, 0xa6, 0x49, 0xd3, 0x38, 0x90, 0xd0, 0xf6, 0x81, 0x3f,
-		0x1e, 0xc8, 0x55, 0x58, 0x97, 0x52, 0xf5, 0x10, 0x4e, 0xa1, 0x03, 0x41, 0x0e, 0x9f, 0x67, 0x4f,
-		0x2d, 0x80, 0x23, 0x17, 0x86, 0x01, 0x4d, 0x02, 0x4d, 0xae, 0x13, 0x2c, 0x09, 0x47, 0x37, 0x3f,
-		0x1a, 0xc8, 0x12, 0xfe, 0x4e, 0xd1, 0xdf, 0x09, 0x5f, 0x2e, 0x2b, 0x18, 0x0c, 0xa9, 0x05, 0x45,
-		0x92, 0x22, 0x2c, 0x2d, 0xca, 0x2a, 0x22, 0x4d, 0x24, 0xda, 0xaa, 0x22, 0x4e, 0x26, 0xea, 0x64,
-		0x22, 0x4f, 0x27, 0xfa, 0x92, 0xe4, 0x9b, 0xe0, 0xde, 0x8b, 0xaa, 0x44, 0x34, 0x30, 0xc4, 0x27,
-		0x8a, 0x67, 0x8f, 0xc1, 0x2c, 0x87, 0xa9, 0x10, 0xac, 0xb2, 0xea, 0x42, 0xa1, 0x36, 0xc4, 0xea,
-		0x43, 0xa5, 0x46, 0xe4, 0xea, 0x44, 0xae, 0x56, 0xf4, 0xea, 0x25, 0xa7, 0x66, 0x92, 0xea, 0x16,
-		0x3d, 0xbe, 0x74, 0xcd, 0xda, 0x92, 0xe4, 0xf4, 0x98, 0xd9, 0x95, 0x4b, 0x41, 0x58, 0xf2, 0x37,
-		0xa7, 0x0a, 0x73, 0xdc, 0x85, 0x11, 0xd1, 0xd1, 0xd1, 0xf1, 0xfc, 0xff, 0xcd, 0x02, 0xa0, 0x89,
-		0x2b, 0x3c, 0x0e, 0x14, 0x3e, 0xa1, 0x72, 0x1f, 0xbd, 0x96, 0x53, 0x32, 0x78, 0x56, 0x8f, 0x24,
-		0xe7, 0x83, 0xae, 0x85, 0xbf, 0x09, 0x31, 0x58, 0xe2, 0x8b, 0x24, 0x52, 0x73, 0x2c, 0xe5, 0x1d,
-		0x54, 0xbc, 0x02, 0xae, 0x9e, 0x04, 0x68, 0x4a, 0x4c, 0xf5, 0xd5, 0x2b, 0x8e, 0xe5, 0xad, 0xb6,
-		0x8a, 0xb5, 0x9e, 0xb7, 0xd2, 0xd2, 0xf6, 0x58, 0x8f, 0xc1, 0x10, 0xa3, 0xc2, 0x95, 0x28, 0x71,
-		0xb2, 0x38, 0xab, 0x02, 0x93, 0x01, 0x93, 0x81, 0x38, 0x0b, 0x71, 0x16, 0xe2, 0x2c, 0xc4, 0x59,
-		0x88, 0xb3, 0x76, 0x27, 0xce, 0x12, 0x38, 0xbf, 0x94, 0x40, 0x4d, 0xa4, 0x94, 0xf7, 0x1f, 0xec,
-		0x55, 0xd0, 0xfe, 0x17, 0xaf, 0x2c, 0xcf, 0x6f, 0xf8, 0xbe, 0x20, 0x55, 0x7e, 0x6d, 0xd9, 0x17,
-		0x3d, 0xd6, 0x0f, 0x0f, 0x10, 0xed, 0x61, 0xaf, 0x27, 0x80, 0xfe, 0xae, 0xcd, 0xef, 0xf2, 0x83,
-		0x6f, 0xdd, 0x0e, 0x73, 0x59, 0xe7, 0xc3, 0x6b, 0x38, 0x34, 0xdf, 0x47, 0xe4, 0xb1, 0x52, 0x47,
-		0xd1, 0x95, 0xe3, 0x61, 0x7e, 0xbe, 0xdd, 0x3a, 0x7f, 0x5f, 0x38, 0xaa, 0x96, 0x3d, 0x42, 0xdf,
-		0x13, 0x78, 0x45, 0x0e, 0xd5, 0xe3, 0x53, 0x35, 0x21, 0xd5, 0x12, 0x52, 0x25, 0x3e, 0xd5, 0x89,
-		0x7b, 0x3f, 0xce, 0xad, 0x93, 0xdd, 0xb2, 0xe2, 0xda, 0x8c, 0xd7, 0x95, 0xf2, 0xbd, 0x7a, 0x5f,
-		0x97, 0x77, 0x6d, 0xf1, 0x37, 0x6f, 0xde, 0x6f, 0xd3, 0x7b, 0x89, 0xbd, 0xcf, 0xe2, 0x23, 0xcd,
-		0xbe, 0x78, 0xee, 0x4b, 0x27, 0x57, 0xca, 0x75, 0xcd, 0x36, 0x5b, 0x4e, 0xbd, 0x58, 0xbc, 0x76,
-		0x6e, 0xf2, 0x99, 0x37, 0x8f, 0xbb, 0x3a, 0x8a, 0x8d, 0x85, 0xdd, 0xeb, 0xe0, 0xf4, 0x3c, 0x4c,
-		0xb6, 0x56, 0x41, 0x9d, 0x4d, 0xc0, 0x97, 0x1b, 0xd0, 0x72, 0x03, 0xd5, 0xb7, 0x00, 0xd4, 0xea,
-		0x16, 0x05, 0xb7, 0x33, 0x2e, 0xf2, 0x9a, 0x2d, 0x69, 0xfc, 0xeb, 0x2c, 0xad, 0x7e, 0xdc, 0xeb,
-		0xac, 0xa7, 0x12, 0x36, 0xc6, 0x40, 0x3c, 0x31, 0xce, 0xc6, 0xcd, 0x11, 0x8d, 0x4e, 0x84, 0xa3,
-		0x0f, 0xe1, 0xe8, 0x82, 0x67, 0xf3, 0xe4, 0x6c, 0xeb, 0xa6, 0x70, 0x9a, 0xf7, 0xe4, 0x5e, 0xec,
-		0xa4, 0x3e, 0x2b, 0x39, 0x4c, 0x56, 0x77, 0x2b, 0xb3, 0x97, 0xd6, 0x89, 0x07, 0x0d, 0xda, 0xe0,
-		0xce, 0x5b, 0xea, 0x30, 0xaf, 0xed, 0x5a, 0x03, 0x21, 0xdc, 0x37, 0xd7, 0x0d, 0x7a, 0x36, 0x78,
-		0x3b, 0x0a, 0x18, 0xad, 0xee, 0x4e, 0xe6, 0x2b, 0xf1, 0x08, 0xa4, 0x9e, 0xb0, 0x4d, 0xbe, 0x68,
-		0xd1, 0xf3, 0x5d, 0xcb, 0x7e, 0x91, 0x69, 0x25, 0xf6, 0x2e, 0xc1, 0xcc, 0x77, 0x66, 0x9b, 0xcf,
-		0x3d, 0xd6, 0x11, 0xd7, 0xad, 0xe9, 0x40, 0xde, 0x8c, 0x2f, 0xd6, 0x35, 0x87, 0xbd, 0x60, 0x3f,
-		0xc7, 0xe2, 0x00, 0x75, 0x84, 0x3a, 0x26, 0xab, 0x8e, 0xcf, 0x8e, 0xd3, 0x63, 0xa6, 0x54, 0x6b,
-		0xbf, 0x72, 0x82, 0xfa, 0xd8, 0x73, 0x9c, 0xc1, 0xb3, 0xd9, 0xfe, 0x6a, 0xf4, 0x9d, 0x8e, 0x44,
-		0x45, 0xca, 0xe2, 0x70, 0x71, 0xdd, 0xec, 0x9a, 0x3d, 0x0f, 0xca, 0x09, 0xe5, 0x84, 0x72, 0xae,
-		0xfa, 0xce, 0xbe, 0x3f, 0x14, 0x57, 0xc9, 0xf1, 0x20, 0x28, 0x14, 0x14, 0x2a, 0x51, 0x85, 0x1a,
-		0x5a, 0xb6, 0x5f, 0xae, 0x4b, 0xe8, 0x53, 0x1d, 0x1d, 0x33, 0x16, 0x87, 0xa3, 0x63, 0x86, 0xf4,
-		0x92, 0xd5, 0x6b, 0xb5, 0x13, 0x34, 0xcb, 0x50, 0xf2, 0x38, 0x42, 0x49, 0x27, 0x32, 0x49, 0x26,
-		0xf0, 0x39, 0xf0, 0x39, 0x3b, 0x45, 0x78, 0xec, 0xfc, 0xc5, 0x04, 0xd0, 0xa8, 0xdc, 0x68, 0x94,
-		0x5c, 0x67, 0x4b, 0x99, 0x8e, 0x96, 0x6a, 0x9d, 0x2c, 0x97, 0x8f, 0xe7, 0x82, 0x0b, 0xaa, 0xc8,
-		0x17, 0x3c, 0x37, 0x29, 0x10, 0xb3, 0x43, 0xe2, 0xe3, 0x55, 0x3f, 0x72, 0xd5, 0x87, 0xc8, 0x35,
-		0x10, 0xf8, 0xe2, 0xf4, 0x3a, 0x7c, 0x97, 0xcc, 0x45, 0x9b, 0x36, 0x1b, 0x82, 0x43, 0x37, 0x1c,
-		0xba, 0xa1, 0xf5, 0x14, 0xfc, 0x64, 0x3a, 0x7e, 0x52, 0xb8, 0x29, 0x40, 0xc7, 0xf9, 0x5b, 0xe1,
-		0x5e, 0xec, 0x60, 0xb4, 0x68, 0xf1, 0xc0, 0x8c, 0x29, 0x2f, 0xed, 0x48, 0x5d, 0x9c, 0xd5, 0x45,
-		0x79, 0x8b, 0xa2, 0x9a, 0x48, 0x12, 0x12, 0x89, 0xd7, 0xc2, 0x09, 0xb7, 0xd9, 0x7d, 0x2b, 0xdb,
-		0xa7, 0xb8, 0x78, 0xb3, 0x90, 0x06, 0xc9, 0x48, 0xc9, 0x9a, 0x29, 0x90, 0x8e, 0x24, 0xe4, 0xa3,
-		0xae, 0xa5, 0x55, 0x6d, 0xdf, 0xab, 0x65, 0x8d, 0x77, 0xe8, 0xf2, 0xeb, 0xe1, 0x40, 0xde, 0x55,
-		0x0f, 0x07, 0x70, 0xd4, 0x70, 0xd4, 0x70, 0xd4, 0x70, 0xd4, 0x70, 0xd4, 0x70, 0xd4, 0x70, 0xd4,
-		0xe2, 0x8e, 0x3a, 0xe3, 0xa5, 0x78, 0x9b, 0xf8, 0xd2, 0x88, 0xa2, 0x44, 0x6f, 0xe8, 0x34, 0x7a,
-		0x43, 0x83, 0x0a, 0x03, 0x15, 0x06, 0x84, 0x0d, 0x84, 0x0d, 0x84, 0x0d, 0x84, 0x0d, 0x84, 0x0d,
-		0x84, 0x0d, 0x84, 0x0d, 0x2a, 0x0c, 0x8e, 0x1a, 0x8e, 0x1a, 0x8e, 0x1a, 0x8e, 0x1a, 0x8e, 0x1a,
-		0x8e, 0x1a, 0x54, 0x58, 0x1a, 0x54, 0xd8, 0x56, 0x5e, 0xde, 0xc4, 0xfd, 0xfa, 0xe2, 0x17, 0x38,
-		0x5d, 0x4e, 0x27, 0x69, 0xfd, 0xee, 0xf4, 0x3a, 0x8f, 0x56, 0x5f, 0xcb, 0x0d, 0x4e, 0x5c, 0x15,
-		0x1b, 0x22, 0x95, 0x1a, 0x9c, 0x46, 0x05, 0x69, 0x97, 0x59, 0x4d, 0xbb, 0xe4, 0x06, 0x39, 0x12,
-		0x2d, 0x2e, 0x45, 0x5a, 0x59, 0x4a, 0x35, 0x9d, 0x96, 0xbd, 0x08, 0x97, 0x87, 0x33, 0x17, 0xe2,
-		0xca, 0x85, 0xb3, 0x8f, 0x2b, 0x50, 0x83, 0x4c, 0xa9, 0x01, 0x77, 0xf6, 0xb1, 0xd9, 0xe9, 0x5b,
-		0xb6, 0x31, 0x96, 0x89, 0xa1, 0x27, 0x7e, 0xee, 0xb2, 0x30, 0x1a, 0x15, 0x3b, 0xc9, 0x85, 0xaf,
-		0xa8, 0xd8, 0x09, 0xba, 0xe2, 0x0c, 0xfb, 0xcc, 0x35, 0x05, 0x3a, 0x4e, 0x2d, 0xd8, 0xb6, 0xaa,
-		0xc0, 0x98, 0x0b, 0x7b, 0xd8, 0x1f, 0x3f, 0xe4, 0x28, 0xc1, 0xb3, 0xd0, 0xb6, 0x33, 0x1c, 0x83,
-		0x28, 0x4f, 0xa6, 0x34, 0x20, 0x1c, 0x89, 0xe2, 0x00, 0xa8, 0xa4, 0x84, 0x4a, 0x8a, 0xdf, 0x18,
-		0x68, 0xba, 0xae, 0xc5, 0x5c, 0xc3, 0x77, 0x4d, 0xdb, 0xb3, 0xc6, 0x0a, 0xe9, 0x29, 0x5c, 0x1f,
-		0xb8, 0x62, 0x32, 0x50, 0xa9, 0xa0, 0x52, 0xb7, 0x8a, 0x4a, 0x0d, 0x4d, 0x74, 0xbd, 0xaa, 0xc0,
-		0xa6, 0xbe, 0x03, 0x9b, 0x0a, 0x36, 0x35, 0xa3, 0x6c, 0x6a, 0xf9, 0x5d, 0xb5, 0x5a, 0x3f, 0xad,
-		0x56, 0x4b, 0xa7, 0x27, 0xa7, 0xa5, 0xb3, 0x5a, 0xad, 0x5c, 0x2f, 0x83, 0x57, 0xa5, 0xe3, 0x55,
-		0x05, 0xf0, 0x90, 0x65, 0x1b, 0xcf, 0xae, 0x63, 0x76, 0xda, 0xa6, 0xe7, 0x1b, 0x83, 0xaf, 0xbe,
-		0xa7, 0x52, 0x71, 0xfe, 0x76, 0x2a, 0xf8, 0x65, 0xf8, 0x65, 0xf8, 0x65, 0xf8, 0x65, 0xf8, 0x65,
-		0xf8, 0x65, 0xf8, 0x65, 0x51, 0xbf, 0xdc, 0xb1, 0xbc, 0xb6, 0xe9, 0x76, 0xd4, 0x3c, 0x72, 0x34,
-		0x09, 0x7c, 0x31, 0x7c, 0x31, 0x7c, 0x31, 0x7c, 0x31, 0x7c, 0x31, 0x7c, 0x31, 0x7c, 0xb1, 0xa8,
-		0x2f, 0x66, 0xae, 0xeb, 0xb8, 0x6a, 0x9e, 0x38, 0x9c, 0x02, 0x7e, 0x18, 0x7e, 0x18, 0x7e, 0x18,
-		0x7e, 0x18, 0x7e, 0x18, 0x7e, 0x18, 0x7e, 0x58, 0xd4, 0x0f, 0x77, 0xdb, 0x1e, 0x85, 0x2f, 0x9e,
-		0x9b, 0x06, 0xfe, 0x18, 0xfe, 0x18, 0xfe, 0x18, 0xfe, 0x18, 0xfe, 0x18, 0xfe, 0x18, 0xfe, 0x58,
-		0xd4, 0x1f, 0xf7, 0x87, 0x3d, 0xdf, 0xa2, 0x39, 0x3b, 0x7e, 0x33, 0x15, 0xfc, 0x32, 0xfc, 0x32,
-		0xfc, 0x32, 0xfc, 0x32, 0xfc, 0x32, 0xfc, 0x32, 0xfc, 0xb2, 0xa8, 0x5f, 0x76, 0xda, 0x3e, 0x53,
-		0xf4, 0xc7, 0xe1, 0x14, 0xf0, 0xc3, 0xf0, 0xc3, 0xf0, 0xc3, 0xf0, 0xc3, 0xf0, 0xc3, 0xf0, 0xc3,
-		0xf0, 0xc3, 0xa2, 0x7e, 0x58, 0x39, 0x2a, 0x46, 0x2c, 0x0c, 0x1f, 0x0c, 0x1f, 0x0c, 0x1f, 0x0c,
-		0x1f, 0x0c, 0x1f, 0x0c, 0x1f, 0x2c, 0xe5, 0x83, 0x87, 0x36, 0x11, 0x43, 0xbd, 0x30, 0x11, 0x7c,
-		0x32, 0x7c, 0x32, 0x7c, 0x32, 0x7c, 0x32, 0x7c, 0x32, 0x7c, 0x32, 0x7c, 0xb2, 0xb8, 0x4f, 0xfe,
-		0x6a, 0x3b, 0x7f, 0xdb, 0xc6, 0xc0, 0x75, 0x7c, 0x47, 0xd5, 0x2b, 0x2f, 0x4c, 0x05, 0xbf, 0x0c,
-		0xbf, 0x0c, 0xbf, 0x0c, 0xbf, 0x0c, 0xbf, 0x0c, 0xbf, 0x0c, 0xbf, 0x2c, 0xe4, 0x97, 0x7b, 0xe3,
-		0xe0, 0xb6, 0xdd, 0x63, 0xa6, 0x2b, 0xef, 0x90, 0xe7, 0xe6, 0x80, 0x27, 0x86, 0x27, 0xde, 0x2a,
-		0x4f, 0xec, 0x5b, 0x7d, 0xe6, 0x5b, 0xed, 0xaf, 0x1e, 0x7c, 0x31, 0x7c, 0x31, 0x7c, 0x31, 0x7c,
-		0xb1, 0x46, 0x5f, 0xec, 0x0c, 0x7d, 0xb2, 0xc6, 0x5c, 0x2b, 0xe6, 0x82, 0x6f, 0x86, 0x6f, 0x46,
-		0x94, 0x0c, 0xcf, 0x0c, 0xcf, 0x0c, 0xcf, 0x0c, 0xcf, 0x2c, 0xec, 0x99, 0xd5, 0x5b, 0x73, 0x2d,
-		0xcc, 0x02, 0x6f, 0x0c, 0x6f, 0x0c, 0x6f, 0x0c, 0x6f, 0x0c, 0x6f, 0x0c, 0x6f, 0x0c, 0x6f, 0x2c,
-		0xec, 0x8d, 0x55, 0x1b, 0x82, 0xcc, 0xcd, 0x01, 0x4f, 0x0c, 0x4f, 0x0c, 0x4f, 0x0c, 0x4f, 0x0c,
-		0x4f, 0x0c, 0x4f, 0x0c, 0x4f, 0x2c, 0xec, 0x89, 0xa9, 0xda, 0x81, 0xac, 0x98, 0x0b, 0x9e, 0x19,
-		0x9e, 0x19, 0x9e, 0x19, 0x9e, 0x19, 0x9e, 0x19, 0x9e, 0x19, 0x9e, 0x59, 0xd8, 0x33, 0xab, 0x36,
-		0x04, 0x99, 0x9b, 0x03, 0x9e, 0x18, 0x9e, 0x18, 0x9e, 0x18, 0x9e, 0x18, 0x9e, 0x18, 0x9e, 0x18,
-		0x9e, 0x58, 0xd8, 0x13, 0xab, 0x47, 0xc6, 0x88, 0x87, 0xe1, 0x85, 0xe1, 0x85, 0xe1, 0x85, 0xe1,
-		0x85, 0xe1, 0x85, 0xe1, 0x85, 0xe5, 0xbc, 0x30, 0x4d, 0x53, 0x90, 0xa5, 0x99, 0xe0, 0x95, 0xe1,
-		0x95, 0xe1, 0x95, 0xe1, 0x95, 0xe1, 0x95, 0xe1, 0x95, 0xe1, 0x95, 0x39, 0x3f, 0xc9, 0x29, 0x53,
-		0xc5, 0x86, 0x6d, 0x3b, 0xbe, 0x39, 0x5e, 0x5a, 0x21, 0x31, 0x2a, 0x7a, 0xed, 0x2f, 0xac, 0x6f,
-		0x0e, 0x4c, 0xff, 0xcb, 0xd8, 0xa2, 0x1d, 0x3b, 0x03, 0x66, 0xb7, 0x03, 0x0f, 0x6a, 0x58, 0x63,
-		0xdb, 0xd6, 0x35, 0xdb, 0xcc, 0x3b, 0x5e, 0xf5, 0xe3, 0xb1, 0xe7, 0x9b, 0x3e, 0x3b, 0x0e, 0x6d,
-		0xa0, 0x88, 0x77, 0x2f, 0x7a, 0xbe, 0x3b, 0x6c, 0xfb, 0x76, 0x68, 0x45, 0x2f, 0xa7, 0x33, 0xb6,
-		0xce, 0xa7, 0x73, 0xed, 0xd1, 0xac, 0x1f, 0xc7, 0xda, 0x15, 0x3b, 0xcc, 0x6b, 0xbb, 0xd6, 0x40,
-		0x68, 0xe1, 0x22, 0x07, 0x30, 0x3f, 0x98, 0x73, 0x9f, 0xc4, 0x54, 0x54, 0x18, 0xcd, 0xc8, 0xa0,
-		0x18, 0x69, 0xf4, 0x22, 0x8b, 0x5a, 0x94, 0xd1, 0x8a, 0x32, 0x4a, 0x51, 0x41, 0x27, 0xb4, 0x7a,
-		0x2b, 0x8c, 0x42, 0xa2, 0xdd, 0xf2, 0x7c, 0xd7, 0xb2, 0x5f, 0x44, 0xb6, 0x2b, 0x94, 0xbd, 0xf2,
-		0xbb, 0x04, 0xf5, 0x8b, 0xd9, 0xe6, 0x73, 0x8f, 0x75, 0xc4, 0x75, 0x6b, 0x3a, 0x90, 0x73, 0x1d,
-		0x3f, 0xb2, 0xae, 0x39, 0xec, 0x05, 0xfb, 0x39, 0x16, 0x07, 0xa8, 0x23, 0xd4, 0x31, 0x59, 0x75,
-		0x7c, 0x76, 0x9c, 0x1e, 0x33, 0x6d, 0x19, 0x7d, 0x2c, 0x27, 0xa8, 0x8f, 0x56, 0xd7, 0xb2, 0x3b,
-		0xec, 0xbb, 0xb8, 0x3e, 0x4e, 0x07, 0x42, 0xb1, 0xa0, 0x58, 0x89, 0x2a, 0xd6, 0xd0, 0xb2, 0xfd,
-		0x93, 0x8a, 0x84, 0x5e, 0x9d, 0x0a, 0x0c, 0x91, 0x0b, 0xa9, 0x25, 0xb8, 0x03, 0x95, 0x10, 0x5a,
-		0x31, 0xbe, 0x53, 0x0d, 0x99, 0x29, 0x82, 0x37, 0x89, 0x10, 0x59, 0x29, 0x34, 0xa6, 0x5a, 0xb2,
-		0x6a, 0xe5, 0xac, 0x7a, 0x56, 0x3f, 0xad, 0x9c, 0xd5, 0x52, 0x5c, 0x3b, 0x4d, 0x81, 0x68, 0x33,
-		0x41, 0xf7, 0x33, 0x69, 0x81, 0xf5, 0x25, 0xd4, 0x36, 0x41, 0x17, 0x34, 0x3f, 0x18, 0x6e, 0x08,
-		0x6e, 0x28, 0x51, 0x37, 0x24, 0xd7, 0xe0, 0x4a, 0x82, 0xe6, 0x85, 0x2f, 0x82, 0x2f, 0x4a, 0x88,
-		0x96, 0x85, 0x57, 0x0a, 0xbd, 0x92, 0xf3, 0x62, 0xb5, 0xcd, 0x9e, 0x84, 0x47, 0x0a, 0x07, 0xc2,
-		0x1b, 0xc1, 0x1b, 0x81, 0x6d, 0x58, 0xa5, 0x58, 0xce, 0xe0, 0xd9, 0x6c, 0x7f, 0x35, 0xfa, 0x4e,
-		0x47, 0x06, 0xf0, 0x2d, 0x0c, 0x17, 0x67, 0x02, 0xbb, 0x66, 0xcf, 0x03, 0x54, 0x84, 0x72, 0x42,
-		0x39, 0x57, 0x7d, 0x67, 0xdf, 0x1f, 0x8a, 0xab, 0xe4, 0x78, 0x10, 0x14, 0x0a, 0x0a, 0x95, 0x38,
-		0x05, 0x58, 0xae, 0x4b, 0xe8, 0x53, 0x1d, 0x61, 0x17, 0xc2, 0x2e, 0xa2, 0x25, 0xab, 0xd7, 0x6a,
-		0x27, 0x88, 0xb3, 0x94, 0x3c, 0x4e, 0x98, 0xf2, 0x21, 0xe8, 0x72, 0x82, 0x51, 0xf0, 0x39, 0xf0,
-		0x39, 0x89, 0xfa, 0x9c, 0x7c, 0xa4, 0x57, 0x38, 0x03, 0xe6, 0x1a, 0x9e, 0x6f, 0xfa, 0x43, 0x4f,
-		0x5c, 0xb1, 0xe6, 0x07, 0x43, 0xbf, 0xa0, 0x5f, 0x89, 0xea, 0x17, 0xb3, 0x87, 0x7d, 0xe6, 0x9a,
-		0x02, 0xb9, 0x73, 0x0b, 0x4a, 0x56, 0x15, 0x18, 0x73, 0x61, 0x0f, 0xfb, 0xe3, 0x87, 0x1c, 0x25,
-		0xa8, 0x98, 0xbe, 0xc8, 0xc2, 0xcc, 0x0e, 0x19, 0xc6, 0xa3, 0xa0, 0x8a, 0x50, 0xc5, 0x44, 0x55,
-		0xd1, 0xea, 0x30, 0xdb, 0xb7, 0xfc, 0x57, 0x97, 0x75, 0x65, 0x54, 0x51, 0x00, 0x14, 0x17, 0x2f,
-		0xc3, 0xaf, 0xfa, 0x60, 0x7a, 0x4c, 0xe5, 0x66, 0xc3, 0x30, 0x65, 0xd8, 0x08, 0x14, 0x86, 0x7a,
-		0xc1, 0xf7, 0xd4, 0x3e, 0xb1, 0x61, 0xc3, 0x44, 0x53, 0xb5, 0x55, 0x53, 0xb4, 0xd7, 0x2f, 0x4f,
-		0xfc, 0xab, 0xac, 0x79, 0x8d, 0xa2, 0x37, 0x7c, 0x9e, 0x7d, 0xe1, 0xc6, 0xb7, 0x98, 0x61, 0xaa,
-		0x85, 0x61, 0x1b, 0x96, 0x69, 0x2a, 0x5e, 0x1b, 0x3e, 0xc6, 0x6b, 0xd7, 0x44, 0xec, 0x99, 0xb0,
-		0x1d, 0x13, 0xb5, 0x5f, 0xd2, 0x76, 0x4b, 0xda, 0x5e, 0xc9, 0xd8, 0x29, 0x35, 0x31, 0xff, 0x68,
-		0xb9, 0x9c, 0xf2, 0x3d, 0x27, 0x15, 0xe2, 0x2e, 0x73, 0x61, 0xb4, 0x98, 0xeb, 0x2c, 0xc3, 0x75,
-		0xc2, 0x75, 0x8a, 0x88, 0x6a, 0x34, 0xa0, 0x3d, 0x95, 0x08, 0x49, 0x07, 0x16, 0x8e, 0x97, 0xab,
-		0x88, 0x2d, 0xa3, 0x22, 0x36, 0x01, 0xf1, 0x26, 0x13, 0x73, 0x0a, 0x71, 0x97, 0x24, 0x01, 0x05,
-		0xf7, 0x5b, 0x54, 0x0d, 0xa2, 0x81, 0x32, 0x45, 0x54, 0xb1, 0x12, 0x23, 0x5e, 0x54, 0x45, 0xcd,
-		0xf8, 0xca, 0x2a, 0x0c, 0x85, 0xe2, 0x90, 0x29, 0x10, 0x95, 0x22, 0x91, 0x2b, 0x14, 0xb9, 0x62,
-		0x51, 0x2a, 0x98, 0x9c, 0xa2, 0x49, 0x2a, 0x9c, 0x7c, 0xe8, 0x46, 0xc7, 0x5a, 0x2a, 0xb2, 0x98,
-		0xea, 0xeb, 0x26, 0x73, 0x1c, 0x24, 0x5a, 0x54, 0xb6, 0x86, 0x84, 0x12, 0x29, 0x32, 0x5b, 0x36,
-		0x98, 0x52, 0x45, 0x67, 0x30, 0x57, 0x30, 0x57, 0x30, 0x57, 0x92, 0x99, 0x32, 0xb1, 0xf6, 0xaa,
-		0x9c, 0x61, 0x7b, 0x25, 0x56, 0x72, 0xb7, 0x86, 0xf6, 0xe2, 0x2f, 0xc0, 0x5b, 0x67, 0xab, 0x4a,
-		0x30, 0x54, 0x30, 0x54, 0x30, 0x54, 0x22, 0xd2, 0x22, 0x5c, 0x84, 0x18, 0xa7, 0x3b, 0xa7, 0x0a,
-		0x53, 0xa8, 0xf5, 0xfd, 0x99, 0xfe, 0x51, 0x13, 0xd7, 0x02, 0x55, 0x1f, 0x20, 0x22, 0xa3, 0xb2,
-		0x34, 0x1d, 0x51, 0x5f, 0xa0, 0x68, 0x3e, 0xc2, 0x8e, 0x35, 0x8a, 0xe2, 0xbc, 0xb8, 0x05, 0x04,
-		0xfd, 0x82, 0x74, 0x6f, 0x81, 0x6a, 0xd1, 0x64, 0x22, 0x7b, 0xb1, 0x97, 0xce, 0xe8, 0x66, 0x52,
-		0x70, 0x45, 0x2b, 0x63, 0x24, 0xd9, 0x75, 0x28, 0x1a, 0xaf, 0x70, 0xb4, 0x35, 0x7f, 0x94, 0xb4,
-		0xf0, 0xb7, 0xe3, 0x90, 0x5c, 0xcd, 0x40, 0x33, 0x45, 0x39, 0xdc, 0xa7, 0x84, 0xf7, 0xd0, 0x36,
-		0x31, 0x0d, 0xec, 0x85, 0xb6, 0x89, 0x02, 0xbb, 0xdd, 0x63, 0x66, 0x57, 0x2c, 0xd5, 0x60, 0x29,
-		0xd8, 0x93, 0x40, 0x51, 0xc5, 0xbb, 0xd0, 0xca, 0x1c, 0x1d, 0x85, 0x16, 0xe2, 0x78, 0xa2, 0x5f,
-		0x19, 0xb0, 0x13, 0x93, 0x73, 0x7a, 0x69, 0x3b, 0x31, 0x19, 0x9e, 0xf0, 0x61, 0x52, 0x05, 0x76,
-		0x02, 0x76, 0x62, 0x1d, 0xdf, 0x20, 0x7b, 0x98, 0x64, 0x76, 0xfa, 0x96, 0x2d, 0x9a, 0xd3, 0x1a,
-		0x2b, 0x32, 0x0b, 0xb3, 0x81, 0xf6, 0x00, 0xed, 0x01, 0xda, 0x43, 0x40, 0x5a, 0xe4, 0x92, 0x74,
-		0x63, 0x7d, 0x4d, 0x55, 0x61, 0x0e, 0xb1, 0x24, 0x5e, 0x82, 0xc0, 0x49, 0xc2, 0xcd, 0x44, 0xcd,
-		0x50, 0x95, 0xcd, 0x96, 0x44, 0x5b, 0x55, 0x02, 0xef, 0x0e, 0x93, 0x05, 0x93, 0x95, 0x8e, 0xc9,
-		0x92, 0x45, 0x0b, 0x33, 0xd5, 0x33, 0x5d, 0xd7, 0x62, 0xae, 0xe1, 0xbb, 0xa6, 0xed, 0x59, 0x63,
-		0x83, 0xe5, 0xa9, 0x6f, 0x78, 0xa4, 0x8d, 0x2b, 0x26, 0x3f, 0xcc, 0x44, 0xab, 0x6e, 0x55, 0x45,
-		0xa5, 0x54, 0x58, 0x72, 0xc5, 0xa5, 0x56, 0x60, 0x6d, 0x8a, 0xac, 0x4d, 0xa1, 0x75, 0x28, 0x36,
-		0x11, 0xf3, 0xa9, 0x28, 0x6f, 0xca, 0x18, 0x25, 0xce, 0x65, 0x4a, 0xdd, 0xc6, 0x10, 0xa7, 0x9e,
-		0xef, 0x08, 0xa6, 0xa2, 0x39, 0xb5, 0x99, 0xfe, 0xa1, 0xd1, 0x80, 0x02, 0xf5, 0x29, 0x0e, 0xb1,
-		0x5d, 0x5b, 0x9a, 0x96, 0xf8, 0x54, 0x27, 0x9a, 0x57, 0xc3, 0x89, 0x02, 0x91, 0x86, 0x2c, 0x6e,
-		0x15, 0xe1, 0x69, 0x4f, 0x52, 0x5b, 0x45, 0x7f, 0x7b, 0x44, 0xa2, 0xbb, 0xb7, 0x97, 0x8d, 0x59,
-		0x9a, 0x29, 0x9d, 0x4f, 0xa9, 0xdc, 0x65, 0x62, 0xd9, 0xc6, 0xb3, 0xeb, 0x98, 0x1d, 0xf9, 0x4b,
-		0xa4, 0x62, 0x8d, 0xfc, 0xf2, 0xd4, 0xc0, 0x61, 0xc0, 0x61, 0xc0, 0x61, 0xc0, 0x61, 0xc0, 0x61,
-		0xc0, 0x61, 0xc0, 0x61, 0xc0, 0x61, 0xc0, 0x61, 0xf3, 0x38, 0xac, 0x63, 0x79, 0x6d, 0xd3, 0xed,
-		0xd0, 0x22, 0xb0, 0x68, 0x52, 0x60, 0x2f, 0x60, 0x2f, 0x60, 0x2f, 0x60, 0x2f, 0x60, 0x2f, 0x60,
-		0x2f, 0x60, 0x2f, 0x60, 0x2f, 0x60, 0xaf, 0x79, 0xec, 0xc5, 0x5c, 0xd7, 0x71, 0x69, 0x91, 0x57,
-		0x38, 0x25, 0x70, 0x17, 0x70, 0x17, 0x70, 0x17, 0x70, 0x17, 0x70, 0x17, 0x70, 0x17, 0x70, 0x17,
-		0x70, 0x17, 0x70, 0xd7, 0x3c, 0xee, 0xea, 0xb6, 0x3d, 0x1d, 0xd8, 0x6b, 0x6e, 0x5a, 0xe0, 0x2f,
-		0xe0, 0x2f, 0xe0, 0x2f, 0xe0, 0x2f, 0xe0, 0x2f, 0xe0, 0x2f, 0xe0, 0x2f, 0xe0, 0x2f, 0xe0, 0xaf,
-		0x79, 0xfc, 0xd5, 0x1f, 0xf6, 0x7c, 0x4b, 0x4f, 0xee, 0xd7, 0x9b, 0xa9, 0x81, 0xc3, 0x80, 0xc3,
-		0x80, 0xc3, 0x80, 0xc3, 0x80, 0xc3, 0x80, 0xc3, 0x80, 0xc3, 0x80, 0xc3, 0x80, 0xc3, 0xe6, 0x71,
-		0x98, 0xd3, 0xf6, 0x19, 0x31, 0xfe, 0x0a, 0xa7, 0x04, 0xee, 0x02, 0xee, 0x02, 0xee, 0x02, 0xee,
-		0x02, 0xee, 0x02, 0xee, 0x02, 0xee, 0x02, 0xee, 0x02, 0xee, 0x9a, 0xc7, 0x5d, 0xe4, 0xac, 0x17,
-		0xb8, 0x2e, 0x60, 0x2e, 0x60, 0x2e, 0x60, 0x2e, 0x60, 0x2e, 0x60, 0x2e, 0x60, 0x2e, 0x60, 0x2e,
-		0x60, 0xae, 0x25, 0xcc, 0x35, 0xb4, 0x35, 0x9d, 0x38, 0x2e, 0x4c, 0x0c, 0x0c, 0x06, 0x0c, 0x06,
-		0x0c, 0x06, 0x0c, 0x06, 0x0c, 0x06, 0x0c, 0x06, 0x0c, 0x06, 0x0c, 0x06, 0x0c, 0xb6, 0x88, 0xc1,
-		0xbe, 0xda, 0xce, 0xdf, 0xb6, 0x31, 0x70, 0x1d, 0xdf, 0xa1, 0x46, 0x61, 0x0b, 0x53, 0x03, 0x87,
-		0x01, 0x87, 0x01, 0x87, 0x01, 0x87, 0x01, 0x87, 0x01, 0x87, 0x01, 0x87, 0x01, 0x87, 0x01, 0x87,
-		0x45, 0xdb, 0xd2, 0x33, 0x3d, 0xdf, 0x68, 0xf7, 0x98, 0xe9, 0xd2, 0x01, 0xb0, 0xb9, 0x39, 0x81,
-		0xbc, 0x80, 0xbc, 0x80, 0xbc, 0x32, 0x84, 0xbc, 0x7c, 0xab, 0xcf, 0x7c, 0xab, 0xfd, 0xd5, 0x03,
-		0xf6, 0x02, 0xf6, 0x02, 0xf6, 0x02, 0xf6, 0x02, 0xf6, 0x4a, 0x0d, 0x7b, 0x39, 0x43, 0x5f, 0x5b,
-		0xe3, 0xfb, 0x15, 0x73, 0x03, 0x8b, 0x01, 0x8b, 0x01, 0x8b, 0x81, 0x05, 0x03, 0x12, 0x03, 0x12,
-		0x03, 0x12, 0x03, 0x12, 0x03, 0x12, 0x5b, 0x40, 0x62, 0xf4, 0xad, 0xef, 0x17, 0x66, 0x05, 0xfa,
-		0x02, 0xfa, 0x02, 0xfa, 0x02, 0xfa, 0x02, 0xfa, 0x02, 0xfa, 0x02, 0xfa, 0x02, 0xfa, 0x02, 0xfa,
-		0x5a, 0x40, 0x5f, 0xd4, 0x0d, 0x58, 0xe7, 0xe6, 0x04, 0xf2, 0x02, 0xf2, 0x02, 0xf2, 0x02, 0xf2,
-		0x02, 0xf2, 0x02, 0xf2, 0x02, 0xf2, 0x02, 0xf2, 0x02, 0xf2, 0x5a, 0x40, 0x5e, 0xba, 0xda, 0xaf,
-		0xae, 0x98, 0x1b, 0x48, 0x0c, 0x48, 0x0c, 0x48, 0x0c, 0x48, 0x0c, 0x48, 0x0c, 0x48, 0x0c, 0x48,
-		0x0c, 0x48, 0x0c, 0x48, 0x6c, 0x01, 0x89, 0x51, 0x37, 0x60, 0x9d, 0x9b, 0x13, 0xc8, 0x0b, 0xc8,
-		0x0b, 0xc8, 0x0b, 0xc8, 0x0b, 0xc8, 0x0b, 0xc8, 0x0b, 0xc8, 0x0b, 0xc8, 0x0b, 0xc8, 0x6b, 0x01,
-		0x79, 0xd1, 0x33, 0x5f, 0xe0, 0xbb, 0x80, 0xba, 0x80, 0xba, 0x80, 0xba, 0x80, 0xba, 0x80, 0xba,
-		0x80, 0xba, 0x80, 0xba, 0x80, 0xba, 0x96, 0x51, 0x97, 0x9e, 0x26, 0xac, 0x4b, 0x33, 0x03, 0x85,
-		0x01, 0x85, 0x01, 0x85, 0x01, 0x85, 0x01, 0x85, 0x01, 0x85, 0x01, 0x85, 0x01, 0x85, 0x6d, 0x1b,
-		0x0a, 0xdb, 0x4b, 0x50, 0xe6, 0x8b, 0x0d, 0xdb, 0x76, 0x7c, 0x73, 0xbc, 0x95, 0x4a, 0x62, 0x5e,
-		0xf4, 0xda, 0x5f, 0x58, 0xdf, 0x1c, 0x98, 0xfe, 0x97, 0xb1, 0x47, 0x38, 0x76, 0x06, 0xcc, 0x6e,
-		0x07, 0x08, 0xc9, 0xb0, 0xc6, 0xbe, 0xa1, 0x6b, 0xb6, 0x99, 0x77, 0xbc, 0xea, 0xc7, 0x63, 0x6f,
-		0xf8, 0x3c, 0xf7, 0xfb, 0xf9, 0xbf, 0x1d, 0x7b, 0xbe, 0xe9, 0xb3, 0xe3, 0xd0, 0xbd, 0xa8, 0x00,
-		0xbf, 0xa2, 0xe7, 0xbb, 0xc3, 0xb6, 0x6f, 0x87, 0x0e, 0xeb, 0x72, 0xfa, 0x0d, 0xad, 0x87, 0xb9,
-		0xaf, 0x6b, 0x9d, 0x4f, 0xbf, 0x68, 0x2f, 0x99, 0x7d, 0x93, 0xd8, 0xb3, 0x62, 0x87, 0x79, 0x6d,
-		0xd7, 0x1a, 0x28, 0x6d, 0x58, 0xe4, 0xb8, 0xe7, 0x27, 0x93, 0x94, 0x1f, 0x35, 0x53, 0xa6, 0x8c,
-		0xa2, 0x29, 0xd0, 0x33, 0x19, 0x6a, 0xa6, 0x42, 0xcb, 0xe4, 0x28, 0x99, 0x1c, 0x1d, 0x53, 0xa2,
-		0xe2, 0x64, 0xed, 0x9d, 0x32, 0xfa, 0x8d, 0xa4, 0xc5, 0xf3, 0x5d, 0xcb, 0x7e, 0x51, 0x11, 0x97,
-		0x50, 0x77, 0xca, 0xef, 0x32, 0x6c, 0x6f, 0x98, 0x6d, 0x3e, 0xf7, 0x58, 0x47, 0xdd, 0xd6, 0x4c,
-		0x27, 0x92, 0xdc, 0xb7, 0x8f, 0xac, 0x6b, 0x0e, 0x7b, 0x81, 0xbc, 0x8d, 0xc5, 0x17, 0xe6, 0x0a,
-		0xe6, 0x0a, 0xe6, 0x4a, 0x44, 0x5a, 0x9e, 0x1d, 0xa7, 0xc7, 0x4c, 0x9b, 0xc2, 0x5e, 0x95, 0x33,
-		0x6c, 0xaf, 0xac, 0xae, 0x65, 0x77, 0xd8, 0x77, 0x75, 0x7b, 0x35, 0x9d, 0x08, 0x86, 0x06, 0x86,
-		0x06, 0x86, 0x46, 0x40, 0x5a, 0x86, 0x96, 0xed, 0x9f, 0x54, 0x08, 0xec, 0xcc, 0xa9, 0xc2, 0x14,
-		0x34, 0xd4, 0x1f, 0x01, 0x47, 0x4a, 0x49, 0xf5, 0x11, 0xf3, 0x46, 0xd4, 0xd4, 0x9e, 0x0e, 0x52,
-		0x88, 0x80, 0xca, 0x23, 0xa5, 0xf0, 0x74, 0x6d, 0x41, 0xb5, 0x72, 0x56, 0x3d, 0xab, 0x9f, 0x56,
-		0xce, 0x6a, 0x19, 0xde, 0x8b, 0x94, 0x08, 0xb1, 0x66, 0x96, 0xe1, 0x06, 0x11, 0xd8, 0x50, 0x81,
-		0x1a, 0x73, 0xa1, 0x51, 0x09, 0x70, 0x05, 0x70, 0x05, 0x70, 0x05, 0x70, 0x05, 0x70, 0x05, 0x70,
-		0x05, 0x70, 0x05, 0x70, 0xe5, 0xcd, 0x32, 0x4f, 0x6e, 0xf2, 0xf9, 0x12, 0x5a, 0x1b, 0x45, 0xd0,
-		0x32, 0x3f, 0x19, 0x60, 0x07, 0x60, 0x07, 0x60, 0x87, 0x80, 0xb4, 0xd0, 0xdc, 0xdb, 0x43, 0x90,
-		0x2d, 0x05, 0xec, 0x01, 0xec, 0x91, 0x2b, 0xec, 0xa1, 0x2f, 0xbb, 0x09, 0x28, 0x24, 0x21, 0x14,
-		0xe2, 0xbc, 0x58, 0x6d, 0xb3, 0x47, 0x80, 0x40, 0xc2, 0x89, 0x80, 0x3e, 0x80, 0x3e, 0x80, 0x3e,
-		0x04, 0xa4, 0x65, 0x47, 0x0e, 0x83, 0xc3, 0x7c, 0x3f, 0x45, 0x2b, 0x13, 0xcc, 0x02, 0x13, 0x03,
-		0x13, 0x03, 0x13, 0x23, 0x20, 0x2d, 0xbb, 0x91, 0x1e, 0xe7, 0x0c, 0x98, 0x6b, 0x78, 0xbe, 0xe9,
-		0x0f, 0x3d, 0x75, 0x43, 0x33, 0x3f, 0x19, 0xec, 0x0d, 0xec, 0x0d, 0xec, 0x8d, 0x80, 0xb4, 0x30,
-		0x7b, 0xd8, 0x67, 0xae, 0xa9, 0x90, 0xcb, 0xbe, 0x60, 0x74, 0xaa, 0x0a, 0x73, 0x5c, 0xd8, 0xc3,
-		0xfe, 0xf8, 0xa5, 0x46, 0x49, 0x19, 0xae, 0x3d, 0x8d, 0x5b, 0xa5, 0x5a, 0x21, 0xa2, 0xb1, 0x32,
-		0x44, 0x4c, 0xc8, 0xf9, 0x57, 0x89, 0xef, 0x93, 0x9c, 0xeb, 0x38, 0xb6, 0x1e, 0xa2, 0x47, 0xfc,
-		0xc5, 0x2b, 0xcb, 0xf3, 0x1b, 0xbe, 0x2f, 0x76, 0x87, 0x7f, 0xf1, 0xda, 0xb2, 0x2f, 0x7a, 0x6c,
-		0x6c, 0x07, 0xc6, 0xce, 0xc8, 0x1e, 0xf6, 0x7a, 0x87, 0x7b, 0x22, 0xd4, 0x8f, 0xfc, 0xe0, 0x5b,
-		0xb7, 0xc3, 0x5c, 0xd6, 0xf9, 0xf0, 0x1a, 0x0e, 0x25, 0x5d, 0x40, 0x49, 0x01, 0xd4, 0x22, 0x78,
-		0x02, 0xa6, 0x85, 0xab, 0xe6, 0x88, 0x4f, 0x84, 0x37, 0x0b, 0xe4, 0xfa, 0x4f, 0x6c, 0x58, 0x69,
-		0xd1, 0x15, 0xa6, 0x5a, 0xd9, 0xf5, 0x2f, 0x1f, 0xff, 0x4a, 0xab, 0xff, 0x25, 0xe6, 0x25, 0xa7,
-		0xfa, 0xb7, 0x26, 0x8a, 0xe3, 0x53, 0x37, 0x21, 0xf5, 0x12, 0x52, 0x27, 0x3e, 0xf5, 0x89, 0x7b,
-		0x3f, 0xce, 0xcd, 0x93, 0xdf, 0xb4, 0x35, 0x32, 0x1f, 0x27, 0xe3, 0xab, 0x77, 0x76, 0x79, 0xdf,
-		0x16, 0x7f, 0xf3, 0xe6, 0x0d, 0x37, 0xbd, 0x99, 0xe8, 0x1b, 0x2d, 0x3e, 0xd4, 0xec, 0xab, 0xe7,
-		0xbe, 0xb6, 0xe8, 0xbd, 0x7a, 0x3e, 0xeb, 0x2f, 0x7d, 0xdd, 0x2c, 0xaa, 0x99, 0xfc, 0xfb, 0x9b,
-		0x07, 0x9d, 0x82, 0x86, 0x37, 0xbf, 0x8e, 0x43, 0xd3, 0xeb, 0x50, 0xf2, 0x3c, 0xfa, 0xf5, 0x5e,
-		0x57, 0xc5, 0x01, 0x9b, 0x60, 0x2d, 0x37, 0x5c, 0xe5, 0x86, 0xa1, 0x6f, 0xe1, 0xe5, 0xf8, 0xb9,
-		0x04, 0xb7, 0xf2, 0xa3, 0xb5, 0x5a, 0xb9, 0x8a, 0xa6, 0x69, 0xc6, 0xbf, 0xc9, 0x74, 0x2d, 0xc6,
-		0x1f, 0x8a, 0xd3, 0xee, 0x95, 0x4b, 0xcf, 0x1d, 0xd0, 0xf0, 0x04, 0x2c, 0xf3, 0x5b, 0x12, 0xff,
-		0x24, 0x22, 0x11, 0x87, 0x70, 0x44, 0x21, 0x1c, 0x31, 0xbc, 0xdd, 0xb2, 0xf1, 0x73, 0x13, 0xd9,
-		0xd3, 0xb8, 0xad, 0x9c, 0x6d, 0x69, 0x3b, 0xa8, 0xd8, 0x1d, 0x47, 0xff, 0x1b, 0x17, 0x22, 0xda,
-		0xe1, 0xd9, 0x98, 0x0d, 0x2f, 0xb6, 0x7e, 0xc3, 0x85, 0x23, 0x59, 0x91, 0x88, 0x95, 0x5f, 0x10,
-		0x64, 0x43, 0x50, 0xe9, 0x50, 0x53, 0x3a, 0xa4, 0x14, 0x12, 0x14, 0x1a, 0xb4, 0xb1, 0x49, 0x80,
-		0xa2, 0x0f, 0xb6, 0xa7, 0x7b, 0xc8, 0xb9, 0x78, 0xb3, 0x7e, 0x24, 0xc1, 0x38, 0x5e, 0x7c, 0xce,
-		0x25, 0x50, 0xd2, 0x14, 0x89, 0x0c, 0x25, 0x22, 0x2e, 0x68, 0xaa, 0x9c, 0x87, 0x32, 0xc7, 0xa1,
-		0xcc, 0x69, 0x48, 0x09, 0xa2, 0x9e, 0x88, 0x8d, 0x57, 0x40, 0x57, 0x58, 0x3c, 0xa3, 0xcf, 0xfc,
-		0x2f, 0x8e, 0x78, 0xb9, 0xec, 0x0a, 0x43, 0x38, 0x9d, 0x4a, 0x70, 0x19, 0xe5, 0x18, 0x40, 0x69,
-		0xe6, 0x4f, 0x85, 0xf1, 0x93, 0x17, 0x73, 0x2a, 0x8a, 0x8f, 0x8c, 0xda, 0x23, 0xa3, 0xf4, 0x94,
-		0xd4, 0x20, 0x19, 0x42, 0x48, 0x9a, 0xb3, 0x9b, 0xe5, 0x5c, 0xdb, 0x72, 0x2c, 0x5d, 0x64, 0xab,
-		0xcf, 0x24, 0xc6, 0x86, 0x8f, 0x2d, 0x97, 0xe6, 0x44, 0x40, 0x50, 0x5a, 0x1d, 0x66, 0xfb, 0x96,
-		0xff, 0xea, 0xb2, 0x2e, 0x05, 0x41, 0xa9, 0x90, 0xf3, 0x52, 0xbc, 0x0c, 0x1f, 0xe5, 0x83, 0xe9,
-		0x31, 0xba, 0x46, 0x7d, 0x8d, 0x46, 0xa3, 0x75, 0x7d, 0xf1, 0xf8, 0xfb, 0xed, 0xc7, 0xd6, 0xe3,
-		0x5f, 0x77, 0x17, 0xaa, 0x24, 0x79, 0x90, 0xef, 0xe3, 0x91, 0xb4, 0xef, 0x22, 0x4a, 0x68, 0x9a,
-		0xbe, 0xe8, 0x7d, 0xe3, 0xe3, 0xe5, 0xa7, 0x87, 0x56, 0xe3, 0xea, 0xaa, 0x98, 0x85, 0xac, 0x2d,
-		0xe2, 0xb7, 0xbb, 0xba, 0x3d, 0x6f, 0x6c, 0xe5, 0x8b, 0x3d, 0x36, 0xce, 0x1b, 0xe7, 0x54, 0xdb,
-		0xa6, 0x34, 0x43, 0x33, 0x1f, 0xa7, 0x33, 0x3b, 0x79, 0x0a, 0xdc, 0xd4, 0xea, 0x3b, 0xa5, 0x88,
-		0x7d, 0x12, 0x82, 0x9f, 0x84, 0xe8, 0x5f, 0xc9, 0x58, 0x2a, 0x9e, 0x7a, 0x0f, 0x3d, 0xe6, 0xca,
-		0xa2, 0x2e, 0x82, 0x13, 0xde, 0x79, 0x08, 0xe8, 0x4c, 0xde, 0xca, 0x78, 0x7e, 0x55, 0x91, 0x58,
-		0xca, 0xd3, 0xdd, 0x05, 0x38, 0x18, 0xac, 0x54, 0x36, 0xcf, 0x14, 0x53, 0x8a, 0xd4, 0xe8, 0x8f,
-		0x86, 0x26, 0x04, 0xef, 0x71, 0xf8, 0x1f, 0xd3, 0x34, 0x8f, 0x67, 0x11, 0xd9, 0x71, 0x48, 0x2c,
-		0x50, 0x9d, 0xd9, 0x70, 0xf0, 0x47, 0xec, 0x5b, 0xa8, 0xa7, 0x82, 0x14, 0x48, 0x38, 0x0e, 0x14,
-		0x08, 0x28, 0x90, 0x64, 0x28, 0x90, 0x40, 0xe0, 0xe4, 0x69, 0x8f, 0xc9, 0x70, 0x39, 0xaa, 0xa3,
-		0x0c, 0xaa, 0x03, 0x54, 0x87, 0x1e, 0xb8, 0x26, 0xaa, 0x06, 0xd1, 0x40, 0x41, 0xea, 0x3a, 0x56,
-		0x5c, 0x84, 0xa8, 0x6c, 0x22, 0x05, 0x51, 0x56, 0x14, 0x0a, 0x85, 0xa1, 0x53, 0x1c, 0x4a, 0xb0,
-		0x58, 0xc8, 0x65, 0x3a, 0xa0, 0x94, 0x62, 0xa5, 0x12, 0x71, 0x4a, 0x2b, 0xdc, 0xa2, 0x1f, 0x32,
-		0x7c, 0x8a, 0x1e, 0xf7, 0x8b, 0xce, 0x69, 0x32, 0x27, 0xee, 0x9e, 0xd0, 0xaf, 0xa8, 0xd4, 0x0a,
-		0xab, 0x4d, 0x71, 0xb5, 0x29, 0xb0, 0x16, 0x45, 0xa6, 0xa1, 0xae, 0xb2, 0x77, 0xfb, 0x04, 0x0d,
-		0xaf, 0xbe, 0xe4, 0x33, 0x09, 0x6a, 0x4a, 0x69, 0x79, 0xf6, 0xa5, 0x17, 0x6f, 0x34, 0x1a, 0xad,
-		0xc6, 0xf9, 0xf9, 0xed, 0xa7, 0x9b, 0xc7, 0xcb, 0x9b, 0xdf, 0x5a, 0x17, 0x7f, 0x5e, 0xdc, 0x3c,
-		0x52, 0x30, 0xef, 0xd1, 0x17, 0x11, 0x32, 0xf0, 0xea, 0x94, 0xa2, 0xf8, 0x52, 0x9c, 0xdf, 0x5e,
-		0x5f, 0x37, 0x6e, 0x3e, 0x16, 0xb3, 0x78, 0xaf, 0x44, 0x92, 0xeb, 0x70, 0x75, 0xfb, 0xdb, 0xe5,
-		0x4d, 0x31, 0x63, 0xf7, 0x33, 0x34, 0x73, 0x5b, 0x59, 0xad, 0x72, 0x4b, 0x96, 0xcb, 0xda, 0x13,
-		0x07, 0x44, 0x84, 0x4c, 0xc2, 0xf9, 0x80, 0x4a, 0x80, 0x4a, 0x80, 0x4a, 0x32, 0x85, 0x4a, 0x68,
-		0xca, 0x91, 0x96, 0x50, 0x49, 0x95, 0x60, 0x2e, 0xb5, 0xf2, 0x24, 0x02, 0x03, 0xba, 0x65, 0x17,
-		0xdc, 0xac, 0x3d, 0x32, 0x98, 0x10, 0xf1, 0x93, 0xff, 0x08, 0x9d, 0x1f, 0xa8, 0xaf, 0x9a, 0xd4,
-		0x75, 0x0f, 0xea, 0xe1, 0x33, 0x5d, 0xd8, 0xbc, 0x6d, 0xd5, 0xac, 0xe0, 0xaf, 0xb6, 0x9e, 0xbf,
-		0xa2, 0xab, 0x67, 0xed, 0x31, 0xb3, 0x4b, 0x94, 0x2a, 0xa6, 0xd2, 0x99, 0xf4, 0x2e, 0x34, 0x78,
-		0x47, 0x47, 0xa1, 0xf5, 0x3a, 0x9e, 0x53, 0xeb, 0x0c, 0x1b, 0xb2, 0x49, 0x81, 0xa8, 0xb2, 0x0d,
-		0x9b, 0x4c, 0x93, 0x32, 0xfd, 0x5e, 0x81, 0xf9, 0x82, 0xf9, 0x4a, 0xc4, 0x7c, 0x81, 0x7e, 0x47,
-		0xa0, 0x8b, 0x40, 0x17, 0x81, 0xae, 0x80, 0xbc, 0x81, 0x7e, 0x07, 0xfd, 0x0e, 0xfa, 0x1d, 0xf4,
-		0x7b, 0x62, 0xec, 0x11, 0xe8, 0x77, 0xa0, 0x12, 0xa0, 0x12, 0xa0, 0x92, 0xf5, 0x31, 0x03, 0xe8,
-		0xf7, 0x6c, 0x44, 0x94, 0x59, 0xa2, 0xdf, 0x25, 0x9a, 0x86, 0x29, 0x90, 0x56, 0x5a, 0x13, 0x53,
-		0xc3, 0xa6, 0x46, 0xd2, 0xa1, 0xf1, 0x56, 0x16, 0x22, 0x71, 0x77, 0x1e, 0x93, 0x5d, 0x75, 0x7d,
-		0xad, 0xf0, 0xb8, 0x85, 0xb8, 0x28, 0xc5, 0x85, 0xce, 0x37, 0x69, 0x7a, 0x08, 0xbe, 0xa3, 0xd5,
-		0x30, 0xcd, 0x56, 0x23, 0xfa, 0x8e, 0xd6, 0x45, 0x30, 0x39, 0x8a, 0x7e, 0xe2, 0x56, 0x3f, 0xc9,
-		0xa2, 0x1f, 0x31, 0xee, 0x5a, 0x8a, 0xab, 0x96, 0x2e, 0xf9, 0xa9, 0xa0, 0xe4, 0x87, 0x12, 0xe4,
-		0xa1, 0xeb, 0x09, 0xba, 0x9e, 0xa0, 0x14, 0x08, 0x5d, 0x4f, 0xb8, 0x6c, 0x35, 0xba, 0x9e, 0xa0,
-		0xeb, 0x49, 0xe2, 0x24, 0x27, 0xba, 0x9e, 0xe4, 0xfb, 0xc5, 0xd0, 0xf5, 0x24, 0x79, 0xa3, 0x87,
-		0xae, 0x27, 0xdb, 0x4f, 0x36, 0xa0, 0xeb, 0x89, 0x1e, 0x28, 0x58, 0x40, 0xd7, 0x93, 0x8c, 0x11,
-		0x20, 0x02, 0xb4, 0x69, 0x7e, 0x1a, 0xd5, 0xaf, 0x7b, 0xe3, 0x22, 0x17, 0x47, 0xb3, 0x91, 0x53,
-		0x93, 0x6e, 0x6f, 0xbf, 0xa6, 0xc1, 0xb4, 0x39, 0xf4, 0xbf, 0x8c, 0x51, 0x6c, 0x9b, 0x6f, 0x11,
-		0x66, 0x01, 0xf6, 0xe2, 0x38, 0xf4, 0x58, 0x46, 0x8f, 0xe5, 0x50, 0xa0, 0x3a, 0x7d, 0xcb, 0x36,
-		0x02, 0x1b, 0x2b, 0x4c, 0x38, 0xce, 0x8d, 0x45, 0xa3, 0x21, 0xb0, 0x8e, 0xc9, 0xb0, 0x8e, 0x92,
-		0x9d, 0x55, 0xd4, 0x3a, 0xaa, 0xa0, 0xd5, 0x10, 0xf8, 0xc5, 0xac, 0xb6, 0x1a, 0x9a, 0x58, 0xe1,
-		0x81, 0xe9, 0x79, 0x7f, 0xab, 0x24, 0x37, 0xbd, 0xb1, 0xea, 0xd1, 0x7c, 0x28, 0xdd, 0x42, 0xed,
-		0x43, 0xc2, 0x8a, 0x96, 0x0a, 0xed, 0xb3, 0x4d, 0x57, 0x9f, 0x4a, 0xae, 0xc0, 0xc5, 0x77, 0x5f,
-		0x8d, 0x79, 0xa6, 0x93, 0x6e, 0xa7, 0x6d, 0xb0, 0xef, 0xfe, 0xfb, 0xb9, 0x80, 0xed, 0x8b, 0xe9,
-		0x7d, 0x61, 0x1d, 0xe3, 0x9b, 0xd9, 0x1b, 0x32, 0x5a, 0xa9, 0xef, 0x9a, 0x3d, 0x8f, 0x52, 0xec,
-		0x93, 0x16, 0xf8, 0x66, 0x22, 0x05, 0x75, 0x8b, 0x6e, 0x21, 0xdc, 0x0e, 0x6a, 0x6f, 0x33, 0x9d,
-		0x16, 0x4e, 0x07, 0x4e, 0x07, 0x4e, 0x47, 0x2c, 0xae, 0x71, 0x5f, 0x07, 0xfe, 0x4c, 0x91, 0x14,
-		0xcb, 0xe5, 0x92, 0xbf, 0x7c, 0x7b, 0x7b, 0x12, 0xf7, 0x16, 0xa8, 0xb6, 0xe3, 0x19, 0x49, 0x22,
-		0xd5, 0xfe, 0x41, 0x80, 0x63, 0x16, 0xba, 0x9a, 0x54, 0xa6, 0x3a, 0x5a, 0xa9, 0x2a, 0x5a, 0x39,
-		0x84, 0xae, 0x20, 0x84, 0x46, 0x08, 0x8d, 0x10, 0x1a, 0x68, 0x06, 0x68, 0x06, 0x21, 0x34, 0x42,
-		0x68, 0x84, 0xd0, 0x08, 0xa1, 0x11, 0x42, 0xc3, 0xe9, 0xc0, 0xe9, 0x20, 0x84, 0x56, 0x35, 0x49,
-		0xe3, 0xe8, 0x34, 0x4c, 0x65, 0x21, 0xb1, 0x45, 0xd1, 0x7c, 0x30, 0x42, 0x30, 0x42, 0x30, 0x42,
-		0xf9, 0x42, 0xbe, 0xa0, 0xee, 0x56, 0x50, 0x77, 0x12, 0xa5, 0xe3, 0x5b, 0x9d, 0x1d, 0x1a, 0xb7,
-		0x50, 0x45, 0x21, 0x12, 0x32, 0x2e, 0x85, 0x72, 0x61, 0xf6, 0x56, 0x63, 0x3c, 0xfb, 0x27, 0xee,
-		0x2c, 0x60, 0x9a, 0x1a, 0x5c, 0xc1, 0x34, 0x23, 0xb9, 0xf4, 0x22, 0xe4, 0xc3, 0x15, 0x90, 0x0f,
-		0xa7, 0xc6, 0x61, 0xbe, 0xc9, 0xec, 0x55, 0xaf, 0xc4, 0x5d, 0x39, 0x1d, 0xaa, 0x71, 0xf5, 0xe1,
-		0x33, 0x50, 0xfd, 0xa8, 0xc6, 0xd5, 0x4c, 0x0d, 0xa2, 0x1a, 0x57, 0x72, 0x5a, 0x54, 0xe3, 0xa6,
-		0xf3, 0x76, 0xa8, 0xc6, 0xd5, 0x16, 0xe1, 0x4e, 0xff, 0xa0, 0x1a, 0x37, 0xbb, 0x11, 0x35, 0xaa,
-		0x71, 0x79, 0x26, 0x41, 0x35, 0x6e, 0x32, 0xd4, 0x1c, 0xaa, 0x71, 0xb3, 0xc6, 0xb7, 0x88, 0x24,
-		0x92, 0xa1, 0x25, 0x19, 0xc8, 0x10, 0x90, 0x21, 0x20, 0x43, 0x40, 0x86, 0x80, 0x0c, 0x01, 0x19,
-		0x02, 0x32, 0x04, 0x64, 0x08, 0xc8, 0x10, 0x90, 0x21, 0x20, 0x43, 0x40, 0x86, 0x80, 0x0c, 0x01,
-		0x19, 0x02, 0x32, 0x24, 0xf7, 0x64, 0x08, 0x6d, 0x7b, 0x32, 0x0e, 0x2e, 0x64, 0xbc, 0xeb, 0x9e,
-		0x38, 0x17, 0x32, 0x19, 0x86, 0xc4, 0x10, 0x70, 0x21, 0xc9, 0x70, 0x21, 0x42, 0x3d, 0xbd, 0x28,
-		0x9c, 0x00, 0x9a, 0x24, 0x81, 0xe9, 0xc8, 0x6a, 0x85, 0xa7, 0x64, 0xd7, 0xb0, 0x25, 0x71, 0x91,
-		0xea, 0x1e, 0xa6, 0xa8, 0x20, 0xca, 0x8a, 0x42, 0xa1, 0x30, 0x74, 0x8a, 0x43, 0x89, 0x15, 0x0b,
-		0xc8, 0x6b, 0xd7, 0x1a, 0x70, 0xaa, 0x5f, 0x08, 0xad, 0x5c, 0x54, 0xbd, 0x24, 0x78, 0x8a, 0x65,
-		0xd5, 0x6f, 0x95, 0x11, 0xd7, 0x2e, 0x26, 0xa9, 0xac, 0xda, 0x94, 0x56, 0x9b, 0xf2, 0x6a, 0x51,
-		0x62, 0x1a, 0xd6, 0x2a, 0x7b, 0xd7, 0x2e, 0x2a, 0xb3, 0x4b, 0x44, 0x2c, 0x13, 0xd5, 0x0a, 0x29,
-		0x97, 0x73, 0xab, 0x33, 0x77, 0xb1, 0xd4, 0x88, 0xce, 0xf2, 0xee, 0x18, 0x6d, 0x22, 0x28, 0xf3,
-		0x5e, 0xa9, 0x4e, 0x39, 0xa6, 0x7f, 0x0f, 0xd5, 0x5d, 0xa3, 0x6a, 0x21, 0x78, 0xac, 0x87, 0x54,
-		0x2b, 0x05, 0x87, 0xa3, 0x84, 0xa3, 0x84, 0xa3, 0xd4, 0xe4, 0x28, 0x69, 0x4b, 0xcd, 0xa9, 0xbd,
-		0x66, 0x3a, 0x97, 0xb3, 0x3b, 0x3d, 0xc2, 0xb3, 0xef, 0x60, 0x36, 0x18, 0x3e, 0x18, 0x3e, 0x18,
-		0xbe, 0x4c, 0x19, 0x3e, 0xd9, 0x4c, 0xa3, 0x58, 0x53, 0x77, 0x46, 0x30, 0x97, 0x52, 0x26, 0x92,
-		0x1e, 0xa8, 0xaf, 0x27, 0xac, 0x22, 0x76, 0x14, 0x44, 0x62, 0xa6, 0x71, 0xe5, 0x68, 0x72, 0xbc,
-		0x62, 0x97, 0xaf, 0x46, 0x38, 0x27, 0x69, 0x0e, 0x58, 0xec, 0x82, 0x3c, 0xfc, 0xf5, 0xf0, 0x78,
-		0x71, 0xdd, 0xfa, 0x78, 0xf1, 0xeb, 0xe5, 0xcd, 0xc5, 0xc7, 0xd6, 0xfd, 0xed, 0xd5, 0xc5, 0x03,
-		0xe1, 0xca, 0x14, 0x88, 0x13, 0xc5, 0xf4, 0x89, 0xc8, 0xba, 0xd5, 0x19, 0xaf, 0x4a, 0xab, 0xf1,
-		0xf1, 0xfa, 0xf2, 0xa6, 0x48, 0xfe, 0x7d, 0x23, 0xd2, 0x19, 0x9b, 0x7b, 0xd9, 0x7a, 0x2e, 0xf5,
-		0x59, 0x9a, 0x39, 0x84, 0xae, 0x9e, 0xf7, 0xc5, 0xf8, 0xca, 0x5e, 0xe9, 0xd0, 0xeb, 0x74, 0x42,
-		0x00, 0x58, 0x00, 0x58, 0x00, 0xd8, 0x4c, 0x01, 0xd8, 0xcc, 0x51, 0xdc, 0xa9, 0x58, 0x3c, 0xe5,
-		0x8e, 0x71, 0xcb, 0x81, 0x81, 0x5a, 0xcf, 0x38, 0xd8, 0x3c, 0xd8, 0x3c, 0xd8, 0x3c, 0xd8, 0x3c,
-		0x2d, 0x23, 0x65, 0xb3, 0x27, 0x14, 0x7b, 0xd7, 0xcd, 0xf0, 0xa5, 0x7c, 0x76, 0x6c, 0x90, 0x75,
-		0x7a, 0x2c, 0x7d, 0xfd, 0x84, 0xfc, 0xba, 0xc9, 0x74, 0x23, 0x95, 0xbb, 0x96, 0x62, 0x85, 0xb8,
-		0x8a, 0x5f, 0x4f, 0xb1, 0x24, 0xa1, 0xaa, 0x39, 0x5a, 0x15, 0xe4, 0x68, 0x69, 0xf4, 0x0b, 0xc8,
-		0xd1, 0x9a, 0x3d, 0x39, 0x72, 0xb4, 0x00, 0xe6, 0x00, 0xe6, 0x00, 0xe6, 0xf2, 0x0a, 0xe6, 0x90,
-		0xa3, 0x45, 0xa9, 0x4d, 0xc8, 0xd1, 0xa2, 0xe4, 0x39, 0x90, 0xa3, 0x05, 0x47, 0x09, 0x47, 0xb9,
-		0x9b, 0x8e, 0x12, 0x39, 0x5a, 0x4b, 0xcf, 0x8e, 0x1c, 0x2d, 0x18, 0x3e, 0x18, 0xbe, 0x6d, 0x37,
-		0x7c, 0xc8, 0xd1, 0x4a, 0x33, 0xac, 0x22, 0x76, 0x14, 0x44, 0x62, 0xa6, 0x71, 0xe5, 0x90, 0xa3,
-		0xf5, 0x66, 0x41, 0x90, 0xa3, 0xc5, 0xb1, 0x3a, 0xc8, 0xd1, 0x4a, 0x6f, 0x16, 0xe4, 0x68, 0x21,
-		0x47, 0x0b, 0x00, 0x16, 0x00, 0x36, 0xab, 0x00, 0x16, 0x39, 0x5a, 0xc8, 0xd1, 0x82, 0xcd, 0x83,
-		0xcd, 0x83, 0xcd, 0xcb, 0xa5, 0xcd, 0xdb, 0xe1, 0x1c, 0x2d, 0x89, 0x7b, 0x46, 0xe5, 0x97, 0x4d,
-		0x26, 0x45, 0x8b, 0xee, 0xaa, 0x68, 0x5c, 0x12, 0x8d, 0x44, 0xad, 0xb4, 0x9d, 0x41, 0x5e, 0x2f,
-		0x89, 0xee, 0x31, 0xb3, 0x4b, 0xd4, 0xb6, 0xfe, 0x54, 0x61, 0x8e, 0xbb, 0xd0, 0xda, 0x1d, 0x1d,
-		0x85, 0xc9, 0xa5, 0xc7, 0x91, 0x52, 0x6f, 0xc5, 0xdd, 0xd3, 0x7f, 0x04, 0x7c, 0x81, 0xac, 0xa5,
-		0xda, 0xca, 0xde, 0xd2, 0xe3, 0x29, 0xb6, 0xf5, 0xbe, 0xef, 0x99, 0x1f, 0x2e, 0x4a, 0x25, 0x2f,
-		0xf3, 0x5d, 0x67, 0xfd, 0x49, 0xb8, 0x69, 0xf4, 0x0e, 0x35, 0x73, 0x9e, 0x34, 0x49, 0xa6, 0x6a,
-		0xe6, 0xbc, 0xa7, 0xb0, 0x0a, 0xa2, 0x6f, 0xaf, 0xf0, 0xd6, 0x45, 0xae, 0x0b, 0xb8, 0xb8, 0xa4,
-		0x6b, 0xfd, 0xd2, 0xc5, 0x2f, 0xc8, 0x9a, 0xc5, 0x08, 0x6e, 0x41, 0x72, 0x5c, 0xeb, 0x1f, 0xbe,
-		0xb5, 0x58, 0xb8, 0x3c, 0x69, 0x36, 0x6c, 0xc3, 0x62, 0xf3, 0x65, 0xdd, 0x73, 0x83, 0x36, 0x11,
-		0x70, 0x26, 0x0e, 0xc2, 0x44, 0xc1, 0x96, 0x34, 0xa8, 0x92, 0x06, 0x4f, 0x52, 0x20, 0x49, 0x4d,
-		0x5d, 0x78, 0xb3, 0xd0, 0x71, 0xb3, 0x3e, 0x1a, 0xa8, 0xa7, 0xe2, 0xcd, 0xa4, 0x2e, 0x93, 0x8b,
-		0x0c, 0x18, 0xc9, 0x5d, 0x72, 0x4b, 0xb3, 0xe1, 0x2a, 0x39, 0x7d, 0x21, 0x2d, 0x1a, 0xac, 0xe3,
-		0x2a, 0x39, 0xae, 0x3f, 0xb8, 0x4a, 0x6e, 0xf3, 0x0b, 0xe2, 0x2a, 0xb9, 0xe4, 0x78, 0x22, 0x8d,
-		0x6f, 0x87, 0xab, 0xe4, 0xb4, 0x91, 0x82, 0xd3, 0x3f, 0xb8, 0x4a, 0x4e, 0xdc, 0xde, 0xe1, 0x2a,
-		0x39, 0x5c, 0x25, 0x47, 0x0d, 0xff, 0x0a, 0xb8, 0x4a, 0x8e, 0x08, 0x42, 0x6e, 0x39, 0xfb, 0x18,
-		0x05, 0x65, 0x29, 0x5c, 0xab, 0xcf, 0xbe, 0x85, 0xaa, 0x2a, 0xc8, 0x85, 0x84, 0xe3, 0xc0, 0x85,
-		0x80, 0x0b, 0x49, 0x86, 0x0b, 0x09, 0x04, 0x4e, 0x9e, 0xfc, 0x98, 0x0c, 0xc7, 0x75, 0x72, 0x60,
-		0x3b, 0x32, 0xc5, 0x76, 0xe0, 0x3a, 0x39, 0x64, 0xc0, 0x68, 0x53, 0x24, 0x2d, 0x98, 0xb1, 0xb0,
-		0xe3, 0xad, 0x8a, 0x02, 0x47, 0x32, 0x29, 0xc5, 0x26, 0xe3, 0xb6, 0xe6, 0xe6, 0x44, 0x5e, 0xb3,
-		0x7e, 0x45, 0xa5, 0x56, 0x58, 0x6d, 0x8a, 0xab, 0x4d, 0x81, 0xb5, 0x28, 0x32, 0x0d, 0x7b, 0x95,
-		0xbd, 0xbc, 0x66, 0xda, 0xea, 0x50, 0xca, 0xaa, 0x50, 0x3d, 0xd5, 0xa0, 0x0b, 0x94, 0x7b, 0xe3,
-		0xd3, 0xe3, 0xef, 0xb7, 0xf7, 0x97, 0xff, 0x6d, 0x3c, 0x5e, 0xde, 0xde, 0xb4, 0x2e, 0xfe, 0xbc,
-		0xb8, 0x79, 0xa4, 0xe0, 0xdf, 0xa3, 0xef, 0xd2, 0x50, 0x05, 0xaa, 0xa9, 0x26, 0x36, 0x6e, 0x35,
-		0xce, 0x6f, 0xaf, 0xaf, 0x1b, 0x37, 0x1f, 0xe9, 0x6a, 0x40, 0x47, 0x87, 0xf9, 0x5d, 0x8a, 0x9b,
-		0x5f, 0x2f, 0x7f, 0x2b, 0x66, 0xac, 0xda, 0xb4, 0x99, 0xb6, 0x45, 0x43, 0x1d, 0x02, 0x27, 0xfd,
-		0x35, 0x21, 0x95, 0x26, 0xff, 0xc9, 0x41, 0xb3, 0x58, 0x02, 0x28, 0x48, 0x07, 0x01, 0x51, 0x8d,
-		0x80, 0x58, 0x0c, 0xd5, 0x08, 0x59, 0xab, 0x46, 0x98, 0x53, 0x6b, 0x74, 0xbd, 0x4e, 0x84, 0x4a,
-		0x42, 0xd7, 0x6b, 0x98, 0x2f, 0x50, 0x49, 0xa0, 0x92, 0x40, 0x25, 0x81, 0x4a, 0x02, 0x95, 0x04,
-		0x2a, 0x09, 0x54, 0x12, 0xa8, 0x24, 0x50, 0x49, 0xbb, 0x4d, 0x25, 0x25, 0xdb, 0xd3, 0x22, 0x89,
-		0x82, 0x70, 0x69, 0x98, 0x87, 0x92, 0xf0, 0x42, 0x0e, 0x4a, 0xc2, 0x57, 0xcb, 0x31, 0x79, 0x4d,
-		0x78, 0xf4, 0x35, 0xad, 0x8b, 0x60, 0x7e, 0x64, 0x65, 0xae, 0xd9, 0x83, 0x24, 0xb3, 0x32, 0xc5,
-		0x08, 0x19, 0x29, 0x02, 0x46, 0x3a, 0x27, 0xb3, 0x82, 0x9c, 0x4c, 0xca, 0x78, 0x0a, 0xf5, 0xa9,
-		0xa8, 0x4f, 0x45, 0xc6, 0x26, 0x25, 0x89, 0x80, 0xfa, 0xd4, 0xf8, 0xc7, 0x46, 0x7d, 0x2a, 0xea,
-		0x53, 0x15, 0x5f, 0x14, 0xf5, 0xa9, 0xb9, 0x7c, 0x31, 0xd4, 0xa7, 0x26, 0x6f, 0xf4, 0x50, 0x9f,
-		0xba, 0xfd, 0xdc, 0x03, 0xea, 0x53, 0xf5, 0x40, 0xc1, 0x02, 0xea, 0x53, 0xb3, 0xc7, 0x84, 0x08,
-		0x10, 0xa9, 0x79, 0x6f, 0x8e, 0xc7, 0xdb, 0x38, 0x4e, 0x80, 0x65, 0xd3, 0xd1, 0x1a, 0x8f, 0xb3,
-		0x0e, 0x4c, 0xac, 0xde, 0x0b, 0xcd, 0xf0, 0x68, 0x6d, 0x5b, 0x0a, 0xcd, 0xf0, 0x74, 0xaa, 0x07,
-		0x4f, 0x66, 0xae, 0x9c, 0x34, 0x7b, 0xcc, 0xfd, 0xc6, 0x5c, 0xe3, 0xc5, 0x75, 0x86, 0x03, 0x8f,
-		0x5f, 0xa8, 0x17, 0x87, 0x41, 0xb6, 0xd1, 0xe8, 0x71, 0x59, 0x9c, 0x24, 0xd8, 0xf4, 0xf9, 0xd1,
-		0x68, 0x74, 0x90, 0x20, 0xaf, 0xb8, 0xd3, 0xa4, 0xba, 0x64, 0x65, 0xb7, 0x5a, 0x45, 0x37, 0x5a,
-		0x1d, 0x24, 0x2a, 0xe0, 0xe4, 0xd1, 0xd2, 0x36, 0xb7, 0x3a, 0xa0, 0xb9, 0xec, 0x03, 0x17, 0x7d,
-		0x20, 0x37, 0x3d, 0x0d, 0xa5, 0x4a, 0x85, 0xbb, 0x24, 0x2c, 0xad, 0xc9, 0x1f, 0x87, 0x29, 0x53,
-		0x01, 0x43, 0x53, 0xc4, 0x87, 0xf2, 0x3d, 0xd8, 0x18, 0xd8, 0x18, 0x71, 0x79, 0xd9, 0x89, 0xc3,
-		0xe1, 0x87, 0x8b, 0xfb, 0x3f, 0x2f, 0xee, 0xb7, 0xfc, 0x70, 0x78, 0x72, 0xca, 0xb8, 0x8d, 0xe7,
-		0xa7, 0x93, 0x63, 0xef, 0x9d, 0x3b, 0x3b, 0xcd, 0x58, 0x10, 0x91, 0x64, 0x22, 0xee, 0x02, 0xab,
-		0xb8, 0xf0, 0x37, 0xa9, 0xde, 0x04, 0x02, 0xa7, 0x44, 0x02, 0x14, 0x8d, 0x54, 0x70, 0xa4, 0x12,
-		0x14, 0x21, 0xc5, 0x0e, 0x4c, 0x41, 0xe6, 0x53, 0xec, 0xe4, 0xfb, 0x01, 0xa8, 0xf4, 0x01, 0x58,
-		0x51, 0xff, 0x2f, 0x7e, 0x13, 0xa1, 0x1e, 0x33, 0x31, 0xb1, 0x5e, 0x9e, 0xbc, 0xa5, 0x98, 0x4e,
-		0x00, 0x5a, 0x11, 0xc6, 0x62, 0x3b, 0x68, 0xc5, 0x89, 0x44, 0x13, 0x74, 0xbd, 0x98, 0xcc, 0x83,
-		0x0e, 0xaa, 0x08, 0xfb, 0x77, 0x22, 0xec, 0x57, 0x6e, 0x7b, 0x61, 0x76, 0x3a, 0x2e, 0xf3, 0x3c,
-		0xba, 0xe8, 0x7a, 0x3a, 0x21, 0x1a, 0x5e, 0xe8, 0x57, 0x51, 0x6a, 0x55, 0xd5, 0xa6, 0xb2, 0xda,
-		0x54, 0x57, 0x8b, 0x0a, 0xd3, 0xb0, 0x03, 0xd9, 0x6b, 0x78, 0xa1, 0xde, 0x98, 0x8b, 0x02, 0x98,
-		0x73, 0x00, 0xf5, 0xa9, 0x0d, 0x49, 0xab, 0x3c, 0x5f, 0xc1, 0x69, 0x2a, 0xb6, 0x82, 0x5f, 0xda,
-		0x33, 0xa5, 0x96, 0xf0, 0x44, 0xc0, 0x06, 0xd6, 0x14, 0xd6, 0x34, 0xef, 0xd6, 0x54, 0x15, 0x28,
-		0x91, 0x03, 0x26, 0x4d, 0xc0, 0x89, 0x18, 0x40, 0x91, 0xab, 0xbe, 0x0e, 0x13, 0xa0, 0xcf, 0x14,
-		0xe8, 0x32, 0x09, 0xda, 0x4d, 0x83, 0x76, 0x13, 0xa1, 0xd5, 0x54, 0xd0, 0x98, 0x0c, 0x22, 0xd3,
-		0x41, 0x0f, 0xc8, 0x96, 0xe4, 0xd5, 0x1a, 0x18, 0xb4, 0xda, 0x5f, 0x50, 0xac, 0x50, 0xde, 0xb4,
-		0x06, 0x9f, 0x49, 0x65, 0x88, 0x56, 0xa7, 0xde, 0xac, 0xec, 0xb7, 0xaa, 0x86, 0xb5, 0x5d, 0x5a,
-		0xe3, 0x77, 0x1a, 0xe6, 0xbe, 0x33, 0x7d, 0x9f, 0xb9, 0x36, 0xf9, 0x72, 0x47, 0x5f, 0xf0, 0xbf,
-		0xfd, 0xfd, 0xcf, 0x25, 0xe3, 0xac, 0xf9, 0xf3, 0x73, 0xd9, 0x38, 0x6b, 0x4e, 0x7e, 0x2c, 0x07,
-		0xff, 0x99, 0xfc, 0x5c, 0xf9, 0x5c, 0x32, 0xaa, 0xd3, 0x9f, 0x6b, 0x9f, 0x4b, 0x46, 0xad, 0x79,
-		0xf0, 0xf4, 0x74, 0x74, 0xf0, 0xe3, 0x64, 0x24, 0x3e, 0xf0, 0x5f, 0x45, 0xf2, 0x97, 0x68, 0x92,
-		0xce, 0x38, 0x3a, 0xcc, 0x91, 0x50, 0xd7, 0x21, 0xd4, 0xeb, 0x85, 0xda, 0x34, 0xba, 0x0d, 0xe3,
-		0xd7, 0xe6, 0x8f, 0xf2, 0x61, 0x75, 0xf4, 0xfe, 0xe0, 0xc7, 0xe9, 0xe8, 0xed, 0x2f, 0x7f, 0xae,
-		0xfa, 0x58, 0xf9, 0xf0, 0x74, 0xf4, 0x3e, 0xe6, 0x5f, 0xea, 0xa3, 0xf7, 0x9c, 0x73, 0xd4, 0x46,
-		0xfb, 0x4b, 0x1f, 0x1d, 0xff, 0xbe, 0x12, 0x37, 0xa0, 0x1a, 0x33, 0xe0, 0x24, 0x6e, 0xc0, 0x49,
-		0xcc, 0x80, 0xd8, 0x47, 0xaa, 0xc4, 0x0c, 0xa8, 0x8d, 0x7e, 0x2e, 0x7d, 0x7e, 0x7f, 0xf5, 0x47,
-		0xeb, 0xa3, 0x83, 0x9f, 0x71, 0xff, 0x76, 0x3a, 0xfa, 0xf9, 0xfe, 0x20, 0x07, 0x2a, 0xbe, 0x97,
-		0xad, 0xe7, 0x1a, 0x65, 0x21, 0x35, 0x47, 0x2d, 0xa5, 0x3b, 0xd6, 0x48, 0x29, 0xa4, 0x78, 0x23,
-		0x96, 0x41, 0x2c, 0x83, 0x58, 0x26, 0xa7, 0xb1, 0x8c, 0x72, 0x8a, 0x7a, 0x3c, 0x1c, 0xd9, 0x22,
-		0x9b, 0xeb, 0x5b, 0x7d, 0xe6, 0x0c, 0x7d, 0x7a, 0xb3, 0x3b, 0x9d, 0x18, 0x96, 0x17, 0x96, 0x17,
-		0x96, 0x77, 0xa7, 0x2c, 0xef, 0xd0, 0xb2, 0xfd, 0x72, 0x5d, 0x83, 0xe5, 0xad, 0x13, 0x4e, 0x79,
-		0x6f, 0xda, 0x2f, 0xb9, 0x60, 0x90, 0xae, 0x2d, 0x9b, 0x5c, 0x51, 0x35, 0x99, 0xd5, 0xa5, 0xe9,
-		0x83, 0x92, 0x04, 0x8d, 0xf3, 0xff, 0xea, 0x9a, 0x6d, 0xdf, 0x72, 0xec, 0x8f, 0xd6, 0x8b, 0x15,
-		0xb4, 0x6e, 0x2a, 0x91, 0x7f, 0xcf, 0x48, 0x03, 0xf7, 0x70, 0x6d, 0x7e, 0xcf, 0xfd, 0x96, 0xd6,
-		0x6b, 0xb5, 0x93, 0x5a, 0x8e, 0xb7, 0x15, 0xf1, 0xbc, 0xc6, 0x19, 0x54, 0x0f, 0x49, 0x89, 0x6e,
-		0x0a, 0x88, 0xe6, 0x23, 0x2a, 0xf0, 0x08, 0xd3, 0x9d, 0xc3, 0xff, 0x2a, 0xdd, 0x45, 0xa9, 0xbe,
-		0xce, 0x2a, 0xc9, 0x21, 0xae, 0xd9, 0xb1, 0x86, 0x84, 0xb9, 0x76, 0xe1, 0x7c, 0x48, 0x0e, 0x49,
-		0x0e, 0xcb, 0x23, 0x39, 0x04, 0xc9, 0x21, 0xf1, 0x13, 0x11, 0x65, 0x7f, 0x2d, 0x89, 0x2f, 0x49,
-		0x16, 0x18, 0xb1, 0xc2, 0x23, 0xa8, 0x47, 0x50, 0x8f, 0xa0, 0x9e, 0xd6, 0x80, 0x44, 0x13, 0x9a,
-		0xed, 0xb6, 0x6f, 0x0c, 0x1c, 0xd7, 0xa7, 0x97, 0xab, 0x28, 0xdf, 0x2c, 0xfa, 0x0a, 0xe2, 0x6d,
-		0xff, 0xc8, 0xba, 0xe6, 0xb0, 0x17, 0xec, 0x7a, 0xf9, 0x5d, 0xf9, 0x84, 0x7a, 0x7a, 0x3d, 0x81,
-		0x16, 0xb9, 0x15, 0xd3, 0x69, 0xcd, 0xf4, 0x5b, 0x35, 0xdd, 0xd6, 0x2d, 0x31, 0x2b, 0x97, 0x98,
-		0xb5, 0x4b, 0xc4, 0xea, 0x69, 0x0a, 0xa3, 0x89, 0x25, 0x9e, 0x9c, 0xe2, 0x5c, 0x92, 0xf7, 0xb1,
-		0xd9, 0x32, 0xec, 0x61, 0xff, 0x59, 0xba, 0xd6, 0x8f, 0xc7, 0xc4, 0xd4, 0x35, 0x4c, 0xad, 0x87,
-		0xff, 0x9c, 0xfe, 0xd1, 0xa3, 0xa4, 0x05, 0xdd, 0x7c, 0x68, 0x42, 0x24, 0xda, 0x12, 0x99, 0xa6,
-		0xfb, 0x7b, 0x12, 0x20, 0xd4, 0x34, 0xe9, 0xf0, 0xe2, 0xd6, 0x6b, 0xe4, 0x4d, 0xd3, 0xda, 0x7a,
-		0x8d, 0x3c, 0x6a, 0x2a, 0xdb, 0xbf, 0x97, 0x8f, 0x59, 0xb3, 0x9a, 0xcf, 0x45, 0xa8, 0x3e, 0xc1,
-		0xd5, 0x69, 0xba, 0xb1, 0x7b, 0xf4, 0x15, 0x5a, 0xb1, 0x7b, 0x05, 0xd8, 0x1d, 0xd8, 0x1d, 0xd8,
-		0x1d, 0xd8, 0x1d, 0xd8, 0x1d, 0xd8, 0x1d, 0xd8, 0x1d, 0xd8, 0x1d, 0xd8, 0x1d, 0xd8, 0x7d, 0xab,
-		0xb1, 0xbb, 0xcb, 0x7c, 0xd7, 0xb4, 0xbd, 0xbe, 0xe5, 0x1b, 0xa6, 0xef, 0xb3, 0xfe, 0xc0, 0xf7,
-		0xf4, 0xa1, 0xf8, 0x55, 0x5f, 0x06, 0xc0, 0x0d, 0xc0, 0x0d, 0xc0, 0x0d, 0xc0, 0x4d, 0x28, 0xef,
-		0x43, 0xcb, 0xf6, 0xdf, 0x69, 0x84, 0xda, 0x35, 0x40, 0x6d, 0x40, 0x6d, 0x40, 0xed, 0xad, 0x84,
-		0xda, 0x95, 0x1a, 0x80, 0x36, 0x80, 0xb6, 0x06, 0xa0, 0xed, 0xb1, 0xb6, 0xcb, 0x7c, 0xe3, 0x2b,
-		0x7b, 0xd5, 0x87, 0xaf, 0xe7, 0xbe, 0x03, 0xb0, 0x1a, 0xb0, 0x1a, 0xb0, 0x1a, 0xb0, 0x9a, 0x32,
-		0x7a, 0x77, 0x86, 0xbe, 0x65, 0xbf, 0x18, 0x03, 0xd3, 0xf3, 0x02, 0xf1, 0xd1, 0xd9, 0x89, 0x65,
-		0x17, 0x3c, 0x42, 0xa0, 0xe5, 0x06, 0x75, 0x7f, 0xbd, 0x65, 0xaf, 0xb0, 0xf8, 0x3d, 0xf0, 0x0c,
-		0xf0, 0x0c, 0xf0, 0x0c, 0xf0, 0x0c, 0x84, 0xf2, 0xae, 0xa5, 0x9d, 0xdf, 0x92, 0x4f, 0x38, 0xd3,
-		0x30, 0xb7, 0x96, 0xf6, 0x7e, 0x09, 0xb0, 0x2e, 0x09, 0xb5, 0xfb, 0x5b, 0xf6, 0xcb, 0x1a, 0xbf,
-		0x43, 0x77, 0xa7, 0xb4, 0xe8, 0x8b, 0xf2, 0xde, 0x06, 0x50, 0x4f, 0xd8, 0xac, 0x99, 0x31, 0x4a,
-		0x46, 0x19, 0xea, 0x50, 0x06, 0x39, 0x65, 0x40, 0xfb, 0xc0, 0xad, 0x68, 0x1f, 0xa8, 0xd9, 0x34,
-		0xec, 0x0e, 0xf3, 0x97, 0xa9, 0x6a, 0x3b, 0xe2, 0x36, 0x05, 0xb3, 0x08, 0x54, 0x4b, 0xbb, 0x82,
-		0x49, 0x91, 0x3e, 0x49, 0xd7, 0x02, 0xba, 0xed, 0xa0, 0xe8, 0x61, 0xe6, 0xf9, 0xa6, 0xaf, 0xa1,
-		0x71, 0xe4, 0x64, 0xda, 0x8c, 0x97, 0x3a, 0x57, 0x50, 0xea, 0x9c, 0xa3, 0x30, 0x1b, 0xa5, 0xce,
-		0x28, 0x75, 0x46, 0xa9, 0x33, 0xc8, 0x44, 0x90, 0x89, 0x20, 0x13, 0x51, 0x2e, 0xb1, 0xca, 0xc4,
-		0xa0, 0x5c, 0x62, 0xee, 0xc1, 0x91, 0xc3, 0x25, 0xfe, 0x3d, 0xc8, 0xe1, 0xca, 0xec, 0xd6, 0xa3,
-		0x5c, 0x02, 0x5c, 0x8e, 0x1e, 0xf5, 0x41, 0xa9, 0x33, 0xb0, 0x3b, 0xb0, 0x3b, 0xb0, 0x3b, 0xb0,
-		0x3b, 0xb0, 0x3b, 0xb0, 0x3b, 0xb0, 0x3b, 0xb0, 0x3b, 0xb0, 0x3b, 0xb0, 0x7b, 0x4e, 0xb0, 0x7b,
-		0xdb, 0x19, 0xda, 0x3e, 0x73, 0x35, 0x66, 0xda, 0x46, 0xdf, 0xa0, 0x07, 0x5a, 0x97, 0x01, 0xad,
-		0x01, 0xad, 0x01, 0xad, 0xb3, 0x08, 0xad, 0xa9, 0x0f, 0x09, 0x67, 0x84, 0x43, 0xbb, 0xcd, 0x3c,
-		0xcf, 0x18, 0xff, 0x47, 0x47, 0x6b, 0x86, 0x65, 0xf6, 0x61, 0xf1, 0xfb, 0x0e, 0x73, 0x79, 0x77,
-		0x8c, 0x2e, 0xc3, 0x96, 0x84, 0x81, 0x4b, 0xce, 0xd0, 0x25, 0x65, 0xf0, 0x12, 0x37, 0x7c, 0x89,
-		0x1b, 0xc0, 0x44, 0x0d, 0xa1, 0x66, 0x68, 0xa9, 0x49, 0x63, 0xb4, 0x71, 0x0f, 0x71, 0x20, 0xac,
-		0x5e, 0x4d, 0x20, 0x05, 0x58, 0x67, 0x06, 0xb0, 0x5e, 0x46, 0x42, 0x3f, 0x33, 0x91, 0x28, 0x43,
-		0x91, 0x70, 0xb8, 0x9a, 0x34, 0x63, 0x91, 0x46, 0xe8, 0x9a, 0x00, 0x83, 0x91, 0x28, 0x93, 0x91,
-		0xb6, 0x88, 0x94, 0xdf, 0x55, 0xab, 0xf5, 0xd3, 0x6a, 0xb5, 0x74, 0x7a, 0x72, 0x5a, 0x3a, 0xab,
-		0xd5, 0xca, 0xf5, 0x72, 0x6d, 0x8b, 0xa5, 0x66, 0x2f, 0x9f, 0xb3, 0xe7, 0x25, 0x71, 0x5e, 0xc7,
-		0x75, 0x91, 0x21, 0xf6, 0x77, 0xd9, 0xff, 0x9f, 0xb5, 0x13, 0x8c, 0x35, 0xa6, 0xdf, 0x87, 0x58,
-		0x03, 0xb1, 0x06, 0x62, 0x0d, 0xc4, 0x1a, 0x88, 0x35, 0x10, 0x6b, 0x20, 0xd6, 0x40, 0xac, 0x81,
-		0x58, 0x03, 0xb1, 0x06, 0x62, 0x8d, 0x2d, 0x8d, 0x35, 0x5c, 0xe6, 0xbb, 0x16, 0xeb, 0x18, 0x51,
-		0x0c, 0xf0, 0xff, 0x86, 0xcc, 0x4b, 0x22, 0xe8, 0x88, 0xfb, 0x62, 0x44, 0x1f, 0x88, 0x3e, 0x10,
-		0x7d, 0x20, 0xfa, 0x40, 0xf4, 0x81, 0xe8, 0x03, 0xd1, 0x07, 0xa2, 0x0f, 0x44, 0x1f, 0x88, 0x3e,
-		0x10, 0x7d, 0x6c, 0x69, 0xf4, 0xe1, 0x5b, 0x7d, 0xe6, 0x0c, 0xfd, 0xe4, 0xa3, 0x8f, 0xb8, 0x2f,
-		0x46, 0xf4, 0x81, 0xe8, 0x03, 0xd1, 0x07, 0xa2, 0x0f, 0x44, 0x1f, 0x88, 0x3e, 0x10, 0x7d, 0x20,
-		0xfa, 0x40, 0xf4, 0x81, 0xe8, 0x03, 0xd1, 0x47, 0x16, 0xa2, 0x8f, 0x4c, 0x97, 0x9d, 0x68, 0x6a,
-		0x0c, 0x1a, 0xcd, 0xaf, 0xb5, 0x41, 0x68, 0xd0, 0xf7, 0xf2, 0x58, 0x53, 0xcd, 0xdc, 0xe4, 0xf1,
-		0x7d, 0x77, 0xd8, 0xf6, 0xed, 0x10, 0xb0, 0x3c, 0x04, 0xcf, 0xda, 0x6a, 0x98, 0x66, 0xeb, 0x21,
-		0x78, 0x90, 0xdf, 0xc6, 0x0f, 0x17, 0xfe, 0xdc, 0xba, 0x0f, 0x1e, 0xaa, 0x75, 0x3e, 0x7d, 0x1c,
-		0xdc, 0xfd, 0x4a, 0x0b, 0x17, 0x71, 0xf7, 0x6b, 0x92, 0xd1, 0x2d, 0xca, 0x24, 0xb3, 0x17, 0xbd,
-		0xa2, 0x4c, 0x12, 0x77, 0xbf, 0xa6, 0x10, 0x81, 0xa2, 0xf7, 0x48, 0x96, 0x22, 0x4c, 0xf4, 0x1e,
-		0xc9, 0xee, 0xd6, 0xe3, 0xee, 0xd7, 0x14, 0x66, 0xc5, 0xdd, 0xaf, 0x34, 0x8e, 0x0f, 0x77, 0xbf,
-		0x02, 0x56, 0x03, 0x56, 0x03, 0x56, 0xeb, 0x91, 0x77, 0xdc, 0xfd, 0x8a, 0xbb, 0x5f, 0xe1, 0x19,
-		0xe0, 0x19, 0xe0, 0x19, 0xe0, 0x19, 0x16, 0xe5, 0x1d, 0x77, 0xbf, 0x26, 0xcd, 0xba, 0xe0, 0xee,
-		0x57, 0x85, 0x2f, 0xc2, 0xdd, 0xaf, 0x29, 0x30, 0x46, 0xb8, 0xfb, 0x35, 0xd3, 0xca, 0x80, 0xbb,
-		0x5f, 0x71, 0xf7, 0x2b, 0x98, 0x3f, 0xe2, 0x99, 0x76, 0xfa, 0xee, 0xd7, 0xc9, 0x95, 0xa6, 0x59,
-		0xb9, 0xfa, 0x75, 0x2f, 0xc5, 0x7d, 0xa4, 0xde, 0x3f, 0xad, 0xfb, 0x56, 0x24, 0xb9, 0x24, 0x57,
-		0x34, 0xe7, 0x46, 0x4d, 0x4e, 0xe4, 0x77, 0x57, 0x61, 0x67, 0x89, 0xae, 0x02, 0x26, 0xbd, 0x02,
-		0x98, 0xa8, 0x4b, 0x38, 0xd9, 0x95, 0xbf, 0x94, 0x6c, 0x0b, 0x3d, 0xbb, 0x42, 0xcd, 0xa6, 0x68,
-		0x63, 0x4f, 0xb4, 0xb1, 0x25, 0x5a, 0xd8, 0x91, 0x74, 0x6d, 0x2d, 0x55, 0x17, 0xee, 0x22, 0x35,
-		0x2f, 0x3b, 0x6b, 0x79, 0x47, 0x1a, 0xa1, 0x10, 0x13, 0xb0, 0xe4, 0xc4, 0x2b, 0x6e, 0xfb, 0x2e,
-		0xe0, 0xb6, 0xef, 0x7c, 0xc1, 0x6d, 0x72, 0xc2, 0x54, 0x2f, 0x51, 0xaa, 0x83, 0x20, 0xd5, 0x43,
-		0x8c, 0x6a, 0xa5, 0xa0, 0xb5, 0x12, 0xa1, 0x3a, 0x39, 0x1f, 0xed, 0x5c, 0x4f, 0xee, 0x09, 0xcf,
-		0x66, 0x96, 0xcf, 0x57, 0xf4, 0x0a, 0x75, 0x1d, 0x42, 0x0d, 0xe2, 0x72, 0x07, 0x88, 0xcb, 0xac,
-		0x12, 0x81, 0xcd, 0xac, 0x10, 0x58, 0x04, 0xe1, 0x6e, 0xdb, 0xb1, 0x6d, 0x16, 0xe4, 0x9e, 0x1a,
-		0xe6, 0xb3, 0xe3, 0xfa, 0x1a, 0x62, 0x9b, 0xe5, 0xaf, 0x40, 0x94, 0x83, 0x28, 0x07, 0x51, 0xce,
-		0x4e, 0x45, 0x39, 0x3a, 0xba, 0x42, 0x68, 0xe8, 0x02, 0xa1, 0xa9, 0xe6, 0x46, 0x03, 0x1e, 0xd4,
-		0x59, 0x63, 0xa3, 0xbb, 0x3f, 0x90, 0xe6, 0x9a, 0x9a, 0x24, 0xca, 0x29, 0x74, 0x74, 0x9f, 0xd2,
-		0x59, 0x3b, 0x93, 0xd4, 0x96, 0x26, 0xd7, 0x65, 0x21, 0x91, 0x5d, 0x06, 0x02, 0x4d, 0x12, 0x81,
-		0xb6, 0x7b, 0x8e, 0xc7, 0xf4, 0x22, 0xd0, 0xf0, 0x2b, 0x80, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81,
-		0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0xc7, 0xf0, 0xb0,
-		0x6b, 0x5a, 0xbd, 0xa1, 0xab, 0x19, 0x83, 0x46, 0x5f, 0x02, 0x14, 0x0a, 0x14, 0x0a, 0x14, 0x0a,
-		0x14, 0x0a, 0x14, 0x0a, 0x14, 0x0a, 0x14, 0x0a, 0x14, 0x0a, 0x14, 0x0a, 0x14, 0x3a, 0x06, 0x88,
-		0xce, 0x80, 0xd9, 0x7a, 0x21, 0xe8, 0xe4, 0x1b, 0x80, 0x3f, 0x81, 0x3f, 0x81, 0x3f, 0x81, 0x3f,
-		0x81, 0x3f, 0x81, 0x3f, 0x81, 0x3f, 0x81, 0x3f, 0x81, 0x3f, 0x81, 0x3f, 0xc7, 0xe8, 0x30, 0xbc,
-		0x8d, 0x4c, 0x2f, 0x04, 0x8d, 0xbe, 0x04, 0x28, 0x14, 0x28, 0x14, 0x28, 0x14, 0x28, 0x14, 0x28,
-		0x14, 0x28, 0x14, 0x28, 0x14, 0x28, 0x14, 0x28, 0x74, 0x87, 0x51, 0x28, 0x73, 0x5d, 0xc7, 0xf5,
-		0x0c, 0x97, 0xb5, 0x99, 0xf5, 0x8d, 0x75, 0xe8, 0x11, 0xe8, 0xdb, 0x2f, 0x00, 0xfa, 0x04, 0xfa,
-		0x04, 0xfa, 0x04, 0xfa, 0x04, 0xfa, 0x04, 0xfa, 0x04, 0xfa, 0x04, 0xfa, 0x04, 0xfa, 0xdc, 0x61,
-		0xf4, 0xd9, 0x67, 0x9e, 0x67, 0xbe, 0x30, 0x9d, 0xf8, 0x73, 0xf9, 0x2b, 0x80, 0x40, 0x81, 0x40,
-		0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40, 0x81, 0x40,
-		0x3d, 0xc3, 0x9b, 0xb8, 0x58, 0x5d, 0xe8, 0x33, 0x98, 0x1e, 0xc8, 0x13, 0xc8, 0x13, 0xc8, 0x13,
-		0xc8, 0x13, 0xc8, 0x13, 0xc8, 0x13, 0xc8, 0x13, 0xc8, 0x13, 0xc8, 0x73, 0x87, 0x91, 0x67, 0x78,
-		0x35, 0x0b, 0x31, 0xe0, 0x0c, 0x66, 0x05, 0xce, 0x04, 0xce, 0x04, 0xce, 0xdc, 0x29, 0x9c, 0xe9,
-		0xf9, 0xae, 0x65, 0xbf, 0xe8, 0xb8, 0xd1, 0xe0, 0xdd, 0x16, 0xd9, 0xdc, 0x30, 0x07, 0x9e, 0xde,
-		0xec, 0x4e, 0x27, 0x86, 0xe5, 0x85, 0xe5, 0x85, 0xe5, 0xdd, 0x29, 0xcb, 0x3b, 0xb4, 0x6c, 0xbf,
-		0x5c, 0xd7, 0x60, 0x79, 0xeb, 0x08, 0xef, 0x11, 0xde, 0x23, 0xbc, 0xcf, 0xc4, 0x96, 0xd6, 0x6b,
-		0xb5, 0x13, 0xc4, 0xf3, 0xdb, 0x1b, 0xcf, 0xe3, 0x6a, 0xda, 0x8d, 0x57, 0xd3, 0x12, 0xdc, 0x25,
-		0x9c, 0xce, 0x1d, 0xb1, 0xbe, 0xd9, 0x36, 0xdb, 0x1e, 0xdd, 0x25, 0xb1, 0xe1, 0x7c, 0x19, 0xbb,
-		0x25, 0xb6, 0x84, 0x5b, 0x62, 0x33, 0x00, 0xdd, 0x71, 0x4b, 0x2c, 0xff, 0x1b, 0x91, 0xdd, 0x12,
-		0xdb, 0x9e, 0xea, 0x00, 0x7d, 0xf1, 0xfc, 0x78, 0x5e, 0xda, 0x98, 0xbe, 0x8c, 0x98, 0x1e, 0x31,
-		0x3d, 0x62, 0x7a, 0x8a, 0x37, 0xa5, 0x32, 0x20, 0xd1, 0x84, 0x03, 0xc7, 0xf5, 0xe9, 0x45, 0x6a,
-		0xaa, 0x04, 0xc1, 0xec, 0xc4, 0x9b, 0xfd, 0x91, 0x75, 0xcd, 0x61, 0x2f, 0xd8, 0xeb, 0xea, 0x19,
-		0xf5, 0xe4, 0x7a, 0x62, 0x2b, 0x72, 0xcb, 0xa5, 0xd3, 0x82, 0xe9, 0xb7, 0x64, 0xba, 0x2d, 0x5a,
-		0x62, 0x96, 0x2d, 0x31, 0x0b, 0x97, 0x88, 0xa5, 0xd3, 0x14, 0x39, 0x13, 0x4b, 0x3c, 0x39, 0xab,
-		0xb9, 0xd2, 0x68, 0x19, 0xf6, 0xb0, 0xff, 0xcc, 0x5c, 0x8d, 0xb7, 0xdf, 0xd6, 0x35, 0x4c, 0xad,
-		0x87, 0xf2, 0x9c, 0xfe, 0xd1, 0xa3, 0xa4, 0x05, 0xdd, 0x14, 0x68, 0x42, 0xbc, 0xd9, 0x12, 0x7f,
-		0xa6, 0xfb, 0x7b, 0x12, 0xe0, 0xd0, 0x34, 0xe9, 0xf0, 0xe2, 0xd6, 0x6b, 0xa4, 0x4a, 0xd3, 0xda,
-		0x7a, 0x8d, 0xd4, 0x69, 0x2a, 0xdb, 0xbf, 0x97, 0x8f, 0x59, 0xb3, 0x7a, 0x99, 0x33, 0xa1, 0xfa,
-		0x14, 0x3d, 0xd6, 0x76, 0x99, 0x6f, 0x7c, 0x65, 0xaf, 0xfa, 0x50, 0xfb, 0xdc, 0x77, 0x00, 0x5e,
-		0x03, 0x5e, 0x03, 0x5e, 0x03, 0x5e, 0x13, 0xca, 0xbb, 0xeb, 0x0c, 0x7d, 0xcb, 0x7e, 0x31, 0x06,
-		0xa6, 0xe7, 0x05, 0xe2, 0xa3, 0x0f, 0x63, 0x13, 0x25, 0x72, 0x65, 0xdc, 0x23, 0x04, 0x5a, 0x6e,
-		0x98, 0x9d, 0x8e, 0xcb, 0x3c, 0x4f, 0xa3, 0x57, 0x58, 0xfc, 0x1e, 0x78, 0x06, 0x78, 0x06, 0x78,
-		0x06, 0x78, 0x06, 0x42, 0x79, 0xb7, 0x06, 0x9a, 0xac, 0xcb, 0x82, 0x4f, 0x38, 0xd3, 0x30, 0x77,
-		0xb8, 0x36, 0xb9, 0xe3, 0x5d, 0x66, 0x2b, 0xff, 0xad, 0xaa, 0x71, 0xed, 0x97, 0xfd, 0xb2, 0xc6,
-		0xef, 0xb8, 0x33, 0x7d, 0x9f, 0xb9, 0xb6, 0xb6, 0xed, 0x88, 0xbe, 0xe8, 0x7f, 0xfb, 0xfb, 0x9f,
-		0x4b, 0xc6, 0x59, 0xf3, 0xe7, 0xe7, 0xb2, 0x71, 0xd6, 0x9c, 0xfc, 0x58, 0x0e, 0xfe, 0x33, 0xf9,
-		0xb9, 0xf2, 0xb9, 0x64, 0x54, 0xa7, 0x3f, 0xd7, 0x3e, 0x97, 0x8c, 0x5a, 0xf3, 0xe0, 0xe9, 0xe9,
-		0xe8, 0xe0, 0xc7, 0xc9, 0x48, 0x7c, 0xe0, 0xbf, 0x8a, 0xda, 0x5e, 0xa6, 0xb9, 0x97, 0x23, 0xce,
-		0x28, 0x19, 0x65, 0xa8, 0x43, 0x19, 0xe4, 0x94, 0xc1, 0x34, 0xba, 0x0d, 0xe3, 0xd7, 0xe6, 0x8f,
-		0xf2, 0x61, 0x75, 0xf4, 0xfe, 0xe0, 0xc7, 0xe9, 0xe8, 0xed, 0x2f, 0x7f, 0xae, 0xfa, 0x58, 0xf9,
-		0xf0, 0x74, 0xf4, 0x3e, 0xe6, 0x5f, 0xea, 0xa3, 0xf7, 0x9c, 0x73, 0xd4, 0x46, 0xfb, 0x4b, 0x1f,
-		0x1d, 0xff, 0xbe, 0x12, 0x37, 0xa0, 0x1a, 0x33, 0xe0, 0x24, 0x6e, 0xc0, 0x49, 0xcc, 0x80, 0xd8,
-		0x47, 0xaa, 0xc4, 0x0c, 0xa8, 0x8d, 0x7e, 0x2e, 0x7d, 0x7e, 0x7f, 0xf5, 0x47, 0xeb, 0xa3, 0x83,
-		0x9f, 0x71, 0xff, 0x76, 0x3a, 0xfa, 0xf9, 0xfe, 0x20, 0x87, 0xa6, 0x61, 0x77, 0x98, 0xbf, 0x4c,
-		0x25, 0x0f, 0x10, 0x27, 0x5d, 0xce, 0x22, 0x50, 0x2d, 0xc9, 0x97, 0x93, 0x9c, 0xc3, 0xe3, 0x30,
-		0x23, 0x69, 0x8b, 0x2a, 0xb2, 0x26, 0x59, 0xa5, 0xe4, 0xa9, 0x5b, 0x93, 0x69, 0x33, 0x9e, 0xb9,
-		0x55, 0x41, 0xe6, 0x56, 0x8e, 0xc2, 0x6c, 0x64, 0x6e, 0x21, 0x73, 0x8b, 0x70, 0x6e, 0x64, 0x6e,
-		0x81, 0x40, 0x04, 0x81, 0x08, 0x02, 0x51, 0x9b, 0xbc, 0x23, 0x73, 0x2b, 0x71, 0xd2, 0x04, 0x99,
-		0x5b, 0xe2, 0xdf, 0x83, 0xcc, 0xad, 0xcc, 0x6e, 0x3d, 0x32, 0xb7, 0xc0, 0xdf, 0xe8, 0x51, 0x1f,
-		0x64, 0x6e, 0x01, 0x5e, 0x03, 0x5e, 0x03, 0x5e, 0xe7, 0x18, 0x5e, 0x23, 0x73, 0x0b, 0x99, 0x5b,
-		0xf0, 0x0c, 0xf0, 0x0c, 0xf0, 0x0c, 0xf0, 0x0c, 0x8b, 0xf2, 0x8e, 0xcc, 0xad, 0xa4, 0x79, 0x17,
-		0x64, 0x6e, 0x29, 0x7c, 0x11, 0x32, 0xb7, 0x52, 0xe0, 0x8c, 0x90, 0xb9, 0x95, 0x69, 0x65, 0x40,
-		0xe6, 0x16, 0x32, 0xb7, 0xc0, 0xfc, 0x11, 0xcf, 0xb4, 0xd3, 0x99, 0x5b, 0x04, 0xdd, 0xf3, 0xe8,
-		0x76, 0x03, 0xed, 0x0e, 0x79, 0xf7, 0xad, 0x48, 0x92, 0xe2, 0xe6, 0x0e, 0xdb, 0x7e, 0x78, 0xdd,
-		0x43, 0xf1, 0x21, 0x78, 0x98, 0x56, 0xc3, 0x34, 0x5b, 0x0f, 0xc1, 0x37, 0xfd, 0x36, 0xfe, 0xf6,
-		0xf0, 0xe7, 0xd6, 0xe3, 0xe4, 0x5b, 0xd3, 0xea, 0xb2, 0xb8, 0x97, 0xa0, 0x3c, 0x8c, 0xa3, 0xeb,
-		0xf1, 0x82, 0xa8, 0x41, 0x94, 0xe2, 0x95, 0xe5, 0xf9, 0x0d, 0xdf, 0x57, 0xcb, 0x27, 0x2a, 0x5e,
-		0x5b, 0xf6, 0x45, 0x8f, 0x8d, 0x23, 0x63, 0xaf, 0xf8, 0xbe, 0x60, 0x0f, 0x7b, 0x3d, 0x85, 0x86,
-		0x93, 0xd7, 0xe6, 0x77, 0xba, 0xc9, 0x6e, 0xdd, 0x0e, 0x73, 0x59, 0xe7, 0xc3, 0x6b, 0x38, 0x55,
-		0xa2, 0x5b, 0x44, 0xa4, 0xaa, 0x7a, 0x54, 0x54, 0x41, 0x37, 0x45, 0x74, 0x52, 0x4e, 0x19, 0xc5,
-		0x55, 0x49, 0x6c, 0x84, 0xe0, 0x8e, 0xaa, 0xee, 0x24, 0xf1, 0x0e, 0x8a, 0xad, 0x29, 0xff, 0xca,
-		0x08, 0xac, 0x8a, 0x64, 0xe6, 0xb1, 0x52, 0x86, 0xb1, 0x64, 0x26, 0xb1, 0x74, 0xc6, 0xb0, 0x0a,
-		0xbd, 0xab, 0x4e, 0xdf, 0xaa, 0xd2, 0xb3, 0x64, 0xf4, 0x2b, 0x19, 0xbd, 0x4a, 0x42, 0x9f, 0xea,
-		0xd5, 0x73, 0xd9, 0xcc, 0x5a, 0xb5, 0xeb, 0xa8, 0x28, 0xae, 0x9f, 0x52, 0x3c, 0x3b, 0x51, 0x3e,
-		0x23, 0xa1, 0x38, 0x0b, 0xa1, 0x3b, 0xf3, 0xa0, 0x3a, 0xdb, 0x20, 0x3f, 0xc3, 0x20, 0x3f, 0xab,
-		0x20, 0x3d, 0x93, 0x48, 0x16, 0xc1, 0x2a, 0x9f, 0x25, 0xd0, 0x5d, 0xdf, 0xa4, 0x78, 0x56, 0x2c,
-		0x01, 0x57, 0x24, 0x1c, 0x82, 0xaf, 0xb2, 0x5e, 0xb3, 0xfe, 0xeb, 0xe3, 0x59, 0x60, 0x63, 0x60,
-		0x63, 0x60, 0x63, 0x84, 0xe4, 0xc5, 0xea, 0x30, 0xdb, 0xb7, 0xfc, 0x57, 0x97, 0x75, 0x29, 0x0c,
-		0x8d, 0x42, 0x66, 0x68, 0xf1, 0x32, 0x7c, 0x94, 0x0f, 0xa6, 0xc7, 0xe8, 0x2e, 0x66, 0x68, 0x34,
-		0x1a, 0xad, 0x87, 0x8b, 0xfb, 0x3f, 0x2f, 0xee, 0x5b, 0x8f, 0x7f, 0xdd, 0x5d, 0xa8, 0x0a, 0x61,
-		0x90, 0x06, 0xeb, 0x91, 0x9c, 0x39, 0x10, 0x57, 0x37, 0x3e, 0x36, 0xce, 0x1b, 0xe7, 0x0f, 0xc5,
-		0x2c, 0x14, 0x70, 0x12, 0xbf, 0xd9, 0x7d, 0xe3, 0xe3, 0xe5, 0xa7, 0x87, 0xb4, 0x6f, 0x36, 0x68,
-		0x26, 0x6d, 0x1b, 0x40, 0x16, 0xac, 0x24, 0x0b, 0xc4, 0x39, 0x73, 0x01, 0xaa, 0x60, 0x8f, 0x70,
-		0xe5, 0xa6, 0x5c, 0xa6, 0x40, 0xf8, 0x23, 0x47, 0x5c, 0x2a, 0x11, 0x95, 0x4a, 0xc4, 0xa4, 0x1c,
-		0x11, 0xc9, 0xbb, 0x7e, 0x92, 0x12, 0x47, 0x24, 0x69, 0x45, 0x21, 0xda, 0x68, 0x33, 0x73, 0xc8,
-		0x27, 0xb3, 0x9b, 0x25, 0x70, 0xfd, 0x27, 0x36, 0xac, 0xad, 0xe8, 0x9a, 0xca, 0xaf, 0xe5, 0xfa,
-		0xd7, 0x8d, 0x7f, 0x89, 0x35, 0x2f, 0xc0, 0xc9, 0xce, 0x09, 0xb1, 0x71, 0x9c, 0xec, 0x1b, 0x37,
-		0xdb, 0x26, 0x02, 0xe6, 0xc5, 0x41, 0xbb, 0x28, 0x38, 0x97, 0x06, 0xe1, 0xd2, 0x60, 0x5b, 0x0a,
-		0x54, 0x67, 0x58, 0xa4, 0x37, 0x7b, 0x9b, 0x35, 0xa2, 0xbc, 0x27, 0xf0, 0x3a, 0xbc, 0xaf, 0x21,
-		0xf2, 0xf8, 0xc5, 0xb5, 0xba, 0xb4, 0xda, 0x64, 0xad, 0x7e, 0xd9, 0xe5, 0x57, 0x59, 0xf1, 0x1a,
-		0x45, 0xb3, 0x67, 0xba, 0xfd, 0xf8, 0x34, 0xed, 0x48, 0xde, 0xc3, 0xcf, 0xc5, 0x2c, 0xc4, 0x7a,
-		0x8d, 0xdc, 0xa8, 0x89, 0x3c, 0x1a, 0xb8, 0xa0, 0x79, 0xeb, 0x1e, 0x46, 0x44, 0xe9, 0x84, 0x95,
-		0x4d, 0x58, 0xc9, 0x96, 0x94, 0x6b, 0xf2, 0xe8, 0x44, 0x02, 0xb8, 0x89, 0x25, 0x9e, 0x6c, 0x1b,
-		0xbf, 0xf9, 0x9d, 0x7c, 0x9c, 0xd8, 0xfc, 0x96, 0x34, 0x99, 0xdf, 0x4d, 0x42, 0x90, 0x63, 0x0b,
-		0xbc, 0x41, 0x48, 0x68, 0x8c, 0x30, 0xef, 0x11, 0x83, 0xe8, 0xbd, 0x6c, 0x72, 0xf7, 0xae, 0x09,
-		0x9e, 0xa9, 0x09, 0x53, 0x78, 0x32, 0x94, 0x9d, 0x94, 0xb8, 0xa9, 0xb2, 0x72, 0xca, 0x2c, 0x9c,
-		0x32, 0xeb, 0x26, 0x2b, 0x8e, 0x7a, 0x42, 0x32, 0xed, 0x21, 0x45, 0xf0, 0x76, 0x93, 0xff, 0x08,
-		0x35, 0xe6, 0xe2, 0xc0, 0xfd, 0x1c, 0xc6, 0xc9, 0xea, 0x88, 0x2b, 0x96, 0xd5, 0x11, 0x54, 0xaa,
-		0x12, 0x94, 0x0a, 0x4a, 0xa5, 0xc4, 0x46, 0x47, 0xbb, 0xd6, 0x63, 0x66, 0x57, 0x8c, 0x79, 0x8e,
-		0x2c, 0xfb, 0xa9, 0xc0, 0x98, 0xbb, 0x50, 0x6f, 0x8f, 0x8e, 0x26, 0x80, 0xfe, 0xd8, 0xea, 0x24,
-		0xa9, 0x95, 0x62, 0x49, 0x25, 0x52, 0xc9, 0x24, 0xd2, 0x0e, 0xaf, 0x02, 0xdd, 0xdc, 0x6a, 0xdd,
-		0x14, 0x4d, 0xfd, 0x10, 0x71, 0x21, 0xf2, 0xae, 0x44, 0xd2, 0xa5, 0x48, 0xbb, 0x16, 0x15, 0x31,
-		0x26, 0x11, 0x67, 0x55, 0xb1, 0x26, 0x13, 0x6f, 0x32, 0x31, 0xa7, 0x12, 0xf7, 0x64, 0x0e, 0x2f,
-		0xa4, 0x0f, 0x4c, 0xd5, 0x93, 0x31, 0x24, 0x93, 0x30, 0xf4, 0xe4, 0x37, 0xba, 0xcc, 0x93, 0xb4,
-		0xab, 0x51, 0x8b, 0x83, 0xe9, 0x0c, 0xd0, 0x74, 0x68, 0x3a, 0x34, 0x3d, 0xb3, 0x9a, 0xee, 0xb1,
-		0x6f, 0xcc, 0xb5, 0xfc, 0x57, 0x85, 0x64, 0xe6, 0xe9, 0x0c, 0xd0, 0x74, 0x68, 0xfa, 0x56, 0x6a,
-		0xba, 0x5a, 0xf2, 0x93, 0x4a, 0xd2, 0x13, 0x4d, 0xb2, 0x53, 0xf4, 0x22, 0xb7, 0x77, 0x17, 0x37,
-		0xe7, 0xb7, 0x37, 0xbf, 0x5e, 0xfe, 0xd6, 0x6a, 0x5c, 0x35, 0xee, 0xaf, 0x5b, 0x0f, 0x17, 0x7f,
-		0x5e, 0xdc, 0x5f, 0x3e, 0xfe, 0x25, 0x2b, 0x49, 0x04, 0x69, 0x4e, 0x44, 0xf9, 0x5b, 0x9f, 0x6e,
-		0xfe, 0xb8, 0xb9, 0xfd, 0xcf, 0x8d, 0x42, 0x66, 0xde, 0x61, 0xda, 0xaf, 0x70, 0x7d, 0x79, 0x73,
-		0x7b, 0x9f, 0xe7, 0x17, 0xf8, 0x4f, 0xe3, 0xfe, 0xe6, 0xf2, 0xe6, 0xb7, 0x5c, 0xef, 0x41, 0xe3,
-		0xff, 0xe6, 0x7b, 0x0f, 0xce, 0xef, 0x2f, 0x1f, 0x2f, 0xcf, 0x1b, 0x57, 0x49, 0xa7, 0xa8, 0x36,
-		0x75, 0x5b, 0x7d, 0x2d, 0xe8, 0xc7, 0x67, 0xdf, 0x7d, 0x79, 0xe4, 0x13, 0x8c, 0x06, 0xea, 0x01,
-		0xea, 0x41, 0x7c, 0x93, 0xd9, 0xf8, 0xc6, 0xb7, 0xfa, 0xcc, 0x68, 0xbb, 0xcc, 0xf4, 0x99, 0x02,
-		0x63, 0xb9, 0x30, 0x0b, 0x34, 0x1e, 0x1a, 0xbf, 0x95, 0x1a, 0x3f, 0x96, 0x72, 0xdf, 0x6a, 0x7f,
-		0xf5, 0xea, 0x55, 0x05, 0xb5, 0x97, 0xe8, 0xe5, 0xa4, 0xd8, 0xb5, 0x5f, 0xad, 0x77, 0x03, 0x41,
-		0x09, 0x13, 0x49, 0xe3, 0x4e, 0xaa, 0xae, 0xf9, 0x94, 0x6d, 0xd1, 0x47, 0x6a, 0x9d, 0x2c, 0x32,
-		0xb7, 0xb4, 0xe5, 0x77, 0xd5, 0x6a, 0xfd, 0xb4, 0x5a, 0x2d, 0x9d, 0x9e, 0x9c, 0x96, 0xce, 0x6a,
-		0xb5, 0x72, 0xbd, 0x5c, 0xcb, 0xd0, 0x6a, 0x27, 0x54, 0x00, 0xd2, 0xcc, 0x82, 0x5f, 0x7e, 0x1d,
-		0x30, 0x43, 0xe5, 0x10, 0x71, 0x3a, 0x01, 0xbc, 0x31, 0xbc, 0xf1, 0x56, 0x7a, 0xe3, 0xa1, 0x6d,
-		0x39, 0xb6, 0x0a, 0xfc, 0x96, 0xe8, 0xf2, 0xaa, 0xd6, 0xc5, 0x75, 0x1b, 0x6a, 0xd9, 0x0f, 0xd3,
-		0x7a, 0xf3, 0xad, 0xaf, 0xb0, 0x5d, 0x22, 0x9f, 0x1f, 0xff, 0xba, 0xbb, 0x68, 0x5d, 0x7e, 0xdc,
-		0xde, 0x52, 0xdb, 0xab, 0xdb, 0xad, 0xac, 0xb3, 0x6d, 0x5c, 0x6e, 0xe5, 0x6b, 0x5d, 0xfc, 0xff,
-		0xee, 0x1e, 0xb7, 0xf1, 0xbd, 0x6e, 0x1f, 0x51, 0x13, 0x9d, 0x1e, 0x24, 0xce, 0x6f, 0x5a, 0xba,
-		0x40, 0x05, 0xb5, 0xee, 0x6a, 0xd4, 0xb0, 0x42, 0x7a, 0x23, 0xda, 0x17, 0xab, 0x8b, 0x96, 0xaa,
-		0x87, 0x96, 0xaa, 0x83, 0x16, 0xab, 0x7f, 0x4e, 0xad, 0x90, 0x71, 0x6e, 0xf7, 0x8b, 0x5c, 0xf9,
-		0xca, 0xab, 0x4a, 0x03, 0x83, 0xd1, 0xf9, 0xac, 0x84, 0x5c, 0x13, 0x48, 0xf0, 0x15, 0x36, 0xb6,
-		0x7b, 0x4e, 0xfb, 0xeb, 0xe6, 0xba, 0xc6, 0xc9, 0xc7, 0x14, 0xcb, 0x1a, 0x4b, 0x34, 0x65, 0x8d,
-		0xde, 0x6b, 0x3e, 0x6b, 0x1a, 0xc7, 0xcf, 0x9d, 0x54, 0x41, 0x23, 0x67, 0x2d, 0x9a, 0x58, 0x0d,
-		0x5a, 0x56, 0x4a, 0x1a, 0xd7, 0x0b, 0x80, 0x2c, 0x8d, 0x90, 0x7e, 0x3d, 0xe3, 0x5a, 0x01, 0xa1,
-		0x71, 0x4b, 0xdc, 0xc5, 0x8c, 0xbe, 0xd5, 0x67, 0xff, 0x38, 0x36, 0x33, 0x84, 0x1a, 0x25, 0x2e,
-		0xf0, 0xf2, 0xb3, 0xe1, 0xdb, 0x51, 0x85, 0xc5, 0x27, 0x76, 0xaa, 0x2c, 0x56, 0xf6, 0xca, 0x3c,
-		0xb8, 0xc4, 0x52, 0x0f, 0x7a, 0x94, 0xaf, 0xbf, 0x5a, 0x10, 0x40, 0x43, 0xb0, 0x87, 0x9e, 0x20,
-		0x23, 0x93, 0xd7, 0xce, 0x26, 0x81, 0x53, 0xe7, 0xaa, 0xe5, 0x44, 0x53, 0x13, 0xb8, 0x20, 0xb8,
-		0x20, 0xb8, 0x20, 0xb8, 0x20, 0xb8, 0x20, 0x0d, 0x2e, 0x28, 0xc7, 0xbd, 0x88, 0xd6, 0x05, 0xc6,
-		0xf1, 0x94, 0xc3, 0x79, 0x30, 0x4a, 0x25, 0x6c, 0x5f, 0x1f, 0xdf, 0xf1, 0xc5, 0x75, 0x08, 0xdc,
-		0xb3, 0x17, 0xb8, 0x77, 0x9c, 0xbe, 0x69, 0xd9, 0x7c, 0x2e, 0x2f, 0x5a, 0xdb, 0xf9, 0x41, 0x7c,
-		0xf8, 0xa9, 0x84, 0x10, 0x3e, 0xaf, 0xf8, 0x89, 0xdb, 0x21, 0x49, 0x88, 0x47, 0x41, 0xf0, 0x86,
-		0xc3, 0xe2, 0x15, 0xb3, 0x5f, 0x02, 0x13, 0xc9, 0x77, 0x92, 0x29, 0xd6, 0xf9, 0x53, 0x02, 0xb8,
-		0xc8, 0xa5, 0xca, 0x44, 0xb9, 0x56, 0x82, 0xe3, 0x14, 0x12, 0xa9, 0x46, 0x62, 0x7d, 0x4c, 0x13,
-		0x5f, 0x8a, 0x4a, 0xed, 0x24, 0xc1, 0xc5, 0x20, 0xc2, 0x38, 0x4d, 0x0e, 0x89, 0x15, 0xbd, 0x5b,
-		0xb3, 0xb8, 0xbf, 0xbf, 0xff, 0xd9, 0x34, 0xfe, 0x69, 0x18, 0xff, 0x2d, 0x19, 0x67, 0xad, 0xe6,
-		0xdc, 0x5f, 0x9e, 0x9e, 0x8c, 0x56, 0xf3, 0xe0, 0x47, 0xe9, 0xb0, 0x5e, 0x1e, 0x1d, 0xfc, 0x32,
-		0xfb, 0x7d, 0xf3, 0xe9, 0xe9, 0xe8, 0xe0, 0xdf, 0x32, 0xa3, 0x7e, 0x39, 0xf8, 0xf9, 0xf4, 0x74,
-		0xb4, 0xd9, 0x82, 0x34, 0x35, 0x44, 0xeb, 0x5f, 0x1c, 0xcf, 0x17, 0x73, 0x3b, 0xd1, 0x08, 0xf8,
-		0x1c, 0xf8, 0x1c, 0xf8, 0x1c, 0xf8, 0x1c, 0xf8, 0x1c, 0xf8, 0x1c, 0x21, 0x9f, 0xd3, 0x73, 0x5e,
-		0x2c, 0xdb, 0x78, 0x36, 0x6d, 0x9b, 0xb9, 0xfc, 0x7e, 0x67, 0x61, 0x14, 0x7c, 0x0f, 0x7c, 0xcf,
-		0xd2, 0x41, 0x02, 0x67, 0x46, 0x2e, 0x27, 0xc9, 0x26, 0x27, 0xdb, 0x7d, 0xc7, 0xef, 0x08, 0x8b,
-		0xf6, 0xfc, 0x20, 0x48, 0x36, 0x24, 0x3b, 0x3d, 0xc9, 0x4e, 0x97, 0x55, 0x5d, 0x73, 0x26, 0xc9,
-		0x49, 0x90, 0x0e, 0x86, 0x1c, 0xed, 0xda, 0x83, 0x4f, 0x65, 0xa3, 0x59, 0x3b, 0xc8, 0x51, 0x8e,
-		0xac, 0xa6, 0xc1, 0x50, 0x20, 0xa5, 0x69, 0x30, 0x44, 0x3e, 0x13, 0x0e, 0x93, 0xc3, 0x0f, 0x5a,
-		0x76, 0x87, 0x7d, 0x97, 0x68, 0x21, 0x1d, 0x0c, 0xc3, 0xe1, 0xb1, 0x48, 0x10, 0x88, 0xc3, 0x63,
-		0x71, 0x0f, 0xbf, 0x1c, 0xe3, 0xa4, 0xd5, 0x3f, 0x3a, 0x10, 0x78, 0xb4, 0x90, 0x86, 0x62, 0x6e,
-		0xb5, 0x62, 0x0a, 0x37, 0x8f, 0xfe, 0x62, 0xba, 0x9d, 0xbf, 0x4d, 0x97, 0x19, 0x96, 0xed, 0x33,
-		0xd7, 0x1d, 0x0e, 0x14, 0x9a, 0x30, 0xad, 0x98, 0x2b, 0xe1, 0x8b, 0xf5, 0x53, 0x2a, 0x09, 0x17,
-		0x13, 0x74, 0x55, 0x81, 0x27, 0x13, 0x7c, 0x32, 0x05, 0x20, 0x51, 0x04, 0x31, 0x85, 0x90, 0xe0,
-		0x7e, 0x0b, 0x4a, 0x17, 0xeb, 0x9b, 0xdf, 0x5e, 0xd4, 0xbb, 0x3e, 0x8e, 0x27, 0xc1, 0x95, 0xd7,
-		0x63, 0xd1, 0xf0, 0x5f, 0x07, 0xcc, 0xc3, 0xa5, 0xd7, 0x12, 0x4a, 0x35, 0x59, 0xb9, 0x9d, 0xbb,
-		0xf6, 0x7a, 0xc0, 0xdc, 0x36, 0xb3, 0x7d, 0xf3, 0x85, 0x11, 0xd4, 0xe4, 0xab, 0x94, 0xe4, 0xab,
-		0x35, 0x48, 0x9a, 0xfe, 0x51, 0xaf, 0x2c, 0x26, 0x69, 0x98, 0x44, 0x64, 0x5e, 0x96, 0xa6, 0x23,
-		0x6a, 0xa0, 0x14, 0xcd, 0x47, 0xd8, 0xda, 0x47, 0x51, 0xa4, 0x17, 0xb7, 0x80, 0xa0, 0xb1, 0x92,
-		0xee, 0x2d, 0x28, 0x97, 0xb2, 0xbc, 0x09, 0x7b, 0xe9, 0x8c, 0x6e, 0x26, 0x75, 0xc1, 0xb7, 0x04,
-		0x2c, 0xb4, 0x6c, 0xcf, 0x37, 0x6d, 0x5f, 0x1d, 0x6d, 0x4c, 0x27, 0x02, 0xe2, 0x00, 0xe2, 0x00,
-		0xe2, 0x00, 0xe2, 0x00, 0xe2, 0x00, 0xe2, 0x00, 0xe2, 0x00, 0xe2, 0x58, 0x81, 0x38, 0x7c, 0xe6,
-		0x7e, 0x33, 0x7b, 0x14, 0x90, 0x23, 0x9c, 0x09, 0x98, 0x03, 0x98, 0x03, 0x98, 0x43, 0x58, 0x66,
-		0x3c, 0xdf, 0xf4, 0x0d, 0x45, 0x25, 0x2a, 0xa8, 0x75, 0x80, 0x8e, 0xa6, 0xf8, 0x64, 0x4f, 0x6c,
-		0x6e, 0xd1, 0x36, 0x6d, 0xc7, 0x63, 0x6d, 0xc7, 0xee, 0x28, 0xc9, 0x32, 0x60, 0x0c, 0x60, 0x4c,
-		0xae, 0x60, 0x0c, 0x79, 0x87, 0x6a, 0xe0, 0x9a, 0x64, 0x71, 0x4d, 0x5f, 0x41, 0xca, 0x66, 0xa9,
-		0xab, 0xe6, 0x77, 0xa0, 0x19, 0xa0, 0x19, 0xa0, 0x19, 0x30, 0x28, 0x80, 0x1e, 0x80, 0x1e, 0x60,
-		0x50, 0x80, 0x34, 0x56, 0x22, 0x0d, 0xc3, 0xb7, 0xfa, 0x8c, 0x04, 0x6e, 0x4c, 0x66, 0x02, 0xe6,
-		0x00, 0xe6, 0x00, 0xe6, 0x10, 0x96, 0x19, 0xb5, 0x9b, 0xb3, 0x28, 0xf9, 0x13, 0x80, 0x0e, 0x80,
-		0x0e, 0xf0, 0x1d, 0x40, 0x21, 0x09, 0xa2, 0x10, 0x05, 0x45, 0x9f, 0x01, 0x10, 0xcb, 0x06, 0xf6,
-		0x00, 0xf6, 0x00, 0xf6, 0x00, 0xdf, 0x01, 0xe8, 0x01, 0xe8, 0x01, 0xbe, 0x03, 0x48, 0x63, 0x25,
-		0xd2, 0xa0, 0xe2, 0x3b, 0xa6, 0x33, 0x01, 0x73, 0x00, 0x73, 0x00, 0x73, 0x80, 0xef, 0x00, 0xe8,
-		0x00, 0xe8, 0x00, 0xdf, 0xb1, 0x7b, 0x28, 0x44, 0x6b, 0xd9, 0xaf, 0xe4, 0x75, 0x8d, 0xd1, 0x78,
-		0xee, 0x06, 0x4f, 0x83, 0xa1, 0x37, 0xfe, 0x9f, 0xb0, 0xeb, 0x84, 0x72, 0x45, 0x7c, 0x21, 0xbe,
-		0xd5, 0xfe, 0x60, 0xd8, 0xfa, 0x3d, 0x9c, 0xfe, 0x32, 0x9a, 0x3d, 0x03, 0xd7, 0xc9, 0x5b, 0x9d,
-		0x1e, 0x93, 0xef, 0x21, 0x10, 0x8c, 0x46, 0xd7, 0x00, 0x7d, 0x00, 0x0f, 0x5d, 0x03, 0xd0, 0x35,
-		0x00, 0xd1, 0x11, 0xa2, 0xa3, 0xdd, 0x88, 0x8e, 0xc0, 0xc8, 0x22, 0x38, 0x42, 0x70, 0x24, 0x13,
-		0x1c, 0x81, 0x91, 0x4d, 0x31, 0x16, 0x42, 0xd7, 0x00, 0x20, 0x0e, 0x20, 0x0e, 0x20, 0x0e, 0x20,
-		0x0e, 0x20, 0x0e, 0x20, 0x0e, 0x20, 0x8e, 0x8c, 0x22, 0x0e, 0x74, 0x0d, 0x00, 0xe6, 0x00, 0xe6,
-		0x40, 0xd7, 0x80, 0xf9, 0x29, 0xd0, 0x35, 0x00, 0x30, 0x66, 0xa7, 0x61, 0x0c, 0x4e, 0x95, 0x73,
-		0x8e, 0x6b, 0xd0, 0x35, 0x00, 0x68, 0x06, 0x68, 0x06, 0x0c, 0x0a, 0xa0, 0x07, 0xa0, 0x07, 0x18,
-		0x14, 0x20, 0x0d, 0xad, 0x48, 0x03, 0x5d, 0x03, 0x80, 0x39, 0x80, 0x39, 0x90, 0x45, 0x0f, 0xd0,
-		0x01, 0xd0, 0x01, 0xbe, 0x03, 0x28, 0x24, 0x0d, 0x14, 0x82, 0xae, 0x01, 0xc0, 0x1e, 0xc0, 0x1e,
-		0xe0, 0x3b, 0x00, 0x3d, 0x00, 0x3d, 0xc0, 0x77, 0x00, 0x69, 0xe8, 0x44, 0x1a, 0xe8, 0x1a, 0x00,
-		0xcc, 0x01, 0xcc, 0x01, 0xbe, 0x03, 0xa0, 0x03, 0xa0, 0x03, 0x7c, 0x07, 0x50, 0x88, 0x2a, 0x0a,
-		0xd9, 0xc6, 0xae, 0x01, 0x12, 0x35, 0xf0, 0x85, 0xb5, 0x7d, 0x02, 0x2e, 0xc7, 0x13, 0x66, 0xa1,
-		0x35, 0x80, 0xdd, 0x61, 0xdf, 0x15, 0x7a, 0x03, 0x04, 0xc3, 0xe5, 0x9a, 0x03, 0x94, 0xd0, 0x1c,
-		0x20, 0x49, 0xfc, 0xb6, 0x4b, 0xcd, 0x01, 0xa4, 0x51, 0x59, 0xb4, 0xdf, 0x43, 0x7b, 0x6c, 0x62,
-		0x24, 0xb6, 0x7b, 0xda, 0xf9, 0xe2, 0x4c, 0x62, 0x6c, 0xf8, 0xd8, 0x72, 0xb8, 0x8b, 0x00, 0x82,
-		0x32, 0x7b, 0xd8, 0x67, 0xee, 0xc4, 0xba, 0xaa, 0x43, 0xd0, 0x72, 0x55, 0x61, 0x8e, 0x0b, 0x7b,
-		0xd8, 0x1f, 0xef, 0x60, 0xa2, 0x68, 0x9e, 0x60, 0x09, 0x87, 0x96, 0xed, 0x9f, 0x54, 0x08, 0x56,
-		0xef, 0x14, 0x00, 0x1e, 0x00, 0x7e, 0x47, 0x00, 0x7c, 0xb5, 0x72, 0x56, 0x3d, 0xab, 0x9f, 0x56,
-		0xce, 0x00, 0xdb, 0x53, 0x83, 0xed, 0xcd, 0x0c, 0x80, 0xd1, 0xaf, 0xcc, 0xb5, 0x59, 0x4f, 0x1e,
-		0x8d, 0x86, 0xe3, 0xd1, 0xab, 0x0a, 0x70, 0x34, 0x53, 0x70, 0x14, 0xbd, 0xaa, 0xc0, 0xc9, 0x6b,
-		0x51, 0x22, 0x72, 0x65, 0x8a, 0x53, 0x2a, 0xe4, 0x01, 0x20, 0x0f, 0x00, 0x88, 0x1e, 0x88, 0x9e,
-		0x77, 0x0b, 0x90, 0x07, 0x90, 0x22, 0x94, 0x47, 0xaf, 0x2a, 0x20, 0x0e, 0x20, 0x0e, 0x20, 0x0e,
-		0x20, 0x0e, 0x20, 0x0e, 0x20, 0x0e, 0x20, 0x8e, 0xac, 0x22, 0x0e, 0xf4, 0xaa, 0x02, 0xe6, 0x00,
-		0xe6, 0x40, 0xaf, 0xaa, 0xf9, 0x29, 0xd0, 0xab, 0x0a, 0x30, 0x66, 0xa7, 0x61, 0x0c, 0x72, 0x19,
-		0x73, 0x8e, 0x6b, 0xd0, 0xab, 0x0a, 0x68, 0x06, 0x68, 0x06, 0x0c, 0x0a, 0xa0, 0x07, 0xa0, 0x07,
-		0x18, 0x14, 0x20, 0x0d, 0xad, 0x48, 0x03, 0xbd, 0xaa, 0x80, 0x39, 0x80, 0x39, 0x50, 0xbb, 0x09,
-		0xd0, 0x01, 0xd0, 0x01, 0xbe, 0x03, 0x28, 0x24, 0x0d, 0x14, 0x82, 0x5e, 0x55, 0xc0, 0x1e, 0xc0,
-		0x1e, 0xe0, 0x3b, 0x00, 0x3d, 0x00, 0x3d, 0xc0, 0x77, 0x00, 0x69, 0xe8, 0x44, 0x1a, 0xe8, 0x55,
-		0x05, 0xcc, 0x01, 0xcc, 0x01, 0xbe, 0x03, 0xa0, 0x03, 0xa0, 0x03, 0x7c, 0x07, 0x50, 0x88, 0x2a,
-		0x0a, 0xd9, 0xc6, 0x5e, 0x55, 0x52, 0x55, 0xf0, 0x85, 0xb5, 0xdd, 0xaa, 0xfe, 0x98, 0x4c, 0x99,
-		0x81, 0x16, 0x01, 0xb6, 0x25, 0x81, 0x33, 0x22, 0xbf, 0x15, 0x8c, 0x46, 0x7b, 0x00, 0x7d, 0x48,
-		0x0e, 0xed, 0x01, 0xd0, 0x1e, 0x00, 0x61, 0x10, 0xc2, 0xa0, 0xdd, 0x08, 0x83, 0x40, 0xbd, 0x22,
-		0x0a, 0x42, 0x14, 0x24, 0x13, 0x05, 0x81, 0x7a, 0x4d, 0x31, 0xe8, 0x41, 0x7b, 0x00, 0x20, 0x0e,
-		0x20, 0x0e, 0x20, 0x0e, 0x20, 0x0e, 0x20, 0x0e, 0x20, 0x0e, 0x20, 0x8e, 0x8c, 0x22, 0x0e, 0xb4,
-		0x07, 0x00, 0xe6, 0x00, 0xe6, 0x40, 0x7b, 0x80, 0xf9, 0x29, 0xd0, 0x1e, 0x00, 0x30, 0x66, 0xa7,
-		0x61, 0x0c, 0x8e, 0x8f, 0x73, 0x8e, 0x6b, 0xd0, 0x1e, 0x00, 0x68, 0x06, 0x68, 0x06, 0x0c, 0x0a,
-		0xa0, 0x07, 0xa0, 0x07, 0x18, 0x14, 0x20, 0x0d, 0xad, 0x48, 0x03, 0xed, 0x01, 0x80, 0x39, 0x80,
-		0x39, 0x90, 0x2e, 0x0f, 0xd0, 0x01, 0xd0, 0x01, 0xbe, 0x03, 0x28, 0x24, 0x0d, 0x14, 0x82, 0xf6,
-		0x00, 0xc0, 0x1e, 0xc0, 0x1e, 0xe0, 0x3b, 0x00, 0x3d, 0x00, 0x3d, 0xc0, 0x77, 0x00, 0x69, 0xe8,
-		0x44, 0x1a, 0x68, 0x0f, 0x00, 0xcc, 0x01, 0xcc, 0x01, 0xbe, 0x03, 0xa0, 0x03, 0xa0, 0x03, 0x7c,
-		0x07, 0x50, 0x88, 0x2a, 0x0a, 0xd9, 0xc6, 0xf6, 0x00, 0x12, 0x35, 0xf0, 0x85, 0xb5, 0xcd, 0x01,
-		0x6e, 0xc6, 0x13, 0x66, 0xa0, 0x35, 0x80, 0xe7, 0x74, 0xfd, 0xbf, 0x4d, 0x97, 0x4d, 0x72, 0x15,
-		0xdd, 0xe1, 0xc0, 0x97, 0x6f, 0x14, 0xb0, 0x62, 0x2e, 0xb4, 0x0d, 0xd0, 0x87, 0xf0, 0xd0, 0x36,
-		0x00, 0x6d, 0x03, 0x10, 0x1e, 0x21, 0x3c, 0xda, 0x8d, 0xf0, 0x08, 0x94, 0x2c, 0xa2, 0x23, 0x44,
-		0x47, 0x32, 0xd1, 0x11, 0x28, 0xd9, 0x14, 0x83, 0x21, 0xb4, 0x0d, 0x00, 0xe2, 0x00, 0xe2, 0x00,
-		0xe2, 0x00, 0xe2, 0x00, 0xe2, 0x00, 0xe2, 0x00, 0xe2, 0xc8, 0x28, 0xe2, 0x40, 0xdb, 0x00, 0x60,
-		0x0e, 0x60, 0x0e, 0xb4, 0x0d, 0x98, 0x9f, 0x02, 0x6d, 0x03, 0x00, 0x63, 0x76, 0x1a, 0xc6, 0xe0,
-		0x58, 0x39, 0xe7, 0xb8, 0x06, 0x6d, 0x03, 0x80, 0x66, 0x80, 0x66, 0xc0, 0xa0, 0x00, 0x7a, 0x00,
-		0x7a, 0x80, 0x41, 0x01, 0xd2, 0xd0, 0x8a, 0x34, 0xd0, 0x36, 0x00, 0x98, 0x03, 0x98, 0x03, 0x69,
-		0xf4, 0x00, 0x1d, 0x00, 0x1d, 0xe0, 0x3b, 0x80, 0x42, 0xd2, 0x40, 0x21, 0x68, 0x1b, 0x00, 0xec,
-		0x01, 0xec, 0x01, 0xbe, 0x03, 0xd0, 0x03, 0xd0, 0x03, 0x7c, 0x07, 0x90, 0x86, 0x4e, 0xa4, 0x81,
-		0xb6, 0x01, 0xc0, 0x1c, 0xc0, 0x1c, 0xe0, 0x3b, 0x00, 0x3a, 0x00, 0x3a, 0xc0, 0x77, 0x00, 0x85,
-		0xa8, 0xa2, 0x90, 0x6d, 0x6c, 0x1b, 0xa0, 0x5c, 0x11, 0x5f, 0x58, 0xdb, 0x44, 0xe0, 0x21, 0x9c,
-		0xfe, 0x32, 0x9a, 0x3d, 0x03, 0x1d, 0x05, 0x7c, 0xc7, 0x97, 0xc8, 0x24, 0x9e, 0xf9, 0xb3, 0x60,
-		0x38, 0xfa, 0x06, 0xe8, 0x83, 0x78, 0xe8, 0x1b, 0x80, 0xbe, 0x01, 0x88, 0x8f, 0x10, 0x1f, 0xed,
-		0x46, 0x7c, 0x04, 0x4e, 0x16, 0xe1, 0x11, 0xc2, 0x23, 0x99, 0xf0, 0x08, 0x9c, 0x6c, 0x8a, 0xd1,
-		0x10, 0xfa, 0x06, 0x00, 0x71, 0x00, 0x71, 0x00, 0x71, 0x00, 0x71, 0x00, 0x71, 0x00, 0x71, 0x00,
-		0x71, 0x64, 0x14, 0x71, 0xa0, 0x6f, 0x00, 0x30, 0x07, 0x30, 0x07, 0xfa, 0x06, 0xcc, 0x4f, 0x81,
-		0xbe, 0x01, 0x80, 0x31, 0x3b, 0x0d, 0x63, 0x70, 0xae, 0x9c, 0x73, 0x5c, 0x83, 0xbe, 0x01, 0x40,
-		0x33, 0x40, 0x33, 0x60, 0x50, 0x00, 0x3d, 0x00, 0x3d, 0xc0, 0xa0, 0x00, 0x69, 0x68, 0x45, 0x1a,
-		0xe8, 0x1b, 0x00, 0xcc, 0x01, 0xcc, 0x81, 0x3c, 0x7a, 0x80, 0x0e, 0x80, 0x0e, 0xf0, 0x1d, 0x40,
-		0x21, 0x69, 0xa0, 0x10, 0xf4, 0x0d, 0x00, 0xf6, 0x00, 0xf6, 0x00, 0xdf, 0x01, 0xe8, 0x01, 0xe8,
-		0x01, 0xbe, 0x03, 0x48, 0x43, 0x27, 0xd2, 0x40, 0xdf, 0x00, 0x60, 0x0e, 0x60, 0x0e, 0xf0, 0x1d,
-		0x00, 0x1d, 0x00, 0x1d, 0xe0, 0x3b, 0x80, 0x42, 0x54, 0x51, 0xc8, 0x36, 0xf6, 0x0d, 0x90, 0x29,
-		0x82, 0x2f, 0xac, 0x6d, 0x15, 0xf0, 0x18, 0xcc, 0x98, 0x81, 0xf6, 0x00, 0x43, 0x8f, 0xb9, 0xf2,
-		0xdd, 0x01, 0x82, 0xd1, 0x68, 0x0e, 0xa0, 0x0f, 0xc7, 0xa1, 0x39, 0x00, 0x9a, 0x03, 0x20, 0x08,
-		0x42, 0x10, 0xb4, 0x1b, 0x41, 0x10, 0x88, 0x57, 0xc4, 0x40, 0x88, 0x81, 0x64, 0x62, 0x20, 0x10,
-		0xaf, 0x29, 0x86, 0x3c, 0x68, 0x0e, 0x00, 0xc4, 0x01, 0xc4, 0x01, 0xc4, 0x01, 0xc4, 0x01, 0xc4,
-		0x01, 0xc4, 0x01, 0xc4, 0x91, 0x51, 0xc4, 0x81, 0xe6, 0x00, 0xc0, 0x1c, 0xc0, 0x1c, 0x68, 0x0e,
-		0x30, 0x3f, 0x05, 0x9a, 0x03, 0x00, 0xc6, 0xec, 0x34, 0x8c, 0xc1, 0xe1, 0x71, 0xce, 0x71, 0x0d,
-		0x9a, 0x03, 0x00, 0xcd, 0x00, 0xcd, 0x80, 0x41, 0x01, 0xf4, 0x00, 0xf4, 0x00, 0x83, 0x02, 0xa4,
-		0xa1, 0x15, 0x69, 0xa0, 0x39, 0x00, 0x30, 0x07, 0x30, 0x07, 0x92, 0xe5, 0x01, 0x3a, 0x00, 0x3a,
-		0xc0, 0x77, 0x00, 0x85, 0xa4, 0x81, 0x42, 0xd0, 0x1c, 0x00, 0xd8, 0x03, 0xd8, 0x03, 0x7c, 0x07,
-		0xa0, 0x07, 0xa0, 0x07, 0xf8, 0x0e, 0x20, 0x0d, 0x9d, 0x48, 0x03, 0xcd, 0x01, 0x80, 0x39, 0x80,
-		0x39, 0xc0, 0x77, 0x00, 0x74, 0x00, 0x74, 0x80, 0xef, 0x00, 0x0a, 0x51, 0x45, 0x21, 0xdb, 0xd8,
-		0x1c, 0x40, 0xa2, 0x06, 0xbe, 0xb0, 0xb6, 0x37, 0xc0, 0xa7, 0xf1, 0x84, 0x19, 0x68, 0x0d, 0xf0,
-		0xb7, 0x69, 0xf9, 0xf2, 0xad, 0x01, 0x82, 0xd1, 0x68, 0x0d, 0xa0, 0x0f, 0xc5, 0xa1, 0x35, 0x00,
-		0x5a, 0x03, 0x20, 0x04, 0x42, 0x08, 0xb4, 0x1b, 0x21, 0x10, 0x68, 0x57, 0x44, 0x40, 0x88, 0x80,
-		0x64, 0x22, 0x20, 0xd0, 0xae, 0x29, 0x06, 0x3c, 0x68, 0x0d, 0x00, 0xc4, 0x01, 0xc4, 0x01, 0xc4,
-		0x01, 0xc4, 0x01, 0xc4, 0x01, 0xc4, 0x01, 0xc4, 0x91, 0x51, 0xc4, 0x81, 0xd6, 0x00, 0xc0, 0x1c,
-		0xc0, 0x1c, 0x68, 0x0d, 0x30, 0x3f, 0x05, 0x5a, 0x03, 0x00, 0xc6, 0xec, 0x34, 0x8c, 0xc1, 0xd1,
-		0x71, 0xce, 0x71, 0x0d, 0x5a, 0x03, 0x00, 0xcd, 0x00, 0xcd, 0x80, 0x41, 0x01, 0xf4, 0x00, 0xf4,
-		0x00, 0x83, 0x02, 0xa4, 0xa1, 0x15, 0x69, 0xa0, 0x35, 0x00, 0x30, 0x07, 0x30, 0x07, 0x52, 0xe5,
-		0x01, 0x3a, 0x00, 0x3a, 0xc0, 0x77, 0x00, 0x85, 0xa4, 0x81, 0x42, 0xd0, 0x1a, 0x00, 0xd8, 0x03,
-		0xd8, 0x03, 0x7c, 0x07, 0xa0, 0x07, 0xa0, 0x07, 0xf8, 0x0e, 0x20, 0x0d, 0x9d, 0x48, 0x03, 0xad,
-		0x01, 0x80, 0x39, 0x80, 0x39, 0xc0, 0x77, 0x00, 0x74, 0x00, 0x74, 0x80, 0xef, 0x00, 0x0a, 0x51,
-		0x45, 0x21, 0xdb, 0xd8, 0x1a, 0x40, 0xa2, 0x06, 0xbe, 0xb0, 0xb6, 0x35, 0xc0, 0x7f, 0xc6, 0x13,
-		0xea, 0x6a, 0x0d, 0xb0, 0x47, 0xb8, 0xf2, 0xb2, 0x2b, 0x2e, 0xb9, 0xd2, 0x7c, 0x6b, 0xb2, 0xf9,
-		0x0d, 0xd7, 0x7f, 0x62, 0xc3, 0xbb, 0x8f, 0x31, 0xd3, 0x24, 0x09, 0xba, 0xc3, 0x36, 0xc1, 0xa5,
-		0xe2, 0x95, 0xe5, 0xf9, 0x0d, 0xdf, 0xe7, 0xab, 0x46, 0x1f, 0xbb, 0xb4, 0x8b, 0x1e, 0x1b, 0xa3,
-		0x9d, 0xb1, 0x35, 0xb1, 0x87, 0xbd, 0xde, 0xe1, 0x1e, 0x8f, 0x11, 0x16, 0x1f, 0x74, 0xeb, 0x76,
-		0x98, 0xcb, 0x3a, 0x1f, 0x5e, 0xc3, 0x21, 0x4a, 0x0b, 0x22, 0x28, 0x04, 0xc2, 0x9b, 0xcf, 0xa1,
-		0x5b, 0xb1, 0xba, 0xb4, 0x5e, 0x64, 0xe2, 0x05, 0x61, 0xf5, 0xbf, 0xc4, 0xac, 0x04, 0xef, 0x0a,
-		0x08, 0xbd, 0xf9, 0xea, 0x27, 0x5f, 0x7e, 0xae, 0x15, 0xcf, 0x54, 0xec, 0xd8, 0x5e, 0xec, 0x83,
-		0x44, 0x98, 0x72, 0xfc, 0xa1, 0x98, 0xf7, 0x59, 0xdf, 0xa1, 0x63, 0x63, 0x38, 0xc5, 0x13, 0x2e,
-		0xf1, 0x77, 0xd6, 0xe0, 0x0d, 0x76, 0x84, 0x83, 0x19, 0xe1, 0x60, 0x45, 0xa8, 0xf3, 0x85, 0x98,
-		0x04, 0x6d, 0xea, 0x58, 0x51, 0x6c, 0x4f, 0xd7, 0x7c, 0xc3, 0x22, 0x4c, 0x97, 0x35, 0xfc, 0xfc,
-		0x26, 0x5b, 0xc6, 0xd5, 0x8a, 0x85, 0x3b, 0x7e, 0x16, 0x89, 0x93, 0xc5, 0x5b, 0xab, 0x88, 0x46,
-		0xbd, 0xd2, 0xd1, 0xad, 0x74, 0x14, 0x2b, 0xd5, 0x1a, 0x45, 0xcd, 0x1b, 0xf1, 0xb6, 0x3a, 0x29,
-		0x7a, 0xcc, 0x74, 0xdb, 0x5f, 0xf8, 0x17, 0x2f, 0x2a, 0x56, 0x98, 0x8c, 0xe3, 0x5c, 0x00, 0x31,
-		0x14, 0x2f, 0x4c, 0xcc, 0xc8, 0x10, 0x31, 0xf2, 0x3d, 0x7c, 0x64, 0x69, 0x16, 0x65, 0x5a, 0x45,
-		0x99, 0x46, 0x51, 0xea, 0xd1, 0x43, 0x0b, 0x11, 0x85, 0x49, 0x90, 0x99, 0x83, 0x72, 0xfa, 0xa6,
-		0x65, 0x1b, 0x81, 0x53, 0x17, 0xd8, 0xb4, 0xa9, 0x4d, 0x13, 0x60, 0x39, 0x8a, 0x57, 0xcc, 0x7e,
-		0x09, 0x9c, 0xb2, 0x18, 0xad, 0x21, 0x11, 0x63, 0xa8, 0xd0, 0x16, 0xaa, 0x34, 0x68, 0x14, 0x13,
-		0x4b, 0x8e, 0x27, 0x08, 0x78, 0x65, 0x68, 0x68, 0x15, 0x9a, 0x81, 0x6a, 0xc9, 0x2a, 0xb5, 0x93,
-		0x14, 0x17, 0x4d, 0x53, 0x2c, 0xd8, 0x14, 0xd0, 0x90, 0x3b, 0xd3, 0xf7, 0x99, 0x6b, 0x0b, 0xab,
-		0x48, 0x71, 0x7f, 0x7f, 0xff, 0xb3, 0x69, 0xfc, 0xd3, 0x30, 0xfe, 0x5b, 0x32, 0xce, 0x5a, 0xcd,
-		0xb9, 0xbf, 0x3c, 0x3d, 0x19, 0xad, 0xe6, 0xc1, 0x8f, 0xd2, 0x61, 0xbd, 0x3c, 0x3a, 0xf8, 0x65,
-		0xf6, 0xfb, 0xe6, 0xd3, 0xd3, 0xd1, 0xc1, 0xbf, 0x65, 0x46, 0xfd, 0x72, 0xf0, 0xf3, 0xe9, 0xe9,
-		0x88, 0xdf, 0xd2, 0x35, 0x49, 0x2d, 0x9d, 0x50, 0xb0, 0xa7, 0x14, 0xf4, 0x29, 0x05, 0x7f, 0x2b,
-		0x83, 0x40, 0xc9, 0xf6, 0x7f, 0x12, 0x5d, 0x11, 0x55, 0xce, 0x2e, 0xe6, 0x1d, 0xab, 0x33, 0x79,
-		0x7a, 0xe3, 0xf9, 0x55, 0x86, 0x7b, 0xa1, 0x38, 0xa7, 0x58, 0x70, 0xb2, 0x43, 0x9d, 0xed, 0x1c,
-		0x33, 0xc1, 0x7b, 0xe8, 0x0a, 0xf3, 0x3b, 0xb6, 0x77, 0x1c, 0x46, 0x2b, 0xb2, 0xc1, 0xfa, 0x9a,
-		0xe0, 0xf1, 0x8b, 0xe3, 0xf9, 0x06, 0xb3, 0x7d, 0xd7, 0x62, 0x1e, 0x7f, 0xf4, 0xb4, 0x30, 0x0a,
-		0x31, 0x14, 0x62, 0xa8, 0x37, 0xc2, 0xf4, 0x2a, 0x1e, 0x47, 0xcd, 0x8d, 0x15, 0x8b, 0xa5, 0xca,
-		0x88, 0xa5, 0x10, 0x4b, 0x89, 0x09, 0xaa, 0x28, 0x6b, 0xa4, 0xc6, 0x22, 0x29, 0x0a, 0xae, 0xb4,
-		0x00, 0xab, 0x08, 0xb2, 0xba, 0x40, 0x53, 0xe0, 0x99, 0x02, 0x1a, 0xfc, 0x4a, 0x85, 0x85, 0x0a,
-		0x0d, 0x7e, 0x7b, 0x96, 0xe9, 0x11, 0xb4, 0xf8, 0x0d, 0xa6, 0x41, 0x32, 0x93, 0xbc, 0xda, 0x50,
-		0xa9, 0x0f, 0xb9, 0x1a, 0x91, 0xab, 0x13, 0xa9, 0x5a, 0xc9, 0xa9, 0x97, 0x02, 0xfb, 0x52, 0xa0,
-		0x6e, 0x7d, 0xe3, 0x5a, 0xf6, 0x0b, 0x41, 0x0e, 0x53, 0xf9, 0x5d, 0xa2, 0x2b, 0x20, 0x15, 0xdb,
-		0x93, 0xc6, 0xfa, 0xa4, 0xb1, 0xff, 0x5a, 0x2e, 0x60, 0xe3, 0x81, 0x30, 0x9d, 0x50, 0xca, 0xd0,
-		0x81, 0x63, 0x20, 0x1d, 0x1e, 0xf4, 0x2a, 0x5a, 0xf1, 0x68, 0x26, 0x18, 0x72, 0x18, 0x72, 0x18,
-		0xf2, 0x7c, 0x19, 0xf2, 0x64, 0x7a, 0x26, 0x0e, 0xbe, 0x55, 0x0d, 0xb3, 0xd3, 0x71, 0x99, 0x47,
-		0x80, 0x1a, 0x17, 0x66, 0x83, 0xcd, 0x81, 0xcd, 0x81, 0xcd, 0x49, 0x5a, 0x7f, 0x0a, 0x92, 0x27,
-		0xc4, 0xcb, 0x7a, 0x20, 0x79, 0x1e, 0xb6, 0x34, 0xd1, 0xff, 0xf6, 0xf7, 0x3f, 0x97, 0x8c, 0xb3,
-		0xe6, 0xcf, 0xcf, 0x65, 0xe3, 0xac, 0x39, 0xf9, 0xb1, 0x1c, 0xfc, 0x67, 0xf2, 0x73, 0xe5, 0x73,
-		0xc9, 0xa8, 0x4e, 0x7f, 0xae, 0x7d, 0x2e, 0x19, 0xb5, 0xe6, 0xc1, 0xd3, 0xd3, 0xd1, 0xc1, 0x8f,
-		0x93, 0x91, 0xf8, 0xc0, 0x7f, 0x15, 0x93, 0x4e, 0x11, 0x06, 0xde, 0xce, 0x3b, 0xde, 0xb6, 0x06,
-		0xdf, 0xea, 0xa4, 0x3e, 0xb0, 0x0e, 0x1f, 0x08, 0x1f, 0x08, 0x1f, 0x98, 0x9a, 0xfe, 0x64, 0xd6,
-		0x07, 0x9a, 0x46, 0xb7, 0x61, 0xfc, 0xda, 0xfc, 0x51, 0x3e, 0xac, 0x8e, 0xde, 0x1f, 0xfc, 0x38,
-		0x1d, 0xbd, 0xfd, 0xe5, 0xcf, 0x55, 0x1f, 0x2b, 0x1f, 0x9e, 0x8e, 0xde, 0xc7, 0xfc, 0x4b, 0x7d,
-		0xf4, 0x9e, 0x73, 0x8e, 0xda, 0x68, 0x7f, 0xe9, 0xa3, 0xe3, 0xdf, 0x57, 0xe2, 0x06, 0x54, 0x63,
-		0x06, 0x9c, 0xc4, 0x0d, 0x38, 0x89, 0x19, 0x10, 0xfb, 0x48, 0x95, 0x98, 0x01, 0xb5, 0xd1, 0xcf,
-		0xa5, 0xcf, 0xef, 0xaf, 0xfe, 0x68, 0x7d, 0x74, 0xf0, 0x33, 0xee, 0xdf, 0x4e, 0x47, 0x3f, 0xdf,
-		0x1f, 0x00, 0x11, 0xec, 0x3a, 0x22, 0xd8, 0x8a, 0x8a, 0xac, 0x8e, 0xed, 0x1d, 0xcf, 0xe7, 0x6c,
-		0xcc, 0xfe, 0xf2, 0xca, 0x95, 0x5b, 0x22, 0xbf, 0x2a, 0x22, 0x57, 0xad, 0x4a, 0x33, 0x97, 0xaa,
-		0x8c, 0xa5, 0x24, 0x62, 0xc2, 0x89, 0x2c, 0x4e, 0x64, 0xb5, 0x23, 0x9c, 0x68, 0xbf, 0x7b, 0xcc,
-		0xec, 0xba, 0xac, 0x2b, 0xb3, 0xe1, 0x53, 0x30, 0x73, 0x2a, 0x31, 0xf6, 0x2e, 0x34, 0x2e, 0x47,
-		0x47, 0xa1, 0xa5, 0x38, 0x8e, 0x94, 0x2c, 0x03, 0x26, 0x63, 0x52, 0xf7, 0x28, 0x6d, 0x2f, 0x26,
-		0xc3, 0x13, 0x4e, 0xdf, 0xa8, 0xc0, 0x58, 0xc0, 0x58, 0xac, 0x7d, 0x42, 0xa4, 0x6f, 0x80, 0x7d,
-		0x00, 0xfb, 0x90, 0x43, 0xf6, 0x01, 0xe9, 0x1b, 0x08, 0x1e, 0x15, 0xe9, 0x64, 0xa4, 0x6f, 0xc0,
-		0x90, 0xc3, 0x90, 0x23, 0x7d, 0x23, 0x09, 0x5b, 0x83, 0xf4, 0x0d, 0xd8, 0x1c, 0xd8, 0x9c, 0xac,
-		0xd8, 0x1c, 0xa4, 0x6f, 0x20, 0x7d, 0x03, 0x78, 0x3b, 0x05, 0x1f, 0x88, 0xf4, 0x0d, 0xf8, 0x40,
-		0xf8, 0x40, 0xa4, 0x6f, 0x68, 0xf6, 0x81, 0x48, 0xdf, 0x40, 0xfa, 0x06, 0x10, 0x01, 0xd2, 0x37,
-		0x66, 0xe3, 0x29, 0xd2, 0x37, 0x04, 0x3a, 0xbf, 0x8a, 0x2f, 0x0a, 0x6d, 0x79, 0x7e, 0xd8, 0x19,
-		0x56, 0x90, 0x97, 0xcc, 0x75, 0xdf, 0x20, 0x6e, 0x51, 0xcf, 0x4a, 0x47, 0xe1, 0x35, 0xa2, 0x56,
-		0x14, 0x3a, 0x97, 0x5f, 0xd1, 0x6f, 0xf6, 0xa3, 0xed, 0xb5, 0x7e, 0x77, 0x3c, 0xff, 0x22, 0x98,
-		0x6d, 0xeb, 0x9b, 0xf6, 0x2c, 0x34, 0xc9, 0xd1, 0xd0, 0xba, 0xc7, 0x63, 0xee, 0x37, 0xe6, 0x0a,
-		0x74, 0xed, 0x99, 0x0e, 0x40, 0xc3, 0x1e, 0x34, 0xec, 0x99, 0x17, 0x21, 0x99, 0xa6, 0xa7, 0xc1,
-		0x38, 0x34, 0xea, 0x49, 0x30, 0x00, 0xdd, 0xe9, 0x46, 0x3d, 0xb2, 0x04, 0xcd, 0x2c, 0xa5, 0x45,
-		0x2a, 0xa6, 0x44, 0x62, 0x68, 0x2a, 0x1c, 0x0b, 0x12, 0x43, 0x45, 0xf6, 0x3b, 0x33, 0x89, 0xa1,
-		0x53, 0x1d, 0xcb, 0x40, 0x5e, 0x28, 0x1a, 0x7b, 0xc1, 0x5a, 0x6c, 0xa3, 0xb5, 0x90, 0xcf, 0x0c,
-		0xa5, 0x3a, 0xe1, 0xc0, 0xe1, 0x06, 0x0e, 0x37, 0x52, 0x52, 0x2d, 0x79, 0x1a, 0xb0, 0x90, 0x91,
-		0xc3, 0x0d, 0xca, 0xa3, 0x8d, 0x33, 0x85, 0x39, 0xc2, 0x77, 0x4a, 0xfd, 0x92, 0x42, 0xe2, 0xd4,
-		0x87, 0xa5, 0x35, 0x7a, 0x47, 0x30, 0x17, 0xd5, 0x31, 0x50, 0x34, 0x61, 0x5e, 0x52, 0x22, 0xa6,
-		0x7f, 0x9a, 0x6a, 0x97, 0xee, 0x1d, 0x66, 0x48, 0xc8, 0xea, 0xbb, 0x26, 0x64, 0x38, 0x73, 0xcc,
-		0xd5, 0x99, 0x23, 0x91, 0xca, 0x6d, 0xe5, 0x6d, 0xdb, 0x03, 0xc7, 0xf5, 0xd5, 0xe1, 0x6b, 0x30,
-		0x8b, 0x24, 0x12, 0xf8, 0xc8, 0xba, 0xe6, 0xb0, 0x17, 0x40, 0x98, 0xda, 0x09, 0x00, 0x30, 0x00,
-		0x30, 0x00, 0xb0, 0xb8, 0xf2, 0x19, 0xf6, 0xb0, 0xff, 0x2c, 0x7c, 0x2b, 0xca, 0x2a, 0x15, 0xaa,
-		0xe3, 0x9a, 0x6e, 0x52, 0xdb, 0xb2, 0x34, 0x1d, 0xae, 0xe9, 0xce, 0xcc, 0x16, 0xd4, 0x6b, 0xb5,
-		0x13, 0xdc, 0xcb, 0x9d, 0x1e, 0x5e, 0xd9, 0x9a, 0x34, 0xa2, 0x30, 0x07, 0x20, 0xfc, 0x6f, 0x86,
-		0x9a, 0xbf, 0xa0, 0x93, 0x83, 0x66, 0xcc, 0x05, 0xbe, 0x3e, 0x05, 0xd5, 0x06, 0x5f, 0x8f, 0x70,
-		0x05, 0xe1, 0x0a, 0xf8, 0x7a, 0xf0, 0xf5, 0xb9, 0xa7, 0x52, 0xc1, 0xd7, 0x27, 0x2f, 0x64, 0xe0,
-		0xeb, 0xc1, 0xd7, 0x83, 0xaf, 0xcf, 0x40, 0xfc, 0x0b, 0xbe, 0x1e, 0x00, 0x18, 0x00, 0x78, 0xd7,
-		0x00, 0x30, 0xf8, 0xfa, 0x65, 0xb2, 0x18, 0x7c, 0x7d, 0x8a, 0xe8, 0xb2, 0x00, 0xbe, 0x1e, 0x7c,
-		0x7d, 0xea, 0xa4, 0x5e, 0x8a, 0x7c, 0x7d, 0xfe, 0xaa, 0x7d, 0xc5, 0x02, 0xb8, 0x5c, 0x17, 0xfb,
-		0x4a, 0x1e, 0x5d, 0x0c, 0x3d, 0x61, 0xf7, 0xaa, 0x82, 0xc3, 0xe6, 0xb1, 0x97, 0x33, 0x79, 0x7a,
-		0xe3, 0xf9, 0x55, 0xe6, 0x40, 0x81, 0x02, 0x73, 0x2d, 0xe0, 0xac, 0x60, 0x25, 0x72, 0x21, 0xdc,
-		0x49, 0x94, 0x61, 0x2f, 0xaa, 0x3e, 0x49, 0xf5, 0xf5, 0xc3, 0x64, 0xaa, 0xad, 0x2f, 0xbd, 0x9e,
-		0x56, 0x3a, 0xeb, 0xa8, 0xba, 0xe6, 0x3a, 0xa7, 0x14, 0x3a, 0x97, 0x14, 0xae, 0xb8, 0xae, 0xa0,
-		0xe2, 0x9a, 0x3c, 0x76, 0x4b, 0xac, 0xe2, 0xda, 0x74, 0xdb, 0x5f, 0x64, 0x2a, 0xae, 0x83, 0x71,
-		0x62, 0x15, 0xd7, 0x25, 0x54, 0x5c, 0xa3, 0xe2, 0x5a, 0x92, 0x14, 0x88, 0xf6, 0xab, 0xe3, 0xf4,
-		0x4d, 0xcb, 0x36, 0x04, 0x3b, 0x49, 0xcb, 0xb0, 0xed, 0xc5, 0x2b, 0x66, 0xbf, 0x04, 0xe6, 0x5d,
-		0x2c, 0xcc, 0x97, 0xc0, 0x40, 0x2a, 0x61, 0xbc, 0x2a, 0x25, 0x38, 0x8d, 0x11, 0xcb, 0x92, 0xe3,
-		0x09, 0xe2, 0x41, 0x19, 0x3e, 0x57, 0x25, 0xec, 0xa6, 0x5a, 0xb2, 0x4a, 0xed, 0x24, 0xc5, 0x45,
-		0xd3, 0x84, 0x4c, 0x9b, 0x02, 0x1a, 0x22, 0x7b, 0xee, 0x54, 0xdc, 0xdf, 0xdf, 0xff, 0x6c, 0x1a,
-		0xff, 0x34, 0x8c, 0xff, 0x96, 0x8c, 0xb3, 0x56, 0x73, 0xee, 0x2f, 0x4f, 0x4f, 0x46, 0xab, 0x79,
-		0xf0, 0xa3, 0x74, 0x58, 0x2f, 0x8f, 0x0e, 0x7e, 0x99, 0xfd, 0xbe, 0xf9, 0xf4, 0x74, 0x74, 0xf0,
-		0x6f, 0x99, 0x51, 0xbf, 0x1c, 0xfc, 0x7c, 0x7a, 0x3a, 0xe2, 0xb7, 0x74, 0x4d, 0x52, 0x4b, 0x87,
-		0x08, 0x12, 0x11, 0x64, 0x9a, 0x11, 0x64, 0x9e, 0xc3, 0xa6, 0xcd, 0xec, 0xd2, 0x9a, 0xa0, 0x69,
-		0x4f, 0xe0, 0x75, 0x78, 0x5f, 0x43, 0xe4, 0xf1, 0x8b, 0x6b, 0xa3, 0xb6, 0xd5, 0x11, 0xf1, 0xea,
-		0x97, 0x5d, 0x7e, 0x95, 0x15, 0xaf, 0x51, 0x7c, 0x71, 0x07, 0x6d, 0x63, 0x43, 0x07, 0xa5, 0x48,
-		0x45, 0xe7, 0x3f, 0x1c, 0xb3, 0x24, 0xeb, 0xa3, 0xc0, 0x8d, 0x20, 0x9d, 0x07, 0x94, 0xbf, 0x01,
-		0xe1, 0x46, 0xff, 0xa5, 0xbf, 0xee, 0x74, 0x95, 0xd7, 0x48, 0x08, 0x23, 0x6d, 0x61, 0xc5, 0x5f,
-		0x81, 0xa4, 0x27, 0x0f, 0x4f, 0x24, 0x8e, 0x9b, 0xe2, 0x37, 0xde, 0x86, 0x22, 0x62, 0x0d, 0x44,
-		0x32, 0xd4, 0x6a, 0x6d, 0x93, 0x28, 0xe4, 0x3a, 0xfa, 0xdf, 0x20, 0x2a, 0x09, 0x53, 0x00, 0x6d,
-		0xe6, 0xfa, 0x56, 0xd7, 0x6a, 0x9b, 0x3e, 0x33, 0xac, 0x8e, 0x38, 0x15, 0xf0, 0x66, 0xfc, 0xd6,
-		0x50, 0x02, 0xbc, 0x22, 0xb8, 0x95, 0xbc, 0x00, 0xa7, 0x88, 0x66, 0x8d, 0x1c, 0x10, 0xbe, 0x27,
-		0x47, 0xf0, 0x5e, 0x1c, 0x0e, 0x34, 0xc5, 0x61, 0xb3, 0x98, 0x6d, 0x3e, 0xf7, 0x98, 0xb8, 0xa6,
-		0x85, 0xe3, 0x78, 0x9b, 0xda, 0xcd, 0xd2, 0x8d, 0xc6, 0x22, 0x01, 0xc5, 0x84, 0x62, 0xa6, 0xa7,
-		0x98, 0xcf, 0x8e, 0xd3, 0x63, 0xa6, 0x2d, 0xa3, 0x99, 0xe5, 0x04, 0x35, 0xb3, 0x67, 0x79, 0x3e,
-		0xb3, 0xa7, 0x59, 0xbe, 0xcc, 0x13, 0xd7, 0xd1, 0xa5, 0x19, 0xa0, 0x76, 0x50, 0xbb, 0xd4, 0xd4,
-		0x6e, 0x68, 0x8f, 0x23, 0x5a, 0x09, 0xa5, 0x13, 0xa8, 0x0e, 0x91, 0xab, 0x06, 0x51, 0x28, 0x39,
-		0x54, 0xaa, 0x83, 0x51, 0xa9, 0x7f, 0x51, 0xab, 0x7b, 0xc1, 0x95, 0x5c, 0xab, 0x27, 0xc2, 0x95,
-		0x5c, 0xda, 0x84, 0x05, 0x77, 0xd7, 0xa0, 0x2e, 0x25, 0xc7, 0x77, 0xd7, 0xec, 0xe9, 0xfd, 0x1e,
-		0x41, 0xd5, 0x54, 0xf0, 0x58, 0xcc, 0x1e, 0xf6, 0x99, 0x3b, 0xa1, 0x97, 0x15, 0x5c, 0x56, 0x55,
-		0x62, 0xec, 0x85, 0x3d, 0xec, 0x8f, 0xb1, 0x83, 0xb6, 0xd3, 0x42, 0x1c, 0x96, 0x09, 0xdf, 0xad,
-		0x42, 0x12, 0xaa, 0x08, 0xd5, 0x33, 0xc9, 0xd4, 0x2f, 0x21, 0x24, 0x41, 0x48, 0xa2, 0x21, 0x24,
-		0x91, 0x2b, 0xe2, 0x91, 0x28, 0xda, 0x91, 0x2c, 0xd2, 0xc9, 0x69, 0xf6, 0x4e, 0x09, 0xd9, 0x3b,
-		0xa2, 0x4b, 0xa6, 0x50, 0x14, 0x93, 0xe9, 0xfc, 0x9d, 0x04, 0x9d, 0x90, 0xef, 0x9a, 0xb6, 0x17,
-		0x68, 0xb4, 0xc7, 0xda, 0x43, 0xd7, 0xf2, 0x5f, 0xc5, 0x5d, 0xd2, 0x8a, 0x39, 0xe0, 0xa0, 0xe0,
-		0xa0, 0x76, 0x9a, 0xaa, 0xce, 0x64, 0x4a, 0xce, 0x5c, 0xce, 0x08, 0x57, 0xa3, 0x36, 0x14, 0x34,
-		0x20, 0xaf, 0x81, 0x5e, 0xba, 0x91, 0xd7, 0x00, 0x9f, 0xb4, 0x73, 0x3e, 0x09, 0x79, 0x0d, 0x73,
-		0x06, 0x00, 0x79, 0x0d, 0x50, 0x4c, 0x80, 0x45, 0x21, 0xcd, 0x44, 0x5e, 0x03, 0xd4, 0x0e, 0x79,
-		0x0d, 0xc8, 0x6b, 0xa0, 0x7c, 0x53, 0xf9, 0x37, 0x5e, 0xf1, 0xe6, 0xc8, 0x6b, 0x40, 0x5e, 0x03,
-		0xbf, 0xb0, 0x20, 0xaf, 0x01, 0x79, 0x0d, 0xc8, 0x6b, 0x20, 0x52, 0x4d, 0xe4, 0x35, 0xc8, 0x2f,
-		0x39, 0xf2, 0x1a, 0xc8, 0x43, 0x15, 0xe4, 0x35, 0x20, 0x24, 0x41, 0x5e, 0xc3, 0xba, 0x21, 0xc8,
-		0x6b, 0xe0, 0x18, 0x8f, 0xbc, 0x86, 0xd4, 0x96, 0x0d, 0x79, 0x0d, 0xc8, 0x6b, 0x80, 0x83, 0x02,
-		0x55, 0x2d, 0xfa, 0x89, 0x2c, 0xe4, 0x35, 0xe4, 0xb8, 0xe5, 0xc8, 0xe6, 0x96, 0x1e, 0x85, 0xd8,
-		0xd6, 0x23, 0xbf, 0xb9, 0x83, 0xf6, 0xba, 0x66, 0x9c, 0x7c, 0x1d, 0x48, 0x7a, 0xce, 0xcb, 0x8b,
-		0x65, 0xbf, 0x6c, 0xee, 0x3e, 0x32, 0xfd, 0x60, 0x36, 0x3a, 0x8f, 0xf4, 0x9c, 0x97, 0x5c, 0x36,
-		0x1d, 0x19, 0x3f, 0x77, 0x82, 0xfd, 0x46, 0x3c, 0xa7, 0xc7, 0x84, 0x1a, 0x8e, 0x04, 0x03, 0xf2,
-		0xd1, 0x71, 0x64, 0xbd, 0x08, 0xc8, 0x7a, 0xb1, 0xf4, 0x93, 0x72, 0xd6, 0x8a, 0x08, 0x8d, 0x55,
-		0xe6, 0xcf, 0xc7, 0xe1, 0x6b, 0x59, 0xb3, 0x4a, 0x92, 0x36, 0xb7, 0xae, 0x11, 0x14, 0xa8, 0xd4,
-		0xb0, 0x13, 0x9f, 0xa0, 0x6d, 0x1f, 0x6c, 0xe2, 0x12, 0x44, 0x3d, 0x88, 0x49, 0x77, 0x6f, 0xec,
-		0xd0, 0x99, 0x1d, 0x87, 0x16, 0x4f, 0xe8, 0x0e, 0x5b, 0x9a, 0x68, 0xc5, 0x63, 0x3d, 0xd6, 0xf6,
-		0x1d, 0xd7, 0x93, 0xe9, 0xe3, 0x3b, 0x1d, 0x0a, 0xfd, 0x82, 0x7e, 0x49, 0xe9, 0x97, 0xe8, 0x9d,
-		0xb2, 0x91, 0xcc, 0x29, 0xdc, 0xae, 0x3c, 0x9d, 0x21, 0xe1, 0x0b, 0x96, 0x4b, 0xe9, 0x5c, 0xb0,
-		0x2c, 0x26, 0xd4, 0xaa, 0xc2, 0x4d, 0x26, 0xe4, 0x64, 0xc2, 0x4e, 0x22, 0xf4, 0x92, 0xf4, 0x54,
-		0x52, 0x17, 0x2c, 0x0b, 0xa2, 0x23, 0x1a, 0xb4, 0x44, 0xa4, 0x20, 0xca, 0x8a, 0x42, 0xa1, 0x30,
-		0x74, 0x8a, 0x43, 0xa5, 0x40, 0xe4, 0x8a, 0x44, 0xae, 0x50, 0xa4, 0x8a, 0x25, 0xa7, 0x60, 0x0a,
-		0x64, 0xbb, 0x92, 0xc2, 0x45, 0x13, 0x74, 0xcd, 0xb6, 0xd5, 0x13, 0x21, 0x78, 0x37, 0x0a, 0x5e,
-		0x34, 0xa3, 0xe2, 0xa6, 0xd0, 0xdc, 0x05, 0xa6, 0xac, 0x94, 0x94, 0xca, 0x49, 0xaf, 0xa4, 0xd4,
-		0xca, 0xaa, 0x4d, 0x69, 0xb5, 0x29, 0xaf, 0x16, 0x25, 0x56, 0x53, 0x66, 0x45, 0xa5, 0x96, 0x27,
-		0xbb, 0x37, 0xca, 0x9b, 0xd5, 0x61, 0xb6, 0x6f, 0xf9, 0xaf, 0x2e, 0xeb, 0x52, 0xde, 0xe1, 0x4c,
-		0x70, 0xb7, 0x5e, 0xf1, 0x32, 0x7c, 0xb4, 0x0f, 0xa6, 0x47, 0x28, 0xc6, 0xd3, 0x17, 0x7f, 0xf8,
-		0xeb, 0xe1, 0xea, 0xf6, 0xb7, 0xd6, 0xaf, 0x8d, 0xf3, 0xcb, 0xab, 0xcb, 0xc7, 0xbf, 0x8a, 0x94,
-		0x17, 0x0c, 0x7a, 0x64, 0xf7, 0x4e, 0x17, 0x48, 0x6e, 0xc5, 0x5c, 0xb9, 0x00, 0x9f, 0x1e, 0x2e,
-		0xee, 0x8b, 0x64, 0x53, 0x8f, 0x0e, 0xb3, 0xfe, 0xbe, 0x8d, 0x4f, 0x1f, 0x2f, 0x1f, 0x77, 0xe9,
-		0x85, 0xcf, 0x6f, 0x6f, 0x1e, 0x6e, 0xaf, 0x2e, 0x76, 0xe9, 0x95, 0xaf, 0x6e, 0xcf, 0x1b, 0x57,
-		0xd5, 0x5d, 0x7a, 0xe3, 0x89, 0x19, 0xdb, 0xb9, 0x3d, 0x2e, 0xed, 0x94, 0xe5, 0xba, 0xba, 0xda,
-		0xa5, 0xd7, 0xbd, 0x6e, 0x5c, 0xee, 0xd4, 0xfb, 0xfe, 0x71, 0x71, 0x7f, 0x73, 0x71, 0xb5, 0x73,
-		0x2a, 0x5c, 0xde, 0xb9, 0x37, 0xae, 0xef, 0xdc, 0x1b, 0x9f, 0xee, 0xd2, 0x1b, 0xdf, 0x3c, 0xde,
-		0xed, 0xdc, 0x06, 0x57, 0x76, 0x2b, 0x82, 0x78, 0xfc, 0xfd, 0xee, 0xfe, 0xf2, 0xcf, 0x9d, 0xdb,
-		0xe5, 0xda, 0xce, 0xbd, 0xf1, 0xc9, 0x8e, 0xc5, 0x10, 0x8f, 0x17, 0xd7, 0xad, 0x8f, 0x8d, 0x8b,
-		0xeb, 0xdb, 0x9b, 0x5d, 0x53, 0x68, 0xc2, 0xf7, 0x25, 0x99, 0xa9, 0x99, 0x36, 0x45, 0xb9, 0x97,
-		0xc2, 0x7e, 0x17, 0x3d, 0xf6, 0x8d, 0xb9, 0xa4, 0x87, 0x0e, 0xd1, 0x8c, 0x38, 0x74, 0xd8, 0xb8,
-		0x56, 0x38, 0x74, 0xc0, 0xa1, 0x43, 0xfc, 0x1b, 0xd1, 0x1f, 0x3a, 0x78, 0xaf, 0x5e, 0xcf, 0x79,
-		0x31, 0x88, 0x54, 0xb4, 0xa0, 0x58, 0x3a, 0xbb, 0x34, 0x97, 0x5c, 0x29, 0x2d, 0xa1, 0x21, 0x4d,
-		0xf4, 0xc0, 0x58, 0x32, 0x1d, 0x70, 0xd9, 0x84, 0x4b, 0xa6, 0x07, 0x46, 0x19, 0x77, 0xd1, 0x4f,
-		0x42, 0x19, 0x83, 0xea, 0x8b, 0x27, 0x53, 0xd6, 0xa6, 0x7c, 0x48, 0x4e, 0x75, 0x38, 0xae, 0x5a,
-		0x23, 0x87, 0x0c, 0x95, 0x44, 0xfc, 0x0e, 0x32, 0x54, 0x08, 0xfd, 0xc9, 0xac, 0x6c, 0x86, 0x99,
-		0x5d, 0xb5, 0x83, 0xeb, 0xc8, 0x6f, 0x9c, 0xaa, 0x35, 0x3d, 0x09, 0x6c, 0xde, 0xd1, 0x51, 0x68,
-		0xb9, 0x8e, 0x23, 0xa5, 0xce, 0xb0, 0x09, 0x53, 0x86, 0xdc, 0x54, 0x50, 0x1b, 0x26, 0x0c, 0x26,
-		0x0c, 0x26, 0x2c, 0x6b, 0x26, 0x2c, 0x52, 0xea, 0x2c, 0x9b, 0x30, 0xae, 0xd6, 0xd8, 0x9b, 0xed,
-		0x17, 0x47, 0xcb, 0xec, 0x8d, 0xab, 0xaf, 0x6a, 0xbc, 0x2a, 0x30, 0x5e, 0x30, 0x5e, 0x89, 0x18,
-		0x2f, 0x64, 0x08, 0x83, 0xac, 0x03, 0x59, 0x07, 0xb2, 0x8e, 0x53, 0xde, 0x90, 0x21, 0x8c, 0x0c,
-		0x61, 0x8a, 0x3f, 0xc8, 0x10, 0xce, 0xdc, 0x0b, 0x23, 0x43, 0x78, 0x07, 0xde, 0x18, 0x19, 0xc2,
-		0x3b, 0x60, 0xb9, 0x90, 0x21, 0xbc, 0xcd, 0xef, 0x8b, 0x0c, 0xe1, 0x1d, 0x79, 0x63, 0x64, 0x08,
-		0x6f, 0xf5, 0x1b, 0x23, 0x43, 0x78, 0xeb, 0x23, 0x08, 0x64, 0x08, 0xef, 0xc8, 0x1b, 0x23, 0x43,
-		0x78, 0x57, 0x14, 0x1a, 0x19, 0xc2, 0x54, 0xa3, 0x91, 0x21, 0xbc, 0x66, 0x1a, 0x1c, 0x3a, 0xc8,
-		0xad, 0x3e, 0x0e, 0x1d, 0x90, 0x21, 0x2c, 0xa0, 0xa6, 0xc8, 0x10, 0x16, 0x17, 0xd8, 0xec, 0x65,
-		0x08, 0x73, 0xb4, 0x25, 0xa7, 0x5b, 0x3b, 0xbd, 0x1d, 0xf2, 0xfe, 0x60, 0xaf, 0xf3, 0x47, 0xe0,
-		0x05, 0x49, 0x99, 0x97, 0xbb, 0x52, 0x29, 0x1a, 0xad, 0x72, 0xb5, 0xd2, 0x6c, 0x12, 0x85, 0x2b,
-		0x96, 0xa2, 0x49, 0xa4, 0xae, 0x5a, 0x92, 0x5d, 0x7c, 0x45, 0xd1, 0x26, 0x14, 0xe9, 0xa2, 0x54,
-		0xfe, 0xd3, 0x8a, 0xd6, 0xf5, 0x57, 0x93, 0xaf, 0x6a, 0x9d, 0x4f, 0xbe, 0xaa, 0xf5, 0x30, 0xfd,
-		0x02, 0x4d, 0xd7, 0x90, 0xe4, 0xbb, 0x39, 0xf1, 0xac, 0xdf, 0x6f, 0x92, 0xfd, 0x89, 0x85, 0xf2,
-		0xd6, 0xa4, 0xf2, 0xd4, 0xa4, 0xfb, 0x12, 0x57, 0xd0, 0x97, 0x98, 0x12, 0x05, 0xee, 0x70, 0xdf,
-		0x6f, 0x01, 0x1f, 0x9d, 0xd7, 0x9b, 0x52, 0xde, 0xbc, 0x72, 0x91, 0x4b, 0xf7, 0x37, 0xdb, 0x6c,
-		0xe9, 0xfb, 0x56, 0xd6, 0xdc, 0xda, 0xe1, 0xb2, 0xbe, 0xe3, 0xb3, 0xf0, 0x3e, 0x14, 0x8f, 0xff,
-		0xde, 0x8a, 0x37, 0xe3, 0x70, 0x7d, 0x05, 0xae, 0xaf, 0x58, 0x21, 0x50, 0xe2, 0xce, 0x6c, 0x71,
-		0x38, 0x9a, 0xed, 0xc3, 0xa9, 0x49, 0x39, 0x35, 0xe1, 0x66, 0xfb, 0x92, 0x7d, 0xc5, 0xd5, 0xfa,
-		0x89, 0xa3, 0xd1, 0x7e, 0x2a, 0x9c, 0x1e, 0x1a, 0xed, 0x73, 0x0c, 0xfc, 0xe2, 0x78, 0xbe, 0x7a,
-		0x0d, 0x4d, 0x30, 0x0b, 0xea, 0xff, 0x50, 0x42, 0x93, 0x12, 0x11, 0x9e, 0xd7, 0xfa, 0x3f, 0x05,
-		0xbd, 0x59, 0x70, 0x2c, 0x67, 0x0a, 0x73, 0x84, 0x6f, 0xa3, 0x96, 0x8f, 0x4e, 0x59, 0x71, 0x30,
-		0x30, 0xcc, 0x4e, 0xc7, 0x65, 0x9e, 0x47, 0xc9, 0xfb, 0x9f, 0x11, 0xcc, 0x45, 0xb2, 0x52, 0x74,
-		0x2b, 0xb6, 0x62, 0xe5, 0xbe, 0x55, 0x09, 0xd7, 0x6e, 0x69, 0x0d, 0xdf, 0x11, 0xce, 0x79, 0x67,
-		0xfa, 0x3e, 0x73, 0x6d, 0xd2, 0x42, 0x88, 0x60, 0xe2, 0xff, 0xed, 0xef, 0x7f, 0x2e, 0x19, 0x67,
-		0xcd, 0x9f, 0x9f, 0xcb, 0xc6, 0x59, 0x73, 0xf2, 0x63, 0x39, 0xf8, 0xcf, 0xe4, 0xe7, 0xca, 0xe7,
-		0x92, 0x51, 0x9d, 0xfe, 0x5c, 0xfb, 0x5c, 0x32, 0x6a, 0xcd, 0x83, 0xa7, 0xa7, 0xa3, 0x83, 0x1f,
-		0x27, 0x23, 0xf1, 0x81, 0xff, 0xa2, 0xcb, 0x0e, 0x68, 0x92, 0xcc, 0x44, 0x94, 0x53, 0xa1, 0x47,
-		0x38, 0xeb, 0x10, 0xce, 0x89, 0x70, 0x9a, 0x46, 0xb7, 0x61, 0xfc, 0xda, 0xfc, 0x51, 0x3e, 0xac,
-		0x8e, 0xde, 0x1f, 0xfc, 0x38, 0x1d, 0xbd, 0xfd, 0xe5, 0xcf, 0x55, 0x1f, 0x2b, 0x1f, 0x9e, 0x8e,
-		0xde, 0xc7, 0xfc, 0x4b, 0x7d, 0xf4, 0x9e, 0x73, 0x8e, 0xda, 0x68, 0x7f, 0xe9, 0xa3, 0xe3, 0xdf,
-		0x57, 0xe2, 0x06, 0x54, 0x63, 0x06, 0x9c, 0xc4, 0x0d, 0x38, 0x89, 0x19, 0x10, 0xfb, 0x48, 0x95,
-		0x98, 0x01, 0xb5, 0xd1, 0xcf, 0xa5, 0xcf, 0xef, 0xaf, 0xfe, 0x68, 0x7d, 0x74, 0xf0, 0x33, 0xee,
-		0xdf, 0x4e, 0x47, 0x3f, 0xdf, 0x1f, 0x64, 0x50, 0x55, 0xf7, 0xd2, 0x7d, 0x0e, 0x45, 0x53, 0x41,
-		0xe8, 0xf1, 0x3b, 0x4e, 0xdf, 0xb4, 0x6c, 0x23, 0x60, 0x45, 0x09, 0x5d, 0x3e, 0x81, 0x45, 0x28,
-		0x5e, 0x31, 0xfb, 0x25, 0xa0, 0x81, 0x33, 0xe7, 0xf4, 0xaf, 0x2d, 0x9b, 0x2c, 0x8d, 0x86, 0x28,
-		0x16, 0x8b, 0x9d, 0x36, 0x28, 0x7a, 0x94, 0x6f, 0x93, 0x10, 0x3b, 0xef, 0xaf, 0xae, 0xd9, 0xf6,
-		0x2d, 0xc7, 0xfe, 0x68, 0xbd, 0x58, 0xc1, 0xa1, 0x73, 0x29, 0x8b, 0xc9, 0x89, 0xc5, 0x6b, 0xf3,
-		0x7b, 0xee, 0xb6, 0xaa, 0x52, 0x3b, 0xc9, 0xd1, 0x66, 0x65, 0xc5, 0x20, 0x13, 0x58, 0x1c, 0x6a,
-		0xec, 0x51, 0xdc, 0xdf, 0xdf, 0xff, 0x6c, 0x1a, 0xff, 0x34, 0x8c, 0xff, 0x96, 0x8c, 0xb3, 0x56,
-		0x73, 0xee, 0x2f, 0x4f, 0x4f, 0x46, 0xab, 0x79, 0xf0, 0xa3, 0x74, 0x58, 0x2f, 0x8f, 0x0e, 0x7e,
-		0x99, 0xfd, 0xbe, 0x39, 0xc6, 0xc3, 0xff, 0x96, 0x19, 0xf5, 0xcb, 0xc1, 0xcf, 0xa7, 0xa7, 0xa3,
-		0x62, 0xda, 0x9e, 0x6d, 0x2f, 0xd9, 0xef, 0x4d, 0xa6, 0xdd, 0x4d, 0x78, 0x64, 0x32, 0x70, 0x5c,
-		0x02, 0xc2, 0x6e, 0x7e, 0x32, 0xd9, 0x46, 0x22, 0xac, 0x6b, 0x0e, 0x7b, 0x01, 0x7d, 0x53, 0x2b,
-		0x57, 0xc1, 0xfe, 0x81, 0xfd, 0x03, 0xfb, 0x27, 0x26, 0x2f, 0x63, 0xed, 0x33, 0xec, 0x61, 0xff,
-		0x99, 0xb9, 0x04, 0x24, 0x60, 0x5d, 0x61, 0x8a, 0x7b, 0xd3, 0x7e, 0xc9, 0x04, 0x09, 0x48, 0x89,
-		0x6a, 0x89, 0x21, 0x52, 0x04, 0x8d, 0xa8, 0xe6, 0xd3, 0x00, 0x88, 0x08, 0x50, 0x2b, 0x29, 0x5a,
-		0xd5, 0xb5, 0x05, 0xf5, 0x5a, 0xed, 0xa4, 0x96, 0xe1, 0x6d, 0x00, 0x7c, 0x59, 0x5a, 0x66, 0x2f,
-		0x70, 0x6d, 0x11, 0x03, 0xa8, 0xde, 0xb6, 0x6f, 0x71, 0x3e, 0xc0, 0x0f, 0xc0, 0x0f, 0xc0, 0x0f,
-		0x21, 0x79, 0x21, 0x39, 0x68, 0xdb, 0xd2, 0x23, 0x48, 0xd2, 0x83, 0x34, 0x52, 0x46, 0x92, 0x9c,
-		0x1f, 0xc8, 0xdb, 0x81, 0xd9, 0xb6, 0xb0, 0xde, 0xc4, 0x07, 0x62, 0xb9, 0x10, 0x32, 0x1c, 0x7c,
-		0xe5, 0xf2, 0xe0, 0x6b, 0xeb, 0xe9, 0xb8, 0xad, 0xaa, 0x32, 0x5b, 0xcc, 0xd9, 0x5f, 0xfc, 0xab,
-		0xd4, 0xe5, 0x2a, 0x02, 0xd5, 0x60, 0x02, 0xf9, 0xcf, 0x52, 0x99, 0x87, 0x2a, 0x99, 0x53, 0x92,
-		0x60, 0x1f, 0x69, 0xb8, 0x48, 0xc3, 0xd5, 0x0e, 0xce, 0x09, 0x6e, 0x04, 0x50, 0xb9, 0x09, 0x60,
-		0xc5, 0x0d, 0x00, 0x81, 0x82, 0x65, 0xc0, 0x4c, 0xcc, 0x4a, 0x34, 0xa5, 0x6d, 0xc5, 0x6c, 0x0a,
-		0xe4, 0xed, 0xc3, 0x60, 0x64, 0xca, 0x60, 0x48, 0xe7, 0xed, 0x47, 0x85, 0xe3, 0x04, 0xf7, 0xf7,
-		0x48, 0x97, 0xa0, 0x17, 0x28, 0xaf, 0xc0, 0x00, 0x85, 0x06, 0x0a, 0x2d, 0x19, 0x0a, 0x4d, 0xf9,
-		0x0a, 0x0c, 0xc9, 0x2a, 0xb2, 0x58, 0xb1, 0x93, 0xaa, 0x2a, 0x23, 0x56, 0x44, 0x32, 0x85, 0xa4,
-		0x54, 0x4c, 0x7a, 0x05, 0xa5, 0x56, 0x54, 0x6d, 0x0a, 0xab, 0x4d, 0x71, 0xb5, 0x28, 0xb0, 0x7a,
-		0xac, 0x4f, 0x40, 0xce, 0x29, 0x2b, 0x76, 0x34, 0x11, 0xd9, 0x1d, 0x37, 0x4b, 0x02, 0x4c, 0x74,
-		0xd7, 0x8d, 0x62, 0x4c, 0xab, 0x5d, 0xf9, 0x75, 0x18, 0x01, 0x7d, 0xc6, 0x40, 0x97, 0x51, 0xd0,
-		0x6e, 0x1c, 0xb4, 0x1b, 0x09, 0xad, 0xc6, 0x82, 0xc6, 0x68, 0x10, 0x19, 0x0f, 0xf5, 0x18, 0x7e,
-		0xa3, 0xbc, 0xd2, 0xde, 0xa5, 0xb3, 0xe4, 0xf7, 0x6b, 0x84, 0x73, 0x6a, 0xb9, 0x5b, 0x67, 0x69,
-		0x41, 0xf4, 0xdc, 0xb1, 0x13, 0x7d, 0x8d, 0x86, 0xbb, 0x76, 0xa6, 0x7f, 0x7e, 0x90, 0xcf, 0x58,
-		0xd0, 0x77, 0xf7, 0x0e, 0xb1, 0x86, 0x24, 0xb8, 0x0e, 0xc4, 0x77, 0xf2, 0xe4, 0x77, 0x21, 0xc8,
-		0xef, 0xea, 0xc9, 0xef, 0x52, 0x50, 0xdf, 0xe1, 0x93, 0xdf, 0x95, 0xa0, 0xbe, 0xdb, 0x27, 0xe7,
-		0x32, 0x51, 0xc2, 0x4a, 0x10, 0xdf, 0x05, 0x94, 0xdf, 0x65, 0xa0, 0xbd, 0x23, 0x28, 0xbf, 0xeb,
-		0x40, 0x7d, 0x77, 0x50, 0xce, 0x4d, 0x44, 0x19, 0x2b, 0x41, 0x7f, 0xd7, 0x50, 0xce, 0x57, 0xe2,
-		0x14, 0x2b, 0x41, 0x7c, 0x37, 0x51, 0xce, 0x05, 0xa2, 0x82, 0x95, 0xd0, 0x71, 0x97, 0x51, 0xce,
-		0xa5, 0xa2, 0x86, 0x95, 0xa0, 0xbf, 0xfb, 0x28, 0xd7, 0xb1, 0x97, 0x8e, 0x3b, 0x91, 0xf2, 0x6d,
-		0x30, 0x34, 0xac, 0x03, 0xe9, 0x8c, 0xcd, 0xad, 0xeb, 0x38, 0x40, 0x51, 0xf0, 0x47, 0x76, 0xb7,
-		0xd2, 0x92, 0x4c, 0x10, 0x5e, 0xe0, 0x52, 0xc0, 0x61, 0x17, 0x0e, 0xbb, 0xc2, 0x2f, 0xc0, 0x61,
-		0x57, 0x0e, 0x0e, 0xbb, 0xe8, 0xef, 0x70, 0x7a, 0x6b, 0x06, 0x28, 0xee, 0x72, 0x8a, 0xe6, 0xa4,
-		0xb9, 0xd3, 0x89, 0xd0, 0xb0, 0xa7, 0x9a, 0x48, 0x41, 0x74, 0xe7, 0xd3, 0xcc, 0xc5, 0x50, 0x96,
-		0x30, 0xac, 0xb8, 0x10, 0x4a, 0xa6, 0xaa, 0x81, 0x6e, 0xc5, 0x55, 0x2e, 0x36, 0x24, 0xcb, 0x34,
-		0xa1, 0xce, 0x30, 0xc1, 0xc5, 0x86, 0x69, 0x3a, 0x53, 0xa4, 0x93, 0x65, 0xc0, 0x0a, 0xd2, 0x5f,
-		0x6c, 0x28, 0x5f, 0xdd, 0x11, 0xeb, 0x04, 0x4f, 0x69, 0xca, 0x3d, 0xdf, 0x54, 0x7f, 0x44, 0x46,
-		0x04, 0x77, 0xc5, 0xe2, 0xae, 0x58, 0x98, 0x54, 0x98, 0x54, 0x98, 0x54, 0x55, 0x93, 0x1a, 0x19,
-		0x91, 0x3c, 0x9a, 0x54, 0xa1, 0x2b, 0x0b, 0x37, 0xdb, 0x53, 0x81, 0xab, 0x0c, 0x37, 0xee, 0x16,
-		0x95, 0x31, 0xad, 0xc0, 0x98, 0xc2, 0x98, 0xe6, 0xca, 0x98, 0xa2, 0xdc, 0x41, 0x75, 0x3a, 0x30,
-		0xc0, 0x60, 0x80, 0x13, 0x33, 0x16, 0x74, 0xe4, 0x60, 0x01, 0xe5, 0x0e, 0x28, 0x77, 0x58, 0xfa,
-		0x1a, 0x94, 0x3b, 0x68, 0xd1, 0x90, 0x04, 0xd7, 0x01, 0xe5, 0x0e, 0x28, 0x77, 0x78, 0xbb, 0x14,
-		0x28, 0x77, 0x40, 0xb9, 0xc3, 0x4a, 0x99, 0x40, 0xb9, 0x03, 0xca, 0x1d, 0x50, 0xee, 0xb0, 0xb8,
-		0x0e, 0x28, 0x77, 0x40, 0xb9, 0xc3, 0xca, 0x95, 0x40, 0xb9, 0x03, 0xca, 0x1d, 0x50, 0xee, 0xb0,
-		0x52, 0x20, 0x50, 0xee, 0x80, 0x72, 0x87, 0x02, 0xca, 0x1d, 0x62, 0x57, 0x02, 0xe5, 0x0e, 0x28,
-		0x77, 0x58, 0x6d, 0x30, 0x50, 0xee, 0x90, 0xf4, 0x2c, 0x28, 0x77, 0x50, 0x98, 0x0e, 0x87, 0x5d,
-		0x38, 0xec, 0x5a, 0xf9, 0x05, 0x38, 0xec, 0x22, 0xd0, 0x7d, 0x94, 0x3b, 0xa4, 0x37, 0xc3, 0xae,
-		0x95, 0x3b, 0x4c, 0xf2, 0xa7, 0xd2, 0xca, 0x23, 0x4b, 0xb4, 0x57, 0xef, 0x1f, 0xec, 0x75, 0x3e,
-		0x8f, 0xa4, 0xa0, 0xa8, 0x60, 0xc5, 0x2b, 0xcb, 0xf3, 0x1b, 0xbe, 0xaf, 0xd8, 0x00, 0xf8, 0xda,
-		0xb2, 0x2f, 0x7a, 0x6c, 0x6c, 0x33, 0xbd, 0xe2, 0xfb, 0x82, 0x3d, 0xec, 0xf5, 0x14, 0x92, 0xeb,
-		0xae, 0xcd, 0xef, 0x74, 0x93, 0xdd, 0xba, 0x1d, 0xe6, 0xb2, 0xce, 0x87, 0xd7, 0x70, 0xaa, 0x44,
-		0x37, 0x8b, 0x48, 0x8f, 0x74, 0xeb, 0x4f, 0x51, 0x29, 0x13, 0xd2, 0x1d, 0xb6, 0x7d, 0x7b, 0x1a,
-		0x16, 0x05, 0xcf, 0xd1, 0xba, 0x9a, 0x3c, 0x47, 0xeb, 0x3e, 0xf8, 0xe2, 0x87, 0xe0, 0x7b, 0x5b,
-		0x0f, 0xd3, 0x6f, 0xc3, 0x25, 0x36, 0xda, 0xb6, 0x34, 0x13, 0x17, 0x54, 0x48, 0xe5, 0xc6, 0x2a,
-		0xe5, 0xc2, 0x2a, 0x5f, 0x4c, 0x51, 0xc1, 0xc5, 0x14, 0x69, 0x22, 0xf1, 0x6d, 0xbe, 0x98, 0x42,
-		0xea, 0x5a, 0xa7, 0x25, 0x61, 0x91, 0xb8, 0xde, 0x89, 0x28, 0x6c, 0xc6, 0x85, 0x14, 0xc9, 0x84,
-		0xb9, 0xb8, 0x90, 0x82, 0x30, 0x2c, 0xa5, 0xd0, 0x9b, 0xc2, 0xf6, 0xde, 0xe6, 0xaa, 0xe3, 0x9a,
-		0xcd, 0x33, 0x82, 0xb9, 0x48, 0x56, 0x8a, 0x6e, 0xc5, 0x56, 0xac, 0x1c, 0xe9, 0x3d, 0xb8, 0x4b,
-		0x6b, 0xf8, 0x8e, 0x70, 0x4e, 0xea, 0x2b, 0x4b, 0xa3, 0x89, 0xf3, 0x76, 0x3f, 0xee, 0xf4, 0x4f,
-		0x33, 0x4b, 0xcc, 0x9b, 0x1e, 0xe1, 0xac, 0x43, 0x38, 0x71, 0xaf, 0x6e, 0x8e, 0xef, 0xd5, 0x25,
-		0x56, 0x55, 0x5c, 0xad, 0x1d, 0x9a, 0x86, 0x8e, 0xd3, 0x37, 0x2d, 0xdb, 0x08, 0x58, 0x9a, 0x8c,
-		0xdd, 0xac, 0x7d, 0xc5, 0xec, 0x97, 0x80, 0x03, 0xc9, 0x9c, 0xd3, 0xbf, 0xb6, 0x6c, 0x0d, 0x87,
-		0x63, 0xa4, 0x47, 0x98, 0xd1, 0xb4, 0x41, 0xa9, 0x8b, 0x7a, 0xf1, 0xef, 0xd2, 0xbc, 0xbf, 0xba,
-		0x66, 0xdb, 0xb7, 0x1c, 0xfb, 0xa3, 0xf5, 0x62, 0x05, 0x6c, 0x6c, 0x89, 0xee, 0x20, 0x8c, 0xd0,
-		0x9d, 0x5c, 0x9b, 0xdf, 0x73, 0xb7, 0x55, 0x95, 0xda, 0x49, 0x8e, 0x36, 0x2b, 0x2b, 0x06, 0x39,
-		0x8b, 0x77, 0xf9, 0xef, 0xef, 0xef, 0x7f, 0x36, 0x8d, 0x7f, 0x1a, 0xc6, 0x7f, 0x4b, 0xc6, 0x59,
-		0xab, 0x39, 0xf7, 0x97, 0xa7, 0x27, 0xa3, 0xd5, 0x3c, 0xf8, 0x51, 0x3a, 0xac, 0x97, 0x47, 0x07,
-		0xbf, 0xcc, 0x7e, 0xdf, 0x1c, 0xe3, 0xe1, 0x7f, 0xcb, 0x8c, 0xfa, 0xe5, 0xe0, 0xe7, 0xd3, 0xd3,
-		0x11, 0x6e, 0xb0, 0xd7, 0x60, 0x5d, 0x8a, 0x21, 0xa7, 0x3e, 0x70, 0x5c, 0x02, 0xc2, 0x6e, 0x7e,
-		0x32, 0xd9, 0x6b, 0x39, 0x59, 0xd7, 0x1c, 0xf6, 0x02, 0xfa, 0xa6, 0x56, 0xae, 0x82, 0xfd, 0x03,
-		0xfb, 0x07, 0xf6, 0x4f, 0x4c, 0x5e, 0xc6, 0xda, 0x67, 0xd8, 0xc3, 0xfe, 0x33, 0x73, 0x09, 0x48,
-		0xc0, 0xba, 0xc2, 0x14, 0xf7, 0xa6, 0xfd, 0x92, 0x09, 0x12, 0x90, 0x12, 0xd5, 0x52, 0x27, 0xe4,
-		0x4d, 0xa1, 0x11, 0xd5, 0x7c, 0x1a, 0x00, 0x11, 0x45, 0xc2, 0x25, 0x25, 0x5a, 0xd5, 0xb5, 0x05,
-		0xf5, 0x5a, 0xed, 0xa4, 0x96, 0xe1, 0x6d, 0x00, 0x7c, 0x59, 0x5a, 0x66, 0x2f, 0x70, 0x6d, 0x11,
-		0x03, 0xa8, 0x7e, 0x0f, 0xfe, 0xe2, 0x7c, 0x80, 0x1f, 0x80, 0x1f, 0x80, 0x1f, 0x42, 0xf2, 0x42,
-		0x72, 0xd0, 0xb6, 0xa5, 0x47, 0x90, 0xa4, 0x07, 0x69, 0xa4, 0x8c, 0x24, 0x39, 0x3f, 0x90, 0xb7,
-		0x03, 0xb3, 0x6d, 0x61, 0xbd, 0x89, 0x0f, 0xc4, 0x72, 0x21, 0x64, 0x38, 0xf8, 0xca, 0xe5, 0xc1,
-		0xd7, 0xd6, 0xd3, 0x71, 0x3b, 0x94, 0x8b, 0x2b, 0x5e, 0x8b, 0x20, 0x90, 0x87, 0xbb, 0x47, 0xb8,
-		0x7e, 0xd3, 0x5a, 0x02, 0x81, 0x1c, 0x29, 0xb9, 0x72, 0x01, 0xa5, 0xf2, 0x00, 0xa5, 0x72, 0x00,
-		0xb9, 0xf4, 0x7f, 0xde, 0xf5, 0x93, 0x94, 0x3b, 0x52, 0x79, 0x2b, 0x0a, 0x65, 0x66, 0x73, 0xe6,
-		0xea, 0xf3, 0x89, 0xef, 0x66, 0x61, 0x5c, 0xff, 0x89, 0x0d, 0xcb, 0x2c, 0xba, 0xbc, 0x8a, 0xcb,
-		0xba, 0xfe, 0x9d, 0xe3, 0xdf, 0x64, 0xf5, 0xbf, 0xc4, 0xbc, 0x1b, 0xef, 0x3b, 0x89, 0xbe, 0xcb,
-		0x1a, 0x29, 0x58, 0xbb, 0xeb, 0xab, 0x5f, 0x7a, 0xf9, 0x95, 0x56, 0xbc, 0x4e, 0xb1, 0xcf, 0xfa,
-		0x8e, 0x1b, 0x5f, 0x35, 0x1c, 0xc1, 0xb0, 0xf0, 0x73, 0x31, 0x0b, 0xb2, 0x3e, 0x73, 0x7f, 0x63,
-		0xfc, 0xcf, 0x13, 0xdf, 0xcf, 0xc7, 0xef, 0xde, 0xeb, 0x3a, 0x28, 0xc8, 0x1b, 0x9f, 0x0b, 0xc7,
-		0xdf, 0xc2, 0xf1, 0xf5, 0xdb, 0xf8, 0x79, 0xfc, 0xdc, 0x44, 0x22, 0xb8, 0x29, 0x93, 0xbd, 0xd8,
-		0x9e, 0xae, 0xf9, 0x86, 0x45, 0x98, 0x2e, 0x6b, 0xf8, 0xf9, 0x0d, 0x2f, 0xc4, 0x57, 0xa2, 0xc1,
-		0x4d, 0xf8, 0x88, 0x10, 0x3b, 0xfc, 0x02, 0x20, 0x4b, 0xd4, 0x48, 0x13, 0x32, 0xd2, 0xc4, 0x8b,
-		0x90, 0x80, 0x64, 0xdb, 0x1e, 0x4f, 0x0c, 0x04, 0xd7, 0x9d, 0x55, 0x6b, 0xcc, 0xf0, 0x5a, 0x0b,
-		0xc8, 0x53, 0x91, 0x24, 0x54, 0x81, 0x24, 0x2c, 0xce, 0x15, 0x88, 0x73, 0xc6, 0xc4, 0x99, 0xb7,
-		0xa2, 0xa7, 0x38, 0xf8, 0xf2, 0xea, 0x59, 0x6d, 0xb3, 0xc7, 0xbf, 0x7c, 0xd1, 0xf9, 0xe3, 0x74,
-		0x24, 0x2f, 0x20, 0x17, 0x22, 0xcb, 0x85, 0xc9, 0x71, 0x19, 0x32, 0x5c, 0x5c, 0xd8, 0x54, 0xc9,
-		0x6e, 0x65, 0x72, 0x5b, 0x99, 0xcc, 0x96, 0x12, 0x46, 0x3d, 0x21, 0x9a, 0x30, 0x19, 0x1d, 0xed,
-		0xd7, 0xd0, 0xb2, 0xfd, 0xba, 0x48, 0xd6, 0xc8, 0x54, 0xfa, 0x04, 0xe8, 0x2d, 0xc9, 0x63, 0x6d,
-		0x89, 0x10, 0x5d, 0xe5, 0xd8, 0x5a, 0xf5, 0x0c, 0x4a, 0xf1, 0x58, 0x9a, 0xe2, 0xfc, 0x53, 0xe6,
-		0x3c, 0x50, 0xe5, 0x98, 0x99, 0x6a, 0xc9, 0xca, 0xef, 0xaa, 0xd5, 0xfa, 0x69, 0xb5, 0x5a, 0x3a,
-		0x3d, 0x39, 0x2d, 0x9d, 0xd5, 0x6a, 0xe5, 0xba, 0x6c, 0x9b, 0x7a, 0x92, 0x55, 0xd4, 0xc4, 0xca,
-		0x34, 0xa9, 0xc2, 0x65, 0x0e, 0x4f, 0xee, 0xb2, 0x20, 0x5e, 0xed, 0x88, 0x3b, 0xa3, 0x68, 0x24,
-		0x9c, 0x11, 0x9c, 0x11, 0x9c, 0x11, 0x9c, 0x11, 0x9c, 0x11, 0x9c, 0x51, 0x4e, 0xb9, 0x02, 0x8e,
-		0x43, 0x96, 0xac, 0x32, 0xb6, 0x6b, 0xe9, 0xd0, 0x78, 0xc2, 0xf6, 0x7a, 0x32, 0x4c, 0x81, 0xaf,
-		0xb5, 0xfd, 0xc1, 0x66, 0xb2, 0x76, 0xfc, 0x21, 0x30, 0xb5, 0x60, 0x6a, 0xc1, 0xd4, 0xee, 0x04,
-		0xb5, 0xc5, 0x6c, 0xf3, 0xb9, 0xc7, 0x0c, 0xdb, 0x1f, 0x18, 0xe6, 0x30, 0xb0, 0x5c, 0x82, 0x41,
-		0xc5, 0xdb, 0x09, 0x38, 0x97, 0x64, 0xae, 0xb4, 0xa5, 0x6b, 0xf6, 0x3c, 0x86, 0x98, 0x04, 0x31,
-		0x49, 0xd2, 0x31, 0xc9, 0xb3, 0xe3, 0xf4, 0x98, 0x69, 0x4b, 0x04, 0x25, 0xe5, 0x72, 0x82, 0x01,
-		0xff, 0x44, 0xc3, 0x3a, 0xb2, 0xaa, 0xd9, 0x81, 0x4a, 0x42, 0x25, 0xa1, 0x92, 0xa4, 0x2a, 0x39,
-		0xf6, 0x76, 0x92, 0xd5, 0x16, 0xf3, 0x40, 0xdb, 0x90, 0xaa, 0xb0, 0x80, 0xc2, 0x41, 0xe1, 0xc8,
-		0x14, 0x4e, 0xaa, 0x42, 0x41, 0xa6, 0x22, 0x41, 0xae, 0x02, 0x41, 0xa1, 0xbb, 0xa6, 0x62, 0x85,
-		0x81, 0x4a, 0xb2, 0xb7, 0x72, 0x72, 0x77, 0xe6, 0x2b, 0x06, 0x9a, 0x3a, 0xd3, 0x79, 0xd5, 0x36,
-		0xbd, 0x9e, 0xf7, 0x4d, 0x47, 0x06, 0x7f, 0x2e, 0x32, 0xf8, 0x9b, 0xa0, 0x6f, 0x25, 0xc8, 0x4f,
-		0xdb, 0x1f, 0x68, 0xcc, 0xf3, 0x1a, 0x63, 0xaa, 0xaf, 0xec, 0xd5, 0xe3, 0x27, 0xc4, 0xa2, 0x11,
-		0xa0, 0xc4, 0x40, 0x89, 0x2d, 0x08, 0x91, 0x1c, 0xa2, 0x1f, 0x0f, 0x14, 0x83, 0xf1, 0x65, 0xc0,
-		0x78, 0xc0, 0x78, 0x31, 0x11, 0x15, 0xa5, 0xff, 0xd5, 0x8e, 0x03, 0x14, 0x05, 0x57, 0x5a, 0x80,
-		0x55, 0x04, 0x59, 0x5d, 0xa0, 0x55, 0x05, 0x9b, 0x4c, 0xc0, 0xc9, 0x04, 0x9d, 0x44, 0xe0, 0xc5,
-		0xf1, 0x4c, 0x21, 0xc9, 0x5e, 0xfb, 0x5f, 0xd9, 0xab, 0x61, 0x75, 0xd4, 0x5b, 0x5f, 0x84, 0xf3,
-		0xa0, 0xe5, 0x85, 0xbc, 0xe2, 0x50, 0x29, 0x10, 0xb9, 0x22, 0x91, 0x2b, 0x14, 0xa9, 0x62, 0xc9,
-		0x29, 0x98, 0xa4, 0xa2, 0xc9, 0x13, 0x48, 0xb1, 0xf2, 0x32, 0xb4, 0x6c, 0xbf, 0x5c, 0x47, 0xb3,
-		0xad, 0xc9, 0x83, 0xa0, 0xd9, 0x96, 0xd2, 0x1f, 0x34, 0xdb, 0xca, 0xc6, 0x36, 0xa0, 0xd9, 0xd6,
-		0x4a, 0xa4, 0xe1, 0xab, 0x58, 0xcd, 0x05, 0xac, 0x11, 0xcc, 0x04, 0xb4, 0x01, 0xb4, 0x01, 0xb4,
-		0x21, 0x24, 0x2f, 0x56, 0x87, 0xd9, 0xbe, 0xe5, 0xbf, 0xba, 0xac, 0x4b, 0xd1, 0x61, 0x4b, 0xc1,
-		0x06, 0x17, 0x2f, 0xc3, 0x47, 0xf9, 0x60, 0x7a, 0x04, 0xe2, 0x37, 0x7d, 0xc1, 0x9b, 0xc7, 0xbb,
-		0x56, 0xe3, 0xd3, 0xe3, 0xef, 0xad, 0xc7, 0xbf, 0xee, 0x2e, 0x54, 0x45, 0x30, 0x70, 0x37, 0x1e,
-		0x49, 0xcb, 0x21, 0xe2, 0x8b, 0xc2, 0xa3, 0xd7, 0xbc, 0xfe, 0x58, 0x2b, 0xa6, 0xec, 0xaf, 0x9a,
-		0x49, 0x6b, 0x51, 0x62, 0xfe, 0xea, 0x5b, 0x08, 0x37, 0x08, 0x1c, 0xd6, 0x64, 0x2a, 0x78, 0x2c,
-		0x78, 0x2c, 0x78, 0x2c, 0x21, 0x79, 0xf1, 0x7c, 0x77, 0x7d, 0xbb, 0x16, 0x6e, 0x67, 0xf5, 0x0e,
-		0xad, 0xbb, 0x04, 0x0f, 0x1e, 0xa7, 0x87, 0x7d, 0xd3, 0x1f, 0xb8, 0x4e, 0x22, 0xe5, 0x97, 0x43,
-		0xe4, 0xce, 0x5c, 0x49, 0xe2, 0x52, 0x8d, 0xb0, 0x94, 0x34, 0xc4, 0x60, 0xf2, 0xc1, 0xe4, 0x6b,
-		0x37, 0x9c, 0xd1, 0x7e, 0xf7, 0x98, 0xd9, 0x95, 0x83, 0xf7, 0x91, 0xa5, 0x3c, 0x95, 0xcb, 0xe6,
-		0x09, 0x2c, 0xca, 0xd1, 0x51, 0x68, 0x25, 0x8e, 0x43, 0x15, 0xc3, 0x05, 0xdb, 0x52, 0xa6, 0xa2,
-		0x02, 0x53, 0x01, 0x53, 0xb1, 0xf6, 0x09, 0x71, 0xe8, 0x87, 0xa0, 0x06, 0x41, 0x4d, 0x2e, 0x83,
-		0x1a, 0x1c, 0xfa, 0xcd, 0x3f, 0x08, 0x0e, 0xfd, 0xd4, 0xd8, 0x3b, 0x1c, 0xfa, 0x65, 0x62, 0x1b,
-		0x70, 0xe8, 0xb7, 0x12, 0x69, 0xe0, 0xd0, 0x0f, 0x68, 0x03, 0x68, 0x23, 0x4d, 0xb4, 0x81, 0x43,
-		0x3f, 0x61, 0x77, 0x83, 0x43, 0x3f, 0x2d, 0x5e, 0xa7, 0x80, 0x43, 0x3f, 0x78, 0x2c, 0x78, 0x2c,
-		0x78, 0xac, 0x0d, 0xf2, 0x82, 0x43, 0xbf, 0x37, 0x32, 0x91, 0xe6, 0xa1, 0x5f, 0xfe, 0xee, 0xe7,
-		0x11, 0x22, 0x26, 0x71, 0x43, 0x0f, 0x81, 0xa4, 0x29, 0x49, 0x98, 0xf2, 0x8d, 0x3c, 0x37, 0xfe,
-		0x60, 0xfc, 0xff, 0xe3, 0xdd, 0xdf, 0xfa, 0x62, 0xe0, 0xa8, 0x00, 0x57, 0xc7, 0xb5, 0x0f, 0xe1,
-		0xc5, 0x3e, 0xfc, 0x17, 0x3f, 0x84, 0x03, 0x50, 0x0c, 0x8c, 0x62, 0xe0, 0x79, 0x11, 0x12, 0xaf,
-		0x05, 0x16, 0xba, 0x9b, 0x0b, 0xa5, 0xc0, 0x05, 0x94, 0x02, 0xcb, 0x09, 0x68, 0x34, 0x40, 0xf6,
-		0xd6, 0xef, 0x68, 0x9f, 0xe5, 0xba, 0xa6, 0x20, 0x85, 0x28, 0x95, 0x70, 0x0d, 0x29, 0x44, 0x22,
-		0xfb, 0x9d, 0x99, 0x14, 0xa2, 0xa9, 0x8e, 0x65, 0x20, 0x87, 0x08, 0xad, 0x03, 0x60, 0x2d, 0xb6,
-		0xd1, 0x5a, 0x48, 0x67, 0x11, 0xc9, 0x3a, 0x50, 0x22, 0x47, 0x0a, 0x9e, 0x14, 0x3c, 0xe9, 0xce,
-		0xf3, 0xa4, 0x02, 0x17, 0x51, 0xaf, 0x75, 0x2f, 0x67, 0x0a, 0x73, 0x48, 0xf5, 0xa9, 0x7c, 0xfb,
-		0x87, 0x20, 0x8b, 0x48, 0xa9, 0x43, 0xa7, 0xce, 0x15, 0xa2, 0x5d, 0x29, 0xba, 0x15, 0x5b, 0xb1,
-		0x72, 0x4a, 0x1d, 0x40, 0x37, 0xae, 0xe1, 0x3b, 0xc2, 0x39, 0x55, 0x9b, 0x47, 0xc6, 0x4e, 0x9c,
-		0xf5, 0x4e, 0xa2, 0x71, 0x7f, 0x9a, 0x24, 0x33, 0x8d, 0x0e, 0x33, 0x2c, 0x9c, 0x75, 0x08, 0x27,
-		0x3a, 0x9e, 0xe6, 0xa8, 0xe3, 0xa9, 0x66, 0x55, 0xdd, 0x4b, 0xf7, 0x39, 0x14, 0x4d, 0x05, 0xa1,
-		0xc7, 0xef, 0x38, 0x7d, 0xd3, 0xb2, 0x8d, 0xe0, 0x50, 0x88, 0xd0, 0xe5, 0x13, 0x58, 0x84, 0xe2,
-		0x15, 0xb3, 0x5f, 0x02, 0x52, 0x23, 0x73, 0x4e, 0x9f, 0x32, 0xe9, 0x9a, 0x28, 0x16, 0x8b, 0x9d,
-		0x36, 0xba, 0x8f, 0x8e, 0x78, 0x5e, 0x0d, 0x59, 0xc0, 0xc4, 0x8e, 0xb4, 0x40, 0x9d, 0x9c, 0x9d,
-		0xd4, 0x56, 0x55, 0x6a, 0x27, 0x39, 0xda, 0xac, 0xac, 0x18, 0x64, 0x02, 0x8b, 0x43, 0x8d, 0x3d,
-		0x8a, 0xfb, 0xfb, 0xfb, 0x9f, 0x4d, 0xe3, 0x9f, 0x86, 0xf1, 0xdf, 0x92, 0x71, 0xd6, 0x6a, 0xce,
-		0xfd, 0xe5, 0xe9, 0xc9, 0x68, 0x35, 0x0f, 0x7e, 0x94, 0x0e, 0xeb, 0xe5, 0xd1, 0xc1, 0x2f, 0xb3,
-		0xdf, 0x37, 0xc7, 0x78, 0xf8, 0xdf, 0x32, 0xa3, 0x7e, 0x39, 0xf8, 0xf9, 0xf4, 0x74, 0x54, 0x4c,
-		0xdb, 0xb3, 0x6d, 0x65, 0x2e, 0xbe, 0xe9, 0x79, 0x4e, 0xdb, 0x0a, 0xb2, 0x12, 0x88, 0x72, 0xf2,
-		0x97, 0x66, 0x94, 0xe4, 0x43, 0xe6, 0x2e, 0x52, 0x7a, 0xb8, 0xb8, 0xff, 0xf3, 0xe2, 0x1e, 0x54,
-		0x20, 0xa8, 0x40, 0x50, 0x81, 0x62, 0xf2, 0xc2, 0xec, 0x61, 0x9f, 0xb9, 0x93, 0xa4, 0x23, 0x02,
-		0x46, 0xb0, 0xaa, 0x30, 0xc7, 0x85, 0x3d, 0xec, 0x8f, 0x5f, 0x2a, 0xcb, 0x99, 0xde, 0xd6, 0xf3,
-		0xd0, 0xf5, 0x7c, 0x75, 0x1b, 0x18, 0xce, 0xa3, 0x6e, 0xf9, 0x44, 0xae, 0x90, 0x83, 0xe1, 0x83,
-		0xe1, 0x83, 0xe1, 0x93, 0xbe, 0x02, 0x2f, 0xd6, 0xe8, 0x95, 0x33, 0x6c, 0xb0, 0x06, 0x8e, 0x4b,
-		0x60, 0xae, 0x82, 0x59, 0xd4, 0x8d, 0x55, 0xb9, 0x72, 0x02, 0x53, 0x05, 0x53, 0x05, 0x53, 0x25,
-		0xae, 0x7d, 0x86, 0x3d, 0xec, 0x3f, 0x73, 0x67, 0xba, 0xae, 0x53, 0x21, 0xf4, 0x7e, 0xd0, 0xcb,
-		0x69, 0xa1, 0xf7, 0x43, 0x76, 0xb6, 0x00, 0xbd, 0x1f, 0x72, 0xc7, 0x37, 0x8d, 0xfd, 0x10, 0x73,
-		0x09, 0x20, 0xcb, 0x64, 0x1e, 0x44, 0x58, 0x80, 0x2d, 0x80, 0x2d, 0x88, 0xb0, 0xf4, 0x19, 0xac,
-		0x6f, 0xcc, 0xf5, 0x64, 0x2b, 0x6c, 0x17, 0xd6, 0x6b, 0x3a, 0x91, 0xba, 0xc9, 0xaa, 0xc2, 0x5c,
-		0xc1, 0x5c, 0xc1, 0x5c, 0x89, 0xc9, 0xcb, 0xd0, 0xb2, 0xfd, 0x77, 0x04, 0xc6, 0xaa, 0x86, 0xf8,
-		0x2a, 0x19, 0x70, 0x5f, 0x46, 0x7c, 0x95, 0xf6, 0x16, 0x54, 0x11, 0x5b, 0xa5, 0x16, 0x5b, 0x6d,
-		0x4d, 0xe7, 0x90, 0xb0, 0x1b, 0x40, 0xf8, 0xdf, 0x0c, 0x5d, 0x16, 0x80, 0xfe, 0xdf, 0x9a, 0x91,
-		0x16, 0x2a, 0xf7, 0x52, 0x50, 0x6d, 0x54, 0xee, 0x21, 0x48, 0x41, 0x90, 0x82, 0xca, 0x3d, 0xe9,
-		0x39, 0x50, 0xb9, 0x97, 0xe8, 0x4a, 0xd1, 0xad, 0xd8, 0x8a, 0x95, 0x43, 0xe5, 0x1e, 0x2a, 0xf7,
-		0xb2, 0x2b, 0x9c, 0xa8, 0xdc, 0x43, 0xe5, 0x1e, 0x2a, 0xf7, 0x68, 0x18, 0x8d, 0x02, 0x2a, 0xf7,
-		0x12, 0xb0, 0x08, 0xa8, 0xdc, 0xcb, 0x22, 0xc5, 0xab, 0x93, 0x67, 0x24, 0x76, 0xa4, 0x05, 0x54,
-		0xee, 0x25, 0xb1, 0x59, 0xa8, 0xdc, 0x8b, 0x9f, 0x10, 0x95, 0x7b, 0xba, 0xbf, 0x17, 0x95, 0x7b,
-		0xa8, 0xdc, 0x03, 0x15, 0x08, 0x2a, 0x50, 0x49, 0x5e, 0x50, 0xb9, 0x27, 0xf4, 0x8c, 0xa8, 0xdc,
-		0x83, 0xe1, 0x83, 0xe1, 0xdb, 0x06, 0xc3, 0xb7, 0x23, 0x79, 0xa5, 0x4e, 0xb7, 0xeb, 0x31, 0x02,
-		0x83, 0x15, 0xce, 0x03, 0x43, 0x03, 0x43, 0x03, 0x43, 0x23, 0x24, 0x2f, 0x43, 0xcb, 0xf6, 0xeb,
-		0x55, 0x02, 0x3b, 0xf3, 0x0e, 0x29, 0xa1, 0x5a, 0xc9, 0x28, 0x94, 0xdc, 0x65, 0x67, 0x0b, 0xca,
-		0xef, 0xaa, 0xd5, 0xfa, 0x69, 0xb5, 0x5a, 0x3a, 0x3d, 0x39, 0x2d, 0x9d, 0xd5, 0x6a, 0xe5, 0x7a,
-		0x19, 0x15, 0x78, 0xb9, 0xe2, 0x8d, 0x06, 0x4e, 0xaf, 0x67, 0x58, 0xb6, 0xcf, 0xdc, 0x6f, 0x66,
-		0x8f, 0xa2, 0x77, 0xc0, 0xfc, 0x74, 0x80, 0x21, 0x80, 0x21, 0x80, 0x21, 0xc2, 0x30, 0xe4, 0xa4,
-		0x42, 0x00, 0x43, 0x4e, 0x01, 0x43, 0x00, 0x43, 0x76, 0xa5, 0x32, 0xa5, 0x72, 0x56, 0x3d, 0xab,
-		0x9f, 0x56, 0xce, 0x00, 0x3e, 0x72, 0x06, 0x3e, 0xd0, 0xaf, 0x08, 0x80, 0x05, 0x80, 0xa5, 0x80,
-		0x7e, 0x45, 0xe8, 0x57, 0x04, 0xd4, 0xb2, 0x63, 0xa8, 0x05, 0xfd, 0x8a, 0xf2, 0x07, 0x58, 0xd0,
-		0xaf, 0x08, 0xb0, 0x05, 0xb0, 0x65, 0x0b, 0x60, 0xcb, 0x8e, 0x9c, 0x2b, 0xbb, 0x8e, 0xe3, 0x1b,
-		0x1d, 0xd6, 0x33, 0x5f, 0xd5, 0x8d, 0xd6, 0xdc, 0x5c, 0x30, 0x38, 0x30, 0x38, 0x30, 0x38, 0x42,
-		0xf2, 0x02, 0x62, 0x17, 0x21, 0x12, 0x42, 0x24, 0xc1, 0x2d, 0x00, 0xb1, 0x9b, 0xcf, 0x38, 0x69,
-		0x02, 0x15, 0x2c, 0x6f, 0x40, 0xd5, 0x2e, 0xf1, 0xed, 0x84, 0x00, 0x20, 0x00, 0x20, 0x00, 0x20,
-		0xc2, 0x00, 0x04, 0x09, 0x6e, 0x00, 0x20, 0x00, 0x20, 0x02, 0x5b, 0x80, 0x04, 0xb7, 0xbc, 0x43,
-		0x11, 0xcf, 0x77, 0x4d, 0x7f, 0x52, 0xb1, 0xa4, 0x06, 0x41, 0xa6, 0x13, 0x01, 0x7a, 0x00, 0x7a,
-		0x00, 0x7a, 0x08, 0x43, 0x0f, 0x74, 0x5b, 0x06, 0xf2, 0x00, 0xf2, 0xe0, 0xdf, 0x82, 0x4a, 0x0d,
-		0x40, 0x23, 0x57, 0x40, 0x03, 0x57, 0x43, 0x00, 0xac, 0x00, 0xac, 0x00, 0xac, 0x00, 0xac, 0x24,
-		0x1d, 0xa3, 0x03, 0xac, 0xa4, 0xbd, 0x05, 0xb8, 0x1a, 0x22, 0x3d, 0xa8, 0xb2, 0xad, 0x57, 0x43,
-		0x4c, 0x6e, 0x54, 0xd0, 0x75, 0x33, 0xc4, 0x1e, 0xe1, 0x6a, 0x8d, 0x1d, 0xbb, 0x78, 0xab, 0xfb,
-		0xe2, 0x95, 0xe5, 0xf9, 0x0d, 0xdf, 0x17, 0x4b, 0x27, 0x1c, 0x5b, 0xe4, 0x8b, 0x1e, 0x1b, 0x3b,
-		0xe9, 0xb1, 0x7e, 0xd8, 0xc3, 0x5e, 0x4f, 0xe0, 0x92, 0x8b, 0x6b, 0xf3, 0xbb, 0xfc, 0xe0, 0x5b,
-		0xb7, 0xc3, 0x5c, 0xd6, 0xf9, 0xf0, 0x1a, 0x0e, 0x25, 0x5d, 0x42, 0x49, 0x41, 0x53, 0x10, 0xb0,
-		0xa2, 0xd0, 0xe5, 0x20, 0xee, 0xb0, 0xed, 0xdb, 0x21, 0x42, 0x78, 0x08, 0xa6, 0x6c, 0xdd, 0xf8,
-		0x83, 0xd6, 0xc3, 0x64, 0xaa, 0x3d, 0x1a, 0x99, 0x5b, 0xff, 0x89, 0x0d, 0x4b, 0x29, 0xba, 0x84,
-		0x32, 0x4b, 0xb7, 0xfe, 0x45, 0xe3, 0x1f, 0x7f, 0xcd, 0xa3, 0x73, 0x5e, 0xbc, 0x22, 0x74, 0xd1,
-		0x0a, 0xe7, 0xc5, 0x2a, 0xdc, 0x17, 0xa9, 0x88, 0xc4, 0x23, 0xe2, 0x71, 0x87, 0x68, 0x7c, 0x21,
-		0x1d, 0x47, 0x48, 0xc7, 0x0b, 0x52, 0x71, 0x81, 0x9a, 0x30, 0xf3, 0x5e, 0x5c, 0x52, 0x34, 0x87,
-		0xfe, 0x17, 0xa3, 0x6f, 0x79, 0x7d, 0xd3, 0x6f, 0x7f, 0xe1, 0x5f, 0xc3, 0xa8, 0xcb, 0xe1, 0xc2,
-		0x70, 0x5e, 0x7b, 0x2f, 0x84, 0xa2, 0x84, 0xc3, 0x61, 0x99, 0xf0, 0x57, 0x3e, 0xdc, 0x95, 0x0d,
-		0x6f, 0x95, 0xc3, 0x59, 0xe5, 0xf0, 0x55, 0x29, 0x5c, 0xa5, 0x45, 0x00, 0xc2, 0xe1, 0x67, 0xb4,
-		0x5f, 0x6d, 0x67, 0x68, 0xfb, 0xcc, 0x15, 0x3a, 0x99, 0x97, 0x38, 0x89, 0x97, 0x0c, 0x29, 0x25,
-		0x20, 0x9f, 0x4a, 0xc8, 0xa8, 0x4a, 0x3c, 0x29, 0xf2, 0xd7, 0x14, 0xf1, 0x87, 0x0c, 0x75, 0xa8,
-		0x12, 0xe2, 0x51, 0x2d, 0x19, 0xdd, 0x49, 0x37, 0xc9, 0x2a, 0x6a, 0xc2, 0xfd, 0x4d, 0x2a, 0xa4,
-		0xc6, 0xe1, 0xd6, 0x99, 0x6d, 0x3e, 0xf7, 0x98, 0x61, 0xfb, 0x03, 0x63, 0xec, 0x65, 0xc4, 0x7d,
-		0xd3, 0xdb, 0x09, 0x38, 0x6d, 0x91, 0x64, 0x79, 0x10, 0xbc, 0x1a, 0xbc, 0x1a, 0x99, 0x57, 0x13,
-		0x2f, 0xaf, 0x11, 0x2c, 0xa7, 0xa1, 0x54, 0xd1, 0x8e, 0xac, 0x6a, 0x76, 0xa0, 0x92, 0x50, 0x49,
-		0xa8, 0x24, 0xa9, 0x4a, 0x8e, 0xbd, 0x9d, 0x17, 0x08, 0x8a, 0x21, 0x7a, 0x07, 0x65, 0xf4, 0xaa,
-		0x2b, 0xe6, 0x80, 0xc2, 0x41, 0xe1, 0x12, 0x56, 0x38, 0xa9, 0xab, 0x0a, 0x65, 0xae, 0x24, 0x94,
-		0xbb, 0x7a, 0x50, 0xe1, 0x0e, 0x60, 0xc5, 0xab, 0x04, 0x55, 0xee, 0x60, 0x52, 0xbe, 0x01, 0x25,
-		0xf3, 0x57, 0x00, 0x36, 0x75, 0x1e, 0xe8, 0xa8, 0x6d, 0x7a, 0x3d, 0xef, 0x9b, 0x8e, 0xab, 0xf5,
-		0x72, 0x71, 0xb5, 0x5e, 0x33, 0xeb, 0xd1, 0x7f, 0x76, 0xcf, 0x69, 0x36, 0x1f, 0x9a, 0xae, 0x39,
-		0xa5, 0xd9, 0x13, 0x78, 0x1d, 0xde, 0xd7, 0x10, 0x79, 0xfc, 0xe2, 0xda, 0x63, 0xa2, 0xd5, 0x47,
-		0x70, 0xab, 0x5f, 0x76, 0xf9, 0x55, 0x56, 0xbc, 0x46, 0x71, 0xfc, 0x2c, 0xdd, 0x9e, 0xf3, 0x77,
-		0xec, 0x0b, 0xcc, 0xb0, 0xd7, 0xf4, 0x93, 0x31, 0x8b, 0xb1, 0xfe, 0xc0, 0x69, 0x23, 0x4e, 0xe4,
-		0xc1, 0x85, 0xbc, 0xcf, 0x22, 0x82, 0xfc, 0x84, 0x91, 0x9e, 0x30, 0xb2, 0x5b, 0x44, 0x72, 0xd3,
-		0x27, 0x27, 0x12, 0xc1, 0x4d, 0x87, 0x44, 0x45, 0xf3, 0x65, 0xf2, 0xcd, 0x9c, 0x67, 0x8b, 0x93,
-		0x8f, 0x13, 0x9f, 0x2d, 0x96, 0x74, 0x9c, 0x2d, 0x6e, 0x16, 0x01, 0xd9, 0x20, 0x20, 0xed, 0xd3,
-		0xc5, 0x8d, 0x22, 0x42, 0x63, 0x84, 0xb9, 0xcf, 0x17, 0xdb, 0xd3, 0x7d, 0x14, 0x8c, 0x41, 0xc3,
-		0x71, 0x62, 0x71, 0x67, 0x39, 0x93, 0x71, 0x27, 0xbf, 0xb0, 0x6d, 0x5b, 0xe4, 0xc9, 0x2d, 0x8c,
-		0x7a, 0x62, 0x4f, 0x5e, 0x21, 0x8d, 0x06, 0x3c, 0x9b, 0xed, 0xaf, 0x4e, 0xb7, 0x2b, 0xdf, 0xd0,
-		0x7d, 0xc6, 0x16, 0xbd, 0x9d, 0x49, 0x70, 0x19, 0xe5, 0x4e, 0xa5, 0xa4, 0x33, 0xc7, 0x55, 0x32,
-		0xc6, 0x55, 0x04, 0x5d, 0x55, 0xe0, 0xc9, 0x04, 0x9f, 0x4c, 0x01, 0x88, 0x14, 0x41, 0x1c, 0x9c,
-		0x4b, 0x44, 0xb3, 0xf2, 0x59, 0xdf, 0xea, 0x6d, 0x79, 0x14, 0xda, 0xf1, 0x28, 0xa6, 0x77, 0x2b,
-		0xe4, 0xb8, 0x53, 0xa4, 0x73, 0x13, 0xe5, 0x10, 0x53, 0xd5, 0x9a, 0x51, 0xe6, 0x0c, 0x2b, 0xa4,
-		0x6b, 0x93, 0xa4, 0x69, 0x53, 0x2f, 0x2d, 0x55, 0xfb, 0x1c, 0xd2, 0x35, 0x4e, 0x28, 0x2f, 0x5a,
-		0x17, 0x87, 0x20, 0x20, 0x23, 0xc5, 0x8e, 0xe9, 0x07, 0x81, 0xaf, 0x61, 0x75, 0xe4, 0x3d, 0xf1,
-		0xfc, 0x24, 0x70, 0xc2, 0x70, 0xc2, 0x5b, 0xe8, 0x84, 0xe5, 0x45, 0x3c, 0x03, 0xcc, 0xf2, 0x3c,
-		0xf3, 0xf9, 0x96, 0x50, 0xad, 0x8c, 0x0e, 0x7e, 0x9c, 0x8e, 0x32, 0xc4, 0x80, 0x8a, 0x58, 0xaf,
-		0xae, 0x69, 0xf5, 0x86, 0x2e, 0x33, 0xfa, 0x4e, 0x87, 0xc9, 0x9b, 0xaf, 0x85, 0x59, 0x60, 0xbf,
-		0x60, 0xbf, 0xb6, 0xd0, 0x7e, 0x29, 0xc8, 0x78, 0x41, 0xf1, 0x5a, 0x6e, 0xc9, 0xeb, 0xb8, 0xf5,
-		0x58, 0x0c, 0xcb, 0x1e, 0xc3, 0xc4, 0x6f, 0x96, 0xff, 0x6a, 0x0c, 0x5c, 0xe7, 0x59, 0xc1, 0x6a,
-		0x2c, 0xcd, 0x04, 0xcb, 0x01, 0xcb, 0x01, 0xfa, 0x01, 0xf4, 0x03, 0xe8, 0x07, 0xd0, 0x0f, 0xa0,
-		0x1f, 0xf8, 0x96, 0xad, 0x6f, 0x7e, 0x37, 0x42, 0x1e, 0x5f, 0xde, 0x13, 0xcf, 0x4f, 0x02, 0x27,
-		0x0c, 0x27, 0x0c, 0x27, 0x0c, 0x27, 0x0c, 0x27, 0x0c, 0x27, 0xbc, 0xd3, 0x4e, 0x38, 0x57, 0xad,
-		0x2f, 0xa6, 0x86, 0xfb, 0x38, 0x48, 0x6f, 0x3a, 0x0e, 0x13, 0x53, 0x12, 0x2c, 0xe9, 0xe0, 0xeb,
-		0xf0, 0xb0, 0x64, 0x96, 0x79, 0x3a, 0x3d, 0x2c, 0x51, 0x28, 0xa2, 0x09, 0x34, 0x15, 0x24, 0xd0,
-		0xd0, 0x62, 0x07, 0x24, 0xd0, 0x20, 0x81, 0x06, 0xe0, 0x19, 0xe0, 0x19, 0xe0, 0x19, 0xe0, 0x19,
-		0xe0, 0x19, 0xe0, 0x59, 0x89, 0xc1, 0x42, 0x02, 0x0d, 0x9c, 0x30, 0x9c, 0xb0, 0x4e, 0x11, 0x2f,
-		0x20, 0x81, 0x06, 0x09, 0x34, 0xb0, 0x5f, 0xb0, 0x5f, 0xa9, 0xda, 0x2f, 0x24, 0xd0, 0x4c, 0x9e,
-		0x05, 0x09, 0x34, 0xb0, 0x1c, 0xb0, 0x1c, 0xa0, 0x1f, 0x40, 0x3f, 0x80, 0x7e, 0x00, 0xfd, 0x90,
-		0xba, 0x3b, 0x46, 0x02, 0x0d, 0x9c, 0x30, 0x9c, 0x30, 0x9c, 0x30, 0x9c, 0x30, 0x9c, 0x30, 0x9c,
-		0x30, 0xa5, 0x13, 0xce, 0x73, 0x02, 0x8d, 0xc0, 0xc5, 0x44, 0x79, 0x6d, 0x25, 0xb6, 0xf8, 0xc6,
-		0x45, 0xae, 0x1c, 0x9f, 0x15, 0xed, 0xb9, 0x6e, 0xc3, 0x69, 0x5a, 0x8d, 0x60, 0x1a, 0x0d, 0x97,
-		0xc7, 0xb4, 0x1d, 0xdb, 0x77, 0x9d, 0x5e, 0x8f, 0xb9, 0x1e, 0x7f, 0x9b, 0xa7, 0xf9, 0x41, 0x68,
-		0xf6, 0x84, 0x66, 0x4f, 0xab, 0xc4, 0x49, 0xaa, 0xe1, 0xd3, 0x74, 0x2c, 0x9a, 0x3e, 0x25, 0x0a,
-		0xdb, 0x77, 0x3c, 0x67, 0x4d, 0xb0, 0x43, 0xd9, 0x2a, 0xc1, 0xe5, 0xef, 0x54, 0x26, 0x29, 0xbc,
-		0x88, 0x4d, 0x11, 0x9b, 0xca, 0xc4, 0xa6, 0xa2, 0xca, 0x10, 0x0d, 0x0c, 0x91, 0x88, 0xe2, 0x55,
-		0xdc, 0xc1, 0x2c, 0xb8, 0x43, 0x5b, 0x45, 0x71, 0xa8, 0x14, 0x88, 0x5c, 0x91, 0xc8, 0x15, 0x8a,
-		0x58, 0xb1, 0x14, 0x63, 0xbd, 0xd4, 0xef, 0xd1, 0xf6, 0x7c, 0xd7, 0xb2, 0x5f, 0x08, 0x2e, 0xd2,
-		0x2e, 0xbf, 0xc3, 0x55, 0xba, 0x32, 0xb1, 0xdb, 0x5c, 0x98, 0x33, 0xf7, 0xb3, 0x50, 0xfd, 0x87,
-		0x44, 0x64, 0x7f, 0x28, 0x04, 0x5c, 0x6c, 0x16, 0x50, 0x22, 0x9e, 0x12, 0x7a, 0x89, 0x26, 0x01,
-		0x84, 0x01, 0x84, 0xd9, 0x1e, 0x08, 0x33, 0x93, 0x6c, 0x75, 0x20, 0x33, 0x37, 0x97, 0x1a, 0x9c,
-		0x29, 0x03, 0xce, 0x00, 0xce, 0xe4, 0x07, 0xce, 0xc8, 0x2a, 0x5f, 0x34, 0x81, 0x39, 0xfc, 0x2e,
-		0x93, 0x7c, 0x1e, 0x2b, 0x7a, 0xe1, 0x7c, 0x87, 0x99, 0x38, 0xe2, 0x50, 0x55, 0x4a, 0x4a, 0xe5,
-		0xd4, 0xa1, 0xa4, 0xd4, 0xca, 0xaa, 0x4d, 0x69, 0xb5, 0x29, 0xaf, 0x26, 0x25, 0x56, 0x53, 0x66,
-		0x45, 0xa5, 0xa6, 0x8b, 0x55, 0x96, 0x64, 0xae, 0xc7, 0xcc, 0xae, 0xcb, 0xba, 0x14, 0x22, 0x37,
-		0xf5, 0x97, 0xa7, 0x04, 0x73, 0xdd, 0x85, 0xa0, 0xff, 0xe8, 0x28, 0x44, 0xef, 0xc7, 0xa1, 0x19,
-		0xd9, 0x4b, 0x67, 0xf3, 0x54, 0x8e, 0x78, 0x25, 0xd9, 0x4a, 0x5a, 0xf6, 0x92, 0x18, 0xdd, 0xc0,
-		0xa0, 0xc2, 0xa0, 0x6e, 0x83, 0x41, 0x55, 0x45, 0x4b, 0x33, 0xd4, 0x24, 0x78, 0x73, 0x27, 0x3f,
-		0x7c, 0x92, 0xbe, 0x7f, 0x4e, 0x23, 0x8e, 0x22, 0x57, 0x7f, 0x1d, 0x66, 0x40, 0xa7, 0x39, 0xd0,
-		0x65, 0x16, 0xb4, 0x9b, 0x07, 0xed, 0x66, 0x42, 0xb3, 0xb9, 0xa0, 0x31, 0x1b, 0x44, 0xe6, 0x83,
-		0x1e, 0x97, 0x2d, 0xc9, 0xac, 0xd4, 0x95, 0xaa, 0xdc, 0xce, 0xff, 0x8c, 0x70, 0x4e, 0xa9, 0x2b,
-		0x59, 0x37, 0xfd, 0xa1, 0xd5, 0xaa, 0x02, 0xe1, 0x95, 0xae, 0xdc, 0x6b, 0xfc, 0x4e, 0xc3, 0xdc,
-		0xaa, 0x25, 0xa8, 0x1b, 0xbf, 0x20, 0xeb, 0x57, 0xc6, 0x6e, 0xfa, 0xd3, 0x24, 0x9d, 0x71, 0x74,
-		0x98, 0x23, 0xa1, 0xae, 0x43, 0xa8, 0xd7, 0x0b, 0x35, 0xae, 0xc4, 0xcd, 0xc5, 0x95, 0xb8, 0x09,
-		0xab, 0xf8, 0x5e, 0xb6, 0x9e, 0x4b, 0xfd, 0x79, 0x08, 0x8c, 0x16, 0x15, 0x17, 0xbc, 0x1c, 0xd4,
-		0x50, 0x70, 0xc2, 0x88, 0x69, 0x10, 0xd3, 0x20, 0xa6, 0xc9, 0x61, 0x4c, 0x63, 0x0e, 0xbf, 0x5b,
-		0x3d, 0xcb, 0x74, 0x5f, 0xe9, 0x6c, 0xc0, 0xbc, 0x1d, 0xa8, 0x11, 0x4e, 0xa9, 0x56, 0x72, 0x95,
-		0x20, 0x00, 0xa4, 0x28, 0xd1, 0x4a, 0xc8, 0xc0, 0x2e, 0x4d, 0x4f, 0x54, 0xd2, 0x15, 0x3b, 0x3f,
-		0x61, 0x19, 0x52, 0x42, 0x11, 0x42, 0x81, 0xaa, 0x34, 0x2c, 0xed, 0x2d, 0x2d, 0xd7, 0x72, 0xbc,
-		0xa7, 0x00, 0x98, 0xda, 0x01, 0x66, 0x9b, 0xb9, 0xbe, 0xd5, 0xb5, 0xda, 0xa6, 0xcf, 0xb4, 0x00,
-		0xcd, 0x37, 0xf3, 0x03, 0x70, 0x02, 0x70, 0x02, 0x70, 0xee, 0x1c, 0xe0, 0x54, 0x4e, 0xcc, 0x8e,
-		0xe7, 0xc1, 0xb6, 0xc8, 0x16, 0x0f, 0x1c, 0xd7, 0xa7, 0xb7, 0xc0, 0xc1, 0xac, 0x44, 0x12, 0xf2,
-		0x91, 0x75, 0xcd, 0x61, 0x2f, 0x50, 0x86, 0x7a, 0xbd, 0x76, 0x02, 0x73, 0x0e, 0x73, 0x0e, 0x73,
-		0xbe, 0x7b, 0xe6, 0x7c, 0x6c, 0x52, 0x0c, 0x7b, 0xd8, 0x7f, 0xe6, 0x2e, 0x3c, 0x16, 0x31, 0x03,
-		0x75, 0xd0, 0x07, 0xa0, 0x0f, 0x40, 0x1f, 0x64, 0x62, 0x4b, 0xeb, 0xb5, 0xda, 0x09, 0x18, 0x04,
-		0x30, 0x08, 0xeb, 0x50, 0xab, 0x6b, 0x39, 0xae, 0xe5, 0xbf, 0x6a, 0x40, 0xae, 0xd3, 0x99, 0x01,
-		0x33, 0x01, 0x33, 0x01, 0x33, 0x77, 0x0e, 0x66, 0x0e, 0x2d, 0xdb, 0x7f, 0x87, 0xf3, 0x29, 0x00,
-		0x4c, 0x00, 0xcc, 0xad, 0x05, 0x98, 0x95, 0x1a, 0xe0, 0x25, 0xe0, 0xe5, 0x9a, 0x6d, 0xf4, 0x02,
-		0x90, 0x31, 0xb9, 0xd3, 0xb0, 0x6b, 0x52, 0x16, 0x00, 0x45, 0xe4, 0xf4, 0xdb, 0x6f, 0x00, 0xdc,
-		0x04, 0xdc, 0x04, 0xdc, 0xdc, 0x39, 0xb8, 0xf9, 0x6c, 0x7a, 0x73, 0x56, 0xc0, 0xa0, 0x29, 0xca,
-		0x7d, 0x6b, 0x0d, 0x28, 0x8a, 0x73, 0x67, 0x1a, 0x1c, 0x75, 0xe6, 0x69, 0x1b, 0x56, 0xf7, 0x7d,
-		0xf4, 0xec, 0xde, 0xdb, 0x5f, 0x84, 0x7f, 0x0f, 0x7a, 0x8b, 0x6d, 0x91, 0x6f, 0xf0, 0x5d, 0xd3,
-		0xf6, 0xf4, 0x9c, 0x9a, 0xcd, 0xa6, 0xa6, 0x3f, 0x3a, 0x7b, 0x3c, 0xbf, 0x83, 0x8f, 0x81, 0x8f,
-		0x81, 0x8f, 0xd9, 0x3d, 0x1f, 0x43, 0x6d, 0x56, 0x0a, 0x8a, 0x57, 0x91, 0xc5, 0xce, 0x29, 0x77,
-		0x45, 0x99, 0x46, 0x77, 0x91, 0x6a, 0x95, 0xba, 0x62, 0x23, 0xbd, 0xe5, 0xa0, 0x86, 0xae, 0xb1,
-		0xde, 0xb4, 0x13, 0xdd, 0xdc, 0xcf, 0x52, 0xfd, 0xf6, 0xe8, 0x16, 0x5c, 0xa5, 0x55, 0xc7, 0xa4,
-		0xd1, 0x3d, 0x59, 0xa7, 0x8e, 0xc9, 0x74, 0x19, 0x6b, 0xd4, 0x51, 0x41, 0xa3, 0x8e, 0x4c, 0xf8,
-		0x4e, 0x34, 0xea, 0x10, 0xc2, 0xaf, 0x68, 0xd4, 0x01, 0x68, 0x0d, 0x68, 0x0d, 0x68, 0x9d, 0x55,
-		0x68, 0x8d, 0x46, 0x1d, 0xc4, 0x7f, 0xd0, 0xa8, 0x83, 0xe7, 0x0b, 0xd0, 0xa8, 0x43, 0x83, 0x99,
-		0x48, 0x46, 0xa8, 0xd1, 0xa8, 0x63, 0x83, 0x50, 0xa3, 0x51, 0x07, 0x1a, 0x75, 0x68, 0xc2, 0x13,
-		0x05, 0x34, 0xea, 0xe0, 0x0b, 0x6a, 0xd0, 0xa8, 0x03, 0x31, 0x0d, 0x62, 0x9a, 0x1d, 0x8d, 0x69,
-		0xd0, 0xa8, 0x03, 0x89, 0x90, 0xf3, 0xd3, 0x23, 0x11, 0x72, 0xf5, 0x96, 0xa2, 0x51, 0x47, 0xba,
-		0x7b, 0x0a, 0x80, 0xa9, 0x1d, 0x60, 0xa2, 0x51, 0x07, 0x00, 0x27, 0x00, 0x27, 0x00, 0x27, 0x1a,
-		0x75, 0x64, 0xc0, 0x16, 0x4f, 0x52, 0x2d, 0x98, 0x0e, 0x33, 0x1c, 0x4d, 0x0d, 0x0b, 0x0c, 0x0b,
-		0x0c, 0x0b, 0xbc, 0x73, 0x16, 0xf8, 0xd9, 0x71, 0x7a, 0xcc, 0xb4, 0x75, 0x98, 0xe0, 0x32, 0x7a,
-		0x25, 0x6d, 0x58, 0x7c, 0xf4, 0x4a, 0x82, 0x3d, 0x87, 0x3d, 0x87, 0x3d, 0x27, 0x36, 0x29, 0xe8,
-		0x95, 0x44, 0x2a, 0x98, 0x60, 0x70, 0xe3, 0xe7, 0x07, 0x83, 0x9b, 0xda, 0x96, 0xa2, 0x57, 0x92,
-		0x96, 0xd9, 0xd0, 0x2b, 0x89, 0xcb, 0xcd, 0xa0, 0x57, 0x12, 0x60, 0x26, 0x60, 0xe6, 0xce, 0xc2,
-		0x4c, 0xf4, 0x4a, 0x02, 0xc0, 0x04, 0xc0, 0xdc, 0x6e, 0x80, 0x89, 0x5e, 0x49, 0x80, 0x97, 0x6b,
-		0xb7, 0x11, 0xbd, 0x92, 0x00, 0x37, 0x01, 0x37, 0x01, 0x37, 0xf5, 0xc3, 0x4d, 0xf4, 0x4a, 0xca,
-		0x9b, 0x6f, 0x40, 0xaf, 0x24, 0xf8, 0x18, 0xf8, 0x18, 0xf8, 0x98, 0xfc, 0xf8, 0x18, 0xf4, 0x4a,
-		0x4a, 0x65, 0x86, 0x1d, 0xeb, 0x95, 0x34, 0x69, 0x11, 0x94, 0x56, 0xab, 0xa4, 0xbd, 0x04, 0x77,
-		0x68, 0x6c, 0x58, 0x95, 0x4b, 0x0a, 0x8b, 0x57, 0x96, 0xe7, 0x37, 0x7c, 0x5f, 0xad, 0x19, 0x4c,
-		0xf1, 0xda, 0xb2, 0x2f, 0x7a, 0x6c, 0x6c, 0x23, 0xbd, 0xe2, 0xfb, 0x82, 0x3d, 0xec, 0xf5, 0x14,
-		0x9a, 0x46, 0x5d, 0x9b, 0xdf, 0xe9, 0x26, 0xbb, 0x75, 0x3b, 0xcc, 0x65, 0x9d, 0x0f, 0xaf, 0xe1,
-		0x54, 0x89, 0xee, 0x10, 0x91, 0xee, 0x68, 0xd6, 0x99, 0xa2, 0x52, 0x83, 0x2f, 0x77, 0xd8, 0xf6,
-		0xed, 0xd0, 0xc4, 0x3f, 0x04, 0x8f, 0xd1, 0xba, 0x0d, 0x1f, 0xa3, 0x75, 0x1e, 0x7d, 0xf5, 0xf8,
-		0xc7, 0xe9, 0xd7, 0xed, 0x25, 0xa3, 0x5a, 0x62, 0x23, 0x04, 0xb7, 0x58, 0x75, 0x6b, 0x75, 0x6c,
-		0xa9, 0xd8, 0xc2, 0xf2, 0x2f, 0x8f, 0xc0, 0xd2, 0x14, 0x43, 0x49, 0x10, 0x5b, 0x90, 0x08, 0x22,
-		0x04, 0xa3, 0x05, 0x37, 0x42, 0x2e, 0x0c, 0x90, 0x86, 0xfb, 0x2a, 0xb0, 0x9e, 0x02, 0xbe, 0xab,
-		0xc2, 0x74, 0x32, 0x38, 0x4e, 0x06, 0xbb, 0x89, 0xe0, 0xb5, 0x5e, 0x75, 0x97, 0x86, 0xc5, 0xd1,
-		0x9e, 0xf7, 0x98, 0xd9, 0x95, 0xe3, 0x55, 0x54, 0xf8, 0x93, 0x88, 0x27, 0x39, 0x3a, 0x0a, 0xdb,
-		0x48, 0x1e, 0x8b, 0x33, 0x21, 0x7a, 0x4c, 0x85, 0x5c, 0x6f, 0x48, 0xa5, 0x5e, 0x90, 0x92, 0xbd,
-		0x1f, 0xa5, 0x7b, 0x3d, 0xc2, 0x58, 0xec, 0xa4, 0xb1, 0x90, 0xed, 0xad, 0x28, 0xe7, 0x3d, 0x29,
-		0xbc, 0x28, 0x11, 0xa9, 0xa6, 0x4c, 0xa2, 0x51, 0x90, 0x66, 0x94, 0x24, 0x19, 0x15, 0x29, 0x46,
-		0x4e, 0x82, 0x91, 0x93, 0x5e, 0xc4, 0x24, 0x57, 0xb2, 0x91, 0xb0, 0x32, 0x69, 0x45, 0x57, 0x30,
-		0xa9, 0x58, 0x20, 0x89, 0x30, 0x67, 0x75, 0x98, 0x23, 0x41, 0xeb, 0x08, 0xa0, 0x96, 0x3d, 0xc2,
-		0xa5, 0x9b, 0xd2, 0x32, 0x02, 0x56, 0x58, 0x8e, 0x84, 0x51, 0x22, 0x5d, 0x94, 0x48, 0x16, 0x39,
-		0x52, 0x85, 0x77, 0xfd, 0x24, 0x45, 0x8e, 0x4a, 0xd4, 0x8a, 0x42, 0x10, 0x96, 0x8f, 0xfd, 0xe0,
-		0x13, 0xdc, 0xcd, 0x62, 0xb8, 0xfe, 0x13, 0x1b, 0x16, 0x58, 0x74, 0x61, 0x95, 0x16, 0x74, 0xfd,
-		0x1b, 0xc7, 0xbf, 0xc7, 0xea, 0x7f, 0x89, 0x79, 0x33, 0xde, 0x37, 0x12, 0x7e, 0x93, 0x35, 0x32,
-		0xb0, 0x7e, 0xcf, 0x57, 0xbf, 0xf6, 0xf2, 0x4b, 0xad, 0x78, 0xa1, 0xe2, 0xc0, 0x75, 0xda, 0xcc,
-		0xf3, 0x58, 0x7c, 0x2b, 0xed, 0xb9, 0xec, 0xf0, 0xe9, 0x47, 0x63, 0x16, 0x66, 0x7d, 0x9c, 0xb3,
-		0x11, 0xa6, 0xf1, 0xc0, 0xb0, 0x19, 0xcc, 0x6a, 0x1b, 0xe3, 0xe7, 0x59, 0xb7, 0x66, 0x9c, 0x28,
-		0x4a, 0x18, 0x25, 0x09, 0xa3, 0xa0, 0x45, 0x94, 0x13, 0x3e, 0x38, 0x91, 0x30, 0x6e, 0x42, 0xfd,
-		0xd3, 0x5d, 0xdb, 0xbc, 0x0c, 0x6f, 0xb6, 0x79, 0xd3, 0x22, 0xf0, 0x05, 0xb5, 0xdc, 0x41, 0xac,
-		0x08, 0x06, 0x17, 0x10, 0x02, 0x59, 0x48, 0x2d, 0x0d, 0x9d, 0xa5, 0x21, 0xb2, 0x98, 0x90, 0xd0,
-		0xd8, 0x66, 0xde, 0x90, 0xb1, 0x38, 0x10, 0x68, 0x16, 0x34, 0x13, 0x24, 0xee, 0xf3, 0x21, 0xc1,
-		0x00, 0x50, 0x38, 0xe0, 0x93, 0x09, 0xf0, 0x24, 0x84, 0x4c, 0x35, 0x7e, 0x53, 0x8e, 0xd7, 0x94,
-		0xe3, 0x33, 0x39, 0x21, 0xd4, 0x83, 0x68, 0x85, 0xc3, 0x2b, 0x05, 0xd2, 0x53, 0x86, 0xec, 0x9c,
-		0x27, 0x39, 0x83, 0x40, 0xe1, 0x78, 0x2c, 0xf0, 0x54, 0x98, 0x8b, 0xc3, 0xa4, 0x89, 0x51, 0x99,
-		0x52, 0x14, 0xa6, 0x20, 0x75, 0x29, 0x4c, 0x59, 0x42, 0x31, 0x73, 0xa8, 0x98, 0xa2, 0x44, 0x63,
-		0xd1, 0x74, 0x5f, 0x3c, 0x79, 0xca, 0x3d, 0x18, 0xbd, 0x0b, 0xc7, 0x73, 0xc2, 0xe2, 0xbc, 0x2b,
-		0x84, 0xbb, 0xa8, 0xb8, 0x27, 0x43, 0x52, 0xa9, 0x1f, 0xce, 0x49, 0xd3, 0x7e, 0x92, 0x74, 0x9f,
-		0xe8, 0x1b, 0x2a, 0x65, 0xe9, 0x90, 0x64, 0xe7, 0x90, 0x64, 0xe5, 0xa8, 0x65, 0xe3, 0xe8, 0x39,
-		0x86, 0x6c, 0x0f, 0x86, 0xc6, 0xd0, 0x33, 0x5f, 0x58, 0xc8, 0x05, 0xc8, 0x9b, 0xc7, 0xa5, 0x99,
-		0x60, 0x2a, 0x61, 0x2a, 0xb7, 0xce, 0x54, 0xfa, 0x56, 0x9f, 0xf9, 0x56, 0xfb, 0xab, 0x57, 0xaf,
-		0x2a, 0xd8, 0x4b, 0x89, 0x6b, 0x54, 0x14, 0xeb, 0x8d, 0xd5, 0xb2, 0x1b, 0x09, 0x4e, 0x00, 0x49,
-		0xca, 0x15, 0xa8, 0xea, 0x81, 0x29, 0x4b, 0x44, 0x47, 0x6a, 0xb9, 0x9e, 0x99, 0x5b, 0xda, 0xf2,
-		0xbb, 0x6a, 0xb5, 0x7e, 0x5a, 0xad, 0x96, 0x4e, 0x4f, 0x4e, 0x4b, 0x67, 0xb5, 0x5a, 0xb9, 0xae,
-		0xda, 0xcc, 0x9b, 0x74, 0xb5, 0x13, 0x3a, 0x57, 0x6c, 0x66, 0xca, 0x37, 0x0f, 0x3d, 0xe6, 0x52,
-		0x78, 0xe6, 0x60, 0x1e, 0xf8, 0x65, 0xf8, 0x65, 0xf8, 0x65, 0xf8, 0x65, 0xf8, 0x65, 0xf8, 0x65,
-		0xf8, 0x65, 0x59, 0xbf, 0xec, 0x5b, 0x3d, 0xeb, 0x1f, 0xb9, 0xd4, 0xa0, 0x45, 0xc7, 0x3c, 0x37,
-		0x11, 0x3c, 0x33, 0x3c, 0xf3, 0xd6, 0x79, 0xe6, 0x01, 0x73, 0xdb, 0xcc, 0xf6, 0xcd, 0x17, 0xa6,
-		0xe0, 0x98, 0x6b, 0x70, 0xcc, 0x70, 0xcc, 0x59, 0x75, 0xcc, 0xa5, 0x12, 0xfc, 0x70, 0x1a, 0x7e,
-		0xb8, 0xcf, 0xfa, 0x8e, 0xfb, 0x3a, 0x09, 0x6d, 0xe5, 0x9d, 0xf0, 0xc2, 0x2c, 0xf0, 0xc0, 0xf0,
-		0xc0, 0x5b, 0xe7, 0x81, 0x87, 0x96, 0xed, 0x23, 0x2c, 0x86, 0xf7, 0x45, 0x58, 0x0c, 0x77, 0x9c,
-		0x80, 0x3b, 0xa6, 0x88, 0x8c, 0x57, 0xcc, 0x05, 0xd7, 0x0c, 0xd7, 0x8c, 0xe0, 0x18, 0xc1, 0x31,
-		0xdc, 0x33, 0x82, 0x63, 0x78, 0xe3, 0x8d, 0xcb, 0x86, 0x56, 0x34, 0xf0, 0xb8, 0xf0, 0xb8, 0xcb,
-		0x3b, 0x9e, 0x78, 0xae, 0xab, 0x16, 0xed, 0x1e, 0x48, 0x5c, 0x9a, 0x2e, 0x51, 0x0f, 0x05, 0xdd,
-		0x86, 0x6e, 0x83, 0xe8, 0x02, 0x92, 0x06, 0x92, 0x06, 0xd1, 0x05, 0x68, 0x3d, 0x5d, 0x36, 0xcf,
-		0x37, 0x5d, 0xdf, 0xf0, 0xad, 0xbe, 0x5a, 0xff, 0xb6, 0xe9, 0x1c, 0x70, 0xc5, 0x70, 0xc5, 0x70,
-		0xc5, 0x70, 0xc5, 0x70, 0xc5, 0x70, 0xc5, 0x70, 0xc5, 0x62, 0xae, 0x78, 0x38, 0x50, 0x73, 0xc3,
-		0xe1, 0x78, 0xb8, 0x60, 0xb8, 0x60, 0x94, 0x44, 0xc0, 0x0f, 0xc3, 0x0f, 0xc3, 0x0f, 0xc3, 0x0f,
-		0x0b, 0x7d, 0x32, 0x2b, 0x7d, 0x2d, 0xa3, 0xa6, 0x80, 0xd3, 0x9f, 0x44, 0x9a, 0xa6, 0xea, 0xee,
-		0x3d, 0x19, 0x36, 0x45, 0xdd, 0x4c, 0xbc, 0x8b, 0xb5, 0xba, 0x90, 0x6a, 0x6d, 0x21, 0xd5, 0xca,
-		0x42, 0xac, 0x75, 0x45, 0x5a, 0xad, 0x38, 0x97, 0x64, 0xa0, 0xc8, 0xd5, 0xbd, 0x6a, 0x45, 0x47,
-		0xcb, 0xbb, 0x70, 0x7c, 0x2e, 0xfb, 0x78, 0xce, 0xfa, 0x63, 0x2a, 0xf4, 0xe3, 0xf4, 0xbc, 0x2f,
-		0x86, 0xc7, 0xdc, 0x6f, 0x6b, 0x0a, 0x8f, 0x67, 0x64, 0xd6, 0xec, 0xb3, 0xd9, 0xe8, 0xc8, 0xe9,
-		0xbd, 0x7a, 0x86, 0xcf, 0xdc, 0x7e, 0x2e, 0xbb, 0x72, 0x46, 0x0f, 0x9f, 0x54, 0x67, 0xce, 0xf6,
-		0x74, 0xf5, 0x39, 0x1b, 0x73, 0x86, 0x9f, 0x27, 0xee, 0xcb, 0x59, 0xd2, 0xd3, 0x97, 0x93, 0x43,
-		0x14, 0x64, 0x03, 0x9d, 0xf4, 0x7b, 0x73, 0x6e, 0x16, 0x15, 0x1a, 0xff, 0xc5, 0xdd, 0x9f, 0x93,
-		0xd9, 0xe6, 0x73, 0x4f, 0xa2, 0x17, 0x60, 0x38, 0x8e, 0xb7, 0xf1, 0xdb, 0xec, 0x46, 0xd5, 0xf1,
-		0xda, 0x6d, 0x49, 0x73, 0x4f, 0x01, 0x49, 0x55, 0x0d, 0xcd, 0xb3, 0xd7, 0x47, 0x90, 0x5f, 0x92,
-		0xf5, 0xc0, 0x53, 0xf9, 0x26, 0x9f, 0xcf, 0x8e, 0xd3, 0x63, 0xa6, 0x2d, 0xd3, 0xe4, 0xb3, 0x9c,
-		0x60, 0x93, 0xce, 0x81, 0xeb, 0xf8, 0x4e, 0xdb, 0xe9, 0x19, 0xdf, 0x98, 0xeb, 0x89, 0xa0, 0xf0,
-		0xf9, 0x7e, 0xcc, 0x8b, 0x33, 0x88, 0x6b, 0xeb, 0x9f, 0x15, 0xe8, 0x2a, 0x74, 0x35, 0x3d, 0x5d,
-		0x65, 0xf6, 0xb0, 0xcf, 0x5c, 0xd1, 0x7a, 0x02, 0x99, 0x6b, 0x76, 0x05, 0xaf, 0xd1, 0xa5, 0xd1,
-		0x71, 0xd7, 0xf4, 0x99, 0xd1, 0xb3, 0xfa, 0x96, 0x2f, 0xae, 0xdd, 0x73, 0x63, 0xa1, 0xa2, 0x50,
-		0xd1, 0xd4, 0x54, 0x74, 0x68, 0xd9, 0x7e, 0xb9, 0x2e, 0xa1, 0x9d, 0x75, 0x81, 0x21, 0x72, 0x04,
-		0xb5, 0x5c, 0xef, 0x51, 0x85, 0x73, 0x13, 0xb5, 0xdb, 0xc8, 0x14, 0x09, 0x68, 0x0a, 0x2a, 0x74,
-		0x24, 0xd7, 0x69, 0x35, 0xf5, 0x25, 0xab, 0xd7, 0x6a, 0x27, 0xb5, 0x14, 0x97, 0x4d, 0x13, 0xa3,
-		0xdb, 0x4c, 0xb2, 0x29, 0x3c, 0xf3, 0xc6, 0x20, 0x51, 0xd6, 0x1d, 0x2d, 0x0e, 0x87, 0x47, 0x82,
-		0x47, 0x82, 0x47, 0x82, 0x47, 0x82, 0x47, 0x82, 0x47, 0x92, 0xf6, 0x48, 0xbe, 0xd5, 0x67, 0xce,
-		0x50, 0xc2, 0x17, 0x4d, 0x07, 0xc2, 0x0b, 0xc1, 0x0b, 0xc1, 0x0b, 0xc1, 0x0b, 0xc1, 0x0b, 0xc1,
-		0x0b, 0x49, 0x7c, 0x22, 0xad, 0xac, 0x88, 0xd9, 0xe9, 0xfc, 0x71, 0x78, 0x72, 0x2b, 0x9b, 0xd5,
-		0xb0, 0xf6, 0x5e, 0x50, 0x9e, 0x3b, 0xc0, 0x84, 0xee, 0xfe, 0xca, 0xca, 0xcd, 0x8e, 0x38, 0x41,
-		0x26, 0x10, 0x6e, 0x9c, 0x20, 0x03, 0xda, 0x01, 0xda, 0xad, 0xde, 0x39, 0x9c, 0x20, 0xf3, 0x6b,
-		0x2b, 0x4e, 0x90, 0xa1, 0xab, 0x69, 0xea, 0x2a, 0x4e, 0x90, 0x63, 0x97, 0x06, 0x27, 0xc8, 0x50,
-		0x51, 0x30, 0x25, 0x60, 0x4a, 0xc0, 0x94, 0x80, 0x29, 0xa1, 0xf0, 0x45, 0x38, 0x41, 0x86, 0x47,
-		0x82, 0x47, 0x82, 0x47, 0x82, 0x47, 0x82, 0x47, 0xca, 0x86, 0x47, 0xc2, 0x09, 0x32, 0xbc, 0x10,
-		0xbc, 0x10, 0xbc, 0x10, 0xbc, 0x10, 0xbc, 0x90, 0x26, 0x2f, 0x94, 0xf5, 0x13, 0x64, 0x8e, 0xa6,
-		0x0a, 0x59, 0x2d, 0x8b, 0xdf, 0x58, 0xa5, 0x5e, 0x88, 0x6d, 0x07, 0xf0, 0xe0, 0x7d, 0x79, 0x98,
-		0x8c, 0x54, 0xa9, 0xa8, 0x5f, 0x7b, 0x44, 0xce, 0x75, 0x34, 0xce, 0x5d, 0x47, 0x5f, 0x21, 0xab,
-		0xa3, 0xcf, 0x6b, 0x09, 0x7d, 0x62, 0xd5, 0xf3, 0xcf, 0x8e, 0xc3, 0xd9, 0x0c, 0x74, 0xfe, 0xc0,
-		0x8f, 0xab, 0xf7, 0x27, 0xa7, 0xd1, 0x4d, 0xa2, 0x86, 0x7e, 0x5b, 0x93, 0x1f, 0x74, 0xe7, 0x3d,
-		0x70, 0xc3, 0x35, 0xc9, 0xb6, 0x5d, 0x02, 0x6d, 0xba, 0x04, 0xb1, 0x99, 0x00, 0xc2, 0x94, 0xc1,
-		0x62, 0xb2, 0x4d, 0xf4, 0x24, 0xb1, 0x97, 0x0a, 0x78, 0x10, 0x69, 0x48, 0x28, 0x83, 0xb1, 0x54,
-		0x97, 0x42, 0xbd, 0x0d, 0x96, 0xd2, 0xea, 0x10, 0x41, 0x9f, 0xa6, 0x86, 0xac, 0xb4, 0xf6, 0xd0,
-		0x75, 0x99, 0xed, 0x1b, 0x1d, 0xd3, 0x67, 0x62, 0x26, 0x7a, 0x69, 0x24, 0x2c, 0x35, 0x2c, 0xf5,
-		0x9b, 0xf5, 0x1e, 0xcb, 0x86, 0x61, 0xda, 0x1d, 0xde, 0x36, 0xde, 0xb3, 0x1b, 0x44, 0x38, 0x3e,
-		0x7b, 0x67, 0xfa, 0x3e, 0x73, 0x6d, 0x6e, 0x73, 0x5d, 0xfc, 0xdf, 0xe7, 0x92, 0x71, 0xd6, 0xfc,
-		0x51, 0x1d, 0x3d, 0x3d, 0x19, 0x93, 0x1f, 0x2b, 0xf3, 0x3f, 0x3e, 0x4e, 0x7f, 0x78, 0xbf, 0xf4,
-		0xc3, 0xfe, 0xd3, 0xd3, 0x51, 0xf0, 0xf3, 0xff, 0x39, 0xf8, 0xe5, 0xbf, 0x9f, 0xff, 0x8f, 0xd1,
-		0x5c, 0xfa, 0xc4, 0xbf, 0x8a, 0xa9, 0xa8, 0x6f, 0xc7, 0xe9, 0x9b, 0x96, 0x6d, 0x70, 0x5d, 0x65,
-		0x34, 0xdb, 0x95, 0xb9, 0x41, 0x50, 0x5a, 0x28, 0xad, 0xb4, 0x78, 0x08, 0xab, 0xec, 0x15, 0xb3,
-		0x5f, 0x82, 0x20, 0x74, 0x2b, 0x00, 0x56, 0x19, 0x00, 0x6b, 0xba, 0x14, 0x95, 0xda, 0x49, 0x0e,
-		0xf1, 0x94, 0x0e, 0x27, 0xb3, 0xbf, 0xbf, 0xff, 0xd9, 0x34, 0xfe, 0x69, 0x18, 0xff, 0x2d, 0x19,
-		0x67, 0xad, 0xe6, 0xdc, 0x5f, 0x9e, 0x9e, 0x8c, 0x56, 0xf3, 0xe0, 0x47, 0xe9, 0xb0, 0x5e, 0x1e,
-		0x1d, 0xfc, 0x32, 0xfb, 0x7d, 0xf3, 0xe9, 0xe9, 0xe8, 0xe0, 0xdf, 0x32, 0xa3, 0x7e, 0x39, 0xf8,
-		0xf9, 0xf4, 0x74, 0x94, 0x8e, 0xe7, 0xf9, 0xe2, 0x78, 0xbe, 0x98, 0xdb, 0x89, 0x46, 0xc0, 0xe7,
-		0xc0, 0xe7, 0xc0, 0xe7, 0xc0, 0xe7, 0xc0, 0xe7, 0xc0, 0xe7, 0x08, 0xf9, 0x9c, 0x9e, 0xf3, 0x62,
-		0xd9, 0xc6, 0xb3, 0x69, 0xdb, 0xcc, 0xe5, 0xf7, 0x3b, 0x0b, 0xa3, 0xe0, 0x7b, 0xe0, 0x7b, 0xde,
-		0xac, 0x37, 0xf7, 0x7d, 0xa7, 0x9c, 0xf7, 0x9b, 0xca, 0xc9, 0x76, 0xdf, 0xf1, 0x3b, 0xc2, 0xa2,
-		0x3d, 0x3f, 0x08, 0x92, 0x0d, 0xc9, 0x4e, 0x4f, 0xb2, 0xd3, 0x3d, 0xb7, 0x8e, 0x3f, 0x72, 0xe7,
-		0x3b, 0x78, 0xf6, 0x59, 0xcf, 0x66, 0x3e, 0x77, 0x37, 0xf7, 0xc5, 0x8f, 0xa3, 0xa1, 0x3b, 0x1a,
-		0xba, 0xf3, 0x6c, 0x79, 0x92, 0x36, 0x16, 0xe5, 0xf8, 0xdb, 0x5b, 0x8e, 0xdf, 0x35, 0x7b, 0x1e,
-		0xea, 0xf1, 0x91, 0x28, 0xab, 0x1a, 0xb8, 0x6f, 0x7f, 0x3d, 0x3e, 0x6a, 0x75, 0xa1, 0x6a, 0xc8,
-		0x49, 0xe7, 0x18, 0x82, 0x9c, 0x74, 0xbd, 0xec, 0xa1, 0x04, 0xa5, 0xaa, 0x44, 0xad, 0x52, 0x2f,
-		0x19, 0x72, 0xd2, 0x95, 0x7d, 0x11, 0x6a, 0x75, 0xe1, 0x91, 0xe0, 0x91, 0xe0, 0x91, 0xe0, 0x91,
-		0xe0, 0x91, 0xb2, 0xe1, 0x91, 0x50, 0xab, 0x0b, 0x2f, 0x04, 0x2f, 0x04, 0x2f, 0x04, 0x2f, 0x04,
-		0x2f, 0xa4, 0xc9, 0x0b, 0x65, 0xb2, 0x56, 0x77, 0xe1, 0xf4, 0x0e, 0x0d, 0x9f, 0x71, 0xc2, 0x84,
-		0x13, 0x26, 0x9c, 0x30, 0x01, 0xde, 0x65, 0x0b, 0xde, 0xe1, 0x84, 0x09, 0xaa, 0x06, 0x55, 0x43,
-		0x24, 0x85, 0x48, 0x0a, 0x91, 0x14, 0x22, 0x29, 0x9c, 0x30, 0xc1, 0x23, 0xc1, 0x23, 0xc1, 0x23,
-		0xc1, 0x23, 0xc1, 0x23, 0xe5, 0xc8, 0x23, 0xe1, 0x84, 0x09, 0x5e, 0x08, 0x5e, 0x08, 0x5e, 0x08,
-		0x5e, 0x08, 0x5e, 0x48, 0x93, 0x17, 0xca, 0xc1, 0x09, 0x53, 0x8e, 0x1b, 0xc2, 0xf2, 0x14, 0xba,
-		0x15, 0x62, 0x7b, 0xc2, 0x3e, 0x06, 0xa3, 0x05, 0xdb, 0xc2, 0xee, 0xad, 0x79, 0xcf, 0x4d, 0xef,
-		0xc7, 0xfb, 0x5e, 0xc5, 0x95, 0xbd, 0x67, 0x97, 0xdf, 0x60, 0xf1, 0xa1, 0x67, 0x8f, 0x36, 0xf9,
-		0x29, 0x7c, 0xb8, 0xb8, 0x87, 0x2a, 0x5a, 0xde, 0xb9, 0xd3, 0x1f, 0xb8, 0xcc, 0xf3, 0x58, 0xe7,
-		0x21, 0x78, 0xb0, 0x25, 0x87, 0x5a, 0xb4, 0xbc, 0x5f, 0xcd, 0xaf, 0xec, 0xde, 0x71, 0x96, 0x9d,
-		0xed, 0xdb, 0x97, 0x29, 0xce, 0xff, 0xd3, 0xc2, 0xc3, 0x7e, 0x64, 0xdf, 0xac, 0x76, 0x28, 0x61,
-		0xa3, 0xbd, 0xd1, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xcf,
-		0x0c, 0xab, 0x2e, 0x99, 0x0e, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xfd, 0x6d, 0x57, 0xdb, 0x4a,
+		0xb2, 0x06, 0x0c, 0x7f, 0xf7, 0xaf, 0xa8, 0xa5, 0x35, 0x1f, 0x92, 0xf3, 0xe0, 0x60, 0x1b, 0x9b,
+		0x97, 0x7c, 0x99, 0x45, 0x80, 0x64, 0x73, 0x6f, 0xde, 0x6e, 0x60, 0xef, 0x3d, 0x73, 0x12, 0x86,
+		0x25, 0x64, 0x19, 0x74, 0x47, 0x96, 0x34, 0x92, 0x4c, 0xc2, 0xc9, 0xe6, 0xbf, 0x3f, 0x4b, 0x7e,
+		0xc3, 0x18, 0x8c, 0xd5, 0xdd, 0xd5, 0xb2, 0xda, 0xbe, 0xb4, 0xce, 0x99, 0x00, 0xdb, 0x6a, 0x4b,
+		0xdd, 0x75, 0x55, 0x5d, 0x55, 0x5d, 0x5d, 0xf5, 0xab, 0x42, 0x44, 0x64, 0x9d, 0xd8, 0x5d, 0xd7,
+		0xfa, 0x48, 0x56, 0xdb, 0xbd, 0xf7, 0x1c, 0xd7, 0x5a, 0x1b, 0xfc, 0xf5, 0x77, 0x2f, 0x68, 0x5b,
+		0x1f, 0xa9, 0x3e, 0xfc, 0x75, 0x2f, 0x0c, 0x3a, 0xde, 0xad, 0xf5, 0x91, 0x6a, 0xc3, 0x3f, 0xec,
+		0x7b, 0xb1, 0xf5, 0x91, 0x06, 0x43, 0x10, 0x11, 0x59, 0x37, 0xb1, 0xd7, 0xbe, 0x75, 0x93, 0x67,
+		0x7f, 0x7c, 0x36, 0xfe, 0xe8, 0x03, 0x6b, 0xcf, 0xff, 0xf3, 0xf3, 0x2f, 0x1a, 0xff, 0x79, 0xfa,
+		0x0b, 0xc7, 0xff, 0xe1, 0x2c, 0x76, 0x3b, 0xde, 0xcf, 0x17, 0x5f, 0xf3, 0xec, 0xab, 0xc2, 0xfb,
+		0xa4, 0x7a, 0x13, 0x5b, 0x6b, 0x2f, 0x3f, 0x71, 0x11, 0xf6, 0x62, 0xc7, 0x7d, 0xf5, 0xee, 0xc1,
+		0xd3, 0xb8, 0x0f, 0x3f, 0xc2, 0x38, 0x7b, 0x20, 0x2b, 0x1a, 0x7c, 0xd1, 0xda, 0xeb, 0x1f, 0xfc,
+		0xcd, 0x4e, 0x76, 0xe3, 0xdb, 0x5e, 0xd7, 0x0d, 0x52, 0xeb, 0x23, 0xa5, 0x71, 0xcf, 0x9d, 0xf1,
+		0xc1, 0x89, 0x4f, 0x8d, 0x9e, 0xeb, 0xc5, 0x07, 0x1f, 0x9f, 0xfd, 0xe5, 0x71, 0xea, 0x8d, 0xa7,
+		0xa7, 0x7a, 0x6a, 0xca, 0x67, 0xbf, 0xcc, 0xf3, 0x99, 0x9f, 0xf5, 0x26, 0xaf, 0x2f, 0xc0, 0xdc,
+		0x85, 0xc8, 0xb3, 0x20, 0xf9, 0x17, 0x26, 0xef, 0x02, 0x09, 0x2f, 0x94, 0xf0, 0x82, 0x09, 0x2d,
+		0xdc, 0xeb, 0x0b, 0x38, 0x63, 0x21, 0xe7, 0x2e, 0xe8, 0xe8, 0xb2, 0x9c, 0xd1, 0x9c, 0xcf, 0x99,
+		0x84, 0xd1, 0xb4, 0x0e, 0x3f, 0x3f, 0xe7, 0x85, 0xde, 0x5e, 0xe8, 0xdc, 0x0b, 0x2e, 0xb2, 0xf0,
+		0xe2, 0x02, 0x20, 0x2a, 0x08, 0xd2, 0x02, 0x21, 0x2d, 0x18, 0x52, 0x02, 0xf2, 0xb6, 0xa0, 0xcc,
+		0x11, 0x98, 0xdc, 0x82, 0x33, 0xba, 0xac, 0x60, 0x30, 0xdd, 0x39, 0xa7, 0x6e, 0xb4, 0x38, 0xfd,
+		0xbb, 0x72, 0xbe, 0xfc, 0x50, 0x98, 0x6a, 0x39, 0x3f, 0x9e, 0x57, 0xa8, 0x64, 0x84, 0x4b, 0x5e,
+		0xc8, 0x64, 0x85, 0x4d, 0x59, 0xe8, 0x94, 0x85, 0x4f, 0x49, 0x08, 0xf3, 0x09, 0x63, 0x4e, 0xa1,
+		0x1c, 0x5d, 0xd6, 0xe5, 0x43, 0xe4, 0xca, 0xad, 0x57, 0x92, 0xc6, 0x5e, 0x70, 0x2b, 0xb2, 0x5e,
+		0x23, 0x55, 0xb6, 0x5d, 0xe1, 0x79, 0x4f, 0x35, 0x58, 0xee, 0x06, 0x41, 0x98, 0xda, 0xa9, 0x17,
+		0x06, 0xf9, 0xd0, 0x99, 0x38, 0x77, 0x6e, 0xd7, 0x8e, 0xec, 0xf4, 0x2e, 0x7b, 0xfb, 0xf5, 0xc1,
+		0xb2, 0xf5, 0xc9, 0xd1, 0xfa, 0xf3, 0x7f, 0xd7, 0x87, 0x8a, 0xbd, 0x22, 0xf7, 0xec, 0x6f, 0x3c,
+		0xb7, 0xd5, 0xf1, 0xc3, 0x1f, 0xd5, 0xd4, 0xbe, 0xf1, 0x5f, 0xa1, 0x6c, 0x33, 0x17, 0x6a, 0xf2,
+		0x26, 0x66, 0x63, 0xd3, 0xd0, 0x64, 0x6c, 0xb2, 0x47, 0x4e, 0x96, 0xd3, 0xde, 0x0c, 0x5e, 0xad,
+		0x2c, 0x26, 0xe7, 0x49, 0x36, 0xc4, 0x0d, 0xcf, 0xc4, 0xbd, 0x62, 0xe6, 0xa7, 0x5e, 0x56, 0xf3,
+		0x93, 0x57, 0xec, 0x64, 0xc5, 0x4f, 0x59, 0x0c, 0x95, 0xc5, 0x51, 0x55, 0x2c, 0xf3, 0x2b, 0x67,
+		0x12, 0x30, 0x42, 0x79, 0xc5, 0xf5, 0x99, 0xd8, 0x26, 0xe2, 0xd3, 0x3e, 0x29, 0xb9, 0x89, 0xe8,
+		0x94, 0x8b, 0x09, 0xaf, 0xb4, 0x10, 0xab, 0x08, 0x33, 0x8b, 0x50, 0xab, 0x0a, 0x37, 0x9b, 0x90,
+		0xb3, 0x09, 0x3b, 0x97, 0xd0, 0x8b, 0x09, 0xbf, 0x20, 0x08, 0xa4, 0xc1, 0xf0, 0x0c, 0x14, 0xf2,
+		0xab, 0x35, 0x89, 0x0d, 0xd9, 0x85, 0x92, 0x83, 0x88, 0x32, 0x54, 0x38, 0x20, 0xc3, 0x0a, 0x1d,
+		0x2e, 0x08, 0xb1, 0x43, 0x89, 0x1d, 0x52, 0xdc, 0xd0, 0x92, 0x83, 0x98, 0x24, 0xd4, 0x94, 0x21,
+		0x37, 0xba, 0x2c, 0xaf, 0xad, 0xbe, 0xc6, 0x23, 0xc1, 0xf3, 0xda, 0xaa, 0x8b, 0x2b, 0xe6, 0xe5,
+		0x6b, 0x83, 0x23, 0x27, 0x2c, 0xb5, 0xc0, 0x93, 0x1b, 0xa6, 0xda, 0xe0, 0xaa, 0x0d, 0xb6, 0xba,
+		0xe0, 0xab, 0x06, 0x63, 0x45, 0x38, 0xcb, 0xc7, 0x36, 0xe6, 0x4a, 0x9d, 0xef, 0xda, 0x9d, 0xd8,
+		0xed, 0x70, 0xc8, 0xdc, 0xc8, 0x56, 0x6e, 0x31, 0x8c, 0x75, 0x36, 0x0c, 0x4a, 0x7c, 0xf8, 0xb0,
+		0x9e, 0xa4, 0x76, 0xea, 0xae, 0x7b, 0x6d, 0xab, 0xb2, 0x98, 0x75, 0x53, 0x58, 0x33, 0xab, 0xff,
+		0xec, 0x7c, 0x8a, 0x74, 0x30, 0x1c, 0x8f, 0x2e, 0xad, 0x43, 0x97, 0x12, 0x41, 0x97, 0xae, 0xae,
+		0x2e, 0x55, 0xa5, 0x48, 0xa3, 0xcb, 0xb2, 0x9d, 0x2c, 0xe4, 0x9a, 0xf0, 0x09, 0xc8, 0x48, 0x8a,
+		0x47, 0x03, 0x33, 0xad, 0x22, 0x0f, 0x89, 0x62, 0x57, 0x00, 0x3a, 0x14, 0x81, 0x56, 0x85, 0xa0,
+		0x4b, 0x31, 0x68, 0x57, 0x10, 0xda, 0x15, 0x85, 0x6e, 0x85, 0xc1, 0xa3, 0x38, 0x98, 0x14, 0x08,
+		0x3f, 0x29, 0x53, 0xdf, 0x90, 0x62, 0xde, 0xb0, 0xd2, 0xbf, 0x0e, 0x0c, 0x6b, 0x60, 0xdd, 0x3c,
+		0xa4, 0x6e, 0xd5, 0x09, 0x7b, 0x41, 0xca, 0xb6, 0x0a, 0x4f, 0x29, 0x2b, 0x4f, 0x63, 0x43, 0x0b,
+		0x2b, 0xcf, 0x26, 0xb4, 0x30, 0x11, 0xb4, 0x30, 0xbb, 0x06, 0x20, 0xbd, 0x5a, 0xb8, 0xe7, 0x05,
+		0xe9, 0x66, 0x53, 0x83, 0x16, 0xde, 0x66, 0x1c, 0xf2, 0xdc, 0x0e, 0xfa, 0x29, 0x78, 0x5f, 0x59,
+		0x45, 0x88, 0x17, 0x52, 0x44, 0x44, 0xd6, 0xb1, 0x17, 0x58, 0x1f, 0x35, 0x0c, 0x4c, 0xfc, 0xca,
+		0x75, 0xfa, 0xb2, 0xfe, 0xb4, 0xfd, 0x9e, 0xab, 0x71, 0xfc, 0xcf, 0xf1, 0x80, 0xf2, 0xef, 0x7b,
+		0xb7, 0x5e, 0x9a, 0x64, 0x5f, 0xc4, 0xfe, 0x3d, 0x8f, 0x6b, 0x1a, 0x96, 0xd4, 0xfe, 0x69, 0xfc,
+		0x92, 0xd6, 0xb7, 0x9b, 0xcd, 0xcd, 0xad, 0x66, 0xb3, 0xb6, 0xb5, 0xb1, 0x55, 0xdb, 0x69, 0xb5,
+		0xea, 0x9b, 0xf5, 0x96, 0xc1, 0xab, 0x5c, 0x29, 0xe7, 0x68, 0x57, 0x4b, 0x44, 0x3b, 0x9d, 0x30,
+		0xfc, 0xee, 0xb9, 0xfc, 0x94, 0x73, 0x38, 0x2e, 0xe8, 0xa6, 0xf2, 0x4c, 0x82, 0x6e, 0x12, 0x81,
+		0x6e, 0xb2, 0x23, 0x9f, 0x40, 0x37, 0x41, 0x37, 0x09, 0x74, 0xb3, 0x40, 0xb8, 0x11, 0x81, 0x6e,
+		0x96, 0x79, 0x95, 0x41, 0x37, 0x75, 0xa3, 0xc0, 0x6a, 0xf7, 0xe2, 0xfc, 0xa9, 0xfd, 0x42, 0x06,
+		0x67, 0x3c, 0x32, 0x28, 0xa7, 0xf2, 0x5c, 0x82, 0x72, 0x12, 0x81, 0x72, 0xb2, 0xa3, 0x9f, 0x40,
+		0x39, 0x41, 0x39, 0x09, 0x94, 0xb3, 0x40, 0xb8, 0x11, 0x81, 0x72, 0x96, 0x79, 0x95, 0x41, 0x39,
+		0x75, 0xa3, 0xc0, 0xba, 0xb3, 0xe3, 0x76, 0x35, 0xf5, 0xba, 0x6e, 0xd8, 0xd3, 0xb0, 0xb5, 0xfe,
+		0x6c, 0x74, 0x50, 0x4f, 0xe5, 0xf9, 0x04, 0xf5, 0x24, 0x02, 0xf5, 0x64, 0xd7, 0x02, 0xa4, 0x9f,
+		0x7a, 0xd6, 0x37, 0x35, 0x50, 0xcf, 0x4d, 0x50, 0x4f, 0xde, 0x0b, 0xd4, 0xb3, 0x28, 0xb8, 0x2d,
+		0x1d, 0xf5, 0xdc, 0x6c, 0xb5, 0x36, 0xc0, 0x35, 0x89, 0xc0, 0x35, 0x67, 0x5d, 0x1c, 0xe7, 0x0d,
+		0x5f, 0xd8, 0x16, 0xe5, 0x73, 0x87, 0x44, 0xe0, 0x95, 0xe0, 0x95, 0x44, 0xe0, 0x95, 0xfc, 0x88,
+		0x27, 0xa4, 0xce, 0x2f, 0x7c, 0x0d, 0x2c, 0xaf, 0xed, 0xbb, 0xfa, 0x3c, 0xfc, 0x67, 0xa3, 0x43,
+		0x13, 0x2b, 0xcf, 0x27, 0x34, 0x31, 0x11, 0x34, 0x31, 0xbb, 0x16, 0x20, 0x78, 0xf8, 0xf0, 0xf0,
+		0x09, 0x1e, 0x7e, 0x81, 0x70, 0x23, 0x82, 0x87, 0x5f, 0xaa, 0x65, 0x85, 0x87, 0xaf, 0x5b, 0xec,
+		0xad, 0xae, 0x9d, 0x3a, 0x77, 0xfc, 0x24, 0x73, 0x30, 0x2c, 0xd8, 0xa5, 0xf2, 0x44, 0x82, 0x5d,
+		0x12, 0x81, 0x5d, 0xb2, 0xe3, 0x9e, 0xe0, 0xe7, 0x2f, 0x7c, 0x0d, 0xac, 0xc8, 0x76, 0xbe, 0xbb,
+		0xa9, 0xae, 0x43, 0xf2, 0xcf, 0x46, 0x87, 0x26, 0x56, 0x9e, 0x4f, 0x68, 0x62, 0x22, 0x68, 0x62,
+		0x76, 0x2d, 0x40, 0x48, 0x22, 0x85, 0x9f, 0x4f, 0xf0, 0xf3, 0x0b, 0x84, 0xdb, 0xd2, 0xf9, 0xf9,
+		0x48, 0x22, 0x25, 0x82, 0xdb, 0x2f, 0x70, 0x59, 0x51, 0xec, 0x85, 0xb1, 0x97, 0x3e, 0x68, 0xa0,
+		0x9d, 0xa3, 0x91, 0x41, 0x39, 0x95, 0xe7, 0x12, 0x94, 0x93, 0x08, 0x94, 0x93, 0x1d, 0xfd, 0x84,
+		0xad, 0x25, 0x50, 0x4e, 0x02, 0xe5, 0x2c, 0x10, 0x6e, 0x4b, 0x47, 0x39, 0xb1, 0xb5, 0xb4, 0xdc,
+		0x1c, 0x73, 0xa1, 0x45, 0xa0, 0x05, 0x5b, 0xe6, 0x31, 0xb6, 0xd4, 0x9b, 0xe8, 0x61, 0x37, 0xf1,
+		0x73, 0xff, 0xc7, 0xc1, 0x1f, 0x06, 0x85, 0xef, 0x17, 0x56, 0xf5, 0xbe, 0xd0, 0x76, 0x25, 0xbf,
+		0xbb, 0x0f, 0x4a, 0x09, 0xbb, 0xd6, 0x91, 0x97, 0xa4, 0xbb, 0x69, 0xaa, 0xd8, 0xf4, 0xe4, 0xd8,
+		0x0b, 0x0e, 0x7c, 0x37, 0x23, 0x66, 0x89, 0xf5, 0x91, 0x82, 0x9e, 0xef, 0xaf, 0x29, 0x0c, 0x66,
+		0xff, 0xe4, 0x1b, 0xec, 0x34, 0x6e, 0xbb, 0xb1, 0xdb, 0xfe, 0xf4, 0x30, 0x1c, 0xaa, 0xd0, 0xd5,
+		0x61, 0x02, 0x09, 0x27, 0x38, 0x2c, 0xa5, 0xa6, 0x0c, 0x71, 0xcf, 0x49, 0x87, 0xad, 0x69, 0xad,
+		0x4f, 0xfd, 0x6f, 0xbc, 0xfe, 0xec, 0x87, 0x3f, 0x2e, 0xb3, 0x2f, 0xe9, 0xff, 0x64, 0x55, 0x8a,
+		0xc1, 0x8c, 0xde, 0xbe, 0x5b, 0x8a, 0xeb, 0xc6, 0xb4, 0x5e, 0x56, 0x45, 0xcf, 0x8c, 0x08, 0xcc,
+		0x86, 0xcc, 0xe9, 0x02, 0xf9, 0x53, 0x04, 0x92, 0xc4, 0x08, 0x7d, 0xf5, 0xd0, 0x57, 0xaf, 0x52,
+		0x8c, 0x63, 0xcd, 0xd0, 0xfd, 0x47, 0xa5, 0xdb, 0x8f, 0x7a, 0x77, 0x1f, 0x3d, 0x5a, 0x42, 0xae,
+		0x5b, 0x8f, 0x52, 0x77, 0x1e, 0xf4, 0xe0, 0x84, 0xae, 0x28, 0x44, 0x57, 0x48, 0xf7, 0xe0, 0x54,
+		0x38, 0x98, 0xa7, 0x7e, 0x10, 0x4f, 0x31, 0xc6, 0x80, 0xfe, 0x9b, 0xda, 0x61, 0xc4, 0x0e, 0x27,
+		0x6e, 0x58, 0xc9, 0xc1, 0x4b, 0x12, 0x66, 0xea, 0xa6, 0xf9, 0xd5, 0x18, 0xf7, 0xb6, 0x8a, 0xc4,
+		0x0c, 0x21, 0xa4, 0x10, 0x42, 0x63, 0x0a, 0x61, 0xff, 0xaa, 0x94, 0x2a, 0x44, 0xcd, 0xbd, 0xdf,
+		0xc7, 0x1c, 0x82, 0xd6, 0x11, 0x9b, 0xe4, 0xd8, 0xcf, 0xe5, 0x0c, 0x29, 0xeb, 0x5a, 0x82, 0x46,
+		0xab, 0x55, 0xe2, 0x45, 0x58, 0x50, 0x44, 0xef, 0x0a, 0x31, 0x0d, 0x8e, 0x98, 0x86, 0x44, 0x54,
+		0xf6, 0xb1, 0xc2, 0xfb, 0xc9, 0x9c, 0x73, 0x26, 0x1c, 0x55, 0x95, 0x8b, 0xa2, 0x2a, 0x45, 0x4d,
+		0x95, 0xa2, 0xa4, 0x72, 0x51, 0xd1, 0xbc, 0xb3, 0x27, 0x29, 0x69, 0xca, 0x12, 0x66, 0xad, 0x55,
+		0x98, 0x42, 0x99, 0x56, 0x85, 0x47, 0xe6, 0x1e, 0x2b, 0x0a, 0xf3, 0x29, 0x3a, 0x8f, 0x72, 0xf3,
+		0x67, 0x55, 0xe4, 0x5e, 0xe0, 0x8d, 0x87, 0xb7, 0xbc, 0x28, 0x0f, 0xf5, 0x7f, 0x72, 0x72, 0xa2,
+		0xf9, 0x2c, 0x3a, 0xa7, 0xbb, 0x9f, 0xdb, 0x6f, 0x11, 0xf1, 0x4f, 0x9e, 0xf9, 0x21, 0x37, 0x71,
+		0x0e, 0x31, 0x13, 0x75, 0x36, 0xa4, 0x9d, 0x0a, 0x69, 0xe7, 0xe1, 0x85, 0x93, 0x70, 0x13, 0x5b,
+		0x9a, 0xc5, 0x39, 0xaf, 0x23, 0x6d, 0x39, 0xa3, 0x35, 0xcc, 0x39, 0x79, 0x4f, 0xfd, 0x5f, 0xfa,
+		0xf7, 0xe5, 0xd5, 0xf1, 0x42, 0xf1, 0x23, 0x61, 0x87, 0x58, 0xc6, 0x01, 0x16, 0x17, 0x34, 0x59,
+		0x81, 0x53, 0x16, 0x3c, 0x65, 0x01, 0x54, 0x12, 0xc4, 0xfc, 0x1a, 0x98, 0x04, 0xec, 0x96, 0x68,
+		0xa4, 0xc7, 0x0a, 0x6f, 0x92, 0x6a, 0x3b, 0xec, 0xda, 0x5e, 0x50, 0x55, 0xd9, 0x27, 0x79, 0x3e,
+		0xcc, 0x8a, 0x6c, 0x99, 0x08, 0x89, 0xb7, 0xaa, 0x98, 0xb3, 0x89, 0x3b, 0x9b, 0xd8, 0xb3, 0x88,
+		0xbf, 0x18, 0x0c, 0x04, 0xe1, 0xa0, 0x1e, 0x91, 0x79, 0x16, 0x89, 0xd9, 0x68, 0x28, 0xec, 0x95,
+		0xc8, 0x6c, 0x95, 0xa8, 0x85, 0x5e, 0xd4, 0x72, 0x21, 0x18, 0xa2, 0x8c, 0x2c, 0xfe, 0x3d, 0x57,
+		0x68, 0x85, 0xd3, 0x9b, 0x7f, 0x54, 0xcb, 0x0c, 0x29, 0xdd, 0xd4, 0x36, 0x1b, 0x3b, 0xcd, 0x9d,
+		0xcd, 0xad, 0xc6, 0x4e, 0xab, 0x44, 0x73, 0x5c, 0x50, 0xc4, 0xe2, 0xaa, 0x04, 0x7b, 0x8f, 0x99,
+		0xf5, 0x8c, 0x42, 0x2f, 0x48, 0x95, 0x6d, 0xf0, 0x78, 0x14, 0x98, 0x60, 0x22, 0x98, 0x60, 0x8d,
+		0xd8, 0x81, 0x09, 0xce, 0x71, 0xc1, 0x04, 0x6b, 0x33, 0x0f, 0x30, 0xc1, 0xfa, 0xe7, 0x78, 0x85,
+		0x4c, 0x70, 0x62, 0x77, 0x23, 0x3f, 0x2b, 0x26, 0x22, 0x9f, 0x01, 0x34, 0x1a, 0x01, 0xa6, 0x97,
+		0x08, 0xa6, 0x57, 0x23, 0x66, 0x60, 0x7a, 0x73, 0x5c, 0x30, 0xbd, 0xda, 0xcc, 0x02, 0x4c, 0xaf,
+		0xfe, 0x39, 0x5e, 0x21, 0xd3, 0x9b, 0xda, 0xf1, 0xad, 0x9b, 0x26, 0xc2, 0x72, 0x31, 0xd6, 0x4e,
+		0xa3, 0x01, 0x60, 0x78, 0x89, 0x60, 0x78, 0x35, 0x22, 0xa6, 0x78, 0xc3, 0x2b, 0x5d, 0xe1, 0x4e,
+		0xb2, 0x92, 0x9d, 0xe8, 0x1b, 0x2a, 0x1d, 0xbd, 0x63, 0x39, 0x72, 0xc7, 0x72, 0xd4, 0x4e, 0xed,
+		0x88, 0xdd, 0xa2, 0x52, 0x7c, 0xf4, 0x27, 0xa9, 0xf4, 0xd3, 0x1a, 0xd6, 0x87, 0x9b, 0xd2, 0x5c,
+		0x09, 0x25, 0x6b, 0x39, 0xd2, 0x40, 0x84, 0x0e, 0x63, 0x48, 0x1d, 0xc2, 0x90, 0xde, 0x3c, 0x6f,
+		0x60, 0xf3, 0x9c, 0x53, 0x9d, 0x63, 0xf3, 0x1c, 0x9b, 0xe7, 0xa4, 0x5b, 0xcc, 0xd9, 0xc4, 0x9d,
+		0x4d, 0xec, 0x59, 0xc4, 0x5f, 0x0c, 0x06, 0x82, 0x70, 0x60, 0x64, 0x31, 0x08, 0x1f, 0xc8, 0xbe,
+		0x38, 0xc2, 0x07, 0x44, 0x08, 0x1f, 0x90, 0x5e, 0x85, 0x60, 0x76, 0xf8, 0x00, 0x9b, 0xe7, 0x52,
+		0xef, 0x0b, 0x13, 0x4c, 0x04, 0x13, 0x4c, 0x04, 0x13, 0xcc, 0x0b, 0xeb, 0x99, 0x76, 0x02, 0x26,
+		0x98, 0x08, 0x26, 0x78, 0x29, 0x4d, 0x30, 0x36, 0xcf, 0x45, 0xde, 0x15, 0xa6, 0x97, 0x08, 0xa6,
+		0x97, 0x08, 0xa6, 0x97, 0x19, 0xd6, 0x33, 0xed, 0x03, 0x4c, 0x2f, 0x11, 0x4c, 0xef, 0x52, 0x9a,
+		0x5e, 0x6c, 0x9e, 0xe7, 0x7f, 0x55, 0x18, 0xde, 0xd5, 0x32, 0xbc, 0xd8, 0x3c, 0x27, 0xc2, 0xe6,
+		0xf9, 0x82, 0x37, 0xcf, 0x05, 0x4a, 0x86, 0x98, 0x5b, 0x8c, 0x21, 0x4f, 0xf9, 0x03, 0x9a, 0x59,
+		0xb3, 0xe2, 0xb0, 0x7f, 0xb7, 0x86, 0x22, 0x0e, 0xc3, 0xef, 0xc9, 0x59, 0xc3, 0xa1, 0xff, 0xe9,
+		0x7c, 0x25, 0x1c, 0x6a, 0x28, 0xe1, 0xc0, 0x62, 0x96, 0x16, 0x50, 0xc2, 0x21, 0xb7, 0x59, 0x91,
+		0xa8, 0x93, 0x2a, 0x52, 0x17, 0x75, 0xb2, 0x0e, 0xea, 0x20, 0xc1, 0x66, 0xbd, 0x2f, 0x80, 0x3a,
+		0x60, 0xe0, 0xa6, 0xfd, 0xb2, 0xd9, 0xf9, 0x91, 0x30, 0xbc, 0x01, 0xf5, 0x4c, 0x50, 0xcf, 0x84,
+		0x08, 0xf5, 0x4c, 0x90, 0x92, 0x65, 0x4c, 0x4a, 0x56, 0x16, 0x44, 0xb8, 0x77, 0xab, 0xa9, 0xd7,
+		0x75, 0xc3, 0x5e, 0x2a, 0xef, 0x16, 0x4f, 0x8d, 0x23, 0x5a, 0x70, 0xd7, 0xed, 0xd8, 0x3d, 0xbf,
+		0x3f, 0x7f, 0x35, 0x78, 0xd6, 0x1a, 0x40, 0xc2, 0x06, 0x16, 0x36, 0xd0, 0xb0, 0x80, 0x47, 0x0c,
+		0x44, 0x82, 0x60, 0x12, 0xa7, 0x40, 0x33, 0xd7, 0x5b, 0x35, 0xa2, 0xbd, 0xb1, 0xaa, 0x11, 0xed,
+		0x3a, 0x53, 0xd8, 0xb5, 0x51, 0x6f, 0x6e, 0x35, 0xb7, 0x37, 0x36, 0x9b, 0xdb, 0x08, 0x6d, 0x2b,
+		0x6a, 0xce, 0xf9, 0x73, 0xbc, 0x85, 0xd0, 0x36, 0xd7, 0xf8, 0x22, 0xa1, 0x6d, 0x37, 0xb8, 0xf5,
+		0x02, 0x57, 0x29, 0xab, 0xeb, 0x69, 0x08, 0x18, 0x61, 0x22, 0x18, 0x61, 0x8d, 0xa8, 0x59, 0xcc,
+		0xbe, 0xf2, 0xb6, 0x82, 0x11, 0x6e, 0x61, 0x5b, 0x59, 0xcd, 0x40, 0x60, 0x5b, 0x59, 0xdb, 0xd4,
+		0x2a, 0x17, 0x71, 0x87, 0xd1, 0x95, 0x12, 0x8e, 0x91, 0xc5, 0x4c, 0x65, 0xb4, 0xd3, 0xb4, 0xd9,
+		0xed, 0x0f, 0x02, 0xc3, 0x4b, 0x04, 0xc3, 0xab, 0x11, 0x39, 0x30, 0xbc, 0xf3, 0x2f, 0x18, 0x5e,
+		0x6d, 0xb6, 0x01, 0x86, 0x57, 0xe3, 0xe4, 0x22, 0x91, 0x2b, 0xbf, 0x56, 0x42, 0x22, 0x17, 0x11,
+		0x0c, 0x2e, 0x12, 0xb9, 0x0c, 0x4f, 0xe4, 0x52, 0xec, 0xb6, 0x58, 0x97, 0x15, 0x48, 0x45, 0x64,
+		0x4c, 0xa3, 0xa3, 0xeb, 0x05, 0x55, 0x77, 0xf4, 0x56, 0x0a, 0x36, 0x92, 0x0b, 0x27, 0x2f, 0xb0,
+		0x52, 0x2f, 0xac, 0xcd, 0x37, 0xd2, 0xf2, 0x4a, 0x95, 0x96, 0x37, 0x4c, 0x6e, 0x41, 0x55, 0x1b,
+		0x54, 0xb5, 0xd1, 0xa7, 0x76, 0x90, 0x42, 0x81, 0x14, 0x0a, 0xcd, 0xe0, 0x50, 0x05, 0x09, 0x1b,
+		0x58, 0xd8, 0x40, 0xc3, 0x02, 0x1e, 0x31, 0x10, 0x09, 0x82, 0x89, 0x91, 0xd3, 0x22, 0x85, 0x42,
+		0xf2, 0xc5, 0x91, 0x42, 0x61, 0x50, 0x34, 0x09, 0x29, 0x14, 0xec, 0xe3, 0x23, 0x85, 0x82, 0x08,
+		0x46, 0x98, 0x08, 0x46, 0x18, 0x3b, 0x39, 0x0b, 0x33, 0xc2, 0xd8, 0xc9, 0x29, 0xbf, 0xed, 0xc5,
+		0x4e, 0x0e, 0xdf, 0xf8, 0x48, 0xa1, 0x20, 0x82, 0xe1, 0x25, 0x82, 0xe1, 0x85, 0xe1, 0x25, 0x82,
+		0xe1, 0x85, 0xe1, 0x25, 0x82, 0xe1, 0x2d, 0x99, 0xe1, 0x45, 0x0a, 0x45, 0xfe, 0x57, 0x85, 0xc1,
+		0x5d, 0x2d, 0x83, 0x8b, 0x14, 0x0a, 0x22, 0xa4, 0x50, 0x20, 0x85, 0x82, 0x08, 0x29, 0x14, 0x6c,
+		0x29, 0x14, 0x2b, 0x51, 0xdb, 0x28, 0x5f, 0x31, 0x14, 0x9a, 0x59, 0xdd, 0xe8, 0x64, 0x78, 0xbf,
+		0x86, 0xc2, 0x2e, 0x89, 0x58, 0x59, 0x97, 0x04, 0x45, 0x5d, 0x50, 0xd4, 0xe5, 0xe9, 0x42, 0x51,
+		0x17, 0x64, 0x24, 0xe5, 0xbc, 0x16, 0x9d, 0x91, 0x74, 0x3b, 0x78, 0x6e, 0xd9, 0x44, 0xa4, 0xfe,
+		0xed, 0x70, 0xea, 0xf8, 0xc5, 0x9a, 0x4d, 0xbc, 0xd9, 0xc4, 0x9c, 0x45, 0xdc, 0xc5, 0xc4, 0x5e,
+		0x50, 0xfc, 0x4d, 0x76, 0xea, 0xb4, 0x84, 0x6d, 0xee, 0x5c, 0xbb, 0xed, 0xc6, 0xf2, 0xf0, 0x1e,
+		0xde, 0x0f, 0x7c, 0x03, 0xdf, 0x4b, 0xb8, 0x4b, 0x82, 0xce, 0x01, 0x05, 0xc0, 0x7a, 0x66, 0x2c,
+		0x1f, 0xdb, 0x24, 0x44, 0xe8, 0x1c, 0xb0, 0x94, 0xbb, 0x25, 0x51, 0xe8, 0xab, 0xf5, 0xec, 0x19,
+		0x0d, 0x00, 0xc3, 0x4b, 0x04, 0xc3, 0xab, 0x11, 0x31, 0x30, 0xbc, 0x39, 0x2e, 0x18, 0x5e, 0x6d,
+		0x46, 0x01, 0x86, 0x57, 0xff, 0x1c, 0xa3, 0x5b, 0x9e, 0x80, 0x7a, 0x42, 0xb7, 0x3c, 0x22, 0x98,
+		0x5e, 0x98, 0x5e, 0x22, 0x98, 0x5e, 0x98, 0x5e, 0x22, 0x98, 0x5e, 0x98, 0xde, 0x3c, 0x17, 0x32,
+		0x04, 0x05, 0x5e, 0x15, 0x86, 0x77, 0xb5, 0x0c, 0x2f, 0x32, 0x04, 0x89, 0x90, 0x21, 0x88, 0x0c,
+		0x41, 0x22, 0x64, 0x08, 0x2a, 0x27, 0xcd, 0x25, 0x28, 0xb1, 0x44, 0x44, 0x84, 0x12, 0x4b, 0xfa,
+		0x94, 0x0e, 0x12, 0x9a, 0xc0, 0x41, 0xd9, 0xc5, 0x9a, 0x4d, 0xbc, 0xd9, 0xc4, 0x9c, 0x45, 0xdc,
+		0xc5, 0xc4, 0x5e, 0x50, 0xfc, 0x4d, 0xe6, 0xa0, 0x48, 0x68, 0x02, 0xbe, 0x81, 0x6f, 0x22, 0x04,
+		0x77, 0x67, 0x5d, 0x08, 0xee, 0x6a, 0x0b, 0x3c, 0x22, 0xb8, 0xab, 0x7f, 0x8e, 0x91, 0xd0, 0x94,
+		0x5f, 0x3b, 0x21, 0xa1, 0x89, 0x08, 0x86, 0x17, 0x86, 0x97, 0x08, 0x86, 0x17, 0x86, 0x97, 0x08,
+		0x86, 0x17, 0x86, 0x37, 0xc7, 0x85, 0x84, 0x26, 0xa1, 0x77, 0x85, 0xe9, 0x25, 0x82, 0xe9, 0x25,
+		0x82, 0xe9, 0x65, 0x86, 0xf5, 0x4c, 0xfb, 0x00, 0xd3, 0x4b, 0x04, 0xd3, 0xbb, 0x94, 0xa6, 0x17,
+		0x09, 0x4d, 0xf9, 0x5f, 0x15, 0x86, 0x77, 0xb5, 0x0c, 0x2f, 0x12, 0x9a, 0x88, 0x90, 0xd0, 0x84,
+		0x84, 0x26, 0x22, 0x24, 0x34, 0x31, 0x25, 0x34, 0xad, 0x44, 0xc1, 0xb3, 0x44, 0xa9, 0xdc, 0xd9,
+		0x85, 0xb6, 0x62, 0x67, 0xb9, 0x52, 0xbb, 0x84, 0x52, 0xba, 0x84, 0x8b, 0x9d, 0x35, 0x50, 0xec,
+		0x2c, 0xb7, 0xaa, 0x2c, 0x59, 0xb1, 0xb3, 0xa1, 0x98, 0x0a, 0x26, 0x06, 0xf6, 0xef, 0x12, 0xcb,
+		0x0b, 0xac, 0xa1, 0xd0, 0x19, 0xf2, 0x02, 0x89, 0xa4, 0x38, 0xac, 0x3c, 0x77, 0x15, 0xe4, 0xac,
+		0x06, 0x5b, 0xa7, 0xf9, 0x26, 0xf8, 0xb1, 0x22, 0xf6, 0x5f, 0x66, 0xbc, 0x4c, 0x26, 0xca, 0x73,
+		0x74, 0x40, 0x3e, 0x0e, 0x3f, 0xc5, 0xd5, 0xdf, 0x26, 0x66, 0x42, 0x2c, 0x2e, 0x1f, 0x5b, 0x9b,
+		0xf5, 0x7e, 0x39, 0x17, 0x29, 0xff, 0xe2, 0x58, 0x6b, 0x15, 0x21, 0xb2, 0x60, 0x55, 0xf2, 0x2d,
+		0xd2, 0x63, 0xe5, 0x8d, 0xd7, 0x99, 0xf7, 0x1a, 0x39, 0x1e, 0xdf, 0xaa, 0xbc, 0xfe, 0x6d, 0x13,
+		0xdf, 0x64, 0x39, 0x61, 0x37, 0x0a, 0x83, 0x57, 0xdd, 0xad, 0x89, 0xc2, 0x98, 0xe3, 0xcf, 0xac,
+		0x55, 0x5e, 0x45, 0xe7, 0x5a, 0x25, 0x97, 0x0d, 0x78, 0x4b, 0xd7, 0x3f, 0xe9, 0x74, 0xa7, 0x1a,
+		0xf9, 0x76, 0xda, 0x09, 0xe3, 0xee, 0x2b, 0x13, 0x3f, 0x4f, 0x81, 0xe7, 0x56, 0xd4, 0xb9, 0x15,
+		0xf2, 0x73, 0xc5, 0x3b, 0xf1, 0x70, 0x82, 0xeb, 0x39, 0xcb, 0xa4, 0x3f, 0x4d, 0xef, 0xec, 0x97,
+		0x7a, 0xb1, 0x12, 0xb3, 0x5e, 0xea, 0x6d, 0xe6, 0x37, 0xd7, 0x38, 0xe7, 0x31, 0xc6, 0x39, 0x17,
+		0x4a, 0xd4, 0xe2, 0x0a, 0x5b, 0x58, 0x61, 0x8b, 0x9a, 0x7f, 0x21, 0xe5, 0xf4, 0xea, 0x3c, 0xce,
+		0x66, 0xdd, 0xd8, 0xce, 0xf7, 0xc8, 0xb7, 0x03, 0x01, 0xba, 0xff, 0x74, 0x8b, 0x09, 0xf5, 0x8d,
+		0x73, 0x09, 0x84, 0xa8, 0x60, 0x48, 0x0b, 0x88, 0xb4, 0xa0, 0xc8, 0x0b, 0xcc, 0x7c, 0x53, 0x4d,
+		0xa8, 0x74, 0xac, 0x20, 0x6a, 0x8a, 0x22, 0x27, 0x2b, 0x7a, 0xca, 0x22, 0xa8, 0x2c, 0x8a, 0xea,
+		0x22, 0x99, 0x4f, 0x34, 0x73, 0x8a, 0x68, 0x5e, 0x92, 0x32, 0xeb, 0x7a, 0x41, 0x5e, 0x22, 0x37,
+		0x18, 0x08, 0xe5, 0xf8, 0x8d, 0xd6, 0x9f, 0x58, 0xc7, 0xd3, 0x8f, 0xeb, 0x63, 0x7d, 0x88, 0x43,
+		0x78, 0xc5, 0x1f, 0xc2, 0x03, 0xd6, 0x56, 0x14, 0x6b, 0x4b, 0x14, 0x1f, 0x16, 0x7e, 0x79, 0xf1,
+		0x88, 0xf1, 0xde, 0x68, 0x90, 0xeb, 0x4f, 0xe3, 0x41, 0x34, 0x04, 0x8e, 0x9d, 0x3b, 0x3b, 0x49,
+		0xbc, 0x24, 0x3f, 0x97, 0x1c, 0xdd, 0x00, 0x26, 0x09, 0x26, 0xf9, 0x42, 0x98, 0xc0, 0x24, 0x61,
+		0xdd, 0x56, 0xca, 0xba, 0x0d, 0xb5, 0x21, 0x78, 0x24, 0x78, 0x24, 0x90, 0x56, 0x08, 0xd2, 0x56,
+		0x90, 0x45, 0xe6, 0xa3, 0x5c, 0xf4, 0x16, 0x87, 0xdc, 0x1b, 0x0e, 0xa1, 0x83, 0x41, 0xe6, 0x33,
+		0xfa, 0x62, 0xc6, 0x1e, 0xfc, 0x51, 0x83, 0x2a, 0x29, 0x3d, 0x7f, 0x5c, 0xf1, 0x34, 0x04, 0x58,
+		0xb4, 0x85, 0x5a, 0x34, 0x24, 0x24, 0x68, 0x37, 0x64, 0x39, 0x58, 0xb2, 0xa4, 0x11, 0x8a, 0x7a,
+		0x02, 0x16, 0x28, 0xea, 0xc1, 0xfc, 0xc0, 0xfc, 0xbc, 0x14, 0x22, 0x84, 0x2f, 0x60, 0x82, 0x56,
+		0xcb, 0xa9, 0x8a, 0x7a, 0x08, 0x5d, 0x20, 0x74, 0x01, 0x94, 0x69, 0x47, 0xd9, 0x2a, 0x86, 0x2d,
+		0xe6, 0xd2, 0x2c, 0x7a, 0x33, 0x64, 0x11, 0xf5, 0x74, 0x30, 0xc5, 0x8e, 0x7d, 0x13, 0x7b, 0x4e,
+		0x7e, 0xb2, 0x38, 0xfc, 0x3c, 0xf8, 0x22, 0xf8, 0xe2, 0xd4, 0x05, 0xbe, 0x08, 0x4b, 0xb6, 0x62,
+		0x96, 0x6c, 0xa0, 0x0c, 0x41, 0x19, 0x41, 0x19, 0x01, 0xb4, 0x22, 0x80, 0xb6, 0x82, 0xac, 0x31,
+		0x17, 0xdf, 0xa2, 0xb7, 0x88, 0xe3, 0xe7, 0xc1, 0x08, 0x5a, 0xb8, 0x63, 0x20, 0x42, 0x1c, 0x03,
+		0xb0, 0x46, 0xb0, 0xc6, 0xe9, 0x0b, 0xac, 0x11, 0xc6, 0x6c, 0xe5, 0x8c, 0x59, 0x00, 0xca, 0x08,
+		0xca, 0x08, 0x94, 0x69, 0x47, 0xd9, 0x4a, 0xf2, 0xc5, 0x40, 0x8d, 0x2c, 0x06, 0x3a, 0x98, 0xa2,
+		0x17, 0xa4, 0xee, 0x6d, 0x6c, 0xa7, 0x6e, 0xbb, 0xea, 0x78, 0xb1, 0xd3, 0xf3, 0xd2, 0xfc, 0xc4,
+		0xf1, 0x95, 0x7b, 0xc1, 0x23, 0xc1, 0x23, 0xa7, 0x2e, 0xf0, 0x48, 0x58, 0xb8, 0x15, 0xb3, 0x70,
+		0x2f, 0x15, 0x23, 0x68, 0x25, 0x68, 0x25, 0x40, 0x57, 0x34, 0xe8, 0x56, 0x90, 0x65, 0x0a, 0x73,
+		0x32, 0x7a, 0x8b, 0x74, 0x1e, 0x8e, 0x47, 0xdb, 0x1b, 0x0e, 0xa6, 0x81, 0x82, 0xe6, 0x4a, 0xa6,
+		0x16, 0x49, 0xa2, 0xce, 0x99, 0x3c, 0x0d, 0x9a, 0x69, 0x02, 0xcd, 0xcc, 0x9d, 0xec, 0x3c, 0x9e,
+		0x79, 0xdf, 0xb5, 0x3b, 0xb1, 0xdb, 0xc9, 0x33, 0xeb, 0x23, 0xfb, 0x95, 0xa3, 0x38, 0xbf, 0x75,
+		0x36, 0xc4, 0xe2, 0x87, 0x0f, 0x43, 0x63, 0xbe, 0xde, 0x17, 0x45, 0x0d, 0x80, 0x88, 0xc2, 0x58,
+		0xc0, 0x0b, 0xeb, 0x7f, 0x1a, 0x7e, 0x17, 0xfc, 0xae, 0xa9, 0x0b, 0x7e, 0x17, 0x28, 0xe0, 0x8a,
+		0x51, 0xc0, 0x4c, 0x15, 0xc2, 0xd3, 0x82, 0xa7, 0x05, 0x98, 0xe9, 0x87, 0xd9, 0x0a, 0xfa, 0x56,
+		0x39, 0x98, 0x16, 0xbd, 0xe5, 0x4d, 0x9d, 0x65, 0xf7, 0x6b, 0xe1, 0x8b, 0x3f, 0xdc, 0xb8, 0x9a,
+		0xf4, 0xa2, 0xc8, 0x7f, 0x10, 0xe1, 0x8d, 0x13, 0x77, 0x81, 0x3f, 0x82, 0x3f, 0x4e, 0x5d, 0xe0,
+		0x8f, 0x30, 0x6c, 0x2b, 0x67, 0xd8, 0x9e, 0x54, 0x22, 0x78, 0x24, 0x78, 0x24, 0xe0, 0x56, 0x1c,
+		0xdc, 0x56, 0x92, 0x4f, 0xe6, 0x66, 0x60, 0xf4, 0x36, 0xaf, 0xfc, 0xe1, 0xc6, 0x17, 0x83, 0x61,
+		0x74, 0xd0, 0xcb, 0x38, 0x8c, 0xdc, 0x38, 0xf5, 0x5c, 0x81, 0xe2, 0x8b, 0x13, 0xf7, 0x80, 0x5a,
+		0x82, 0x5a, 0xce, 0x10, 0xa9, 0x07, 0x71, 0x63, 0x37, 0xbe, 0x13, 0xf4, 0x12, 0xf6, 0x4e, 0x4e,
+		0x4c, 0x85, 0xc5, 0x75, 0x74, 0x89, 0x7a, 0x44, 0x2f, 0x16, 0x5c, 0xc8, 0x33, 0x92, 0x14, 0x61,
+		0x69, 0x51, 0x56, 0x11, 0x69, 0x26, 0xd1, 0x56, 0x15, 0x71, 0x36, 0x51, 0x67, 0x13, 0x79, 0x3e,
+		0xd1, 0x17, 0x83, 0x80, 0x20, 0x14, 0xa4, 0x21, 0x31, 0xba, 0xc4, 0x2a, 0xa2, 0xcd, 0x14, 0x1b,
+		0x81, 0x0a, 0x69, 0xb3, 0x60, 0x22, 0xd9, 0x13, 0x5b, 0x1a, 0x2e, 0x1c, 0xb0, 0x61, 0x86, 0x0f,
+		0x17, 0x8c, 0xd8, 0xe1, 0xc4, 0x0e, 0x2b, 0x7e, 0x78, 0xc9, 0xc1, 0x4c, 0x12, 0x6e, 0xe2, 0x49,
+		0x0e, 0xfc, 0x15, 0xde, 0x14, 0x2b, 0xbe, 0xa9, 0xcf, 0x9b, 0xc4, 0x9c, 0x59, 0xf7, 0xc3, 0xc6,
+		0xf5, 0x8a, 0xea, 0x66, 0x30, 0x0c, 0xf4, 0x0d, 0xf4, 0x0d, 0xf4, 0x8d, 0xa4, 0xe4, 0xf4, 0x82,
+		0x2c, 0x4c, 0xc2, 0xa0, 0x6e, 0x76, 0x14, 0xc6, 0x18, 0xbe, 0xce, 0x57, 0xa5, 0x35, 0x55, 0x93,
+		0x59, 0x62, 0x55, 0xc2, 0x4c, 0xca, 0x58, 0x51, 0x4c, 0x34, 0xcc, 0xcc, 0x4d, 0x18, 0xfa, 0xae,
+		0x1d, 0x70, 0x4e, 0x4d, 0x7d, 0x49, 0xa6, 0xc6, 0x0b, 0xd2, 0xcd, 0x26, 0xe3, 0xc4, 0x34, 0x19,
+		0x86, 0x3a, 0xb7, 0x83, 0x5b, 0x75, 0x64, 0xf1, 0x4d, 0xd6, 0x64, 0x2f, 0x56, 0x65, 0x33, 0x33,
+		0x53, 0xa2, 0xd6, 0x78, 0x87, 0xfd, 0x73, 0x48, 0x56, 0x76, 0x1a, 0x8d, 0x8d, 0x8d, 0xad, 0x46,
+		0x6d, 0x63, 0x73, 0xbb, 0xd5, 0xdc, 0xda, 0x6a, 0x6d, 0xd7, 0xb6, 0x99, 0xbf, 0xe9, 0x73, 0x6c,
+		0x3b, 0x59, 0xd8, 0x7a, 0xdf, 0xbb, 0xf5, 0xfa, 0x1d, 0x4e, 0x6b, 0x6c, 0xe3, 0x3f, 0xae, 0x31,
+		0x2e, 0x9e, 0xfd, 0x53, 0xdb, 0xe2, 0xd5, 0x0a, 0x5b, 0xbc, 0x2d, 0x83, 0x16, 0xaf, 0x52, 0x8e,
+		0x51, 0xae, 0x96, 0x44, 0x55, 0xf7, 0xb8, 0x75, 0xf5, 0x36, 0x74, 0x75, 0xb9, 0xe0, 0x5e, 0x83,
+		0x66, 0x36, 0x65, 0xa9, 0xea, 0xdb, 0xcd, 0xe6, 0xe6, 0x56, 0xb3, 0x59, 0xdb, 0xda, 0xd8, 0xaa,
+		0xed, 0xb4, 0x5a, 0xf5, 0xcd, 0x7a, 0x0b, 0xaa, 0x79, 0x55, 0x55, 0x73, 0xdb, 0x75, 0xbc, 0xae,
+		0xed, 0xb3, 0x6a, 0xe7, 0x7a, 0x63, 0xad, 0xc2, 0x2f, 0x43, 0x0d, 0xe8, 0x7c, 0xb9, 0xe5, 0x68,
+		0x40, 0xe7, 0x9b, 0xa2, 0xf3, 0x37, 0xb0, 0x54, 0x4b, 0xa2, 0xe0, 0x2b, 0xc5, 0x7e, 0xef, 0x63,
+		0xc9, 0xf6, 0x45, 0x25, 0x53, 0xe3, 0xd4, 0x53, 0xc4, 0xc6, 0x79, 0x54, 0xa3, 0x1f, 0xc5, 0x12,
+		0x53, 0xc5, 0x67, 0x47, 0x60, 0x66, 0xe4, 0x36, 0x7d, 0x55, 0x36, 0x7b, 0x25, 0xb9, 0x24, 0x72,
+		0x21, 0x90, 0x0b, 0x21, 0x8e, 0x79, 0xe9, 0x4d, 0x12, 0x89, 0x93, 0xe8, 0x2a, 0x27, 0xd3, 0x5f,
+		0x0a, 0xad, 0xc4, 0x49, 0xf5, 0x62, 0x14, 0x86, 0x58, 0x86, 0xfb, 0x8b, 0xf9, 0x14, 0xc9, 0x74,
+		0x7f, 0x31, 0x93, 0xb2, 0x2a, 0xa3, 0x01, 0x95, 0x01, 0x95, 0xa1, 0x3b, 0x7d, 0x6a, 0x80, 0xd3,
+		0x5e, 0x6c, 0xdf, 0xf8, 0x0c, 0x79, 0x0d, 0xcf, 0x46, 0x43, 0x7a, 0x03, 0xd2, 0x1b, 0x16, 0x06,
+		0x37, 0x39, 0xd8, 0x49, 0xc2, 0x4f, 0xdd, 0x72, 0x6b, 0xd8, 0xaf, 0x56, 0xdc, 0xa7, 0x2e, 0x26,
+		0x9f, 0x0a, 0xd9, 0x9b, 0x50, 0x37, 0x50, 0x37, 0xc8, 0xde, 0x44, 0xf6, 0x26, 0xf4, 0x0d, 0xf4,
+		0x0d, 0x11, 0xb2, 0x37, 0x89, 0x90, 0xbd, 0xa9, 0x3c, 0x22, 0xb2, 0x37, 0x35, 0xb1, 0xe2, 0xf2,
+		0x4d, 0x0d, 0xb2, 0x37, 0x4b, 0xb2, 0x3b, 0x8c, 0xec, 0x4d, 0x7e, 0x9c, 0x14, 0xb6, 0x5f, 0x8c,
+		0xec, 0x4d, 0x36, 0x72, 0xc1, 0x3f, 0x0a, 0xb2, 0x37, 0x67, 0x48, 0x2d, 0xb2, 0x37, 0x4b, 0x06,
+		0x77, 0x64, 0xf2, 0x10, 0x21, 0x7b, 0xb3, 0x88, 0xd5, 0x83, 0x6a, 0x46, 0xf6, 0x26, 0x11, 0x74,
+		0x7e, 0xfe, 0xe5, 0x40, 0xf6, 0x26, 0xb2, 0x37, 0xa1, 0xe0, 0x0b, 0x56, 0xf0, 0xc8, 0xde, 0x2c,
+		0x4d, 0xf6, 0xa6, 0x40, 0x9d, 0x43, 0xf1, 0xc9, 0xe1, 0xad, 0x8f, 0xf5, 0xbb, 0xfb, 0x20, 0xb8,
+		0xad, 0x6b, 0x1d, 0x79, 0x49, 0xba, 0x9b, 0xa6, 0x82, 0x75, 0xb5, 0x8e, 0xbd, 0xe0, 0xc0, 0x77,
+		0xb3, 0x88, 0x7c, 0x86, 0xde, 0xa0, 0xe7, 0xfb, 0x6b, 0x15, 0x11, 0x0d, 0x28, 0x7f, 0xf3, 0x69,
+		0xdc, 0x76, 0x63, 0xb7, 0xfd, 0xe9, 0x61, 0x78, 0xab, 0xd9, 0xf5, 0x34, 0x5f, 0x8a, 0x9b, 0x25,
+		0x94, 0x9c, 0x37, 0xa3, 0xbc, 0xe4, 0x68, 0xac, 0xd5, 0x2a, 0xce, 0x39, 0x9e, 0x4c, 0x1d, 0x35,
+		0x35, 0xf3, 0x25, 0x42, 0x0a, 0x25, 0x3e, 0x0a, 0x57, 0xd2, 0x6c, 0xa0, 0x92, 0x66, 0x0e, 0x0c,
+		0x97, 0xbc, 0x92, 0xa6, 0xed, 0xfb, 0xa1, 0xd3, 0x6f, 0xf7, 0xd6, 0xaf, 0x26, 0x2b, 0x5e, 0x50,
+		0x73, 0x7a, 0x00, 0xb1, 0xba, 0x9a, 0x35, 0xd4, 0xd5, 0x44, 0x5d, 0xcd, 0x19, 0x1b, 0xba, 0xbf,
+		0x2a, 0x52, 0xa1, 0xd9, 0x8d, 0x86, 0xc8, 0xa2, 0x0d, 0xe5, 0x50, 0x20, 0x9c, 0x2f, 0xe9, 0x76,
+		0xff, 0xaa, 0x14, 0xea, 0x46, 0xab, 0x26, 0xa8, 0x28, 0xfa, 0x5a, 0x1c, 0x3e, 0x95, 0x4c, 0x6a,
+		0x90, 0x8a, 0x3b, 0xcb, 0x35, 0x65, 0xcd, 0xc6, 0x4e, 0x73, 0x67, 0x73, 0xab, 0xb1, 0xd3, 0x5a,
+		0xe0, 0xdc, 0x69, 0x72, 0x10, 0xae, 0x0a, 0x6c, 0x67, 0xd0, 0x76, 0x13, 0x27, 0xf6, 0x22, 0x21,
+		0xfe, 0x3b, 0x11, 0x09, 0x7c, 0xba, 0x19, 0x26, 0x09, 0x26, 0x69, 0x61, 0x26, 0x49, 0x38, 0x1b,
+		0x48, 0x30, 0xeb, 0x87, 0x07, 0x6b, 0x6e, 0x37, 0x92, 0xa9, 0xa6, 0x3e, 0xb8, 0x2d, 0x6f, 0xb9,
+		0x6c, 0xb7, 0x63, 0xf7, 0xfc, 0xfe, 0x8a, 0x76, 0x6c, 0x3f, 0x71, 0x81, 0x4b, 0xe0, 0x72, 0x71,
+		0xb8, 0x14, 0xcf, 0x45, 0x13, 0xcc, 0x39, 0x63, 0x02, 0xe6, 0x7f, 0x7b, 0x5e, 0x94, 0x4d, 0x78,
+		0xb5, 0x63, 0x7b, 0x7e, 0x2f, 0x96, 0xe8, 0xef, 0xf3, 0x72, 0x88, 0xd5, 0x06, 0xac, 0xed, 0xdb,
+		0x71, 0x37, 0x59, 0x49, 0xb8, 0x0e, 0x5f, 0x1d, 0x60, 0xd5, 0x0d, 0xd6, 0xae, 0x97, 0x74, 0xed,
+		0xd4, 0xb9, 0x53, 0x41, 0xeb, 0x78, 0x0c, 0xc0, 0x15, 0x70, 0x05, 0x5c, 0xf9, 0xe1, 0xda, 0xf1,
+		0xe2, 0xee, 0x0f, 0x3b, 0x76, 0xab, 0xf7, 0x6e, 0x9c, 0x48, 0x79, 0x99, 0x2f, 0x46, 0x00, 0xa5,
+		0x05, 0xa5, 0x25, 0x82, 0xab, 0x39, 0xfb, 0xb2, 0xee, 0xec, 0xb8, 0xad, 0x86, 0xba, 0x17, 0x23,
+		0x00, 0x75, 0x40, 0x1d, 0x50, 0xf7, 0xd6, 0x65, 0x79, 0x6d, 0x71, 0x9c, 0x79, 0x6d, 0x20, 0x0b,
+		0xc8, 0x02, 0xb2, 0xde, 0xbc, 0xac, 0xfe, 0xf6, 0xb7, 0x94, 0x1d, 0x1b, 0xdf, 0x09, 0x94, 0x01,
+		0x65, 0x40, 0xd9, 0x5b, 0x97, 0xd5, 0x75, 0xbb, 0x61, 0x2c, 0xb1, 0x43, 0x31, 0xbc, 0x0f, 0xdd,
+		0x5e, 0x81, 0x30, 0x45, 0x84, 0x09, 0x77, 0x7b, 0xb5, 0xef, 0x6d, 0xcf, 0x97, 0x2a, 0xc8, 0x36,
+		0x5e, 0xf3, 0xa7, 0x21, 0x50, 0xe7, 0x54, 0x93, 0xa0, 0xb3, 0x09, 0x3c, 0x9b, 0xe0, 0xf3, 0x01,
+		0x40, 0x0c, 0x08, 0x82, 0x80, 0x90, 0x37, 0x3d, 0xaf, 0xa6, 0x6d, 0x49, 0x9d, 0xd9, 0x52, 0x38,
+		0x41, 0xab, 0x78, 0x7a, 0x4a, 0xa1, 0x78, 0x0a, 0xc7, 0xe9, 0x28, 0xa6, 0x63, 0x95, 0x5c, 0x47,
+		0x6a, 0x38, 0x8f, 0xd0, 0x28, 0x9c, 0x6e, 0x62, 0x39, 0xcd, 0xc4, 0x3d, 0xb5, 0xfc, 0x27, 0x54,
+		0x59, 0x67, 0xbb, 0xa0, 0x03, 0x3a, 0x57, 0x25, 0xa8, 0x1e, 0xdc, 0x4b, 0x3d, 0xdf, 0xfb, 0x3f,
+		0xb7, 0x2d, 0x6f, 0x8f, 0xc7, 0x23, 0xc0, 0x1c, 0x13, 0xc1, 0x1c, 0xc3, 0x1c, 0x13, 0xc1, 0x1c,
+		0xc3, 0x1c, 0x13, 0xc1, 0x1c, 0xc3, 0x1c, 0x13, 0xbb, 0xcb, 0x5d, 0xf4, 0x01, 0xc8, 0xfe, 0x31,
+		0xb9, 0x75, 0xa1, 0x90, 0x11, 0xbd, 0x75, 0xf2, 0xf1, 0x78, 0x30, 0x52, 0x91, 0x61, 0xb2, 0xce,
+		0x6d, 0xb5, 0x2d, 0xd2, 0x23, 0xe1, 0x29, 0x50, 0x36, 0xba, 0x13, 0xc1, 0x68, 0x84, 0xca, 0x14,
+		0x71, 0x2b, 0x1f, 0x8c, 0x16, 0x90, 0x40, 0x7a, 0x16, 0x8a, 0x16, 0xb8, 0xe7, 0xcc, 0x4e, 0x53,
+		0x37, 0x0e, 0x84, 0xb9, 0x86, 0xf5, 0x9f, 0xaf, 0xb5, 0xea, 0xce, 0xd5, 0xaf, 0xe6, 0xe3, 0xb7,
+		0x6f, 0xd5, 0xc1, 0x8f, 0x8d, 0xc9, 0x1f, 0xff, 0x61, 0x95, 0xf2, 0x18, 0x4d, 0x06, 0x6c, 0xa1,
+		0xfa, 0xec, 0xcf, 0x54, 0x82, 0xc8, 0xe1, 0x7d, 0xa8, 0x04, 0xa8, 0x84, 0x55, 0xdd, 0x9f, 0x92,
+		0x43, 0x18, 0xd0, 0x05, 0x74, 0x01, 0x5d, 0x73, 0x3f, 0x62, 0x65, 0xa5, 0x34, 0xaa, 0x19, 0x3b,
+		0xef, 0x25, 0xe2, 0x20, 0x9b, 0xbc, 0x19, 0x58, 0x03, 0xd6, 0x16, 0x86, 0x35, 0xaf, 0xed, 0x06,
+		0xa9, 0x97, 0x3e, 0x88, 0xb5, 0xf4, 0x1b, 0x03, 0x4e, 0x20, 0x4c, 0x61, 0x1d, 0x0e, 0xbf, 0xea,
+		0x93, 0x9d, 0x28, 0x6c, 0x3f, 0xef, 0x9d, 0x1e, 0x9f, 0x9d, 0x9e, 0x1c, 0x9c, 0x5c, 0x5e, 0x9f,
+		0x9e, 0x1d, 0x9c, 0x5f, 0x5f, 0x5c, 0xee, 0x5e, 0xfe, 0x71, 0x21, 0xba, 0xfe, 0xfd, 0xc0, 0x4b,
+		0x22, 0x15, 0xd9, 0x53, 0x6c, 0xf0, 0xb1, 0x7f, 0x78, 0xb1, 0xfb, 0xe9, 0xe8, 0x60, 0xdf, 0x2a,
+		0xa2, 0xf4, 0x80, 0xe2, 0xb3, 0xee, 0xee, 0x5d, 0x1e, 0xfe, 0x79, 0x60, 0xc2, 0x93, 0x1e, 0x9e,
+		0x48, 0x3f, 0xab, 0xd0, 0x1d, 0x57, 0xdc, 0xf8, 0x66, 0xb1, 0x44, 0x91, 0x1d, 0x0f, 0x54, 0x91,
+		0xa0, 0x11, 0x1a, 0xde, 0x07, 0xfb, 0x03, 0xfb, 0xb3, 0x30, 0xfb, 0x23, 0xde, 0x4e, 0x56, 0xa6,
+		0x8d, 0xec, 0x64, 0xfb, 0x58, 0x99, 0x0e, 0xb2, 0x6c, 0x38, 0x4d, 0xab, 0x41, 0x28, 0x05, 0xd4,
+		0xfe, 0x8d, 0x40, 0x2a, 0x90, 0x4a, 0x04, 0xaf, 0x6c, 0xf6, 0x65, 0xc5, 0x6e, 0x37, 0xbc, 0x17,
+		0x4a, 0x70, 0x1c, 0xbf, 0xe0, 0xd3, 0xad, 0xc0, 0x19, 0x70, 0x46, 0x84, 0x83, 0xca, 0x6f, 0x5c,
+		0x56, 0xe2, 0xc6, 0x9e, 0xed, 0x4b, 0x99, 0xb3, 0xa7, 0x5b, 0x01, 0x34, 0x00, 0x8d, 0x08, 0x06,
+		0xed, 0x2d, 0x9c, 0x85, 0x9d, 0x54, 0xed, 0x68, 0xf2, 0x8b, 0x11, 0x80, 0x3a, 0xa0, 0x0e, 0xa8,
+		0x7b, 0xeb, 0xb2, 0x52, 0xb7, 0x1b, 0xb9, 0xb1, 0x9d, 0x4a, 0x15, 0xb7, 0x9a, 0xbc, 0x19, 0x87,
+		0xbc, 0x80, 0xb5, 0xc2, 0x0f, 0x79, 0x65, 0x25, 0x7a, 0xaa, 0x89, 0x7b, 0xef, 0xc6, 0x5e, 0xfa,
+		0x20, 0x74, 0x2f, 0x3d, 0x2f, 0xa3, 0xfd, 0x6c, 0x1c, 0xe4, 0x97, 0x6b, 0x12, 0x79, 0x36, 0xd1,
+		0x67, 0x83, 0x00, 0x1f, 0x14, 0xc4, 0x20, 0x21, 0x08, 0x0d, 0x79, 0x73, 0xc4, 0xb4, 0x0f, 0xa6,
+		0xb2, 0x1f, 0xc6, 0xb3, 0x2f, 0xf6, 0xe2, 0x45, 0x4e, 0xcf, 0x0e, 0x4e, 0xf6, 0x4e, 0x4f, 0x3e,
+		0x1f, 0x7e, 0xb9, 0xde, 0x3d, 0xda, 0x3d, 0x3f, 0xbe, 0xbe, 0x38, 0xf8, 0xf3, 0xe0, 0xfc, 0xf0,
+		0xf2, 0xdf, 0x96, 0x4a, 0x09, 0xe9, 0x44, 0xa9, 0xa5, 0x97, 0x62, 0xb6, 0xf5, 0xe8, 0xd5, 0xfe,
+		0x38, 0xf9, 0xfd, 0xe4, 0xf4, 0xaf, 0x13, 0x6b, 0x11, 0x79, 0xe3, 0x4c, 0xaf, 0xb0, 0x77, 0x7e,
+		0x78, 0x79, 0xb8, 0xb7, 0x7b, 0x64, 0xf2, 0x3b, 0x1c, 0xef, 0xfe, 0x3f, 0xa7, 0xe7, 0x26, 0xbf,
+		0xc0, 0x5f, 0xbb, 0xe7, 0x27, 0x87, 0x27, 0x5f, 0x8c, 0x5e, 0x83, 0xc3, 0x13, 0xa5, 0x35, 0x90,
+		0xba, 0xf3, 0xaa, 0x34, 0x25, 0xdf, 0x05, 0x16, 0x60, 0xc4, 0x62, 0xc4, 0x12, 0x75, 0x5e, 0x4c,
+		0xf8, 0xb3, 0x51, 0xc0, 0x84, 0x88, 0xc0, 0x84, 0x96, 0x94, 0x09, 0x89, 0xc7, 0x9f, 0x25, 0xe3,
+		0xd0, 0x45, 0xc0, 0x3e, 0xbd, 0x8b, 0xdd, 0xe4, 0x2e, 0xf4, 0xdb, 0xaa, 0xc8, 0x7f, 0x1a, 0x08,
+		0xe0, 0x07, 0xf8, 0x97, 0xfa, 0x98, 0xad, 0x50, 0xb3, 0xa2, 0x69, 0x39, 0xdf, 0xc2, 0x31, 0x5b,
+		0x92, 0x77, 0xb3, 0x70, 0xcc, 0x56, 0xe7, 0xd4, 0xaa, 0x36, 0x41, 0xd2, 0x32, 0xc7, 0x2b, 0x54,
+		0xeb, 0xc2, 0xbe, 0xbf, 0x55, 0x29, 0x3b, 0x75, 0xbb, 0x62, 0xa6, 0xb7, 0x9a, 0x3e, 0x44, 0x6e,
+		0x02, 0x03, 0xfc, 0x86, 0x01, 0x1e, 0x4e, 0xd1, 0xd2, 0x99, 0xe1, 0xb6, 0xeb, 0x78, 0x5d, 0xdb,
+		0x57, 0x2a, 0x78, 0x51, 0x97, 0xe8, 0x4a, 0xff, 0x52, 0xb3, 0xd5, 0x57, 0xd5, 0x9e, 0x37, 0x60,
+		0xcf, 0x75, 0xd9, 0xf3, 0x8d, 0x25, 0x9c, 0xda, 0x15, 0x32, 0xe3, 0x5e, 0x90, 0xa4, 0xb6, 0xc0,
+		0x79, 0x83, 0x17, 0xda, 0x6d, 0x34, 0x00, 0xcc, 0x39, 0x11, 0xcc, 0x39, 0xcc, 0x39, 0x11, 0xcc,
+		0x39, 0x11, 0xcc, 0xb9, 0xe0, 0x05, 0x73, 0xae, 0x6f, 0x6a, 0x57, 0xca, 0x9c, 0xa7, 0x6e, 0x7c,
+		0x6f, 0xfb, 0x2a, 0xf6, 0x7c, 0x38, 0xc2, 0x6a, 0x18, 0x74, 0xd8, 0xf1, 0x59, 0x76, 0x7c, 0x49,
+		0xcd, 0x77, 0xb6, 0xeb, 0x5b, 0x95, 0x14, 0x72, 0x52, 0x2c, 0x41, 0xf9, 0x47, 0x30, 0xd0, 0x65,
+		0x56, 0x60, 0x07, 0x61, 0xe2, 0x3a, 0x61, 0xd0, 0x4e, 0xac, 0x55, 0x35, 0xe2, 0x88, 0xb1, 0x6b,
+		0x33, 0xe2, 0x28, 0x65, 0xb9, 0x6c, 0x76, 0xbd, 0x2b, 0x21, 0x1d, 0x4f, 0x65, 0xd6, 0xec, 0x9f,
+		0x70, 0xcf, 0x89, 0x60, 0xd6, 0xe1, 0x9e, 0x13, 0xc1, 0x3d, 0xd7, 0x6e, 0xd9, 0xe1, 0x9e, 0x3f,
+		0x9f, 0x5a, 0xb8, 0xe7, 0x7c, 0x0a, 0xc5, 0x78, 0x33, 0x5e, 0x4d, 0xbd, 0xae, 0xab, 0x64, 0xcb,
+		0x07, 0x23, 0xc0, 0x3d, 0x5f, 0x6d, 0x3b, 0xbe, 0xa4, 0xe6, 0x3b, 0x93, 0xed, 0xd4, 0x73, 0xbe,
+		0x27, 0xe8, 0x0f, 0x51, 0x00, 0xbe, 0x8d, 0xb0, 0x0f, 0x70, 0xaa, 0x8b, 0x9c, 0xed, 0x55, 0xb2,
+		0xc6, 0x12, 0xc0, 0x7b, 0x32, 0xc4, 0x5e, 0x00, 0xa7, 0x9a, 0x08, 0xc6, 0x18, 0x4e, 0x35, 0x11,
+		0x9c, 0x6a, 0xed, 0x96, 0x1d, 0x4e, 0xb5, 0x36, 0xcb, 0x0e, 0xa7, 0xda, 0x74, 0x33, 0xae, 0xea,
+		0x54, 0x8f, 0x46, 0x80, 0x53, 0xbd, 0xda, 0x76, 0x1c, 0x4e, 0x35, 0x11, 0x9c, 0x6a, 0x45, 0x7c,
+		0x1b, 0x61, 0x1f, 0xe0, 0x54, 0x17, 0x39, 0xdb, 0x68, 0xba, 0xb8, 0xf0, 0xa6, 0x8b, 0xe2, 0x75,
+		0xdc, 0xe8, 0xad, 0xce, 0x8b, 0x97, 0x13, 0xc3, 0x15, 0x59, 0xca, 0x4e, 0xc4, 0x16, 0x3c, 0xe9,
+		0xff, 0xec, 0x2e, 0x14, 0x8a, 0x44, 0xf1, 0x3a, 0x45, 0xe4, 0xca, 0x17, 0x8a, 0xec, 0x05, 0xf9,
+		0x6b, 0x95, 0x4e, 0x8a, 0x61, 0x7d, 0x47, 0xe0, 0x9e, 0xe1, 0xe3, 0x89, 0x71, 0x0d, 0x94, 0x19,
+		0x7b, 0x56, 0x66, 0xec, 0xb7, 0xdd, 0xf3, 0xfd, 0xbf, 0x76, 0xcf, 0x0f, 0xae, 0xc7, 0xad, 0x79,
+		0xcc, 0x2f, 0x35, 0xf6, 0x69, 0x77, 0xef, 0xf7, 0xb3, 0xa3, 0xdd, 0x93, 0x03, 0x93, 0x8b, 0x44,
+		0x5d, 0x1c, 0x9c, 0x5c, 0x98, 0x5d, 0xa9, 0xeb, 0xf2, 0x7c, 0xf7, 0xe4, 0x62, 0xef, 0xe0, 0xf0,
+		0xcf, 0x03, 0xa3, 0x5f, 0xe3, 0xf3, 0xf9, 0x1f, 0x26, 0x3f, 0xfe, 0xd9, 0xe9, 0x5f, 0x59, 0xa7,
+		0xad, 0x3f, 0xce, 0xce, 0x8e, 0xfe, 0x6d, 0xf2, 0x7b, 0x1c, 0x9e, 0x5c, 0x1e, 0x7c, 0x39, 0xdf,
+		0xbd, 0x3c, 0xd8, 0xbf, 0xde, 0x3b, 0x3c, 0xdf, 0xfb, 0xe3, 0xf0, 0xd2, 0xe4, 0xb7, 0x39, 0x3a,
+		0x3c, 0x39, 0xd8, 0xdb, 0x3d, 0xdf, 0x37, 0x1a, 0x18, 0xbb, 0x9f, 0xce, 0x0f, 0xf7, 0x8c, 0x2e,
+		0xe8, 0x78, 0x66, 0x34, 0xb4, 0xf7, 0x4e, 0x4f, 0x2e, 0xcf, 0x4f, 0x8f, 0x8e, 0x0e, 0xce, 0xaf,
+		0x4d, 0x97, 0xa5, 0xbd, 0xdf, 0x76, 0x2f, 0x2e, 0x0e, 0x2f, 0x8c, 0x36, 0xd8, 0x97, 0xa7, 0xe7,
+		0xbb, 0x5f, 0x0e, 0xcc, 0xb6, 0x15, 0xe7, 0x97, 0x66, 0x6b, 0xa4, 0x93, 0xa5, 0x2b, 0xab, 0xb9,
+		0x06, 0xef, 0x46, 0xde, 0xbb, 0xb9, 0x38, 0xfd, 0x7c, 0xb9, 0x6c, 0xde, 0x4d, 0xd6, 0x39, 0x75,
+		0xf7, 0xf2, 0xf0, 0xe4, 0xcb, 0xf5, 0xc5, 0xbf, 0x2f, 0x2e, 0x0f, 0x8e, 0x57, 0xb7, 0x90, 0xec,
+		0x55, 0x81, 0x81, 0xb8, 0x5e, 0xe2, 0xb6, 0xab, 0x51, 0xf8, 0xc3, 0x8d, 0xc5, 0xc3, 0x71, 0x13,
+		0xf7, 0x22, 0x28, 0x87, 0xa0, 0x9c, 0xbc, 0x14, 0x92, 0x62, 0x50, 0x4e, 0xb4, 0x3e, 0xa4, 0x44,
+		0x5d, 0x48, 0xc9, 0x1d, 0xc0, 0x5f, 0x95, 0x42, 0x77, 0xfc, 0x14, 0xb7, 0xa3, 0x54, 0x77, 0xf8,
+		0x38, 0xf6, 0x9a, 0x24, 0xd8, 0x96, 0xd2, 0x4e, 0x1e, 0xd7, 0x94, 0xa9, 0xd6, 0x71, 0x64, 0x99,
+		0xbb, 0xb2, 0x1b, 0xa4, 0x8a, 0xc2, 0xca, 0x8b, 0xee, 0xb7, 0xa9, 0xed, 0xb3, 0x59, 0x15, 0xb9,
+		0x17, 0x79, 0xe3, 0x25, 0xac, 0x24, 0x0d, 0x63, 0xfb, 0x76, 0xbe, 0x92, 0x9b, 0x38, 0xe3, 0x3f,
+		0xb8, 0x61, 0xad, 0x92, 0x47, 0x82, 0xe7, 0xa4, 0x14, 0xe6, 0xb6, 0xa6, 0x22, 0x56, 0x54, 0xd2,
+		0x7a, 0x8a, 0x5a, 0x4d, 0x69, 0x6b, 0x29, 0x6d, 0x25, 0xe5, 0xad, 0xa3, 0x9a, 0x90, 0xe7, 0xed,
+		0xab, 0x64, 0x39, 0xa3, 0xd5, 0x14, 0x24, 0x6d, 0xc3, 0xfb, 0xd0, 0x02, 0x0c, 0x84, 0x4d, 0x4e,
+		0x44, 0x65, 0xf5, 0x31, 0x83, 0x5e, 0xee, 0x6b, 0xc3, 0x61, 0xa3, 0xf4, 0x22, 0xb3, 0x15, 0x06,
+		0x16, 0x41, 0xbc, 0xc7, 0x65, 0xff, 0x36, 0xcd, 0x48, 0x6b, 0x00, 0x69, 0x40, 0x9a, 0x26, 0xa4,
+		0xe5, 0x60, 0x42, 0x02, 0x40, 0x33, 0x84, 0xfc, 0xe5, 0xa1, 0x5c, 0xf4, 0x56, 0x4a, 0xd5, 0xc5,
+		0x70, 0x08, 0x1d, 0x0c, 0xb2, 0x77, 0xf3, 0xf4, 0xd4, 0x02, 0x3c, 0xf2, 0xd9, 0x6d, 0x60, 0x93,
+		0x60, 0x93, 0x6f, 0x09, 0x96, 0x84, 0xa1, 0x9b, 0xbc, 0x1b, 0xcc, 0x12, 0xf6, 0x4e, 0x4e, 0x5c,
+		0x85, 0xc5, 0x76, 0x74, 0x89, 0x3a, 0x43, 0x2f, 0x16, 0x5c, 0xc8, 0x29, 0x92, 0x14, 0x61, 0x69,
+		0x51, 0x56, 0x11, 0x69, 0x26, 0xd1, 0x56, 0x15, 0x71, 0x36, 0x51, 0x67, 0x13, 0x79, 0x3e, 0xd1,
+		0x17, 0x83, 0x80, 0x20, 0x14, 0xa4, 0x21, 0x31, 0xba, 0xac, 0x21, 0x3f, 0x51, 0xdc, 0x7b, 0xec,
+		0x8f, 0xb2, 0xb6, 0x90, 0x00, 0xab, 0x2c, 0x5c, 0x38, 0x60, 0xc3, 0x0c, 0x1f, 0x2e, 0x18, 0xb1,
+		0xc3, 0x89, 0x1d, 0x56, 0xfc, 0xf0, 0x92, 0x83, 0x99, 0x24, 0xdc, 0xe4, 0x37, 0xa5, 0x66, 0x4a,
+		0x8e, 0xef, 0xda, 0x1d, 0xb9, 0x14, 0x84, 0x69, 0x20, 0xd5, 0xb7, 0x14, 0xc6, 0x38, 0x1b, 0x7a,
+		0x44, 0x1f, 0x3e, 0xac, 0x4f, 0xfe, 0xdf, 0x93, 0x03, 0x34, 0x30, 0x85, 0xeb, 0x7d, 0xc0, 0x17,
+		0x74, 0xdc, 0x47, 0xaf, 0xe6, 0x94, 0x74, 0x9e, 0xd5, 0x3d, 0xc9, 0x49, 0xa7, 0xeb, 0xd9, 0x6f,
+		0x42, 0x11, 0x2c, 0xf1, 0x49, 0x12, 0x98, 0x20, 0x39, 0xeb, 0xa0, 0x62, 0x15, 0xd0, 0x7a, 0x72,
+		0x71, 0x5a, 0x1e, 0xad, 0x27, 0x0b, 0xd4, 0xda, 0x2a, 0xda, 0x7a, 0x52, 0x4b, 0x4b, 0xeb, 0x63,
+		0x3d, 0x0a, 0x43, 0x2c, 0x14, 0xfe, 0x62, 0x3e, 0x45, 0x42, 0xe2, 0x2f, 0x66, 0x52, 0x56, 0x65,
+		0x34, 0xa0, 0x32, 0xa0, 0x32, 0x88, 0xe0, 0x67, 0xe5, 0x83, 0x0b, 0xfc, 0x2c, 0x6d, 0x70, 0x62,
+		0x87, 0x15, 0x3f, 0xbc, 0xe4, 0x60, 0x26, 0x09, 0x37, 0x75, 0x4b, 0xcd, 0x68, 0xb1, 0x39, 0x2c,
+		0xf7, 0x6b, 0x16, 0x1c, 0x7e, 0xd6, 0xa2, 0xfc, 0x2c, 0x81, 0xfd, 0x4b, 0xf1, 0x39, 0xe2, 0x0d,
+		0x79, 0xff, 0xee, 0x3e, 0x08, 0xea, 0x7f, 0xeb, 0xc8, 0x4b, 0xd2, 0xdd, 0x34, 0x15, 0x0c, 0x95,
+		0x1f, 0x7b, 0xc1, 0x81, 0xef, 0x76, 0x87, 0x1b, 0x88, 0x41, 0xcf, 0xf7, 0x05, 0xd8, 0xdf, 0xb1,
+		0xfd, 0x53, 0xfe, 0xe6, 0xd3, 0xb8, 0xed, 0xc6, 0x6e, 0xfb, 0xd3, 0xc3, 0xf0, 0x56, 0xb3, 0xb7,
+		0xc8, 0x67, 0x4a, 0x1d, 0x47, 0x55, 0x8e, 0x8b, 0xc9, 0xf1, 0x56, 0x6b, 0xff, 0x7d, 0x72, 0x5e,
+		0xa5, 0xb7, 0xd0, 0x2b, 0x02, 0xaf, 0x98, 0x03, 0x7a, 0xf9, 0xa0, 0x26, 0x04, 0x2d, 0x21, 0x28,
+		0xe5, 0x83, 0xce, 0xac, 0xf7, 0xcb, 0xb9, 0x74, 0xb2, 0x4b, 0x66, 0xad, 0x55, 0x44, 0xe5, 0xdb,
+		0xaa, 0xe4, 0x5b, 0xb5, 0xc7, 0xca, 0x1b, 0xef, 0x37, 0xef, 0xbd, 0xc4, 0xde, 0xc7, 0xaa, 0xbc,
+		0xfe, 0xc5, 0x13, 0x5f, 0x3a, 0x68, 0x29, 0xd7, 0xb1, 0x1d, 0xf7, 0x65, 0xea, 0xc5, 0xf3, 0xb6,
+		0x73, 0x83, 0xcf, 0xac, 0x55, 0x5e, 0x65, 0x15, 0x6b, 0x95, 0x5c, 0xb4, 0xfb, 0x2d, 0x3a, 0x3d,
+		0x49, 0x93, 0xbd, 0xd7, 0xa8, 0xce, 0x3c, 0xe2, 0x9b, 0x9b, 0xd0, 0xe6, 0x26, 0xaa, 0xd3, 0x04,
+		0xd4, 0xeb, 0x58, 0x82, 0xcb, 0x39, 0xcb, 0xf3, 0x7a, 0x9a, 0xd2, 0xd9, 0xaf, 0xf3, 0x62, 0xf6,
+		0x67, 0xbd, 0xce, 0xdb, 0xa1, 0x84, 0xb9, 0x3e, 0x50, 0x1e, 0x1f, 0x67, 0xee, 0xe2, 0xe4, 0x5d,
+		0x24, 0xe1, 0xc5, 0x12, 0x5e, 0x34, 0x91, 0xc5, 0x93, 0xd3, 0xad, 0xf3, 0xdc, 0x69, 0xcb, 0xbe,
+		0xbd, 0x8d, 0xdd, 0xdb, 0x7c, 0x86, 0x65, 0x3c, 0xb3, 0x93, 0x37, 0x99, 0x91, 0xcd, 0xe4, 0xdb,
+		0xb7, 0x4b, 0x99, 0xc8, 0x94, 0xbd, 0x17, 0x32, 0xe2, 0x19, 0x04, 0x4b, 0x46, 0xc0, 0xe4, 0x05,
+		0x4d, 0x56, 0xe0, 0x94, 0x05, 0x4f, 0x59, 0x00, 0x95, 0x04, 0x31, 0x9f, 0x40, 0xe6, 0x14, 0x4c,
+		0x61, 0x01, 0x1d, 0x5d, 0x96, 0x6f, 0xdf, 0x56, 0x53, 0x99, 0x80, 0xc7, 0x53, 0x90, 0x63, 0x34,
+		0xc2, 0x6a, 0x6c, 0xbe, 0x89, 0x09, 0xb5, 0xaa, 0x70, 0xb3, 0x09, 0x39, 0x9b, 0xb0, 0xb3, 0x08,
+		0xbd, 0x98, 0xf0, 0x0b, 0x82, 0x40, 0x3d, 0x90, 0xf7, 0x9a, 0x71, 0x97, 0x91, 0xf1, 0x67, 0x4a,
+		0xba, 0x29, 0x71, 0xef, 0x41, 0xd0, 0xeb, 0x66, 0x6f, 0xf0, 0x58, 0x92, 0x92, 0xe0, 0xbe, 0x17,
+		0x7c, 0x4f, 0xd4, 0x6a, 0x82, 0x0f, 0x86, 0x80, 0xae, 0x80, 0xae, 0x58, 0x32, 0x5d, 0x91, 0x9d,
+		0xfc, 0xaf, 0x6f, 0x2a, 0x68, 0x88, 0x4d, 0xd4, 0x02, 0x27, 0xa5, 0x63, 0xef, 0xa8, 0x05, 0xae,
+		0x6d, 0x6a, 0x37, 0x5b, 0xad, 0x0d, 0x14, 0xff, 0x66, 0x1b, 0xbf, 0xec, 0x47, 0xf2, 0x9e, 0x22,
+		0x87, 0xeb, 0xaf, 0xfd, 0xb8, 0x3e, 0xc1, 0x8b, 0x70, 0x04, 0x76, 0x11, 0x47, 0x60, 0xe1, 0x5a,
+		0x9b, 0xe1, 0x5a, 0x27, 0x91, 0xeb, 0xb6, 0xd5, 0x7c, 0xeb, 0xc1, 0x10, 0x20, 0xcc, 0xfc, 0xe2,
+		0xcd, 0x26, 0xe6, 0x6c, 0xe2, 0xce, 0x22, 0xf6, 0x62, 0xe2, 0x2f, 0x08, 0x03, 0x66, 0xc2, 0x2c,
+		0x54, 0x2a, 0x6b, 0x5a, 0xba, 0xb7, 0x40, 0x98, 0xd5, 0x58, 0x1d, 0x08, 0xb3, 0xb6, 0xa9, 0x55,
+		0x2d, 0xc1, 0xa5, 0x65, 0x8e, 0x57, 0xa8, 0x81, 0x1d, 0xe2, 0xda, 0x22, 0xef, 0x0a, 0xd3, 0xbb,
+		0x5a, 0xa6, 0x17, 0x71, 0xed, 0xf1, 0x65, 0x75, 0xdd, 0xee, 0x8d, 0x1b, 0x2b, 0x04, 0xb5, 0x07,
+		0xf7, 0x43, 0x4b, 0x40, 0x4b, 0x2c, 0x99, 0x96, 0xb8, 0xb1, 0x13, 0xf7, 0x29, 0x14, 0x54, 0x5d,
+		0xe0, 0xd9, 0xb3, 0xf5, 0x7e, 0x22, 0xd0, 0xc7, 0x89, 0x58, 0xd4, 0xd4, 0x1f, 0x86, 0xbf, 0x4b,
+		0x9c, 0x4c, 0x13, 0x9c, 0x55, 0xa9, 0x44, 0xe7, 0x49, 0x27, 0x40, 0x3a, 0x67, 0x59, 0x2a, 0x5b,
+		0x53, 0x2d, 0x8b, 0xb3, 0x60, 0x45, 0x8c, 0x0d, 0x46, 0xa8, 0x63, 0xa8, 0xe3, 0x37, 0xe3, 0x25,
+		0xd8, 0x60, 0x2c, 0x00, 0xd6, 0x33, 0x9d, 0x7a, 0xc4, 0x4b, 0x88, 0xb0, 0xc1, 0x68, 0x44, 0xa8,
+		0x64, 0x89, 0x36, 0x18, 0x79, 0x2b, 0x7f, 0xe6, 0xd9, 0x5f, 0xfc, 0xe1, 0xa5, 0xce, 0x9d, 0xdb,
+		0xae, 0xde, 0xfb, 0x76, 0x20, 0xb1, 0xcf, 0xf8, 0xec, 0xf6, 0xe5, 0x48, 0xe5, 0x15, 0x78, 0x15,
+		0x5a, 0xaa, 0x0d, 0xc7, 0xfe, 0x8b, 0xa3, 0xf4, 0x20, 0x9f, 0xe8, 0x4a, 0x8b, 0xb0, 0x8a, 0x28,
+		0x33, 0x88, 0xb4, 0xaa, 0x68, 0xb3, 0x89, 0x38, 0x9b, 0xa8, 0xf3, 0x88, 0xbc, 0x98, 0xe8, 0x0b,
+		0x42, 0x40, 0x1a, 0x0a, 0xa3, 0xcb, 0xb2, 0x1d, 0xc7, 0x4d, 0x12, 0x31, 0x4d, 0x3e, 0x53, 0x6a,
+		0x26, 0x07, 0x43, 0x61, 0x0c, 0x05, 0x10, 0x71, 0x81, 0x89, 0x1d, 0x54, 0xec, 0xe0, 0xe2, 0x05,
+		0x99, 0x1c, 0xd8, 0x24, 0x41, 0xa7, 0xee, 0xba, 0xbe, 0x90, 0x98, 0xec, 0xad, 0xab, 0x5e, 0x9b,
+		0xa1, 0x20, 0xc6, 0xa6, 0xc2, 0x10, 0x6a, 0x3e, 0xed, 0xe8, 0x52, 0x93, 0x58, 0xe2, 0xf2, 0x71,
+		0x99, 0x34, 0xcb, 0x4c, 0xc7, 0xac, 0xce, 0x34, 0x1e, 0xa3, 0x73, 0xa6, 0x28, 0xcf, 0xec, 0xbe,
+		0xb0, 0xee, 0x25, 0x68, 0xd6, 0x76, 0x9a, 0x25, 0x5e, 0x85, 0xca, 0x62, 0xee, 0xbe, 0x2a, 0xaa,
+		0x52, 0x8d, 0x04, 0x47, 0x7c, 0xda, 0x34, 0xe9, 0x86, 0x6d, 0x86, 0x52, 0x5c, 0x53, 0xe3, 0x81,
+		0x7b, 0x80, 0x7b, 0x10, 0x81, 0x7b, 0x08, 0x49, 0x4c, 0x9f, 0x7b, 0x64, 0xf0, 0x91, 0xcd, 0x78,
+		0xe0, 0xc8, 0x7c, 0x50, 0xcc, 0x80, 0x28, 0x56, 0x8b, 0x05, 0x76, 0xea, 0xdd, 0xbb, 0x4c, 0xbe,
+		0xd3, 0xe4, 0x60, 0xd0, 0x5f, 0xd0, 0x5f, 0xd0, 0x5f, 0x44, 0xf0, 0x9d, 0x88, 0xe0, 0x3b, 0x2d,
+		0x86, 0xb5, 0xc3, 0x77, 0x2a, 0xc3, 0x2a, 0xc0, 0x77, 0x9a, 0xbe, 0xac, 0x34, 0xee, 0x05, 0xdf,
+		0xfb, 0x3a, 0x3e, 0x51, 0x67, 0x1d, 0x93, 0x83, 0x81, 0x75, 0x80, 0x75, 0x10, 0x81, 0x75, 0x08,
+		0x49, 0x4c, 0x2f, 0x98, 0x5f, 0xd8, 0x2c, 0x0f, 0x7c, 0xea, 0x3b, 0x0a, 0x63, 0x0c, 0x5f, 0x67,
+		0xe1, 0x9c, 0x83, 0x8f, 0x8a, 0x31, 0x52, 0x32, 0x66, 0x6a, 0xc6, 0x37, 0x5d, 0x5a, 0xa8, 0xda,
+		0xf4, 0xc4, 0x31, 0xf1, 0x85, 0x17, 0xbc, 0xa1, 0xce, 0x3c, 0xae, 0x06, 0xf2, 0xa0, 0xa8, 0x24,
+		0xb4, 0x53, 0xba, 0xa2, 0x96, 0x8a, 0x8f, 0xe2, 0x15, 0xb2, 0x5a, 0x95, 0x72, 0x8c, 0x72, 0x55,
+		0x59, 0xa0, 0xcc, 0x71, 0xeb, 0xe2, 0xb8, 0xaf, 0xfa, 0xf8, 0xd4, 0x71, 0x7d, 0x9b, 0x61, 0xac,
+		0x33, 0x3b, 0x4d, 0xdd, 0x38, 0x60, 0xd3, 0xc8, 0xd6, 0x7f, 0xde, 0x35, 0x6b, 0x3b, 0x5f, 0x6b,
+		0xd5, 0xe6, 0xd5, 0xdf, 0xcd, 0xda, 0xd7, 0x5a, 0x75, 0xfb, 0xea, 0x6b, 0xad, 0xba, 0x73, 0xf5,
+		0xf7, 0xd7, 0x7a, 0x75, 0x63, 0xf0, 0xe3, 0xaf, 0x8d, 0xc7, 0xec, 0xb7, 0x9d, 0xe1, 0x6f, 0xf5,
+		0xb5, 0xc6, 0xf0, 0xf7, 0xf7, 0xdf, 0xbe, 0x7d, 0xf8, 0xf6, 0xed, 0x83, 0xc2, 0x00, 0xff, 0xb0,
+		0x16, 0x2d, 0x72, 0x45, 0x7b, 0x3b, 0x92, 0xdc, 0x4b, 0xe9, 0x84, 0xc8, 0xa4, 0xcd, 0x54, 0x3e,
+		0xe4, 0xc1, 0x7a, 0x62, 0x84, 0xe7, 0xe4, 0x88, 0xfc, 0x62, 0x9a, 0xda, 0x30, 0x42, 0x28, 0xd3,
+		0x75, 0x32, 0x73, 0xb4, 0x44, 0x9d, 0xf9, 0xd0, 0x68, 0x2b, 0xdf, 0x8b, 0x22, 0xab, 0x70, 0x12,
+		0x52, 0xc8, 0x2a, 0xcc, 0x27, 0x35, 0xc8, 0x2a, 0x24, 0xc4, 0xa8, 0x88, 0x16, 0x07, 0x32, 0x39,
+		0xb0, 0x49, 0x82, 0x6e, 0x3a, 0xa8, 0x83, 0x9d, 0xb1, 0x67, 0x17, 0x76, 0xc6, 0x16, 0xed, 0xa8,
+		0x63, 0x67, 0xac, 0x14, 0xab, 0x80, 0x9d, 0xb1, 0xe9, 0x0b, 0x59, 0x85, 0x44, 0xe0, 0x1e, 0x44,
+		0xe0, 0x1e, 0x2a, 0x28, 0x22, 0x64, 0x15, 0x2e, 0x56, 0x8b, 0x21, 0xab, 0x10, 0xfa, 0x0b, 0xfa,
+		0x8b, 0x08, 0xbe, 0x13, 0x11, 0x7c, 0x27, 0x09, 0xe2, 0x0e, 0xdf, 0x89, 0x08, 0xbe, 0x13, 0xbb,
+		0x2e, 0x5a, 0x6e, 0xdf, 0x09, 0x59, 0x85, 0x44, 0x60, 0x1d, 0x44, 0x60, 0x1d, 0xc8, 0x2a, 0x7c,
+		0xfe, 0x3a, 0xc8, 0x2a, 0x2c, 0x80, 0x9a, 0xf1, 0x4d, 0x97, 0x16, 0xaa, 0x36, 0x3d, 0x71, 0xc8,
+		0x2a, 0x44, 0x56, 0x21, 0xb2, 0x0a, 0x0b, 0xa3, 0x80, 0x4c, 0x32, 0x87, 0xac, 0x42, 0x22, 0x64,
+		0x15, 0x16, 0x25, 0xf2, 0xc8, 0x2a, 0x44, 0x56, 0xa1, 0x81, 0x59, 0x85, 0x02, 0xd5, 0x34, 0xc5,
+		0x27, 0x69, 0xa9, 0xca, 0x8e, 0x4a, 0x94, 0xf1, 0x1c, 0x7c, 0x7d, 0x1a, 0xf7, 0x9c, 0x34, 0x18,
+		0x5a, 0x92, 0xc3, 0xd1, 0xe0, 0xd7, 0xbb, 0x4f, 0x83, 0x5f, 0x5f, 0x0c, 0x07, 0xff, 0x33, 0xb7,
+		0xfb, 0xf7, 0x58, 0x51, 0xfb, 0xc4, 0x9c, 0x49, 0x17, 0x9d, 0x6c, 0x9e, 0x49, 0xb6, 0xd6, 0x2a,
+		0x1c, 0xd3, 0x69, 0x55, 0xe4, 0xe6, 0xe5, 0x8d, 0x39, 0xc9, 0x5b, 0x4b, 0x53, 0xac, 0x76, 0x66,
+		0xce, 0xac, 0xd6, 0xdc, 0xe1, 0x1d, 0x91, 0x30, 0xce, 0x64, 0xb8, 0xc6, 0xcb, 0xd3, 0xc9, 0x41,
+		0x34, 0x28, 0x23, 0x1d, 0x7c, 0x91, 0x0e, 0xb2, 0x4c, 0x07, 0x53, 0xbc, 0x8e, 0xa5, 0x19, 0x25,
+		0x79, 0x33, 0x46, 0xad, 0xb6, 0x9b, 0x38, 0xb1, 0x17, 0x09, 0x29, 0xaf, 0xf1, 0x0a, 0x4d, 0xde,
+		0x2c, 0x56, 0x3f, 0xb8, 0x56, 0xd2, 0xfa, 0xc1, 0x5e, 0x67, 0x25, 0xab, 0x07, 0xe7, 0x11, 0xc8,
+		0xfc, 0x0a, 0x9e, 0x04, 0x6c, 0xa7, 0x70, 0xac, 0x6e, 0x22, 0x5b, 0x3f, 0xf6, 0x02, 0xa1, 0xee,
+		0xb2, 0x63, 0xf7, 0xa6, 0xc0, 0x1a, 0xdd, 0x6e, 0x60, 0xdf, 0xf8, 0x02, 0xad, 0x55, 0xc7, 0xaf,
+		0x37, 0xba, 0x31, 0x6f, 0x0d, 0x66, 0xb7, 0x63, 0xf7, 0xfc, 0x74, 0x18, 0xf5, 0x77, 0x01, 0x47,
+		0xc0, 0xb1, 0x58, 0x38, 0xde, 0x84, 0xa1, 0xef, 0x8a, 0x11, 0xd0, 0x11, 0x1e, 0xeb, 0x05, 0xe2,
+		0xd1, 0x0f, 0xc3, 0xe8, 0xc6, 0x76, 0xbe, 0x8b, 0xa5, 0x26, 0x8e, 0xdf, 0xf2, 0xf9, 0xed, 0xe2,
+		0xd8, 0xec, 0xd8, 0x7e, 0x02, 0x70, 0x02, 0x9c, 0x00, 0xe7, 0x2b, 0x97, 0xd5, 0x4d, 0x7b, 0xe2,
+		0x90, 0xcc, 0x6e, 0x02, 0xa0, 0x00, 0xa8, 0x42, 0x01, 0x25, 0xdc, 0xe3, 0x4a, 0x62, 0xab, 0x53,
+		0x72, 0x4b, 0x53, 0xae, 0xdd, 0x9f, 0xc2, 0x89, 0x4e, 0xb5, 0x0c, 0x11, 0xc5, 0x9e, 0x55, 0x1c,
+		0x9b, 0x55, 0x8f, 0x72, 0xcd, 0x0d, 0x17, 0x3e, 0x65, 0x0a, 0xbd, 0xa8, 0x58, 0xa6, 0x4d, 0x53,
+		0x70, 0xf6, 0xaa, 0x40, 0x8b, 0x33, 0x0c, 0xd7, 0x09, 0x9a, 0x9c, 0xfe, 0x5d, 0xb0, 0x39, 0x44,
+		0xb0, 0x39, 0x05, 0xda, 0x1c, 0x33, 0x02, 0x1e, 0x69, 0xe4, 0x49, 0x44, 0x3b, 0xfa, 0x77, 0x89,
+		0xbb, 0x53, 0xc3, 0xc4, 0xb4, 0xfe, 0x99, 0x96, 0xe4, 0xe3, 0xe5, 0xd9, 0xe1, 0xfe, 0x75, 0xed,
+		0x5f, 0xdb, 0xf5, 0x5a, 0x6d, 0x49, 0xd0, 0x89, 0x76, 0x66, 0x46, 0x21, 0xd4, 0x6b, 0xbb, 0x41,
+		0xea, 0xa5, 0x0f, 0x62, 0x1d, 0xa8, 0xc7, 0x30, 0x15, 0xb0, 0xe5, 0xd6, 0xe1, 0xf0, 0xab, 0x3e,
+		0xd9, 0x89, 0x42, 0xc5, 0x93, 0x3e, 0x62, 0x2e, 0xff, 0x7d, 0x76, 0x70, 0x21, 0xba, 0xe0, 0x7d,
+		0x0e, 0x92, 0x48, 0x65, 0x77, 0x28, 0x26, 0x35, 0x8f, 0x50, 0xbe, 0xbd, 0xbb, 0x6d, 0x15, 0x41,
+		0xf5, 0x78, 0x1e, 0x77, 0xa7, 0x51, 0xab, 0x19, 0xf4, 0xb8, 0x7d, 0x1d, 0x6a, 0xd0, 0xec, 0xca,
+		0x3d, 0xae, 0xd0, 0x1d, 0x57, 0xdc, 0xea, 0x88, 0xc7, 0xdc, 0x8a, 0x28, 0xab, 0x27, 0x73, 0x9b,
+		0xff, 0x0c, 0x28, 0x08, 0x2c, 0x08, 0xec, 0xaa, 0x9a, 0xc7, 0xa7, 0xc2, 0x03, 0x12, 0x87, 0xa6,
+		0x17, 0x68, 0x22, 0x3d, 0x3b, 0xb0, 0xab, 0x4a, 0x0f, 0xcf, 0xf1, 0x12, 0x6a, 0x2f, 0xf3, 0xe2,
+		0xa5, 0xee, 0x92, 0xc4, 0xb3, 0x16, 0xd1, 0x87, 0x9c, 0xe9, 0xf9, 0x6f, 0xb7, 0x6a, 0x1b, 0x76,
+		0xda, 0xe8, 0xde, 0x98, 0xfc, 0x12, 0xed, 0xa4, 0xfe, 0x79, 0xff, 0xc8, 0xe4, 0x37, 0x48, 0xe3,
+		0x5a, 0x6d, 0xdb, 0xe4, 0x17, 0xb8, 0xf3, 0x22, 0x37, 0xf6, 0xed, 0xa0, 0x61, 0xf2, 0x4b, 0x84,
+		0x51, 0xea, 0x39, 0xb6, 0x7f, 0x19, 0xdb, 0x41, 0x12, 0x85, 0x71, 0x6a, 0xf4, 0x82, 0x84, 0x5d,
+		0x37, 0x0a, 0x6c, 0x93, 0x5f, 0xc1, 0xeb, 0xfc, 0xd9, 0xf1, 0xfa, 0x86, 0xdd, 0xe0, 0x97, 0xb0,
+		0x63, 0x27, 0x70, 0xd3, 0x33, 0xbf, 0x97, 0x98, 0xfc, 0x16, 0x9d, 0xd8, 0xee, 0xba, 0xe7, 0xae,
+		0x6f, 0x3f, 0x5c, 0xb8, 0xf1, 0xbd, 0xe7, 0x18, 0xbd, 0x24, 0xed, 0xfb, 0x9b, 0x73, 0xc7, 0x39,
+		0xb6, 0x9d, 0x23, 0xfb, 0xc1, 0x8d, 0x4d, 0x7e, 0x13, 0xc7, 0x4b, 0x9c, 0xf0, 0xf0, 0xe2, 0x48,
+		0xf1, 0x1c, 0xec, 0xe2, 0xdf, 0xc3, 0x8e, 0x7e, 0xd8, 0xd1, 0x7e, 0x98, 0xd6, 0xeb, 0x67, 0x71,
+		0xd8, 0xf1, 0x7c, 0xa3, 0xe5, 0xcb, 0x73, 0x5d, 0x77, 0xbb, 0xd6, 0xa8, 0xb7, 0x9a, 0x46, 0x1b,
+		0x90, 0xf6, 0x5d, 0x7d, 0xbb, 0x61, 0xb4, 0x3d, 0x8f, 0xe2, 0x30, 0x75, 0xc3, 0xa0, 0x5e, 0x3b,
+		0xbe, 0xf1, 0x8c, 0x36, 0xe6, 0x49, 0x98, 0xd9, 0x90, 0xec, 0xb4, 0x80, 0xc1, 0x2f, 0xe1, 0x26,
+		0x4e, 0x68, 0xb4, 0x96, 0x6a, 0x3b, 0x46, 0x3f, 0x7e, 0x37, 0x72, 0x6f, 0x97, 0x82, 0xda, 0xfe,
+		0xdc, 0xde, 0x3c, 0xb2, 0x23, 0xa3, 0xf9, 0x54, 0xe4, 0x45, 0x46, 0xeb, 0xa3, 0x76, 0xe2, 0x9b,
+		0xfc, 0xfc, 0xf7, 0xa1, 0xe7, 0xb8, 0x07, 0xc7, 0x46, 0x93, 0x8c, 0x68, 0x19, 0x28, 0xd2, 0xe6,
+		0x5f, 0xc7, 0xbb, 0x27, 0x26, 0xbf, 0x88, 0x6f, 0x47, 0x6d, 0xa3, 0xdd, 0xd3, 0xb4, 0xbb, 0xff,
+		0xd3, 0xe8, 0x08, 0xa6, 0x7d, 0xef, 0x39, 0xde, 0xe9, 0x20, 0x72, 0x73, 0x90, 0xde, 0x99, 0xed,
+		0xd0, 0x75, 0x43, 0xc7, 0xfe, 0xd3, 0x8d, 0x13, 0x2f, 0x0c, 0xea, 0x26, 0xbf, 0x47, 0x6c, 0xb7,
+		0xbd, 0xf0, 0x78, 0x77, 0xcf, 0xf0, 0xa8, 0xb2, 0xd1, 0xb8, 0xb0, 0xfd, 0x96, 0xd1, 0x36, 0xba,
+		0x5e, 0x37, 0xdb, 0x3e, 0x7f, 0x0e, 0xe3, 0x1f, 0x76, 0xdc, 0x36, 0x9e, 0x27, 0x9d, 0xde, 0xbb,
+		0xf1, 0x6e, 0xda, 0x35, 0x5a, 0x1d, 0x25, 0x8d, 0x8d, 0x86, 0xe1, 0xdb, 0x2b, 0x91, 0x37, 0x3e,
+		0xbd, 0x6f, 0xf2, 0x9b, 0xb8, 0x91, 0x1f, 0x27, 0x66, 0x03, 0x3b, 0x03, 0xc4, 0x9e, 0x6f, 0xff,
+		0x30, 0x9c, 0xb7, 0x76, 0x0c, 0x8f, 0xe1, 0xef, 0x26, 0xde, 0x69, 0x2f, 0x5d, 0x82, 0xfd, 0xc6,
+		0xbd, 0x3b, 0x3b, 0x08, 0x5c, 0xa3, 0x1d, 0xea, 0x76, 0xe8, 0x24, 0x7b, 0xd9, 0xd1, 0xf0, 0x3f,
+		0xa2, 0x24, 0x8d, 0x5d, 0xbb, 0xbb, 0x04, 0xef, 0xd4, 0xf5, 0xc2, 0x9f, 0x0d, 0xa3, 0x29, 0xd4,
+		0xcf, 0x46, 0xeb, 0xae, 0x17, 0xa4, 0x5f, 0xe2, 0xb0, 0x17, 0x19, 0x1e, 0xc7, 0x8f, 0xfe, 0xf2,
+		0x62, 0xd7, 0x77, 0x93, 0xe4, 0xac, 0x71, 0x66, 0xb8, 0xde, 0xba, 0x6c, 0x1b, 0xcd, 0xa5, 0xee,
+		0x1e, 0x22, 0x37, 0x76, 0xcc, 0x87, 0xf7, 0x98, 0xdb, 0x1e, 0x46, 0x4b, 0xf1, 0x1a, 0x9f, 0xc7,
+		0x5b, 0xf4, 0x46, 0x47, 0x96, 0xbd, 0xe8, 0x42, 0xb4, 0x6d, 0x6b, 0xb9, 0xde, 0xa0, 0xe3, 0x78,
+		0xd1, 0x91, 0x17, 0x7c, 0x37, 0x9a, 0x98, 0xa4, 0xc1, 0x69, 0xbb, 0x67, 0xfc, 0x8e, 0xe9, 0x9f,
+		0x97, 0x26, 0xbf, 0x82, 0xed, 0xf7, 0xbe, 0x44, 0x61, 0x70, 0x1a, 0x18, 0xbd, 0x10, 0x7e, 0x98,
+		0x65, 0xd4, 0xd9, 0xfe, 0x77, 0xf3, 0xb3, 0x64, 0x37, 0x9b, 0x46, 0xbf, 0xc4, 0x12, 0xd8, 0x6c,
+		0x2f, 0x1a, 0x94, 0x7e, 0x34, 0x9b, 0x94, 0xd7, 0x37, 0x8c, 0x27, 0x1c, 0x9f, 0xff, 0x75, 0x6a,
+		0xbc, 0x75, 0xc8, 0x48, 0xd3, 0x9d, 0x6b, 0xb7, 0x97, 0xc0, 0x55, 0xbd, 0x6f, 0x27, 0x7e, 0x63,
+		0xa9, 0xe2, 0x07, 0xe7, 0x9d, 0x33, 0xc3, 0xb3, 0x54, 0x6c, 0xbf, 0x77, 0xb0, 0x04, 0xe6, 0xdb,
+		0x8e, 0x6e, 0xcc, 0x4e, 0x7a, 0x72, 0xcc, 0x3e, 0x17, 0x92, 0xe5, 0x6b, 0xfd, 0xd6, 0xf6, 0x1d,
+		0xd3, 0xe3, 0x37, 0x9f, 0xfe, 0xda, 0x8d, 0x1a, 0xc7, 0xe6, 0xbb, 0xda, 0x07, 0x81, 0x63, 0x47,
+		0xe6, 0x67, 0xbf, 0x8f, 0xf4, 0xac, 0xd9, 0x19, 0xca, 0x86, 0x5b, 0xbd, 0x9b, 0xac, 0xc8, 0x91,
+		0xd1, 0x69, 0xfb, 0x37, 0xbe, 0xbb, 0x1f, 0xfe, 0x08, 0x96, 0xc5, 0x66, 0x7b, 0x49, 0xb8, 0xbd,
+		0x5d, 0x6b, 0xb4, 0x2e, 0xc3, 0xef, 0x6e, 0x70, 0x9e, 0xd5, 0xb5, 0x31, 0x39, 0x10, 0xd5, 0x6e,
+		0x7b, 0xcb, 0x71, 0xe6, 0xe8, 0xf8, 0xec, 0xd0, 0xf0, 0x1c, 0xbb, 0xcf, 0xbd, 0xc0, 0xe8, 0xb5,
+		0xe8, 0x25, 0x37, 0x86, 0x47, 0x95, 0x2f, 0x8d, 0xc6, 0xf2, 0x6d, 0x6a, 0x34, 0xe7, 0x88, 0x23,
+		0xa3, 0xb3, 0x32, 0xdd, 0x2c, 0xad, 0x34, 0x70, 0xd3, 0x0d, 0xd3, 0xcf, 0x11, 0xd9, 0x1d, 0xdf,
+		0x0e, 0xb2, 0xc4, 0xeb, 0x96, 0xd9, 0x47, 0x6a, 0xcd, 0x66, 0xe0, 0xb6, 0xe1, 0xa7, 0x27, 0x86,
+		0x91, 0x8d, 0xb3, 0xbb, 0x87, 0x24, 0x4b, 0x5f, 0xf9, 0xc3, 0x6c, 0xd3, 0x66, 0xa7, 0xdd, 0xa3,
+		0xf0, 0x36, 0x7b, 0x11, 0xc3, 0x13, 0xae, 0x13, 0xdf, 0xf4, 0x2d, 0xc7, 0xd4, 0xfc, 0x2d, 0x47,
+		0xa3, 0x49, 0x86, 0x77, 0x6b, 0xdf, 0x78, 0xe9, 0xc1, 0xd0, 0xda, 0x19, 0x0d, 0x87, 0x8e, 0x53,
+		0x6f, 0x6e, 0x1b, 0xbd, 0xcf, 0xd2, 0x35, 0xfd, 0x90, 0x9d, 0x9d, 0x76, 0xff, 0xf4, 0xe2, 0xb4,
+		0x67, 0xb6, 0x62, 0xf5, 0xba, 0xcb, 0x10, 0xd1, 0xd8, 0x3c, 0xb6, 0x83, 0xe5, 0x88, 0x05, 0x18,
+		0x0d, 0xe9, 0x7b, 0xb3, 0xa1, 0x10, 0x74, 0xbc, 0xc0, 0xbb, 0xb1, 0x03, 0xa3, 0xcf, 0xb4, 0x24,
+		0xa9, 0x1d, 0x1f, 0x99, 0x8d, 0x86, 0x28, 0x32, 0x3b, 0x9d, 0xd7, 0x8e, 0x8d, 0xae, 0x4b, 0x15,
+		0xbb, 0xb6, 0x73, 0xb7, 0x7f, 0x71, 0x64, 0xf6, 0x3b, 0xdc, 0xf6, 0x7c, 0x3b, 0x36, 0xbf, 0xc2,
+		0x8b, 0xd7, 0xb5, 0xe3, 0x87, 0xc3, 0x8b, 0xfd, 0x13, 0xd3, 0x37, 0x48, 0xcf, 0x42, 0x2f, 0x48,
+		0x2f, 0xc3, 0xfe, 0x3f, 0x17, 0x6e, 0xec, 0x99, 0x4d, 0x9b, 0x3a, 0xf1, 0xbe, 0xef, 0x78, 0x07,
+		0x41, 0xfb, 0xcc, 0x68, 0xfa, 0x14, 0x24, 0x66, 0xd7, 0x59, 0xb8, 0xdf, 0xd8, 0x34, 0xf9, 0xf1,
+		0xff, 0xeb, 0x9b, 0x9d, 0xf8, 0x60, 0x27, 0x0f, 0x81, 0x63, 0x76, 0x15, 0xc9, 0x24, 0x3d, 0xb3,
+		0xcd, 0xe6, 0x7b, 0xc9, 0xd6, 0x85, 0x77, 0x6b, 0x7a, 0x46, 0xfe, 0x7f, 0x1b, 0x3b, 0x1b, 0x75,
+		0xf3, 0x93, 0x67, 0x3e, 0x5d, 0x98, 0xed, 0x4c, 0xb7, 0xcd, 0x2e, 0x60, 0xe6, 0x27, 0x3f, 0x96,
+		0x22, 0x4b, 0xf4, 0xd8, 0x76, 0x7c, 0xd3, 0x6b, 0x77, 0xde, 0xee, 0xec, 0x98, 0x1d, 0xa0, 0x74,
+		0xeb, 0x66, 0x57, 0x77, 0xa9, 0x2d, 0x07, 0x14, 0xf6, 0xba, 0x69, 0xf2, 0x94, 0x83, 0x65, 0x7c,
+		0x66, 0x62, 0x72, 0xd9, 0xee, 0x1a, 0x1d, 0x1d, 0xe8, 0x78, 0x37, 0xb1, 0xbb, 0x04, 0x67, 0x0a,
+		0xec, 0x7a, 0xe3, 0x38, 0x5a, 0x82, 0xf3, 0x36, 0xb6, 0x93, 0xba, 0xbe, 0x97, 0x1c, 0xbb, 0xa9,
+		0x7d, 0x74, 0x7a, 0x6a, 0xf4, 0xe1, 0xf1, 0xc4, 0x37, 0xdb, 0x1b, 0xed, 0xc4, 0x9d, 0xfa, 0xe6,
+		0x71, 0x27, 0xfe, 0xd4, 0x0b, 0xda, 0x66, 0x97, 0x78, 0x0e, 0x3a, 0xb6, 0xd1, 0x99, 0xbb, 0x37,
+		0x76, 0xe2, 0x39, 0xa6, 0x47, 0xcd, 0x4c, 0x8f, 0xcd, 0xf4, 0x0f, 0xd2, 0x7d, 0xd9, 0x3f, 0xd8,
+		0x3d, 0xd9, 0x35, 0x7c, 0x9f, 0xf7, 0x26, 0x0c, 0xda, 0xa6, 0xc7, 0x5f, 0xf7, 0x02, 0xdf, 0xec,
+		0xba, 0x54, 0x9d, 0xea, 0x6d, 0x12, 0x2c, 0xc1, 0x5e, 0x75, 0xc3, 0xf0, 0xa0, 0x5f, 0x37, 0x6c,
+		0xbb, 0x5d, 0xc3, 0x01, 0x7d, 0x68, 0x36, 0x07, 0xbf, 0x1f, 0xe4, 0x9d, 0x1c, 0x46, 0xbb, 0xed,
+		0x76, 0xec, 0x26, 0x89, 0xd9, 0x56, 0xee, 0xe7, 0x65, 0xf8, 0x39, 0xec, 0xc5, 0xcb, 0x53, 0xe9,
+		0xcc, 0xf8, 0x82, 0x54, 0x3f, 0x7e, 0xd8, 0xc1, 0x99, 0xd1, 0xae, 0x84, 0xe3, 0x1a, 0x0d, 0x8a,
+		0xae, 0xdb, 0xf6, 0xec, 0x63, 0xdb, 0xf3, 0xcd, 0x2f, 0xe0, 0xd4, 0x8d, 0xfc, 0xe4, 0xb2, 0x67,
+		0x7c, 0xb4, 0xc0, 0xf4, 0xb3, 0x98, 0xf7, 0xdd, 0x1f, 0x76, 0xec, 0x0e, 0x13, 0x16, 0x4f, 0x3c,
+		0xc7, 0x70, 0x03, 0x7e, 0xd1, 0xbb, 0x59, 0x8a, 0x72, 0xb1, 0x83, 0xd3, 0xca, 0xcb, 0x11, 0xdf,
+		0xcc, 0xb6, 0x8f, 0x7a, 0x4b, 0x55, 0xa5, 0xc3, 0x68, 0x0b, 0xe8, 0xa4, 0x07, 0xdd, 0x9e, 0xe9,
+		0x19, 0xd6, 0xe7, 0x59, 0xa1, 0x7d, 0xa3, 0xcd, 0x9f, 0xed, 0x5c, 0xb8, 0xce, 0x5e, 0x18, 0xa4,
+		0x71, 0xe8, 0xfb, 0x6e, 0xfb, 0xf0, 0xb3, 0xd1, 0x42, 0x15, 0xc4, 0x4b, 0xd0, 0x96, 0x65, 0xc3,
+		0x6e, 0x1f, 0xd9, 0xb7, 0xcb, 0x10, 0x5b, 0x3b, 0xbd, 0x30, 0x7d, 0x8b, 0xb8, 0x61, 0xb8, 0x82,
+		0x32, 0xda, 0xde, 0xb5, 0x83, 0x7f, 0x99, 0x7d, 0x54, 0xb6, 0x63, 0x27, 0xa9, 0xf1, 0x4d, 0x71,
+		0x86, 0xdd, 0xba, 0x3e, 0x7f, 0xd9, 0x37, 0xfe, 0x2d, 0xf6, 0x0f, 0x8d, 0x7e, 0x87, 0xdb, 0x4e,
+		0x64, 0xf8, 0xa9, 0xc0, 0xed, 0xad, 0xad, 0x9f, 0x8d, 0x96, 0xf9, 0x0d, 0xa5, 0x0d, 0x3f, 0xbe,
+		0x72, 0xdc, 0xf3, 0x53, 0xcf, 0xf7, 0x82, 0xef, 0xe6, 0x6f, 0x05, 0x7b, 0xd1, 0x12, 0x34, 0xc8,
+		0x71, 0x6e, 0xfc, 0x3f, 0x5d, 0x27, 0xb5, 0x2f, 0x52, 0x7b, 0x19, 0x08, 0x6c, 0xdd, 0x68, 0xd6,
+		0x94, 0xba, 0x71, 0xd7, 0xf0, 0xa4, 0xeb, 0x51, 0x21, 0xad, 0xcf, 0xde, 0x8d, 0xd9, 0xdc, 0xe3,
+		0x67, 0xa3, 0xd5, 0xf5, 0x8d, 0x36, 0x7a, 0x7e, 0x37, 0x5a, 0x92, 0xd6, 0xf0, 0x9f, 0xcc, 0xde,
+		0xc8, 0xf3, 0x12, 0xb3, 0x37, 0xbd, 0xbc, 0x2c, 0x9e, 0xec, 0xbb, 0xf6, 0xbd, 0xd1, 0xe6, 0x3a,
+		0x35, 0x7e, 0xab, 0xc5, 0xdf, 0xf0, 0xa2, 0x9f, 0xf7, 0xbe, 0xd9, 0x47, 0x98, 0xbd, 0x9b, 0xee,
+		0xc6, 0x56, 0x2d, 0xb2, 0xe3, 0xbd, 0x3b, 0xb3, 0x5f, 0x24, 0xdb, 0xbe, 0x33, 0x3a, 0xbd, 0xe0,
+		0xce, 0xf0, 0x32, 0x23, 0xb7, 0xf1, 0x46, 0x6d, 0xe3, 0x70, 0xff, 0x72, 0x19, 0x0a, 0xcc, 0xed,
+		0x25, 0x5d, 0xdb, 0x31, 0x9a, 0xf8, 0xdd, 0x6d, 0x34, 0x36, 0xbe, 0xd8, 0xa9, 0xfb, 0xdd, 0x75,
+		0x23, 0xb3, 0x99, 0x5f, 0x14, 0x87, 0xa9, 0x1b, 0x06, 0xdb, 0x35, 0xd3, 0x8b, 0xfe, 0x65, 0x31,
+		0x40, 0xa3, 0xa3, 0xb0, 0xde, 0xad, 0x97, 0xda, 0xfe, 0x5f, 0xb1, 0x1d, 0x45, 0x6e, 0xbc, 0x44,
+		0x5d, 0x1b, 0xb2, 0xac, 0x53, 0xc3, 0xe3, 0x06, 0xb7, 0x51, 0x68, 0xb4, 0xf5, 0x4e, 0x0c, 0xaf,
+		0xac, 0x9f, 0xd5, 0xd8, 0x5a, 0x82, 0x32, 0x0b, 0xc3, 0x70, 0xc1, 0x86, 0xf9, 0xf6, 0xaf, 0x9d,
+		0x98, 0xdd, 0xdc, 0x67, 0x63, 0xcb, 0xf4, 0xf0, 0x5f, 0x7d, 0x63, 0xa7, 0x69, 0xf4, 0x3b, 0x18,
+		0xce, 0xc7, 0xa3, 0x30, 0xd8, 0x34, 0xbb, 0xa8, 0x50, 0x12, 0x76, 0xd2, 0x2c, 0x19, 0xf0, 0x28,
+		0x0c, 0xa3, 0x1b, 0xdb, 0xf9, 0x6e, 0x76, 0x2c, 0xd9, 0xf8, 0xca, 0x17, 0x86, 0x1f, 0x6a, 0x4e,
+		0x6a, 0xe6, 0xef, 0x71, 0x0d, 0x7b, 0xbe, 0x5d, 0x98, 0x5e, 0xb5, 0xfa, 0xc8, 0xec, 0xe3, 0xbf,
+		0xdd, 0x76, 0xb2, 0xff, 0xd3, 0xe8, 0x62, 0xdb, 0xa9, 0xe1, 0x7c, 0x3b, 0x8b, 0xbd, 0x9a, 0x1e,
+		0x7a, 0xed, 0xf9, 0xa9, 0xd9, 0xf5, 0x17, 0x7f, 0x36, 0x5a, 0x91, 0xef, 0x2e, 0x81, 0xbf, 0xd3,
+		0xda, 0x4f, 0xe3, 0x25, 0x38, 0x66, 0x77, 0x79, 0x6b, 0x7a, 0x14, 0x66, 0x3f, 0x74, 0x92, 0xbf,
+		0xbc, 0xd8, 0xf5, 0xdd, 0x24, 0x59, 0x86, 0xdc, 0xf6, 0xa8, 0x1d, 0xf4, 0xb3, 0xfe, 0x32, 0x06,
+		0x5b, 0x5f, 0x82, 0x56, 0x13, 0xc3, 0x06, 0x0d, 0xa6, 0x53, 0xd9, 0x71, 0x36, 0xe6, 0xe7, 0x7f,
+		0x99, 0x7e, 0x8e, 0xc5, 0xec, 0xea, 0x17, 0x89, 0x63, 0x7e, 0xc6, 0xd9, 0x9e, 0xe1, 0x54, 0xaa,
+		0xdb, 0x59, 0x82, 0xb2, 0x8c, 0xae, 0x63, 0x7a, 0xd3, 0x8f, 0x49, 0x53, 0xd1, 0x58, 0x02, 0x53,
+		0x61, 0x76, 0x0f, 0x1c, 0xc3, 0x33, 0xf5, 0x9d, 0xb0, 0xd3, 0x71, 0x4d, 0x3f, 0x6f, 0xba, 0x9b,
+		0x78, 0x87, 0xcb, 0x50, 0x47, 0xa5, 0xb5, 0x77, 0xfe, 0xf9, 0xec, 0x30, 0x30, 0xbb, 0x8f, 0x4f,
+		0x12, 0xdf, 0x98, 0xdd, 0xa2, 0xd8, 0x6c, 0x23, 0xed, 0x84, 0xdd, 0x28, 0x4c, 0x3c, 0xf3, 0xa3,
+		0xc8, 0x37, 0xb7, 0x51, 0x14, 0xfa, 0x9e, 0xf3, 0x60, 0x3b, 0x4e, 0xd8, 0x0b, 0x52, 0xc3, 0x7b,
+		0xfb, 0x66, 0x69, 0x36, 0x67, 0x71, 0xf8, 0xf3, 0xc1, 0xf8, 0x9a, 0x11, 0x91, 0xdf, 0x33, 0xda,
+		0x97, 0xf0, 0x1b, 0xa6, 0xc7, 0x03, 0x93, 0x38, 0x5a, 0x8a, 0xa2, 0x04, 0xcb, 0x51, 0x24, 0xa2,
+		0x9f, 0xce, 0x78, 0x6e, 0x78, 0x3a, 0xa3, 0xd9, 0x34, 0xfc, 0x7e, 0xc3, 0xe8, 0x03, 0x82, 0xf7,
+		0x86, 0x27, 0x10, 0xdc, 0xc4, 0x5e, 0xfb, 0xd6, 0x6c, 0x27, 0xc2, 0xec, 0xa4, 0xbe, 0x4e, 0xfc,
+		0x39, 0x8c, 0x7f, 0xd8, 0xb1, 0xd9, 0x25, 0x3d, 0x43, 0xb3, 0x0f, 0x0b, 0xf9, 0xcb, 0xd2, 0xb0,
+		0xb1, 0x5f, 0x86, 0xca, 0x09, 0x83, 0xc0, 0x75, 0x52, 0xe3, 0x73, 0x20, 0xfa, 0x81, 0xd7, 0x8c,
+		0x6c, 0x2c, 0x41, 0x7c, 0xa0, 0x79, 0x19, 0x7e, 0x77, 0x83, 0x4f, 0xbd, 0x64, 0x09, 0x92, 0xc3,
+		0xcf, 0xc2, 0x1f, 0x6e, 0xec, 0x7b, 0x81, 0xbb, 0x4c, 0x3b, 0x91, 0xcb, 0x41, 0x68, 0xb3, 0x55,
+		0xb9, 0x35, 0xbd, 0xe0, 0xe7, 0xa0, 0x35, 0xc5, 0xb1, 0xed, 0x1c, 0x99, 0xde, 0x76, 0x66, 0x50,
+		0xe7, 0xf0, 0xc4, 0x73, 0x2e, 0x0d, 0x97, 0xab, 0xee, 0x43, 0xec, 0x19, 0xbe, 0xf7, 0x92, 0xa4,
+		0xb6, 0xf3, 0xfd, 0x32, 0xbc, 0x48, 0x0d, 0x4f, 0x32, 0x8d, 0xc2, 0xa0, 0xde, 0x6a, 0x99, 0x5f,
+		0x85, 0xee, 0x8f, 0xc0, 0x59, 0x92, 0x3a, 0x74, 0x59, 0xe5, 0xb0, 0xba, 0xe9, 0xb9, 0x8e, 0x87,
+		0x8e, 0x17, 0x99, 0x7e, 0x1a, 0x21, 0x2b, 0x46, 0x62, 0xf4, 0x42, 0x44, 0xbe, 0x63, 0x7e, 0xf9,
+		0x88, 0xbf, 0xd2, 0x68, 0x58, 0xd9, 0xd7, 0xf8, 0x7a, 0x99, 0x77, 0x5e, 0x14, 0x79, 0xa6, 0xb3,
+		0xdc, 0xe1, 0x62, 0x98, 0xfc, 0x1a, 0x76, 0xc7, 0xb7, 0x83, 0x7e, 0xb1, 0x4c, 0xa3, 0xdf, 0xc2,
+		0xf6, 0x4d, 0x4f, 0xfa, 0xf8, 0x12, 0x85, 0xc1, 0xd9, 0xdd, 0x43, 0x92, 0x25, 0x08, 0xfe, 0x11,
+		0x18, 0x0f, 0x8d, 0x7e, 0x65, 0xb4, 0xc8, 0x77, 0x7f, 0x86, 0xf1, 0x32, 0xf9, 0xb2, 0xcb, 0xd0,
+		0xb1, 0x73, 0xd0, 0xf5, 0xa1, 0x61, 0x76, 0x93, 0xa0, 0xb3, 0x1f, 0x97, 0x0f, 0x91, 0x4a, 0x98,
+		0x44, 0xea, 0xce, 0xab, 0x4a, 0x01, 0x73, 0xf5, 0xab, 0xa2, 0x34, 0x37, 0x77, 0x49, 0xe2, 0x59,
+		0x06, 0x3c, 0xe7, 0xed, 0x56, 0x6d, 0xc3, 0x4e, 0x1b, 0xdd, 0x1b, 0x13, 0x1e, 0xb6, 0x9d, 0xd4,
+		0x3f, 0xef, 0x1f, 0x99, 0xf0, 0xa4, 0x69, 0x5c, 0xab, 0x6d, 0x9b, 0xf0, 0xa0, 0x77, 0x5e, 0xe4,
+		0xc6, 0xbe, 0x1d, 0x34, 0x4c, 0x78, 0xd8, 0x61, 0xe3, 0x9f, 0xcb, 0xd8, 0x0e, 0x92, 0x28, 0x8c,
+		0x53, 0x23, 0x26, 0x38, 0xec, 0xba, 0x51, 0x60, 0x9b, 0xf0, 0xa8, 0x5e, 0xe7, 0xcf, 0x8e, 0x27,
+		0xa7, 0xd0, 0x8b, 0x7f, 0xd8, 0x41, 0xe5, 0xda, 0x33, 0xa9, 0x84, 0x9f, 0xe2, 0x9f, 0xf6, 0x69,
+		0x07, 0xeb, 0xc2, 0x8d, 0xef, 0x3d, 0xc7, 0x88, 0x29, 0x1e, 0xb4, 0x4b, 0x91, 0x8f, 0x53, 0x17,
+		0xff, 0xc4, 0x8e, 0x97, 0x38, 0xe1, 0xe1, 0xc5, 0x91, 0x5c, 0x16, 0xd5, 0x02, 0x9e, 0xf7, 0xa9,
+		0x1e, 0xe5, 0x59, 0x1c, 0x76, 0x3c, 0xdf, 0x08, 0xb9, 0x18, 0x85, 0xa2, 0x5a, 0x4d, 0x23, 0x14,
+		0x70, 0xfb, 0xae, 0xbe, 0xdd, 0x30, 0xc2, 0xbe, 0x0d, 0xab, 0x84, 0xd5, 0x25, 0xab, 0x84, 0x15,
+		0xff, 0xc0, 0x49, 0x76, 0x60, 0xe5, 0xcc, 0x4e, 0xef, 0x4c, 0x78, 0x58, 0x37, 0x71, 0x42, 0x23,
+		0xb4, 0x42, 0xdb, 0x31, 0xe2, 0x31, 0xbb, 0x91, 0x7b, 0x6b, 0x14, 0x15, 0xfb, 0xb9, 0xbd, 0x79,
+		0x64, 0x47, 0x46, 0xf0, 0x85, 0xc8, 0x8b, 0x4c, 0x78, 0xcc, 0x44, 0x2a, 0x3b, 0xb0, 0xf8, 0xe7,
+		0x1c, 0x36, 0xfd, 0x30, 0xc2, 0xb8, 0x46, 0x26, 0x51, 0x80, 0xcd, 0xbf, 0x8e, 0x77, 0x4f, 0x4c,
+		0x78, 0x60, 0xdf, 0x8e, 0xda, 0x46, 0xb8, 0x35, 0x69, 0x57, 0xaa, 0x2a, 0xcb, 0x02, 0x9e, 0xf4,
+		0xde, 0x73, 0xbc, 0xd3, 0x81, 0x47, 0x2e, 0xd9, 0x15, 0x68, 0x01, 0x36, 0x2b, 0x74, 0xec, 0x3f,
+		0xdd, 0x38, 0xf1, 0xc2, 0xa0, 0x6e, 0xc2, 0xf3, 0xc6, 0xd9, 0x0e, 0xe3, 0xf1, 0xee, 0x9e, 0x21,
+		0xd1, 0x39, 0x23, 0xe4, 0xd6, 0xf6, 0x5b, 0x46, 0xd8, 0xac, 0x7a, 0xdd, 0x0c, 0x7b, 0x25, 0x9d,
+		0xdf, 0xbd, 0x20, 0x1e, 0x20, 0xdd, 0x61, 0x67, 0x01, 0xf0, 0x4f, 0x1a, 0x1b, 0x0d, 0x43, 0xc2,
+		0xc8, 0x91, 0xa7, 0xd0, 0x23, 0x78, 0x01, 0xde, 0x60, 0xe4, 0xc7, 0x89, 0x19, 0x00, 0xeb, 0xe7,
+		0x89, 0xfb, 0xf6, 0x0f, 0x43, 0x78, 0x56, 0xc7, 0x90, 0xd8, 0xe6, 0x6e, 0xe2, 0x9d, 0xf6, 0x52,
+		0x83, 0xf6, 0x3d, 0xa4, 0xab, 0x94, 0x2f, 0x60, 0x7a, 0xa7, 0x9b, 0x3b, 0x1b, 0xf4, 0xec, 0x5d,
+		0x2f, 0x94, 0xea, 0xea, 0xb7, 0x80, 0x80, 0x46, 0xa3, 0x75, 0xd7, 0x0b, 0xd2, 0x2f, 0x72, 0x09,
+		0xf1, 0x0b, 0x89, 0x6f, 0x46, 0xa3, 0x1c, 0x8e, 0xb3, 0xc6, 0x99, 0x21, 0x7a, 0xe2, 0xb2, 0x6d,
+		0x04, 0x57, 0xb8, 0x7b, 0x88, 0xdc, 0xd8, 0x31, 0x07, 0x66, 0x63, 0x2e, 0x76, 0x18, 0x19, 0xf5,
+		0xb8, 0x9f, 0xc7, 0x5b, 0x79, 0x46, 0x44, 0xe8, 0xbc, 0xe8, 0xc2, 0xbb, 0x35, 0x62, 0x8b, 0xd4,
+		0xf1, 0x22, 0xb9, 0xc2, 0x20, 0x0b, 0x30, 0xc8, 0x69, 0x70, 0xda, 0xee, 0x19, 0xb3, 0x43, 0xf3,
+		0xe7, 0xa5, 0x11, 0x8e, 0xf9, 0x20, 0xfd, 0xf2, 0x34, 0x30, 0x62, 0x62, 0xfd, 0x30, 0xcb, 0x44,
+		0xb1, 0xfd, 0xef, 0xe6, 0x64, 0x79, 0x6d, 0x36, 0x8d, 0x78, 0x58, 0x83, 0x6c, 0x98, 0x17, 0x5d,
+		0xfc, 0xf0, 0x52, 0xe7, 0xce, 0x0c, 0xb2, 0x58, 0xdf, 0x30, 0xc6, 0xd0, 0x7e, 0xfe, 0xd7, 0xa9,
+		0x31, 0xda, 0x55, 0xb9, 0x89, 0xd4, 0x02, 0xe6, 0x38, 0x2b, 0x96, 0x64, 0xa4, 0x1f, 0x79, 0xde,
+		0x39, 0x33, 0x64, 0xb7, 0x79, 0x58, 0x42, 0xd2, 0x14, 0x73, 0x66, 0x47, 0x37, 0x66, 0x24, 0x1d,
+		0x38, 0x66, 0xe4, 0xff, 0x66, 0x79, 0x11, 0xbf, 0xb5, 0x7d, 0x23, 0x9e, 0x36, 0xf3, 0xcb, 0x3f,
+		0xfd, 0xb5, 0x1b, 0x35, 0x8e, 0xcd, 0x71, 0xc5, 0x0e, 0x02, 0xc7, 0x8e, 0xcc, 0xc9, 0xa2, 0x94,
+		0x2f, 0x04, 0xbf, 0x88, 0x8c, 0x39, 0x43, 0xac, 0xc3, 0x8d, 0x97, 0x26, 0x66, 0xa4, 0x79, 0x3e,
+		0xab, 0x28, 0x67, 0x8e, 0x0d, 0x1b, 0x15, 0x7f, 0xed, 0x17, 0x77, 0x39, 0x97, 0x2a, 0x73, 0xb9,
+		0x80, 0x40, 0x42, 0xbb, 0xed, 0x99, 0x95, 0x13, 0x7e, 0x7c, 0x76, 0x68, 0x48, 0x6e, 0xca, 0xe7,
+		0x5e, 0x60, 0xc4, 0xdc, 0xf6, 0x92, 0x1b, 0x43, 0xa2, 0x73, 0x97, 0x46, 0x60, 0xea, 0x36, 0x35,
+		0xc2, 0xd6, 0xc6, 0x91, 0x11, 0x59, 0x48, 0xa3, 0x0e, 0xdd, 0x1b, 0xa6, 0xe4, 0x79, 0x8f, 0xcf,
+		0xa7, 0xb7, 0xcc, 0x38, 0x1a, 0x64, 0x06, 0x33, 0xb4, 0x0d, 0xc9, 0x9e, 0x1d, 0x7a, 0xb2, 0x4a,
+		0xe7, 0xe2, 0x17, 0x62, 0xad, 0x86, 0x9d, 0x7e, 0x0c, 0x49, 0xf4, 0x4b, 0x7c, 0x53, 0xb6, 0x3e,
+		0x52, 0x73, 0xb6, 0x3e, 0x8c, 0x30, 0xae, 0xde, 0xad, 0x7d, 0xe3, 0x0d, 0x9a, 0x39, 0x19, 0xf2,
+		0xc8, 0x71, 0xc7, 0xa9, 0x37, 0xb7, 0x8d, 0x88, 0x27, 0x77, 0x4d, 0x39, 0xa4, 0x90, 0xb5, 0x5f,
+		0x97, 0x2d, 0x65, 0xb3, 0x00, 0xd7, 0xb0, 0x6b, 0x92, 0x07, 0xbb, 0x79, 0x6c, 0x07, 0x66, 0xf9,
+		0x84, 0x46, 0x40, 0xeb, 0xde, 0x0c, 0x51, 0x0d, 0x3a, 0x5e, 0xe0, 0xdd, 0xd8, 0x81, 0x11, 0x39,
+		0xca, 0x49, 0x6a, 0xc7, 0x47, 0x66, 0x48, 0x6b, 0x14, 0x99, 0x91, 0x76, 0x66, 0xc7, 0x46, 0xd4,
+		0x5f, 0x88, 0x5d, 0xdb, 0xb9, 0xdb, 0xbf, 0x38, 0x32, 0xe3, 0x59, 0x6f, 0x7b, 0xbe, 0x1d, 0x9b,
+		0x73, 0xb2, 0xda, 0xeb, 0xda, 0xf1, 0xc3, 0xe1, 0xc5, 0xfe, 0x89, 0x29, 0x1b, 0x32, 0x67, 0xa1,
+		0x17, 0xa4, 0x97, 0x61, 0xff, 0x9f, 0x0b, 0x37, 0xf6, 0xcc, 0xa0, 0x05, 0x9d, 0x78, 0xdf, 0x77,
+		0xbc, 0x83, 0xa0, 0x7d, 0x66, 0x04, 0x3d, 0x08, 0x12, 0x33, 0xce, 0x5b, 0xde, 0x6f, 0x6c, 0x9a,
+		0xf0, 0x98, 0xff, 0xf5, 0xcd, 0xd8, 0xf0, 0xb4, 0x93, 0x87, 0xc0, 0x31, 0xa3, 0x7a, 0x50, 0x92,
+		0x9e, 0xd9, 0x66, 0xf0, 0x96, 0x64, 0x4b, 0xba, 0x07, 0xeb, 0x02, 0x24, 0xb5, 0xb1, 0xb3, 0x51,
+		0x37, 0x67, 0xb3, 0xfb, 0xd3, 0x85, 0x19, 0xce, 0x56, 0xdb, 0x8c, 0x02, 0x1b, 0x7e, 0xf2, 0xc3,
+		0xa8, 0xec, 0x27, 0xf9, 0x82, 0x97, 0x0b, 0x88, 0x66, 0xed, 0xec, 0x98, 0x11, 0x18, 0x72, 0xeb,
+		0x66, 0x9c, 0xaa, 0xae, 0x99, 0x25, 0xaa, 0x7b, 0xdd, 0x54, 0xa9, 0xd9, 0xc8, 0x82, 0x32, 0x74,
+		0x92, 0xcb, 0x76, 0xd7, 0x08, 0x2f, 0xb1, 0xe3, 0xdd, 0xc4, 0xae, 0x41, 0xb9, 0xa6, 0x76, 0xbd,
+		0x71, 0x1c, 0x19, 0x94, 0x27, 0x6d, 0x3b, 0xa9, 0xeb, 0x7b, 0xc9, 0xb1, 0x9b, 0xda, 0x47, 0xa7,
+		0xa7, 0x46, 0x1c, 0x52, 0x4b, 0x7c, 0x33, 0xbc, 0x98, 0x4e, 0xdc, 0xa9, 0x6f, 0x1e, 0x77, 0xe2,
+		0x4f, 0xbd, 0xa0, 0x6d, 0x46, 0xa9, 0xbb, 0xa0, 0x63, 0x1b, 0x91, 0x61, 0x76, 0x63, 0x27, 0x9e,
+		0x63, 0x4a, 0x74, 0xc3, 0x14, 0x9f, 0xbb, 0x7f, 0x10, 0xe1, 0xcb, 0xfe, 0xc1, 0xee, 0xc9, 0xae,
+		0x21, 0xfb, 0x47, 0x37, 0x61, 0xd0, 0x36, 0x25, 0xbe, 0xb5, 0x17, 0xf8, 0x66, 0xd4, 0x5f, 0xe8,
+		0x54, 0x6f, 0x93, 0xc0, 0xa0, 0xbd, 0xae, 0x86, 0x21, 0x41, 0x98, 0x6e, 0xd8, 0x76, 0xbb, 0x86,
+		0x00, 0xeb, 0xd0, 0x0c, 0x6e, 0x78, 0x3f, 0xd8, 0x3f, 0x3e, 0x8c, 0x76, 0xdb, 0xed, 0xd8, 0x4d,
+		0x12, 0x33, 0xac, 0xc1, 0xcf, 0xcb, 0xf0, 0x73, 0xd8, 0x8b, 0xcd, 0xab, 0xc4, 0x61, 0x4c, 0xe1,
+		0x85, 0x41, 0xb3, 0x09, 0x23, 0x52, 0xe5, 0x5d, 0x23, 0x84, 0xb6, 0xeb, 0xb6, 0x3d, 0xfb, 0xd8,
+		0xf6, 0x7c, 0x73, 0x0a, 0x18, 0x74, 0x23, 0x3f, 0xb9, 0xec, 0x19, 0xe3, 0x35, 0x9a, 0x72, 0x06,
+		0x65, 0xd0, 0x02, 0x73, 0x98, 0xb8, 0x73, 0xe2, 0x39, 0x86, 0x18, 0xb4, 0x8b, 0xde, 0x8d, 0x51,
+		0xe5, 0xba, 0x06, 0xa7, 0xa8, 0xcc, 0x8a, 0x2b, 0x65, 0xe1, 0xf0, 0x9e, 0x91, 0xa7, 0x6c, 0x8d,
+		0xb0, 0x14, 0x4e, 0x7a, 0xd0, 0xed, 0x99, 0x92, 0xd9, 0x27, 0xd9, 0x2a, 0x70, 0x01, 0x66, 0xa2,
+		0xdf, 0xbc, 0x74, 0x4f, 0xa9, 0x75, 0xe9, 0x02, 0x84, 0x21, 0x88, 0x0d, 0x2a, 0x9b, 0xbc, 0x61,
+		0xb7, 0x8f, 0xec, 0x5b, 0x93, 0x62, 0x20, 0xa7, 0x17, 0xa6, 0x6c, 0x3d, 0x35, 0x0c, 0x51, 0x08,
+		0x46, 0xd8, 0x85, 0x76, 0xf0, 0x2f, 0x33, 0x8e, 0xfc, 0x74, 0xec, 0x24, 0x35, 0xa6, 0x08, 0xf5,
+		0xb0, 0x1a, 0xfd, 0xe7, 0x2f, 0xfb, 0xc6, 0x3c, 0xed, 0xfe, 0xa1, 0x11, 0xcf, 0x7a, 0xdb, 0x89,
+		0x0c, 0x39, 0x3d, 0xb1, 0xbd, 0xb5, 0xf5, 0xb3, 0xd1, 0x32, 0xa7, 0xd1, 0x96, 0x21, 0xe9, 0xc8,
+		0xfd, 0x86, 0xa7, 0xbe, 0x17, 0x7c, 0x37, 0x67, 0x8b, 0xc9, 0x8b, 0x0c, 0x2a, 0x48, 0xed, 0xdc,
+		0xf8, 0x7f, 0xba, 0x4e, 0x6a, 0x5f, 0xa4, 0xb6, 0x49, 0x84, 0xab, 0x6e, 0x04, 0x2b, 0x48, 0xdd,
+		0xb8, 0x6b, 0x48, 0xb2, 0xdf, 0xa8, 0x30, 0xc4, 0x67, 0xef, 0xc6, 0x0c, 0x9b, 0xfb, 0xb3, 0xd1,
+		0xea, 0xfa, 0x46, 0x18, 0x07, 0xbf, 0x1b, 0x19, 0xd6, 0xba, 0xee, 0x93, 0x19, 0x1b, 0x0d, 0x5e,
+		0x62, 0x46, 0xb0, 0xde, 0xcb, 0xe2, 0x72, 0xbe, 0x6b, 0xdf, 0x1b, 0x61, 0xbe, 0x52, 0x63, 0x42,
+		0xca, 0xfe, 0x86, 0x17, 0xfd, 0x34, 0xa5, 0x33, 0xa4, 0x77, 0xd3, 0xdd, 0xd8, 0xaa, 0x45, 0x76,
+		0xbc, 0x77, 0x67, 0xc6, 0x03, 0x67, 0xdb, 0x0b, 0x46, 0x6c, 0x37, 0xde, 0x19, 0x72, 0x1c, 0xf8,
+		0x36, 0xde, 0xa8, 0x6d, 0x1c, 0xee, 0x5f, 0x9a, 0x54, 0xd0, 0x64, 0x2f, 0xe9, 0xda, 0x8e, 0x11,
+		0x04, 0xe6, 0x6e, 0xa3, 0xb1, 0xf1, 0xc5, 0x4e, 0xdd, 0xef, 0xae, 0x1b, 0xb9, 0xb1, 0x41, 0xcd,
+		0x41, 0xb7, 0x8d, 0x69, 0x0e, 0x9a, 0xc5, 0x64, 0x8c, 0x88, 0x72, 0x79, 0xb7, 0x5e, 0x6a, 0xfb,
+		0x7f, 0xc5, 0x76, 0x14, 0xb9, 0xb1, 0x81, 0xd5, 0x5c, 0xb3, 0x6c, 0x2a, 0x43, 0xfc, 0xc7, 0xdb,
+		0xc8, 0x8c, 0x16, 0xac, 0x89, 0x21, 0x95, 0x3b, 0xb3, 0x9a, 0x11, 0x06, 0x1d, 0xb7, 0x1c, 0xba,
+		0x8d, 0x1b, 0xe6, 0xd8, 0x89, 0x76, 0x62, 0x46, 0xd1, 0xec, 0x8d, 0x2d, 0x53, 0xc2, 0x31, 0xf5,
+		0x8d, 0x1d, 0x23, 0xfa, 0x86, 0x7b, 0x86, 0xf0, 0xc4, 0x28, 0x0c, 0x36, 0xcd, 0x38, 0x84, 0x9f,
+		0x84, 0x9d, 0x34, 0x4b, 0x96, 0x39, 0x0a, 0xc3, 0xe8, 0xc6, 0x76, 0xbe, 0x9b, 0x11, 0x93, 0x33,
+		0xe6, 0x44, 0xab, 0x21, 0x87, 0xad, 0x92, 0x9a, 0x39, 0xb1, 0xf9, 0x61, 0x2f, 0x82, 0x0b, 0x53,
+		0xaa, 0xf4, 0x1d, 0x99, 0x71, 0x5c, 0xa9, 0xdb, 0x4e, 0x0c, 0x69, 0x72, 0x9c, 0x1a, 0xc2, 0x03,
+		0xb3, 0xd8, 0x96, 0x29, 0xa1, 0xad, 0x9e, 0x9f, 0x9a, 0x51, 0x8f, 0xe7, 0x67, 0xa3, 0x15, 0xf9,
+		0xae, 0x41, 0xbc, 0xba, 0xb5, 0x9f, 0xc6, 0x06, 0x1d, 0x53, 0xb8, 0xbc, 0x35, 0xc5, 0xbb, 0xde,
+		0x0f, 0x9d, 0x64, 0xd4, 0xb8, 0xd0, 0xa4, 0x1c, 0xc9, 0xa8, 0x1d, 0xf4, 0xb3, 0x62, 0x32, 0xc6,
+		0x55, 0x37, 0xa8, 0xd4, 0xec, 0xb0, 0x70, 0xab, 0x29, 0xd4, 0x6b, 0x9c, 0x7d, 0xf4, 0xf9, 0x5f,
+		0xa6, 0xe4, 0x25, 0x9b, 0x71, 0xaa, 0x35, 0x71, 0x0c, 0xea, 0xd0, 0x6c, 0x08, 0x55, 0xe8, 0x76,
+		0x0c, 0x2a, 0xd3, 0xe3, 0x3a, 0xa6, 0x14, 0xf1, 0x9d, 0x54, 0xb5, 0x0d, 0x83, 0x54, 0xad, 0x19,
+		0x35, 0xa7, 0x0d, 0xc9, 0xec, 0x74, 0xc2, 0x4e, 0xc7, 0x75, 0xcd, 0xe9, 0x7f, 0x7e, 0x68, 0xd2,
+		0xb9, 0xe6, 0xd6, 0xde, 0xf9, 0xe7, 0xb3, 0xc3, 0xc0, 0x8c, 0xfa, 0xd8, 0x49, 0x7c, 0x63, 0x46,
+		0xab, 0x27, 0x33, 0x8c, 0x96, 0x13, 0x76, 0xa3, 0x30, 0xf1, 0xcc, 0x89, 0xc6, 0xdd, 0xdc, 0x46,
+		0x51, 0xe8, 0x7b, 0xce, 0x83, 0xed, 0x38, 0x61, 0x2f, 0x48, 0x0d, 0xe9, 0x9d, 0x94, 0x6d, 0x8b,
+		0x9f, 0xc5, 0xe1, 0xcf, 0x07, 0x63, 0xce, 0x88, 0x46, 0x7e, 0xcf, 0x08, 0x2e, 0xeb, 0x37, 0x4c,
+		0x89, 0xcf, 0x24, 0x71, 0x64, 0xd4, 0xa1, 0x45, 0xb3, 0x0e, 0x85, 0xf6, 0xd3, 0x7a, 0xce, 0x0d,
+		0x49, 0xeb, 0x31, 0x83, 0x1e, 0xde, 0x6f, 0xb4, 0x4c, 0x69, 0xba, 0x6b, 0x84, 0xe9, 0x8a, 0xbd,
+		0xf6, 0xad, 0x19, 0x24, 0xd6, 0x8c, 0xa4, 0x97, 0x4e, 0xfc, 0x39, 0x8c, 0x7f, 0xd8, 0xb1, 0x19,
+		0xa5, 0x99, 0x42, 0x33, 0x92, 0xb9, 0x7d, 0xd3, 0x1a, 0x7a, 0xf4, 0xcb, 0x2d, 0x38, 0x61, 0x10,
+		0xb8, 0x4e, 0x6a, 0xcc, 0xde, 0x67, 0x3f, 0xb0, 0x95, 0x19, 0x59, 0x83, 0xfc, 0xc4, 0x66, 0xbf,
+		0x5b, 0xe9, 0xa7, 0x5e, 0x62, 0x50, 0xf2, 0xe1, 0x59, 0xf8, 0xc3, 0x8d, 0x7d, 0x2f, 0x70, 0x4d,
+		0xdc, 0x11, 0x31, 0x8b, 0x80, 0x65, 0xb3, 0x7c, 0x6b, 0x4a, 0xe1, 0xa6, 0x41, 0x69, 0xda, 0x63,
+		0xdb, 0x39, 0x32, 0xa5, 0xfc, 0xf3, 0xa0, 0x1e, 0xce, 0x89, 0xe7, 0x5c, 0x1a, 0x22, 0x0f, 0xdd,
+		0x87, 0xd8, 0x33, 0x24, 0xc6, 0x9c, 0xa4, 0xb6, 0xf3, 0xfd, 0x32, 0xbc, 0x48, 0x0d, 0x49, 0x9e,
+		0x8a, 0xc2, 0xa0, 0xde, 0x6a, 0x99, 0x53, 0xf5, 0xe4, 0x8f, 0xc0, 0x31, 0xac, 0xee, 0x49, 0x56,
+		0xf1, 0xa2, 0x6e, 0x4a, 0xce, 0xcf, 0xa1, 0xe3, 0x45, 0xa6, 0x64, 0xa9, 0x66, 0x87, 0x86, 0x8d,
+		0x98, 0xd8, 0xc8, 0x77, 0xcc, 0x39, 0x2e, 0xfa, 0x57, 0x1a, 0x0d, 0x2b, 0xa5, 0x19, 0x53, 0x0f,
+		0xe9, 0xce, 0x8b, 0x22, 0xcf, 0x14, 0x56, 0x66, 0x50, 0xff, 0xc8, 0x71, 0x4b, 0x69, 0x23, 0xf2,
+		0xeb, 0x6d, 0xdb, 0x37, 0x65, 0x53, 0xf7, 0x8b, 0x79, 0xad, 0x9a, 0x33, 0xd1, 0xed, 0x57, 0xee,
+		0x88, 0x7c, 0xf7, 0x67, 0x18, 0x9b, 0xe8, 0x03, 0x99, 0xd4, 0xa1, 0x65, 0x50, 0x0d, 0xb6, 0x61,
+		0x46, 0xf1, 0xed, 0xb3, 0x1f, 0x97, 0x0f, 0x91, 0x8c, 0x5b, 0x2c, 0x74, 0xc7, 0x55, 0x85, 0x77,
+		0xdc, 0xc7, 0x8a, 0xda, 0x27, 0xe6, 0xcc, 0xb4, 0xb5, 0x1b, 0x04, 0x61, 0x6a, 0xa7, 0x5e, 0x18,
+		0x58, 0x1f, 0x73, 0xcc, 0xb1, 0x95, 0x38, 0x77, 0x6e, 0xd7, 0x8e, 0xec, 0xf4, 0xce, 0xfa, 0x48,
+		0xd6, 0x7a, 0x18, 0xb9, 0x19, 0xd5, 0xed, 0x78, 0xb7, 0x55, 0x6f, 0x54, 0x05, 0x34, 0x59, 0x7f,
+		0xed, 0xc7, 0xf5, 0xc1, 0xc7, 0xac, 0x8a, 0xdc, 0xbb, 0xbc, 0xf1, 0x1e, 0xe3, 0x43, 0xc3, 0x73,
+		0xdf, 0xe0, 0xc5, 0x31, 0x63, 0x6b, 0xce, 0xec, 0xfc, 0xee, 0x05, 0x6d, 0xeb, 0x23, 0xd5, 0xe7,
+		0x7c, 0x6c, 0x6f, 0xf0, 0x6a, 0x1f, 0xa9, 0x36, 0xe7, 0x83, 0x67, 0xb1, 0xdb, 0xf1, 0x7e, 0xe6,
+		0x9b, 0xe9, 0xd1, 0xb3, 0x86, 0x4e, 0xd5, 0x4d, 0xef, 0xac, 0xf9, 0x88, 0xb1, 0x2e, 0xc2, 0x5e,
+		0xec, 0xb8, 0xb9, 0x46, 0x1f, 0xbc, 0x9d, 0xfb, 0xf0, 0x23, 0x8c, 0xdb, 0x03, 0x2d, 0xd4, 0x7f,
+		0xb0, 0xb5, 0x7c, 0x37, 0xfe, 0x66, 0x27, 0xbb, 0xf1, 0x6d, 0xaf, 0xeb, 0x06, 0xd9, 0x9c, 0xa7,
+		0x71, 0xcf, 0xcd, 0x79, 0xe3, 0xc4, 0x5d, 0xa3, 0xf7, 0xd2, 0x2c, 0xdf, 0xfb, 0x5e, 0x9c, 0x6f,
+		0xba, 0x9d, 0xd1, 0x1a, 0xe6, 0x9c, 0xbc, 0xa7, 0x84, 0x82, 0xfe, 0x7d, 0x39, 0x27, 0x20, 0x9f,
+		0x40, 0x09, 0x0b, 0x96, 0x8c, 0x80, 0xc9, 0x0b, 0x9a, 0xac, 0xc0, 0x29, 0x0b, 0x9e, 0xb2, 0x00,
+		0x2a, 0x09, 0xa2, 0xa0, 0xe2, 0xce, 0xb9, 0x62, 0x79, 0x05, 0x74, 0x74, 0x59, 0xf6, 0xed, 0x6d,
+		0xec, 0xde, 0xda, 0xa9, 0x5b, 0xf5, 0xda, 0xe2, 0x53, 0x3f, 0xe6, 0x77, 0x93, 0xa3, 0x08, 0x4e,
+		0xde, 0x50, 0x88, 0x6b, 0x82, 0xb7, 0x89, 0x0a, 0xb3, 0x8a, 0x50, 0xbf, 0x26, 0xdc, 0xbe, 0x7d,
+		0x6b, 0xad, 0x89, 0x8f, 0x20, 0x29, 0xe4, 0x6c, 0xc2, 0xce, 0x26, 0xf4, 0xb3, 0x84, 0xdf, 0x97,
+		0xaa, 0x20, 0x2b, 0x74, 0x87, 0x20, 0xdf, 0xb3, 0xfa, 0x4c, 0x4d, 0x69, 0xbd, 0x7d, 0xd7, 0xee,
+		0xc4, 0x6e, 0x47, 0x66, 0xc1, 0x47, 0x3a, 0x7a, 0x4b, 0xe2, 0xde, 0xb3, 0x31, 0x31, 0x72, 0xaa,
+		0x5e, 0xe7, 0xe3, 0x04, 0x11, 0x9a, 0xfa, 0xc3, 0xf0, 0xf7, 0x20, 0x7b, 0xdc, 0x8a, 0x9e, 0x89,
+		0x17, 0x98, 0x74, 0xcb, 0xee, 0xa5, 0x61, 0x35, 0x70, 0x6f, 0xc3, 0xd4, 0xb3, 0x53, 0x57, 0x41,
+		0xad, 0x3c, 0x1f, 0x47, 0x70, 0xd9, 0xf7, 0xdd, 0x8e, 0xdd, 0xf3, 0xfb, 0x72, 0x99, 0x89, 0xf7,
+		0x8a, 0xe8, 0x25, 0x31, 0xa3, 0xbb, 0x3a, 0x7a, 0x49, 0xc8, 0x28, 0x9b, 0xa2, 0x97, 0x6e, 0xc2,
+		0xd0, 0x77, 0xed, 0x40, 0x45, 0x2f, 0xd5, 0x4b, 0xa0, 0x2f, 0xda, 0xbd, 0x2c, 0xc2, 0x52, 0xcd,
+		0x3a, 0x2f, 0xc9, 0x2b, 0x8b, 0xc9, 0x41, 0x00, 0x75, 0x40, 0x7d, 0xc9, 0xa0, 0xee, 0x06, 0xbd,
+		0xae, 0x1b, 0x0f, 0x62, 0x2b, 0x0a, 0x70, 0x6f, 0x4a, 0xdc, 0x7b, 0x10, 0xf4, 0xba, 0xd9, 0xc3,
+		0x3f, 0x96, 0x40, 0x55, 0xb8, 0x41, 0x96, 0xf6, 0x53, 0xed, 0xf8, 0xe1, 0x8f, 0xea, 0x70, 0x57,
+		0x54, 0x5e, 0x65, 0xbc, 0x36, 0x98, 0x3c, 0xc9, 0xe8, 0xd8, 0x7e, 0x02, 0xd5, 0x03, 0xd5, 0x03,
+		0x96, 0x51, 0x4e, 0x96, 0xd1, 0xb5, 0x9d, 0xaa, 0x3d, 0x6c, 0x40, 0x28, 0xad, 0x32, 0x26, 0x07,
+		0x01, 0xd4, 0x01, 0xf5, 0x25, 0x83, 0xba, 0xbc, 0x78, 0x3f, 0x83, 0xfb, 0xb6, 0x5c, 0xb0, 0x23,
+		0x75, 0xe3, 0xc0, 0xfa, 0x48, 0x5f, 0xe5, 0xd6, 0xe7, 0x3f, 0x5f, 0x6b, 0xd5, 0x1d, 0xbb, 0xda,
+		0xd9, 0xad, 0x7e, 0xbe, 0xfa, 0xd5, 0x78, 0x7c, 0xf7, 0xf1, 0xf9, 0xef, 0xef, 0x7f, 0xb5, 0x1e,
+		0xff, 0x21, 0xbe, 0x62, 0x57, 0x25, 0xd0, 0x5c, 0x51, 0x18, 0xa7, 0xd5, 0x24, 0x72, 0x5d, 0x85,
+		0x10, 0xed, 0xc4, 0x18, 0xd0, 0x5b, 0xd0, 0x5b, 0x4b, 0xa6, 0xb7, 0xbc, 0xb6, 0x1b, 0xa4, 0x5e,
+		0xfa, 0xa0, 0x18, 0xa4, 0x6d, 0x49, 0xdc, 0x7b, 0x38, 0xfc, 0xea, 0x4f, 0x76, 0xa2, 0x20, 0x36,
+		0xa3, 0x17, 0x39, 0xb8, 0xfc, 0xed, 0xe0, 0xfc, 0xe4, 0xe0, 0xf2, 0xfa, 0xe2, 0xec, 0xe0, 0x60,
+		0x5f, 0x56, 0x76, 0xfe, 0xb4, 0xfd, 0x9e, 0x9b, 0x48, 0xab, 0x52, 0x92, 0xce, 0x90, 0x78, 0xf1,
+		0x3e, 0xfd, 0xd7, 0xb8, 0xae, 0x7f, 0xf9, 0x64, 0x49, 0x8f, 0xf7, 0xb8, 0x56, 0x92, 0x97, 0xa8,
+		0x2d, 0xc3, 0x5b, 0xb4, 0x96, 0xe2, 0x2d, 0xfe, 0x38, 0xf9, 0xfd, 0xe4, 0xf4, 0xaf, 0x93, 0x65,
+		0x10, 0xaa, 0xa5, 0x58, 0x8f, 0x46, 0x6b, 0x29, 0xb0, 0xb1, 0x0c, 0x2f, 0xd1, 0xac, 0x2d, 0x87,
+		0xb2, 0x3d, 0x5e, 0x8a, 0xb7, 0x58, 0x8a, 0xd7, 0x68, 0xb4, 0x14, 0xdf, 0x43, 0xea, 0xce, 0x2b,
+		0xdd, 0x2c, 0x75, 0x41, 0x39, 0x3a, 0x82, 0x49, 0x92, 0x0c, 0xc9, 0x92, 0xa3, 0x04, 0xc5, 0x5c,
+		0x59, 0x93, 0xf9, 0x5f, 0x3a, 0xc7, 0x0b, 0x67, 0x67, 0xc3, 0x04, 0x32, 0x00, 0x26, 0x8f, 0x94,
+		0xe5, 0xde, 0xf0, 0x97, 0x4e, 0x83, 0x6b, 0x20, 0x0d, 0x8e, 0xd3, 0xe1, 0x44, 0x1a, 0x1c, 0xd2,
+		0xe0, 0x34, 0x0b, 0x39, 0x9b, 0xb0, 0xb3, 0x09, 0xfd, 0x2c, 0xe1, 0x47, 0x1a, 0x1c, 0x11, 0xd2,
+		0xe0, 0x90, 0x06, 0x87, 0xe8, 0x2f, 0xa2, 0xbf, 0x44, 0xd8, 0xa0, 0xce, 0x71, 0x59, 0xfd, 0x8a,
+		0x8c, 0x6e, 0xac, 0xb0, 0x3b, 0x3d, 0x1e, 0x41, 0x0e, 0xe4, 0x75, 0x80, 0x1c, 0x20, 0xd7, 0x03,
+		0x72, 0x51, 0x46, 0x3e, 0xba, 0x2c, 0x2f, 0xa8, 0x66, 0x45, 0x0f, 0xfe, 0x5b, 0xed, 0x97, 0xac,
+		0x4a, 0xd4, 0x77, 0x58, 0xa6, 0x07, 0x94, 0x9c, 0x72, 0x39, 0xbb, 0xa8, 0x0c, 0x1d, 0x0e, 0x08,
+		0xf1, 0x41, 0x89, 0x0b, 0x52, 0xec, 0xd0, 0x62, 0x87, 0x18, 0x2b, 0xd4, 0xe4, 0x20, 0x27, 0x09,
+		0x3d, 0x75, 0x3b, 0x3b, 0xcb, 0xcc, 0x6c, 0x36, 0x55, 0x44, 0x66, 0x08, 0xa0, 0x6d, 0x85, 0x21,
+		0xce, 0xed, 0xe0, 0xd6, 0x55, 0xda, 0xe5, 0x24, 0xe5, 0x50, 0x29, 0x11, 0x91, 0x75, 0xec, 0x05,
+		0xd6, 0x47, 0x86, 0x81, 0x18, 0x34, 0xcb, 0xeb, 0x7b, 0xc1, 0x8c, 0xe3, 0x7d, 0x8e, 0x6d, 0x27,
+		0x0b, 0x5e, 0xee, 0x67, 0x25, 0xe2, 0x32, 0x7d, 0x5c, 0x53, 0x1e, 0xf7, 0x71, 0x8d, 0x61, 0x09,
+		0xec, 0x9f, 0xa5, 0x5f, 0x82, 0xfa, 0x76, 0xb3, 0xb9, 0xb9, 0xd5, 0x6c, 0xd6, 0xb6, 0x36, 0xb6,
+		0x6a, 0x3b, 0xad, 0x56, 0x7d, 0x53, 0x26, 0xe3, 0xa0, 0xb0, 0x55, 0xa9, 0x2c, 0xe6, 0xee, 0xab,
+		0x4a, 0x31, 0xdf, 0x27, 0x21, 0x75, 0x19, 0x79, 0xb8, 0xf1, 0x43, 0xe7, 0x7b, 0xd5, 0x8d, 0xe3,
+		0x30, 0xe6, 0x61, 0x23, 0xcf, 0x06, 0x04, 0x1b, 0x01, 0x1b, 0x21, 0x02, 0x1b, 0x21, 0x02, 0x1b,
+		0x21, 0x02, 0x1b, 0x59, 0x84, 0xdd, 0x03, 0x1b, 0x29, 0xe3, 0xaa, 0x80, 0x8d, 0x4c, 0x5f, 0x19,
+		0x79, 0x70, 0x62, 0x87, 0x93, 0x8b, 0x4c, 0x0c, 0x07, 0x26, 0x02, 0x26, 0x42, 0x04, 0x26, 0x42,
+		0x04, 0x26, 0x42, 0x04, 0x26, 0xb2, 0x08, 0x9b, 0x07, 0x26, 0x52, 0xc6, 0x55, 0x01, 0x13, 0x99,
+		0xbe, 0x32, 0xea, 0xd0, 0x89, 0xed, 0xdb, 0xcc, 0x00, 0x70, 0x6e, 0xd4, 0x4c, 0x8f, 0x09, 0x4e,
+		0x02, 0x4e, 0x42, 0x04, 0x4e, 0x42, 0x04, 0x4e, 0x42, 0x04, 0x4e, 0xb2, 0x08, 0xeb, 0x07, 0x4e,
+		0x52, 0xc6, 0x55, 0x01, 0x27, 0x99, 0xbe, 0x32, 0xfe, 0xf0, 0xff, 0xd9, 0x37, 0x37, 0x6e, 0xcc,
+		0xc9, 0x48, 0x9e, 0x8f, 0x08, 0x3e, 0x02, 0x3e, 0x42, 0x04, 0x3e, 0x42, 0x04, 0x3e, 0x42, 0x04,
+		0x3e, 0xb2, 0x08, 0xcb, 0x07, 0x3e, 0x52, 0xc6, 0x55, 0x01, 0x1f, 0x99, 0xbe, 0x32, 0xf6, 0x90,
+		0x95, 0x57, 0x1a, 0x16, 0x1a, 0xe4, 0x24, 0x25, 0xaf, 0x0c, 0x0b, 0x66, 0x02, 0x66, 0x42, 0x04,
+		0x66, 0x42, 0x04, 0x66, 0x42, 0x04, 0x66, 0xb2, 0x08, 0x1b, 0x08, 0x66, 0x52, 0xc6, 0x55, 0x01,
+		0x33, 0x99, 0xbe, 0x46, 0x14, 0x22, 0xb2, 0x7b, 0x89, 0xcb, 0xcd, 0x4b, 0x9e, 0x0d, 0x0a, 0x56,
+		0x02, 0x56, 0x42, 0x04, 0x56, 0x42, 0x04, 0x56, 0x42, 0x04, 0x56, 0xb2, 0x08, 0xfb, 0x07, 0x56,
+		0x52, 0xc6, 0x55, 0x01, 0x2b, 0x99, 0xbe, 0x32, 0x02, 0x11, 0xde, 0xbb, 0x71, 0xe2, 0xfd, 0x1f,
+		0x2b, 0x29, 0x99, 0x1e, 0x13, 0x9c, 0x04, 0x9c, 0x84, 0x08, 0x9c, 0x84, 0x08, 0x9c, 0x84, 0x08,
+		0x9c, 0x64, 0x11, 0xd6, 0x0f, 0x9c, 0xa4, 0x8c, 0xab, 0x02, 0x4e, 0x32, 0x7d, 0x65, 0xfc, 0xa1,
+		0x17, 0xb4, 0xf9, 0x49, 0xc9, 0x8b, 0x41, 0xc1, 0x4a, 0xc0, 0x4a, 0x88, 0xc0, 0x4a, 0x88, 0xc0,
+		0x4a, 0x88, 0xc0, 0x4a, 0x16, 0x61, 0xff, 0xc0, 0x4a, 0xca, 0xb8, 0x2a, 0x60, 0x25, 0xd3, 0x97,
+		0x15, 0xf6, 0x52, 0xe6, 0x22, 0x69, 0x2f, 0x46, 0x04, 0x1f, 0x01, 0x1f, 0x21, 0x02, 0x1f, 0x21,
+		0x02, 0x1f, 0x21, 0x02, 0x1f, 0x59, 0x84, 0xe5, 0x03, 0x1f, 0x29, 0xe3, 0xaa, 0x80, 0x8f, 0x4c,
+		0x5f, 0x7d, 0xf6, 0xa0, 0x23, 0xd5, 0x75, 0xc6, 0xb8, 0xe0, 0x26, 0xe0, 0x26, 0x44, 0xe0, 0x26,
+		0x44, 0xe0, 0x26, 0x44, 0xe0, 0x26, 0x8b, 0xb0, 0x82, 0xe0, 0x26, 0x65, 0x5c, 0x15, 0x70, 0x93,
+		0xe9, 0x6b, 0xcc, 0x21, 0x78, 0x93, 0x5d, 0x5f, 0x1d, 0x15, 0xbc, 0x04, 0xbc, 0x84, 0x08, 0xbc,
+		0x84, 0x08, 0xbc, 0x84, 0x08, 0xbc, 0x64, 0x11, 0x16, 0x10, 0xbc, 0xa4, 0x8c, 0xab, 0xb2, 0xec,
+		0xbc, 0x44, 0x6b, 0x2b, 0x1d, 0xc9, 0xfe, 0xb1, 0x9c, 0x7d, 0x64, 0xfb, 0xed, 0x59, 0xd7, 0x25,
+		0xbb, 0x4d, 0x0d, 0x1e, 0x22, 0x8d, 0x7b, 0x4e, 0x1a, 0x0c, 0xd5, 0xfe, 0xe1, 0xe8, 0x2b, 0xae,
+		0x0f, 0x86, 0x5f, 0x71, 0xbd, 0x37, 0x1a, 0xbc, 0x04, 0x8d, 0xb9, 0xda, 0xbd, 0xc8, 0x77, 0x7f,
+		0x56, 0xbb, 0x61, 0x5b, 0xa1, 0x8b, 0xdf, 0xe4, 0x20, 0xe8, 0xc1, 0xa7, 0x8f, 0xf1, 0xa1, 0x3d,
+		0xd7, 0x42, 0x7a, 0xf0, 0xb9, 0x41, 0xaf, 0xeb, 0xc6, 0x03, 0xb5, 0xa4, 0xd0, 0x87, 0xaf, 0x29,
+		0x71, 0xef, 0x41, 0xd0, 0xeb, 0x66, 0x0f, 0xff, 0x58, 0x02, 0x55, 0xe1, 0x06, 0xf6, 0x8d, 0xef,
+		0x56, 0x3b, 0x7e, 0xf8, 0x63, 0x14, 0xa6, 0x96, 0x57, 0x19, 0xaf, 0x0d, 0x26, 0xdf, 0xfd, 0xb3,
+		0x63, 0xfb, 0x09, 0x54, 0x0f, 0x54, 0x0f, 0xda, 0x7f, 0x96, 0xb3, 0xfd, 0xe7, 0xdd, 0x8f, 0x7e,
+		0x08, 0xc9, 0x6e, 0xb7, 0x63, 0x37, 0x51, 0x68, 0x02, 0x3a, 0x35, 0x0e, 0x00, 0x0f, 0xc0, 0x2f,
+		0x19, 0xe0, 0xe5, 0xc5, 0xfb, 0x19, 0xe8, 0xb7, 0xe5, 0x7a, 0x91, 0xa7, 0x6e, 0x1c, 0x48, 0x87,
+		0x86, 0xac, 0xff, 0x7c, 0xad, 0x55, 0x77, 0xec, 0x6a, 0x67, 0xb7, 0xfa, 0xf9, 0xea, 0x57, 0xe3,
+		0xf1, 0xdd, 0xc7, 0xe7, 0xbf, 0xbf, 0xff, 0xd5, 0x7a, 0xfc, 0x87, 0x55, 0xd1, 0xeb, 0xd9, 0xea,
+		0xd1, 0x5f, 0x2c, 0xca, 0x0b, 0x9a, 0x0b, 0x9a, 0x0b, 0x9a, 0x0b, 0x9a, 0xab, 0x50, 0xcd, 0x15,
+		0xb8, 0xb7, 0x61, 0xea, 0xd9, 0xa9, 0xdb, 0xae, 0xb2, 0x84, 0x7a, 0x66, 0x8c, 0x07, 0x7d, 0x06,
+		0x7d, 0x46, 0x84, 0xa8, 0xcf, 0xf2, 0x45, 0x7d, 0x26, 0x00, 0x1f, 0x85, 0x71, 0x5a, 0x4d, 0x22,
+		0xd7, 0x6d, 0xb3, 0xe8, 0x8f, 0x89, 0xe1, 0xa0, 0x3e, 0xa0, 0x3e, 0x96, 0x4c, 0x7d, 0x78, 0x6d,
+		0x37, 0x48, 0xbd, 0xf4, 0x21, 0x76, 0x3b, 0x2a, 0xea, 0x43, 0x62, 0xab, 0xd3, 0x3a, 0x1c, 0x7e,
+		0xf5, 0x27, 0x3b, 0x71, 0xd5, 0xf3, 0x7f, 0x0e, 0x2e, 0x7f, 0x3b, 0x38, 0x3f, 0x39, 0xb8, 0xbc,
+		0xbe, 0x38, 0x3b, 0x38, 0xd8, 0x97, 0x95, 0x9d, 0xfe, 0x6e, 0x6e, 0xa2, 0x94, 0x76, 0xa0, 0x98,
+		0x25, 0x33, 0x7a, 0x9f, 0xfe, 0x6b, 0x5c, 0xd7, 0xbf, 0x7c, 0x52, 0x48, 0x41, 0x59, 0x2b, 0xc9,
+		0x4b, 0xd4, 0x96, 0xe1, 0x2d, 0x5a, 0x4b, 0xf1, 0x16, 0x7f, 0x9c, 0xfc, 0x7e, 0x72, 0xfa, 0xd7,
+		0xc9, 0x32, 0x08, 0xd5, 0x52, 0xac, 0x47, 0xa3, 0xb5, 0x14, 0xd8, 0x58, 0x86, 0x97, 0x68, 0xd6,
+		0x96, 0x43, 0xd9, 0x1e, 0x2f, 0xc5, 0x5b, 0x2c, 0xc5, 0x6b, 0x34, 0x5a, 0x8a, 0xef, 0x21, 0x75,
+		0xe7, 0x95, 0x6e, 0x96, 0xaa, 0xc5, 0x83, 0xe3, 0x70, 0xdb, 0xe0, 0xab, 0x11, 0xc1, 0x57, 0x83,
+		0xaf, 0x46, 0x04, 0x5f, 0x2d, 0xcf, 0x05, 0x5f, 0x0d, 0xbe, 0x1a, 0x11, 0x7c, 0x35, 0xed, 0x42,
+		0x05, 0x5f, 0x0d, 0xbe, 0x1a, 0x7c, 0x35, 0xf8, 0x6a, 0xa5, 0x46, 0xf7, 0x8a, 0xfb, 0x6a, 0x15,
+		0xc6, 0xd5, 0x92, 0x3d, 0x22, 0xc3, 0x76, 0x34, 0xc6, 0xaa, 0xf0, 0xbc, 0x73, 0x8e, 0xf7, 0xb5,
+		0x92, 0x1f, 0x5e, 0xea, 0xdc, 0xb9, 0xed, 0xea, 0xbd, 0x6f, 0xe7, 0x7f, 0xdd, 0xb1, 0xf8, 0x3d,
+		0xbf, 0x7d, 0xad, 0x22, 0x44, 0xe6, 0xd7, 0x2a, 0x5a, 0xfc, 0x54, 0x19, 0xff, 0x74, 0xd2, 0x2f,
+		0x15, 0x78, 0x15, 0x52, 0x70, 0x48, 0x95, 0x1d, 0x51, 0x65, 0x07, 0x74, 0xda, 0xf1, 0xec, 0xbf,
+		0xf8, 0x82, 0x30, 0xb7, 0xef, 0xc5, 0x62, 0x0b, 0xe6, 0x8c, 0xa4, 0x42, 0x32, 0xdc, 0x32, 0xbc,
+		0x5f, 0x2e, 0xd4, 0x52, 0x37, 0x2c, 0xd4, 0x22, 0x28, 0xd2, 0xaa, 0xa2, 0xcd, 0x26, 0xe2, 0x6c,
+		0xa2, 0xce, 0x23, 0xf2, 0x62, 0xa2, 0x2f, 0x08, 0x01, 0x69, 0x28, 0x8c, 0x2e, 0xcb, 0x76, 0x1c,
+		0x37, 0x49, 0xc4, 0x34, 0xf9, 0x4c, 0xa9, 0x99, 0x1c, 0x0c, 0xa5, 0x28, 0x14, 0x40, 0xc4, 0x05,
+		0x26, 0x76, 0x50, 0xb1, 0x83, 0x8b, 0x17, 0x64, 0x72, 0x60, 0x93, 0x04, 0x9d, 0x7a, 0xa4, 0xf3,
+		0x85, 0xc4, 0x64, 0x6f, 0x5d, 0xf5, 0xda, 0x0c, 0xa5, 0x28, 0x36, 0x51, 0x8a, 0x82, 0x55, 0xb3,
+		0xbc, 0x1e, 0x8d, 0x15, 0x37, 0xeb, 0x85, 0x16, 0x3d, 0x58, 0x91, 0x52, 0x14, 0xcd, 0xda, 0x4e,
+		0x13, 0xa5, 0x27, 0xd4, 0x3d, 0x6d, 0x2a, 0xb0, 0xa9, 0xc9, 0xd0, 0x8b, 0x95, 0x4b, 0x7b, 0x7f,
+		0xa1, 0x37, 0xa7, 0xc6, 0x03, 0xf7, 0x00, 0xf7, 0x20, 0x02, 0xf7, 0x10, 0x92, 0x98, 0x3e, 0xf7,
+		0xc8, 0xe0, 0x53, 0x4d, 0xb3, 0x31, 0xd5, 0x29, 0x48, 0x5d, 0x41, 0x29, 0x4b, 0xe6, 0xda, 0x17,
+		0xab, 0xc5, 0x02, 0x3b, 0xf5, 0xee, 0x5d, 0x26, 0xdf, 0x69, 0x72, 0x30, 0xe8, 0x2f, 0xe8, 0x2f,
+		0xe8, 0x2f, 0x22, 0xf8, 0x4e, 0x44, 0xf0, 0x9d, 0x16, 0xc3, 0xda, 0xe1, 0x3b, 0x95, 0x61, 0x15,
+		0xe0, 0x3b, 0x4d, 0x5f, 0x56, 0x1a, 0xf7, 0x82, 0xef, 0x7d, 0x1d, 0xcf, 0x50, 0x45, 0x78, 0x72,
+		0x30, 0xb0, 0x0e, 0xb0, 0x0e, 0x22, 0xb0, 0x0e, 0x21, 0x89, 0xe9, 0x05, 0x72, 0x07, 0x91, 0xa7,
+		0xe1, 0x53, 0xdf, 0x51, 0x18, 0x63, 0xf8, 0x3a, 0x0b, 0xe7, 0x1c, 0x7c, 0x54, 0x8c, 0x91, 0x92,
+		0x31, 0x53, 0x33, 0xbe, 0xe9, 0xd2, 0x42, 0xd5, 0xa6, 0x27, 0x8e, 0x89, 0x2f, 0xbc, 0xe0, 0x0d,
+		0x75, 0xe6, 0x71, 0x35, 0x90, 0x07, 0x45, 0x25, 0xa1, 0x9d, 0xd2, 0x15, 0xb5, 0x54, 0x7c, 0x14,
+		0xaf, 0x90, 0xd5, 0xaa, 0x94, 0x63, 0x94, 0xab, 0xca, 0x02, 0x65, 0x8e, 0x5b, 0x17, 0xc7, 0x7d,
+		0xd5, 0xc7, 0xa7, 0x8e, 0xeb, 0xdb, 0x0c, 0x63, 0xa9, 0x96, 0x07, 0x9a, 0xbe, 0xac, 0xff, 0xbc,
+		0x6b, 0xd6, 0x76, 0xbe, 0xd6, 0xaa, 0xcd, 0xab, 0xbf, 0x9b, 0xb5, 0xaf, 0xb5, 0xea, 0xf6, 0x55,
+		0x56, 0x2f, 0xe8, 0xea, 0xef, 0xaf, 0xf5, 0xea, 0xc6, 0xe0, 0xc7, 0x5f, 0x1b, 0x8f, 0xd9, 0x6f,
+		0x3b, 0xc3, 0xdf, 0xea, 0x6b, 0x8d, 0xe1, 0xef, 0xef, 0xbf, 0x7d, 0xfb, 0xf0, 0xed, 0xdb, 0x07,
+		0x85, 0x01, 0xfe, 0x61, 0x2d, 0x5a, 0xe4, 0x8a, 0xf6, 0x76, 0x24, 0xb9, 0xd7, 0x91, 0x97, 0xa4,
+		0xbb, 0x69, 0x1a, 0xab, 0xf1, 0xaf, 0x63, 0x2f, 0x38, 0xf0, 0xdd, 0x8c, 0x7e, 0x66, 0xea, 0x27,
+		0xe8, 0xf9, 0xbe, 0x02, 0x7f, 0x3a, 0xb6, 0x7f, 0xf2, 0x0d, 0x76, 0x1a, 0xb7, 0xdd, 0xd8, 0x6d,
+		0x7f, 0x7a, 0x18, 0x0e, 0x85, 0x0a, 0xf0, 0x4c, 0x69, 0xae, 0x93, 0x69, 0xa3, 0xeb, 0x83, 0xbb,
+		0xcb, 0x50, 0xaa, 0x7d, 0x90, 0x7e, 0x2b, 0x9d, 0x53, 0x38, 0xb8, 0xbd, 0xe0, 0x94, 0xc2, 0x06,
+		0x52, 0x0a, 0x17, 0xea, 0x43, 0x23, 0xa5, 0x30, 0xaf, 0xd4, 0x20, 0xa5, 0x90, 0x10, 0xa0, 0x22,
+		0x5a, 0x1c, 0xc8, 0xe4, 0xc0, 0x26, 0x09, 0xba, 0xe9, 0x88, 0x0e, 0xb6, 0xc5, 0x9e, 0x5d, 0xd8,
+		0x16, 0x5b, 0xb4, 0x97, 0x8e, 0x6d, 0xb1, 0x52, 0xac, 0x02, 0xb6, 0xc5, 0xa6, 0x2f, 0xa4, 0x14,
+		0x12, 0x81, 0x7b, 0x10, 0x81, 0x7b, 0xa8, 0xa0, 0x88, 0x90, 0x52, 0xb8, 0x58, 0x2d, 0x86, 0x94,
+		0x42, 0xe8, 0x2f, 0xe8, 0x2f, 0x22, 0xf8, 0x4e, 0x44, 0xf0, 0x9d, 0x24, 0x88, 0x3b, 0x7c, 0x27,
+		0x22, 0xf8, 0x4e, 0xec, 0xba, 0x68, 0xb9, 0x7d, 0x27, 0xa4, 0x14, 0x12, 0x81, 0x75, 0x10, 0x81,
+		0x75, 0x20, 0xa5, 0xf0, 0xf9, 0xeb, 0x20, 0xa5, 0xb0, 0x00, 0x6a, 0xc6, 0x37, 0x5d, 0x5a, 0xa8,
+		0xda, 0xf4, 0xc4, 0x21, 0xa5, 0x10, 0x29, 0x85, 0x48, 0x29, 0x2c, 0x8c, 0x02, 0x32, 0xc9, 0x1c,
+		0x52, 0x0a, 0x89, 0x90, 0x52, 0x58, 0x94, 0xc8, 0x23, 0xa5, 0x10, 0x29, 0x85, 0xa6, 0xa5, 0x14,
+		0x0a, 0xd4, 0xd1, 0x14, 0x9f, 0xa1, 0xe5, 0xa9, 0x36, 0x2a, 0x51, 0xbd, 0x73, 0xf0, 0xdd, 0x69,
+		0xdc, 0x73, 0xd2, 0x60, 0x68, 0x43, 0x0e, 0x47, 0x23, 0x5f, 0x1f, 0x0c, 0x47, 0xbe, 0xbe, 0x18,
+		0x8e, 0xfc, 0x67, 0x6e, 0xaf, 0xef, 0xb1, 0xa2, 0xf6, 0x89, 0x39, 0xd3, 0x2d, 0x3a, 0xcd, 0x0c,
+		0xd3, 0x6b, 0xad, 0x55, 0x94, 0x27, 0xd2, 0xaa, 0xc8, 0xcd, 0xc8, 0x1b, 0xb3, 0x61, 0xdd, 0x85,
+		0x7e, 0xbb, 0x9a, 0x7a, 0xdd, 0xf9, 0x2e, 0xee, 0x98, 0x25, 0x3c, 0xdd, 0xb2, 0x56, 0xc9, 0x45,
+		0x00, 0xd6, 0x2a, 0x2c, 0x51, 0x1d, 0x91, 0xe8, 0xcd, 0x64, 0x94, 0xc6, 0xcb, 0xd3, 0x64, 0x42,
+		0x34, 0x16, 0x23, 0x1d, 0x73, 0x91, 0x8e, 0xad, 0x4c, 0xc7, 0x50, 0xbc, 0x8e, 0xa5, 0x19, 0x25,
+		0x79, 0x13, 0x45, 0x45, 0xcb, 0xaf, 0xca, 0x95, 0x5d, 0x2d, 0x7b, 0xa5, 0x60, 0xaf, 0xb3, 0x92,
+		0x75, 0x82, 0xf3, 0x88, 0x61, 0x7e, 0xb5, 0x4e, 0x3a, 0xab, 0x04, 0xb7, 0xc3, 0x1f, 0x81, 0x7c,
+		0x3e, 0x7f, 0xff, 0x6e, 0xd1, 0x54, 0x6b, 0xb7, 0x63, 0xf7, 0xfc, 0xfe, 0x54, 0xd5, 0x56, 0xa4,
+		0x91, 0x93, 0xd7, 0xc1, 0x41, 0x00, 0x45, 0x98, 0x88, 0xc1, 0x45, 0x10, 0x36, 0xd3, 0x81, 0x60,
+		0x85, 0xd5, 0xee, 0x79, 0x41, 0xba, 0xd1, 0x50, 0xe8, 0xe0, 0xb4, 0xb5, 0x56, 0x29, 0x38, 0x32,
+		0xab, 0xe6, 0x45, 0x32, 0xec, 0xd4, 0xb0, 0x84, 0xef, 0xc6, 0xe1, 0x3a, 0xd5, 0x71, 0x18, 0x63,
+		0x73, 0x8f, 0x6a, 0x3e, 0x75, 0xe9, 0xa6, 0xb6, 0xd9, 0xd8, 0x69, 0xee, 0x6c, 0x6e, 0x35, 0x76,
+		0x5a, 0x25, 0x9a, 0xe3, 0x82, 0xe2, 0x03, 0x57, 0x25, 0x38, 0x7d, 0xd7, 0x8b, 0xe4, 0x4d, 0x75,
+		0x2f, 0x82, 0xa1, 0x7e, 0x7b, 0x86, 0x60, 0xa8, 0x89, 0x60, 0xa8, 0x89, 0x60, 0xa8, 0x59, 0x41,
+		0x3d, 0xd3, 0x9a, 0xc0, 0x50, 0x13, 0xc1, 0x50, 0x9b, 0x63, 0xa8, 0x0d, 0x0f, 0x6a, 0x8f, 0x43,
+		0x94, 0x42, 0xe5, 0x04, 0x98, 0x9a, 0x68, 0x09, 0x95, 0x0d, 0x90, 0x2a, 0x17, 0x20, 0x1d, 0x0a,
+		0x6b, 0x20, 0x14, 0xc6, 0x48, 0x21, 0x10, 0x0a, 0x23, 0x02, 0xc3, 0x66, 0x82, 0x81, 0x2a, 0x1c,
+		0xd8, 0x60, 0xc1, 0x06, 0x0f, 0x0e, 0x98, 0x88, 0xc1, 0x45, 0x10, 0x36, 0x44, 0x60, 0xd8, 0x44,
+		0x60, 0xd8, 0x04, 0x86, 0x4d, 0x04, 0x86, 0x5d, 0x2c, 0xc3, 0x46, 0x28, 0x8c, 0x08, 0x86, 0x1a,
+		0x86, 0x9a, 0x08, 0x86, 0xfa, 0xed, 0x0b, 0x86, 0x5a, 0x9b, 0x11, 0x81, 0xa1, 0xd6, 0x3f, 0xc7,
+		0x08, 0x85, 0x95, 0x24, 0x14, 0xc6, 0xdb, 0x4e, 0xde, 0x90, 0xfc, 0xcb, 0xbc, 0xc9, 0x8a, 0xf4,
+		0x56, 0x02, 0xe6, 0x6f, 0xa1, 0xdf, 0xbe, 0xcc, 0xc6, 0xd0, 0x90, 0x80, 0x39, 0xfc, 0xb6, 0x9c,
+		0xb9, 0x97, 0xfd, 0x4f, 0xaf, 0x55, 0x18, 0x94, 0x0a, 0xd2, 0x2e, 0xcb, 0x9a, 0x76, 0x99, 0x9b,
+		0xe4, 0x8c, 0x67, 0xdb, 0x77, 0xed, 0x4e, 0xec, 0xe6, 0x9a, 0xef, 0x51, 0xe4, 0x38, 0x07, 0x8d,
+		0xb1, 0xce, 0x86, 0x78, 0xfb, 0xf0, 0x61, 0x18, 0x46, 0x5f, 0xef, 0x8b, 0x9f, 0x06, 0x10, 0x84,
+		0xf7, 0x49, 0x7e, 0x0c, 0x64, 0x1f, 0x36, 0x21, 0xf3, 0xf8, 0x3e, 0x59, 0x4e, 0x0c, 0x0c, 0xde,
+		0xab, 0x2c, 0xb9, 0xc7, 0x37, 0x61, 0xd0, 0xce, 0xf5, 0xc9, 0x67, 0x8b, 0xd3, 0xbf, 0x6b, 0x19,
+		0xf2, 0x8e, 0xf3, 0x0a, 0x99, 0xac, 0xb0, 0x29, 0x0b, 0x9d, 0xb2, 0xf0, 0x29, 0x09, 0x61, 0x3e,
+		0x61, 0xcc, 0x29, 0x94, 0xc2, 0xc2, 0x39, 0xba, 0x44, 0x13, 0xe4, 0x5f, 0x2c, 0xb3, 0x50, 0xa2,
+		0xbc, 0xa4, 0xe0, 0x4a, 0x0b, 0xb0, 0x8a, 0x20, 0xab, 0x0b, 0xb4, 0xaa, 0x60, 0xb3, 0x09, 0x38,
+		0x9b, 0xa0, 0xb3, 0x08, 0xbc, 0x98, 0xe0, 0x0b, 0x02, 0x40, 0x1a, 0x08, 0xa3, 0xab, 0xbf, 0x83,
+		0xd8, 0x76, 0x7d, 0xfb, 0x41, 0xbd, 0x2c, 0xcd, 0xd3, 0x50, 0x92, 0xd3, 0xac, 0x10, 0xf0, 0x9c,
+		0x86, 0xda, 0x72, 0x14, 0xb6, 0x91, 0x85, 0x20, 0x17, 0x14, 0xd9, 0x21, 0xc9, 0x0e, 0x4d, 0x56,
+		0x88, 0xca, 0x41, 0x55, 0x12, 0xb2, 0xe2, 0x5e, 0x86, 0xbe, 0x90, 0xea, 0x34, 0x7a, 0xb6, 0x50,
+		0x4b, 0x8f, 0x55, 0xad, 0xcc, 0x8c, 0x13, 0xd6, 0x50, 0x4b, 0x6f, 0xd1, 0x4b, 0xc0, 0x15, 0xaa,
+		0xd5, 0xba, 0x16, 0xa8, 0xa8, 0x37, 0x7d, 0x59, 0x3c, 0x35, 0xc8, 0x51, 0x79, 0x1c, 0x54, 0x83,
+		0x08, 0x54, 0x43, 0x54, 0x5e, 0x92, 0x34, 0xf6, 0x82, 0x5b, 0x8e, 0x12, 0x7a, 0xdb, 0x25, 0xd6,
+		0x31, 0xbd, 0x88, 0xc9, 0x35, 0x1a, 0x0d, 0x04, 0xc7, 0x08, 0xda, 0x0a, 0xda, 0x8a, 0x08, 0x8e,
+		0x91, 0xec, 0x05, 0xc7, 0x68, 0xe1, 0x64, 0x1c, 0x8e, 0x51, 0x79, 0xd6, 0x62, 0xd9, 0x1d, 0xa3,
+		0x25, 0xad, 0x0f, 0x17, 0xde, 0x27, 0xeb, 0xd9, 0xce, 0x1f, 0x9a, 0xcc, 0x9a, 0xd7, 0x64, 0x16,
+		0xfb, 0x42, 0x4f, 0x00, 0x5a, 0xde, 0x7d, 0xa1, 0x4c, 0x55, 0xdf, 0xbb, 0xd5, 0xae, 0xdb, 0xbd,
+		0x71, 0x63, 0x8e, 0x26, 0xb3, 0x93, 0xc3, 0xc1, 0x85, 0x81, 0x0b, 0x53, 0x30, 0xcc, 0xe4, 0xe0,
+		0x26, 0x09, 0x3b, 0x0d, 0x2e, 0xcc, 0x6a, 0x04, 0x5c, 0xb0, 0x1b, 0x0d, 0x8d, 0x05, 0x8d, 0x45,
+		0x84, 0xa0, 0x0b, 0x82, 0x2e, 0xda, 0xd4, 0xca, 0x4c, 0x8f, 0x1f, 0x41, 0x17, 0x22, 0x04, 0x5d,
+		0x34, 0x29, 0x24, 0x83, 0x82, 0x2e, 0xd8, 0x8d, 0x26, 0x02, 0xd5, 0x20, 0x50, 0x0d, 0x6d, 0x78,
+		0x21, 0x38, 0x47, 0xd8, 0x8d, 0x86, 0xb6, 0x82, 0xb6, 0x82, 0x63, 0x44, 0x04, 0xc7, 0x08, 0x8e,
+		0x91, 0xf4, 0x05, 0xc7, 0xa8, 0x44, 0x6b, 0x81, 0xdd, 0x68, 0xb3, 0x77, 0xa3, 0xd1, 0x9f, 0x2c,
+		0xc7, 0x2c, 0x71, 0x74, 0x24, 0x3b, 0xbd, 0x4f, 0xae, 0x3f, 0x65, 0x63, 0x15, 0x58, 0x0e, 0x16,
+		0xbd, 0x91, 0x70, 0x46, 0x79, 0x11, 0x48, 0x15, 0x3e, 0xa3, 0x7c, 0x13, 0x7b, 0xed, 0x5b, 0x85,
+		0x54, 0x94, 0xe1, 0xfd, 0xab, 0x50, 0x31, 0x0e, 0xb9, 0x28, 0x2c, 0x02, 0x2f, 0x26, 0xf8, 0x82,
+		0x00, 0x50, 0xf7, 0xa0, 0xd4, 0xe3, 0x3c, 0x92, 0xf1, 0x1d, 0x3d, 0x99, 0x66, 0xa9, 0xcc, 0x3c,
+		0x8c, 0xe7, 0xa0, 0x7f, 0x37, 0xb0, 0x0d, 0x6c, 0x03, 0xdb, 0x45, 0x61, 0x7b, 0x09, 0x88, 0x3b,
+		0xba, 0x2f, 0x14, 0xde, 0x7d, 0x01, 0x64, 0x9b, 0x08, 0x64, 0xfb, 0x4d, 0xc1, 0x85, 0x41, 0x2e,
+		0x44, 0xc0, 0xd9, 0x04, 0x9d, 0x45, 0xe0, 0xc5, 0x04, 0x5f, 0x10, 0x00, 0xab, 0x61, 0x90, 0x41,
+		0xb6, 0x81, 0x6d, 0x60, 0x1b, 0x64, 0xbb, 0xa4, 0x64, 0x9b, 0xb7, 0xbe, 0xf3, 0xfc, 0x57, 0xb5,
+		0xd2, 0x5e, 0x10, 0xb8, 0xbe, 0x38, 0xd9, 0x1e, 0xde, 0x87, 0xd0, 0x76, 0x81, 0x0a, 0x0b, 0xe5,
+		0x37, 0x51, 0x7e, 0x53, 0x87, 0x40, 0xab, 0x0a, 0x36, 0x9b, 0x80, 0xb3, 0x09, 0x3a, 0x8b, 0xc0,
+		0x8b, 0x09, 0xbe, 0x20, 0x00, 0xa4, 0x81, 0x30, 0xba, 0xac, 0x76, 0x92, 0x56, 0xa3, 0x30, 0x4e,
+		0x19, 0xce, 0x3b, 0x8d, 0x46, 0x42, 0x46, 0x1e, 0x32, 0xf2, 0x0a, 0x06, 0x97, 0x1c, 0xc8, 0x24,
+		0xc1, 0xa6, 0x4e, 0x83, 0x5f, 0xc8, 0x4b, 0x86, 0x9b, 0x6a, 0xd0, 0x53, 0x38, 0x9b, 0x3c, 0x09,
+		0xa1, 0x4d, 0xa4, 0xe5, 0xb1, 0xea, 0x96, 0x99, 0x39, 0x61, 0x48, 0xcb, 0x5b, 0xf8, 0x12, 0x6c,
+		0xb6, 0x5a, 0x1b, 0xc8, 0xc8, 0x5b, 0x5c, 0x46, 0x9e, 0x04, 0x3f, 0xfc, 0xee, 0x32, 0x1c, 0x21,
+		0xc8, 0x06, 0x01, 0xd1, 0x00, 0xd1, 0x20, 0x02, 0xd1, 0x28, 0x22, 0xee, 0xa6, 0x18, 0x7f, 0x2b,
+		0x56, 0xc3, 0xf8, 0xa1, 0x63, 0xfb, 0x55, 0x2f, 0x52, 0x57, 0x33, 0xe3, 0x91, 0xa0, 0x6b, 0xa0,
+		0x6b, 0xa0, 0x6b, 0xa0, 0x6b, 0xa6, 0x2f, 0x2b, 0x76, 0xbb, 0x61, 0xea, 0xb2, 0x28, 0x9b, 0xa7,
+		0xa1, 0xa0, 0x6d, 0xa0, 0x6d, 0xa0, 0x6d, 0x96, 0x5d, 0xdb, 0x2c, 0xf1, 0x69, 0xa6, 0xc1, 0xc6,
+		0x1e, 0xaa, 0x6b, 0xa2, 0xba, 0x66, 0xe1, 0x6a, 0x16, 0xdb, 0x3e, 0xf3, 0x2f, 0x6c, 0xfb, 0x70,
+		0x80, 0x86, 0x0f, 0x3c, 0x5c, 0x20, 0x62, 0x07, 0x13, 0x3b, 0xa8, 0x58, 0xc1, 0x25, 0x07, 0x32,
+		0x49, 0xb0, 0x69, 0xe0, 0x2c, 0xd8, 0xf6, 0x21, 0xc2, 0xb6, 0x0f, 0xb6, 0x7d, 0x88, 0xb0, 0xed,
+		0x43, 0x84, 0x6d, 0x9f, 0xf9, 0x1a, 0x13, 0xdb, 0x3e, 0x20, 0x1a, 0x44, 0x20, 0x1a, 0xcb, 0x1f,
+		0x1c, 0xc1, 0xb6, 0x0f, 0x74, 0x0d, 0x74, 0x0d, 0x74, 0x4d, 0x49, 0x75, 0x0d, 0xb6, 0x7d, 0xa0,
+		0x6d, 0xa0, 0x6d, 0xa0, 0x6d, 0xb0, 0xed, 0xf3, 0xca, 0xb6, 0x0f, 0xca, 0xd8, 0xe5, 0x9a, 0x27,
+		0xae, 0x42, 0x76, 0x97, 0x83, 0xd1, 0xb8, 0xce, 0xfb, 0x55, 0x14, 0xe6, 0x55, 0x74, 0x3e, 0xd5,
+		0xe6, 0xd1, 0x5a, 0xab, 0xa8, 0x4c, 0x9c, 0x55, 0x91, 0x9b, 0x87, 0x37, 0xe6, 0xc0, 0xfa, 0x6f,
+		0x98, 0xcc, 0x7d, 0xf1, 0xb1, 0xf2, 0xca, 0x3e, 0xbc, 0x56, 0xc9, 0xa5, 0x9d, 0xd6, 0x2a, 0x2c,
+		0x36, 0x5c, 0xc4, 0x56, 0x3f, 0xb3, 0xc9, 0xf3, 0x1f, 0x95, 0x24, 0x8c, 0xaf, 0xb4, 0x91, 0x95,
+		0x36, 0xa6, 0x2f, 0x8c, 0x66, 0xf6, 0x62, 0x9a, 0x31, 0x91, 0x77, 0x97, 0x0e, 0x15, 0x22, 0x05,
+		0x44, 0x4d, 0x56, 0xe4, 0x94, 0x45, 0x4f, 0x59, 0x04, 0xd5, 0x44, 0x31, 0xbf, 0x22, 0x27, 0x9d,
+		0x07, 0x69, 0xbb, 0xf6, 0xcf, 0x6a, 0xac, 0x94, 0x52, 0x31, 0x1e, 0x61, 0x45, 0xca, 0x5b, 0x88,
+		0x89, 0xb5, 0xaa, 0x78, 0xb3, 0x89, 0x39, 0x9b, 0xb8, 0xf3, 0x88, 0xbd, 0x98, 0xf8, 0x0b, 0xc2,
+		0x40, 0xdd, 0x3f, 0x79, 0x56, 0x63, 0x7f, 0xb3, 0xa9, 0x50, 0xe0, 0x62, 0x7b, 0xad, 0x52, 0xf0,
+		0x2e, 0xee, 0xaf, 0xca, 0x42, 0x77, 0x6d, 0x99, 0xb6, 0x0a, 0xb9, 0x76, 0x69, 0x39, 0xb7, 0x05,
+		0x15, 0x76, 0x65, 0x59, 0x76, 0x63, 0xb9, 0xa7, 0xb6, 0xbe, 0xdd, 0x6c, 0x6e, 0x6e, 0x35, 0x9b,
+		0xb5, 0xad, 0x8d, 0xad, 0xda, 0x4e, 0xab, 0x55, 0xdf, 0xac, 0xb7, 0x4a, 0x34, 0xdb, 0x05, 0x39,
+		0xf2, 0x57, 0xa8, 0x2e, 0x45, 0x04, 0xf3, 0x4b, 0x04, 0xf3, 0x5b, 0x3a, 0xf3, 0x8b, 0xfa, 0x52,
+		0xba, 0xc2, 0x2e, 0xff, 0x0d, 0x17, 0x51, 0xcc, 0xd5, 0x0b, 0x6e, 0x63, 0x37, 0x49, 0xaa, 0x51,
+		0xe8, 0x7b, 0x4e, 0xb6, 0xb2, 0xc2, 0x3e, 0xf2, 0x8b, 0x11, 0xe0, 0x2d, 0x17, 0xa9, 0xc7, 0x56,
+		0xdb, 0x5b, 0x46, 0xd9, 0x29, 0x7d, 0x22, 0xad, 0x2a, 0xda, 0x6c, 0x22, 0xce, 0x26, 0xea, 0x3c,
+		0x22, 0x2f, 0x26, 0xfa, 0x82, 0x10, 0x90, 0x86, 0xc2, 0xe8, 0xb2, 0x6e, 0x7a, 0x71, 0xc2, 0x70,
+		0xfc, 0x60, 0x30, 0x0c, 0x1a, 0x49, 0xb2, 0x6d, 0xbe, 0xcb, 0x81, 0x8f, 0x0b, 0x84, 0xec, 0x60,
+		0x64, 0x07, 0x25, 0x2f, 0x38, 0xe5, 0x40, 0x2a, 0x09, 0x56, 0x75, 0x7e, 0xfd, 0x6a, 0x98, 0x0b,
+		0xad, 0x24, 0x89, 0x88, 0x70, 0x78, 0x01, 0x87, 0x17, 0x88, 0xd0, 0x4a, 0x52, 0xf1, 0xee, 0x32,
+		0x9f, 0x60, 0x90, 0xda, 0xef, 0x7a, 0xa1, 0x32, 0x25, 0xf6, 0xbc, 0x88, 0x40, 0x58, 0x5e, 0x9d,
+		0x4b, 0x10, 0x16, 0x22, 0x10, 0x16, 0x22, 0x10, 0x16, 0x22, 0x10, 0x96, 0xe2, 0x8d, 0x24, 0x08,
+		0x4b, 0x79, 0xd6, 0x02, 0xbd, 0xaf, 0xe5, 0x25, 0x73, 0x81, 0x69, 0xc3, 0xd9, 0x7e, 0xc2, 0x74,
+		0x6c, 0x1e, 0x75, 0x63, 0x8c, 0xac, 0x1b, 0x83, 0xb8, 0xed, 0x08, 0x4d, 0x88, 0xdb, 0xce, 0x93,
+		0x17, 0xc4, 0x6d, 0xe1, 0x06, 0x15, 0x0a, 0x4a, 0x5e, 0x70, 0xca, 0x81, 0x54, 0x12, 0xac, 0x44,
+		0x70, 0x83, 0x66, 0x5f, 0x70, 0x83, 0x16, 0x4e, 0xbd, 0xe1, 0x06, 0x95, 0x67, 0x2d, 0x10, 0xb7,
+		0x9d, 0xbe, 0x10, 0xb7, 0x05, 0x61, 0x21, 0x02, 0x61, 0x51, 0x40, 0x38, 0x08, 0x0b, 0x81, 0xb0,
+		0x08, 0x5a, 0x4b, 0x10, 0x16, 0x22, 0x10, 0x16, 0x4d, 0x0a, 0xc9, 0x20, 0xc2, 0xb2, 0x4a, 0x71,
+		0x5b, 0x14, 0x7e, 0x10, 0x9c, 0x31, 0x8e, 0x12, 0x10, 0xff, 0x6f, 0x98, 0x5c, 0x1f, 0x0e, 0xc6,
+		0x3d, 0x1b, 0x0d, 0x5b, 0x60, 0x6a, 0xfe, 0x7f, 0x7b, 0x6e, 0xcf, 0x4d, 0xc4, 0x13, 0xf2, 0x87,
+		0xf7, 0x21, 0x0d, 0xbf, 0x48, 0xb6, 0xba, 0xda, 0x69, 0xf8, 0x7d, 0x91, 0x93, 0xdf, 0xcc, 0x19,
+		0xdc, 0x8e, 0x24, 0x7c, 0x0d, 0x82, 0xcd, 0x26, 0xe0, 0x6c, 0x82, 0xce, 0x23, 0xf0, 0x62, 0x82,
+		0x2f, 0x08, 0x00, 0x69, 0x20, 0x8c, 0x2e, 0xd9, 0x73, 0x29, 0x2f, 0x04, 0x46, 0xea, 0x7c, 0x8a,
+		0x22, 0x44, 0x94, 0xa1, 0xc2, 0x01, 0x19, 0x46, 0xe8, 0x70, 0x41, 0x88, 0x1d, 0x4a, 0xec, 0x90,
+		0xe2, 0x85, 0x96, 0x1c, 0xc4, 0x24, 0xa1, 0xa6, 0x0c, 0xb9, 0xd1, 0x65, 0x79, 0x6d, 0xf5, 0x15,
+		0x1e, 0x9f, 0x6d, 0x6c, 0xab, 0x2e, 0x2d, 0x53, 0x81, 0x00, 0x55, 0x28, 0x72, 0x42, 0x52, 0x03,
+		0x34, 0xb9, 0x21, 0xaa, 0x0d, 0xaa, 0xda, 0x20, 0xab, 0x07, 0xba, 0x6a, 0x10, 0x56, 0x84, 0x32,
+		0x5f, 0xb0, 0x92, 0x3f, 0x68, 0xc9, 0x18, 0xbc, 0x64, 0x0e, 0x62, 0x8e, 0x2e, 0x1e, 0x00, 0x10,
+		0x77, 0x50, 0x93, 0x59, 0xb1, 0xcd, 0x8c, 0xb0, 0x71, 0x8f, 0xab, 0x21, 0xc0, 0xc6, 0x04, 0x0f,
+		0x6d, 0xc1, 0xcf, 0xa2, 0x96, 0x8a, 0x3b, 0x18, 0x5a, 0xc8, 0x9a, 0x55, 0xca, 0x31, 0xca, 0xd5,
+		0x82, 0x82, 0xb4, 0x0a, 0x32, 0x2b, 0x5f, 0xa1, 0x6e, 0xa6, 0x3a, 0x97, 0xac, 0x58, 0x47, 0x04,
+		0xb2, 0x45, 0x04, 0xb2, 0x45, 0x04, 0xb2, 0xa5, 0x99, 0x6c, 0x49, 0x55, 0xdc, 0x63, 0xac, 0xc0,
+		0x47, 0x04, 0xb2, 0x05, 0xb2, 0x05, 0xb2, 0xa5, 0xa1, 0x42, 0x60, 0xa1, 0xab, 0x07, 0xda, 0x25,
+		0xbd, 0x2c, 0x5d, 0x2f, 0xe0, 0xa6, 0x5d, 0xa3, 0x11, 0x41, 0xbb, 0xe6, 0xce, 0x15, 0x68, 0x17,
+		0x68, 0x17, 0x11, 0x68, 0x17, 0x68, 0x17, 0x9f, 0x2d, 0x07, 0xed, 0x22, 0x02, 0xed, 0x2a, 0x62,
+		0xf5, 0x56, 0x9d, 0x76, 0x15, 0xba, 0x83, 0xa9, 0x98, 0x3a, 0xc8, 0x94, 0x10, 0x37, 0xc8, 0x02,
+		0x1b, 0xfc, 0x23, 0x75, 0xec, 0x5b, 0x7e, 0xf2, 0x24, 0x26, 0x4e, 0x65, 0xd7, 0x56, 0x7d, 0xb7,
+		0x16, 0x47, 0x40, 0xb4, 0x30, 0x54, 0x24, 0x4a, 0xe8, 0x54, 0x33, 0x7c, 0x47, 0x40, 0x7c, 0xd7,
+		0xee, 0xc4, 0x6e, 0x87, 0xa3, 0xd7, 0x9f, 0xca, 0x21, 0x90, 0xb3, 0xa1, 0xa6, 0xfb, 0xf0, 0x61,
+		0xa8, 0xaf, 0xd6, 0xbd, 0x76, 0x99, 0x55, 0x96, 0x5c, 0x05, 0x8b, 0x17, 0xb3, 0x2f, 0x53, 0xc9,
+		0xe2, 0xc5, 0xbc, 0xab, 0x2a, 0xae, 0x06, 0x14, 0x17, 0x14, 0x97, 0x29, 0x19, 0x5e, 0x4e, 0xd8,
+		0xcb, 0xa8, 0x4e, 0xc2, 0x17, 0x09, 0x1b, 0x8f, 0xc8, 0x13, 0x09, 0xab, 0x23, 0x12, 0x56, 0x24,
+		0x5c, 0xb5, 0xc1, 0x56, 0x1b, 0x7c, 0xf5, 0xc0, 0x58, 0x0d, 0xce, 0x8a, 0xb0, 0x66, 0x83, 0xf7,
+		0xe8, 0xb2, 0xd2, 0x9f, 0xd5, 0x9b, 0x87, 0xd4, 0x4d, 0xf8, 0xe4, 0x63, 0xdc, 0x9e, 0x67, 0x34,
+		0xf2, 0x5a, 0x29, 0x4f, 0x19, 0x72, 0xc1, 0x5f, 0x87, 0x1a, 0xd0, 0xa8, 0x0e, 0x74, 0xa9, 0x05,
+		0xed, 0xea, 0x41, 0xbb, 0x9a, 0xd0, 0xab, 0x2e, 0x78, 0xd4, 0x06, 0x93, 0xfa, 0xe0, 0x73, 0x6f,
+		0xe6, 0x19, 0x7b, 0x96, 0xd8, 0xba, 0x86, 0x18, 0xbb, 0xa6, 0x58, 0xfb, 0xe8, 0xe2, 0xc5, 0x14,
+		0xe9, 0x8a, 0xbd, 0x4f, 0x4f, 0x2c, 0x73, 0x60, 0xf7, 0x45, 0x80, 0x57, 0xd7, 0xf8, 0x1a, 0xa3,
+		0xba, 0xcc, 0x88, 0xd3, 0x1e, 0xa3, 0x2f, 0x7a, 0x49, 0xf5, 0xc7, 0xec, 0x0b, 0x5d, 0xe5, 0x4a,
+		0x39, 0x47, 0xbb, 0x2a, 0xc9, 0xde, 0x02, 0x03, 0x0a, 0x32, 0x5e, 0xe8, 0xc6, 0x71, 0x18, 0xeb,
+		0xa1, 0x9c, 0xc3, 0xa1, 0xc1, 0x39, 0x95, 0x27, 0x13, 0x9c, 0x93, 0x08, 0x9c, 0x93, 0x15, 0xfb,
+		0x04, 0xce, 0x09, 0xce, 0x39, 0x35, 0xb1, 0xe0, 0x9c, 0xba, 0x11, 0x47, 0x04, 0xce, 0x59, 0xe6,
+		0x55, 0x06, 0xe7, 0xd4, 0x8d, 0x82, 0x8c, 0x18, 0x46, 0xb6, 0xf3, 0xdd, 0x4d, 0xf5, 0x90, 0xce,
+		0xd1, 0xd8, 0x60, 0x9d, 0xca, 0xb3, 0x09, 0xd6, 0x49, 0x04, 0xd6, 0xc9, 0x8a, 0x7e, 0x02, 0xeb,
+		0x04, 0xeb, 0x9c, 0x9a, 0x58, 0xb0, 0x4e, 0xdd, 0x88, 0x23, 0x02, 0xeb, 0x2c, 0xf3, 0x2a, 0x83,
+		0x75, 0x6a, 0x1c, 0x41, 0x75, 0x7b, 0x9f, 0x29, 0xbb, 0x59, 0x47, 0x96, 0x73, 0x3f, 0x93, 0x6e,
+		0x9d, 0x29, 0x97, 0x87, 0xe6, 0x55, 0x06, 0xfd, 0x7f, 0xb3, 0xef, 0xbc, 0xde, 0x1b, 0x7d, 0x9b,
+		0x81, 0x27, 0x0a, 0x51, 0x29, 0xab, 0x20, 0x57, 0x02, 0xb9, 0x53, 0xc8, 0x9d, 0x2a, 0xd6, 0x15,
+		0x40, 0xa5, 0xac, 0xd2, 0xf0, 0x7b, 0x9c, 0x22, 0xe4, 0x82, 0x87, 0x76, 0xde, 0x8e, 0x4a, 0x59,
+		0xdc, 0x0a, 0x91, 0x6f, 0x14, 0x54, 0xca, 0x42, 0xa5, 0x2c, 0xb1, 0xb9, 0x02, 0xd9, 0x02, 0xd9,
+		0x2a, 0x9e, 0x6c, 0xa1, 0x64, 0x03, 0x11, 0xc8, 0x56, 0x19, 0x0c, 0x37, 0x4a, 0x36, 0xa0, 0x64,
+		0x03, 0x2a, 0x65, 0xb1, 0x28, 0x76, 0x54, 0xca, 0xca, 0x3f, 0x57, 0xa0, 0x5d, 0xa0, 0x5d, 0x44,
+		0xa0, 0x5d, 0xa0, 0x5d, 0x7c, 0xb6, 0x1c, 0xb4, 0x8b, 0x08, 0xb4, 0xab, 0x88, 0xd5, 0x43, 0xa5,
+		0xac, 0xe2, 0x64, 0xbe, 0x8c, 0x95, 0xb2, 0x24, 0x1a, 0x6d, 0xca, 0xcf, 0x9d, 0xde, 0xe6, 0x65,
+		0xbf, 0xbb, 0x0f, 0x52, 0x3b, 0xb3, 0xd6, 0x91, 0x97, 0xa4, 0xbb, 0x69, 0x2a, 0xd9, 0xfa, 0xec,
+		0xd8, 0x0b, 0x0e, 0x7c, 0x37, 0x23, 0x2d, 0x19, 0x34, 0x83, 0x9e, 0xef, 0xaf, 0x55, 0x64, 0x34,
+		0x9f, 0xfa, 0x20, 0xa7, 0x71, 0xdb, 0x8d, 0xdd, 0xf6, 0xa7, 0x87, 0xe1, 0x10, 0x4b, 0xdc, 0x30,
+		0x76, 0x52, 0x86, 0xad, 0xb5, 0x0a, 0x7f, 0xa2, 0x03, 0x7a, 0xcf, 0xce, 0x9b, 0xfc, 0x22, 0x5b,
+		0xc3, 0x8a, 0x95, 0x9e, 0x92, 0x2a, 0x35, 0x25, 0xdd, 0x18, 0xb6, 0x81, 0xc6, 0xb0, 0xac, 0xbe,
+		0xa1, 0xd1, 0x8d, 0x61, 0xa5, 0x77, 0xc0, 0x54, 0x77, 0xbc, 0x24, 0x69, 0x2e, 0xda, 0xc3, 0xa2,
+		0x3d, 0x6c, 0x01, 0xa1, 0x0c, 0xf5, 0xd0, 0x85, 0x42, 0xa8, 0x42, 0x31, 0x34, 0xf1, 0xab, 0xb2,
+		0xd0, 0xd0, 0x03, 0x57, 0x0c, 0x95, 0x29, 0xb4, 0xc0, 0xe9, 0x8c, 0xaa, 0xc4, 0xbc, 0x39, 0x42,
+		0x05, 0xdc, 0x53, 0xcb, 0x1f, 0x0a, 0x60, 0x9d, 0xed, 0x82, 0x9c, 0xbc, 0x2b, 0x5d, 0xcc, 0x59,
+		0x80, 0xfc, 0xa4, 0x32, 0x8a, 0xea, 0xe9, 0x18, 0x61, 0x76, 0x37, 0xcc, 0x2f, 0x11, 0xcc, 0xaf,
+		0x56, 0xb4, 0x14, 0x6f, 0x7e, 0x93, 0x34, 0xf6, 0x82, 0x5b, 0x05, 0xf3, 0x5b, 0xdf, 0x86, 0x5f,
+		0x3c, 0xd3, 0x2f, 0x16, 0x88, 0xa5, 0x3d, 0x56, 0xd4, 0x3e, 0x31, 0x67, 0x22, 0x44, 0x27, 0x40,
+		0xed, 0xc5, 0xad, 0xb5, 0x8a, 0x4a, 0xc0, 0xc5, 0xaa, 0xc8, 0xcd, 0xc3, 0x1b, 0x73, 0x60, 0xc5,
+		0x61, 0x2f, 0x75, 0xdb, 0xd5, 0x7b, 0xdf, 0x9e, 0x3f, 0x01, 0x63, 0x74, 0x4c, 0xde, 0xb4, 0x56,
+		0xc9, 0x05, 0x87, 0xb5, 0x0a, 0x8b, 0x7a, 0x17, 0x51, 0xe7, 0x4f, 0xea, 0xdb, 0xc9, 0xf3, 0xa8,
+		0x32, 0xea, 0x5a, 0x5a, 0x3d, 0x4b, 0xab, 0xe3, 0xe7, 0xea, 0x77, 0xf8, 0x62, 0x9a, 0x31, 0x92,
+		0xd7, 0xb9, 0xb7, 0x9c, 0xd1, 0x2a, 0x0a, 0x86, 0x9f, 0x86, 0xf7, 0x69, 0x8e, 0x3f, 0xd5, 0x8a,
+		0x88, 0x3f, 0xe5, 0x16, 0x35, 0x59, 0x91, 0x53, 0x16, 0x3d, 0x65, 0x11, 0x54, 0x13, 0xc5, 0xfc,
+		0x8a, 0x9d, 0x74, 0xc6, 0x9f, 0x72, 0x29, 0xbc, 0x99, 0xcb, 0x2c, 0xb8, 0xc6, 0x64, 0x2c, 0xf1,
+		0x15, 0x16, 0x67, 0x55, 0xb1, 0x66, 0x13, 0x6f, 0x36, 0x31, 0xe7, 0x11, 0x77, 0x31, 0xb1, 0x17,
+		0x14, 0x7f, 0x46, 0xe2, 0xdb, 0x0b, 0x32, 0x2a, 0xa4, 0xc0, 0x7b, 0x77, 0x24, 0xee, 0x1d, 0x3e,
+		0x76, 0xe1, 0x61, 0xa7, 0xc9, 0x60, 0x5b, 0x7d, 0x93, 0xa1, 0xcd, 0xc9, 0xa6, 0xc2, 0x10, 0x3c,
+		0x79, 0x41, 0x0c, 0xd9, 0x53, 0x9c, 0x79, 0x40, 0xdc, 0x75, 0x81, 0x98, 0xf3, 0x7e, 0x74, 0x64,
+		0x8c, 0x70, 0xd4, 0x7e, 0xe2, 0xcc, 0xef, 0xd1, 0xb5, 0x04, 0x9b, 0xad, 0xd6, 0x46, 0xab, 0xc4,
+		0xcb, 0xb0, 0xa0, 0x44, 0x99, 0xab, 0x22, 0x13, 0x65, 0x18, 0x74, 0x9f, 0x74, 0xa4, 0x43, 0x31,
+		0xe2, 0x21, 0x3f, 0xd3, 0x57, 0x88, 0xac, 0xbc, 0x1a, 0x60, 0x98, 0xf0, 0xca, 0x85, 0xfa, 0xfa,
+		0x21, 0xf3, 0x80, 0x88, 0x08, 0x9e, 0x5f, 0x51, 0x54, 0x18, 0x9e, 0x1f, 0x89, 0x89, 0x2f, 0x3c,
+		0x3f, 0x78, 0x7e, 0x39, 0x5d, 0x28, 0x78, 0x7e, 0x44, 0xf0, 0xfc, 0x88, 0xe0, 0xf9, 0x2d, 0xc2,
+		0xe5, 0x80, 0xe7, 0x57, 0x8a, 0x65, 0x80, 0xe7, 0x97, 0x4b, 0xf7, 0xc1, 0xf3, 0x13, 0xfb, 0xa4,
+		0x19, 0x9e, 0xdf, 0x4a, 0xee, 0xad, 0xe7, 0xdf, 0x90, 0xa6, 0xb7, 0xf6, 0xd8, 0xcf, 0xfb, 0xc3,
+		0xfc, 0x39, 0x97, 0xde, 0xc9, 0x6d, 0xb5, 0xe7, 0xf3, 0x90, 0x85, 0x3c, 0x63, 0xe1, 0xed, 0xf5,
+		0x86, 0x9e, 0xed, 0x75, 0xaf, 0xb3, 0x94, 0x9b, 0xeb, 0x5e, 0xa7, 0x34, 0x5b, 0xeb, 0x76, 0x3b,
+		0x2b, 0x35, 0x90, 0xc9, 0x44, 0x2f, 0x11, 0x8f, 0xb2, 0x3c, 0xbb, 0x7b, 0xad, 0xa2, 0x81, 0x3c,
+		0x14, 0xbe, 0xcd, 0xee, 0x75, 0x56, 0x32, 0xd4, 0x92, 0x47, 0x24, 0xf3, 0xeb, 0x77, 0x12, 0x30,
+		0x6b, 0xc2, 0x1e, 0xe6, 0x78, 0xb5, 0xdc, 0xa0, 0xd7, 0x75, 0xe3, 0x81, 0x21, 0x10, 0x58, 0xb3,
+		0x91, 0x6e, 0x6b, 0x0a, 0xdc, 0x73, 0x10, 0xf4, 0xba, 0xd9, 0x43, 0x3e, 0x16, 0x18, 0xf9, 0x14,
+		0xee, 0x3a, 0x2e, 0xdb, 0x5d, 0xbc, 0xec, 0x99, 0x2f, 0x80, 0x64, 0xc1, 0x90, 0x14, 0x8e, 0x7d,
+		0x3a, 0x76, 0x1c, 0x7b, 0x6e, 0x5c, 0x4d, 0x63, 0x3b, 0x48, 0xbc, 0x0c, 0x90, 0x89, 0x7c, 0x28,
+		0xf4, 0xb5, 0xc1, 0x56, 0x23, 0x32, 0x2a, 0x24, 0xe8, 0xaa, 0x02, 0xcf, 0x26, 0xf8, 0x6c, 0x00,
+		0xe0, 0x00, 0x82, 0x18, 0x20, 0x04, 0x81, 0x21, 0x6f, 0xb3, 0x66, 0xa9, 0x68, 0x1c, 0xc5, 0x2a,
+		0x00, 0xd7, 0x33, 0x03, 0x4e, 0x38, 0x8a, 0x45, 0x84, 0xa3, 0x58, 0x26, 0x06, 0x96, 0x04, 0xf8,
+		0x90, 0x17, 0x54, 0x6f, 0xe2, 0xd0, 0x6e, 0x3b, 0x76, 0x92, 0x56, 0xa3, 0xef, 0xa9, 0x82, 0x65,
+		0x7e, 0x39, 0x14, 0xec, 0x32, 0x11, 0xec, 0xb2, 0x36, 0x0c, 0xc1, 0x2e, 0xe7, 0xbb, 0x60, 0x97,
+		0xb5, 0x59, 0x0a, 0xd8, 0xe5, 0x22, 0x67, 0x7b, 0xb5, 0xec, 0x72, 0xdb, 0x4b, 0x1c, 0x3b, 0x6e,
+		0xab, 0x59, 0xe4, 0xf1, 0x20, 0xb0, 0xc5, 0x44, 0xb0, 0xc5, 0xda, 0x70, 0x03, 0x5b, 0x9c, 0xef,
+		0x82, 0x2d, 0xd6, 0x66, 0x1d, 0x60, 0x8b, 0x8b, 0x9c, 0xed, 0xd5, 0xb2, 0xc5, 0x6e, 0x1c, 0x87,
+		0xb1, 0x9a, 0x25, 0x1e, 0x0e, 0x01, 0x3b, 0x4c, 0x04, 0x3b, 0xac, 0x0d, 0x33, 0xb0, 0xc3, 0xf9,
+		0x2e, 0xd8, 0x61, 0x6d, 0x96, 0x01, 0x76, 0xb8, 0xc8, 0xd9, 0x5e, 0x2d, 0x3b, 0xdc, 0x71, 0x12,
+		0x0e, 0x5b, 0x3c, 0x31, 0x0c, 0xec, 0x31, 0x11, 0xec, 0xb1, 0x36, 0xec, 0xc0, 0x1e, 0xe7, 0xbb,
+		0x60, 0x8f, 0xb5, 0x59, 0x08, 0xd8, 0xe3, 0x22, 0x67, 0x7b, 0xb5, 0xec, 0x71, 0xb7, 0xe7, 0xa7,
+		0x1e, 0xcf, 0xde, 0xf1, 0xd4, 0x50, 0xb0, 0xcb, 0x44, 0xb0, 0xcb, 0xda, 0x30, 0x04, 0xbb, 0x9c,
+		0xef, 0x82, 0x5d, 0xd6, 0x66, 0x29, 0x60, 0x97, 0x8b, 0x9c, 0xed, 0xd5, 0xb2, 0xcb, 0xa1, 0x93,
+		0xba, 0x8a, 0xf6, 0x78, 0x38, 0x04, 0xec, 0x30, 0x11, 0xec, 0xb0, 0x36, 0xcc, 0xc0, 0x0e, 0xe7,
+		0xbb, 0x60, 0x87, 0xb5, 0x59, 0x06, 0xd8, 0xe1, 0x22, 0x67, 0x7b, 0xb5, 0xec, 0xb0, 0xb2, 0x57,
+		0x0c, 0x5f, 0x98, 0x08, 0x36, 0x58, 0x2f, 0x5e, 0x60, 0x83, 0xf3, 0x5d, 0xb0, 0xc1, 0xda, 0xac,
+		0x02, 0x6c, 0x70, 0x91, 0xb3, 0xbd, 0x5a, 0x36, 0xb8, 0x17, 0x30, 0x45, 0xa8, 0x9f, 0x0d, 0x04,
+		0x9b, 0x4c, 0x04, 0x9b, 0xac, 0x0d, 0x3f, 0xb0, 0xc9, 0xf9, 0x2e, 0xd8, 0x64, 0x6d, 0x56, 0x02,
+		0x36, 0xb9, 0xc8, 0xd9, 0x5e, 0x35, 0x9b, 0xfc, 0x3d, 0x08, 0x7f, 0x04, 0xd5, 0x28, 0x0e, 0xd3,
+		0x50, 0xd5, 0x2a, 0x3f, 0x1b, 0x0a, 0x76, 0x99, 0x08, 0x76, 0x59, 0x1b, 0x86, 0x60, 0x97, 0xf3,
+		0x5d, 0xb0, 0xcb, 0xda, 0x2c, 0x05, 0xec, 0x72, 0x91, 0xb3, 0xbd, 0x42, 0x76, 0xd9, 0xcf, 0x9c,
+		0x5b, 0xc7, 0x77, 0xed, 0x58, 0xde, 0x20, 0x4f, 0x8c, 0x01, 0x4b, 0x4c, 0x04, 0x4b, 0xac, 0x0d,
+		0x35, 0xc5, 0x5b, 0xe2, 0xd4, 0xeb, 0xba, 0xa9, 0xe7, 0x7c, 0x4f, 0x60, 0x8b, 0x0b, 0x40, 0xf6,
+		0x4c, 0x83, 0x01, 0x5b, 0x4c, 0x04, 0x5b, 0xbc, 0xe4, 0xb6, 0x38, 0xec, 0xa5, 0x6c, 0x85, 0xb9,
+		0x5e, 0x19, 0x0b, 0xb6, 0x99, 0x08, 0xb6, 0x59, 0x1b, 0x8a, 0xe0, 0x25, 0xe7, 0xbb, 0x60, 0x99,
+		0xb5, 0xd9, 0x0a, 0x58, 0xe6, 0x22, 0x67, 0x7b, 0xc5, 0x2c, 0xb3, 0x7a, 0x69, 0xae, 0x67, 0xa3,
+		0xc0, 0x1a, 0x13, 0xc1, 0x1a, 0x6b, 0x43, 0x0e, 0xac, 0x71, 0xbe, 0x0b, 0xd6, 0x58, 0x9b, 0x7d,
+		0x80, 0x35, 0x2e, 0x72, 0xb6, 0x57, 0xcc, 0x1a, 0xab, 0x16, 0x04, 0x99, 0x18, 0x03, 0x96, 0x98,
+		0x08, 0x96, 0x58, 0x1b, 0x6a, 0x60, 0x89, 0xf3, 0x5d, 0xb0, 0xc4, 0xda, 0x6c, 0x03, 0x2c, 0x71,
+		0x91, 0xb3, 0xbd, 0x62, 0x96, 0x98, 0xab, 0x1c, 0xc8, 0x2b, 0x63, 0xc1, 0x32, 0x13, 0xc1, 0x32,
+		0x6b, 0x43, 0x11, 0x2c, 0x73, 0xbe, 0x0b, 0x96, 0x59, 0x9b, 0xad, 0x80, 0x65, 0x2e, 0x72, 0xb6,
+		0x57, 0xcc, 0x32, 0xab, 0x16, 0x04, 0x99, 0x18, 0x03, 0x96, 0x98, 0x08, 0x96, 0x58, 0x1b, 0x6a,
+		0x60, 0x89, 0xf3, 0x5d, 0xb0, 0xc4, 0xda, 0x6c, 0x03, 0x2c, 0x71, 0x91, 0xb3, 0xbd, 0x62, 0x96,
+		0x58, 0xdd, 0x33, 0x86, 0x3f, 0x4c, 0x04, 0x2b, 0xac, 0x17, 0x31, 0xb0, 0xc2, 0xf9, 0x2e, 0x58,
+		0x61, 0x6d, 0x76, 0x01, 0x56, 0xb8, 0xc8, 0xd9, 0x5e, 0x31, 0x2b, 0xcc, 0x53, 0x14, 0xe4, 0xc5,
+		0x48, 0xb0, 0xca, 0x44, 0xb0, 0xca, 0xda, 0x10, 0x04, 0xab, 0x9c, 0xef, 0x82, 0x55, 0xd6, 0x66,
+		0x27, 0x60, 0x95, 0x8b, 0x9c, 0xed, 0x15, 0xb2, 0xca, 0x49, 0x6a, 0xa7, 0x5e, 0x92, 0x7a, 0x8e,
+		0x82, 0x3d, 0x9e, 0x18, 0x43, 0xce, 0x12, 0xd7, 0x8d, 0xb2, 0xc4, 0xf7, 0x09, 0x4c, 0x31, 0x11,
+		0xbd, 0x30, 0xc5, 0x83, 0x79, 0x29, 0x99, 0x2d, 0xde, 0xf7, 0x62, 0xb9, 0xe5, 0x1e, 0x0b, 0xb5,
+		0xfc, 0x7a, 0xbd, 0xc0, 0x87, 0xec, 0x7a, 0xc9, 0xc1, 0x44, 0x19, 0x2e, 0x1c, 0xb0, 0xe1, 0x83,
+		0x0f, 0x17, 0x8c, 0xd8, 0xe1, 0xc4, 0x0e, 0x2b, 0x56, 0x78, 0xc9, 0xc1, 0x4c, 0x12, 0x6e, 0xca,
+		0xb0, 0x1b, 0x5d, 0x56, 0x30, 0x10, 0x17, 0xc5, 0x25, 0x1e, 0x09, 0x5d, 0x7f, 0xb4, 0xb5, 0x52,
+		0x50, 0x20, 0x55, 0x30, 0x72, 0x82, 0x92, 0x1f, 0x9c, 0xdc, 0x20, 0xd5, 0x06, 0x56, 0x6d, 0xa0,
+		0xd5, 0x02, 0x5e, 0x35, 0x10, 0x2b, 0x82, 0x59, 0xdd, 0xaf, 0x9d, 0x29, 0x6f, 0xbe, 0x6b, 0x77,
+		0x62, 0x97, 0x45, 0xe0, 0x46, 0x36, 0x72, 0x8b, 0x61, 0xac, 0x33, 0x3b, 0xbd, 0xcb, 0x1e, 0xef,
+		0xc3, 0x87, 0xf5, 0xcc, 0x76, 0xbb, 0xeb, 0x7d, 0xfd, 0x51, 0x59, 0xcc, 0xca, 0xa9, 0xf8, 0x7e,
+		0xfd, 0xa7, 0xe7, 0x53, 0xa3, 0x83, 0xe1, 0x78, 0xf4, 0x68, 0x1d, 0x7a, 0x94, 0x08, 0x7a, 0x74,
+		0x15, 0xf5, 0xa8, 0x2a, 0x39, 0x1a, 0x5d, 0x3c, 0x24, 0xe9, 0x85, 0xf0, 0x32, 0x90, 0xa5, 0x69,
+		0xb0, 0xd7, 0x98, 0x86, 0xe3, 0x02, 0xbd, 0x0e, 0xf0, 0xeb, 0x53, 0x02, 0xba, 0x94, 0x81, 0x76,
+		0xa5, 0xa0, 0x5d, 0x39, 0x68, 0x55, 0x12, 0x3c, 0xca, 0x82, 0x49, 0x69, 0xf0, 0x93, 0xb0, 0x17,
+		0xf2, 0x9a, 0xa4, 0xb1, 0x17, 0xdc, 0x72, 0xca, 0xeb, 0xc8, 0xd4, 0x6f, 0x57, 0xca, 0xb1, 0x0e,
+		0x0c, 0x6b, 0x60, 0xdd, 0x0f, 0x43, 0xd8, 0xcc, 0x4a, 0x77, 0x30, 0x2c, 0xb4, 0xae, 0xf2, 0x44,
+		0x42, 0xeb, 0x12, 0x41, 0xeb, 0x32, 0x22, 0x9e, 0xf4, 0x6a, 0x5d, 0x95, 0xad, 0x5e, 0x0d, 0x5b,
+		0xc0, 0x9a, 0xb6, 0x86, 0x67, 0x5d, 0xbc, 0x90, 0x22, 0xae, 0xad, 0xe4, 0x79, 0x13, 0x5b, 0x5b,
+		0xd3, 0x33, 0x3c, 0xd3, 0xd6, 0x73, 0x11, 0x9b, 0xa4, 0x9a, 0x11, 0xc7, 0xbe, 0x85, 0xbd, 0xe8,
+		0x25, 0xe5, 0xdf, 0xf2, 0x5e, 0xe8, 0x2a, 0x57, 0xca, 0x39, 0xda, 0x55, 0x59, 0x98, 0xe6, 0x42,
+		0x03, 0x0c, 0xbb, 0x41, 0x10, 0x66, 0x7b, 0x8e, 0x21, 0x8f, 0x26, 0xb4, 0x12, 0xe7, 0xce, 0xed,
+		0xda, 0xd1, 0x30, 0x2a, 0xba, 0x1e, 0x46, 0x6e, 0xe0, 0xf4, 0x39, 0x61, 0xd5, 0xcb, 0x6c, 0x57,
+		0xc7, 0x76, 0xdc, 0x64, 0xfd, 0xb5, 0x1f, 0x87, 0xe1, 0xd3, 0xa1, 0x8d, 0x4b, 0xd6, 0x9f, 0x32,
+		0x05, 0x9e, 0x7e, 0x1c, 0x7c, 0x66, 0x61, 0xd1, 0xd5, 0x42, 0xb7, 0xc5, 0x7e, 0x77, 0x1f, 0x14,
+		0x23, 0x2c, 0xd6, 0x91, 0x97, 0xa4, 0xbb, 0x69, 0xaa, 0xb8, 0xbd, 0x76, 0xec, 0x05, 0x07, 0xbe,
+		0x9b, 0x71, 0xb7, 0xc4, 0xfa, 0x48, 0x41, 0xcf, 0xf7, 0xd5, 0x32, 0x8c, 0xf8, 0x06, 0x3b, 0x8d,
+		0xdb, 0x6e, 0xec, 0xb6, 0x3f, 0x3d, 0x0c, 0x87, 0x2a, 0x74, 0x7d, 0x98, 0x80, 0xa3, 0x17, 0x30,
+		0x96, 0xd2, 0x86, 0x40, 0xdc, 0x73, 0xd2, 0x61, 0xdc, 0xd0, 0x3a, 0x1c, 0x7d, 0xed, 0xf5, 0xde,
+		0xf0, 0x0b, 0xaf, 0x2f, 0xc6, 0xdf, 0x52, 0x50, 0x12, 0x94, 0xde, 0xf4, 0x0f, 0xc5, 0x05, 0xd5,
+		0xb1, 0x90, 0x96, 0xae, 0xac, 0xaf, 0x0a, 0xe3, 0x04, 0xca, 0x4e, 0x1c, 0xdb, 0x84, 0x59, 0x6b,
+		0x15, 0x36, 0xa1, 0xb6, 0x2a, 0x3c, 0xf3, 0x97, 0x63, 0xee, 0xac, 0xb6, 0x9b, 0x38, 0xb1, 0x17,
+		0x09, 0x4d, 0xdc, 0xd8, 0x17, 0x9c, 0xbc, 0x79, 0xad, 0xa2, 0x81, 0xbd, 0x0a, 0x87, 0x72, 0x64,
+		0x42, 0x35, 0xd2, 0x39, 0xeb, 0xb2, 0x91, 0x16, 0xe5, 0x48, 0x8a, 0x72, 0xa4, 0x44, 0x25, 0x27,
+		0x9d, 0x17, 0xb7, 0xc2, 0x81, 0x0a, 0xf9, 0xf0, 0xaf, 0x60, 0x78, 0x97, 0x07, 0x5f, 0x6e, 0x60,
+		0xdf, 0xf8, 0x6e, 0x5b, 0x1c, 0x5b, 0xa3, 0x1b, 0x73, 0xce, 0xe3, 0xbe, 0xdb, 0xb1, 0x7b, 0x7e,
+		0x7f, 0x3d, 0x33, 0x71, 0x00, 0x1c, 0x01, 0xc7, 0x62, 0xe1, 0x78, 0x13, 0x86, 0xbe, 0x6b, 0x07,
+		0x32, 0x78, 0xac, 0x17, 0x88, 0x47, 0xaf, 0xe3, 0x05, 0x6d, 0xf7, 0xa7, 0x38, 0x1e, 0x47, 0x37,
+		0x02, 0x58, 0x00, 0x56, 0xa1, 0xc0, 0xea, 0x79, 0x41, 0xba, 0xd1, 0x90, 0xc0, 0x95, 0x40, 0x66,
+		0x99, 0x64, 0xb4, 0xfc, 0x57, 0xa5, 0xd0, 0x68, 0xb7, 0x62, 0xe8, 0x53, 0x35, 0x5a, 0xcd, 0x11,
+		0xa7, 0x94, 0x70, 0xf4, 0x95, 0xa2, 0xc9, 0x5c, 0x53, 0xd6, 0x6c, 0xec, 0x34, 0x77, 0x36, 0xb7,
+		0x1a, 0x3b, 0xad, 0x05, 0xce, 0x9d, 0x26, 0x47, 0xf4, 0xaa, 0x40, 0xf3, 0x33, 0x68, 0x7c, 0x78,
+		0x37, 0x44, 0x9b, 0xa0, 0x09, 0x9a, 0xbc, 0x19, 0x66, 0x88, 0x08, 0x66, 0xa8, 0x40, 0x33, 0x24,
+		0xd7, 0xd6, 0x50, 0x62, 0x67, 0x17, 0xb6, 0xa8, 0x28, 0x7d, 0x6a, 0xa8, 0x2d, 0xe2, 0xdb, 0x99,
+		0x84, 0x55, 0x22, 0x22, 0x22, 0xcb, 0x0f, 0x6f, 0x3d, 0xc7, 0xf6, 0x25, 0x2c, 0xd2, 0xf0, 0x46,
+		0x58, 0x23, 0x22, 0x58, 0xa3, 0x02, 0xad, 0x91, 0x21, 0xd1, 0x06, 0x3f, 0x0c, 0xa3, 0x1b, 0xdb,
+		0xf9, 0x5e, 0xed, 0x86, 0x6d, 0x19, 0xc2, 0xf7, 0xec, 0x76, 0xf1, 0x48, 0x60, 0xc7, 0xf6, 0x13,
+		0x50, 0x45, 0x80, 0x13, 0xe0, 0x7c, 0xe5, 0xb2, 0xba, 0x69, 0x4f, 0x1c, 0x92, 0xd9, 0x4d, 0x00,
+		0x14, 0x00, 0x55, 0x78, 0x08, 0xb0, 0xbe, 0x29, 0x81, 0xa7, 0x4d, 0xb8, 0x5d, 0xaf, 0xfb, 0x10,
+		0x70, 0xbb, 0x84, 0xa7, 0x6c, 0xb3, 0xd5, 0xda, 0x80, 0x9f, 0xa5, 0xb2, 0xac, 0x62, 0xe7, 0x1f,
+		0x65, 0xce, 0x37, 0xc2, 0xe6, 0xc0, 0xe6, 0x30, 0xd9, 0x1c, 0x33, 0xd2, 0x2b, 0xc2, 0xc8, 0x8d,
+		0xab, 0x59, 0x26, 0x56, 0x2f, 0x11, 0x07, 0xd6, 0xe4, 0xcd, 0xc0, 0x17, 0xf0, 0x55, 0x28, 0xbe,
+		0xdc, 0xa0, 0xd7, 0x75, 0x63, 0x5b, 0x20, 0x77, 0xee, 0x19, 0xc8, 0x9a, 0x02, 0xf7, 0x1c, 0x04,
+		0xbd, 0x6e, 0xf6, 0x90, 0x8f, 0x05, 0x02, 0x33, 0x8d, 0x3c, 0x89, 0xa4, 0xa7, 0xfe, 0x5d, 0xe2,
+		0x71, 0x8e, 0xd0, 0xa9, 0xde, 0xfb, 0x76, 0x50, 0x4d, 0x1f, 0x22, 0x37, 0xf9, 0x78, 0x79, 0x76,
+		0xb8, 0x7f, 0x5d, 0xfb, 0xd7, 0x76, 0xbd, 0x56, 0x5b, 0x12, 0x58, 0x67, 0x2f, 0xb7, 0x92, 0xc0,
+		0xee, 0xbf, 0xb8, 0x71, 0xd0, 0xf6, 0xda, 0x6e, 0x90, 0x7a, 0xe9, 0x83, 0x58, 0xa5, 0xa0, 0x31,
+		0xb4, 0x05, 0x48, 0xb6, 0x75, 0x38, 0xfc, 0xaa, 0x4f, 0x76, 0xe2, 0xca, 0x17, 0xc4, 0xec, 0x23,
+		0xe6, 0xf2, 0xdf, 0x67, 0x07, 0x17, 0xa2, 0x0b, 0xde, 0x77, 0x0e, 0x12, 0xa9, 0x63, 0x96, 0x8a,
+		0x45, 0x0a, 0x47, 0x28, 0xdf, 0xde, 0xdd, 0xb6, 0x8a, 0xf0, 0xc1, 0x78, 0x1e, 0x77, 0xa7, 0x51,
+		0xab, 0x19, 0xf4, 0xb8, 0x7d, 0x1d, 0x6a, 0xd0, 0xec, 0xca, 0x3d, 0xae, 0xd0, 0x1d, 0x57, 0xdc,
+		0xea, 0x88, 0xc7, 0xdc, 0x8a, 0x28, 0xab, 0x27, 0x73, 0x9b, 0xdd, 0x05, 0xe6, 0x6b, 0xb0, 0x81,
+		0xf4, 0x3a, 0x30, 0x8f, 0xda, 0xcd, 0xe3, 0xf8, 0xcc, 0x4f, 0x55, 0x00, 0x30, 0x25, 0x30, 0x91,
+		0x9e, 0x1d, 0xd8, 0x55, 0xa5, 0x87, 0xe7, 0x78, 0x09, 0xb5, 0x97, 0x79, 0xf1, 0x52, 0x77, 0x49,
+		0xe2, 0x59, 0x8b, 0x28, 0x4e, 0xc8, 0xf4, 0xfc, 0xb7, 0x5b, 0xb5, 0x0d, 0x3b, 0x6d, 0x74, 0x6f,
+		0x4c, 0x7e, 0x89, 0x76, 0x52, 0xff, 0xbc, 0x7f, 0x64, 0xf2, 0x1b, 0xa4, 0x71, 0xad, 0xb6, 0x6d,
+		0xf2, 0x0b, 0xdc, 0x79, 0x91, 0x1b, 0xfb, 0x76, 0xd0, 0x30, 0xf9, 0x25, 0xc2, 0x28, 0x6b, 0xde,
+		0xee, 0x5f, 0xc6, 0x76, 0x90, 0x44, 0x61, 0x9c, 0x1a, 0xbd, 0x20, 0x61, 0xd7, 0x8d, 0x02, 0xdb,
+		0xe4, 0x57, 0xf0, 0x3a, 0x7f, 0x76, 0xbc, 0xbe, 0x61, 0x37, 0xf8, 0x25, 0xec, 0xd8, 0x09, 0xdc,
+		0xf4, 0xcc, 0xef, 0x25, 0x26, 0xbf, 0x45, 0x27, 0xb6, 0xbb, 0xee, 0xb9, 0xeb, 0xdb, 0x0f, 0x17,
+		0x6e, 0x7c, 0xef, 0x39, 0x46, 0x2f, 0x49, 0xfb, 0xfe, 0xe6, 0xdc, 0x71, 0x8e, 0x6d, 0xe7, 0xc8,
+		0x7e, 0x70, 0x63, 0x93, 0xdf, 0xc4, 0xf1, 0x12, 0x27, 0x3c, 0xbc, 0x38, 0x12, 0x0b, 0x4c, 0x95,
+		0xf0, 0x3d, 0xec, 0xe8, 0x87, 0x1d, 0xed, 0x87, 0x69, 0xbd, 0x7e, 0x16, 0x87, 0x1d, 0xcf, 0x37,
+		0x5a, 0xbe, 0x3c, 0xd7, 0x75, 0xb7, 0x6b, 0x8d, 0x7a, 0xab, 0x69, 0xb4, 0x01, 0x69, 0xdf, 0xd5,
+		0xb7, 0x1b, 0x46, 0xdb, 0xf3, 0x28, 0x0e, 0x53, 0x37, 0x0c, 0xea, 0xb5, 0xe3, 0x1b, 0xcf, 0x68,
+		0x63, 0x9e, 0x84, 0x99, 0x0d, 0xc9, 0xaa, 0x42, 0x18, 0xfc, 0x12, 0x6e, 0xe2, 0x84, 0x46, 0x6b,
+		0xa9, 0xb6, 0x63, 0xf4, 0xe3, 0x77, 0x23, 0xf7, 0x76, 0x29, 0xa8, 0xed, 0xcf, 0xed, 0xcd, 0x23,
+		0x3b, 0x32, 0x9a, 0x4f, 0x45, 0x5e, 0x64, 0xb4, 0x3e, 0x6a, 0x27, 0xbe, 0xc9, 0xcf, 0x7f, 0x1f,
+		0x7a, 0x8e, 0x7b, 0x70, 0x6c, 0x34, 0xc9, 0x88, 0x96, 0x81, 0x22, 0x6d, 0xfe, 0x75, 0xbc, 0x7b,
+		0x62, 0xf2, 0x8b, 0xf8, 0x76, 0xd4, 0x36, 0xda, 0x3d, 0x4d, 0xbb, 0xfb, 0x3f, 0x8d, 0x8e, 0x60,
+		0xda, 0xf7, 0x9e, 0xe3, 0x9d, 0x0e, 0x22, 0x37, 0x07, 0xe9, 0x9d, 0xd9, 0x0e, 0x5d, 0x37, 0x74,
+		0xec, 0x3f, 0xdd, 0x38, 0xf1, 0xc2, 0xa0, 0x6e, 0xf2, 0x7b, 0xc4, 0x76, 0xdb, 0x0b, 0x8f, 0x77,
+		0xf7, 0x0c, 0x8f, 0x2a, 0x1b, 0x8d, 0x0b, 0xdb, 0x6f, 0x19, 0x6d, 0xa3, 0xeb, 0x75, 0xb3, 0xed,
+		0xf3, 0xe7, 0x30, 0xfe, 0x61, 0xc7, 0x6d, 0xe3, 0x79, 0xd2, 0xe9, 0xbd, 0x1b, 0xef, 0xa6, 0x5d,
+		0xa3, 0xd5, 0x51, 0xd2, 0xd8, 0x68, 0x18, 0xbe, 0xbd, 0x12, 0x79, 0xe3, 0xd2, 0x8c, 0x26, 0xbf,
+		0x89, 0x1b, 0xf9, 0x71, 0x62, 0x36, 0xb0, 0x33, 0x40, 0xec, 0xf9, 0xf6, 0x0f, 0xc3, 0x79, 0x6b,
+		0xc7, 0xf0, 0x18, 0xfe, 0x6e, 0xe2, 0x9d, 0xf6, 0xd2, 0x25, 0xd8, 0x6f, 0xdc, 0xbb, 0xb3, 0x83,
+		0xc0, 0x35, 0xda, 0xa1, 0x6e, 0x87, 0x4e, 0xb2, 0x97, 0x55, 0x88, 0xfc, 0x23, 0x4a, 0xd2, 0xd8,
+		0xb5, 0xbb, 0x4b, 0xf0, 0x4e, 0x5d, 0x2f, 0xfc, 0xd9, 0x30, 0x9a, 0x42, 0xfd, 0x6c, 0xb4, 0xee,
+		0x7a, 0x41, 0xfa, 0x25, 0x0e, 0x7b, 0x91, 0xe1, 0x71, 0xfc, 0xe8, 0x2f, 0x2f, 0x76, 0x7d, 0x37,
+		0x49, 0xce, 0x1a, 0x67, 0x86, 0xeb, 0xad, 0xcb, 0xb6, 0xd1, 0x5c, 0xea, 0xee, 0x21, 0x72, 0x63,
+		0xc7, 0x7c, 0x78, 0x8f, 0xb9, 0xed, 0x61, 0xb4, 0x14, 0xaf, 0xf1, 0x79, 0xbc, 0x45, 0x6f, 0x74,
+		0x64, 0xd9, 0x8b, 0x2e, 0xbc, 0x5b, 0xa3, 0x53, 0x25, 0x1c, 0x2f, 0x3a, 0xf2, 0x82, 0xef, 0x46,
+		0x13, 0x93, 0x34, 0x38, 0x6d, 0xf7, 0x8c, 0xdf, 0x31, 0xfd, 0xf3, 0xd2, 0xe4, 0x57, 0xb0, 0xfd,
+		0xde, 0x97, 0x28, 0x0c, 0x4e, 0x03, 0xa3, 0x17, 0xc2, 0x0f, 0xb3, 0x8c, 0x3a, 0xdb, 0xff, 0x6e,
+		0x7e, 0x96, 0xec, 0x66, 0xd3, 0xe8, 0x97, 0x58, 0x02, 0x9b, 0xed, 0x45, 0x17, 0x3f, 0xbc, 0xd4,
+		0xb9, 0x33, 0x9b, 0x94, 0xd7, 0x37, 0x8c, 0x27, 0x1c, 0x9f, 0xff, 0x75, 0x6a, 0xbc, 0x75, 0xc8,
+		0x48, 0xd3, 0x9d, 0x6b, 0xb7, 0x97, 0xc0, 0x55, 0xbd, 0x6f, 0x27, 0x7e, 0x63, 0xa9, 0xe2, 0x07,
+		0xe7, 0x9d, 0x33, 0xc3, 0xb3, 0x54, 0x6c, 0xbf, 0x77, 0xb0, 0x04, 0xe6, 0xdb, 0x8e, 0x6e, 0xcc,
+		0x4e, 0x7a, 0x72, 0xcc, 0x3e, 0x17, 0x92, 0xe5, 0x6b, 0xfd, 0xd6, 0xf6, 0x1d, 0xd3, 0xe3, 0x37,
+		0x9f, 0xfe, 0xda, 0x8d, 0x1a, 0xc7, 0xe6, 0xbb, 0xda, 0x07, 0x81, 0x63, 0x47, 0xe6, 0x67, 0xbf,
+		0x8f, 0xf4, 0xac, 0xd9, 0x19, 0xca, 0x86, 0x5b, 0xbd, 0x9b, 0xac, 0xfa, 0x98, 0xd1, 0x69, 0xfb,
+		0x37, 0xbe, 0xbb, 0x1f, 0xfe, 0x08, 0x96, 0xc5, 0x66, 0x7b, 0x49, 0xb8, 0xbd, 0x5d, 0x6b, 0xb4,
+		0x2e, 0xc3, 0xef, 0x6e, 0x70, 0x9e, 0x15, 0x9c, 0x32, 0x39, 0x10, 0xd5, 0x6e, 0x7b, 0xcb, 0x71,
+		0xe6, 0xe8, 0xf8, 0xec, 0xd0, 0xf0, 0x1c, 0xbb, 0xcf, 0xbd, 0xc0, 0xe8, 0xb5, 0xe8, 0x25, 0x37,
+		0x86, 0x47, 0x95, 0x2f, 0x8d, 0xc6, 0xf2, 0x6d, 0x6a, 0x34, 0xe7, 0x88, 0x23, 0xa3, 0xb3, 0x32,
+		0xdd, 0x2c, 0xad, 0x34, 0x70, 0xd3, 0x0d, 0xd3, 0xcf, 0x11, 0xd9, 0x1d, 0xdf, 0x0e, 0xb2, 0xc4,
+		0xeb, 0x96, 0xd9, 0x47, 0x6a, 0xcd, 0x66, 0xe0, 0xb6, 0xe1, 0xa7, 0x27, 0x86, 0x91, 0x8d, 0xb3,
+		0xbb, 0x87, 0x24, 0x4b, 0x5f, 0xf9, 0xc3, 0x6c, 0xd3, 0x66, 0xa7, 0xdd, 0xa3, 0x61, 0xdf, 0x10,
+		0xb3, 0x13, 0xae, 0x13, 0xdf, 0xf4, 0x2d, 0xc7, 0xd4, 0xfc, 0x2d, 0x47, 0xa3, 0x49, 0x86, 0x77,
+		0x6b, 0xdf, 0x78, 0xe9, 0xc1, 0xd0, 0xda, 0x19, 0x0d, 0x87, 0x8e, 0x53, 0x6f, 0x6e, 0x1b, 0xbd,
+		0xcf, 0xd2, 0x35, 0xfd, 0x90, 0x9d, 0x9d, 0x76, 0xff, 0xf4, 0xe2, 0xb4, 0x67, 0xb6, 0x62, 0xf5,
+		0xba, 0xcb, 0x10, 0xd1, 0xd8, 0x3c, 0xb6, 0x83, 0xe5, 0x88, 0x05, 0x18, 0x0d, 0xe9, 0x7b, 0xb3,
+		0xa1, 0x10, 0x74, 0xbc, 0xc0, 0xbb, 0xb1, 0x03, 0xa3, 0xcf, 0xb4, 0x24, 0xa9, 0x1d, 0x1f, 0x99,
+		0x8d, 0x86, 0x28, 0x32, 0x3b, 0x9d, 0xd7, 0x8e, 0x8d, 0xae, 0x4b, 0x15, 0xbb, 0xb6, 0x73, 0xb7,
+		0x7f, 0x71, 0x64, 0xf6, 0x3b, 0xdc, 0xf6, 0x7c, 0x3b, 0x36, 0xbf, 0xc2, 0x8b, 0xd7, 0xb5, 0xe3,
+		0x87, 0xc3, 0x8b, 0xfd, 0x13, 0xd3, 0x37, 0x48, 0xcf, 0x42, 0x2f, 0x48, 0x2f, 0xc3, 0xfe, 0x3f,
+		0x17, 0x6e, 0xec, 0x99, 0x4d, 0x9b, 0x3a, 0xf1, 0xbe, 0xef, 0x78, 0x07, 0x41, 0xfb, 0xcc, 0x68,
+		0xfa, 0x14, 0x24, 0x66, 0xd7, 0x59, 0xb8, 0xdf, 0xd8, 0x34, 0xf9, 0xf1, 0xff, 0xeb, 0x9b, 0x9d,
+		0xf8, 0x60, 0x27, 0x0f, 0x81, 0x63, 0x76, 0x15, 0xc9, 0x24, 0x3d, 0xb3, 0xcd, 0xe6, 0x7b, 0xc9,
+		0xd6, 0x85, 0x77, 0x6b, 0x7a, 0x46, 0xfe, 0x7f, 0x1b, 0x3b, 0x1b, 0x75, 0xf3, 0x93, 0x67, 0x3e,
+		0x5d, 0x98, 0xed, 0x4c, 0xb7, 0xcd, 0x2e, 0x60, 0xe6, 0x27, 0x3f, 0x96, 0x22, 0x4b, 0xf4, 0xd8,
+		0x76, 0x7c, 0xd3, 0x6b, 0x77, 0xde, 0xee, 0xec, 0x98, 0x1d, 0xa0, 0x74, 0xeb, 0x66, 0x57, 0x77,
+		0xa9, 0x2d, 0x07, 0x14, 0xf6, 0xba, 0x69, 0xf2, 0x94, 0x83, 0x65, 0x7c, 0x66, 0x62, 0x72, 0xd9,
+		0xee, 0x1a, 0x1d, 0x1d, 0xe8, 0x78, 0x37, 0xb1, 0xbb, 0x04, 0x67, 0x0a, 0xec, 0x7a, 0xe3, 0x38,
+		0x5a, 0x82, 0xf3, 0x36, 0xb6, 0x93, 0xba, 0xbe, 0x97, 0x1c, 0xbb, 0xa9, 0x7d, 0x74, 0x7a, 0x6a,
+		0xf4, 0xe1, 0xf1, 0xc4, 0x37, 0xdb, 0x1b, 0xed, 0xc4, 0x9d, 0xfa, 0xe6, 0x71, 0x27, 0xfe, 0xd4,
+		0x0b, 0xda, 0x66, 0x97, 0x78, 0x0e, 0x3a, 0xb6, 0xd1, 0x99, 0xbb, 0x37, 0x76, 0xe2, 0x39, 0xa6,
+		0x47, 0xcd, 0x4c, 0x8f, 0xcd, 0xf4, 0x0f, 0xd2, 0x7d, 0xd9, 0x3f, 0xd8, 0x3d, 0xd9, 0x35, 0x7c,
+		0x9f, 0xf7, 0x26, 0x0c, 0xda, 0xa6, 0xc7, 0x5f, 0xf7, 0x02, 0xdf, 0xec, 0xba, 0x54, 0x9d, 0xea,
+		0x6d, 0x12, 0x2c, 0xc1, 0x5e, 0x75, 0xc3, 0xf0, 0xa0, 0x5f, 0x37, 0x6c, 0xbb, 0x5d, 0xc3, 0x01,
+		0x7d, 0x68, 0x36, 0x07, 0xbf, 0x1f, 0xe4, 0x9d, 0x1c, 0x46, 0xbb, 0xed, 0x76, 0xec, 0x26, 0x89,
+		0xd9, 0x56, 0xee, 0xe7, 0x65, 0xf8, 0x39, 0xec, 0xc5, 0xcb, 0x53, 0xe9, 0xcc, 0xf8, 0x82, 0x54,
+		0x3f, 0x7e, 0xd8, 0xc1, 0x99, 0xd1, 0xae, 0x84, 0xe3, 0x1a, 0x0d, 0x8a, 0xae, 0xdb, 0xf6, 0xec,
+		0x63, 0xdb, 0xf3, 0xcd, 0x2f, 0xe0, 0xd4, 0x8d, 0xfc, 0xe4, 0xb2, 0x67, 0x7c, 0xb4, 0xc0, 0xf4,
+		0xb3, 0x98, 0xf7, 0xdd, 0x1f, 0x76, 0xec, 0x0e, 0x13, 0x16, 0x4f, 0x3c, 0xc7, 0x70, 0x03, 0x7e,
+		0xd1, 0xbb, 0x59, 0x8a, 0x72, 0xb1, 0x83, 0xd3, 0xca, 0xcb, 0x11, 0xdf, 0xcc, 0xb6, 0x8f, 0x7a,
+		0x4b, 0x55, 0xa5, 0xc3, 0x68, 0x0b, 0xe8, 0xa4, 0x07, 0xdd, 0x9e, 0xe9, 0x19, 0xd6, 0xe7, 0x59,
+		0xa1, 0x7d, 0xa3, 0xcd, 0x9f, 0xed, 0x5c, 0xb8, 0xce, 0x5e, 0x18, 0xa4, 0x71, 0xe8, 0xfb, 0x6e,
+		0xfb, 0xf0, 0xb3, 0xd1, 0x42, 0x15, 0xc4, 0x4b, 0xd0, 0x96, 0x65, 0xc3, 0x6e, 0x1f, 0xd9, 0xb7,
+		0xcb, 0x10, 0x5b, 0x3b, 0xbd, 0x30, 0x7d, 0x8b, 0xb8, 0x61, 0xb8, 0x82, 0x32, 0xda, 0xde, 0xb5,
+		0x83, 0x7f, 0x99, 0x7d, 0x54, 0xb6, 0x63, 0x27, 0xa9, 0xf1, 0x4d, 0x71, 0x86, 0xdd, 0xba, 0x3e,
+		0x7f, 0xd9, 0x37, 0xfe, 0x2d, 0xf6, 0x0f, 0x8d, 0x7e, 0x87, 0xdb, 0x4e, 0x64, 0xf8, 0xa9, 0xc0,
+		0xed, 0xad, 0xad, 0x9f, 0x8d, 0x96, 0xf9, 0x0d, 0xa5, 0x0d, 0x3f, 0xbe, 0x72, 0xdc, 0xf3, 0x53,
+		0xcf, 0xf7, 0x82, 0xef, 0xe6, 0x6f, 0x05, 0x7b, 0xd1, 0x12, 0x34, 0xc8, 0x71, 0x6e, 0xfc, 0x3f,
+		0x5d, 0x27, 0xb5, 0x2f, 0x52, 0x7b, 0x19, 0x08, 0x6c, 0xdd, 0x68, 0xd6, 0x94, 0xba, 0x71, 0xd7,
+		0xf0, 0xa4, 0xeb, 0x51, 0x21, 0xad, 0xcf, 0xde, 0x8d, 0xd9, 0xdc, 0xe3, 0x67, 0xa3, 0xd5, 0xf5,
+		0x8d, 0x36, 0x7a, 0x7e, 0x37, 0x5a, 0x92, 0xd6, 0xf0, 0x9f, 0xcc, 0xde, 0xc8, 0xf3, 0x12, 0xb3,
+		0x37, 0xbd, 0xbc, 0x2c, 0x9e, 0xec, 0xbb, 0xf6, 0xbd, 0xd1, 0xe6, 0x3a, 0x35, 0x7e, 0xab, 0xc5,
+		0xdf, 0xf0, 0xa2, 0x9f, 0xf7, 0xbe, 0xd9, 0x47, 0x98, 0xbd, 0x9b, 0xee, 0xc6, 0x56, 0x2d, 0xb2,
+		0xe3, 0xbd, 0x3b, 0xb3, 0x5f, 0x24, 0xdb, 0xbe, 0x33, 0x3a, 0xbd, 0xe0, 0xce, 0xf0, 0x32, 0x23,
+		0xb7, 0xf1, 0x46, 0x6d, 0xe3, 0x70, 0xff, 0x72, 0x19, 0x0a, 0xcc, 0xed, 0x25, 0x5d, 0xdb, 0x31,
+		0x9a, 0xf8, 0xdd, 0x6d, 0x34, 0x36, 0xbe, 0xd8, 0xa9, 0xfb, 0xdd, 0x75, 0x23, 0xb3, 0x99, 0x5f,
+		0x14, 0x87, 0xa9, 0x1b, 0x06, 0xdb, 0x35, 0xd3, 0x8b, 0xfe, 0x65, 0x31, 0x40, 0xa3, 0xa3, 0xb0,
+		0xde, 0xad, 0x97, 0xda, 0xfe, 0x5f, 0xb1, 0x1d, 0x45, 0x6e, 0xbc, 0x44, 0x5d, 0x1b, 0xb2, 0xac,
+		0x53, 0xc3, 0xe3, 0x06, 0xb7, 0x51, 0x68, 0xb4, 0xf5, 0x4e, 0x0c, 0xaf, 0xac, 0x9f, 0xd5, 0xd8,
+		0x5a, 0x82, 0x32, 0x0b, 0xc3, 0x70, 0xc1, 0x86, 0xf9, 0xf6, 0xaf, 0x9d, 0x98, 0xdd, 0xdc, 0x67,
+		0x63, 0xcb, 0xf4, 0xf0, 0x5f, 0x7d, 0x63, 0xa7, 0x69, 0xf4, 0x3b, 0x18, 0xce, 0xc7, 0xa3, 0x30,
+		0xd8, 0x34, 0xbb, 0xa8, 0x50, 0x12, 0x76, 0xd2, 0x2c, 0x19, 0xf0, 0x28, 0x0c, 0xa3, 0x1b, 0xdb,
+		0xf9, 0x6e, 0x76, 0x2c, 0xd9, 0xf8, 0xca, 0x17, 0x86, 0x1f, 0x6a, 0x4e, 0x6a, 0xe6, 0xef, 0x71,
+		0x0d, 0x7b, 0xbe, 0x5d, 0x98, 0x5e, 0xb5, 0xfa, 0xc8, 0xec, 0xe3, 0xbf, 0xdd, 0x76, 0xb2, 0xff,
+		0xd3, 0xe8, 0x62, 0xdb, 0xa9, 0xe1, 0x7c, 0x3b, 0x8b, 0xbd, 0x9a, 0x1e, 0x7a, 0xed, 0xf9, 0xa9,
+		0xd9, 0xf5, 0x17, 0x7f, 0x36, 0x5a, 0x91, 0xef, 0x2e, 0x81, 0xbf, 0xd3, 0xda, 0x4f, 0xe3, 0x25,
+		0x38, 0x66, 0x77, 0x79, 0x6b, 0x7a, 0x14, 0x66, 0x3f, 0x74, 0x92, 0xbf, 0xbc, 0xd8, 0xf5, 0xdd,
+		0x24, 0x59, 0x86, 0xdc, 0xf6, 0xa8, 0x1d, 0xf4, 0xb3, 0xfe, 0x32, 0x06, 0x5b, 0x5f, 0x82, 0x56,
+		0x13, 0xc3, 0x06, 0x0d, 0xa6, 0x53, 0xd9, 0x71, 0x36, 0xe6, 0xe7, 0x7f, 0x99, 0x7e, 0x8e, 0xc5,
+		0xec, 0xea, 0x17, 0x89, 0x63, 0x7e, 0xc6, 0xd9, 0x9e, 0xe1, 0x54, 0xaa, 0xdb, 0x59, 0x82, 0xb2,
+		0x8c, 0xae, 0x63, 0x7a, 0xd3, 0x8f, 0x49, 0x53, 0xd1, 0x58, 0x02, 0x53, 0x61, 0x76, 0x0f, 0x1c,
+		0xc3, 0x33, 0xf5, 0x9d, 0xb0, 0xd3, 0x71, 0x4d, 0x3f, 0x6f, 0xba, 0x9b, 0x78, 0x87, 0xcb, 0x50,
+		0x47, 0xa5, 0xb5, 0x77, 0xfe, 0xf9, 0xec, 0x30, 0x30, 0xbb, 0x8f, 0x4f, 0x12, 0xdf, 0x98, 0xdd,
+		0xa2, 0xd8, 0x6c, 0x23, 0xed, 0x84, 0xdd, 0x28, 0x4c, 0x3c, 0xf3, 0xa3, 0xc8, 0x37, 0xb7, 0x51,
+		0x14, 0xfa, 0x9e, 0xf3, 0x60, 0x3b, 0x4e, 0xd8, 0x0b, 0x52, 0xc3, 0x7b, 0xfb, 0x66, 0x69, 0x36,
+		0x67, 0x71, 0xf8, 0xf3, 0xc1, 0xf8, 0x9a, 0x11, 0x91, 0xdf, 0x33, 0xda, 0x97, 0xf0, 0x1b, 0xa6,
+		0xc7, 0x03, 0x93, 0x38, 0x5a, 0x8a, 0xa2, 0x04, 0xcb, 0x51, 0x24, 0xa2, 0x9f, 0xce, 0x78, 0x6e,
+		0x78, 0x3a, 0xa3, 0xd9, 0x34, 0xfc, 0x7e, 0xc3, 0xe8, 0x03, 0x82, 0xf7, 0x86, 0x27, 0x10, 0xdc,
+		0xc4, 0x5e, 0xfb, 0xd6, 0x6c, 0x27, 0xc2, 0xec, 0xa4, 0xbe, 0x4e, 0xfc, 0x39, 0x8c, 0x7f, 0xd8,
+		0xb1, 0xd9, 0x25, 0x3d, 0x43, 0xb3, 0x0f, 0x0b, 0xf9, 0xcb, 0xd2, 0xb0, 0xb1, 0x5f, 0x86, 0xca,
+		0x09, 0x83, 0xc0, 0x75, 0x52, 0xe3, 0x73, 0x20, 0xfa, 0x81, 0xd7, 0x8c, 0x6c, 0x2c, 0x41, 0x7c,
+		0xa0, 0x79, 0x19, 0x7e, 0x77, 0x83, 0x4f, 0xbd, 0x64, 0x09, 0x92, 0xc3, 0xcf, 0xc2, 0x1f, 0x6e,
+		0xec, 0x7b, 0x81, 0xbb, 0x4c, 0x3b, 0x91, 0xcb, 0x41, 0x68, 0xb3, 0x55, 0xb9, 0x35, 0xbd, 0xe0,
+		0xe7, 0xa0, 0x35, 0xc5, 0xb1, 0xed, 0x1c, 0x99, 0xde, 0x76, 0x66, 0x50, 0xe7, 0xf0, 0xc4, 0x73,
+		0x2e, 0x0d, 0x97, 0xab, 0xee, 0x43, 0xec, 0x19, 0xbe, 0xf7, 0x92, 0xa4, 0xb6, 0xf3, 0xfd, 0x32,
+		0xbc, 0x48, 0x0d, 0x4f, 0x32, 0x8d, 0xc2, 0xa0, 0xde, 0x6a, 0x99, 0x5f, 0x85, 0xee, 0x8f, 0xc0,
+		0x59, 0x92, 0x3a, 0x74, 0x59, 0xe5, 0xb0, 0xba, 0xe9, 0xb9, 0x8e, 0x87, 0x8e, 0x17, 0x99, 0x7e,
+		0x1a, 0x21, 0x2b, 0x46, 0x62, 0xf4, 0x42, 0x44, 0xbe, 0x63, 0x7e, 0xf9, 0x88, 0xbf, 0xd2, 0x68,
+		0x58, 0xd9, 0xd7, 0xf8, 0x7a, 0x99, 0x77, 0x5e, 0x14, 0x79, 0xa6, 0xb3, 0xdc, 0xe1, 0x62, 0x98,
+		0xfc, 0x1a, 0x76, 0xc7, 0xb7, 0x83, 0x7e, 0xb1, 0x4c, 0xa3, 0xdf, 0xc2, 0xf6, 0x4d, 0x4f, 0xfa,
+		0xf8, 0x12, 0x85, 0xc1, 0xd9, 0xdd, 0x43, 0x92, 0x25, 0x08, 0xfe, 0x11, 0x18, 0x0f, 0x8d, 0x7e,
+		0x65, 0xb4, 0xc8, 0x77, 0x7f, 0x86, 0xf1, 0x32, 0xf9, 0xb2, 0xcb, 0xd0, 0xb1, 0x73, 0xd0, 0xf5,
+		0xa1, 0x61, 0x76, 0x93, 0xa0, 0xb3, 0x1f, 0x97, 0x0f, 0x91, 0x4a, 0x98, 0x44, 0xea, 0xce, 0xab,
+		0x4a, 0x01, 0x73, 0xf5, 0xab, 0xa2, 0x34, 0x37, 0x77, 0x49, 0xe2, 0x59, 0x06, 0x3c, 0xe7, 0xed,
+		0x56, 0x6d, 0xc3, 0x4e, 0x1b, 0xdd, 0x1b, 0x13, 0x1e, 0xb6, 0x9d, 0xd4, 0x3f, 0xef, 0x1f, 0x99,
+		0xf0, 0xa4, 0x69, 0x5c, 0xab, 0x6d, 0x9b, 0xf0, 0xa0, 0x77, 0x5e, 0xe4, 0xc6, 0xbe, 0x1d, 0x34,
+		0x4c, 0x78, 0xd8, 0x61, 0xe3, 0x9f, 0xcb, 0xd8, 0x0e, 0x92, 0x28, 0x8c, 0x53, 0x23, 0x26, 0x38,
+		0xec, 0xba, 0x51, 0x60, 0x9b, 0xf0, 0xa8, 0x5e, 0xe7, 0xcf, 0x8e, 0x27, 0xa7, 0xd0, 0x8b, 0x7f,
+		0xd8, 0x41, 0xe5, 0xda, 0x33, 0xa9, 0x84, 0x9f, 0xe2, 0x9f, 0xf6, 0x69, 0x07, 0xeb, 0xc2, 0x8d,
+		0xef, 0x3d, 0xc7, 0x88, 0x29, 0x1e, 0xb4, 0x4b, 0x91, 0x8f, 0x53, 0x17, 0xff, 0xc4, 0x8e, 0x97,
+		0x38, 0xe1, 0xe1, 0xc5, 0x91, 0x5c, 0x16, 0xd5, 0x02, 0x9e, 0xf7, 0xa9, 0x1e, 0xe5, 0x59, 0x1c,
+		0x76, 0x3c, 0xdf, 0x08, 0xb9, 0x18, 0x85, 0xa2, 0x5a, 0x4d, 0x23, 0x14, 0x70, 0xfb, 0xae, 0xbe,
+		0xdd, 0x30, 0xc2, 0xbe, 0x0d, 0xab, 0x84, 0xd5, 0x25, 0xab, 0x84, 0x15, 0xff, 0xc0, 0x49, 0x76,
+		0x60, 0xe5, 0xcc, 0x4e, 0xef, 0x4c, 0x78, 0x58, 0x37, 0x71, 0x42, 0x23, 0xb4, 0x42, 0xdb, 0x31,
+		0xe2, 0x31, 0xbb, 0x91, 0x7b, 0x6b, 0x14, 0x15, 0xfb, 0xb9, 0xbd, 0x79, 0x64, 0x47, 0x46, 0xf0,
+		0x85, 0xc8, 0x8b, 0x4c, 0x78, 0xcc, 0x44, 0x2a, 0x3b, 0xb0, 0xf8, 0xe7, 0x1c, 0x36, 0xfd, 0x30,
+		0xc2, 0xb8, 0x46, 0x26, 0x51, 0x80, 0xcd, 0xbf, 0x8e, 0x77, 0x4f, 0x4c, 0x78, 0x60, 0xdf, 0x8e,
+		0xda, 0x46, 0xb8, 0x35, 0x69, 0x57, 0xaa, 0x2a, 0xcb, 0x02, 0x9e, 0xf4, 0xde, 0x73, 0xbc, 0xd3,
+		0x81, 0x47, 0x2e, 0xd9, 0x15, 0x68, 0x01, 0x36, 0x2b, 0x74, 0xec, 0x3f, 0xdd, 0x38, 0xf1, 0xc2,
+		0xa0, 0x6e, 0xc2, 0xf3, 0xc6, 0xd9, 0x0e, 0xe3, 0xf1, 0xee, 0x9e, 0x21, 0xd1, 0x39, 0x23, 0xe4,
+		0xd6, 0xf6, 0x5b, 0x46, 0xd8, 0xac, 0x7a, 0xdd, 0x0c, 0x7b, 0x25, 0x9d, 0xdf, 0xbd, 0x20, 0x1e,
+		0x20, 0xdd, 0x61, 0x67, 0x01, 0xf0, 0x4f, 0x1a, 0x1b, 0x0d, 0x43, 0xc2, 0xc8, 0x91, 0xa7, 0xd0,
+		0x23, 0x78, 0x01, 0xde, 0x60, 0xe4, 0xc7, 0x89, 0x19, 0x00, 0xeb, 0xe7, 0x89, 0xfb, 0xf6, 0x0f,
+		0x43, 0x78, 0x56, 0xc7, 0x90, 0xd8, 0xe6, 0x6e, 0xe2, 0x9d, 0xf6, 0x52, 0x83, 0xf6, 0x3d, 0xa4,
+		0xab, 0x94, 0x2f, 0x60, 0x7a, 0xa7, 0x9b, 0x3b, 0x1b, 0xf4, 0xec, 0x5d, 0x2f, 0x94, 0xea, 0xea,
+		0xb7, 0x80, 0x80, 0x46, 0xa3, 0x75, 0xd7, 0x0b, 0xd2, 0x2f, 0x72, 0x09, 0xf1, 0x0b, 0x89, 0x6f,
+		0x46, 0xa3, 0x1c, 0x8e, 0xb3, 0xc6, 0x99, 0x21, 0x7a, 0xe2, 0xb2, 0x6d, 0x04, 0x57, 0xb8, 0x7b,
+		0x88, 0xdc, 0xd8, 0x31, 0x07, 0x66, 0x63, 0x2e, 0x76, 0x18, 0x19, 0xf5, 0xb8, 0x9f, 0xc7, 0x5b,
+		0x79, 0x46, 0x44, 0xe8, 0xbc, 0xe8, 0xc2, 0xbb, 0x35, 0x62, 0x8b, 0xd4, 0xf1, 0x22, 0xb9, 0xc2,
+		0x20, 0x0b, 0x30, 0xc8, 0x69, 0x70, 0xda, 0xee, 0x19, 0xb3, 0x43, 0xf3, 0xe7, 0xa5, 0x11, 0x8e,
+		0xf9, 0x20, 0xfd, 0xf2, 0x34, 0x30, 0x62, 0x62, 0xfd, 0x30, 0xcb, 0x44, 0xb1, 0xfd, 0xef, 0xe6,
+		0x64, 0x79, 0x6d, 0x36, 0x8d, 0x78, 0x58, 0x83, 0x6c, 0x98, 0x17, 0x5d, 0xfc, 0xf0, 0x52, 0xe7,
+		0xce, 0x0c, 0xb2, 0x58, 0xdf, 0x30, 0xc6, 0xd0, 0x7e, 0xfe, 0xd7, 0xa9, 0x31, 0xda, 0x55, 0xb9,
+		0x89, 0xd4, 0x02, 0xe6, 0x38, 0x2b, 0x96, 0x64, 0xa4, 0x1f, 0x79, 0xde, 0x39, 0x33, 0x64, 0xb7,
+		0x79, 0x58, 0x42, 0xd2, 0x14, 0x73, 0x66, 0x47, 0x37, 0x66, 0x24, 0x1d, 0x38, 0x66, 0xe4, 0xff,
+		0x66, 0x79, 0x11, 0xbf, 0xb5, 0x7d, 0x23, 0x9e, 0x36, 0xf3, 0xcb, 0x3f, 0xfd, 0xb5, 0x1b, 0x35,
+		0x8e, 0xcd, 0x71, 0xc5, 0x0e, 0x02, 0xc7, 0x8e, 0xcc, 0xc9, 0xa2, 0x94, 0x2f, 0x04, 0xbf, 0x88,
+		0x8c, 0x39, 0x43, 0xac, 0xc3, 0x8d, 0x97, 0x26, 0x66, 0xa4, 0x79, 0x3e, 0xab, 0x28, 0x67, 0x8e,
+		0x0d, 0x1b, 0x15, 0x7f, 0xed, 0x17, 0x77, 0x39, 0x97, 0x2a, 0x73, 0xb9, 0x80, 0x40, 0x42, 0xbb,
+		0xed, 0x99, 0x95, 0x13, 0x7e, 0x7c, 0x76, 0x68, 0x48, 0x6e, 0xca, 0xe7, 0x5e, 0x60, 0xc4, 0xdc,
+		0xf6, 0x92, 0x1b, 0x43, 0xa2, 0x73, 0x97, 0x46, 0x60, 0xea, 0x36, 0x35, 0xc2, 0xd6, 0xc6, 0x91,
+		0x11, 0x59, 0x48, 0xa3, 0x0e, 0xdd, 0x1b, 0xa6, 0xe4, 0x79, 0x8f, 0xcf, 0xa7, 0xb7, 0xcc, 0x38,
+		0x1a, 0x64, 0x06, 0x33, 0xb4, 0x0d, 0xc9, 0x9e, 0x1d, 0x7a, 0xb2, 0x4a, 0xe7, 0xe2, 0x17, 0x62,
+		0xad, 0x86, 0x9d, 0x7e, 0x0c, 0x49, 0xf4, 0x4b, 0x7c, 0x53, 0xb6, 0x3e, 0x52, 0x73, 0xb6, 0x3e,
+		0x8c, 0x30, 0xae, 0xde, 0xad, 0x7d, 0xe3, 0x0d, 0x9a, 0x39, 0x19, 0xf2, 0xc8, 0x71, 0xc7, 0xa9,
+		0x37, 0xb7, 0x8d, 0x88, 0x27, 0x77, 0x4d, 0x39, 0xa4, 0x90, 0xb5, 0x5f, 0x97, 0x2d, 0x65, 0xb3,
+		0x00, 0xd7, 0xb0, 0x6b, 0x92, 0x07, 0xbb, 0x79, 0x6c, 0x07, 0x66, 0xf9, 0x84, 0x46, 0x40, 0xeb,
+		0xde, 0x0c, 0x51, 0x0d, 0x3a, 0x5e, 0xe0, 0xdd, 0xd8, 0x81, 0x11, 0x39, 0xca, 0x49, 0x6a, 0xc7,
+		0x47, 0x66, 0x48, 0x6b, 0x14, 0x99, 0x91, 0x76, 0x66, 0xc7, 0x46, 0xd4, 0x5f, 0x88, 0x5d, 0xdb,
+		0xb9, 0xdb, 0xbf, 0x38, 0x32, 0xe3, 0x59, 0x6f, 0x7b, 0xbe, 0x1d, 0x9b, 0x73, 0xb2, 0xda, 0xeb,
+		0xda, 0xf1, 0xc3, 0xe1, 0xc5, 0xfe, 0x89, 0x29, 0x1b, 0x32, 0x67, 0xa1, 0x17, 0xa4, 0x97, 0x61,
+		0xff, 0x9f, 0x0b, 0x37, 0xf6, 0xcc, 0xa0, 0x05, 0x9d, 0x78, 0xdf, 0x77, 0xbc, 0x83, 0xa0, 0x7d,
+		0x66, 0x04, 0x3d, 0x08, 0x12, 0x33, 0xce, 0x5b, 0xde, 0x6f, 0x6c, 0x9a, 0xf0, 0x98, 0xff, 0xf5,
+		0xcd, 0xd8, 0xf0, 0xb4, 0x93, 0x87, 0xc0, 0x31, 0xa3, 0x7a, 0x50, 0x92, 0x9e, 0xd9, 0x66, 0xf0,
+		0x96, 0x64, 0x4b, 0xba, 0x07, 0xeb, 0x02, 0x24, 0xb5, 0xb1, 0xb3, 0x51, 0x37, 0x67, 0xb3, 0xfb,
+		0xd3, 0x85, 0x19, 0xce, 0x56, 0xdb, 0x8c, 0x02, 0x1b, 0x7e, 0xf2, 0xc3, 0xa8, 0xec, 0x27, 0xf9,
+		0x82, 0x97, 0x0b, 0x88, 0x66, 0xed, 0xec, 0x98, 0x11, 0x18, 0x72, 0xeb, 0x66, 0x9c, 0xaa, 0xae,
+		0x99, 0x25, 0xaa, 0x7b, 0xdd, 0x54, 0xa9, 0xd9, 0xc8, 0x82, 0x32, 0x74, 0x92, 0xcb, 0x76, 0xd7,
+		0x08, 0x2f, 0xb1, 0xe3, 0xdd, 0xc4, 0xae, 0x41, 0xb9, 0xa6, 0x76, 0xbd, 0x71, 0x1c, 0x19, 0x94,
+		0x27, 0x6d, 0x3b, 0xa9, 0xeb, 0x7b, 0xc9, 0xb1, 0x9b, 0xda, 0x47, 0xa7, 0xa7, 0x46, 0x1c, 0x52,
+		0x4b, 0x7c, 0x33, 0xbc, 0x98, 0x4e, 0xdc, 0xa9, 0x6f, 0x1e, 0x77, 0xe2, 0x4f, 0xbd, 0xa0, 0x6d,
+		0x46, 0xa9, 0xbb, 0xa0, 0x63, 0x1b, 0x91, 0x61, 0x76, 0x63, 0x27, 0x9e, 0x63, 0x4a, 0x74, 0xc3,
+		0x14, 0x9f, 0xbb, 0x7f, 0x10, 0xe1, 0xcb, 0xfe, 0xc1, 0xee, 0xc9, 0xae, 0x21, 0xfb, 0x47, 0x37,
+		0x61, 0xd0, 0x36, 0x25, 0xbe, 0xb5, 0x17, 0xf8, 0x66, 0xd4, 0x5f, 0xe8, 0x54, 0x6f, 0x93, 0xc0,
+		0xa0, 0xbd, 0xae, 0x86, 0x21, 0x41, 0x98, 0x6e, 0xd8, 0x76, 0xbb, 0x86, 0x00, 0xeb, 0xd0, 0x0c,
+		0x6e, 0x78, 0x3f, 0xd8, 0x3f, 0x3e, 0x8c, 0x76, 0xdb, 0xed, 0xd8, 0x4d, 0x12, 0x33, 0xac, 0xc1,
+		0xcf, 0xcb, 0xf0, 0x73, 0xd8, 0x8b, 0xcd, 0xab, 0xc4, 0x61, 0x4c, 0xe1, 0x85, 0x41, 0xb3, 0x09,
+		0x23, 0x52, 0xe5, 0x5d, 0x23, 0x84, 0xb6, 0xeb, 0xb6, 0x3d, 0xfb, 0xd8, 0xf6, 0x7c, 0x73, 0x0a,
+		0x18, 0x74, 0x23, 0x3f, 0xb9, 0xec, 0x19, 0xe3, 0x35, 0x9a, 0x72, 0x06, 0x65, 0xd0, 0x02, 0x73,
+		0x98, 0xb8, 0x73, 0xe2, 0x39, 0x86, 0x18, 0xb4, 0x8b, 0xde, 0x8d, 0x51, 0xe5, 0xba, 0x06, 0xa7,
+		0xa8, 0xcc, 0x8a, 0x2b, 0x65, 0xe1, 0xf0, 0x9e, 0x91, 0xa7, 0x6c, 0x8d, 0xb0, 0x14, 0x4e, 0x7a,
+		0xd0, 0xed, 0x99, 0x92, 0xd9, 0x27, 0xd9, 0x2a, 0x70, 0x01, 0x66, 0xa2, 0xdf, 0xbc, 0x74, 0x4f,
+		0xa9, 0x75, 0xe9, 0x02, 0x84, 0x21, 0x88, 0x0d, 0x2a, 0x9b, 0xbc, 0x61, 0xb7, 0x8f, 0xec, 0x5b,
+		0x93, 0x62, 0x20, 0xa7, 0x17, 0xa6, 0x6c, 0x3d, 0x35, 0x0c, 0x51, 0x08, 0x46, 0xd8, 0x85, 0x76,
+		0xf0, 0x2f, 0x33, 0x8e, 0xfc, 0x74, 0xec, 0x24, 0x35, 0xa6, 0x08, 0xf5, 0xb0, 0x1a, 0xfd, 0xe7,
+		0x2f, 0xfb, 0xc6, 0x3c, 0xed, 0xfe, 0xa1, 0x11, 0xcf, 0x7a, 0xdb, 0x89, 0x0c, 0x39, 0x3d, 0xb1,
+		0xbd, 0xb5, 0xf5, 0xb3, 0xd1, 0x32, 0xa7, 0xd1, 0x96, 0x21, 0xe9, 0xc8, 0xfd, 0x86, 0xa7, 0xbe,
+		0x17, 0x7c, 0x37, 0x67, 0x8b, 0xc9, 0x8b, 0x0c, 0x2a, 0x48, 0xed, 0xdc, 0xf8, 0x7f, 0xba, 0x4e,
+		0x6a, 0x5f, 0xa4, 0xb6, 0x49, 0x84, 0xab, 0x6e, 0x04, 0x2b, 0x48, 0xdd, 0xb8, 0x6b, 0x48, 0xb2,
+		0xdf, 0xa8, 0x30, 0xc4, 0x67, 0xef, 0xc6, 0x0c, 0x9b, 0xfb, 0xb3, 0xd1, 0xea, 0xfa, 0x46, 0x18,
+		0x07, 0xbf, 0x1b, 0x19, 0xd6, 0xba, 0xee, 0x93, 0x19, 0x1b, 0x0d, 0x5e, 0x62, 0x46, 0xb0, 0xde,
+		0xcb, 0xe2, 0x72, 0xbe, 0x6b, 0xdf, 0x1b, 0x61, 0xbe, 0x52, 0x63, 0x42, 0xca, 0xfe, 0x86, 0x17,
+		0xfd, 0x34, 0xa5, 0x33, 0xa4, 0x77, 0xd3, 0xdd, 0xd8, 0xaa, 0x45, 0x76, 0xbc, 0x77, 0x67, 0xc6,
+		0x03, 0x67, 0xdb, 0x0b, 0x46, 0x6c, 0x37, 0xde, 0x19, 0x72, 0x1c, 0xf8, 0x36, 0xde, 0xa8, 0x6d,
+		0x1c, 0xee, 0x5f, 0x9a, 0x54, 0xd0, 0x64, 0x2f, 0xe9, 0xda, 0x8e, 0x11, 0x04, 0xe6, 0x6e, 0xa3,
+		0xb1, 0xf1, 0xc5, 0x4e, 0xdd, 0xef, 0xae, 0x1b, 0xb9, 0xb1, 0x41, 0xcd, 0x41, 0xb7, 0x8d, 0x69,
+		0x0e, 0x9a, 0xc5, 0x64, 0x8c, 0x88, 0x72, 0x79, 0xb7, 0x5e, 0x6a, 0xfb, 0x7f, 0xc5, 0x76, 0x14,
+		0xb9, 0xb1, 0x81, 0xd5, 0x5c, 0xb3, 0x6c, 0x2a, 0x43, 0xfc, 0xc7, 0xdb, 0xc8, 0x8c, 0x16, 0xac,
+		0x89, 0x21, 0x95, 0x3b, 0xb3, 0x9a, 0x11, 0x06, 0x1d, 0xb7, 0x1c, 0xba, 0x8d, 0x1b, 0xe6, 0xd8,
+		0x89, 0x76, 0x62, 0x46, 0xd1, 0xec, 0x8d, 0x2d, 0x53, 0xc2, 0x31, 0xf5, 0x8d, 0x1d, 0x23, 0xfa,
+		0x86, 0x7b, 0x86, 0xf0, 0xc4, 0x28, 0x0c, 0x36, 0xcd, 0x38, 0x84, 0x9f, 0x84, 0x9d, 0x34, 0x4b,
+		0x96, 0x39, 0x0a, 0xc3, 0xe8, 0xc6, 0x76, 0xbe, 0x9b, 0x11, 0x93, 0x33, 0xe6, 0x44, 0xab, 0x21,
+		0x87, 0xad, 0x92, 0x9a, 0x39, 0xb1, 0xf9, 0x61, 0x2f, 0x82, 0x0b, 0x53, 0xaa, 0xf4, 0x1d, 0x99,
+		0x71, 0x5c, 0xa9, 0xdb, 0x4e, 0x0c, 0x69, 0x72, 0x9c, 0x1a, 0xc2, 0x03, 0xb3, 0xd8, 0x96, 0x29,
+		0xa1, 0xad, 0x9e, 0x9f, 0x9a, 0x51, 0x8f, 0xe7, 0x67, 0xa3, 0x15, 0xf9, 0xae, 0x41, 0xbc, 0xba,
+		0xb5, 0x9f, 0xc6, 0x06, 0x1d, 0x53, 0xb8, 0xbc, 0x35, 0xc5, 0xbb, 0xde, 0x0f, 0x9d, 0x64, 0xd4,
+		0xb8, 0xd0, 0xa4, 0x1c, 0xc9, 0xa8, 0x1d, 0xf4, 0xb3, 0x62, 0x32, 0xc6, 0x55, 0x37, 0xa8, 0xd4,
+		0xec, 0xb0, 0x70, 0xab, 0x29, 0xd4, 0x6b, 0x9c, 0x7d, 0xf4, 0xf9, 0x5f, 0xa6, 0xe4, 0x25, 0x9b,
+		0x71, 0xaa, 0x35, 0x71, 0x0c, 0xea, 0xd0, 0x6c, 0x08, 0x55, 0xe8, 0x76, 0x0c, 0x2a, 0xd3, 0xe3,
+		0x3a, 0xa6, 0x14, 0xf1, 0x9d, 0x54, 0xb5, 0x0d, 0x83, 0x54, 0xad, 0x19, 0x35, 0xa7, 0x0d, 0xc9,
+		0xec, 0x74, 0xc2, 0x4e, 0xc7, 0x75, 0xcd, 0xe9, 0x7f, 0x7e, 0x68, 0xd2, 0xb9, 0xe6, 0xd6, 0xde,
+		0xf9, 0xe7, 0xb3, 0xc3, 0xc0, 0x8c, 0xfa, 0xd8, 0x49, 0x7c, 0x63, 0x46, 0xab, 0x27, 0x33, 0x8c,
+		0x96, 0x13, 0x76, 0xa3, 0x30, 0xf1, 0xcc, 0x89, 0xc6, 0xdd, 0xdc, 0x46, 0x51, 0xe8, 0x7b, 0xce,
+		0x83, 0xed, 0x38, 0x61, 0x2f, 0x48, 0x0d, 0xe9, 0x9d, 0x94, 0x6d, 0x8b, 0x9f, 0xc5, 0xe1, 0xcf,
+		0x07, 0x63, 0xce, 0x88, 0x46, 0x7e, 0xcf, 0x08, 0x2e, 0xeb, 0x37, 0x4c, 0x89, 0xcf, 0x24, 0x71,
+		0x64, 0xd4, 0xa1, 0x45, 0xb3, 0x0e, 0x85, 0xf6, 0xd3, 0x7a, 0xce, 0x0d, 0x49, 0xeb, 0x31, 0x83,
+		0x1e, 0xde, 0x6f, 0xb4, 0x4c, 0x69, 0xba, 0x6b, 0x84, 0xe9, 0x8a, 0xbd, 0xf6, 0xad, 0x19, 0x24,
+		0xd6, 0x8c, 0xa4, 0x97, 0x4e, 0xfc, 0x39, 0x8c, 0x7f, 0xd8, 0xb1, 0x19, 0xa5, 0x99, 0x42, 0x33,
+		0x92, 0xb9, 0x7d, 0xd3, 0x1a, 0x7a, 0xf4, 0xcb, 0x2d, 0x38, 0x61, 0x10, 0xb8, 0x4e, 0x6a, 0xcc,
+		0xde, 0x67, 0x3f, 0xb0, 0x95, 0x19, 0x59, 0x83, 0xfc, 0xc4, 0x66, 0xbf, 0x5b, 0xe9, 0xa7, 0x5e,
+		0x62, 0x50, 0xf2, 0xe1, 0x59, 0xf8, 0xc3, 0x8d, 0x7d, 0x2f, 0x70, 0x4d, 0xdc, 0x11, 0x31, 0x8b,
+		0x80, 0x65, 0xb3, 0x7c, 0x6b, 0x4a, 0xe1, 0xa6, 0x41, 0x69, 0xda, 0x63, 0xdb, 0x39, 0x32, 0xa5,
+		0xfc, 0xf3, 0xa0, 0x1e, 0xce, 0x89, 0xe7, 0x5c, 0x1a, 0x22, 0x0f, 0xdd, 0x87, 0xd8, 0x33, 0x24,
+		0xc6, 0x9c, 0xa4, 0xb6, 0xf3, 0xfd, 0x32, 0xbc, 0x48, 0x0d, 0x49, 0x9e, 0x8a, 0xc2, 0xa0, 0xde,
+		0x6a, 0x99, 0x53, 0xf5, 0xe4, 0x8f, 0xc0, 0x31, 0xac, 0xee, 0x49, 0x56, 0xf1, 0xa2, 0x6e, 0x4a,
+		0xce, 0xcf, 0xa1, 0xe3, 0x45, 0xa6, 0x64, 0xa9, 0x66, 0x87, 0x86, 0x8d, 0x98, 0xd8, 0xc8, 0x77,
+		0xcc, 0x39, 0x2e, 0xfa, 0x57, 0x1a, 0x0d, 0x2b, 0xa5, 0x19, 0x53, 0x0f, 0xe9, 0xce, 0x8b, 0x22,
+		0xcf, 0x14, 0x56, 0x66, 0x50, 0xff, 0xc8, 0x71, 0x4b, 0x69, 0x23, 0xf2, 0xeb, 0x6d, 0xdb, 0x37,
+		0x65, 0x53, 0xf7, 0x8b, 0x79, 0xad, 0x9a, 0x33, 0xd1, 0xed, 0x57, 0xee, 0x88, 0x7c, 0xf7, 0x67,
+		0x18, 0x9b, 0xe8, 0x03, 0x99, 0xd4, 0xa1, 0x65, 0x50, 0x0d, 0xb6, 0x61, 0x46, 0xf1, 0xed, 0xb3,
+		0x1f, 0x97, 0x0f, 0x91, 0x8c, 0x5b, 0x2c, 0x74, 0xc7, 0x55, 0x85, 0x77, 0xdc, 0xc7, 0x8a, 0xda,
+		0x27, 0xe6, 0xcc, 0xb4, 0xb5, 0x1b, 0x04, 0x61, 0x6a, 0xa7, 0x5e, 0x18, 0x58, 0x1f, 0x73, 0xcc,
+		0xb1, 0x95, 0x38, 0x77, 0x6e, 0xd7, 0x8e, 0xec, 0xf4, 0xce, 0xfa, 0x48, 0xd6, 0x7a, 0x18, 0xb9,
+		0x19, 0xd5, 0xed, 0x78, 0xb7, 0x55, 0x6f, 0x54, 0x05, 0x34, 0x59, 0x7f, 0xed, 0xc7, 0xf5, 0x24,
+		0xb5, 0xd3, 0x39, 0xd3, 0xff, 0x58, 0x91, 0x78, 0x0d, 0x2b, 0xe9, 0xdd, 0x3c, 0x7d, 0xe1, 0xdc,
+		0xb7, 0x78, 0x22, 0x92, 0xcf, 0x6e, 0x9b, 0x33, 0x4d, 0xbf, 0x7b, 0x41, 0xdb, 0xfa, 0x48, 0xf5,
+		0x39, 0x1f, 0xdb, 0xeb, 0x4f, 0x85, 0xf5, 0x91, 0x6a, 0x73, 0x3e, 0x78, 0x16, 0xbb, 0x1d, 0xef,
+		0x67, 0xbe, 0x29, 0x1f, 0x3d, 0x70, 0xe8, 0x54, 0xbd, 0x8e, 0x35, 0x1f, 0x39, 0xd6, 0x45, 0xd8,
+		0x8b, 0x1d, 0x37, 0xd7, 0xe0, 0x83, 0x97, 0x73, 0x1f, 0x7e, 0x84, 0x71, 0x7b, 0xa0, 0x8d, 0xfa,
+		0xcf, 0xb5, 0x96, 0xef, 0xc6, 0xdf, 0xec, 0x64, 0x37, 0xbe, 0xed, 0x75, 0xdd, 0x20, 0xb5, 0x3e,
+		0x52, 0x1a, 0xf7, 0xdc, 0x9c, 0x37, 0x4e, 0xdc, 0x35, 0x7c, 0x2d, 0xcd, 0x62, 0xbe, 0xef, 0xc5,
+		0x39, 0xe5, 0x7b, 0x42, 0x2a, 0xf2, 0xcf, 0xe0, 0x6b, 0x32, 0x95, 0x77, 0x12, 0xf3, 0x89, 0x96,
+		0xb0, 0x88, 0xc9, 0x88, 0x9a, 0xb4, 0xc8, 0xc9, 0x8a, 0x9e, 0xb2, 0x08, 0x2a, 0x8b, 0xa2, 0x8a,
+		0x48, 0x0a, 0x6a, 0xf2, 0x9c, 0xeb, 0x95, 0x57, 0x54, 0x47, 0x97, 0xe5, 0x8c, 0x24, 0x42, 0x70,
+		0xce, 0x9f, 0x52, 0x62, 0xfa, 0xf7, 0x0b, 0xce, 0x97, 0x98, 0xd8, 0x4a, 0x8b, 0xaf, 0x8a, 0x18,
+		0x2b, 0x8b, 0xb3, 0xaa, 0x58, 0xb3, 0x89, 0x37, 0x9b, 0x98, 0x73, 0x88, 0xbb, 0x98, 0xd8, 0x0b,
+		0x8a, 0xbf, 0x34, 0x0c, 0x46, 0x97, 0xd5, 0x76, 0x13, 0x27, 0xf6, 0xa2, 0xdc, 0x94, 0xe6, 0x4d,
+		0x89, 0x99, 0x1c, 0x4c, 0x72, 0xa6, 0x87, 0x40, 0xa9, 0x49, 0xde, 0x2e, 0x0b, 0x18, 0x0e, 0xe0,
+		0xb0, 0x01, 0x88, 0x0b, 0x48, 0xec, 0x80, 0x62, 0x07, 0x16, 0x27, 0xc0, 0xe4, 0x80, 0x26, 0x09,
+		0xb8, 0xd1, 0x65, 0xf5, 0xbd, 0x24, 0x16, 0x69, 0x49, 0xd2, 0x38, 0xcb, 0x56, 0x54, 0x10, 0x97,
+		0x91, 0x91, 0xd9, 0xae, 0x14, 0x33, 0x6f, 0x12, 0x73, 0x66, 0xb9, 0x41, 0xb6, 0xad, 0xdd, 0x56,
+		0xd7, 0x35, 0xa3, 0x81, 0x24, 0xd7, 0x6d, 0xdf, 0xed, 0xd8, 0x3d, 0xbf, 0x2f, 0x6f, 0x99, 0xf8,
+		0x42, 0x5d, 0x41, 0x5d, 0x41, 0x5d, 0x89, 0x48, 0xcb, 0x4d, 0x18, 0xfa, 0xae, 0x1d, 0x70, 0xe8,
+		0xab, 0x7a, 0x89, 0xf5, 0x95, 0x17, 0xb4, 0xdd, 0x9f, 0xea, 0xda, 0x6a, 0x30, 0x8c, 0xba, 0xae,
+		0xaa, 0x41, 0x51, 0x41, 0x51, 0x41, 0x51, 0x89, 0x48, 0x4b, 0xcf, 0x0b, 0xd2, 0x8d, 0x06, 0x83,
+		0x9e, 0xda, 0x52, 0x18, 0xe2, 0xdc, 0x0e, 0x6e, 0xb3, 0xa7, 0xf9, 0xaa, 0xb4, 0x9c, 0x6a, 0xe2,
+		0x4a, 0x44, 0x64, 0x1d, 0x7b, 0x81, 0xf5, 0x91, 0x61, 0x20, 0x06, 0xa5, 0x32, 0x7d, 0x59, 0x7f,
+		0xda, 0x7e, 0xcf, 0x65, 0x1c, 0xef, 0x73, 0x6c, 0x3b, 0x99, 0x2f, 0xba, 0x9f, 0x25, 0xfb, 0x25,
+		0xd9, 0xc0, 0xca, 0xe3, 0x3e, 0xae, 0x31, 0x2c, 0x81, 0xfd, 0xb3, 0xf4, 0x4b, 0xd0, 0x6c, 0xec,
+		0x34, 0x77, 0x36, 0xb7, 0x1a, 0x3b, 0xad, 0x12, 0xaf, 0x45, 0x65, 0x31, 0x77, 0x5f, 0x15, 0x45,
+		0x57, 0xb4, 0x46, 0x8c, 0x04, 0xb7, 0xb2, 0x38, 0xb7, 0xb6, 0x26, 0xb7, 0x92, 0x9e, 0xfd, 0xb6,
+		0x3e, 0x18, 0xc1, 0xaa, 0xe8, 0x99, 0x26, 0x81, 0x29, 0x92, 0xe4, 0x7d, 0x4a, 0x7c, 0x4f, 0x12,
+		0xcb, 0x08, 0x12, 0x23, 0x48, 0xac, 0x99, 0x53, 0x3d, 0x25, 0x71, 0xbb, 0x76, 0x27, 0x76, 0xa5,
+		0xd6, 0x7b, 0xe4, 0xec, 0x49, 0xb0, 0x28, 0xeb, 0x6c, 0xa8, 0x65, 0x3e, 0x7c, 0x18, 0x6a, 0x88,
+		0xf5, 0x01, 0xbe, 0x4a, 0xa0, 0x27, 0x06, 0xfb, 0xf4, 0xd2, 0x7a, 0x62, 0x70, 0x7b, 0xc1, 0x9b,
+		0x49, 0x0d, 0xe8, 0x09, 0xe8, 0x09, 0x1d, 0x9b, 0x49, 0x76, 0xbb, 0xeb, 0x05, 0xd5, 0x4c, 0xa6,
+		0x7b, 0x89, 0x7a, 0xcc, 0xe4, 0xd9, 0x68, 0x08, 0x7b, 0x20, 0xec, 0x51, 0x28, 0xc4, 0xe4, 0xa0,
+		0x26, 0x09, 0x39, 0x75, 0x13, 0xfd, 0x42, 0x5a, 0xdc, 0xa0, 0xd7, 0x75, 0x63, 0x5b, 0x61, 0x2f,
+		0x76, 0x12, 0x40, 0xf5, 0xa6, 0xc2, 0x18, 0x07, 0x41, 0xaf, 0x9b, 0xbd, 0xd4, 0x63, 0x89, 0xe3,
+		0xbc, 0xfd, 0x6a, 0x21, 0x6e, 0xcc, 0xa0, 0xb6, 0xc6, 0x23, 0xa9, 0xa9, 0xac, 0x3a, 0x54, 0x16,
+		0x54, 0x96, 0x09, 0x2a, 0x4b, 0x96, 0x2d, 0x3c, 0x41, 0xcf, 0x8e, 0x63, 0xcf, 0x8d, 0xab, 0x69,
+		0x6c, 0x07, 0x89, 0x97, 0x29, 0xac, 0x44, 0x7d, 0xc1, 0x9f, 0x0e, 0xc0, 0xbc, 0x1c, 0x5c, 0x71,
+		0xa5, 0x78, 0x42, 0x6e, 0xca, 0x40, 0xe5, 0x04, 0x2c, 0x3b, 0x70, 0xb9, 0x01, 0xac, 0x0d, 0xc8,
+		0xda, 0x00, 0xad, 0x03, 0xd8, 0x6a, 0x00, 0x57, 0x04, 0x3a, 0x1f, 0x47, 0x99, 0x65, 0x32, 0x37,
+		0x9b, 0x1c, 0x12, 0x37, 0x84, 0xe7, 0x36, 0xc3, 0x50, 0x3c, 0xbb, 0x36, 0xa3, 0x8b, 0x07, 0x01,
+		0xc4, 0xbd, 0x8b, 0xc3, 0xac, 0xd7, 0x66, 0x6e, 0x29, 0x70, 0x8f, 0xab, 0x61, 0x47, 0x81, 0x09,
+		0x21, 0xda, 0x76, 0x7b, 0x8a, 0x5a, 0xaa, 0xfa, 0x76, 0xb3, 0xb9, 0xb9, 0xd5, 0x6c, 0xd6, 0xb6,
+		0x36, 0xb6, 0x6a, 0x3b, 0xad, 0x56, 0x7d, 0xb3, 0xde, 0x32, 0x68, 0xf5, 0x2a, 0xe5, 0x18, 0xe5,
+		0x6a, 0x41, 0xfb, 0x53, 0x0a, 0xd2, 0x6b, 0x79, 0x41, 0xf5, 0x26, 0x0e, 0xed, 0xb6, 0x63, 0x27,
+		0x69, 0x35, 0xfa, 0x9e, 0x32, 0x32, 0xb1, 0x97, 0x43, 0x83, 0x87, 0xcd, 0x9d, 0x34, 0xf0, 0x30,
+		0xf0, 0x30, 0x22, 0xf0, 0x30, 0xf0, 0x30, 0x36, 0xe3, 0x0e, 0x1e, 0x46, 0x04, 0x1e, 0x56, 0xc4,
+		0xea, 0x81, 0x87, 0x49, 0x2f, 0x8b, 0x17, 0x54, 0xdb, 0x5e, 0xe2, 0xd8, 0x71, 0x9b, 0x97, 0x81,
+		0x8d, 0x07, 0x05, 0xf7, 0x9a, 0x3b, 0x5d, 0xe0, 0x5e, 0xe0, 0x5e, 0x44, 0xe0, 0x5e, 0xe0, 0x5e,
+		0x6c, 0x06, 0x1d, 0xdc, 0x8b, 0x08, 0xdc, 0xab, 0x88, 0xd5, 0x03, 0xf7, 0x92, 0x5e, 0x16, 0x2f,
+		0xa8, 0xba, 0x71, 0x1c, 0xc6, 0xbc, 0xcc, 0x6b, 0x38, 0x24, 0x78, 0xd7, 0xdc, 0xc9, 0x02, 0xef,
+		0x02, 0xef, 0x22, 0x02, 0xef, 0x02, 0xef, 0x62, 0x33, 0xe6, 0xe0, 0x5d, 0x44, 0xe0, 0x5d, 0x45,
+		0xac, 0x1e, 0x78, 0x97, 0xf4, 0xb2, 0x78, 0x41, 0xb5, 0xe3, 0x24, 0x3a, 0xb8, 0xd7, 0xc4, 0xb0,
+		0xe0, 0x5f, 0x73, 0x27, 0x0c, 0xfc, 0x0b, 0xfc, 0x8b, 0x08, 0xfc, 0x0b, 0xfc, 0x8b, 0xcd, 0xa8,
+		0x83, 0x7f, 0x11, 0x81, 0x7f, 0x15, 0xb1, 0x7a, 0xe0, 0x5f, 0xd2, 0xcb, 0xe2, 0x05, 0xd5, 0x6e,
+		0x56, 0x0d, 0x5e, 0x4f, 0xee, 0xd7, 0xd4, 0xd0, 0xe0, 0x61, 0x73, 0x27, 0x0d, 0x3c, 0x0c, 0x3c,
+		0x8c, 0x08, 0x3c, 0x0c, 0x3c, 0x8c, 0xcd, 0xb8, 0x83, 0x87, 0x11, 0x81, 0x87, 0x15, 0xb1, 0x7a,
+		0xe0, 0x61, 0xd2, 0xcb, 0xe2, 0x05, 0xd5, 0xd0, 0x49, 0x5d, 0x66, 0xfe, 0x35, 0x1c, 0x12, 0xbc,
+		0x6b, 0xee, 0x64, 0x81, 0x77, 0x81, 0x77, 0x11, 0x81, 0x77, 0x81, 0x77, 0xb1, 0x19, 0x73, 0xf0,
+		0x2e, 0x22, 0xf0, 0xae, 0x22, 0x56, 0x0f, 0xbc, 0x4b, 0x7a, 0x59, 0xbc, 0x80, 0x3f, 0xea, 0x85,
+		0x58, 0x57, 0xbe, 0xa9, 0x02, 0xe7, 0x02, 0xe7, 0x22, 0x02, 0xe7, 0x02, 0xe7, 0x62, 0x33, 0xe4,
+		0xe0, 0x5c, 0x44, 0xe0, 0x5c, 0x45, 0xac, 0x1e, 0x38, 0x97, 0xf4, 0xb2, 0x78, 0x41, 0xb5, 0x17,
+		0x68, 0xda, 0x71, 0x7c, 0x36, 0x30, 0x38, 0xd8, 0xdc, 0x29, 0x03, 0x07, 0x03, 0x07, 0x23, 0x02,
+		0x07, 0x03, 0x07, 0x63, 0x33, 0xec, 0xe0, 0x60, 0x44, 0xe0, 0x60, 0x45, 0xac, 0x1e, 0x38, 0x98,
+		0xf4, 0xb2, 0xf4, 0xa9, 0xd2, 0xf7, 0x20, 0xfc, 0x11, 0x54, 0xa3, 0x38, 0x4c, 0x43, 0x6e, 0x16,
+		0xf6, 0x6c, 0x68, 0xf0, 0xb0, 0xb9, 0x93, 0x06, 0x1e, 0x06, 0x1e, 0x46, 0x04, 0x1e, 0x06, 0x1e,
+		0xc6, 0x66, 0xdc, 0xc1, 0xc3, 0x88, 0xc0, 0xc3, 0x8a, 0x58, 0x3d, 0xf0, 0x30, 0xe9, 0x65, 0xf1,
+		0xb3, 0x60, 0x95, 0xe3, 0xbb, 0x76, 0xcc, 0x47, 0xc0, 0x26, 0xc6, 0x04, 0xf3, 0x9a, 0x3b, 0x5b,
+		0x60, 0x5e, 0x60, 0x5e, 0xc5, 0x31, 0xaf, 0xd4, 0xeb, 0xba, 0xa9, 0xe7, 0x7c, 0x4f, 0xc0, 0xbd,
+		0x88, 0xc0, 0xbd, 0xca, 0x60, 0xbd, 0xc1, 0xbd, 0xc0, 0xbd, 0x56, 0x92, 0x7b, 0x85, 0xbd, 0x54,
+		0x5b, 0xe1, 0xfb, 0x57, 0xc6, 0x06, 0x17, 0x9b, 0x3f, 0x6b, 0xe0, 0x62, 0xe0, 0x62, 0x85, 0x71,
+		0x31, 0x44, 0xc1, 0x88, 0xc0, 0xc4, 0x4a, 0x64, 0xcb, 0xc1, 0xc4, 0xc0, 0xc4, 0x56, 0x96, 0x89,
+		0xf1, 0x97, 0xbe, 0x7f, 0x36, 0x2a, 0xd8, 0xd7, 0xfc, 0xf9, 0x02, 0xfb, 0x02, 0xfb, 0x22, 0x02,
+		0xfb, 0x02, 0xfb, 0xe2, 0x32, 0xe9, 0x60, 0x5f, 0x44, 0x60, 0x5f, 0x45, 0xac, 0x1e, 0xd8, 0x97,
+		0xf4, 0xb2, 0x64, 0x3c, 0x89, 0xbb, 0x00, 0xeb, 0xc4, 0x98, 0x60, 0x5e, 0xf3, 0x67, 0x0b, 0xcc,
+		0x0b, 0xcc, 0x8b, 0x08, 0xcc, 0x0b, 0xcc, 0x8b, 0xcb, 0x9c, 0x83, 0x79, 0x11, 0x81, 0x79, 0x15,
+		0xb1, 0x7a, 0x60, 0x5e, 0xd2, 0xcb, 0x92, 0xb1, 0x24, 0x5d, 0xe5, 0x57, 0x5f, 0x19, 0x1b, 0x4c,
+		0x6c, 0xfe, 0xac, 0x81, 0x89, 0x81, 0x89, 0x11, 0x81, 0x89, 0x81, 0x89, 0x71, 0x99, 0x77, 0x30,
+		0x31, 0x22, 0x30, 0xb1, 0x22, 0x56, 0x0f, 0x4c, 0x4c, 0x7a, 0x59, 0x32, 0xb6, 0xc4, 0x5d, 0x80,
+		0x75, 0x62, 0x4c, 0x30, 0xaf, 0xf9, 0xb3, 0x05, 0xe6, 0x05, 0xe6, 0x45, 0x04, 0xe6, 0x05, 0xe6,
+		0xc5, 0x65, 0xce, 0xc1, 0xbc, 0x88, 0xc0, 0xbc, 0x8a, 0x58, 0x3d, 0x30, 0x2f, 0xe9, 0x65, 0x09,
+		0x7b, 0xc3, 0xe8, 0x14, 0x2b, 0xef, 0x42, 0xbc, 0x2b, 0xe7, 0x5c, 0x81, 0x75, 0x81, 0x75, 0x11,
+		0x81, 0x75, 0x81, 0x75, 0x71, 0x99, 0x72, 0xb0, 0x2e, 0x22, 0xb0, 0xae, 0x22, 0x56, 0x0f, 0xac,
+		0x4b, 0x7a, 0x59, 0x32, 0x8e, 0xa4, 0xa7, 0x08, 0xeb, 0x8b, 0x91, 0xc1, 0xc2, 0xe6, 0xcf, 0x19,
+		0x58, 0x18, 0x58, 0x18, 0x11, 0x58, 0x18, 0x58, 0x18, 0x97, 0x69, 0x07, 0x0b, 0x23, 0x02, 0x0b,
+		0x2b, 0x62, 0xf5, 0x56, 0x9d, 0x85, 0x55, 0x0a, 0x94, 0x79, 0x6b, 0x37, 0x08, 0xc2, 0xd4, 0xce,
+		0x96, 0x52, 0x49, 0xcc, 0xad, 0xc4, 0xb9, 0x73, 0xbb, 0x76, 0x64, 0xa7, 0x77, 0xd6, 0x47, 0xb2,
+		0xd6, 0xc3, 0xc8, 0x0d, 0x9c, 0x3e, 0x43, 0xaa, 0x7a, 0x99, 0x6d, 0xe8, 0xd8, 0x8e, 0x9b, 0xac,
+		0xbf, 0xf6, 0xe3, 0x7a, 0xd2, 0xbb, 0x99, 0xf8, 0xfb, 0xe4, 0x6f, 0xeb, 0x49, 0x6a, 0xa7, 0xee,
+		0xfa, 0xd0, 0xbc, 0xa8, 0x10, 0x3f, 0x2b, 0x49, 0xe3, 0x9e, 0x93, 0x06, 0x43, 0x83, 0x75, 0x38,
+		0xfa, 0x86, 0xeb, 0x8b, 0x89, 0xaf, 0xbb, 0xde, 0x1b, 0x7d, 0x51, 0xa5, 0x98, 0x75, 0x93, 0x58,
+		0x33, 0xab, 0xed, 0x26, 0x4e, 0xec, 0x45, 0x4a, 0x0b, 0x36, 0x36, 0xdc, 0x93, 0x83, 0xad, 0x55,
+		0x16, 0xa0, 0xca, 0x94, 0x59, 0x34, 0x07, 0x7b, 0x66, 0x63, 0xcd, 0x5c, 0x6c, 0x99, 0x9d, 0x25,
+		0xb3, 0xb3, 0x63, 0x4e, 0x56, 0x5c, 0xac, 0xbe, 0x53, 0x66, 0xbf, 0x63, 0x69, 0x49, 0xd2, 0xd8,
+		0x0b, 0x6e, 0x55, 0xc4, 0x65, 0x88, 0x9d, 0xfa, 0x76, 0x89, 0xf5, 0x8d, 0x1b, 0xd8, 0x37, 0xbe,
+		0xdb, 0x56, 0xd7, 0x35, 0xa3, 0x81, 0x24, 0xd7, 0x6d, 0xdf, 0xed, 0xd8, 0x3d, 0xbf, 0x2f, 0x6f,
+		0x99, 0xf8, 0x42, 0x5d, 0x41, 0x5d, 0x41, 0x5d, 0x89, 0x48, 0xcb, 0x4d, 0x18, 0xfa, 0xae, 0x1d,
+		0x70, 0xe8, 0xab, 0x7a, 0x89, 0xf5, 0x95, 0xd7, 0xf1, 0x82, 0xb6, 0xfb, 0x53, 0x5d, 0x5f, 0x8d,
+		0x06, 0x82, 0xa2, 0x81, 0xa2, 0x81, 0xa2, 0x11, 0x90, 0x96, 0x9e, 0x17, 0xa4, 0x1b, 0x0d, 0x06,
+		0x3d, 0xb3, 0xb5, 0x56, 0x59, 0x70, 0xe8, 0xef, 0x57, 0xa5, 0x54, 0xa1, 0x3e, 0xe6, 0xb8, 0x11,
+		0x77, 0x68, 0x4f, 0x47, 0x50, 0x88, 0x21, 0x94, 0xc7, 0x1a, 0xc2, 0xd3, 0xb5, 0x04, 0xcd, 0xc6,
+		0x4e, 0x73, 0x67, 0x73, 0xab, 0xb1, 0xd3, 0x2a, 0xf1, 0x5a, 0x2c, 0x28, 0x20, 0x76, 0x55, 0x66,
+		0xba, 0xc1, 0x44, 0x36, 0x54, 0xa8, 0xc6, 0x84, 0x6b, 0x54, 0x03, 0x5d, 0x01, 0x5d, 0x21, 0x02,
+		0x5d, 0x21, 0x02, 0x5d, 0x21, 0x02, 0x5d, 0x29, 0xda, 0x44, 0x82, 0xae, 0x94, 0x67, 0x2d, 0x40,
+		0x57, 0xa6, 0xaf, 0x61, 0x27, 0x9f, 0xbb, 0xa1, 0xb6, 0x51, 0x24, 0x2d, 0x93, 0x83, 0x81, 0x76,
+		0x80, 0x76, 0x10, 0x81, 0x76, 0x14, 0xdc, 0xb7, 0x87, 0x21, 0x5b, 0x0a, 0xdc, 0x43, 0xc4, 0xf0,
+		0x81, 0x7b, 0x2c, 0x7c, 0x09, 0xf4, 0x65, 0x37, 0x81, 0x85, 0x14, 0xc4, 0x42, 0xc2, 0x5b, 0xcf,
+		0xb1, 0x7d, 0x06, 0x06, 0x32, 0x1c, 0x08, 0xec, 0x03, 0xec, 0x83, 0x08, 0xec, 0x83, 0x08, 0x9b,
+		0xc1, 0x13, 0x97, 0x35, 0xcc, 0xf7, 0x53, 0xd4, 0x32, 0xfd, 0x51, 0xa0, 0x62, 0xa0, 0x62, 0xa0,
+		0x62, 0x88, 0x90, 0x1e, 0x37, 0x79, 0x59, 0x61, 0xe4, 0xc6, 0xd5, 0x2c, 0x4d, 0xb9, 0x97, 0xa8,
+		0x2b, 0x9a, 0xc9, 0xc1, 0xa0, 0x6f, 0xa0, 0x6f, 0xa0, 0x6f, 0x04, 0xa4, 0xc5, 0x0d, 0x7a, 0x5d,
+		0x37, 0xb6, 0x15, 0x72, 0xd9, 0x9f, 0x29, 0x9d, 0xa6, 0xc2, 0x18, 0x07, 0x41, 0xaf, 0x9b, 0xbd,
+		0xd4, 0x63, 0x51, 0x8a, 0xab, 0xa2, 0x71, 0xa9, 0x54, 0x4f, 0x88, 0x68, 0x3c, 0x19, 0x62, 0x55,
+		0xf4, 0xcc, 0x92, 0xc0, 0x0c, 0x59, 0xf7, 0xbe, 0x2d, 0x3e, 0x2f, 0x63, 0xa1, 0xed, 0xdf, 0xbd,
+		0x56, 0x91, 0x92, 0xd0, 0xb5, 0x4a, 0x21, 0xaa, 0x5d, 0x45, 0xa5, 0x4f, 0xaa, 0x72, 0x89, 0x57,
+		0x25, 0x06, 0x25, 0xce, 0xa6, 0xbc, 0xd9, 0x94, 0xf6, 0xb4, 0xb2, 0xee, 0x4f, 0x4c, 0xc9, 0x30,
+		0xbf, 0xef, 0xc5, 0x72, 0x0b, 0xee, 0x8c, 0xa4, 0x4c, 0x91, 0x0a, 0x0d, 0xc7, 0x51, 0x63, 0x41,
+		0xf5, 0x25, 0x61, 0x41, 0x92, 0xd0, 0xe1, 0x82, 0x10, 0x3b, 0x94, 0xd8, 0x21, 0xc5, 0x0b, 0x2d,
+		0x39, 0x88, 0x49, 0x42, 0x4d, 0x19, 0x72, 0xa3, 0xab, 0x6f, 0x4b, 0xaa, 0x5e, 0x9b, 0xaf, 0xf0,
+		0xc6, 0x68, 0x40, 0xd4, 0xdb, 0x28, 0x00, 0xa4, 0xdc, 0x60, 0xd5, 0x06, 0x5a, 0x6d, 0xe0, 0xd5,
+		0x03, 0x62, 0x35, 0x30, 0x2b, 0x82, 0x9a, 0xcf, 0xcd, 0x79, 0x21, 0x71, 0xbd, 0x40, 0xcd, 0xd1,
+		0x99, 0x86, 0x67, 0x7d, 0x87, 0x61, 0xac, 0xe1, 0x6b, 0x96, 0xae, 0xde, 0x06, 0xaf, 0x46, 0x7b,
+		0x6d, 0xfa, 0x36, 0x19, 0x87, 0xe4, 0x2d, 0x5b, 0xc2, 0x3f, 0x9d, 0x5a, 0x36, 0xec, 0x67, 0x4d,
+		0x2c, 0x73, 0x8d, 0x8c, 0x97, 0xbb, 0xc9, 0x9a, 0xc6, 0xd7, 0x58, 0x20, 0x83, 0x49, 0x29, 0x69,
+		0x4f, 0x00, 0x58, 0xd4, 0x92, 0x36, 0x6b, 0x3b, 0x4d, 0x83, 0x57, 0xb5, 0x52, 0xce, 0xd1, 0xae,
+		0x2a, 0x25, 0x92, 0x59, 0x0d, 0xb6, 0xe1, 0xbf, 0x5e, 0xf0, 0x5f, 0x3d, 0xb6, 0xa1, 0xbe, 0xcd,
+		0x38, 0xe6, 0x99, 0x9d, 0xa6, 0x6e, 0x1c, 0xb0, 0x9b, 0x07, 0xeb, 0x3f, 0xef, 0x9a, 0xb5, 0x9d,
+		0xaf, 0xb5, 0x6a, 0xf3, 0xea, 0xef, 0x66, 0xed, 0x6b, 0xad, 0xba, 0x7d, 0xf5, 0xb5, 0x56, 0xdd,
+		0xb9, 0xfa, 0xfb, 0x6b, 0xbd, 0xba, 0x31, 0xf8, 0xf1, 0xd7, 0xc6, 0x63, 0xf6, 0xdb, 0xce, 0xf0,
+		0xb7, 0xfa, 0x5a, 0x63, 0xf8, 0xfb, 0xfb, 0x6f, 0xdf, 0x3e, 0xbc, 0x53, 0xb8, 0xfd, 0xef, 0x6f,
+		0xdf, 0xfe, 0xe7, 0xfd, 0x3f, 0xac, 0xb2, 0x89, 0x2a, 0x2a, 0x08, 0x15, 0x11, 0x1f, 0xd6, 0x1b,
+		0x27, 0xce, 0x18, 0xdf, 0xfa, 0x60, 0x98, 0x32, 0x57, 0xf5, 0x19, 0x84, 0xb3, 0x95, 0xa3, 0x66,
+		0x83, 0x61, 0x16, 0x1c, 0x34, 0x6b, 0x20, 0x68, 0xa6, 0xd3, 0xff, 0x46, 0xd0, 0x8c, 0x08, 0x41,
+		0x33, 0x51, 0x50, 0x22, 0x68, 0xb6, 0x50, 0xd0, 0x6a, 0x03, 0xaf, 0x1e, 0x10, 0xab, 0x81, 0x59,
+		0x11, 0xd4, 0xd3, 0xd1, 0x24, 0x04, 0xcd, 0x04, 0x2e, 0x04, 0xcd, 0x10, 0x34, 0x43, 0xd0, 0x4c,
+		0x9f, 0x52, 0x22, 0x42, 0xd0, 0xac, 0x8c, 0xab, 0x8a, 0xa0, 0xd9, 0xfc, 0x0b, 0x41, 0x33, 0x04,
+		0xcd, 0xb4, 0x8b, 0x2a, 0x82, 0x66, 0x4b, 0x12, 0x34, 0x93, 0xc8, 0xb0, 0x94, 0x9f, 0xca, 0x15,
+		0xcc, 0x60, 0x95, 0xcd, 0x87, 0xcc, 0x55, 0xc9, 0xfc, 0x4f, 0x61, 0xf7, 0xef, 0xb1, 0xc2, 0xfb,
+		0xc9, 0x9c, 0x6b, 0x94, 0x79, 0xda, 0xa2, 0x05, 0xb0, 0xac, 0x23, 0x2f, 0x49, 0x77, 0xd3, 0x54,
+		0x2c, 0xf4, 0x93, 0x11, 0xef, 0x03, 0xdf, 0xcd, 0x7c, 0xe4, 0xc4, 0xfa, 0x48, 0x41, 0xcf, 0xf7,
+		0xd7, 0x04, 0x6e, 0xb6, 0x7f, 0xca, 0xdf, 0x7c, 0x1a, 0xb7, 0xdd, 0xd8, 0x6d, 0x7f, 0x7a, 0x18,
+		0xde, 0xca, 0x3a, 0x81, 0x92, 0xc2, 0xad, 0x45, 0xa8, 0x05, 0xe4, 0x39, 0x97, 0x1c, 0x5b, 0x15,
+		0x1e, 0x81, 0x7c, 0xac, 0x28, 0xcc, 0xb4, 0xe8, 0x0c, 0x73, 0xcd, 0xac, 0x55, 0x91, 0x7b, 0xa5,
+		0xc7, 0x8a, 0xc0, 0x4b, 0x8e, 0xf0, 0xf7, 0xc6, 0x19, 0xc7, 0x7c, 0x70, 0x13, 0x82, 0x97, 0x10,
+		0x9c, 0xf2, 0xc1, 0x67, 0xd6, 0xfb, 0xe5, 0x5c, 0x3c, 0xf9, 0x45, 0x7b, 0x43, 0xe6, 0x67, 0xc9,
+		0xb8, 0x55, 0xc9, 0xb7, 0x6e, 0x8f, 0x95, 0x37, 0xde, 0x70, 0xde, 0x9b, 0x89, 0xbe, 0x91, 0x55,
+		0x79, 0xfd, 0xab, 0x27, 0xbe, 0xd6, 0xf2, 0x6d, 0x27, 0x7a, 0xf1, 0x65, 0x13, 0xf5, 0x80, 0x9c,
+		0x68, 0x6a, 0x36, 0x66, 0x6c, 0x2a, 0xcd, 0x8c, 0x4b, 0xbf, 0x15, 0x67, 0x9e, 0x8c, 0x1b, 0xbf,
+		0xf2, 0x55, 0x94, 0x23, 0x0e, 0x9c, 0x3b, 0xae, 0x9b, 0x3b, 0x4e, 0x3b, 0x1d, 0x77, 0xed, 0x3f,
+		0x98, 0xe0, 0x42, 0xce, 0xda, 0xc4, 0x98, 0x97, 0x4c, 0x9f, 0x2f, 0x59, 0x7e, 0xce, 0xbe, 0xde,
+		0xdc, 0x2d, 0x82, 0x3c, 0xa1, 0xff, 0x1c, 0x4b, 0x93, 0x77, 0x89, 0x84, 0x97, 0x4a, 0x78, 0xc9,
+		0xc4, 0x96, 0x4e, 0x4e, 0xab, 0xce, 0xdb, 0x97, 0xb2, 0x92, 0x87, 0x24, 0x75, 0xbb, 0xd5, 0x28,
+		0xf6, 0xc2, 0xd8, 0x4b, 0x1f, 0xe6, 0x4f, 0xc7, 0x78, 0x8b, 0x77, 0xea, 0xc6, 0x39, 0xef, 0x98,
+		0x2f, 0x7a, 0x94, 0x7b, 0x9f, 0x48, 0x64, 0x1f, 0x48, 0x40, 0x28, 0x44, 0x85, 0x43, 0x5a, 0x48,
+		0xa4, 0x85, 0x45, 0x4e, 0x68, 0xe6, 0x1b, 0x6b, 0xca, 0xc1, 0x3f, 0x72, 0xef, 0x83, 0x3c, 0xab,
+		0x69, 0x59, 0xdf, 0xcc, 0x33, 0xe1, 0xf9, 0x23, 0xf1, 0x82, 0x11, 0x76, 0x31, 0x62, 0x2e, 0x7e,
+		0x82, 0x4e, 0x2e, 0x2c, 0x2a, 0x5b, 0xc7, 0x49, 0x25, 0x86, 0xf9, 0x28, 0xe6, 0x66, 0x14, 0x3e,
+		0x15, 0x9b, 0xad, 0xd6, 0x46, 0xab, 0xc0, 0xe9, 0x60, 0xe2, 0xf4, 0x57, 0x85, 0xd0, 0x63, 0x75,
+		0xfa, 0x98, 0x69, 0x88, 0xf5, 0xfe, 0xff, 0xbc, 0x95, 0x86, 0xf4, 0x58, 0xc9, 0xf1, 0x44, 0xd6,
+		0x04, 0x5b, 0x9b, 0x4b, 0x0a, 0x26, 0x3e, 0x0b, 0x62, 0x60, 0x0e, 0x31, 0x78, 0xf2, 0x2b, 0x72,
+		0x53, 0x82, 0xbc, 0xee, 0x77, 0xce, 0x0c, 0x2f, 0x90, 0x81, 0x32, 0x93, 0x81, 0xbc, 0x19, 0x4f,
+		0xa2, 0x27, 0x71, 0xe5, 0x4e, 0xde, 0x0a, 0x26, 0x0d, 0x0a, 0xe7, 0x23, 0xc9, 0xe4, 0x1d, 0x49,
+		0x88, 0x9a, 0xac, 0xc8, 0x29, 0x8b, 0x9e, 0xb2, 0x08, 0xaa, 0x89, 0x62, 0x3e, 0x91, 0xcc, 0x29,
+		0x9a, 0xc2, 0x22, 0xfa, 0x5c, 0xe7, 0xdd, 0x4b, 0x14, 0x04, 0x7c, 0xae, 0x02, 0xef, 0x85, 0x2b,
+		0x01, 0x4e, 0xb6, 0x4e, 0xb8, 0x38, 0x3a, 0xfd, 0x4b, 0xb2, 0x14, 0x43, 0xcd, 0xb0, 0x52, 0x0c,
+		0x82, 0xa8, 0x50, 0x45, 0x07, 0x1b, 0x4a, 0xd8, 0xd0, 0xc2, 0x83, 0x1a, 0x31, 0xf4, 0x08, 0xa2,
+		0x48, 0xdc, 0xfb, 0x9b, 0xb9, 0xe2, 0xd9, 0x5b, 0x55, 0x23, 0x37, 0xf6, 0xc2, 0x76, 0x35, 0xcd,
+		0x46, 0x93, 0x58, 0x7a, 0x85, 0xb2, 0x38, 0x92, 0xe5, 0x70, 0xf4, 0x94, 0x6b, 0xe9, 0xcf, 0x45,
+		0x37, 0x6c, 0xbb, 0xf2, 0xba, 0xe6, 0x69, 0x08, 0x79, 0x65, 0xb3, 0xbb, 0x77, 0x79, 0xf8, 0xe7,
+		0x01, 0xd4, 0x0d, 0xd4, 0xcd, 0x92, 0xaa, 0x9b, 0x2c, 0x3c, 0x70, 0xef, 0xa5, 0x0f, 0x2b, 0xaf,
+		0x70, 0xa4, 0x4a, 0x90, 0xaa, 0x94, 0x1e, 0x85, 0x96, 0x80, 0x96, 0x30, 0x40, 0x4b, 0xdc, 0xd8,
+		0x89, 0xfb, 0xb4, 0x11, 0x59, 0x8d, 0xdd, 0x8e, 0x8a, 0x96, 0x90, 0xe8, 0xbd, 0x64, 0x9d, 0x8d,
+		0x83, 0x75, 0x59, 0xc1, 0xc4, 0x8f, 0x13, 0x9b, 0xa2, 0x53, 0x7f, 0x18, 0xfe, 0xde, 0xc7, 0x62,
+		0x09, 0x34, 0xca, 0x70, 0x07, 0xc8, 0x6b, 0x57, 0xbb, 0xb6, 0x23, 0xaf, 0x5a, 0x9e, 0x0f, 0x03,
+		0x1d, 0x03, 0x1d, 0xb3, 0x74, 0x3a, 0xa6, 0x6b, 0x3b, 0x55, 0xbb, 0xdd, 0x8e, 0xdd, 0x24, 0x51,
+		0x51, 0x2e, 0xdb, 0x6b, 0x95, 0xc2, 0x33, 0x8e, 0xad, 0xff, 0x64, 0x09, 0xbf, 0x76, 0xb5, 0xb3,
+		0x5b, 0xfd, 0x7c, 0xf5, 0xab, 0xf1, 0xf8, 0xee, 0xe3, 0xf3, 0xdf, 0xdf, 0xff, 0x6a, 0x3d, 0x4a,
+		0x24, 0xff, 0x5e, 0x95, 0x47, 0x7f, 0xe5, 0xde, 0xfa, 0x9e, 0xa7, 0xc1, 0x72, 0x6e, 0x85, 0x43,
+		0x87, 0x41, 0x87, 0x19, 0xa8, 0xc3, 0x72, 0x6f, 0xe5, 0x2b, 0x6c, 0xed, 0x2b, 0x6e, 0xf5, 0x4f,
+		0x5f, 0x0a, 0xe9, 0xec, 0x1c, 0x87, 0xe3, 0xb8, 0xce, 0x4e, 0x33, 0xb5, 0x7c, 0xe2, 0x3c, 0xfe,
+		0xa4, 0x70, 0x10, 0x88, 0xe5, 0x90, 0x1a, 0xf7, 0xd4, 0x4a, 0xa4, 0x1e, 0x68, 0x9d, 0xde, 0x82,
+		0x4e, 0x3b, 0x5c, 0x19, 0x91, 0x99, 0xcf, 0x9f, 0x58, 0xfe, 0x94, 0x0a, 0xf1, 0x6a, 0xf6, 0xb3,
+		0x48, 0x99, 0x96, 0xc7, 0x0a, 0xc3, 0x6b, 0x5a, 0x5d, 0xb7, 0x7b, 0xe3, 0xc6, 0x89, 0xf8, 0x26,
+		0xe9, 0xe8, 0x46, 0xcd, 0xbb, 0xa4, 0x0d, 0xec, 0x92, 0xb2, 0x52, 0x07, 0xa3, 0x77, 0x49, 0x07,
+		0x32, 0x27, 0x4f, 0x97, 0x87, 0xf7, 0xaf, 0x46, 0xb5, 0x79, 0xb0, 0x64, 0x16, 0x91, 0x17, 0x13,
+		0x7d, 0x41, 0x08, 0x48, 0x43, 0x61, 0x74, 0x09, 0x24, 0x4b, 0xcd, 0x95, 0x19, 0x99, 0x33, 0x4c,
+		0xaf, 0x01, 0x65, 0x59, 0x3a, 0xef, 0x48, 0x02, 0x88, 0x0b, 0x48, 0xec, 0x80, 0x62, 0x07, 0x16,
+		0x2f, 0xc0, 0xe4, 0x80, 0x26, 0x09, 0x38, 0x75, 0xf7, 0xf4, 0x85, 0xc4, 0xf8, 0xae, 0xdd, 0x91,
+		0x8b, 0xe1, 0x73, 0xc4, 0xf2, 0x9f, 0x40, 0x30, 0x64, 0x9d, 0x1f, 0x3e, 0x0c, 0xce, 0x2f, 0xaf,
+		0x0b, 0x1e, 0x25, 0x54, 0x5f, 0x0e, 0x54, 0xff, 0x83, 0xfa, 0x82, 0xfa, 0x2a, 0x46, 0x7d, 0x29,
+		0x57, 0xff, 0x1b, 0x65, 0x29, 0xf0, 0x95, 0xff, 0x1b, 0x8f, 0x88, 0xfa, 0x7f, 0x05, 0xc0, 0x94,
+		0x1b, 0xae, 0xda, 0x60, 0xab, 0x0d, 0xbe, 0x7a, 0x60, 0xac, 0x06, 0x67, 0x45, 0x58, 0xf3, 0xb1,
+		0x93, 0x17, 0x12, 0xc7, 0x92, 0x9a, 0xc4, 0x99, 0xaa, 0xc4, 0x94, 0xba, 0xc4, 0xb7, 0x6e, 0x0a,
+		0x6b, 0x66, 0xd9, 0xb7, 0xb7, 0xb1, 0x7b, 0x6b, 0xa7, 0xf6, 0x8d, 0xef, 0x32, 0x6a, 0xd4, 0xc9,
+		0x51, 0xa1, 0x55, 0xe7, 0xce, 0x17, 0xb4, 0x2a, 0xb4, 0x6a, 0xb1, 0x5a, 0x55, 0xbd, 0x99, 0x3c,
+		0x53, 0x53, 0xf9, 0xc5, 0xaa, 0x3f, 0x27, 0xf4, 0x7d, 0xd7, 0x49, 0xb3, 0xa6, 0xd7, 0x6c, 0xca,
+		0x6f, 0x62, 0x4c, 0xa8, 0x3e, 0xa8, 0x3e, 0xa8, 0x3e, 0xa8, 0xbe, 0x72, 0xaa, 0xbe, 0x5e, 0x90,
+		0x8a, 0x6c, 0xa4, 0xe6, 0x50, 0x7c, 0xc3, 0x11, 0x79, 0xd4, 0x5e, 0x1d, 0x6a, 0x0f, 0x6a, 0x6f,
+		0x55, 0xd5, 0x9e, 0x6a, 0x98, 0x6c, 0x74, 0x0d, 0xdc, 0x67, 0x37, 0x8e, 0xc3, 0x38, 0x61, 0x19,
+		0x90, 0x5e, 0xf8, 0xe6, 0xc3, 0xc1, 0x99, 0xd6, 0x92, 0xb7, 0x74, 0x36, 0x9b, 0x12, 0xd0, 0xa1,
+		0x0c, 0x34, 0x2a, 0x05, 0x5d, 0xca, 0x41, 0xbb, 0x92, 0xd0, 0xae, 0x2c, 0xf4, 0x2a, 0x0d, 0x1e,
+		0xe5, 0xc1, 0xa4, 0x44, 0xf8, 0x39, 0xd4, 0x2c, 0x93, 0xbf, 0xd9, 0xd4, 0x50, 0x4d, 0x7c, 0x1b,
+		0x9d, 0x26, 0x78, 0xaf, 0xa2, 0xda, 0x12, 0xd4, 0xd0, 0x69, 0x82, 0x3b, 0x89, 0x77, 0xd1, 0x4b,
+		0x5a, 0xdf, 0x6e, 0x36, 0x37, 0xb7, 0x9a, 0xcd, 0xda, 0xd6, 0xc6, 0x56, 0x6d, 0xa7, 0xd5, 0xaa,
+		0x6f, 0xd6, 0x5b, 0xe8, 0x3c, 0xc1, 0x3d, 0x5a, 0x59, 0xca, 0xf9, 0x33, 0xa0, 0x60, 0x40, 0x0d,
+		0xbd, 0xa0, 0x1a, 0x7d, 0x4f, 0x75, 0x11, 0xcf, 0xd1, 0xe8, 0x60, 0x9e, 0xca, 0xf3, 0x09, 0xe6,
+		0x49, 0x04, 0xe6, 0xc9, 0xaa, 0x01, 0x08, 0xcc, 0x13, 0xcc, 0x73, 0x6a, 0x62, 0xc1, 0x3c, 0x75,
+		0x23, 0x8e, 0x08, 0xcc, 0xb3, 0xcc, 0xab, 0x0c, 0xe6, 0xa9, 0x1b, 0x05, 0x03, 0x6e, 0x18, 0xf6,
+		0x52, 0x9d, 0xd4, 0x73, 0x3c, 0x3c, 0xb8, 0xa7, 0xf2, 0x84, 0x82, 0x7b, 0x12, 0x81, 0x7b, 0xb2,
+		0xea, 0x00, 0x02, 0xf7, 0x04, 0xf7, 0x9c, 0x9a, 0x58, 0x70, 0x4f, 0xdd, 0x88, 0x23, 0x02, 0xf7,
+		0x2c, 0xf3, 0x2a, 0x83, 0x7b, 0xea, 0x46, 0xc1, 0x80, 0x1c, 0xc6, 0x3f, 0xf5, 0x6e, 0xb8, 0x3f,
+		0x8d, 0x0f, 0xf6, 0xa9, 0x3c, 0xa3, 0x60, 0x9f, 0x44, 0x60, 0x9f, 0xac, 0x5a, 0x80, 0xc0, 0x3e,
+		0xc1, 0x3e, 0xa7, 0x26, 0x16, 0xec, 0x53, 0x37, 0xe2, 0x88, 0xc0, 0x3e, 0xcb, 0xbc, 0xca, 0x60,
+		0x9f, 0xba, 0x51, 0x30, 0x60, 0x87, 0xa9, 0x66, 0xf6, 0x99, 0x82, 0x7d, 0xf2, 0xcd, 0x28, 0xd8,
+		0x27, 0x11, 0xd8, 0x27, 0xab, 0x16, 0x20, 0xb0, 0x4f, 0xb0, 0xcf, 0xa9, 0x89, 0x05, 0xfb, 0xd4,
+		0x8d, 0x38, 0x22, 0xb0, 0xcf, 0x32, 0xaf, 0x32, 0xd8, 0xa7, 0x6e, 0x14, 0x0c, 0xd8, 0x61, 0x2f,
+		0xf8, 0x1e, 0x84, 0x3f, 0x02, 0xbd, 0x14, 0x74, 0xea, 0x4b, 0xc0, 0x43, 0x95, 0xa7, 0x15, 0x3c,
+		0x94, 0x08, 0x3c, 0x94, 0x55, 0x1f, 0x10, 0x78, 0x28, 0x78, 0xe8, 0xd4, 0xc4, 0x82, 0x87, 0xea,
+		0x46, 0x1c, 0x11, 0x78, 0x68, 0x99, 0x57, 0x19, 0x3c, 0x54, 0xe3, 0x08, 0x8f, 0xaa, 0x65, 0x35,
+		0xe5, 0xba, 0x27, 0xf0, 0x77, 0x55, 0x18, 0xb6, 0x2a, 0x18, 0xfe, 0x3b, 0x2c, 0x8b, 0xcb, 0x54,
+		0x5e, 0x63, 0xf0, 0x64, 0x69, 0xdc, 0x73, 0xd2, 0x61, 0x9f, 0x4b, 0xeb, 0xc8, 0x76, 0xa2, 0xeb,
+		0xc3, 0xd1, 0xb7, 0x5f, 0x1f, 0xf7, 0xbf, 0xf5, 0x7a, 0x6f, 0xf4, 0x7d, 0x06, 0x16, 0x37, 0x69,
+		0x7b, 0x49, 0x1a, 0x7b, 0x37, 0x3d, 0xde, 0xca, 0x4e, 0xcf, 0x46, 0x45, 0x6d, 0xa7, 0xb9, 0xf3,
+		0x85, 0x22, 0x27, 0x28, 0x72, 0x52, 0x88, 0x77, 0x80, 0xda, 0x4e, 0xcf, 0x2e, 0x86, 0x1e, 0x0b,
+		0x2f, 0x66, 0x56, 0xb5, 0xd7, 0x02, 0x14, 0x1f, 0x14, 0x1f, 0x14, 0x9f, 0x3e, 0xc5, 0xc7, 0xd1,
+		0x9a, 0x59, 0x47, 0x7b, 0x87, 0x27, 0x9c, 0x69, 0x6f, 0xdd, 0xcc, 0xb7, 0xc6, 0x2a, 0xba, 0x37,
+		0x8c, 0xdc, 0xb8, 0xfa, 0xdd, 0x65, 0xac, 0x4f, 0x3f, 0x1e, 0x11, 0x9a, 0x77, 0xfe, 0x5c, 0x41,
+		0xf3, 0x42, 0xf3, 0x16, 0xaa, 0x79, 0xa5, 0x9b, 0xbd, 0x32, 0x36, 0x7f, 0xd5, 0x1c, 0x75, 0xfe,
+		0x55, 0x29, 0x75, 0x94, 0x59, 0x53, 0x28, 0x52, 0x57, 0x54, 0x59, 0x67, 0x9c, 0x91, 0x31, 0x8a,
+		0xac, 0x25, 0x7a, 0xac, 0x7b, 0xa9, 0x18, 0x9a, 0xd3, 0x16, 0xba, 0x5c, 0x25, 0x09, 0xbb, 0x5e,
+		0x19, 0xc8, 0xb3, 0x22, 0x3b, 0x4e, 0x03, 0x37, 0xae, 0x7a, 0x6d, 0x3e, 0xa6, 0x35, 0x31, 0x26,
+		0xb8, 0xd6, 0xdc, 0xd9, 0x02, 0xd7, 0x02, 0xd7, 0x2a, 0x96, 0x6b, 0x75, 0x6d, 0xa7, 0x6a, 0xb7,
+		0xdb, 0xb1, 0x9b, 0x24, 0x9c, 0xee, 0xed, 0x36, 0x8f, 0x7b, 0x9b, 0xba, 0x71, 0xc0, 0xc6, 0xb9,
+		0xac, 0xff, 0x7c, 0xad, 0x55, 0x77, 0xec, 0x6a, 0x67, 0xb7, 0xfa, 0xf9, 0xea, 0x57, 0xe3, 0xf1,
+		0xdd, 0xc7, 0xe7, 0xbf, 0xbf, 0xff, 0xd5, 0x7a, 0xfc, 0x87, 0xb5, 0xd2, 0x9a, 0x9f, 0xd5, 0xc9,
+		0x9e, 0x1c, 0x14, 0xba, 0x7f, 0xee, 0x74, 0x41, 0xf7, 0x43, 0xf7, 0x17, 0xab, 0xfb, 0xe1, 0x67,
+		0x13, 0xc1, 0xcf, 0x2e, 0x8b, 0xe3, 0x06, 0x3f, 0x1b, 0x7e, 0xf6, 0x6a, 0xb1, 0xad, 0x28, 0x8c,
+		0xd3, 0xea, 0xb0, 0xd3, 0x25, 0x2f, 0xe5, 0x1a, 0x8f, 0x0c, 0xde, 0x35, 0x77, 0xce, 0xc0, 0xbb,
+		0xc0, 0xbb, 0x88, 0xc0, 0xbb, 0xc0, 0xbb, 0xf8, 0x8c, 0x39, 0x78, 0x17, 0x11, 0x78, 0x17, 0x78,
+		0x17, 0xff, 0xf7, 0x2b, 0xf1, 0x2e, 0x7e, 0xbe, 0x05, 0x9e, 0x95, 0x7b, 0xae, 0xc0, 0xb3, 0xc0,
+		0xb3, 0x88, 0xc0, 0xb3, 0xc0, 0xb3, 0xf8, 0x8c, 0x37, 0x78, 0x16, 0x11, 0x78, 0x16, 0x78, 0x16,
+		0xff, 0xf7, 0xab, 0xf0, 0xac, 0xe4, 0x21, 0x70, 0xee, 0xe2, 0x30, 0xf0, 0xfe, 0x8f, 0xe7, 0xe4,
+		0xdf, 0x58, 0xa1, 0x4f, 0x0f, 0x0c, 0xd6, 0x35, 0x77, 0xca, 0xc0, 0xba, 0xc0, 0xba, 0x8a, 0x65,
+		0x5d, 0xfd, 0x7a, 0x32, 0x53, 0x48, 0xad, 0xa6, 0xd9, 0xd7, 0x30, 0xe6, 0x97, 0x34, 0x19, 0xc6,
+		0x3a, 0x18, 0xba, 0x82, 0x8f, 0x46, 0xaa, 0xd8, 0x24, 0x75, 0xbb, 0xac, 0x99, 0x7a, 0x4f, 0x43,
+		0x42, 0xad, 0xce, 0x9d, 0x2c, 0xa8, 0x55, 0xa8, 0xd5, 0x62, 0xd5, 0x2a, 0x12, 0xf5, 0x90, 0xa8,
+		0x47, 0x56, 0xea, 0x75, 0xdd, 0xb0, 0x97, 0xf2, 0x69, 0xfd, 0xd1, 0x80, 0xd0, 0xf9, 0x73, 0xa7,
+		0x0a, 0x3a, 0x1f, 0x3a, 0xbf, 0x58, 0x9d, 0x3f, 0xa8, 0x0e, 0x3e, 0x40, 0x28, 0x28, 0x34, 0xdb,
+		0x9d, 0x92, 0x0b, 0xcd, 0x55, 0xcc, 0x88, 0xb7, 0x88, 0x91, 0x55, 0x29, 0x66, 0xe2, 0x1e, 0x2b,
+		0x1a, 0xa7, 0x38, 0x53, 0x60, 0x2a, 0x25, 0x31, 0xac, 0x23, 0x2f, 0x49, 0x77, 0xd3, 0x34, 0x96,
+		0x5a, 0x98, 0x2c, 0xf4, 0x7d, 0xe0, 0xbb, 0x99, 0x3a, 0x4a, 0xac, 0x8f, 0x14, 0xf4, 0x7c, 0x7f,
+		0x4d, 0x62, 0x10, 0xfb, 0xa7, 0xfa, 0x20, 0xa7, 0x71, 0xdb, 0x8d, 0xdd, 0xf6, 0xa7, 0x87, 0xe1,
+		0x10, 0x5a, 0x27, 0x5d, 0x51, 0x9e, 0xb9, 0xe4, 0x58, 0x42, 0xa9, 0xe5, 0xa9, 0xb6, 0x65, 0x55,
+		0xf4, 0x88, 0xf7, 0x63, 0x85, 0x71, 0x2d, 0x64, 0xd7, 0x40, 0x79, 0xee, 0xad, 0x0a, 0xcf, 0xbb,
+		0xe6, 0x78, 0x4f, 0x6b, 0xb8, 0x4c, 0xbf, 0x2a, 0x42, 0xc6, 0xaf, 0x7f, 0xd7, 0x5a, 0x45, 0xc4,
+		0xa6, 0xe5, 0x64, 0x8d, 0xc2, 0x34, 0x53, 0x86, 0x4e, 0x2a, 0xd0, 0x46, 0x59, 0x7a, 0xa8, 0x4c,
+		0x03, 0x95, 0xe9, 0x9e, 0x1a, 0xad, 0xe3, 0x45, 0x96, 0x30, 0x1d, 0x7b, 0xa2, 0x5d, 0xae, 0xdd,
+		0x11, 0x2b, 0xf7, 0x22, 0x53, 0xd6, 0x65, 0x5c, 0xbe, 0xe5, 0xc3, 0x87, 0xf5, 0x01, 0x74, 0xd7,
+		0xf3, 0x17, 0x68, 0xe1, 0x01, 0xe6, 0x80, 0x40, 0x08, 0x23, 0x73, 0x70, 0x9b, 0x18, 0x34, 0xeb,
+		0xa2, 0xd0, 0x6c, 0x00, 0x9a, 0x4b, 0x0b, 0xcd, 0x7d, 0x4f, 0x8c, 0xa8, 0x0d, 0x78, 0xe1, 0xbd,
+		0xed, 0x8b, 0xcf, 0xfb, 0xb3, 0x62, 0x6b, 0xd9, 0x08, 0x82, 0xb3, 0xb6, 0xef, 0x76, 0xec, 0x9e,
+		0xdf, 0x9f, 0xb4, 0x8b, 0xa3, 0xd3, 0xbf, 0x44, 0x6f, 0x97, 0x8b, 0x8b, 0x48, 0xc7, 0x41, 0x54,
+		0xe2, 0x1e, 0x0c, 0x71, 0x0e, 0xd5, 0xb8, 0x06, 0x5b, 0x1c, 0x83, 0x2d, 0x6e, 0xc1, 0x13, 0xa7,
+		0xd0, 0xeb, 0x3b, 0x49, 0xc7, 0x1d, 0x9e, 0xc7, 0x19, 0x22, 0x37, 0xf6, 0xc2, 0xb6, 0x6c, 0x98,
+		0x41, 0x25, 0xac, 0x20, 0x19, 0x46, 0x78, 0xac, 0x68, 0x98, 0xd1, 0xc1, 0x5c, 0x74, 0xc3, 0xb6,
+		0x2b, 0xaf, 0x6b, 0x9e, 0x86, 0x90, 0x57, 0x36, 0xbb, 0x7b, 0x97, 0x87, 0x7f, 0x1e, 0x40, 0xdd,
+		0x40, 0xdd, 0x2c, 0xa9, 0xba, 0xc9, 0x32, 0xb7, 0xee, 0xbd, 0xf4, 0x61, 0xe5, 0x15, 0x8e, 0x90,
+		0x77, 0xfc, 0x62, 0x2e, 0x05, 0xbc, 0x64, 0x68, 0x09, 0x68, 0x09, 0x83, 0xb4, 0x04, 0x4b, 0xfd,
+		0x55, 0x95, 0x7a, 0xab, 0x05, 0xd4, 0x57, 0xd5, 0xa3, 0x51, 0xc6, 0x99, 0x3c, 0xd5, 0xae, 0xed,
+		0xc8, 0xab, 0x96, 0xe7, 0xc3, 0x40, 0xc7, 0x40, 0xc7, 0x2c, 0x9d, 0x8e, 0x51, 0x4b, 0xaa, 0x51,
+		0x49, 0xa2, 0x51, 0x4e, 0x9a, 0xd1, 0x94, 0x24, 0x73, 0x55, 0x1e, 0xfd, 0x15, 0xc5, 0x5e, 0x18,
+		0x7b, 0xe9, 0x83, 0xb2, 0x06, 0x1b, 0x0f, 0x04, 0x1d, 0x06, 0x1d, 0xb6, 0x74, 0x3a, 0x4c, 0xfa,
+		0x94, 0x9b, 0xc2, 0xa9, 0x36, 0xc5, 0x53, 0x6c, 0xbf, 0x2a, 0x0b, 0x3d, 0xa5, 0xc6, 0x95, 0xad,
+		0xc6, 0x74, 0x0a, 0x8d, 0xf3, 0x18, 0x93, 0x4a, 0x6e, 0x21, 0xc7, 0xa9, 0x32, 0xee, 0xa9, 0x65,
+		0x38, 0x35, 0xc6, 0x3a, 0xbd, 0x05, 0x25, 0xdb, 0x5c, 0x21, 0x77, 0xe1, 0xb5, 0xdc, 0x05, 0x81,
+		0x84, 0xa7, 0xc7, 0x8a, 0xda, 0x27, 0xe6, 0xcc, 0xc1, 0x28, 0x61, 0x29, 0x47, 0xf8, 0x45, 0x2c,
+		0x37, 0x49, 0x2a, 0x17, 0x49, 0x2a, 0xf7, 0x48, 0x2c, 0xd7, 0x68, 0xde, 0x7c, 0x08, 0xca, 0x82,
+		0xb4, 0x0c, 0x58, 0x6b, 0x15, 0xa5, 0xe4, 0x20, 0xab, 0x22, 0x27, 0x13, 0x8f, 0x15, 0x81, 0x59,
+		0xc9, 0x3b, 0x1b, 0x82, 0xb3, 0x60, 0x55, 0xf2, 0x3d, 0xd9, 0x2b, 0x4f, 0x35, 0x67, 0xab, 0x3f,
+		0xd7, 0xd6, 0xfe, 0x9c, 0xad, 0xfc, 0xb9, 0x5b, 0xf7, 0x79, 0xf8, 0xad, 0x00, 0x8f, 0xcd, 0xcb,
+		0x57, 0x85, 0x79, 0xa9, 0x30, 0xff, 0x14, 0xe3, 0x99, 0x62, 0x92, 0x34, 0x6f, 0xab, 0x5c, 0xd8,
+		0x59, 0x92, 0x74, 0x8e, 0x72, 0x1a, 0xf6, 0xdc, 0xce, 0x8f, 0x88, 0xb3, 0x23, 0xe1, 0xdc, 0x88,
+		0x3a, 0x33, 0xd2, 0xce, 0x8b, 0xb4, 0xb3, 0x22, 0xe7, 0x9c, 0xa8, 0x19, 0xad, 0xdc, 0xce, 0x86,
+		0xb8, 0x73, 0x21, 0xe0, 0x4c, 0x08, 0x3a, 0x0f, 0xbf, 0x2a, 0x5a, 0x9d, 0x03, 0x59, 0x2f, 0x5f,
+		0x92, 0xfc, 0xab, 0xb0, 0x51, 0x91, 0x18, 0x8a, 0x0c, 0x99, 0x57, 0x9d, 0x0a, 0x09, 0xb2, 0xae,
+		0x34, 0x1d, 0x4c, 0x44, 0xf0, 0xca, 0x38, 0x4a, 0xf0, 0x06, 0x0f, 0x7e, 0xac, 0xbc, 0xfd, 0x97,
+		0xa9, 0xc7, 0x9b, 0xf7, 0x58, 0x79, 0x1e, 0xc7, 0x5a, 0xab, 0xe4, 0xa0, 0x60, 0x56, 0xe5, 0xf5,
+		0x87, 0x9a, 0x78, 0x20, 0xcb, 0xf7, 0xdb, 0xd1, 0x8b, 0xc7, 0x78, 0xda, 0x36, 0xce, 0xfe, 0xeb,
+		0x5a, 0xe5, 0x35, 0x89, 0x9d, 0xa2, 0x24, 0x33, 0xad, 0xd0, 0x5b, 0x56, 0xe7, 0x99, 0x95, 0x79,
+		0xf9, 0x55, 0x79, 0xac, 0x4a, 0x6e, 0x2b, 0x92, 0xdb, 0x6a, 0xbc, 0xb0, 0x12, 0xd9, 0x83, 0x09,
+		0x2e, 0xf1, 0x2c, 0x0a, 0x61, 0x39, 0xa3, 0x59, 0x9a, 0x43, 0x0a, 0x87, 0x9f, 0x53, 0x64, 0x85,
+		0x35, 0x26, 0x56, 0xf8, 0xfa, 0xd2, 0xe4, 0x5d, 0x22, 0xe1, 0xa5, 0x12, 0x5e, 0x32, 0xb1, 0xa5,
+		0x93, 0x53, 0x26, 0x73, 0x59, 0xa1, 0x73, 0x67, 0x27, 0x89, 0x97, 0xe4, 0x29, 0x10, 0xf0, 0xb4,
+		0xcc, 0x4f, 0xf7, 0x18, 0xc2, 0x05, 0xdf, 0x16, 0x05, 0x51, 0x91, 0x90, 0x16, 0x0d, 0x69, 0x11,
+		0x91, 0x13, 0x95, 0x7c, 0x96, 0x8d, 0x9f, 0x0b, 0x66, 0x9d, 0xda, 0x73, 0x75, 0x69, 0x7f, 0xda,
+		0x17, 0x93, 0xb5, 0xac, 0x6b, 0x79, 0x24, 0xbb, 0x9a, 0xe6, 0x79, 0x85, 0x57, 0xc4, 0x3b, 0x4f,
+		0xca, 0x11, 0x64, 0x7c, 0x25, 0x65, 0x5c, 0x4c, 0x48, 0x48, 0x30, 0x0f, 0x2d, 0x67, 0xde, 0x99,
+		0x1c, 0x30, 0xdc, 0xc0, 0xbe, 0xf1, 0x5d, 0x01, 0x7d, 0x3f, 0xba, 0x61, 0xad, 0x92, 0x37, 0x29,
+		0x35, 0x5b, 0x6d, 0xe0, 0x06, 0xb8, 0x79, 0x39, 0xe3, 0x37, 0x61, 0xe8, 0xbb, 0x76, 0x20, 0x82,
+		0x97, 0xba, 0x06, 0x0c, 0xdc, 0xb9, 0xbe, 0x1f, 0xf6, 0x4f, 0xcc, 0xc7, 0xf9, 0x71, 0x30, 0x79,
+		0x13, 0x84, 0x1b, 0xc2, 0xfd, 0x6a, 0x10, 0x6c, 0xb3, 0x29, 0x20, 0xdb, 0xdb, 0x08, 0x82, 0xe9,
+		0x8b, 0xfa, 0x94, 0x3c, 0x08, 0x56, 0xdf, 0x6e, 0x36, 0x37, 0xb7, 0x9a, 0xcd, 0xda, 0xd6, 0xc6,
+		0x56, 0x6d, 0xa7, 0xd5, 0xaa, 0x6f, 0xd6, 0x57, 0x29, 0x26, 0xf6, 0x86, 0x72, 0x4e, 0x7a, 0x51,
+		0x94, 0x65, 0xda, 0x55, 0x53, 0xff, 0xbe, 0x6a, 0xb7, 0xef, 0xdd, 0x38, 0xf5, 0x12, 0x77, 0x88,
+		0xfe, 0xbc, 0x9b, 0x16, 0xb3, 0xc7, 0x80, 0xea, 0x86, 0xea, 0x7e, 0x31, 0xe3, 0x5e, 0xdb, 0x0d,
+		0x52, 0x2f, 0x7d, 0xc8, 0x97, 0x3d, 0x3e, 0xe6, 0x26, 0x39, 0x10, 0x6b, 0x1d, 0x0e, 0x87, 0xfe,
+		0x64, 0x27, 0x12, 0x67, 0xa8, 0x8f, 0x8e, 0xf6, 0xcf, 0xae, 0x2f, 0x8f, 0xfe, 0xcc, 0xbb, 0x4c,
+		0x7d, 0xf5, 0x92, 0x08, 0x65, 0x5c, 0x49, 0x26, 0x4d, 0x1e, 0xef, 0x9e, 0xec, 0x7e, 0x39, 0x38,
+		0x3e, 0x38, 0xb9, 0xbc, 0xde, 0xdd, 0xdf, 0x3f, 0x3f, 0xb8, 0xb8, 0xb0, 0x74, 0x28, 0x67, 0xc9,
+		0xa7, 0xdb, 0xfb, 0x6d, 0xf7, 0xe2, 0xe2, 0xf0, 0xe2, 0xfa, 0x70, 0xbf, 0x4c, 0x4f, 0x75, 0xf1,
+		0xef, 0x8b, 0xcb, 0x83, 0xe3, 0xeb, 0x93, 0xdd, 0xe3, 0x83, 0x32, 0x3d, 0xd6, 0xd9, 0xe9, 0xf9,
+		0x65, 0xc9, 0x66, 0xaa, 0xff, 0x48, 0xfb, 0x07, 0x17, 0x7b, 0xe7, 0x87, 0x67, 0x97, 0x87, 0xa7,
+		0x27, 0x25, 0x5c, 0xc5, 0xbd, 0xdd, 0xb3, 0xdd, 0x4f, 0x87, 0x47, 0x87, 0x97, 0x87, 0x07, 0x17,
+		0x25, 0x7c, 0x3c, 0xb9, 0xc9, 0xcb, 0xf5, 0xc9, 0x2b, 0xcd, 0xfa, 0x7b, 0x39, 0x32, 0xa1, 0xe4,
+		0xc8, 0xcf, 0x20, 0xdb, 0xa2, 0xed, 0x26, 0x4e, 0xec, 0x45, 0xb9, 0xf2, 0xa4, 0xa6, 0x33, 0x35,
+		0x26, 0xef, 0x05, 0xd9, 0x01, 0xd9, 0xe1, 0x08, 0xd0, 0xe7, 0xf8, 0xec, 0x91, 0x1b, 0xdc, 0xa6,
+		0x77, 0xb9, 0x99, 0x07, 0x3c, 0xd5, 0x9c, 0x0b, 0x3c, 0xad, 0xb6, 0x0a, 0x9f, 0x8a, 0x46, 0x0b,
+		0x8e, 0xe9, 0xa4, 0x6e, 0xce, 0x75, 0x10, 0x7b, 0x5a, 0x29, 0xe7, 0x49, 0x00, 0x86, 0x36, 0x86,
+		0x36, 0x86, 0x36, 0x86, 0x36, 0x86, 0x36, 0x7e, 0xfb, 0xbf, 0x68, 0x4c, 0x9d, 0xf3, 0xdb, 0xd1,
+		0x7a, 0xff, 0x7f, 0x06, 0x7f, 0x50, 0xc9, 0xa4, 0x9f, 0xc8, 0xc7, 0x9f, 0x9b, 0x39, 0x35, 0xf1,
+		0x59, 0x64, 0x4f, 0x99, 0x93, 0x3d, 0x35, 0x5e, 0xb6, 0xfc, 0x74, 0x20, 0xef, 0x01, 0x95, 0x9c,
+		0xf5, 0x10, 0x41, 0x06, 0xca, 0x4c, 0x06, 0xf2, 0xd6, 0x2f, 0x9c, 0x97, 0x61, 0x39, 0x73, 0x81,
+		0xde, 0xcc, 0xb8, 0x94, 0x14, 0x29, 0x61, 0xd1, 0x92, 0x11, 0x31, 0x05, 0x51, 0x93, 0x15, 0x39,
+		0x65, 0xd1, 0x53, 0x16, 0x41, 0x35, 0x51, 0xcc, 0x27, 0x92, 0x39, 0x45, 0x53, 0x58, 0x44, 0x47,
+		0x57, 0xee, 0xf4, 0xa1, 0x99, 0x2b, 0x9d, 0x2f, 0x9d, 0x48, 0x31, 0xbd, 0x68, 0x96, 0xec, 0x1b,
+		0x57, 0xa3, 0x41, 0x0c, 0x13, 0xaa, 0xd8, 0x60, 0xc3, 0x08, 0x1b, 0x56, 0x78, 0x30, 0x23, 0x86,
+		0x1d, 0x41, 0x0c, 0x89, 0xfb, 0x7e, 0x0c, 0xe9, 0x51, 0x92, 0xe9, 0x52, 0xf2, 0x13, 0x82, 0x52,
+		0x75, 0x80, 0x37, 0xe0, 0x8d, 0x52, 0x75, 0x8c, 0x1a, 0xa5, 0xec, 0x45, 0x26, 0xc6, 0xc1, 0x80,
+		0x57, 0x8b, 0x4c, 0xbc, 0x15, 0x21, 0x10, 0x7f, 0x55, 0xf4, 0xc7, 0xe0, 0x57, 0x97, 0xf0, 0x10,
+		0x38, 0x80, 0x85, 0xfe, 0x18, 0xf3, 0x81, 0xe9, 0x7a, 0xb7, 0x77, 0x37, 0x61, 0x9c, 0x48, 0xa0,
+		0x73, 0x7c, 0xeb, 0x92, 0xf4, 0xc9, 0x00, 0x44, 0x0d, 0x70, 0xe2, 0x47, 0x52, 0xa7, 0x40, 0xd2,
+		0x47, 0x23, 0xc8, 0x11, 0xf5, 0x3a, 0x88, 0x7a, 0x21, 0x62, 0xce, 0x26, 0xee, 0x3c, 0x62, 0x2f,
+		0x26, 0xfe, 0x82, 0x30, 0x90, 0x86, 0xc3, 0xe8, 0xb2, 0x1c, 0x3b, 0xb2, 0x6f, 0x3c, 0xdf, 0x4b,
+		0x3d, 0x37, 0x91, 0x5f, 0xb3, 0x71, 0x70, 0x76, 0x72, 0x34, 0xc9, 0xd9, 0x96, 0x83, 0x8b, 0xb4,
+		0xf6, 0xe7, 0x84, 0x0f, 0x23, 0x8c, 0xb8, 0xe0, 0xc4, 0x0e, 0x2b, 0x76, 0x78, 0xf1, 0xc2, 0x4c,
+		0x0e, 0x6e, 0x92, 0xb0, 0x53, 0x86, 0xdf, 0x4b, 0x18, 0x3e, 0xf0, 0xf5, 0xaf, 0x9e, 0x18, 0x93,
+		0xa7, 0x85, 0x75, 0x7d, 0xd9, 0x5b, 0x58, 0xab, 0x41, 0x95, 0x1b, 0xb2, 0xda, 0xa0, 0xab, 0x0d,
+		0xc2, 0x7a, 0xa0, 0xac, 0x06, 0x69, 0x45, 0x68, 0xb3, 0x41, 0xfc, 0x09, 0xea, 0x62, 0x1b, 0x9f,
+		0xf9, 0xe1, 0x2e, 0xb2, 0x31, 0x5a, 0x10, 0xe4, 0xd9, 0xa1, 0xaf, 0x43, 0x05, 0x68, 0x54, 0x05,
+		0xba, 0x54, 0x82, 0x76, 0xd5, 0xa0, 0x5d, 0x45, 0xe8, 0x55, 0x15, 0x3c, 0x2a, 0x83, 0x49, 0x75,
+		0xa8, 0xc6, 0x6b, 0xf5, 0xc5, 0x73, 0xc7, 0x91, 0x98, 0xf1, 0x4f, 0xeb, 0x93, 0x0c, 0xfe, 0xe9,
+		0x97, 0x07, 0xa1, 0xd0, 0xaf, 0xfe, 0x55, 0x61, 0x58, 0x11, 0xb9, 0x1d, 0xbb, 0xb9, 0xba, 0x43,
+		0x62, 0x27, 0x6f, 0x9e, 0x0e, 0xae, 0x41, 0x07, 0x13, 0x41, 0x07, 0x43, 0x07, 0x33, 0x5c, 0xf2,
+		0x3b, 0x9a, 0x1a, 0x42, 0xff, 0x3a, 0xb6, 0x06, 0xe6, 0x83, 0xf6, 0x69, 0xeb, 0xa0, 0x5f, 0x2b,
+		0x73, 0x5d, 0x7c, 0xbf, 0x53, 0xdf, 0x4a, 0x73, 0xe8, 0x75, 0xb1, 0xce, 0xdd, 0xb9, 0x97, 0x57,
+		0xa4, 0xb3, 0x77, 0xee, 0x85, 0xe5, 0xd6, 0xec, 0x0d, 0x68, 0x76, 0x22, 0x68, 0xf6, 0x95, 0xd4,
+		0xec, 0x5c, 0x0e, 0xfa, 0xe8, 0x92, 0x4e, 0xfb, 0xcc, 0x8d, 0x04, 0xb9, 0xb4, 0xd0, 0xbc, 0xca,
+		0xa5, 0xc6, 0x3c, 0x2c, 0x37, 0x7d, 0xd4, 0xa9, 0x6c, 0x0a, 0x50, 0x3a, 0xba, 0x95, 0x4f, 0x61,
+		0x4a, 0xa8, 0x30, 0x65, 0x54, 0x8c, 0x52, 0xe2, 0x55, 0x4e, 0xcc, 0x4a, 0x4a, 0x1f, 0x0d, 0x65,
+		0xcc, 0xab, 0xd5, 0x94, 0x77, 0x5b, 0xdc, 0x82, 0x31, 0x2e, 0x16, 0x6f, 0x94, 0xe0, 0xc5, 0x22,
+		0x31, 0x46, 0x0b, 0xa6, 0x57, 0x07, 0xea, 0x1f, 0xea, 0x9f, 0x08, 0xea, 0xbf, 0x6c, 0x1a, 0x85,
+		0x8a, 0x51, 0xff, 0x62, 0xd5, 0xdd, 0x64, 0x95, 0x4c, 0xbd, 0xa5, 0x61, 0x6c, 0xa9, 0xea, 0x71,
+		0xd2, 0x13, 0xd5, 0xaf, 0x36, 0x37, 0x5d, 0xde, 0xea, 0xdf, 0xba, 0x40, 0x26, 0x51, 0xab, 0x4e,
+		0xf4, 0xfa, 0xa5, 0x6d, 0x64, 0x7a, 0x5e, 0x0b, 0x6f, 0xef, 0xfa, 0xd3, 0xf9, 0xe1, 0xfe, 0x97,
+		0x03, 0x4b, 0xdb, 0x17, 0x3e, 0xae, 0x99, 0x3e, 0x4b, 0xa7, 0x97, 0xbf, 0x1d, 0x9c, 0x63, 0x82,
+		0x66, 0x4f, 0xd0, 0x5f, 0x47, 0xbb, 0x27, 0xd7, 0xbb, 0x7b, 0x7b, 0x07, 0x17, 0x17, 0xd7, 0x67,
+		0xa7, 0x87, 0x27, 0x97, 0x98, 0xac, 0xd9, 0x93, 0xb5, 0x7f, 0xba, 0x97, 0x15, 0x78, 0xdc, 0xdb,
+		0xfd, 0x74, 0x74, 0x70, 0xbd, 0x7f, 0xf0, 0xe7, 0xe1, 0x1e, 0xc0, 0xf7, 0xc6, 0x74, 0x5d, 0xfe,
+		0x75, 0x7a, 0xdd, 0x2f, 0xaa, 0x98, 0xe9, 0xaa, 0xf3, 0x83, 0xa3, 0xdd, 0x7f, 0x63, 0xb6, 0x66,
+		0xcf, 0xd6, 0xf9, 0xc1, 0xd9, 0xc1, 0xee, 0x25, 0xb4, 0xd5, 0x9b, 0x12, 0x75, 0x70, 0x74, 0x70,
+		0xf6, 0xdb, 0xe9, 0x09, 0x60, 0xf7, 0xc6, 0x24, 0xed, 0x5d, 0xff, 0x79, 0xb4, 0x7b, 0x82, 0x19,
+		0x7a, 0x03, 0x6a, 0xa7, 0x7f, 0x00, 0x68, 0x6f, 0xce, 0xd0, 0x05, 0x64, 0x68, 0xde, 0x0c, 0x5d,
+		0xee, 0x66, 0x75, 0x6e, 0xaf, 0x4f, 0x4f, 0x8e, 0x74, 0x9a, 0x35, 0x2d, 0x23, 0x5f, 0x95, 0xdd,
+		0xdd, 0x47, 0x02, 0x5a, 0xe9, 0x12, 0xd0, 0x04, 0x1a, 0xdc, 0xeb, 0x5f, 0x94, 0xc5, 0xa6, 0x22,
+		0xe7, 0x6f, 0xb0, 0x3f, 0xef, 0x12, 0x2b, 0x3b, 0x9d, 0xa7, 0x4e, 0xa2, 0x70, 0x85, 0x69, 0x2d,
+		0x65, 0xab, 0x79, 0xcb, 0x5a, 0xeb, 0x5e, 0x4f, 0x66, 0x58, 0x16, 0x01, 0x47, 0x8b, 0x25, 0x51,
+		0xe7, 0x59, 0x23, 0x5c, 0xbf, 0x1d, 0x5d, 0x1f, 0x8e, 0x1e, 0xe5, 0xfa, 0x64, 0xf8, 0x00, 0xd7,
+		0x7b, 0x4f, 0xdf, 0x59, 0x59, 0x0c, 0x64, 0x8b, 0x3d, 0x4a, 0xc4, 0x24, 0x0c, 0xda, 0x84, 0xc0,
+		0xaa, 0x14, 0x33, 0x87, 0x12, 0xf3, 0xa7, 0x7a, 0xb4, 0x82, 0xe7, 0x28, 0x05, 0xdb, 0x01, 0xc6,
+		0x1a, 0x0e, 0x30, 0xea, 0xdc, 0x08, 0xc3, 0x01, 0xc6, 0xb2, 0x6b, 0x1d, 0x85, 0x23, 0x07, 0x05,
+		0xe9, 0x9b, 0x5e, 0x92, 0x86, 0xdd, 0xac, 0xe1, 0x16, 0xc7, 0xd9, 0xe9, 0x89, 0xc1, 0x70, 0x74,
+		0x1a, 0x9a, 0x67, 0x45, 0x34, 0x8f, 0xf2, 0xd1, 0xe9, 0xd4, 0xbf, 0xe7, 0x3b, 0x33, 0x9d, 0x0d,
+		0x86, 0xc3, 0xd2, 0x05, 0x80, 0x93, 0x1b, 0xa4, 0xda, 0xc0, 0xaa, 0x0d, 0xb4, 0x7a, 0xc0, 0xab,
+		0x06, 0x62, 0x45, 0x30, 0xb3, 0x81, 0x7a, 0x74, 0xe1, 0xb0, 0x34, 0xcb, 0x80, 0x38, 0xce, 0x41,
+		0x38, 0xce, 0xa1, 0x59, 0x65, 0x30, 0xa9, 0x0e, 0x6e, 0x8f, 0x44, 0xaf, 0x87, 0xf2, 0x44, 0xd9,
+		0xd7, 0x53, 0xff, 0x7e, 0x09, 0x0f, 0x49, 0x87, 0x3d, 0x8f, 0x5f, 0xf9, 0x66, 0x83, 0xe2, 0x88,
+		0xb4, 0xfa, 0x34, 0x42, 0xf3, 0x12, 0x41, 0xf3, 0x72, 0xe2, 0x9d, 0x70, 0x44, 0xfa, 0xb5, 0x23,
+		0xd2, 0x99, 0xc2, 0x5a, 0x2e, 0xa5, 0x5e, 0x4d, 0x7a, 0x37, 0x29, 0xe7, 0x3a, 0x4f, 0x2a, 0xf7,
+		0xf1, 0xe0, 0x50, 0xf2, 0xea, 0xd3, 0x09, 0x25, 0x4f, 0x04, 0x25, 0xcf, 0x89, 0x7f, 0x82, 0x92,
+		0x9f, 0xa1, 0xe4, 0xc7, 0x8a, 0x0b, 0xe5, 0x30, 0xe6, 0xad, 0x32, 0xca, 0x61, 0x30, 0x4d, 0x24,
+		0x14, 0x3c, 0x11, 0x14, 0x3c, 0x2b, 0xe6, 0x49, 0x4b, 0x39, 0x0c, 0xce, 0x50, 0x80, 0xc6, 0x90,
+		0xc0, 0xb4, 0x52, 0xc1, 0x39, 0x68, 0x9c, 0x83, 0x2e, 0x95, 0x12, 0x2a, 0x46, 0x19, 0xf1, 0x2a,
+		0x25, 0x66, 0xe5, 0xa4, 0x8f, 0x85, 0xca, 0xb7, 0x1a, 0x97, 0xd5, 0x2f, 0xf5, 0xed, 0x15, 0xa8,
+		0x82, 0xa1, 0x23, 0x62, 0xf0, 0x52, 0x39, 0xb1, 0x47, 0x0e, 0xa6, 0xd7, 0x0a, 0xb6, 0x00, 0xb6,
+		0x80, 0x08, 0xb6, 0xa0, 0x6c, 0xfa, 0x85, 0x60, 0x0b, 0xf4, 0xae, 0x17, 0xa7, 0x2d, 0xd0, 0x6b,
+		0x04, 0xa0, 0xfd, 0xa1, 0xfd, 0x89, 0xa0, 0xfd, 0xa1, 0xfd, 0xb9, 0x25, 0xde, 0x0b, 0xd2, 0x8d,
+		0x86, 0x46, 0xe5, 0xbf, 0xa1, 0x61, 0xe8, 0x73, 0x3b, 0xb8, 0x75, 0xb5, 0x55, 0x0b, 0xd2, 0x83,
+		0x4f, 0x1a, 0x9e, 0x04, 0xb4, 0x3e, 0x6a, 0xfc, 0x02, 0xe2, 0x0f, 0x1b, 0xbf, 0x5d, 0xb3, 0x29,
+		0x8b, 0x26, 0xd7, 0x9b, 0x5b, 0xcd, 0xed, 0x8d, 0xcd, 0xe6, 0xb6, 0xe6, 0x2f, 0xfc, 0x1c, 0xdb,
+		0x4e, 0x96, 0xf8, 0xb4, 0xef, 0xdd, 0x7a, 0xfd, 0x43, 0x8f, 0x35, 0x13, 0xcf, 0xc1, 0x67, 0x07,
+		0x37, 0x0b, 0x93, 0x81, 0x5a, 0xd1, 0x32, 0xb0, 0xb5, 0x44, 0x32, 0x50, 0x31, 0x63, 0xd4, 0xab,
+		0x15, 0x20, 0xd7, 0xf7, 0x43, 0x39, 0xd3, 0xc4, 0xae, 0x07, 0xc3, 0x83, 0x5e, 0x83, 0x5e, 0x13,
+		0x81, 0x5e, 0x53, 0x39, 0xf5, 0x09, 0x99, 0x59, 0x6f, 0xda, 0x0b, 0xec, 0xf8, 0x41, 0x23, 0xbf,
+		0xde, 0x41, 0x89, 0x97, 0x25, 0x4d, 0x9b, 0x2f, 0x57, 0x69, 0x17, 0xf5, 0x85, 0xb0, 0xf4, 0x64,
+		0x56, 0x22, 0xa5, 0x92, 0x67, 0x1e, 0x91, 0x71, 0x53, 0x9c, 0x0d, 0x46, 0x4a, 0xe5, 0x2a, 0xa7,
+		0x54, 0x96, 0x2a, 0x97, 0xb2, 0x0c, 0x25, 0xbb, 0xb2, 0x09, 0xa1, 0xb0, 0xe7, 0x11, 0xdf, 0x5e,
+		0x37, 0x4a, 0x78, 0xa1, 0x84, 0x97, 0x10, 0xdd, 0x2a, 0xae, 0x74, 0xd7, 0xa5, 0x7f, 0x8f, 0x9a,
+		0x5d, 0x25, 0x58, 0xf5, 0x32, 0x97, 0xd0, 0xf1, 0xda, 0xea, 0x95, 0x73, 0xbc, 0xb6, 0x62, 0xc1,
+		0x9c, 0x1a, 0x4a, 0x75, 0x11, 0x0a, 0xe6, 0x18, 0xa2, 0x6c, 0x94, 0x69, 0x26, 0x23, 0xad, 0xe4,
+		0xa0, 0x91, 0x2f, 0x69, 0xa3, 0xd7, 0x2e, 0xb3, 0xc6, 0x52, 0x3b, 0x50, 0xc3, 0x72, 0x80, 0x06,
+		0x85, 0xbe, 0xa0, 0xb7, 0x56, 0xaf, 0xd0, 0x97, 0x7d, 0xeb, 0xf2, 0x15, 0xfa, 0xca, 0x06, 0xe3,
+		0x29, 0xf4, 0x55, 0x43, 0xa1, 0xaf, 0x22, 0x41, 0xaa, 0x0d, 0xac, 0xda, 0x40, 0xab, 0x07, 0xbc,
+		0x6a, 0x20, 0x56, 0x04, 0x33, 0x1f, 0x19, 0x79, 0x21, 0x71, 0x3d, 0x2f, 0x48, 0x37, 0x9b, 0x1c,
+		0x02, 0x37, 0xc4, 0x27, 0x43, 0xb6, 0x0e, 0x73, 0x1e, 0xd6, 0xaf, 0x4a, 0xa9, 0xf3, 0xac, 0x74,
+		0x6d, 0xe8, 0x8f, 0x72, 0x68, 0xb8, 0xc7, 0xd5, 0x98, 0x2a, 0xc3, 0x99, 0xda, 0xa1, 0x23, 0x1d,
+		0x4a, 0xf7, 0x52, 0xd5, 0xb7, 0x9b, 0xcd, 0xcd, 0xad, 0x66, 0xb3, 0xb6, 0xb5, 0xb1, 0x55, 0xdb,
+		0x69, 0xb5, 0xea, 0x9b, 0xf5, 0x96, 0x41, 0xab, 0x57, 0x92, 0xd0, 0xf3, 0xd5, 0xa2, 0x82, 0x60,
+		0x0a, 0x94, 0xda, 0xb9, 0xb3, 0x93, 0xc4, 0x4b, 0xaa, 0x5e, 0x9b, 0x8f, 0x73, 0x4d, 0x8c, 0x09,
+		0xea, 0x35, 0x77, 0xb6, 0x40, 0xbd, 0x40, 0xbd, 0x8a, 0xa5, 0x5e, 0x6c, 0xe7, 0xa3, 0x98, 0xce,
+		0x43, 0x2d, 0x5a, 0xf3, 0x55, 0x59, 0xf2, 0x38, 0x5e, 0x51, 0x7f, 0x55, 0x8e, 0x7d, 0x3f, 0xe8,
+		0x40, 0xe8, 0x40, 0xe8, 0x40, 0x6e, 0x1d, 0xc8, 0x0b, 0xd2, 0x67, 0xca, 0xb0, 0xc9, 0x30, 0xd6,
+		0x41, 0xd0, 0xeb, 0x66, 0x2f, 0xfc, 0x68, 0xa0, 0x62, 0xe5, 0xa4, 0x92, 0xa0, 0x90, 0x79, 0x66,
+		0x09, 0xea, 0x13, 0xea, 0xb3, 0x58, 0xf5, 0x09, 0x0a, 0x49, 0x44, 0x96, 0x6f, 0x27, 0x69, 0xb5,
+		0x17, 0xb5, 0x39, 0x2a, 0xef, 0x3d, 0xed, 0xd6, 0x4e, 0x0c, 0x0a, 0xdd, 0x07, 0xdd, 0x07, 0xdd,
+		0x57, 0x32, 0xdd, 0xc7, 0xbd, 0x71, 0xd1, 0xc4, 0xc6, 0x45, 0xa1, 0x69, 0x11, 0x73, 0xa3, 0xe1,
+		0x3b, 0x8d, 0xc6, 0xc6, 0xc6, 0x56, 0xa3, 0xb6, 0xb1, 0xb9, 0xdd, 0x6a, 0x6e, 0x6d, 0xb5, 0xb6,
+		0x6b, 0xdb, 0xd8, 0xca, 0xe0, 0x5e, 0xbc, 0x5a, 0x61, 0x8b, 0xb7, 0x85, 0x9d, 0x8c, 0x55, 0xd8,
+		0xc9, 0xe8, 0xda, 0x81, 0x7d, 0xdb, 0x4f, 0x3e, 0xaf, 0xda, 0xed, 0x76, 0xec, 0x26, 0x09, 0x1f,
+		0x27, 0x7b, 0x65, 0x6c, 0x50, 0xb3, 0xb9, 0xb3, 0x06, 0x6a, 0x06, 0x6a, 0x56, 0x2c, 0x35, 0x83,
+		0x5b, 0xfa, 0xba, 0x26, 0x64, 0xde, 0xe1, 0x98, 0xf5, 0x05, 0xd0, 0x89, 0xd0, 0x89, 0xd0, 0x89,
+		0xd0, 0x89, 0x25, 0xd4, 0x89, 0x51, 0x18, 0xa7, 0xd5, 0xb6, 0x9b, 0x38, 0xb1, 0x17, 0xb1, 0x1c,
+		0xdf, 0x1b, 0xcf, 0xef, 0x8b, 0x91, 0xa1, 0x05, 0xa1, 0x05, 0xa1, 0x05, 0xa1, 0x05, 0xcb, 0xaa,
+		0x05, 0x39, 0xf7, 0x67, 0x47, 0x03, 0x42, 0xe7, 0x41, 0xe7, 0x41, 0xe7, 0x41, 0xe7, 0x95, 0x57,
+		0xe7, 0x31, 0xbb, 0xc0, 0xcf, 0x46, 0x85, 0xf6, 0x83, 0xf6, 0x83, 0xf6, 0x2b, 0x99, 0xf6, 0x63,
+		0x44, 0x28, 0x21, 0xbd, 0x6f, 0x7c, 0x59, 0xc9, 0x43, 0x92, 0xba, 0x5d, 0x3d, 0xbe, 0xf4, 0x2b,
+		0x63, 0x43, 0xb7, 0xce, 0x9d, 0x35, 0xe8, 0x56, 0xe8, 0xd6, 0x62, 0x75, 0xab, 0x06, 0x66, 0xc9,
+		0x30, 0xd6, 0x91, 0x1b, 0xdc, 0xa6, 0x77, 0xab, 0x96, 0x05, 0x83, 0xe3, 0xbb, 0x2b, 0x9f, 0xf3,
+		0xd2, 0x68, 0xe1, 0xb4, 0xee, 0x4a, 0xe4, 0xb8, 0x0c, 0xf9, 0xd1, 0xb0, 0x20, 0x1f, 0x2f, 0xe9,
+		0xea, 0x0f, 0x0a, 0xb6, 0x35, 0x77, 0xba, 0xc0, 0xb6, 0xc0, 0xb6, 0x88, 0xc0, 0xb6, 0xc0, 0xb6,
+		0x38, 0x4d, 0x38, 0xd8, 0x16, 0x11, 0xd8, 0x16, 0xd8, 0x16, 0xf7, 0xf7, 0xab, 0xb0, 0xad, 0x34,
+		0xf5, 0xf9, 0x58, 0x56, 0x36, 0x18, 0xd8, 0xd5, 0xdc, 0x69, 0x02, 0xbb, 0x02, 0xbb, 0x2a, 0x96,
+		0x5d, 0x65, 0x85, 0xe8, 0xea, 0x9b, 0x8c, 0xec, 0x6a, 0x13, 0xe7, 0xb9, 0x16, 0xa2, 0xd8, 0x66,
+		0x1a, 0x6c, 0x70, 0x2b, 0x63, 0x96, 0x6a, 0xb3, 0xd5, 0xda, 0x00, 0xbb, 0x32, 0x87, 0x5d, 0xad,
+		0x7c, 0xfb, 0x05, 0x85, 0x9e, 0x66, 0x8f, 0x15, 0xbd, 0x77, 0x08, 0x4e, 0xf3, 0xa8, 0x77, 0x8d,
+		0x70, 0x36, 0x9f, 0x5a, 0x67, 0x1a, 0x96, 0x4e, 0x34, 0x2c, 0x9d, 0x67, 0xd4, 0x3a, 0xcd, 0x88,
+		0xce, 0xb6, 0xa2, 0x30, 0x73, 0x0a, 0xb1, 0xb5, 0x56, 0xd1, 0xd3, 0x19, 0xc6, 0xaa, 0xe8, 0x91,
+		0xee, 0xc7, 0x0a, 0xe3, 0x8a, 0xc8, 0xae, 0x04, 0xc3, 0x0a, 0x58, 0x15, 0x9e, 0xb7, 0xcd, 0xf1,
+		0xa6, 0x82, 0xed, 0x0c, 0xa4, 0xda, 0x17, 0x08, 0x9e, 0xcb, 0x17, 0x6e, 0x4f, 0x20, 0xe3, 0x70,
+		0x2a, 0x38, 0x96, 0xb2, 0x0e, 0xa4, 0xb2, 0xa3, 0xa8, 0xec, 0x10, 0xaa, 0x39, 0x7e, 0xbc, 0xe8,
+		0x12, 0x6d, 0x07, 0x60, 0x39, 0x61, 0x2f, 0x43, 0x8a, 0xf8, 0xe9, 0xed, 0xf1, 0x52, 0x8f, 0x47,
+		0x10, 0x35, 0x80, 0x52, 0x65, 0x25, 0xa4, 0xe3, 0x29, 0x2a, 0xf1, 0x13, 0x86, 0x78, 0x89, 0x6a,
+		0x7c, 0x84, 0x2d, 0x1e, 0xc2, 0x16, 0xff, 0xe0, 0x89, 0x77, 0xe8, 0x25, 0x59, 0xb2, 0xdd, 0x31,
+		0xac, 0x4e, 0x6c, 0x77, 0xdd, 0x6a, 0xdb, 0x4b, 0x1c, 0x3b, 0x66, 0xe8, 0xa5, 0xf5, 0x7c, 0x38,
+		0xb4, 0xd5, 0x42, 0x7b, 0x9a, 0xc2, 0x81, 0x26, 0x07, 0x38, 0x49, 0xe0, 0xf1, 0x05, 0x10, 0xa7,
+		0xcd, 0x8c, 0x52, 0x2d, 0x28, 0x86, 0xe6, 0x15, 0x4c, 0xb1, 0x42, 0x9e, 0x9e, 0xa2, 0x8c, 0xd1,
+		0x76, 0xde, 0xce, 0xd5, 0xcc, 0xb1, 0x40, 0x1d, 0x41, 0xa5, 0x47, 0x9e, 0x0e, 0xac, 0xa5, 0x5f,
+		0x02, 0x7d, 0xcd, 0x26, 0xb4, 0xac, 0xca, 0x82, 0x42, 0x6b, 0x57, 0x25, 0x6e, 0x8d, 0x37, 0x20,
+		0x0f, 0x6e, 0x1c, 0x87, 0x71, 0x55, 0x01, 0xf3, 0x53, 0x64, 0x64, 0x3c, 0x1e, 0xd8, 0x08, 0xd8,
+		0x08, 0x11, 0xd8, 0x08, 0x11, 0xd8, 0x08, 0x11, 0xd8, 0xc8, 0x62, 0xec, 0x1e, 0xd8, 0x48, 0x19,
+		0x57, 0x05, 0x6c, 0x64, 0xfa, 0x7a, 0xc6, 0x1e, 0xc2, 0x5e, 0xca, 0x4b, 0x47, 0xb2, 0x01, 0xc1,
+		0x47, 0xc0, 0x47, 0x88, 0xc0, 0x47, 0x88, 0xc0, 0x47, 0x88, 0xc0, 0x47, 0x16, 0x63, 0xf9, 0xc0,
+		0x47, 0xca, 0xb8, 0x2a, 0xe0, 0x23, 0xd3, 0xd7, 0x90, 0x3e, 0xf0, 0xc5, 0x45, 0x10, 0x11, 0x01,
+		0x03, 0x21, 0x02, 0x03, 0x21, 0x02, 0x03, 0x21, 0x02, 0x03, 0x59, 0x98, 0xad, 0x03, 0x03, 0x29,
+		0xe3, 0xaa, 0x80, 0x81, 0x4c, 0x5f, 0x43, 0xde, 0xc0, 0x18, 0x0b, 0x41, 0x14, 0x04, 0x1c, 0x84,
+		0x08, 0x1c, 0x84, 0x08, 0x1c, 0x84, 0x08, 0x1c, 0x64, 0x71, 0xd6, 0x0e, 0x1c, 0xa4, 0x8c, 0xab,
+		0x02, 0x0e, 0x32, 0x7d, 0x0d, 0xda, 0x98, 0x3a, 0xbe, 0x6b, 0xc7, 0xea, 0x24, 0x64, 0x62, 0x2c,
+		0xb0, 0x10, 0xb0, 0x10, 0x22, 0xb0, 0x10, 0x21, 0x89, 0xc9, 0x7a, 0x09, 0x57, 0xed, 0xa0, 0x5d,
+		0x4d, 0x3d, 0xa5, 0x1a, 0x5f, 0x1c, 0xf5, 0x83, 0xac, 0x33, 0x3b, 0x4d, 0xdd, 0x38, 0x50, 0x26,
+		0x23, 0xd6, 0xb7, 0x6f, 0xed, 0x5f, 0xcd, 0xc7, 0x6a, 0xf6, 0x4f, 0x63, 0xf4, 0xcf, 0xe5, 0xe0,
+		0x9f, 0x8f, 0xcf, 0xfe, 0x79, 0xf7, 0xed, 0xdb, 0x87, 0x6f, 0xdf, 0xda, 0xff, 0xbf, 0xf7, 0xff,
+		0x7c, 0xf7, 0xbf, 0x7f, 0x7f, 0xfd, 0xf6, 0xed, 0xff, 0xf7, 0xed, 0x5b, 0xf5, 0xea, 0xd9, 0x27,
+		0xde, 0x5b, 0x4b, 0xa9, 0x83, 0x53, 0xff, 0x9e, 0xef, 0xc4, 0xc0, 0xe4, 0x60, 0xd0, 0xc2, 0xd0,
+		0xc2, 0x44, 0xd0, 0xc2, 0x44, 0xf0, 0x05, 0x89, 0xe0, 0x0b, 0x2e, 0xc6, 0xeb, 0x80, 0x2f, 0x58,
+		0xc6, 0x55, 0x81, 0x2f, 0x38, 0x7d, 0xf5, 0xa9, 0x43, 0x2f, 0xf8, 0x1e, 0x84, 0x3f, 0x02, 0x1e,
+		0x1e, 0x32, 0x1a, 0x0c, 0x3c, 0x04, 0x3c, 0x84, 0x08, 0x3c, 0x84, 0x08, 0x3c, 0x84, 0x08, 0x3c,
+		0x64, 0x31, 0x16, 0x0f, 0x3c, 0xa4, 0x8c, 0xab, 0xb2, 0xec, 0x3c, 0x64, 0x39, 0xab, 0x33, 0xf5,
+		0xeb, 0xed, 0xac, 0x4b, 0x56, 0x30, 0xa1, 0xb9, 0x95, 0x99, 0xf6, 0x46, 0x03, 0xeb, 0xaa, 0xcc,
+		0x94, 0xff, 0x81, 0x2d, 0x37, 0xb0, 0x6f, 0x7c, 0xb7, 0x2d, 0x5f, 0xe7, 0x65, 0x34, 0x80, 0x68,
+		0x09, 0x0e, 0xb7, 0x63, 0xf7, 0xfc, 0x3e, 0x07, 0xc8, 0x28, 0x85, 0x64, 0x95, 0x98, 0x1a, 0xaa,
+		0xc4, 0x14, 0x4a, 0x0a, 0x57, 0xaa, 0x4a, 0x8c, 0x34, 0xd9, 0x1b, 0xaf, 0xf8, 0x4d, 0x18, 0xfa,
+		0xae, 0x2d, 0xe3, 0x21, 0x8d, 0x83, 0xfc, 0xf5, 0x12, 0xe8, 0x08, 0xa9, 0x4e, 0x27, 0xe3, 0x49,
+		0x90, 0x68, 0x69, 0x02, 0x78, 0x03, 0xde, 0x26, 0xc0, 0xdb, 0x4e, 0xb2, 0x83, 0x01, 0x43, 0xb3,
+		0x5e, 0x8d, 0xdd, 0x8e, 0x0a, 0xd2, 0xb7, 0x24, 0xee, 0x3d, 0x1b, 0x73, 0x1b, 0xa7, 0xea, 0x75,
+		0x3e, 0x4e, 0x90, 0x99, 0xa9, 0x3f, 0x0c, 0x7f, 0xef, 0x63, 0x11, 0xf5, 0x20, 0x67, 0x72, 0x3e,
+		0xb6, 0x5a, 0x90, 0x15, 0x85, 0x39, 0x18, 0xd5, 0x80, 0xcd, 0xa1, 0x38, 0xc5, 0xaa, 0xbe, 0x4a,
+		0x55, 0x79, 0x95, 0xaa, 0xea, 0x2a, 0x56, 0xc5, 0x75, 0xde, 0x7c, 0x08, 0xca, 0x82, 0xb4, 0x0c,
+		0x58, 0x6b, 0x15, 0x25, 0x62, 0x6f, 0x55, 0xe4, 0x64, 0xe2, 0xb1, 0x22, 0x30, 0x2b, 0x79, 0x67,
+		0x43, 0x70, 0x16, 0xac, 0x4a, 0xbe, 0x27, 0x7b, 0xe5, 0xa9, 0xe6, 0x54, 0x35, 0xcd, 0x55, 0xc5,
+		0x74, 0x4e, 0xd9, 0xc7, 0xb9, 0x55, 0x4a, 0xf3, 0x58, 0x70, 0x01, 0x4b, 0x9d, 0xd7, 0x22, 0x0b,
+		0x5b, 0x5e, 0x61, 0x0b, 0x2b, 0x66, 0x49, 0xc5, 0x24, 0x69, 0x5e, 0x19, 0x44, 0xcb, 0xb9, 0xb3,
+		0x93, 0xc4, 0x4b, 0xaa, 0xde, 0x7c, 0xff, 0xf0, 0x29, 0xb0, 0xf9, 0x74, 0xcf, 0x3c, 0x35, 0x97,
+		0x8b, 0xe4, 0xe5, 0x26, 0x75, 0x22, 0x24, 0x4e, 0x82, 0xb4, 0x89, 0x92, 0x34, 0x69, 0x52, 0x26,
+		0x4d, 0xc2, 0xe4, 0x48, 0x97, 0x9a, 0xa9, 0xca, 0x4d, 0xa2, 0xc4, 0xfb, 0xa8, 0x3d, 0xe5, 0x39,
+		0xc9, 0xaa, 0xd5, 0xb5, 0x3c, 0x92, 0x5d, 0x4d, 0xf3, 0xbc, 0xc2, 0x2b, 0xe2, 0x9d, 0xa7, 0xa7,
+		0x39, 0x64, 0x7c, 0x25, 0x65, 0x5c, 0x4c, 0x48, 0x48, 0xb0, 0xb1, 0x7d, 0xce, 0xc6, 0xf5, 0x92,
+		0xc0, 0xc8, 0x5b, 0xf8, 0x59, 0xb4, 0xd0, 0x73, 0xce, 0xc2, 0xce, 0x80, 0x42, 0x99, 0xa1, 0x90,
+		0xb7, 0x70, 0xb2, 0xe5, 0x06, 0x69, 0xec, 0xb9, 0x49, 0xd5, 0xbe, 0x75, 0xdb, 0x42, 0x67, 0xe0,
+		0x26, 0xe2, 0xca, 0x53, 0x23, 0x88, 0x95, 0xbf, 0xaf, 0x89, 0x96, 0xbf, 0xaf, 0xa1, 0xfc, 0x3d,
+		0x8b, 0x58, 0xaa, 0x89, 0x67, 0x3e, 0x31, 0xcd, 0x29, 0xae, 0xe2, 0x1a, 0x9c, 0x65, 0x9b, 0x5e,
+		0x62, 0x5b, 0x5e, 0x72, 0x1b, 0x5e, 0xae, 0xd1, 0x8b, 0x42, 0x9c, 0x51, 0x2d, 0x65, 0x47, 0x71,
+		0x1b, 0x9d, 0x63, 0x83, 0xf6, 0x51, 0xae, 0xad, 0xcd, 0xc2, 0xa7, 0x8c, 0x6f, 0xdb, 0x9b, 0x65,
+		0x16, 0x35, 0x85, 0x10, 0xaf, 0x0a, 0x6c, 0xc5, 0x22, 0x57, 0xca, 0x5f, 0xa9, 0x74, 0x3f, 0x6c,
+		0x13, 0x11, 0x6c, 0x13, 0x11, 0x6c, 0x13, 0x11, 0x6c, 0x93, 0xac, 0xc4, 0x10, 0xc1, 0x36, 0x29,
+		0xa3, 0xd3, 0x04, 0xdb, 0x24, 0x5c, 0xda, 0x5d, 0xad, 0x94, 0x3b, 0xac, 0x13, 0x11, 0xac, 0x13,
+		0x11, 0xac, 0xd3, 0x0c, 0x55, 0x0b, 0xeb, 0x44, 0x04, 0xeb, 0x04, 0xeb, 0x24, 0x5c, 0x5a, 0x53,
+		0xb6, 0x94, 0x26, 0x2c, 0x12, 0x11, 0x2c, 0x12, 0x11, 0x2c, 0xd2, 0x0c, 0xf5, 0x0a, 0x8b, 0x44,
+		0x04, 0x8b, 0x04, 0x8b, 0x24, 0x5e, 0x6a, 0x51, 0xba, 0xb4, 0x22, 0x6c, 0x12, 0x11, 0x6c, 0x12,
+		0x11, 0x6c, 0x12, 0x11, 0x6c, 0x92, 0xac, 0xc4, 0x10, 0xc1, 0x26, 0x29, 0xa3, 0xb3, 0xdc, 0x36,
+		0x49, 0xa2, 0xf4, 0x9e, 0x7c, 0xa9, 0x3d, 0x58, 0x25, 0x22, 0x58, 0x25, 0x36, 0xab, 0x24, 0x5b,
+		0xaa, 0x4e, 0xa6, 0x34, 0x9d, 0x74, 0x29, 0xba, 0x05, 0x95, 0x9e, 0x2b, 0x52, 0x87, 0x64, 0x55,
+		0x56, 0x6c, 0xc7, 0x71, 0xa3, 0xd4, 0x95, 0xd8, 0xa2, 0x7e, 0x76, 0x37, 0xf4, 0x08, 0xf4, 0x08,
+		0x11, 0xd8, 0xed, 0xc4, 0x05, 0x76, 0x2b, 0x3a, 0x06, 0xd8, 0x2d, 0xd8, 0x2d, 0x91, 0x6c, 0x51,
+		0x53, 0x85, 0x22, 0xa6, 0xb0, 0x4b, 0x44, 0xb0, 0x4b, 0x44, 0xb0, 0x4b, 0x44, 0xb0, 0x4b, 0xb2,
+		0x12, 0x43, 0x04, 0xbb, 0xa4, 0x8c, 0xce, 0xf2, 0xdb, 0x25, 0xd1, 0x22, 0x97, 0x0a, 0x45, 0x2d,
+		0x61, 0x97, 0x88, 0x60, 0x97, 0x88, 0x60, 0x97, 0x88, 0x60, 0x97, 0x64, 0x25, 0x86, 0x08, 0x76,
+		0x49, 0x19, 0x9d, 0x0b, 0xb4, 0x4b, 0xa5, 0x2b, 0x6e, 0x22, 0x5c, 0xbf, 0xf0, 0xb5, 0xb2, 0x26,
+		0xf9, 0xaa, 0x14, 0xca, 0x9d, 0x32, 0xce, 0x5b, 0x75, 0x50, 0xb0, 0xca, 0xa0, 0x60, 0x55, 0x41,
+		0x9c, 0xce, 0x67, 0xb6, 0xc6, 0x66, 0x9c, 0xce, 0xcf, 0x5f, 0x95, 0x2f, 0x67, 0x15, 0x3e, 0x39,
+		0x0c, 0xdc, 0xb9, 0xbe, 0x1f, 0xf6, 0x77, 0x58, 0xe2, 0xfc, 0x38, 0x98, 0xbc, 0x09, 0xc2, 0x0d,
+		0xe1, 0x7e, 0x31, 0xe3, 0x3d, 0x2f, 0x48, 0x73, 0xf1, 0x47, 0x01, 0xde, 0x28, 0xc8, 0x17, 0x7f,
+		0x55, 0xb4, 0xf2, 0x43, 0xd9, 0xe2, 0x90, 0x92, 0x7c, 0x50, 0x85, 0xc1, 0x88, 0x14, 0xd9, 0x94,
+		0xe1, 0x7d, 0xaa, 0x53, 0xa1, 0xce, 0xf3, 0x94, 0x66, 0x87, 0x89, 0x7f, 0x5d, 0x69, 0x50, 0xce,
+		0x49, 0x2f, 0x8a, 0x62, 0x37, 0x49, 0xaa, 0xfd, 0x4d, 0xd4, 0xf6, 0xbd, 0x1b, 0xa7, 0x5e, 0xe2,
+		0x0e, 0xd1, 0x9f, 0x53, 0x57, 0xbf, 0x31, 0x06, 0x54, 0x37, 0x54, 0xf7, 0x8b, 0x19, 0xf7, 0xda,
+		0x6e, 0x90, 0x7a, 0xe9, 0x43, 0xbe, 0x3a, 0xa2, 0x63, 0x6e, 0x92, 0x03, 0xb1, 0xd6, 0xe1, 0x70,
+		0xe8, 0x4f, 0x76, 0xe2, 0x8a, 0xc7, 0xc5, 0x8e, 0x8e, 0xf6, 0xcf, 0xae, 0x2f, 0x8f, 0xfe, 0xcc,
+		0xbb, 0x4c, 0x7d, 0xf5, 0x92, 0x08, 0xc5, 0x17, 0x24, 0x6b, 0x0b, 0x1f, 0xef, 0x9e, 0xec, 0x7e,
+		0x39, 0x38, 0x3e, 0x38, 0xb9, 0xbc, 0xde, 0xdd, 0xdf, 0x3f, 0x3f, 0xb8, 0xb8, 0xb0, 0x74, 0x28,
+		0x67, 0xc9, 0xa7, 0xdb, 0xfb, 0x6d, 0xf7, 0xe2, 0xe2, 0xf0, 0xe2, 0xfa, 0x70, 0xbf, 0x4c, 0x4f,
+		0x75, 0xf1, 0xef, 0x8b, 0xcb, 0x83, 0xe3, 0xeb, 0x93, 0xdd, 0xe3, 0x83, 0x32, 0x3d, 0xd6, 0xd9,
+		0xe9, 0xf9, 0x65, 0xc9, 0x66, 0xaa, 0xff, 0x48, 0xfb, 0x07, 0x17, 0x7b, 0xe7, 0x87, 0x67, 0x97,
+		0x87, 0xa7, 0x27, 0x25, 0x5c, 0xc5, 0xbd, 0xdd, 0xb3, 0xdd, 0x4f, 0x87, 0x47, 0x87, 0x97, 0x87,
+		0x07, 0x17, 0x25, 0x7c, 0x3c, 0xb9, 0xc9, 0xcb, 0xf5, 0xc9, 0x2b, 0xcd, 0xfa, 0x7b, 0x39, 0x2a,
+		0xeb, 0xca, 0x91, 0x9f, 0x87, 0x24, 0x75, 0xbb, 0xd5, 0xb6, 0x9b, 0x38, 0xb1, 0x17, 0xe5, 0x0a,
+		0x4d, 0x3d, 0x91, 0x9e, 0x97, 0xf7, 0x82, 0xec, 0x80, 0xec, 0x70, 0x94, 0x01, 0xcd, 0xf1, 0xd9,
+		0x23, 0x37, 0xb8, 0x4d, 0xef, 0x72, 0x33, 0x0f, 0x78, 0xaa, 0x39, 0x17, 0x78, 0x5a, 0x6d, 0x15,
+		0x3e, 0x15, 0x8d, 0x16, 0x1c, 0xd3, 0x49, 0xdd, 0x9c, 0xab, 0x25, 0xc7, 0xb4, 0x52, 0xce, 0x53,
+		0x50, 0x1e, 0xda, 0x18, 0xda, 0x18, 0xda, 0x18, 0xda, 0x18, 0xda, 0xf8, 0xed, 0xff, 0x52, 0x44,
+		0x77, 0x86, 0x37, 0x5a, 0x92, 0x3c, 0x56, 0xde, 0xfe, 0xcb, 0xd4, 0xe3, 0xcd, 0x7b, 0xac, 0x3c,
+		0x8f, 0x63, 0xad, 0x55, 0x72, 0x6c, 0x1b, 0x5b, 0x95, 0xd7, 0x1f, 0x6a, 0xe2, 0x81, 0xac, 0xae,
+		0x97, 0x55, 0xa6, 0x7a, 0x59, 0x62, 0x7a, 0xac, 0x8e, 0x46, 0x1f, 0x58, 0xab, 0xbc, 0x26, 0xb5,
+		0x53, 0x15, 0xa4, 0x67, 0xda, 0xa2, 0xb7, 0x6c, 0xcf, 0x93, 0xad, 0xb9, 0x4f, 0xaa, 0x5d, 0xef,
+		0xb5, 0xcd, 0xb4, 0x79, 0xb6, 0x25, 0xb7, 0x2d, 0xc9, 0x6d, 0x3b, 0x9e, 0xdb, 0x8a, 0xe1, 0x83,
+		0x09, 0x2e, 0xf4, 0xac, 0x0a, 0xcd, 0xc3, 0x39, 0x9d, 0xdf, 0xa5, 0x63, 0xf8, 0x39, 0xc5, 0x36,
+		0x1d, 0x35, 0x8e, 0x36, 0x1d, 0x33, 0x97, 0x26, 0xef, 0x12, 0x09, 0x2f, 0x95, 0xf0, 0x92, 0x89,
+		0x2d, 0x9d, 0x9c, 0x4a, 0x99, 0xdf, 0xa6, 0x63, 0x34, 0xeb, 0xb9, 0x2b, 0xb6, 0xf7, 0x3f, 0x6f,
+		0x42, 0xbd, 0xf6, 0xb9, 0x22, 0x20, 0x2a, 0x0a, 0xd2, 0x22, 0x21, 0x2d, 0x1a, 0x72, 0x22, 0x92,
+		0xcf, 0xae, 0xb1, 0xd5, 0x6b, 0xbf, 0x89, 0xbd, 0xf6, 0xad, 0xc4, 0xd6, 0xc0, 0xf0, 0xbe, 0x65,
+		0xc8, 0x96, 0xcd, 0x2d, 0x6a, 0xb2, 0x22, 0xa7, 0x2c, 0x7a, 0xca, 0x22, 0xa8, 0x26, 0x8a, 0xf9,
+		0x44, 0x32, 0xa7, 0x68, 0x8a, 0x3b, 0x2b, 0xf2, 0x4e, 0x8b, 0x60, 0x47, 0x99, 0xfc, 0xef, 0x99,
+		0xe3, 0x1d, 0xc5, 0x9a, 0x67, 0xca, 0x34, 0xcd, 0x04, 0xaa, 0x80, 0xaa, 0x95, 0x43, 0x55, 0xd8,
+		0x4b, 0xa3, 0x5e, 0x5a, 0x8d, 0xc2, 0x58, 0xa2, 0xe6, 0xd3, 0xe4, 0xcd, 0xc0, 0x18, 0x30, 0x06,
+		0x8c, 0xbd, 0x85, 0xb1, 0x7b, 0xdf, 0x0e, 0xa4, 0x31, 0xd6, 0xbf, 0x19, 0x18, 0x03, 0xc6, 0x0a,
+		0xc7, 0x58, 0x26, 0x79, 0xf3, 0x9b, 0x50, 0xbe, 0x26, 0x80, 0x9b, 0x38, 0x49, 0xf5, 0x7a, 0xd8,
+		0xb7, 0x8e, 0x93, 0x54, 0xa2, 0x53, 0xd6, 0xac, 0xed, 0x34, 0x71, 0x72, 0x4a, 0x61, 0x55, 0xad,
+		0xc4, 0xf5, 0x5d, 0x27, 0xad, 0xda, 0xbe, 0x2f, 0x6e, 0x84, 0x26, 0xee, 0xcd, 0xa9, 0x6f, 0x26,
+		0x0e, 0x10, 0x75, 0x6c, 0x3f, 0x81, 0x0f, 0x06, 0xdb, 0xb5, 0x00, 0xdb, 0x95, 0xff, 0x84, 0x92,
+		0xe0, 0x49, 0x25, 0x2d, 0xd0, 0x6c, 0x27, 0xb2, 0x8e, 0xd8, 0xf4, 0x00, 0x00, 0x1b, 0xc0, 0xb6,
+		0x7c, 0xce, 0x58, 0xce, 0x37, 0x10, 0x4a, 0x38, 0x9d, 0xe4, 0x85, 0xc2, 0x39, 0xa4, 0x4a, 0x09,
+		0xa8, 0x72, 0x89, 0xa8, 0x5a, 0x14, 0x4f, 0x12, 0x3b, 0x6a, 0x8a, 0x67, 0x3c, 0x00, 0x14, 0x0f,
+		0x14, 0x0f, 0x14, 0x0f, 0x14, 0x4f, 0x4e, 0xc5, 0x23, 0x17, 0x12, 0x9b, 0xbc, 0x19, 0x0a, 0x07,
+		0x0a, 0xa7, 0x70, 0x85, 0x83, 0x90, 0x98, 0x2c, 0xb2, 0x66, 0xc6, 0x77, 0x10, 0x12, 0x23, 0x42,
+		0x48, 0x2c, 0x7f, 0x48, 0x0c, 0x26, 0x59, 0x64, 0x72, 0x17, 0x5b, 0x59, 0x69, 0xa0, 0xe6, 0xb3,
+		0x44, 0xd4, 0xf5, 0xe7, 0xff, 0xae, 0x0f, 0x13, 0xe8, 0x34, 0x1c, 0xef, 0x10, 0x3b, 0xd7, 0x61,
+		0xcc, 0x81, 0x0e, 0xa4, 0xf1, 0x15, 0x7c, 0xa0, 0xc3, 0x77, 0xed, 0x8e, 0x60, 0x1d, 0x81, 0xad,
+		0x1c, 0x9f, 0x3d, 0x1b, 0x82, 0xe3, 0xc3, 0x87, 0x21, 0x06, 0xd6, 0xfb, 0x22, 0xa8, 0xe3, 0x9c,
+		0x53, 0x3f, 0xf7, 0x3d, 0xff, 0x09, 0xa7, 0xfe, 0xc7, 0x99, 0x33, 0x5a, 0x1b, 0x80, 0x02, 0x11,
+		0x32, 0x5a, 0xd5, 0xb5, 0xab, 0xb0, 0x96, 0x95, 0x11, 0x31, 0x05, 0x51, 0x93, 0x15, 0x39, 0x65,
+		0xd1, 0x53, 0x16, 0x41, 0x35, 0x51, 0xcc, 0x27, 0x92, 0x39, 0x45, 0x53, 0x5c, 0x5b, 0x17, 0x1f,
+		0x11, 0x62, 0x09, 0x82, 0x8c, 0xeb, 0x4a, 0x0a, 0x63, 0x4b, 0xa0, 0x22, 0xa5, 0x80, 0xc2, 0x06,
+		0xba, 0x56, 0x09, 0x5d, 0x79, 0x0d, 0xc0, 0xe8, 0xb2, 0xd2, 0x9f, 0xd5, 0x9b, 0x87, 0xd4, 0x4d,
+		0xc4, 0xe7, 0x7d, 0xb4, 0xd4, 0xe3, 0x11, 0xd6, 0x0a, 0x39, 0x65, 0x29, 0x2a, 0xc6, 0x2a, 0xe2,
+		0xcc, 0x20, 0xd6, 0xaa, 0xe2, 0xcd, 0x26, 0xe6, 0x6c, 0xe2, 0xce, 0x23, 0xf6, 0x62, 0xe2, 0x2f,
+		0x08, 0x03, 0x79, 0x63, 0x33, 0x4b, 0x29, 0x0b, 0x15, 0x1d, 0x9f, 0x16, 0xf1, 0x6d, 0x89, 0x5b,
+		0xe5, 0xe2, 0x84, 0xa3, 0x4b, 0x4e, 0xc6, 0x48, 0x35, 0x6e, 0xa8, 0x88, 0xed, 0x99, 0x41, 0x31,
+		0xd5, 0x71, 0x18, 0x22, 0x63, 0x92, 0x12, 0xc8, 0x16, 0x5f, 0xd4, 0x35, 0xb5, 0x7c, 0xc5, 0xcc,
+		0xb5, 0xcc, 0x76, 0xa5, 0x98, 0xbb, 0xae, 0x34, 0xc5, 0x3d, 0x05, 0xa4, 0x25, 0xb3, 0xa3, 0x91,
+		0xed, 0x7c, 0x77, 0x53, 0x35, 0x5b, 0x3c, 0x1a, 0x03, 0xd6, 0x98, 0x08, 0xd6, 0x58, 0x2b, 0x72,
+		0x60, 0x8d, 0xf3, 0x5d, 0xb0, 0xc6, 0xda, 0xec, 0x03, 0xac, 0x71, 0x91, 0xb3, 0x6d, 0xba, 0x35,
+		0x66, 0x75, 0xb5, 0x05, 0x37, 0xd0, 0x24, 0x36, 0xd2, 0x84, 0x1b, 0x95, 0xcc, 0xa8, 0x3c, 0x73,
+		0xdc, 0x1f, 0x2e, 0x67, 0xcb, 0x92, 0xfc, 0x93, 0x85, 0xf3, 0xdd, 0xf3, 0xde, 0x03, 0x51, 0xb0,
+		0xc5, 0x40, 0x13, 0xe7, 0xbb, 0xdf, 0x12, 0x4a, 0x9c, 0xef, 0x06, 0xc6, 0x80, 0xb1, 0x9c, 0x18,
+		0xc3, 0xf9, 0x6e, 0x60, 0x0c, 0xc9, 0xac, 0xac, 0x6e, 0x31, 0x92, 0x59, 0x45, 0xc7, 0x40, 0x32,
+		0x2b, 0xce, 0x77, 0xe3, 0x7c, 0x37, 0x6c, 0xd7, 0x6a, 0xd8, 0x2e, 0x9c, 0xef, 0x06, 0xd8, 0x00,
+		0xb6, 0x65, 0x71, 0xc6, 0x70, 0xa6, 0x43, 0x93, 0xe2, 0xc1, 0xf9, 0x6e, 0x28, 0x1e, 0x28, 0x1e,
+		0x28, 0x9e, 0xc2, 0x15, 0x0f, 0xce, 0x77, 0x43, 0xe1, 0x20, 0x24, 0x46, 0x84, 0x90, 0x18, 0x42,
+		0x62, 0x44, 0x08, 0x89, 0xe1, 0x7c, 0xf7, 0x6a, 0x9d, 0xef, 0x7e, 0xa3, 0xf1, 0xd2, 0xfc, 0x47,
+		0x17, 0x6b, 0xee, 0xf2, 0xbb, 0xfb, 0x30, 0x27, 0x1f, 0x24, 0x9f, 0xb0, 0x08, 0x09, 0x87, 0x90,
+		0x30, 0xe4, 0x5b, 0x7c, 0xee, 0x7e, 0x58, 0x33, 0x17, 0xc7, 0x5a, 0xab, 0x08, 0x25, 0x05, 0x2d,
+		0xa6, 0x7f, 0xd6, 0xcb, 0xc7, 0xcf, 0xd3, 0x18, 0x2b, 0x49, 0xa3, 0xd9, 0x4d, 0xb1, 0xb2, 0xff,
+		0x58, 0x44, 0x43, 0x2c, 0xa7, 0xfa, 0xf2, 0x9b, 0xa8, 0x04, 0xfd, 0xb0, 0x06, 0xcf, 0xc5, 0xd5,
+		0x0e, 0xeb, 0xd6, 0x0f, 0x6f, 0x6c, 0x7f, 0x7e, 0x3b, 0xac, 0xe1, 0xe7, 0xca, 0xd0, 0x0e, 0x6b,
+		0xd6, 0xc2, 0xe4, 0x5d, 0x20, 0xe1, 0x85, 0x12, 0x5e, 0x30, 0xa1, 0x85, 0x93, 0xd3, 0x97, 0xab,
+		0xdc, 0x0c, 0x6b, 0x9e, 0x00, 0x88, 0x0a, 0x82, 0xb4, 0x40, 0x48, 0x0b, 0x86, 0x94, 0x80, 0xcc,
+		0x37, 0xb9, 0xc4, 0x5a, 0x38, 0x20, 0x6a, 0xf7, 0xaa, 0x1d, 0xcf, 0x4f, 0xdd, 0x58, 0xa2, 0x7a,
+		0xc0, 0xc4, 0xcd, 0xcb, 0x10, 0x03, 0xc8, 0x2b, 0x72, 0xb2, 0xa2, 0xa7, 0x2c, 0x82, 0xca, 0xa2,
+		0xa8, 0x24, 0x92, 0xf9, 0x44, 0x33, 0xa7, 0x88, 0x32, 0x44, 0x00, 0x0c, 0xd9, 0x58, 0xec, 0xa3,
+		0xe4, 0xb6, 0x67, 0xc7, 0xed, 0xdc, 0xaf, 0xf9, 0x1c, 0x61, 0x83, 0x7b, 0x01, 0x30, 0x00, 0x0c,
+		0x00, 0x9b, 0x05, 0xb0, 0x3e, 0x46, 0xaa, 0xa9, 0xd7, 0x75, 0xc3, 0x5e, 0x5a, 0x8d, 0x5d, 0x27,
+		0xbc, 0x77, 0xe3, 0x07, 0x39, 0xc0, 0xcd, 0x18, 0x0b, 0x00, 0x04, 0x00, 0x0b, 0x06, 0x60, 0xcf,
+		0x0b, 0xd2, 0x6d, 0x09, 0xf8, 0xb5, 0x10, 0xe1, 0x7e, 0x3d, 0x5c, 0x5b, 0x43, 0x84, 0x5b, 0x7b,
+		0xfb, 0x7b, 0xd6, 0x49, 0x5b, 0x82, 0x9c, 0xcf, 0x41, 0x8d, 0xb5, 0xaa, 0x9d, 0x24, 0xbd, 0xd8,
+		0x0e, 0x1c, 0xe9, 0x2a, 0x6d, 0x13, 0x23, 0xc0, 0x14, 0x11, 0xc1, 0x14, 0x15, 0x6a, 0x8a, 0x0c,
+		0xe1, 0x82, 0x6e, 0x60, 0xdf, 0xf8, 0x6e, 0xbb, 0x1a, 0xc5, 0x61, 0x1a, 0x3a, 0xa1, 0x44, 0x9a,
+		0xf5, 0x8b, 0x11, 0x00, 0x36, 0x80, 0xad, 0x60, 0xb0, 0x79, 0x6d, 0x37, 0x48, 0xbd, 0xf4, 0x21,
+		0x5f, 0x51, 0xdb, 0x17, 0x80, 0x13, 0xa1, 0x7f, 0x87, 0xc3, 0xaf, 0xfa, 0x64, 0x27, 0xae, 0x7c,
+		0xa1, 0x9a, 0x8b, 0xcb, 0xb3, 0xeb, 0xb3, 0xf3, 0xd3, 0xcb, 0xd3, 0xbd, 0xd3, 0x23, 0xd1, 0x15,
+		0xef, 0x13, 0x8d, 0x44, 0xaa, 0x1a, 0x87, 0x24, 0x33, 0x1a, 0x3d, 0xf5, 0xf9, 0xc5, 0xe5, 0x99,
+		0x55, 0x04, 0x91, 0x53, 0x7c, 0xce, 0x63, 0x43, 0x9e, 0xf3, 0x7c, 0xf7, 0xec, 0x70, 0xff, 0xfa,
+		0xec, 0xcf, 0x8b, 0x4b, 0x13, 0x9e, 0x56, 0x6e, 0x52, 0x85, 0xee, 0xb8, 0x5a, 0x90, 0xe6, 0x41,
+		0xc6, 0x45, 0xde, 0xe9, 0xb2, 0xdc, 0xf4, 0xce, 0x8d, 0x9d, 0x3b, 0x3b, 0x08, 0x5c, 0xbf, 0xda,
+		0xf5, 0x92, 0xc1, 0x0e, 0x99, 0x6c, 0xbc, 0xf6, 0xcd, 0xd1, 0x40, 0x24, 0x88, 0x40, 0x24, 0x0a,
+		0x25, 0x12, 0x86, 0xb0, 0x76, 0x3f, 0x0c, 0x23, 0x59, 0xc8, 0x4d, 0xdc, 0x0b, 0x80, 0x01, 0x60,
+		0x2b, 0x08, 0xb0, 0x85, 0xe6, 0x15, 0x46, 0x6e, 0x30, 0x34, 0x72, 0x49, 0x64, 0x07, 0x81, 0x17,
+		0xdc, 0x56, 0xd3, 0xd8, 0x75, 0xd7, 0x93, 0x34, 0x5a, 0x1f, 0xa4, 0x13, 0x69, 0x6c, 0x20, 0xb3,
+		0xb4, 0x7d, 0x33, 0x90, 0xfc, 0x42, 0x84, 0xe4, 0x17, 0x18, 0x1e, 0x56, 0x51, 0x54, 0x12, 0xc9,
+		0xfc, 0xfa, 0x98, 0xc0, 0xec, 0xa6, 0x20, 0x86, 0xe4, 0x17, 0x00, 0x0c, 0x00, 0xd3, 0x07, 0x30,
+		0x24, 0xbf, 0x00, 0x80, 0x44, 0x48, 0x7e, 0x41, 0xf2, 0xcb, 0xec, 0x0d, 0x16, 0x24, 0xbf, 0x10,
+		0x21, 0xf9, 0x85, 0x08, 0xc9, 0x2f, 0x44, 0x30, 0x45, 0x44, 0x30, 0x45, 0xcb, 0xc7, 0x05, 0x91,
+		0xfc, 0x02, 0xb0, 0x21, 0xf9, 0x05, 0xc9, 0x2f, 0x44, 0x48, 0x7e, 0x41, 0xf2, 0xcb, 0x3c, 0x99,
+		0x45, 0xf2, 0xcb, 0x94, 0x83, 0x86, 0xe4, 0x17, 0x24, 0xbf, 0xbc, 0xf5, 0x7e, 0x20, 0x12, 0x66,
+		0x11, 0x09, 0x24, 0xbf, 0x00, 0x60, 0x00, 0xd8, 0x92, 0x03, 0xac, 0xec, 0xc9, 0x2f, 0x05, 0x56,
+		0xd7, 0x92, 0xad, 0x3e, 0x35, 0xff, 0x2d, 0xf2, 0x97, 0xa1, 0xba, 0x48, 0xa3, 0xeb, 0x2f, 0x83,
+		0x7b, 0xf2, 0x96, 0xa2, 0x7a, 0x39, 0xb6, 0xe5, 0x65, 0x8d, 0xed, 0x3a, 0xb6, 0xf3, 0x46, 0x1f,
+		0xfb, 0x27, 0x77, 0xf1, 0xe9, 0xb3, 0xa8, 0x5b, 0x64, 0x4a, 0xdd, 0xa2, 0xf1, 0xa2, 0xe5, 0x4f,
+		0xde, 0x7a, 0xba, 0x05, 0xd5, 0x8b, 0x90, 0xc0, 0x45, 0x24, 0x50, 0xfe, 0xea, 0xc5, 0xf2, 0xe4,
+		0x2a, 0x83, 0x25, 0x28, 0x50, 0xc2, 0x82, 0x25, 0x23, 0x60, 0xf2, 0x82, 0x26, 0x2b, 0x70, 0xca,
+		0x82, 0xa7, 0x2c, 0x80, 0x4a, 0x82, 0x98, 0x4f, 0x20, 0x73, 0x0a, 0xa6, 0xb0, 0x80, 0x8e, 0x2e,
+		0xa9, 0x4c, 0xc3, 0x17, 0x6b, 0x2d, 0x9e, 0x71, 0x38, 0x2d, 0xc2, 0x66, 0xb5, 0x83, 0x17, 0x15,
+		0x6d, 0x55, 0x11, 0x67, 0x13, 0x75, 0x36, 0x91, 0x67, 0x11, 0x7d, 0x31, 0x08, 0x08, 0x42, 0x41,
+		0xde, 0x8b, 0x60, 0xf0, 0x26, 0x24, 0xbd, 0x0a, 0xf1, 0x09, 0x11, 0x98, 0x0c, 0x99, 0x8c, 0xc7,
+		0xd7, 0x91, 0x2e, 0xe2, 0xd6, 0x03, 0xe8, 0x00, 0x3a, 0x80, 0x5e, 0x2c, 0xd0, 0xdd, 0x2c, 0x2d,
+		0x45, 0xa8, 0x63, 0xcf, 0x8b, 0x99, 0x78, 0x1a, 0x02, 0x30, 0x07, 0xcc, 0x97, 0x0c, 0xe6, 0x72,
+		0xfb, 0xf8, 0x2a, 0xfb, 0xf9, 0x3c, 0xfb, 0xfa, 0xaf, 0xee, 0xef, 0x1f, 0xec, 0x7f, 0x39, 0xb8,
+		0x3e, 0x3b, 0x3d, 0xbf, 0xb4, 0x54, 0x32, 0x0a, 0xe5, 0x36, 0xfa, 0x47, 0xd7, 0x2f, 0xe9, 0x3b,
+		0x9f, 0xbd, 0x4e, 0xff, 0x55, 0x0e, 0x4e, 0x76, 0x3f, 0x1d, 0x1d, 0x58, 0xd2, 0x23, 0x3e, 0xae,
+		0x95, 0xe2, 0x35, 0xf6, 0x0f, 0x2f, 0x96, 0xe2, 0x3d, 0x76, 0xff, 0xb8, 0x3c, 0x55, 0x78, 0x09,
+		0xa9, 0x3b, 0xaf, 0x4a, 0x93, 0x90, 0x2a, 0x62, 0x75, 0x15, 0x99, 0x35, 0x48, 0x35, 0x11, 0xac,
+		0xed, 0x12, 0x5a, 0xdb, 0x24, 0x1d, 0x6e, 0x04, 0x57, 0xd3, 0x6c, 0x2c, 0x05, 0x83, 0x2b, 0xd1,
+		0xcf, 0xc8, 0x3a, 0x08, 0x7a, 0xdd, 0xec, 0xf9, 0xcb, 0xa0, 0x21, 0x7c, 0x2f, 0xf8, 0x5e, 0x4d,
+		0x65, 0x26, 0xf4, 0x69, 0x5b, 0x7d, 0x3c, 0x04, 0x34, 0x05, 0x34, 0xc5, 0x12, 0x6a, 0x0a, 0x59,
+		0x01, 0x5f, 0x2a, 0x45, 0x31, 0xdc, 0xb3, 0x96, 0xd4, 0x11, 0x6f, 0x34, 0xb1, 0x82, 0x7a, 0x80,
+		0x7a, 0x30, 0x56, 0x3d, 0xdc, 0xd8, 0x89, 0x5b, 0x1d, 0x6f, 0xb6, 0x57, 0x15, 0xbd, 0xf7, 0x2d,
+		0x89, 0x7b, 0xcf, 0xc6, 0x99, 0x29, 0x4e, 0xd5, 0xeb, 0x7c, 0x1c, 0x3f, 0x4b, 0x32, 0xfd, 0x87,
+		0xe1, 0xef, 0x7d, 0x24, 0xea, 0xd2, 0x27, 0xac, 0xfb, 0x86, 0x82, 0xf9, 0x46, 0x52, 0x19, 0x3b,
+		0x13, 0xd3, 0xf5, 0x34, 0x51, 0x79, 0x4a, 0xf0, 0xe4, 0x7f, 0xe5, 0x1c, 0xaf, 0x2b, 0xa6, 0x5c,
+		0x65, 0x94, 0x2a, 0x32, 0x19, 0x09, 0xdb, 0xf2, 0x8a, 0xca, 0xef, 0x89, 0xf0, 0xbb, 0x76, 0x47,
+		0xf2, 0xbc, 0x91, 0x80, 0x86, 0x1b, 0x6b, 0xb6, 0x0f, 0x1f, 0x86, 0x88, 0x5c, 0xcf, 0xaf, 0xbb,
+		0x98, 0x7a, 0x7e, 0xe7, 0xaa, 0x98, 0xf5, 0x62, 0x7e, 0xf2, 0x54, 0xce, 0x7a, 0x31, 0x33, 0xa2,
+		0xc0, 0x6c, 0x00, 0x98, 0x44, 0xc8, 0x97, 0x41, 0xbe, 0x0c, 0x49, 0xbd, 0x2e, 0x88, 0x3a, 0x8f,
+		0xe8, 0x8b, 0x41, 0x40, 0x10, 0x0a, 0xf2, 0xb6, 0x6a, 0x59, 0xb7, 0xd1, 0x91, 0x2f, 0x03, 0xa0,
+		0x03, 0xe8, 0x2b, 0x00, 0x74, 0xe4, 0xcb, 0x00, 0xe6, 0x80, 0x39, 0x11, 0xf2, 0x65, 0x88, 0x90,
+		0x2f, 0x43, 0x84, 0x7c, 0x19, 0x22, 0xe4, 0xcb, 0xc8, 0x7c, 0x1a, 0xf9, 0x32, 0x44, 0xb0, 0xb6,
+		0x44, 0xb0, 0xb6, 0xc8, 0x97, 0x51, 0xd7, 0x10, 0xc8, 0x97, 0x81, 0xa6, 0x80, 0xa6, 0x78, 0x5b,
+		0x53, 0x20, 0x5f, 0x06, 0xf9, 0x32, 0x50, 0x0f, 0x50, 0x0f, 0x44, 0xc8, 0x97, 0x61, 0xf8, 0x64,
+		0xe9, 0xf3, 0x65, 0x72, 0x54, 0xed, 0xc9, 0xff, 0xc6, 0x6a, 0xc5, 0x30, 0x7e, 0x77, 0x1f, 0x72,
+		0xaa, 0x4f, 0xb1, 0xca, 0x87, 0x52, 0x15, 0x0f, 0xa5, 0x2a, 0x1d, 0x8a, 0x55, 0x38, 0x5c, 0x64,
+		0xcd, 0xa6, 0xd7, 0x64, 0xc1, 0xca, 0x95, 0x55, 0x31, 0x5d, 0x03, 0xe9, 0x70, 0x7c, 0xbb, 0xb1,
+		0xa5, 0x9f, 0x9e, 0x26, 0x43, 0xa5, 0x94, 0x53, 0x37, 0x53, 0xfa, 0x73, 0x8b, 0x38, 0x75, 0x67,
+		0x9b, 0x4c, 0x94, 0x6f, 0x9a, 0x05, 0x84, 0xc5, 0x95, 0x6f, 0xca, 0x59, 0x77, 0x47, 0xac, 0xde,
+		0x0e, 0x0a, 0x37, 0xf1, 0x08, 0x86, 0x94, 0x80, 0xf0, 0xd8, 0xaa, 0xdc, 0x85, 0x9b, 0x3a, 0x61,
+		0xfc, 0xc3, 0x8e, 0xdb, 0x99, 0xbe, 0x69, 0xbb, 0xbe, 0x2d, 0xd1, 0xab, 0xe8, 0xc5, 0x08, 0xc8,
+		0x1a, 0xcd, 0x7f, 0x21, 0x39, 0x4d, 0xc5, 0x05, 0x40, 0x87, 0x22, 0x45, 0x54, 0xbd, 0xbe, 0xd9,
+		0x67, 0x7d, 0xa4, 0x26, 0x3a, 0x14, 0x89, 0x4e, 0xd9, 0x46, 0x0d, 0x0d, 0x8a, 0x14, 0xd6, 0xd4,
+		0xba, 0x73, 0x7d, 0x3f, 0xec, 0xf7, 0xbb, 0x13, 0xb7, 0x41, 0x13, 0xf7, 0xc2, 0xfa, 0x10, 0xc1,
+		0xfa, 0x10, 0xc1, 0xfa, 0x98, 0x6b, 0x7d, 0xea, 0xb0, 0x3e, 0xc2, 0x53, 0x06, 0xeb, 0xa3, 0xb2,
+		0xa6, 0xd6, 0x5d, 0xe8, 0xb7, 0xab, 0x4e, 0xd8, 0x0b, 0x52, 0x09, 0xeb, 0xf3, 0x74, 0x6f, 0xde,
+		0x63, 0x18, 0x6e, 0xc7, 0xee, 0xf9, 0xd9, 0x57, 0x59, 0x9b, 0xb0, 0x58, 0x44, 0xb0, 0x58, 0x44,
+		0xb0, 0x58, 0x04, 0x8b, 0x05, 0x8b, 0x55, 0xcc, 0x9c, 0x2d, 0x81, 0xc5, 0xea, 0xda, 0x3f, 0xab,
+		0xf6, 0xad, 0x84, 0xb3, 0x34, 0xba, 0x11, 0x76, 0x87, 0x08, 0x76, 0x87, 0x08, 0x76, 0xc7, 0x5c,
+		0xbb, 0xb3, 0x09, 0xbb, 0x23, 0x3a, 0x65, 0x4d, 0xd8, 0x1d, 0x95, 0x35, 0xed, 0x9b, 0x8f, 0xbb,
+		0x30, 0x92, 0xb3, 0x3b, 0xd9, 0x8d, 0xb0, 0x3b, 0x44, 0xb0, 0x3b, 0x44, 0xb0, 0x3b, 0xf0, 0x77,
+		0x56, 0xc8, 0xee, 0x34, 0x5a, 0x2d, 0x18, 0x1e, 0x85, 0x45, 0x45, 0x21, 0x2b, 0x98, 0x1c, 0x36,
+		0xd9, 0xd5, 0x6f, 0x72, 0x92, 0x34, 0xf6, 0x82, 0x5b, 0x99, 0x3a, 0x56, 0xdb, 0x02, 0xf7, 0x1c,
+		0xb9, 0xc1, 0x6d, 0x7a, 0x07, 0xab, 0xa3, 0x5f, 0x81, 0x9a, 0x9a, 0x95, 0xd0, 0x80, 0xd1, 0x51,
+		0x58, 0x53, 0x2b, 0x76, 0xef, 0xbd, 0x44, 0x24, 0xed, 0x7d, 0xac, 0x00, 0xc6, 0x77, 0xc2, 0xf8,
+		0x10, 0xc1, 0xf8, 0x14, 0x6a, 0x7c, 0x32, 0x7f, 0x67, 0xa3, 0x21, 0x61, 0x7c, 0xb6, 0xe0, 0xf0,
+		0xbc, 0xae, 0x47, 0x6b, 0x30, 0x3d, 0xa2, 0x53, 0xd6, 0x6c, 0xec, 0x34, 0x77, 0x36, 0xb7, 0x1a,
+		0x3b, 0xf0, 0x7b, 0x24, 0x3f, 0xb1, 0xc8, 0x13, 0x38, 0xd9, 0x41, 0x90, 0x5c, 0xd5, 0x8a, 0x1f,
+		0x2b, 0x12, 0x8f, 0x9f, 0x9d, 0x33, 0xa9, 0x7a, 0x41, 0x92, 0xda, 0xc1, 0x5b, 0xbd, 0xc5, 0x5f,
+		0x28, 0xb6, 0xe7, 0xb7, 0xe1, 0x18, 0x03, 0x8e, 0x31, 0xbc, 0x14, 0x27, 0x89, 0xc8, 0xf4, 0xe4,
+		0xdd, 0xe8, 0x45, 0x9d, 0xff, 0x02, 0x5d, 0x93, 0x10, 0xd6, 0xd1, 0x25, 0xda, 0x34, 0xfd, 0xc5,
+		0x32, 0x0b, 0x35, 0x4f, 0x97, 0x14, 0x5c, 0x69, 0x01, 0x56, 0x11, 0x64, 0x75, 0x81, 0x56, 0x15,
+		0x6c, 0x36, 0x01, 0x67, 0x13, 0x74, 0x16, 0x81, 0x17, 0x13, 0x7c, 0x41, 0x00, 0x48, 0x03, 0x61,
+		0x74, 0x59, 0x37, 0xb1, 0xd7, 0xaf, 0x2b, 0x19, 0x7b, 0x61, 0xec, 0xa5, 0x0f, 0xea, 0x05, 0xf0,
+		0xa6, 0x07, 0x5c, 0x5b, 0x08, 0x21, 0x96, 0x85, 0x0e, 0x07, 0x84, 0xf8, 0xa0, 0xc4, 0x05, 0x29,
+		0x76, 0x68, 0xb1, 0x43, 0x8c, 0x15, 0x6a, 0x72, 0x90, 0x93, 0x84, 0x9e, 0x7c, 0xe8, 0xe0, 0xcd,
+		0xa2, 0x3b, 0x53, 0x30, 0x92, 0xad, 0xbf, 0xa3, 0x10, 0x6c, 0x60, 0x0a, 0x3e, 0x4c, 0x5f, 0x6a,
+		0x12, 0x4c, 0xaa, 0xc1, 0x09, 0x66, 0x45, 0x33, 0xd3, 0x13, 0xaf, 0x33, 0x8d, 0xc7, 0xe0, 0x90,
+		0x33, 0x09, 0x38, 0x5b, 0xb0, 0xa3, 0xa8, 0x25, 0xd8, 0xac, 0xd7, 0x9b, 0xcd, 0x5a, 0x89, 0xd7,
+		0xa1, 0xb2, 0x98, 0xbb, 0xaf, 0x2a, 0xc5, 0x7c, 0x9f, 0x4c, 0x10, 0xad, 0xef, 0x16, 0xb6, 0xd5,
+		0x59, 0xc8, 0x70, 0x1c, 0x90, 0x0f, 0x90, 0x0f, 0x22, 0x90, 0x0f, 0xc1, 0x7d, 0x8c, 0xfa, 0x26,
+		0x03, 0xd5, 0xd8, 0x04, 0xd5, 0x60, 0x55, 0x2b, 0x33, 0xed, 0x1c, 0xa8, 0xc6, 0xc2, 0x97, 0xa0,
+		0x59, 0xdb, 0x69, 0x82, 0x68, 0x98, 0x44, 0x34, 0xee, 0x7d, 0x3b, 0x50, 0xa7, 0x19, 0xfd, 0x51,
+		0x40, 0x32, 0x40, 0x32, 0x88, 0x40, 0x32, 0x04, 0xe4, 0xa5, 0x17, 0xe4, 0xcf, 0xd2, 0x79, 0x0b,
+		0x3c, 0xf5, 0x1d, 0x85, 0x31, 0x86, 0xaf, 0xb3, 0x70, 0x8e, 0x31, 0xa9, 0x4c, 0xe4, 0x9d, 0x16,
+		0x66, 0x0a, 0xc6, 0x4c, 0xc5, 0xf8, 0xa6, 0x4b, 0x0b, 0x35, 0x9b, 0x9e, 0x38, 0x26, 0x7e, 0xf0,
+		0x82, 0x27, 0xd4, 0x99, 0xc7, 0xd5, 0x40, 0x16, 0x14, 0x95, 0x84, 0x76, 0x0a, 0x57, 0xd4, 0x52,
+		0xf1, 0x51, 0xba, 0x42, 0x56, 0xab, 0x52, 0x8e, 0x51, 0xae, 0x2a, 0x0b, 0x94, 0x39, 0x6e, 0x5d,
+		0x1c, 0xf7, 0x55, 0x1f, 0x9f, 0x3a, 0x16, 0x49, 0x33, 0x7f, 0xab, 0xe0, 0x75, 0xea, 0xc6, 0x01,
+		0x9b, 0x46, 0xb6, 0xfe, 0xf3, 0xae, 0x59, 0xdb, 0xf9, 0x5a, 0xab, 0x36, 0xaf, 0xfe, 0x6e, 0xd6,
+		0xbe, 0xd6, 0xaa, 0xdb, 0x57, 0x5f, 0x6b, 0xd5, 0x9d, 0xab, 0xbf, 0xbf, 0xd6, 0xab, 0x1b, 0x83,
+		0x1f, 0x7f, 0x6d, 0x3c, 0x66, 0xbf, 0xed, 0x0c, 0x7f, 0xab, 0xaf, 0x35, 0x86, 0xbf, 0xbf, 0xff,
+		0xf6, 0xed, 0xc3, 0xb7, 0x6f, 0x1f, 0x14, 0x06, 0xf8, 0x87, 0xb5, 0x68, 0x91, 0x2b, 0xda, 0xbb,
+		0x91, 0xe4, 0x5e, 0x42, 0xe5, 0xad, 0xdf, 0xb2, 0x99, 0xc2, 0x15, 0xac, 0x59, 0xcb, 0x61, 0xf3,
+		0x94, 0xc9, 0xe6, 0x5b, 0x4c, 0xbd, 0xbb, 0xf2, 0x92, 0x25, 0xdc, 0xe5, 0x93, 0x07, 0x9f, 0xe5,
+		0xea, 0x3d, 0xfb, 0x2d, 0x57, 0x5e, 0xa1, 0xfc, 0xec, 0x08, 0xcc, 0x8c, 0x35, 0x51, 0x5b, 0x5b,
+		0x3a, 0x0f, 0x67, 0x62, 0x0c, 0xe4, 0xe2, 0xe8, 0x73, 0xab, 0x91, 0x8b, 0x53, 0x64, 0x2e, 0xce,
+		0x58, 0xa8, 0xd5, 0x03, 0x53, 0x22, 0x15, 0xfc, 0x19, 0x61, 0xa2, 0x0c, 0x17, 0x0e, 0xd8, 0xf0,
+		0xc1, 0x87, 0x0b, 0x46, 0xec, 0x70, 0x62, 0x87, 0x15, 0x2b, 0xbc, 0xe4, 0x60, 0x26, 0x09, 0x37,
+		0x65, 0xd8, 0x8d, 0x2e, 0xd9, 0xdc, 0xd0, 0x99, 0x62, 0x27, 0x95, 0x2b, 0xca, 0x0c, 0x44, 0x36,
+		0x40, 0x72, 0x02, 0x93, 0x1f, 0xa0, 0xdc, 0x40, 0xd5, 0x06, 0x58, 0x6d, 0xc0, 0xd5, 0x02, 0x60,
+		0x35, 0x20, 0x2b, 0x02, 0x9a, 0x0d, 0xd8, 0x13, 0x00, 0x4f, 0x52, 0x3e, 0xd1, 0x78, 0x82, 0x79,
+		0x92, 0x72, 0x49, 0x05, 0xf3, 0xf6, 0x24, 0x17, 0xe8, 0x75, 0x80, 0x5f, 0x9f, 0x12, 0xd0, 0xa5,
+		0x0c, 0xb4, 0x2b, 0x05, 0xed, 0xca, 0x41, 0xab, 0x92, 0xe0, 0x51, 0x16, 0x4c, 0x4a, 0x63, 0x7a,
+		0x93, 0x47, 0x83, 0xbc, 0x0a, 0x1f, 0xf8, 0x2d, 0x20, 0x47, 0x57, 0xf3, 0xee, 0xcd, 0xe8, 0xe2,
+		0xc5, 0x13, 0xe9, 0xda, 0xcd, 0x99, 0x9e, 0x58, 0xe6, 0xad, 0x82, 0x17, 0x5b, 0x06, 0x75, 0x4d,
+		0xe3, 0x6b, 0xdc, 0x37, 0x60, 0x86, 0x9b, 0xf6, 0x5d, 0x9f, 0xa2, 0x97, 0xb4, 0x51, 0x1b, 0x5e,
+		0x06, 0x2f, 0x6d, 0xa5, 0x9c, 0xa3, 0x5d, 0x95, 0x64, 0xcb, 0x8a, 0x41, 0xf4, 0xe5, 0xba, 0x03,
+		0xcf, 0xb5, 0x30, 0x12, 0x5d, 0x83, 0x89, 0xc0, 0x2c, 0x5f, 0xcc, 0x23, 0x98, 0x25, 0x11, 0x98,
+		0x25, 0x23, 0xde, 0x49, 0x2f, 0xb3, 0x64, 0xe9, 0xaa, 0xac, 0xa3, 0xdb, 0xf2, 0x6c, 0xf4, 0x6a,
+		0xef, 0xc2, 0xac, 0x4f, 0x26, 0x38, 0xf4, 0x7f, 0x14, 0xc6, 0xa9, 0xfa, 0x19, 0xda, 0x99, 0x02,
+		0xf1, 0x7c, 0x78, 0x58, 0x04, 0xe5, 0x09, 0x85, 0x45, 0x20, 0x82, 0x45, 0x60, 0xd4, 0x00, 0xa4,
+		0xd7, 0x22, 0x64, 0x27, 0x82, 0x9f, 0x29, 0x01, 0xd5, 0xf3, 0xc0, 0xb3, 0xb4, 0x41, 0x0b, 0xb1,
+		0x07, 0xde, 0x0b, 0xb1, 0x87, 0xa2, 0xe0, 0xb7, 0x7c, 0xb1, 0x87, 0x26, 0xa2, 0x0e, 0xcb, 0x1b,
+		0x75, 0x58, 0xe8, 0x96, 0x9a, 0x62, 0x62, 0x9a, 0xd6, 0x44, 0xb5, 0x09, 0xe7, 0x61, 0xfc, 0xa3,
+		0x54, 0xf6, 0x1a, 0xdf, 0x94, 0x2b, 0x4c, 0x37, 0x4f, 0x68, 0x88, 0x33, 0x24, 0xc4, 0xa4, 0xbe,
+		0x90, 0x51, 0x50, 0x2e, 0x42, 0x8f, 0x8c, 0x82, 0x05, 0x10, 0xf5, 0xb1, 0xbc, 0xf9, 0xae, 0xdd,
+		0xe1, 0x89, 0xd3, 0x70, 0xc6, 0x67, 0xc6, 0x71, 0x99, 0x0f, 0x1f, 0x86, 0x1a, 0x74, 0x5d, 0x3d,
+		0xf2, 0xb2, 0x18, 0x35, 0x9a, 0xa4, 0x76, 0xca, 0xa8, 0x47, 0x07, 0xc3, 0x95, 0x2c, 0x35, 0xab,
+		0x01, 0x45, 0x4a, 0x04, 0x45, 0x5a, 0x10, 0x1a, 0x09, 0xa9, 0x59, 0x12, 0x60, 0x47, 0xb8, 0x94,
+		0xe3, 0x42, 0xb8, 0xb4, 0x50, 0x25, 0xc1, 0xa3, 0x2c, 0x98, 0x94, 0x06, 0x3f, 0x0b, 0x23, 0x42,
+		0x6a, 0xd6, 0xe8, 0x42, 0x78, 0xf4, 0xd5, 0x58, 0x1a, 0xc2, 0xa3, 0xcb, 0x17, 0x1e, 0x45, 0x6a,
+		0xd6, 0xd2, 0x07, 0x49, 0xd7, 0x38, 0x98, 0x65, 0x2f, 0x8b, 0x22, 0x26, 0x3a, 0xd8, 0xe5, 0x70,
+		0x64, 0x5e, 0x86, 0x59, 0x07, 0xc3, 0x24, 0x02, 0xc3, 0x04, 0xc3, 0x54, 0xbe, 0xd8, 0xdc, 0xd3,
+		0xd1, 0x65, 0xdd, 0x44, 0xed, 0x5e, 0x35, 0x76, 0x1d, 0xd7, 0xbb, 0x77, 0xdb, 0xfc, 0xb2, 0x35,
+		0x4e, 0x00, 0x7b, 0xf6, 0x35, 0x6b, 0x46, 0xd4, 0xcd, 0xe1, 0x56, 0x37, 0x3a, 0xd5, 0x8e, 0x7e,
+		0xf5, 0xa3, 0x5b, 0x0d, 0x15, 0xa6, 0x8e, 0x0a, 0x53, 0x4b, 0x85, 0xa8, 0x27, 0x5e, 0x35, 0xc5,
+		0xac, 0xae, 0xf4, 0x39, 0xc6, 0xb3, 0xa8, 0xcb, 0x66, 0x53, 0x87, 0xc8, 0x0f, 0x15, 0xcc, 0xb6,
+		0x86, 0xa1, 0xf5, 0xf8, 0xcc, 0xa3, 0x4b, 0x0f, 0x44, 0x49, 0xb7, 0x0f, 0x3d, 0x3d, 0xf1, 0x9a,
+		0x1c, 0xa2, 0x17, 0x0e, 0x98, 0xee, 0xef, 0x29, 0xc0, 0x01, 0xd3, 0x84, 0xe0, 0xc2, 0x7c, 0xed,
+		0x45, 0x2d, 0x7d, 0x7d, 0xbb, 0xd9, 0xdc, 0xdc, 0x6a, 0x36, 0x6b, 0x5b, 0x1b, 0x5b, 0xb5, 0x9d,
+		0x56, 0xab, 0xbe, 0x59, 0x6f, 0x2d, 0x91, 0x34, 0x54, 0xcc, 0x18, 0xf5, 0xaa, 0xa4, 0xe1, 0x03,
+		0x46, 0x34, 0x0d, 0xa8, 0x70, 0x32, 0xa0, 0x03, 0x3a, 0xd9, 0x76, 0xff, 0x2b, 0xc0, 0xb4, 0xc1,
+		0xb4, 0x89, 0xc0, 0xb4, 0x4b, 0xa9, 0x55, 0x08, 0x4c, 0x9b, 0x08, 0x4c, 0x9b, 0x08, 0x4c, 0xdb,
+		0x64, 0x6e, 0x05, 0xa6, 0x4d, 0x04, 0xa6, 0x4d, 0x44, 0x04, 0xa6, 0x5d, 0x92, 0x91, 0xb8, 0xc2,
+		0xed, 0xcc, 0xa7, 0x0a, 0x8a, 0x3b, 0x5d, 0xd0, 0xcf, 0x06, 0x5d, 0x67, 0xde, 0xcf, 0x1b, 0x3c,
+		0x7a, 0x1a, 0xf7, 0x9c, 0x74, 0x78, 0x06, 0xc0, 0xba, 0x48, 0xa3, 0xeb, 0xe3, 0x64, 0xf0, 0x3f,
+		0x87, 0xc3, 0x27, 0xba, 0x3e, 0x1c, 0x3d, 0xc6, 0xf5, 0xde, 0xe8, 0x01, 0x96, 0x68, 0xfb, 0xb5,
+		0xed, 0x26, 0xde, 0x6d, 0x60, 0xa7, 0x6e, 0x7b, 0xd4, 0xd2, 0xd4, 0x6e, 0xb7, 0x63, 0x37, 0xd1,
+		0xb0, 0x1f, 0x3b, 0xfb, 0xab, 0x90, 0x02, 0xa8, 0x3c, 0xb9, 0xd8, 0xa0, 0x2d, 0xcc, 0x3f, 0x43,
+		0x0a, 0x20, 0x83, 0xbc, 0x76, 0x6d, 0x87, 0x19, 0xfe, 0xc4, 0xdc, 0xc9, 0x41, 0x5b, 0x47, 0x87,
+		0xd1, 0x65, 0xfd, 0x27, 0x6b, 0xb7, 0x60, 0x57, 0x3b, 0xbb, 0xd5, 0xcf, 0x57, 0xbf, 0x1a, 0x8f,
+		0xef, 0x3e, 0x3e, 0xff, 0xfd, 0xfd, 0xaf, 0xd6, 0xe3, 0x3f, 0x2c, 0x24, 0x0d, 0xbd, 0xbc, 0x5e,
+		0x31, 0x25, 0xfa, 0x8a, 0x7b, 0xbc, 0xf1, 0x5d, 0xb0, 0x5b, 0xca, 0xb3, 0x0b, 0xbb, 0x45, 0x04,
+		0xbb, 0xc5, 0xa8, 0x1b, 0x48, 0x7f, 0xa5, 0x0f, 0xe6, 0xde, 0xff, 0x44, 0x48, 0x66, 0x2f, 0x24,
+		0x3c, 0x88, 0x64, 0xf6, 0xa2, 0x00, 0x58, 0x58, 0xd8, 0xaf, 0xa8, 0x25, 0xdd, 0xac, 0xd7, 0x9b,
+		0x28, 0xf7, 0xc1, 0x3f, 0xda, 0x92, 0x92, 0x52, 0x3d, 0xc7, 0x25, 0xa7, 0xbf, 0x00, 0xf4, 0x53,
+		0x79, 0x4a, 0x41, 0x3f, 0x89, 0x40, 0x3f, 0x19, 0xb5, 0x00, 0xe1, 0xe4, 0x24, 0xc8, 0x26, 0x15,
+		0xc8, 0x4c, 0x6a, 0x20, 0x9b, 0xcb, 0x46, 0x36, 0x9b, 0x8d, 0x9d, 0xe6, 0xce, 0xe6, 0x56, 0x63,
+		0xa7, 0x05, 0xc2, 0x49, 0x04, 0xc2, 0x39, 0xe3, 0x9a, 0xe4, 0x83, 0xfd, 0xe2, 0xa3, 0x41, 0xaf,
+		0xab, 0x95, 0x74, 0x8e, 0xbf, 0x04, 0xc4, 0x53, 0x79, 0x5a, 0x41, 0x3c, 0x89, 0x40, 0x3c, 0x19,
+		0xb5, 0x01, 0xe9, 0x27, 0x9e, 0xf5, 0x4d, 0x0d, 0xc4, 0x73, 0x13, 0xc4, 0x93, 0xf7, 0x02, 0xf1,
+		0x2c, 0x0a, 0x6e, 0x4b, 0x47, 0x3c, 0x37, 0x5b, 0xad, 0x0d, 0x70, 0x4e, 0x22, 0x70, 0xce, 0x59,
+		0xd7, 0x0b, 0x3a, 0x58, 0xc8, 0xbe, 0x3b, 0xfa, 0x6b, 0x10, 0xb1, 0xce, 0x2d, 0xd8, 0x27, 0x11,
+		0xd8, 0x27, 0xa3, 0x5e, 0x20, 0xf4, 0xd7, 0x20, 0x02, 0x1b, 0x9d, 0x3d, 0xb1, 0xd8, 0x73, 0xd7,
+		0x0d, 0xbf, 0xa5, 0x63, 0xa3, 0xe8, 0xaf, 0x41, 0x04, 0x2e, 0x3a, 0xfb, 0x9a, 0x64, 0x88, 0x71,
+		0x18, 0xa6, 0x85, 0x9c, 0x5c, 0x78, 0xf6, 0x45, 0x60, 0xa2, 0xca, 0x53, 0x0b, 0x26, 0x4a, 0x04,
+		0x26, 0xca, 0xa8, 0x15, 0x08, 0xe7, 0x16, 0x88, 0x70, 0x6e, 0x81, 0x5f, 0x3e, 0x75, 0x58, 0xac,
+		0x42, 0xa2, 0x27, 0xcf, 0xbf, 0x09, 0x36, 0x4b, 0x79, 0x6e, 0x61, 0xb3, 0x88, 0x60, 0xb3, 0x18,
+		0xf5, 0x02, 0xe1, 0xcc, 0x02, 0x11, 0xe2, 0x27, 0x6f, 0x4d, 0x2c, 0xe2, 0x27, 0xba, 0x01, 0xb8,
+		0x74, 0xf1, 0x13, 0x9c, 0x59, 0xd0, 0x33, 0xda, 0x32, 0x11, 0xd2, 0x4e, 0x18, 0xff, 0xb0, 0xe3,
+		0x76, 0x35, 0x8d, 0xed, 0x20, 0xf1, 0x12, 0x2f, 0x5b, 0x52, 0x0d, 0x01, 0x94, 0xd7, 0xbf, 0x06,
+		0x54, 0x54, 0x79, 0x62, 0x41, 0x45, 0x89, 0x40, 0x45, 0x19, 0x35, 0x02, 0xe9, 0xa5, 0xa2, 0x3a,
+		0xca, 0xed, 0x69, 0x28, 0xb3, 0x07, 0xee, 0x49, 0xc8, 0x24, 0x2b, 0x10, 0x71, 0x4b, 0xc7, 0x3d,
+		0x8b, 0x2b, 0x8b, 0x07, 0x26, 0xba, 0xf8, 0xe7, 0xe1, 0x60, 0xa2, 0x2c, 0x7d, 0xd8, 0x5f, 0xd8,
+		0x1b, 0x86, 0x7e, 0xec, 0x44, 0xe0, 0x99, 0xe0, 0x99, 0x44, 0xe0, 0x99, 0x9c, 0x78, 0x27, 0xbd,
+		0x3c, 0xf3, 0xc6, 0x4e, 0xdc, 0xea, 0xb8, 0x80, 0x64, 0x95, 0xa7, 0xf5, 0xbb, 0x8e, 0x16, 0xf0,
+		0x4f, 0xe8, 0x1d, 0x97, 0xcf, 0x74, 0xaa, 0x5e, 0xe7, 0xe3, 0x44, 0x1d, 0xcc, 0xa9, 0x3f, 0x0c,
+		0x7f, 0x57, 0x6f, 0x14, 0xcf, 0x27, 0x13, 0x1c, 0xfa, 0x5f, 0xdf, 0x09, 0x36, 0x1c, 0x5b, 0xe3,
+		0x9b, 0x4b, 0xd8, 0x01, 0x22, 0xd8, 0x01, 0x46, 0xdc, 0x13, 0x8e, 0xad, 0x21, 0xd8, 0x40, 0x08,
+		0x36, 0x14, 0x08, 0xb7, 0xa5, 0x0b, 0x36, 0xe0, 0xd8, 0x1a, 0x11, 0xa2, 0x0b, 0x6f, 0x5d, 0x96,
+		0xe6, 0xb3, 0x6a, 0x38, 0xa0, 0x46, 0xc4, 0x3a, 0xa1, 0xe0, 0x99, 0x44, 0xe0, 0x99, 0x8c, 0x1a,
+		0x80, 0x70, 0x40, 0x8d, 0x08, 0xbc, 0x73, 0xf6, 0xc4, 0x22, 0xc1, 0x4a, 0x37, 0xfc, 0x96, 0x8e,
+		0x77, 0xe2, 0x80, 0x1a, 0x11, 0x58, 0xe7, 0xec, 0x6b, 0x40, 0x0b, 0xfb, 0xcd, 0x8b, 0x34, 0x51,
+		0xce, 0xc1, 0xd8, 0xe0, 0x9b, 0xca, 0xb3, 0x09, 0xbe, 0x49, 0x04, 0xbe, 0xc9, 0x88, 0x7d, 0xd2,
+		0xcb, 0x37, 0xbd, 0xb6, 0x1b, 0xa4, 0x5e, 0xfa, 0xa0, 0x69, 0x63, 0x8b, 0x93, 0x65, 0x1e, 0x0e,
+		0x1f, 0xf5, 0x93, 0x9d, 0xb8, 0xfa, 0x9a, 0x44, 0x5f, 0x5c, 0x9e, 0x5d, 0x9f, 0x9d, 0x9e, 0x5f,
+		0x5e, 0x5f, 0x5c, 0xee, 0x5e, 0x1e, 0x70, 0x63, 0xa2, 0x6f, 0xf1, 0x13, 0x2d, 0xed, 0x56, 0x35,
+		0x51, 0xa0, 0xd1, 0xbc, 0xec, 0x1f, 0x5e, 0xec, 0x7e, 0x3a, 0x3a, 0xd8, 0xb7, 0x4c, 0x60, 0x85,
+		0x9a, 0xe7, 0xe2, 0xd3, 0xd1, 0xe9, 0xde, 0xef, 0x87, 0x27, 0x5f, 0x30, 0x17, 0x64, 0x1d, 0x1d,
+		0xec, 0x9e, 0x9f, 0x60, 0x2e, 0xfa, 0x73, 0xf1, 0xf9, 0xf4, 0xfc, 0xaf, 0xdd, 0xf3, 0x7d, 0xcc,
+		0xc6, 0x40, 0x32, 0x0e, 0x2f, 0x2e, 0x0f, 0x34, 0x89, 0x06, 0xeb, 0x88, 0x57, 0x65, 0x63, 0x0d,
+		0xa5, 0xf0, 0x39, 0xe2, 0xd0, 0xd7, 0xe0, 0x6d, 0xf4, 0x47, 0x85, 0x9f, 0xa1, 0x3c, 0x8f, 0xf0,
+		0x33, 0x88, 0xe0, 0x67, 0x30, 0xe2, 0x9d, 0xe0, 0x67, 0x10, 0xd1, 0x02, 0xfc, 0x8c, 0xf3, 0xd3,
+		0x23, 0xb8, 0x19, 0x4f, 0xd4, 0x7a, 0x77, 0xef, 0xf7, 0x3f, 0xce, 0x40, 0x9f, 0xc8, 0xda, 0x3f,
+		0xb8, 0x38, 0xfc, 0x72, 0xb2, 0x7b, 0x09, 0x97, 0xcb, 0xfa, 0x48, 0xd6, 0xee, 0xd1, 0xe5, 0xc1,
+		0x79, 0x36, 0x1b, 0x98, 0x0c, 0xb2, 0xce, 0x4f, 0x4f, 0x2f, 0x41, 0xaa, 0x8b, 0x1e, 0xe5, 0xb1,
+		0xb2, 0x40, 0x29, 0xb3, 0x76, 0x83, 0x20, 0x4c, 0xed, 0x6c, 0x2f, 0x87, 0xc5, 0x26, 0x59, 0x89,
+		0x73, 0xe7, 0x76, 0xed, 0x68, 0x9c, 0x2c, 0x1e, 0xb9, 0x81, 0xd3, 0x27, 0xc0, 0xd5, 0x24, 0xb2,
+		0x83, 0xc0, 0x0b, 0x6e, 0xab, 0x69, 0xec, 0xba, 0xeb, 0x49, 0x1a, 0xad, 0x77, 0x87, 0xff, 0x53,
+		0xf5, 0x82, 0x24, 0xb5, 0x83, 0x2c, 0x97, 0x7c, 0xf2, 0xb7, 0xf5, 0x89, 0x1c, 0xf3, 0xf1, 0x8f,
+		0xeb, 0x83, 0x2d, 0x85, 0xca, 0x62, 0x66, 0xfc, 0xb1, 0x52, 0xe0, 0x1a, 0x65, 0x14, 0x57, 0xf1,
+		0x8c, 0x90, 0x75, 0xe4, 0x25, 0xe9, 0x6e, 0x9a, 0xc6, 0x4a, 0x8b, 0x9b, 0xed, 0x6f, 0x1f, 0xf8,
+		0x6e, 0xc6, 0x55, 0x13, 0xeb, 0x23, 0x05, 0x3d, 0xdf, 0x5f, 0x53, 0x18, 0xcc, 0xfe, 0xc9, 0x37,
+		0xd8, 0x69, 0xdc, 0x76, 0x63, 0xb7, 0xfd, 0xe9, 0x61, 0x38, 0x54, 0xa1, 0xeb, 0xc3, 0x84, 0x1d,
+		0xed, 0x98, 0x51, 0xa0, 0x81, 0x56, 0x92, 0xc6, 0x3d, 0x27, 0x0d, 0x46, 0xec, 0x32, 0x8d, 0xae,
+		0x8f, 0x93, 0xc1, 0xff, 0x1c, 0x0e, 0xbf, 0xf2, 0xfa, 0x70, 0xfc, 0x3d, 0x95, 0x62, 0x30, 0xf5,
+		0x58, 0xd1, 0xb8, 0xba, 0xaa, 0xab, 0xaa, 0x69, 0x35, 0xad, 0x8a, 0x9e, 0x19, 0x12, 0x98, 0x1d,
+		0xab, 0xff, 0x68, 0x6d, 0xe1, 0x59, 0x79, 0xaa, 0x4d, 0x38, 0xb8, 0x7f, 0xad, 0x22, 0xe3, 0x8b,
+		0x09, 0x46, 0x4f, 0xa4, 0xc3, 0x2e, 0x2a, 0xe1, 0x15, 0xf5, 0x30, 0x8a, 0x6a, 0xb8, 0x84, 0x2d,
+		0x2c, 0xc2, 0x16, 0xfe, 0x60, 0x09, 0x73, 0xe8, 0x45, 0xbc, 0x74, 0x78, 0x62, 0xbc, 0xde, 0xbe,
+		0x6b, 0x77, 0xe4, 0x42, 0x10, 0x2a, 0x67, 0xf5, 0xc6, 0x67, 0xf2, 0x3e, 0x7c, 0x58, 0x1f, 0xa8,
+		0x98, 0xf5, 0x21, 0xc4, 0x4a, 0xa0, 0x2c, 0xe4, 0xd2, 0x4a, 0x26, 0x12, 0x16, 0xc5, 0x33, 0x47,
+		0xc6, 0x73, 0x29, 0xab, 0x2a, 0x1a, 0x50, 0x15, 0x50, 0x15, 0x6f, 0x5d, 0xd6, 0xbe, 0x27, 0x47,
+		0xa5, 0xad, 0x61, 0x7d, 0x43, 0xd5, 0x12, 0xe0, 0x4f, 0x07, 0x88, 0x9f, 0x8f, 0x27, 0xeb, 0x61,
+		0x28, 0x6d, 0x75, 0x28, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0xf0, 0x6d, 0x5d, 0x70, 0x6d, 0x55, 0xb0,
+		0x6f, 0x4d, 0xb0, 0x6f, 0x45, 0xb0, 0x6e, 0x3d, 0x14, 0xeb, 0x13, 0x2b, 0x6f, 0x25, 0x30, 0x57,
+		0xca, 0xe6, 0xa8, 0x8c, 0xcd, 0x56, 0x09, 0x5b, 0x73, 0xe5, 0xeb, 0xab, 0xa2, 0xfc, 0xbb, 0x35,
+		0x69, 0xfd, 0xaa, 0x7c, 0x84, 0x6a, 0x5a, 0xc1, 0x2a, 0x1e, 0x9a, 0x82, 0x86, 0x25, 0x82, 0x86,
+		0x5d, 0x51, 0x0d, 0xab, 0xa1, 0xae, 0x33, 0x43, 0x1d, 0x67, 0xa6, 0x63, 0x45, 0xbf, 0x2a, 0xa5,
+		0x3a, 0x36, 0xc4, 0x9d, 0xb5, 0xc2, 0x7c, 0x2c, 0x48, 0xc7, 0x89, 0x11, 0x8e, 0x6c, 0x24, 0xce,
+		0x63, 0x3e, 0xba, 0x96, 0x80, 0xb3, 0x4e, 0xb2, 0x96, 0x75, 0x58, 0xd0, 0x66, 0x4c, 0x99, 0xe9,
+		0x08, 0x77, 0xeb, 0x27, 0x4d, 0xad, 0x9e, 0x40, 0x4f, 0x88, 0x40, 0x4f, 0xe0, 0x00, 0xc2, 0x01,
+		0x5c, 0x3a, 0x8d, 0xcb, 0xe7, 0x09, 0x32, 0xb7, 0x2a, 0x82, 0xce, 0x25, 0x82, 0xce, 0x85, 0x4b,
+		0x08, 0x97, 0x90, 0x08, 0x2e, 0x21, 0x5c, 0x42, 0x22, 0xb8, 0x84, 0x5c, 0x77, 0x97, 0x99, 0xa0,
+		0xdc, 0x85, 0x7e, 0xbb, 0x9a, 0x7a, 0x0a, 0x55, 0xc4, 0xc7, 0x5a, 0xf4, 0x69, 0x28, 0x50, 0x10,
+		0x50, 0x10, 0x22, 0x50, 0x10, 0xc1, 0x92, 0xab, 0xdb, 0x0c, 0x7c, 0xa3, 0x05, 0xbe, 0xc1, 0xaa,
+		0x55, 0x66, 0x1a, 0xbb, 0x1a, 0xf8, 0xc6, 0xa2, 0x97, 0xa0, 0xd1, 0x6a, 0x81, 0x6c, 0x98, 0x44,
+		0x36, 0x24, 0x53, 0x75, 0x5f, 0x28, 0x4b, 0xa9, 0x94, 0x5d, 0x22, 0xd0, 0x0c, 0x22, 0xd0, 0x8c,
+		0x95, 0xa7, 0x19, 0x4a, 0x95, 0xdd, 0x19, 0x2a, 0xb9, 0x83, 0x67, 0x88, 0x18, 0x39, 0xc4, 0x35,
+		0x16, 0xbe, 0x04, 0xcd, 0xda, 0x4e, 0x13, 0x44, 0xc3, 0x24, 0xa2, 0xd1, 0xdf, 0x11, 0x71, 0xc2,
+		0x24, 0x55, 0xe7, 0x1a, 0x4f, 0x43, 0x81, 0x6e, 0x80, 0x6e, 0x10, 0x81, 0x6e, 0x08, 0xd2, 0x8d,
+		0x8d, 0x06, 0xb6, 0x51, 0x88, 0x10, 0xd6, 0x00, 0xdd, 0xc8, 0xb9, 0x04, 0xcd, 0xc6, 0x4e, 0x73,
+		0x67, 0x73, 0xab, 0xb1, 0x83, 0xe8, 0x86, 0x79, 0xa4, 0x23, 0x2b, 0x30, 0xcd, 0x44, 0x3a, 0xfa,
+		0x43, 0x81, 0x74, 0x80, 0x74, 0x10, 0x81, 0x74, 0x08, 0x92, 0x0e, 0xc4, 0x38, 0x88, 0x88, 0x40,
+		0x3a, 0x40, 0x3a, 0x8a, 0xef, 0x06, 0x07, 0xbe, 0x51, 0x0c, 0xdf, 0xc8, 0x52, 0x2d, 0xaa, 0x89,
+		0x17, 0x38, 0x6e, 0x35, 0x0d, 0xa3, 0xd0, 0x0f, 0x6f, 0x1f, 0xaa, 0xce, 0xdd, 0x50, 0xef, 0x28,
+		0x12, 0x90, 0x37, 0xc6, 0x06, 0x23, 0x01, 0x23, 0x21, 0x02, 0x23, 0x11, 0x44, 0x53, 0xea, 0x39,
+		0xdf, 0x93, 0xcd, 0x26, 0x03, 0x2d, 0xd9, 0x06, 0x2d, 0x61, 0xd5, 0x2d, 0x33, 0x6d, 0x22, 0x68,
+		0xc9, 0xc2, 0x97, 0xa0, 0xbe, 0xdd, 0x6c, 0x6e, 0x6e, 0x35, 0x9b, 0xb5, 0xad, 0x8d, 0xad, 0xda,
+		0x4e, 0xab, 0x55, 0xdf, 0xac, 0x83, 0xa5, 0x98, 0xc5, 0x52, 0x9e, 0xd3, 0x07, 0x86, 0xc3, 0x86,
+		0x2f, 0x46, 0x04, 0x23, 0x01, 0x23, 0x21, 0x02, 0x23, 0x11, 0x90, 0x17, 0x27, 0xec, 0x65, 0x45,
+		0x1c, 0xc1, 0x47, 0x88, 0x88, 0xc0, 0x47, 0xc0, 0x47, 0x88, 0xc0, 0x47, 0x56, 0x82, 0x8f, 0xdc,
+		0xfb, 0x76, 0xa0, 0xce, 0x41, 0xfa, 0xa3, 0x80, 0x77, 0x80, 0x77, 0x10, 0x81, 0x77, 0x08, 0xc8,
+		0x4b, 0x2f, 0xf0, 0xc2, 0x80, 0xa3, 0xae, 0xc1, 0x8e, 0xc2, 0x18, 0xc3, 0xd7, 0x59, 0x38, 0xe7,
+		0x98, 0x54, 0x26, 0xf2, 0xf9, 0xec, 0xcc, 0x3b, 0x57, 0xcc, 0xd4, 0x8c, 0x6f, 0xba, 0xb4, 0x50,
+		0xb5, 0xe9, 0x89, 0xab, 0xe9, 0xe8, 0x4d, 0xc4, 0xdf, 0xc7, 0x5e, 0x67, 0xab, 0x73, 0xc6, 0x0e,
+		0x31, 0x5a, 0xfa, 0xd5, 0xeb, 0x5e, 0x2a, 0xbe, 0x6c, 0xdf, 0x42, 0x56, 0xab, 0x24, 0xfd, 0x62,
+		0xae, 0x16, 0xd9, 0x2f, 0x86, 0x5b, 0x17, 0xc7, 0x0a, 0x1b, 0x5d, 0xdc, 0x55, 0x78, 0xd8, 0xab,
+		0xf1, 0x8c, 0x2e, 0xeb, 0x3f, 0xef, 0x9a, 0xb5, 0x9d, 0xaf, 0xb5, 0x6a, 0xf3, 0xea, 0xef, 0x66,
+		0xed, 0x6b, 0xad, 0xba, 0x7d, 0x95, 0x95, 0xe5, 0xb9, 0xfa, 0xfb, 0x6b, 0xbd, 0xba, 0x31, 0xf8,
+		0xf1, 0xd7, 0xc6, 0x63, 0xf6, 0xdb, 0xce, 0xf0, 0xb7, 0xfa, 0x5a, 0x63, 0xf8, 0xfb, 0xfb, 0x6f,
+		0xdf, 0x3e, 0x7c, 0xfb, 0xf6, 0x41, 0x61, 0x80, 0x7f, 0x58, 0x8b, 0x16, 0xb9, 0xa2, 0xbd, 0x1b,
+		0xf4, 0xca, 0xd1, 0xd8, 0x2b, 0x07, 0xdd, 0x5a, 0x66, 0x76, 0x6b, 0x91, 0x68, 0x4d, 0xf5, 0x58,
+		0xe1, 0xfd, 0x64, 0xce, 0xe9, 0x1b, 0xb5, 0x96, 0x12, 0x3a, 0xde, 0x29, 0x07, 0x10, 0x25, 0x40,
+		0x28, 0x01, 0x40, 0x4e, 0xe0, 0xf3, 0xce, 0xa0, 0xa4, 0xe0, 0x71, 0x0a, 0x9c, 0xb5, 0x56, 0x61,
+		0xec, 0xe3, 0x64, 0x55, 0x78, 0xc4, 0xf0, 0xb1, 0xa2, 0x30, 0xbd, 0xa2, 0xd3, 0xaa, 0x38, 0x9d,
+		0x56, 0x45, 0xee, 0x4d, 0xde, 0x78, 0x8b, 0x9c, 0x8d, 0x4f, 0x84, 0x1a, 0x9d, 0xe4, 0x6c, 0x6c,
+		0x92, 0xbb, 0x91, 0x89, 0x48, 0x60, 0x4a, 0x3c, 0x00, 0x25, 0x1a, 0x68, 0x92, 0x0e, 0x28, 0x49,
+		0x07, 0x8e, 0xa4, 0x02, 0x44, 0x6a, 0x72, 0x9d, 0xb7, 0x71, 0x88, 0xd5, 0x09, 0xe3, 0x1f, 0x76,
+		0xdc, 0xce, 0x04, 0xb7, 0xed, 0xfa, 0x76, 0xfe, 0xfa, 0x85, 0xe3, 0x85, 0x7a, 0x31, 0x42, 0x5e,
+		0x9b, 0x20, 0xe4, 0xed, 0x09, 0x47, 0x47, 0x65, 0xa2, 0xa1, 0xf2, 0xd1, 0x4f, 0xd9, 0x68, 0xa7,
+		0x72, 0x74, 0x53, 0x39, 0x9a, 0xa9, 0x14, 0xbd, 0xe4, 0x65, 0x09, 0xc2, 0xd1, 0x48, 0xe9, 0x22,
+		0x3b, 0x12, 0x45, 0x75, 0x24, 0xc3, 0x66, 0xbf, 0x2a, 0x85, 0x86, 0xc1, 0x54, 0xf7, 0x20, 0xc6,
+		0xb1, 0x92, 0xb5, 0xca, 0xa2, 0x02, 0x22, 0x32, 0x7b, 0x3f, 0x2a, 0xe1, 0x28, 0xae, 0x29, 0xdb,
+		0xa8, 0x2d, 0x70, 0xce, 0x34, 0x79, 0x01, 0x57, 0x5c, 0x24, 0x2d, 0x87, 0x19, 0xbf, 0x73, 0x7d,
+		0x3f, 0x14, 0xab, 0x58, 0xf7, 0x54, 0xa1, 0xee, 0xe9, 0x5e, 0x58, 0x1f, 0x22, 0x58, 0x1f, 0x22,
+		0x58, 0x1f, 0x73, 0xad, 0x4f, 0x1d, 0xd6, 0x47, 0x78, 0xca, 0x60, 0x7d, 0x54, 0xd6, 0x74, 0x50,
+		0xe3, 0xb4, 0x9f, 0x3c, 0x27, 0x61, 0x7d, 0x9e, 0xee, 0xcd, 0xa9, 0x6b, 0xf6, 0xdd, 0x8e, 0xdd,
+		0xf3, 0xb3, 0xaf, 0xb2, 0x36, 0x61, 0xb1, 0x88, 0x60, 0xb1, 0x88, 0x60, 0xb1, 0x08, 0x16, 0x0b,
+		0x16, 0xab, 0x98, 0x39, 0x5b, 0x02, 0x8b, 0xd5, 0xb5, 0x7f, 0x56, 0xed, 0x5b, 0x09, 0x67, 0x69,
+		0x74, 0x23, 0xec, 0x0e, 0x11, 0xec, 0x0e, 0x11, 0xec, 0x8e, 0xb9, 0x76, 0x67, 0x13, 0x76, 0x47,
+		0x74, 0xca, 0x9a, 0xb0, 0x3b, 0x2a, 0x6b, 0xda, 0x37, 0x1f, 0x77, 0x61, 0x24, 0x67, 0x77, 0xb2,
+		0x1b, 0x61, 0x77, 0x88, 0x60, 0x77, 0x88, 0x60, 0x77, 0xe0, 0xef, 0xac, 0x90, 0xdd, 0x91, 0x6e,
+		0x6a, 0x00, 0xc3, 0x43, 0x44, 0x44, 0xd6, 0x30, 0x9b, 0x48, 0xd0, 0xea, 0xf4, 0xef, 0x82, 0xc9,
+		0x21, 0x82, 0xc9, 0x29, 0xd4, 0xe4, 0x24, 0x69, 0xec, 0x05, 0xb7, 0x12, 0x36, 0x47, 0x24, 0xa7,
+		0xdc, 0x3a, 0x72, 0x83, 0xdb, 0xf4, 0x0e, 0x56, 0x47, 0xbf, 0x02, 0x35, 0x35, 0x2b, 0xa1, 0x01,
+		0xa3, 0xa3, 0xb0, 0xa6, 0x56, 0xec, 0xde, 0x7b, 0x89, 0x48, 0xd6, 0xed, 0x53, 0xa9, 0xd7, 0xd1,
+		0x9d, 0x30, 0x3e, 0x44, 0x30, 0x3e, 0x85, 0x1a, 0x1f, 0xe1, 0xf2, 0xec, 0x12, 0xe5, 0xd8, 0x57,
+		0xcb, 0xe1, 0xa9, 0xc1, 0xf4, 0x14, 0x5d, 0xee, 0x7c, 0x25, 0x4c, 0x50, 0xb9, 0x4f, 0x2f, 0xe4,
+		0x38, 0x62, 0xf4, 0x58, 0x11, 0xfb, 0x2f, 0x33, 0xde, 0x29, 0xef, 0xbb, 0x08, 0xbf, 0x83, 0xb5,
+		0x56, 0x11, 0x3c, 0xa5, 0x62, 0x55, 0xf2, 0xbd, 0xcd, 0x2b, 0x6f, 0x62, 0xc5, 0x76, 0xe4, 0xb5,
+		0xab, 0xd1, 0xfd, 0x1b, 0x0d, 0x6a, 0x9e, 0x08, 0xc2, 0xd3, 0x67, 0xd7, 0x2a, 0x6f, 0x01, 0x71,
+		0x06, 0xed, 0x9d, 0x4b, 0x01, 0xf2, 0x98, 0xfc, 0xfc, 0x26, 0x3e, 0xaf, 0x49, 0x17, 0x36, 0xe1,
+		0xc2, 0x26, 0x5b, 0xc8, 0x44, 0x8b, 0x49, 0xe1, 0xbc, 0x73, 0x13, 0xf9, 0xaa, 0xcc, 0x88, 0x54,
+		0x93, 0x11, 0x3e, 0x6c, 0x53, 0xc3, 0x61, 0x1b, 0x36, 0xe1, 0xe0, 0x51, 0xc3, 0xb9, 0x0f, 0xdb,
+		0x38, 0xa3, 0x35, 0x14, 0x74, 0x25, 0x86, 0xf7, 0x89, 0x39, 0x12, 0x75, 0x38, 0x12, 0x70, 0x24,
+		0xc4, 0x04, 0x74, 0x74, 0x59, 0x37, 0xb1, 0xd7, 0xbe, 0x75, 0xab, 0x51, 0xec, 0x85, 0xb1, 0x97,
+		0x3e, 0x88, 0xcf, 0xfe, 0x68, 0xbd, 0xa7, 0x07, 0x12, 0x9c, 0x42, 0x39, 0x26, 0x2a, 0x5d, 0x49,
+		0x4b, 0xa5, 0x82, 0x96, 0x7a, 0xe5, 0x2c, 0xd5, 0x8a, 0x59, 0x6c, 0x95, 0xb2, 0xd8, 0x2a, 0x64,
+		0xb1, 0x54, 0xc6, 0xd2, 0x7b, 0xa0, 0x5f, 0xba, 0x02, 0xd6, 0x44, 0xa0, 0x37, 0xaa, 0x4e, 0x89,
+		0x79, 0x35, 0xcd, 0x46, 0x95, 0x90, 0x00, 0xf9, 0xa6, 0x68, 0x8a, 0x55, 0x9d, 0xd4, 0x2a, 0x50,
+		0x30, 0x14, 0x79, 0x63, 0x29, 0xfd, 0xc3, 0x55, 0x95, 0x89, 0xb3, 0xae, 0xcf, 0xa3, 0x5a, 0x3d,
+		0x8e, 0xd2, 0x4d, 0xed, 0x66, 0xbd, 0xde, 0x6c, 0xd6, 0x4a, 0x34, 0xbf, 0x05, 0xd5, 0x15, 0xb9,
+		0xd2, 0x55, 0x68, 0x43, 0x80, 0x0b, 0x49, 0x1f, 0xd6, 0x7e, 0xa1, 0xb4, 0x24, 0x0f, 0x6d, 0x13,
+		0xc1, 0x30, 0x13, 0xc1, 0x30, 0x4b, 0x60, 0xa8, 0x78, 0xc3, 0x2c, 0x9a, 0xf4, 0x33, 0x2d, 0xdc,
+		0x2d, 0x58, 0x61, 0x35, 0x53, 0xd1, 0x84, 0x15, 0xd6, 0x35, 0xb5, 0x1b, 0xb0, 0xc0, 0x6c, 0xe3,
+		0x8b, 0x58, 0x60, 0x89, 0x43, 0xea, 0x2f, 0xf4, 0x92, 0xf0, 0x61, 0x75, 0x22, 0x58, 0x5d, 0x22,
+		0x58, 0x5d, 0x09, 0xdc, 0xc0, 0xea, 0xce, 0xbf, 0xe0, 0xfb, 0x6a, 0xb3, 0x0c, 0x4b, 0x66, 0x75,
+		0xeb, 0xb0, 0xba, 0x6c, 0xe3, 0x0b, 0x59, 0x5d, 0xf1, 0xc3, 0xf9, 0x2f, 0xf4, 0x92, 0xf0, 0x21,
+		0x7d, 0x95, 0xc3, 0xfa, 0x44, 0xb0, 0xd8, 0x44, 0xb0, 0xd8, 0x12, 0x98, 0x83, 0xc5, 0x9e, 0x7f,
+		0xc1, 0x62, 0x6b, 0xb3, 0x2a, 0xb0, 0xd8, 0xfa, 0xe6, 0x76, 0x85, 0x2c, 0xb6, 0x68, 0x71, 0x82,
+		0x17, 0x4a, 0x49, 0xac, 0x48, 0x01, 0x11, 0xec, 0x2d, 0x11, 0xec, 0xad, 0x04, 0x62, 0x60, 0x6f,
+		0xe7, 0x5f, 0x4b, 0x66, 0x6f, 0x37, 0x61, 0x6f, 0x75, 0x4d, 0x2d, 0x76, 0x86, 0xf9, 0xc6, 0x17,
+		0xb1, 0xb7, 0xa3, 0x4e, 0x63, 0xd2, 0xf6, 0x56, 0xae, 0x55, 0x19, 0xec, 0x2d, 0x11, 0xec, 0x6d,
+		0xc9, 0xed, 0xad, 0x9c, 0x68, 0x93, 0x5a, 0xd7, 0x3d, 0x58, 0x5c, 0x78, 0xb8, 0x85, 0x4c, 0xad,
+		0x7a, 0x57, 0x3b, 0xd8, 0x5c, 0xd1, 0x4f, 0x96, 0xa9, 0x69, 0xcf, 0xd3, 0x29, 0xa2, 0xf5, 0x4c,
+		0xd1, 0xad, 0x0f, 0x3e, 0x68, 0x15, 0x78, 0x44, 0xda, 0xcb, 0x1a, 0xce, 0x77, 0xec, 0xac, 0xbf,
+		0x8d, 0xf0, 0xc9, 0x86, 0x89, 0x7b, 0x71, 0xba, 0xa1, 0x40, 0x26, 0xb1, 0xd2, 0xa7, 0x1b, 0xc6,
+		0x42, 0x27, 0x4f, 0x97, 0x9f, 0x86, 0x90, 0x23, 0xcc, 0x75, 0x10, 0xe6, 0x22, 0xc4, 0x9c, 0x4d,
+		0xdc, 0x59, 0xc4, 0x5e, 0x4c, 0xfc, 0x05, 0x61, 0x20, 0x0d, 0x87, 0xd1, 0x25, 0x7a, 0x3a, 0x6d,
+		0xa6, 0xb8, 0x08, 0x9d, 0x56, 0x63, 0x02, 0x88, 0x32, 0x50, 0x38, 0x00, 0xc3, 0x07, 0x1c, 0x2e,
+		0x00, 0xb1, 0x03, 0x89, 0x1d, 0x50, 0xac, 0xc0, 0x92, 0x03, 0x98, 0x24, 0xd0, 0x94, 0x01, 0x37,
+		0x01, 0xbc, 0x24, 0x55, 0x5f, 0xe2, 0x27, 0xf8, 0x25, 0xa9, 0xea, 0xea, 0x32, 0xf9, 0x28, 0xaa,
+		0x60, 0xe4, 0x04, 0x25, 0x3f, 0x38, 0xb9, 0x41, 0xaa, 0x0d, 0xac, 0xda, 0x40, 0xab, 0x05, 0xbc,
+		0x6a, 0x20, 0x56, 0x04, 0xb3, 0x7a, 0xd8, 0xe9, 0xcd, 0x6d, 0x1f, 0xa1, 0x9a, 0x3c, 0x1a, 0x4e,
+		0x09, 0x32, 0x47, 0xa9, 0xa6, 0x2f, 0x1e, 0xf9, 0x27, 0xae, 0x28, 0x96, 0x26, 0xb5, 0x36, 0x33,
+		0x14, 0x53, 0x67, 0x1e, 0x97, 0x31, 0x2e, 0xc3, 0x0c, 0x0f, 0xf6, 0xa8, 0x58, 0xd1, 0x4b, 0xd5,
+		0xa8, 0x0d, 0x2f, 0x83, 0x96, 0xac, 0x52, 0x8e, 0x51, 0x16, 0xd5, 0x9d, 0x5f, 0x25, 0x70, 0x2b,
+		0x54, 0x3a, 0x76, 0xae, 0x26, 0x17, 0x28, 0x29, 0x4b, 0x04, 0x86, 0x05, 0x86, 0x45, 0x04, 0x86,
+		0x55, 0x00, 0xc3, 0xba, 0xb1, 0x13, 0xb7, 0x3a, 0x8e, 0xcc, 0x55, 0x63, 0xb7, 0xc3, 0xc8, 0xb6,
+		0xea, 0x1c, 0x74, 0xeb, 0x6c, 0x1c, 0xd2, 0x77, 0xaa, 0x5e, 0xe7, 0xe3, 0x53, 0xf4, 0x7b, 0xfa,
+		0x0f, 0xc3, 0xdf, 0xfb, 0x9a, 0xc6, 0x40, 0x7d, 0x1b, 0x85, 0x71, 0x2a, 0x5f, 0x3d, 0x66, 0xe6,
+		0x02, 0x3f, 0x1f, 0x16, 0x1a, 0x78, 0xee, 0x84, 0x41, 0x03, 0x43, 0x03, 0x17, 0xa9, 0x81, 0xb3,
+		0x5a, 0x38, 0xcf, 0x40, 0x2a, 0x5b, 0x09, 0x87, 0x31, 0xf7, 0x91, 0x08, 0x3e, 0x2f, 0x7c, 0x5e,
+		0xf8, 0xbc, 0xd4, 0x68, 0xc2, 0xdb, 0x35, 0xc7, 0xdb, 0x2d, 0x74, 0x0b, 0x43, 0x32, 0x51, 0x85,
+		0x35, 0x71, 0x65, 0x82, 0x0c, 0x3f, 0xd1, 0x60, 0x91, 0x6c, 0x16, 0xf5, 0x29, 0x94, 0x98, 0x3e,
+		0xb5, 0xd0, 0x02, 0x47, 0x48, 0x41, 0x51, 0x6d, 0x60, 0xc7, 0xb4, 0x18, 0x82, 0x8a, 0x1d, 0x53,
+		0x46, 0xe2, 0x39, 0x96, 0x17, 0xdf, 0xb5, 0x3b, 0x6a, 0x7e, 0x3e, 0x87, 0x7f, 0x3f, 0xf6, 0xeb,
+		0x3f, 0x7c, 0x18, 0x6a, 0xac, 0x75, 0x79, 0xcf, 0xbd, 0x18, 0xb5, 0x35, 0xa8, 0xec, 0xae, 0xac,
+		0xb7, 0x06, 0xc3, 0x2c, 0x38, 0xd5, 0xa3, 0x01, 0xc5, 0x05, 0xc5, 0x45, 0x84, 0x54, 0x0f, 0x05,
+		0x10, 0x22, 0x0c, 0x56, 0x24, 0x48, 0xb5, 0x81, 0x55, 0x1b, 0x68, 0xb5, 0x80, 0x57, 0x0d, 0xc4,
+		0x8a, 0x60, 0xe6, 0x63, 0x23, 0x44, 0x48, 0xf5, 0x20, 0x42, 0xd8, 0xab, 0xa4, 0x91, 0x14, 0xa4,
+		0x7a, 0x20, 0xd5, 0xc3, 0xb0, 0xe0, 0xd7, 0x9a, 0x0a, 0xc3, 0xea, 0x65, 0xd1, 0xa3, 0x84, 0x93,
+		0x65, 0x0d, 0x47, 0xe4, 0x61, 0x5a, 0x75, 0x30, 0x2d, 0x22, 0x30, 0xad, 0x55, 0x64, 0x5a, 0xaa,
+		0xee, 0xd3, 0xe8, 0xb2, 0x6e, 0xa2, 0x76, 0xaf, 0x1a, 0xbb, 0x8e, 0xeb, 0xdd, 0xbb, 0x6d, 0x3e,
+		0x19, 0x19, 0x27, 0x92, 0x3c, 0x1b, 0x9e, 0x69, 0x39, 0x79, 0xcd, 0x1c, 0x9b, 0x1a, 0xd0, 0xa1,
+		0x0e, 0xf4, 0xa9, 0x05, 0x5d, 0xea, 0x41, 0xbb, 0x9a, 0xd0, 0xae, 0x2e, 0xb4, 0xaa, 0x0d, 0x1e,
+		0xf5, 0xc1, 0xa4, 0x46, 0xf8, 0x1d, 0xb7, 0x59, 0x26, 0x7f, 0xb3, 0xc9, 0x29, 0xb2, 0x43, 0x05,
+		0xb0, 0xcd, 0x38, 0x24, 0xaf, 0x4f, 0x37, 0xba, 0x78, 0x21, 0x45, 0xba, 0x7c, 0xbc, 0xe9, 0x89,
+		0x65, 0x26, 0xf8, 0x2f, 0x1c, 0x09, 0x5d, 0xe3, 0x6b, 0x74, 0x24, 0x98, 0x11, 0xa7, 0xdd, 0x17,
+		0x2c, 0x7a, 0x49, 0xeb, 0xdb, 0xcd, 0xe6, 0xe6, 0x56, 0xb3, 0x59, 0xdb, 0xda, 0xd8, 0xaa, 0xed,
+		0xb4, 0x5a, 0xf5, 0xcd, 0x7a, 0xcb, 0xe0, 0x55, 0xae, 0x94, 0x73, 0xb4, 0xab, 0x92, 0xb8, 0xb3,
+		0x0c, 0x28, 0x18, 0x50, 0xc3, 0xc4, 0x0d, 0x52, 0x4d, 0xac, 0xb3, 0x3f, 0x34, 0x18, 0xa7, 0xf2,
+		0x64, 0x82, 0x71, 0x12, 0x81, 0x71, 0x32, 0x22, 0x9f, 0xc0, 0x38, 0xc1, 0x38, 0xa7, 0x26, 0x16,
+		0x8c, 0x53, 0x37, 0xe2, 0x88, 0xc0, 0x38, 0xcb, 0xbc, 0xca, 0x60, 0x9c, 0x1a, 0x47, 0x50, 0x0d,
+		0xb7, 0x32, 0x65, 0xf5, 0xea, 0xcb, 0xee, 0xed, 0x67, 0x8f, 0xad, 0x33, 0xed, 0xaf, 0x0c, 0x1e,
+		0x31, 0x8d, 0x7b, 0x4e, 0x3a, 0xcc, 0xc9, 0xb5, 0x2e, 0xd2, 0xe8, 0xfa, 0x4f, 0xdf, 0x0e, 0xae,
+		0x0f, 0x47, 0x5f, 0x79, 0xbd, 0x37, 0xfa, 0x32, 0x03, 0xb7, 0xb6, 0xda, 0x6e, 0xe2, 0xdd, 0x06,
+		0x76, 0xea, 0xb6, 0x47, 0x0d, 0xa7, 0xed, 0x76, 0x3b, 0x76, 0x13, 0xc6, 0xbd, 0xae, 0xd9, 0x5f,
+		0x81, 0x34, 0xa3, 0xb9, 0x93, 0x87, 0xcd, 0x2f, 0x6c, 0x7e, 0x15, 0xe0, 0x3b, 0x4c, 0xf4, 0xc8,
+		0x70, 0x98, 0xe0, 0x39, 0x09, 0xd1, 0xfa, 0x36, 0xcf, 0x41, 0xe7, 0xd4, 0x8d, 0x03, 0x36, 0x47,
+		0xc1, 0xfa, 0xcf, 0xd7, 0x5a, 0x75, 0xc7, 0xae, 0x76, 0x76, 0xab, 0x9f, 0xaf, 0x7e, 0x35, 0x1e,
+		0xdf, 0x7d, 0x7c, 0xfe, 0xfb, 0xfb, 0x5f, 0xad, 0xc7, 0x7f, 0x58, 0x2b, 0x99, 0xf0, 0xf0, 0x52,
+		0x65, 0xf3, 0x1f, 0xbc, 0x7e, 0xe3, 0x3b, 0x60, 0x17, 0xe6, 0xce, 0x1e, 0xec, 0x02, 0xec, 0x42,
+		0x91, 0x76, 0x21, 0x3b, 0x85, 0x3d, 0x05, 0x53, 0xee, 0x73, 0xd8, 0x48, 0x48, 0x5d, 0x8c, 0xa2,
+		0x9b, 0x1d, 0x57, 0x40, 0x42, 0xaa, 0x29, 0x4b, 0xb5, 0x59, 0xaf, 0x37, 0x71, 0x14, 0x7b, 0xe5,
+		0xc8, 0x19, 0xef, 0xd1, 0x9f, 0xe9, 0x81, 0x41, 0xc3, 0xe6, 0x4e, 0x19, 0x68, 0x18, 0x68, 0x58,
+		0x91, 0x34, 0x0c, 0xa7, 0x80, 0x96, 0x9d, 0x74, 0xd5, 0x40, 0xba, 0x4c, 0x59, 0xaa, 0x66, 0x63,
+		0xa7, 0xb9, 0xb3, 0xb9, 0xd5, 0xd8, 0x69, 0x81, 0x78, 0xad, 0x18, 0xf1, 0xea, 0x17, 0x24, 0x0b,
+		0x7a, 0x5d, 0x2d, 0xe4, 0x6b, 0x3c, 0x38, 0x08, 0xd8, 0xdc, 0x69, 0x03, 0x01, 0x03, 0x01, 0x2b,
+		0x9a, 0x80, 0xd5, 0x37, 0x19, 0x09, 0xd8, 0x26, 0x08, 0xd8, 0x42, 0xd4, 0xda, 0x4c, 0xab, 0x0e,
+		0x02, 0x66, 0xcc, 0x52, 0x6d, 0xb6, 0x5a, 0x1b, 0xe0, 0x5e, 0x2b, 0xc9, 0xbd, 0xb4, 0xee, 0x47,
+		0xa2, 0x26, 0xb4, 0xe0, 0xdc, 0x81, 0x85, 0x81, 0x85, 0x15, 0xc9, 0xc2, 0x50, 0x13, 0x7a, 0xd5,
+		0x58, 0x19, 0xf6, 0x22, 0x89, 0x50, 0x13, 0x1a, 0x9c, 0x8c, 0xfb, 0xfb, 0x99, 0x38, 0x59, 0x1c,
+		0x86, 0xa9, 0xd6, 0xcc, 0xe1, 0x67, 0x5f, 0x00, 0x46, 0x36, 0x77, 0xea, 0xc0, 0xc8, 0xc0, 0xc8,
+		0x8a, 0x64, 0x64, 0xc8, 0x1b, 0x46, 0xde, 0xf0, 0x4b, 0x85, 0xad, 0xd5, 0x4b, 0x7f, 0xfe, 0x0d,
+		0xb0, 0x09, 0x73, 0xe7, 0x0e, 0x36, 0x01, 0x36, 0xa1, 0x48, 0x9b, 0x80, 0x9c, 0x61, 0x22, 0xf8,
+		0xe9, 0xe5, 0x75, 0xfd, 0x90, 0x33, 0x8c, 0x9c, 0xe1, 0x15, 0x21, 0x66, 0x9d, 0x30, 0xfe, 0x61,
+		0xc7, 0xed, 0x6a, 0x1a, 0xdb, 0x41, 0xe2, 0x25, 0x5e, 0xb6, 0x44, 0x8c, 0x8e, 0xfa, 0xeb, 0xc3,
+		0x83, 0x92, 0xcd, 0x9d, 0x38, 0x50, 0x32, 0x50, 0xb2, 0x22, 0x29, 0x19, 0x67, 0x49, 0x20, 0xc6,
+		0x52, 0x40, 0xe0, 0x60, 0x9c, 0x86, 0x1d, 0x19, 0x2c, 0xc6, 0x2c, 0x95, 0xfe, 0xd2, 0x3d, 0x60,
+		0x64, 0xfa, 0xbe, 0x5f, 0x85, 0x91, 0x29, 0xf5, 0x78, 0x7c, 0xa1, 0xd7, 0x15, 0x7a, 0x3d, 0x12,
+		0x81, 0x6f, 0x11, 0x81, 0x6f, 0x11, 0x81, 0x6f, 0xb1, 0xf3, 0xad, 0x1b, 0x3b, 0x71, 0xab, 0xe3,
+		0xe2, 0x54, 0x55, 0xb5, 0xb6, 0x92, 0x9c, 0xed, 0x25, 0x9f, 0x50, 0x36, 0x2e, 0xbd, 0xe5, 0x54,
+		0xbd, 0xce, 0xc7, 0x89, 0x9a, 0x5a, 0x53, 0x7f, 0x18, 0xfe, 0x2e, 0xdf, 0x84, 0x52, 0x7d, 0x8d,
+		0x55, 0xf4, 0x2d, 0xff, 0x89, 0x0d, 0x1c, 0xd3, 0xc8, 0x3f, 0x57, 0xd0, 0xbb, 0xd0, 0xbb, 0x45,
+		0xea, 0x5d, 0x1c, 0xd3, 0x20, 0x82, 0x93, 0x5b, 0x16, 0x37, 0x09, 0xc7, 0x34, 0x70, 0x4c, 0x63,
+		0x35, 0xbc, 0x5a, 0x4d, 0x67, 0x33, 0x70, 0x20, 0x43, 0x70, 0xc2, 0xc0, 0xb7, 0xc0, 0xb7, 0x8a,
+		0xe4, 0x5b, 0x38, 0x90, 0xb1, 0x6a, 0xfc, 0x0b, 0x89, 0x1e, 0x44, 0x38, 0x90, 0x01, 0xf6, 0xc5,
+		0xfd, 0xfd, 0xca, 0xec, 0xab, 0x5f, 0x00, 0x9f, 0x99, 0x7a, 0x0d, 0xc6, 0x04, 0xef, 0x9a, 0x3b,
+		0x5b, 0xe0, 0x5d, 0xe0, 0x5d, 0x45, 0xf2, 0x2e, 0xaf, 0xed, 0x06, 0xa9, 0x97, 0x3e, 0x30, 0x6f,
+		0x2c, 0x70, 0xb0, 0xad, 0xc3, 0xe1, 0xa3, 0x7d, 0xb2, 0x13, 0x97, 0xbf, 0xb1, 0xdd, 0xc5, 0xe5,
+		0xd9, 0xf5, 0xd9, 0xe9, 0xf9, 0xe5, 0xf5, 0xc5, 0xe5, 0xee, 0xe5, 0x01, 0x97, 0x2c, 0xf7, 0x2d,
+		0x66, 0xc2, 0xda, 0x8a, 0x8a, 0x99, 0x2a, 0x8c, 0xde, 0x7f, 0xff, 0xf0, 0x62, 0xf7, 0xd3, 0xd1,
+		0xc1, 0xbe, 0x55, 0x46, 0x96, 0xa4, 0xe9, 0x9d, 0x3f, 0x1d, 0x9d, 0xee, 0xfd, 0x7e, 0x78, 0xf2,
+		0x65, 0x95, 0xde, 0xf9, 0xe8, 0x60, 0xf7, 0xfc, 0x64, 0xc5, 0xde, 0xf9, 0xf3, 0xe9, 0xf9, 0x5f,
+		0xbb, 0xe7, 0xfb, 0xab, 0xb6, 0xd2, 0x87, 0x17, 0x97, 0x07, 0xcc, 0x4b, 0xcd, 0x32, 0xd2, 0xd5,
+		0xa2, 0xad, 0xee, 0x42, 0xb8, 0x74, 0x1c, 0xfa, 0x8c, 0x2c, 0xba, 0x3f, 0x1a, 0xf8, 0xf3, 0xdc,
+		0x79, 0x02, 0x7f, 0x06, 0x7f, 0x26, 0x02, 0x7f, 0x2e, 0x8e, 0x3f, 0x9f, 0x9f, 0x1e, 0xad, 0x20,
+		0x7d, 0xfe, 0xb4, 0xbb, 0xf7, 0xfb, 0x1f, 0x67, 0xab, 0x44, 0x2f, 0xf6, 0x0f, 0x2e, 0x0e, 0xbf,
+		0x9c, 0xec, 0x5e, 0xae, 0x96, 0xcb, 0xb0, 0x7b, 0x74, 0x79, 0x70, 0x9e, 0xbd, 0xf5, 0x2a, 0xbd,
+		0xf4, 0xf9, 0xe9, 0xe9, 0x25, 0x48, 0x24, 0xd7, 0xdd, 0x8f, 0x95, 0x02, 0xa5, 0x84, 0xab, 0xbf,
+		0xaa, 0xa6, 0xbe, 0xaa, 0x56, 0xa5, 0x98, 0x19, 0x7c, 0xac, 0x68, 0x9c, 0xeb, 0x8c, 0xca, 0x49,
+		0xe6, 0xcc, 0x5b, 0x47, 0x5e, 0x92, 0xee, 0xa6, 0x69, 0x2c, 0xb5, 0x38, 0xd9, 0x3e, 0xdf, 0x81,
+		0xef, 0x66, 0x9c, 0x2c, 0xb1, 0x3e, 0x52, 0xd0, 0xf3, 0xfd, 0x35, 0x89, 0x41, 0xec, 0x9f, 0xea,
+		0x83, 0x9c, 0xc6, 0x6d, 0x37, 0x76, 0xdb, 0x9f, 0x1e, 0x86, 0x43, 0x68, 0x9d, 0x6f, 0x45, 0x99,
+		0x66, 0x97, 0x65, 0x09, 0xba, 0x33, 0xb7, 0x09, 0xb0, 0x55, 0xd1, 0x23, 0xdf, 0x8f, 0x15, 0xc6,
+		0x15, 0x91, 0x5d, 0x09, 0xa6, 0x15, 0xb0, 0x2a, 0x3c, 0x6f, 0x9c, 0xe3, 0x6d, 0x2d, 0xb1, 0x5d,
+		0xb0, 0x89, 0x3c, 0x86, 0xfc, 0x1b, 0x5d, 0x63, 0xb6, 0xbf, 0x56, 0x11, 0x73, 0xbc, 0x1b, 0x6b,
+		0x15, 0x5d, 0x0e, 0xb6, 0xbc, 0x23, 0x2d, 0xeb, 0x30, 0x2b, 0x3b, 0xc6, 0xca, 0x0e, 0xb0, 0x92,
+		0xa3, 0xcb, 0x8b, 0xaf, 0x7d, 0x4f, 0xcc, 0x32, 0x58, 0x8a, 0x3d, 0xb7, 0x9f, 0xce, 0x99, 0xa8,
+		0x34, 0xd6, 0x96, 0x8c, 0x2c, 0x49, 0x47, 0x92, 0x54, 0x22, 0x47, 0xea, 0x91, 0x22, 0xd5, 0xc8,
+		0x10, 0x5b, 0x24, 0x88, 0x2d, 0xf2, 0xc3, 0x12, 0xe9, 0xd1, 0x4b, 0xb9, 0xa4, 0x23, 0x37, 0x4c,
+		0x05, 0xe6, 0x54, 0x0a, 0xca, 0x29, 0x17, 0x90, 0xd3, 0x54, 0x30, 0xee, 0x4a, 0x17, 0xe5, 0x58,
+		0x13, 0xd6, 0x5f, 0xd2, 0x19, 0xbf, 0xd3, 0x0a, 0x4c, 0x32, 0xc7, 0x17, 0x1a, 0x0c, 0x1a, 0xac,
+		0xf4, 0x1a, 0x8c, 0xb1, 0x1c, 0x9a, 0x42, 0xf9, 0x33, 0xc5, 0x2c, 0xd8, 0x5f, 0x95, 0x85, 0x66,
+		0xb9, 0x72, 0x6d, 0x82, 0x31, 0x65, 0xb1, 0x72, 0x26, 0x42, 0xaa, 0x6c, 0x56, 0x72, 0x64, 0xa5,
+		0x72, 0x4f, 0x2d, 0x47, 0x79, 0x31, 0xd6, 0xf9, 0x2d, 0x28, 0x76, 0x55, 0x06, 0xb3, 0xcc, 0x55,
+		0x99, 0x9b, 0xb9, 0x12, 0x37, 0xcc, 0x34, 0x11, 0xcc, 0x34, 0x1c, 0x0d, 0x22, 0x38, 0x1a, 0x8a,
+		0x1a, 0x4d, 0xdd, 0xe3, 0x60, 0xaa, 0x24, 0x0d, 0x9d, 0x06, 0x9d, 0x06, 0xd7, 0x83, 0x08, 0xae,
+		0x87, 0x20, 0x3f, 0x86, 0xeb, 0x41, 0x04, 0xd7, 0x83, 0xf5, 0xae, 0x32, 0x18, 0xea, 0x61, 0x29,
+		0xe0, 0x6c, 0x4b, 0xb0, 0xed, 0xfa, 0xb6, 0x82, 0x81, 0x7e, 0x31, 0x12, 0x0c, 0x33, 0x11, 0x0c,
+		0xb3, 0x46, 0x0c, 0x15, 0x6f, 0x98, 0xb3, 0x3a, 0x45, 0xdb, 0x0a, 0x56, 0xb8, 0x05, 0x2b, 0xac,
+		0x66, 0x2a, 0x9a, 0xb0, 0xc2, 0xba, 0xa6, 0x76, 0x03, 0x16, 0x98, 0x6d, 0x7c, 0x11, 0x0b, 0x7c,
+		0xe7, 0xfa, 0x7e, 0x58, 0x4d, 0x3d, 0x89, 0xfa, 0xb2, 0x63, 0xbd, 0x34, 0x31, 0x06, 0xac, 0x2e,
+		0x11, 0xac, 0xae, 0x46, 0xdc, 0xc0, 0xea, 0xce, 0xbf, 0xe0, 0xfb, 0x6a, 0xb3, 0x0c, 0x4b, 0x66,
+		0x75, 0xeb, 0xb0, 0xba, 0x6c, 0xe3, 0x0b, 0x59, 0xdd, 0xd0, 0x6f, 0x57, 0xfb, 0xed, 0x35, 0x14,
+		0xac, 0xee, 0xd3, 0x18, 0x82, 0x3a, 0x71, 0xdf, 0xed, 0xd8, 0x3d, 0x3f, 0xfb, 0x6a, 0x6b, 0x13,
+		0x16, 0x9b, 0x08, 0x16, 0x5b, 0x27, 0xe6, 0x60, 0xb1, 0xe7, 0x5f, 0xb0, 0xd8, 0xda, 0xac, 0x0a,
+		0x2c, 0xb6, 0xbe, 0xb9, 0x5d, 0x35, 0x8b, 0xad, 0xe8, 0x26, 0x8f, 0x87, 0x80, 0xcd, 0x25, 0x82,
+		0xcd, 0xd5, 0x88, 0x1a, 0xd8, 0xdc, 0xf9, 0xd7, 0x92, 0xd9, 0xdc, 0x1a, 0x6c, 0xae, 0xae, 0xa9,
+		0x6d, 0xb4, 0x5a, 0x30, 0xba, 0x5c, 0xe3, 0x8b, 0x18, 0xdd, 0xae, 0xfd, 0xb3, 0x6a, 0xdf, 0x2a,
+		0x98, 0xdc, 0xd1, 0x00, 0x30, 0xb8, 0x44, 0x30, 0xb8, 0x1a, 0x11, 0x03, 0x83, 0x3b, 0xff, 0x5a,
+		0x32, 0x83, 0xbb, 0x09, 0x83, 0xab, 0x6b, 0x6a, 0x91, 0x8e, 0xc5, 0x37, 0xbe, 0x88, 0xbd, 0xed,
+		0xa7, 0x36, 0x3b, 0x61, 0xa2, 0x10, 0x95, 0x7e, 0x1a, 0x02, 0x36, 0x97, 0x08, 0x36, 0x57, 0x23,
+		0x6a, 0x16, 0x63, 0x73, 0x37, 0x1a, 0xc8, 0x83, 0xd6, 0x0e, 0xeb, 0x99, 0x96, 0x01, 0x5e, 0xae,
+		0xb6, 0xa9, 0x6d, 0x36, 0x76, 0x9a, 0x3b, 0x9b, 0x5b, 0x8d, 0x1d, 0x38, 0xbb, 0x6c, 0xe3, 0x0b,
+		0x1b, 0xdf, 0xac, 0x81, 0x86, 0xa2, 0xf1, 0xed, 0x0f, 0x01, 0xe3, 0x4b, 0x04, 0xe3, 0xab, 0x11,
+		0x35, 0x8b, 0x31, 0xbe, 0x52, 0x5d, 0x5a, 0x15, 0xba, 0xb2, 0xc2, 0xf8, 0xc2, 0xf8, 0x16, 0x32,
+		0xb5, 0x0c, 0x5d, 0x4f, 0x61, 0x77, 0xa5, 0xc4, 0xc3, 0xca, 0x76, 0x64, 0xab, 0x89, 0x17, 0x38,
+		0x6e, 0x35, 0x0d, 0xa3, 0xd0, 0x0f, 0x6f, 0x1f, 0xaa, 0xce, 0xdd, 0x10, 0xf7, 0x92, 0x86, 0xf8,
+		0x8d, 0x31, 0x61, 0x99, 0x89, 0x60, 0x99, 0x35, 0xe2, 0xaa, 0x78, 0xcb, 0x9c, 0x49, 0x7b, 0xea,
+		0x39, 0xdf, 0x93, 0xcd, 0xa6, 0x82, 0x79, 0xde, 0x86, 0x79, 0x56, 0xb3, 0x21, 0x30, 0xcf, 0xda,
+		0xa6, 0xb6, 0xbe, 0xdd, 0x6c, 0x6e, 0x6e, 0x35, 0x9b, 0xb5, 0xad, 0x8d, 0xad, 0xda, 0x4e, 0xab,
+		0x55, 0xdf, 0xac, 0xc3, 0x5a, 0xb3, 0x8d, 0x2f, 0x64, 0xad, 0x9f, 0x9b, 0x53, 0x85, 0x2a, 0x45,
+		0x2f, 0x46, 0x82, 0x65, 0x26, 0x82, 0x65, 0xd6, 0x88, 0xa1, 0xe2, 0x2d, 0x73, 0xff, 0x78, 0x80,
+		0x1b, 0xc3, 0x2e, 0x17, 0x80, 0xec, 0x99, 0xc6, 0x03, 0x76, 0x99, 0x08, 0x76, 0x79, 0xc9, 0xed,
+		0x72, 0x56, 0xbd, 0xbf, 0xea, 0xb5, 0xe5, 0xcd, 0xf1, 0x68, 0x00, 0x58, 0x61, 0x22, 0x58, 0x61,
+		0x8d, 0x88, 0x29, 0xde, 0x0a, 0xcb, 0x89, 0x36, 0x21, 0x74, 0x8d, 0x13, 0x49, 0x06, 0xd8, 0xe0,
+		0x66, 0x6d, 0xa7, 0x09, 0x9b, 0xcb, 0x35, 0xbe, 0xf9, 0x2d, 0x7c, 0x04, 0x7a, 0x7f, 0xf1, 0x74,
+		0xef, 0x11, 0xa5, 0x1e, 0x92, 0x7a, 0x59, 0x10, 0x37, 0xc2, 0x14, 0x03, 0x1d, 0x7c, 0x0a, 0xa0,
+		0x0c, 0xbc, 0xf0, 0x12, 0xa6, 0x06, 0xe3, 0xf5, 0xf2, 0x5d, 0xbb, 0x23, 0xd6, 0x46, 0x76, 0x5c,
+		0x2b, 0x58, 0x20, 0x87, 0xcc, 0x3a, 0x1b, 0x22, 0xf8, 0xc3, 0x87, 0xf5, 0x01, 0x7e, 0xd7, 0x47,
+		0x32, 0xcf, 0x85, 0xcf, 0x8a, 0xc2, 0x3c, 0x8e, 0x7a, 0xeb, 0xe5, 0xc3, 0xa1, 0x58, 0x3b, 0x3d,
+		0xa9, 0xf6, 0x79, 0x52, 0xed, 0xf2, 0xc4, 0xda, 0xe3, 0xcd, 0x9b, 0x12, 0x41, 0x8d, 0xad, 0xa2,
+		0xa9, 0xad, 0xb5, 0x8a, 0x64, 0x27, 0x3b, 0xab, 0x22, 0x27, 0x13, 0x8f, 0x15, 0x81, 0x29, 0xc9,
+		0x3b, 0x15, 0x92, 0x53, 0x60, 0x55, 0xf2, 0x3d, 0xe1, 0x2b, 0x4f, 0x67, 0xc5, 0x99, 0xce, 0x99,
+		0xf5, 0x4c, 0x4f, 0xd9, 0x58, 0xb3, 0x35, 0xf2, 0x9c, 0x6e, 0x70, 0x73, 0x6d, 0x47, 0x1e, 0x5b,
+		0x91, 0xdf, 0x36, 0xe4, 0xb5, 0x05, 0xc2, 0xba, 0x5f, 0x58, 0xd7, 0x0b, 0xe9, 0x76, 0x31, 0x69,
+		0x9a, 0xd7, 0x6d, 0xcd, 0x72, 0x46, 0x73, 0x3e, 0x67, 0x12, 0x9e, 0x62, 0xab, 0xfd, 0xcf, 0xcf,
+		0x53, 0x71, 0xb9, 0xda, 0xfe, 0xe5, 0x26, 0x0b, 0x22, 0x24, 0x41, 0x9c, 0x1c, 0x88, 0x92, 0x02,
+		0x69, 0x32, 0x20, 0x4d, 0x02, 0xa4, 0x8c, 0xbf, 0x9a, 0x91, 0xca, 0xdb, 0xa6, 0x4f, 0xba, 0xbd,
+		0x95, 0x62, 0x5b, 0x2b, 0xf0, 0x52, 0x02, 0x2f, 0xe5, 0xe2, 0xa5, 0x0c, 0xb5, 0xdf, 0x25, 0xce,
+		0x3a, 0x48, 0xc6, 0xaa, 0xe4, 0x9a, 0x2a, 0x2b, 0x44, 0x4f, 0x95, 0x02, 0x27, 0xaa, 0xb1, 0x28,
+		0x8e, 0x28, 0xc9, 0xa3, 0x5c, 0x0b, 0xe9, 0x85, 0x4f, 0x99, 0x4a, 0x8d, 0x76, 0x96, 0x79, 0xd3,
+		0x14, 0xed, 0xb9, 0x2a, 0x30, 0x4c, 0x22, 0x5d, 0x6b, 0x5d, 0xb5, 0xc6, 0x3a, 0x0c, 0x14, 0xc1,
+		0x40, 0x71, 0x19, 0x28, 0xd1, 0x63, 0xef, 0x12, 0xc7, 0xdd, 0x57, 0xcb, 0x1a, 0x35, 0x61, 0x8d,
+		0x8a, 0xaa, 0x55, 0x0e, 0x4b, 0x44, 0x24, 0x59, 0x73, 0x5c, 0xbe, 0xd6, 0x38, 0xac, 0x0f, 0xc1,
+		0xfa, 0x10, 0xc1, 0xfa, 0x94, 0xd2, 0xfa, 0xc0, 0x17, 0x12, 0x9f, 0x32, 0x58, 0x1f, 0x95, 0x35,
+		0x95, 0xa9, 0xbd, 0x2d, 0x5f, 0x73, 0x5b, 0xa6, 0xd6, 0x36, 0x2c, 0x16, 0xc1, 0x62, 0x11, 0xc1,
+		0x62, 0x11, 0xc1, 0x62, 0x49, 0x48, 0x0b, 0x11, 0x2c, 0x96, 0x20, 0x0e, 0xcb, 0x6d, 0xb1, 0x44,
+		0xcb, 0x60, 0x4a, 0x96, 0xbf, 0x84, 0xdd, 0x21, 0xd8, 0x1d, 0x22, 0xd8, 0x9d, 0x52, 0xda, 0x9d,
+		0x4d, 0xd8, 0x1d, 0xd1, 0x29, 0xc3, 0x8e, 0x91, 0xec, 0x27, 0x16, 0x9a, 0xa5, 0x96, 0xfd, 0xcf,
+		0xe0, 0xbf, 0x4a, 0xe7, 0x99, 0xcd, 0x7e, 0x7c, 0xcb, 0xcb, 0x0e, 0x44, 0x76, 0x6c, 0x27, 0xc7,
+		0xd1, 0xe1, 0xb1, 0x32, 0x9b, 0xb8, 0x07, 0xe9, 0x3e, 0x48, 0xf7, 0x99, 0x12, 0x24, 0x71, 0x5e,
+		0xf6, 0x74, 0xab, 0x18, 0x33, 0xab, 0x83, 0x99, 0x81, 0x99, 0x89, 0x89, 0xe9, 0xe8, 0xca, 0x9b,
+		0xde, 0x38, 0x73, 0x99, 0x73, 0xa5, 0x3b, 0x2a, 0x0a, 0xae, 0xb4, 0x00, 0xab, 0x08, 0xb2, 0xba,
+		0x40, 0xab, 0x0a, 0x36, 0x9b, 0x80, 0xb3, 0x09, 0x3a, 0x8b, 0xc0, 0x8b, 0x09, 0xbe, 0x20, 0x00,
+		0xa4, 0x81, 0x30, 0x01, 0x88, 0x24, 0x95, 0xba, 0x73, 0x0a, 0x16, 0xc2, 0xa5, 0xae, 0xa7, 0xc1,
+		0x21, 0xcb, 0x52, 0x65, 0x41, 0xc2, 0x01, 0x16, 0x3e, 0xd0, 0x70, 0x81, 0x87, 0x1d, 0x44, 0xec,
+		0x60, 0x62, 0x05, 0x95, 0x1c, 0xb8, 0x24, 0x41, 0x26, 0x1f, 0x0f, 0x78, 0x33, 0x3e, 0x20, 0x55,
+		0x4a, 0x5b, 0x21, 0xcd, 0x94, 0x29, 0x80, 0x30, 0x7d, 0xa9, 0xc9, 0x2b, 0x71, 0x1d, 0x99, 0x66,
+		0x52, 0x2b, 0x33, 0xbd, 0xe9, 0x3a, 0xd3, 0x78, 0x8c, 0x87, 0x7d, 0x15, 0xc5, 0x99, 0x2d, 0x60,
+		0x51, 0xd4, 0x12, 0x34, 0x6a, 0xc3, 0xab, 0xc4, 0x4b, 0x51, 0x59, 0xcc, 0xdd, 0x57, 0x05, 0x9d,
+		0xfb, 0x96, 0x89, 0x85, 0x0d, 0x8f, 0xcf, 0x29, 0x32, 0x8d, 0xfe, 0x28, 0x60, 0x1a, 0x60, 0x1a,
+		0x44, 0x60, 0x1a, 0x02, 0xf2, 0x72, 0x63, 0x27, 0x6e, 0x75, 0x1c, 0x71, 0xa9, 0x8a, 0x9d, 0xba,
+		0xe6, 0x38, 0x85, 0xfd, 0x12, 0x0d, 0xe3, 0x38, 0xa8, 0x53, 0xf5, 0x3a, 0x1f, 0x9f, 0xa2, 0x8b,
+		0xd3, 0x7f, 0x18, 0xfe, 0xde, 0x47, 0x7e, 0x89, 0xf5, 0x5b, 0xd6, 0x71, 0x40, 0xfc, 0xd8, 0xdb,
+		0xcc, 0x05, 0x7b, 0x3e, 0x1c, 0x34, 0x1e, 0x34, 0x1e, 0x34, 0x9e, 0xf0, 0x21, 0xbe, 0x67, 0x20,
+		0x12, 0x3d, 0xc2, 0xc7, 0xb0, 0x39, 0x4b, 0x04, 0x5f, 0x4b, 0x9e, 0xe8, 0xc3, 0xd7, 0x5a, 0xf8,
+		0x12, 0x34, 0x9a, 0xf0, 0xb2, 0x16, 0xe7, 0x65, 0x69, 0x0d, 0x19, 0x4b, 0x56, 0xc5, 0x92, 0xdf,
+		0xcd, 0x9e, 0x60, 0x78, 0x4f, 0xdc, 0x2e, 0xcf, 0x16, 0xb7, 0xfc, 0xbc, 0x08, 0xcc, 0x89, 0x9c,
+		0x7f, 0xaa, 0xe2, 0x97, 0xa2, 0x6a, 0xe7, 0x42, 0x58, 0x17, 0xaa, 0x76, 0xea, 0x2d, 0xd1, 0xc5,
+		0xe1, 0x24, 0xbe, 0x52, 0xb2, 0x4b, 0xdc, 0xfd, 0xd3, 0xa3, 0x26, 0x06, 0x85, 0xfd, 0xa4, 0xf5,
+		0xc4, 0xe0, 0xf6, 0x82, 0xf7, 0x8f, 0x1b, 0x50, 0x14, 0x50, 0x14, 0x44, 0xd8, 0x3f, 0x7e, 0x0b,
+		0x24, 0x88, 0x71, 0xe8, 0x00, 0x11, 0x3b, 0x98, 0x58, 0x41, 0x25, 0x07, 0x2e, 0x49, 0x90, 0xa9,
+		0x5b, 0x65, 0x22, 0xec, 0x1f, 0x13, 0x21, 0xa6, 0x81, 0x98, 0x06, 0x11, 0xf6, 0x8f, 0x4d, 0x8e,
+		0x6c, 0xac, 0xc9, 0x30, 0x8d, 0x7e, 0x17, 0x9f, 0x84, 0x83, 0x6d, 0x0c, 0x47, 0x52, 0x63, 0x1c,
+		0x75, 0x30, 0x0e, 0x30, 0x8e, 0x62, 0x31, 0x2a, 0xcb, 0x38, 0x64, 0xe9, 0xfd, 0xe8, 0xb2, 0x6e,
+		0xa2, 0x76, 0xaf, 0x1a, 0xbb, 0x8e, 0xeb, 0xdd, 0xbb, 0x6d, 0xf5, 0xb5, 0x1e, 0xef, 0x4a, 0x3f,
+		0x1b, 0x76, 0xad, 0x14, 0x1d, 0x1d, 0x54, 0xe1, 0xc9, 0x09, 0x53, 0x7e, 0xb8, 0x72, 0xc3, 0x56,
+		0x1b, 0x7c, 0xb5, 0xc1, 0x58, 0x0b, 0x9c, 0xd5, 0x60, 0xad, 0x08, 0x6f, 0x3e, 0xc7, 0x62, 0x96,
+		0xa9, 0x94, 0x6a, 0x9d, 0xc7, 0xd8, 0x4a, 0x4f, 0x93, 0xcf, 0x31, 0xba, 0x78, 0x20, 0x40, 0xdc,
+		0x3e, 0x08, 0xb3, 0x66, 0x9b, 0x49, 0x88, 0xb9, 0xc7, 0xd5, 0x40, 0x88, 0x99, 0x10, 0xa2, 0xcd,
+		0x57, 0x29, 0x6a, 0xa9, 0xf8, 0x5b, 0xfd, 0x15, 0xba, 0x7a, 0x95, 0x72, 0x8c, 0x72, 0xb5, 0x20,
+		0xf7, 0x4a, 0x41, 0x7a, 0x07, 0x54, 0x29, 0x71, 0x83, 0x94, 0x99, 0x7d, 0xf5, 0x87, 0x04, 0xf3,
+		0x9a, 0x3b, 0x59, 0x60, 0x5e, 0x60, 0x5e, 0x44, 0x60, 0x5e, 0x60, 0x5e, 0x8c, 0xe6, 0x1c, 0xcc,
+		0x8b, 0x08, 0xcc, 0xab, 0x88, 0xd5, 0x5b, 0x75, 0xe6, 0x55, 0x68, 0xb8, 0x4d, 0x31, 0xb5, 0x8e,
+		0x39, 0xc5, 0xae, 0x9f, 0x72, 0xb2, 0xae, 0x18, 0xf4, 0xa6, 0xd7, 0xfb, 0xa0, 0x9d, 0x27, 0x69,
+		0x74, 0x7d, 0x38, 0xfa, 0xaa, 0xeb, 0xbd, 0xd1, 0x97, 0x94, 0x78, 0x1f, 0xa1, 0xed, 0x26, 0xde,
+		0x6d, 0x60, 0xa7, 0x6e, 0x7b, 0xd4, 0xe3, 0xc5, 0x6e, 0xb7, 0x63, 0x37, 0x61, 0xd8, 0x58, 0x98,
+		0x3d, 0x34, 0x72, 0x1b, 0xb0, 0xd3, 0x20, 0xa2, 0x40, 0x90, 0xdb, 0x60, 0x75, 0x6d, 0x47, 0x11,
+		0x3e, 0x93, 0x10, 0xaa, 0x6f, 0xab, 0x1d, 0x55, 0x4b, 0xdd, 0x38, 0x50, 0x66, 0xbd, 0xd6, 0x7f,
+		0xbe, 0xd6, 0xaa, 0x3b, 0x76, 0xb5, 0xb3, 0x5b, 0xfd, 0x7c, 0xf5, 0xab, 0xf1, 0xf8, 0xee, 0xe3,
+		0xf3, 0xdf, 0xdf, 0xff, 0x6a, 0x3d, 0xfe, 0xc3, 0x5a, 0xca, 0xdd, 0xdb, 0x97, 0xaa, 0x91, 0xef,
+		0xa8, 0xdc, 0x1b, 0x63, 0x43, 0xef, 0x42, 0xef, 0x12, 0x41, 0xef, 0x0a, 0x9e, 0x9b, 0x53, 0x6c,
+		0x7e, 0x47, 0x84, 0x2c, 0xb3, 0x22, 0x14, 0xcd, 0x6c, 0x67, 0x15, 0x59, 0x66, 0x8b, 0x5e, 0x02,
+		0x95, 0x66, 0x7d, 0x85, 0xac, 0x03, 0x52, 0xcc, 0xa6, 0xaf, 0x49, 0x22, 0xc1, 0x93, 0xd7, 0x3e,
+		0x3d, 0x20, 0xe8, 0x08, 0xe8, 0x08, 0x11, 0xe8, 0x08, 0x11, 0x52, 0xdc, 0x97, 0x96, 0x7c, 0xd4,
+		0x40, 0x3e, 0x16, 0xbd, 0x04, 0xcd, 0xc6, 0x4e, 0x73, 0x67, 0x73, 0xab, 0xb1, 0xd3, 0x02, 0x01,
+		0x31, 0x94, 0x80, 0xf4, 0x4b, 0x97, 0x04, 0xbd, 0x2e, 0x2b, 0x09, 0x19, 0x0f, 0x0a, 0x22, 0x02,
+		0x22, 0x42, 0x04, 0x22, 0x22, 0x48, 0x44, 0xea, 0x9b, 0x0c, 0x44, 0x64, 0x13, 0x44, 0x84, 0x55,
+		0xad, 0xcc, 0xb4, 0x82, 0x20, 0x22, 0x0b, 0x5f, 0x82, 0xcd, 0x56, 0x6b, 0x03, 0x1c, 0xc4, 0x68,
+		0x0e, 0xa2, 0x65, 0x9f, 0x06, 0xd5, 0x0d, 0xa7, 0xe6, 0x06, 0x6c, 0x84, 0x08, 0x6c, 0x44, 0x6c,
+		0x97, 0x06, 0xd5, 0x0d, 0x4d, 0x66, 0x27, 0xd8, 0xa3, 0x21, 0x42, 0x75, 0x43, 0x6e, 0xcd, 0xb4,
+		0x32, 0xdc, 0x24, 0x0e, 0xc3, 0x54, 0x4b, 0xe6, 0xde, 0xb3, 0x81, 0xc1, 0x4c, 0xc0, 0x4c, 0x88,
+		0xc0, 0x4c, 0x88, 0x90, 0xb7, 0xb7, 0xea, 0x79, 0x7b, 0x7d, 0xc5, 0xa8, 0xc5, 0x1b, 0x7c, 0x3e,
+		0x32, 0x74, 0x2e, 0x74, 0x2e, 0x11, 0x74, 0xae, 0xa0, 0x37, 0x88, 0x9c, 0x3d, 0x22, 0xf8, 0x83,
+		0xf0, 0x07, 0x89, 0x90, 0xb3, 0xc7, 0x7c, 0x77, 0x99, 0x09, 0x4a, 0x27, 0x8c, 0x7f, 0xd8, 0x71,
+		0xbb, 0x9a, 0xc6, 0x76, 0x90, 0x78, 0x89, 0x97, 0x4d, 0x39, 0x83, 0x43, 0xf8, 0xfa, 0xb0, 0xa0,
+		0x26, 0xa0, 0x26, 0x44, 0xa0, 0x26, 0x02, 0xf2, 0xc2, 0x51, 0xc7, 0x80, 0xa1, 0x7e, 0x01, 0xb8,
+		0x88, 0x88, 0x21, 0xc4, 0xce, 0xf9, 0xc2, 0x97, 0x40, 0x5f, 0xbd, 0x01, 0x30, 0x13, 0x34, 0x3c,
+		0x25, 0x02, 0xef, 0x20, 0x02, 0xef, 0xd0, 0x85, 0x4d, 0x34, 0x3c, 0x7d, 0x05, 0x0d, 0xcb, 0xd8,
+		0xf0, 0x94, 0x25, 0x43, 0x19, 0x69, 0xc9, 0xd0, 0x73, 0xd0, 0x73, 0x44, 0x48, 0x4b, 0x26, 0x82,
+		0x73, 0xb5, 0x48, 0x1a, 0x8f, 0xb4, 0xe4, 0x52, 0x2c, 0x03, 0xbc, 0xa9, 0xe9, 0x0b, 0xed, 0xd5,
+		0x89, 0xc0, 0x3b, 0x88, 0xc0, 0x3b, 0x64, 0x11, 0x44, 0x48, 0x40, 0xce, 0x79, 0x61, 0xc3, 0x79,
+		0xe1, 0x06, 0x10, 0x09, 0xc8, 0x25, 0x58, 0x04, 0xb0, 0x90, 0xe9, 0x6b, 0x40, 0x1b, 0xe4, 0x5a,
+		0x00, 0xbf, 0xd0, 0xa0, 0x13, 0x63, 0x81, 0x7f, 0x80, 0x7f, 0x10, 0x81, 0x7f, 0x08, 0xc8, 0x8b,
+		0xd7, 0x76, 0x83, 0xd4, 0x4b, 0x1f, 0x98, 0x02, 0xbb, 0x2a, 0xac, 0xe3, 0x70, 0xf8, 0x28, 0x9f,
+		0xec, 0xc4, 0xe5, 0xeb, 0xce, 0x71, 0x71, 0x79, 0x76, 0x7d, 0x76, 0x7a, 0x7e, 0x79, 0x7d, 0x71,
+		0xb9, 0x7b, 0x79, 0xa0, 0x2a, 0x83, 0x7d, 0x8b, 0x93, 0x28, 0x53, 0x23, 0x62, 0xab, 0xd9, 0x3f,
+		0x7e, 0xcf, 0xfd, 0xc3, 0x8b, 0xdd, 0x4f, 0x47, 0x07, 0xfb, 0x56, 0x19, 0x58, 0x03, 0xf3, 0xbb,
+		0x7d, 0x3a, 0x3a, 0xdd, 0xfb, 0xfd, 0xf0, 0xe4, 0xcb, 0x32, 0xbe, 0xdb, 0xd1, 0xc1, 0xee, 0xf9,
+		0xc9, 0x92, 0xbe, 0xdb, 0xe7, 0xd3, 0xf3, 0xbf, 0x76, 0xcf, 0xf7, 0x97, 0x75, 0xe5, 0x0e, 0x2f,
+		0x2e, 0x0f, 0x98, 0x96, 0x4e, 0x69, 0x84, 0xab, 0xa2, 0xad, 0x56, 0x21, 0x1c, 0x31, 0x0e, 0x7d,
+		0x06, 0x76, 0xd8, 0x1f, 0x05, 0xbc, 0x10, 0xbc, 0x90, 0x08, 0xbc, 0x90, 0x08, 0xbc, 0xf0, 0x05,
+		0x2f, 0x3c, 0x3f, 0x3d, 0x5a, 0x62, 0x5a, 0xf8, 0x69, 0x77, 0xef, 0xf7, 0x3f, 0xce, 0x96, 0xd1,
+		0xfc, 0xee, 0x1f, 0x5c, 0x1c, 0x7e, 0x39, 0xd9, 0xbd, 0x5c, 0x4e, 0xca, 0xbb, 0x7b, 0x74, 0x79,
+		0x70, 0x9e, 0xbd, 0xdd, 0x32, 0xbe, 0xdc, 0xf9, 0xe9, 0xe9, 0x25, 0x48, 0x13, 0xef, 0x1d, 0x82,
+		0xab, 0xac, 0xda, 0x5c, 0x89, 0xb3, 0xa9, 0x92, 0x55, 0xd1, 0x33, 0x2d, 0x8f, 0x15, 0xc6, 0x89,
+		0xcb, 0x78, 0x8b, 0x60, 0x22, 0xa9, 0x75, 0xe4, 0x25, 0xe9, 0x6e, 0x9a, 0x8a, 0x35, 0x8d, 0xcf,
+		0x36, 0x3d, 0x0e, 0x7c, 0x37, 0x23, 0x20, 0x89, 0xf5, 0x91, 0x82, 0x9e, 0xef, 0xaf, 0x09, 0xdc,
+		0x6c, 0xff, 0x94, 0xbf, 0xf9, 0x34, 0x6e, 0xbb, 0xb1, 0xdb, 0xfe, 0xf4, 0x30, 0xbc, 0x95, 0x75,
+		0xfe, 0x24, 0x05, 0x8e, 0x47, 0xd0, 0x04, 0x0c, 0xfc, 0xdc, 0xf6, 0x5c, 0x56, 0x85, 0x47, 0xf8,
+		0x1e, 0x2b, 0x0a, 0xd3, 0x2a, 0x3a, 0x9d, 0x2a, 0xd3, 0x68, 0x55, 0xe4, 0x5e, 0xe3, 0x8d, 0x57,
+		0xb0, 0xf2, 0x05, 0xff, 0x27, 0xb6, 0x49, 0xe7, 0xc7, 0xf7, 0xc7, 0x64, 0x73, 0xad, 0x92, 0xcf,
+		0x2f, 0x6b, 0xac, 0x55, 0xb8, 0xfc, 0x2f, 0x71, 0x3f, 0x4b, 0xd4, 0x9f, 0x92, 0xf6, 0x9b, 0xa4,
+		0xfd, 0x23, 0x29, 0x3f, 0x48, 0x4d, 0xa8, 0xf7, 0xbd, 0x7c, 0xba, 0xd2, 0x1a, 0x9c, 0xd2, 0xce,
+		0x3f, 0x79, 0xe3, 0x74, 0xe6, 0xc1, 0x7d, 0x79, 0x55, 0xbe, 0x50, 0x00, 0x40, 0xd8, 0xe1, 0x97,
+		0x71, 0xf0, 0x9f, 0x04, 0xed, 0x3e, 0x11, 0xf4, 0xe8, 0x65, 0x3d, 0x78, 0x65, 0x8f, 0x5d, 0xd9,
+		0x43, 0x7f, 0x2e, 0x89, 0xc3, 0x17, 0x5f, 0x10, 0x0d, 0x10, 0x76, 0xb1, 0x27, 0x74, 0x58, 0xec,
+		0x05, 0xb7, 0x22, 0x0b, 0x36, 0x2e, 0xde, 0xc2, 0x65, 0x71, 0xd6, 0xf2, 0x22, 0x4b, 0xb8, 0x24,
+		0xd4, 0x14, 0xc2, 0x04, 0x4b, 0xd7, 0x94, 0x1b, 0x69, 0xce, 0x8a, 0x02, 0xcd, 0x31, 0x13, 0x67,
+		0x72, 0x95, 0x93, 0x64, 0x2a, 0x25, 0x49, 0x57, 0x46, 0x62, 0xae, 0x84, 0x74, 0x55, 0xbc, 0x7e,
+		0x10, 0x4e, 0x21, 0x9d, 0x56, 0x10, 0x82, 0x49, 0xa3, 0xd0, 0x10, 0xd0, 0x10, 0x6c, 0x1a, 0x82,
+		0xa1, 0xce, 0x8f, 0x44, 0x5d, 0x1f, 0xc9, 0xb4, 0xca, 0x5f, 0x95, 0x42, 0xd3, 0x26, 0x55, 0x77,
+		0xbd, 0x14, 0xd3, 0x22, 0x39, 0x32, 0xf0, 0x64, 0x76, 0x1b, 0x55, 0xd2, 0x1c, 0xb9, 0xa6, 0x4c,
+		0xa5, 0x6e, 0x0e, 0xcb, 0xbc, 0x69, 0x8a, 0xc3, 0x15, 0x69, 0x9e, 0x54, 0x4b, 0x9b, 0x32, 0x95,
+		0x32, 0x85, 0xb9, 0x22, 0x98, 0x2b, 0x2e, 0x73, 0x05, 0x42, 0x5b, 0xa4, 0xc6, 0x90, 0x67, 0xb6,
+		0x8a, 0xa5, 0x38, 0xa1, 0x33, 0xa0, 0x33, 0x88, 0x40, 0x71, 0x89, 0x40, 0x71, 0x99, 0x04, 0x88,
+		0x08, 0x14, 0x57, 0x02, 0x9e, 0xe2, 0x9f, 0x2e, 0xd2, 0x60, 0x0d, 0x6b, 0x2a, 0x66, 0x3b, 0x76,
+		0x6d, 0xd7, 0xb7, 0x25, 0x0c, 0xd5, 0x8b, 0x11, 0x60, 0xa0, 0x88, 0x60, 0xa0, 0x0a, 0x35, 0x50,
+		0x59, 0xc1, 0x8d, 0x6d, 0x09, 0x6b, 0xd4, 0x82, 0x35, 0x7a, 0x5d, 0xb5, 0x36, 0x61, 0x8d, 0x44,
+		0xa7, 0x6c, 0x03, 0x96, 0x48, 0x65, 0x4d, 0xad, 0x3b, 0xd7, 0xf7, 0xc3, 0x6a, 0xea, 0x75, 0x25,
+		0x76, 0xe2, 0x27, 0xee, 0x85, 0xf5, 0x21, 0x82, 0xf5, 0x21, 0x82, 0xf5, 0x81, 0x2f, 0xb4, 0x42,
+		0xd6, 0xa7, 0x0e, 0xeb, 0xa3, 0xb2, 0xa6, 0xd6, 0x5d, 0xe8, 0xb7, 0xab, 0xfd, 0xba, 0xd8, 0x12,
+		0xd6, 0xe7, 0xe9, 0xde, 0x9c, 0xba, 0x66, 0xdf, 0xed, 0xd8, 0x3d, 0x3f, 0xfb, 0x2a, 0x6b, 0x13,
+		0x16, 0x8b, 0x08, 0x16, 0x8b, 0x08, 0x16, 0x8b, 0x60, 0xb1, 0x60, 0xb1, 0x8a, 0x99, 0xb3, 0x65,
+		0xb1, 0x58, 0x92, 0xee, 0xd2, 0xf8, 0x56, 0xd8, 0x1e, 0x22, 0xd8, 0x1e, 0x22, 0xd8, 0x1e, 0x73,
+		0x6d, 0x4f, 0x0d, 0xb6, 0x47, 0x74, 0xca, 0x1a, 0xad, 0x16, 0x8c, 0x8f, 0xc2, 0xa2, 0x5a, 0x5d,
+		0xfb, 0x67, 0xd5, 0x96, 0x39, 0x33, 0x33, 0xba, 0x11, 0x86, 0x87, 0x08, 0x86, 0x87, 0x08, 0x86,
+		0xc7, 0x5c, 0xc3, 0xb3, 0x09, 0xc3, 0x23, 0x3a, 0x65, 0x48, 0x57, 0x50, 0x5a, 0x53, 0xab, 0x9f,
+		0x0a, 0xe7, 0x84, 0x89, 0x44, 0x94, 0xee, 0xe9, 0x56, 0xd8, 0x1e, 0x22, 0xd8, 0x9e, 0xc2, 0x6d,
+		0xcf, 0x46, 0x03, 0xf9, 0x72, 0xf0, 0x7a, 0x16, 0x38, 0x65, 0xcd, 0xc6, 0x4e, 0x73, 0x67, 0x73,
+		0xab, 0xb1, 0x03, 0xe7, 0x47, 0x65, 0x6d, 0x07, 0x96, 0x24, 0xab, 0x14, 0x2d, 0x69, 0x84, 0xfa,
+		0xb7, 0xc2, 0x08, 0x11, 0xc1, 0x08, 0x15, 0x6e, 0x84, 0x84, 0xda, 0x52, 0x49, 0xb4, 0xa1, 0x82,
+		0x11, 0x2a, 0x4a, 0x91, 0x9a, 0x9a, 0xb4, 0x2d, 0xdf, 0xe6, 0x09, 0xf6, 0x87, 0x88, 0x88, 0xac,
+		0x6c, 0xe7, 0xa6, 0x9a, 0x78, 0x81, 0xe3, 0x56, 0xd3, 0x30, 0x0a, 0xfd, 0xf0, 0xf6, 0xa1, 0xea,
+		0xdc, 0x0d, 0x71, 0x27, 0x68, 0x90, 0xde, 0x18, 0x0b, 0x16, 0x8a, 0x08, 0x16, 0xaa, 0x50, 0x0b,
+		0x95, 0x49, 0x63, 0xea, 0x39, 0xdf, 0x13, 0xa1, 0xd6, 0xf4, 0x12, 0xad, 0xe8, 0x61, 0xa6, 0x8a,
+		0xd2, 0xb7, 0xa6, 0x66, 0x27, 0xb0, 0xb5, 0x7a, 0x87, 0xd5, 0x22, 0x22, 0x22, 0x6b, 0xca, 0xbc,
+		0x48, 0x9c, 0xa2, 0x7f, 0x31, 0x02, 0x2c, 0x14, 0x11, 0x2c, 0x54, 0xa1, 0x16, 0xaa, 0x9f, 0xe6,
+		0xe9, 0xc6, 0xb0, 0x4f, 0x04, 0xfb, 0x44, 0x04, 0xfb, 0x24, 0x82, 0xce, 0x05, 0xda, 0xa7, 0x72,
+		0x57, 0xc7, 0xcd, 0x51, 0xb8, 0xfa, 0xb1, 0x22, 0xf6, 0x5f, 0x66, 0xbc, 0x53, 0xde, 0x77, 0x11,
+		0x7e, 0x07, 0x6b, 0xad, 0x22, 0x58, 0x03, 0xd9, 0xaa, 0xe4, 0x7b, 0x9b, 0xc7, 0xca, 0x1b, 0xef,
+		0x35, 0xef, 0x7d, 0x44, 0xde, 0xe3, 0x95, 0x57, 0x78, 0xf9, 0xe8, 0x56, 0xe5, 0xf5, 0x67, 0x9b,
+		0x78, 0x2e, 0x2b, 0x79, 0x48, 0x52, 0xb7, 0xfb, 0xe2, 0x79, 0x9e, 0xca, 0x29, 0x0c, 0xfe, 0xfb,
+		0x5a, 0xe5, 0x35, 0xc5, 0x30, 0x95, 0x49, 0x3c, 0x93, 0x8d, 0xbc, 0xc5, 0x3a, 0x9e, 0xb1, 0x8b,
+		0x87, 0xd7, 0x78, 0xd2, 0x3c, 0x16, 0x91, 0x9b, 0x2d, 0xe4, 0x66, 0x05, 0x2f, 0xac, 0xff, 0xc3,
+		0x2b, 0xa5, 0xa0, 0xdf, 0x5e, 0xeb, 0x59, 0x05, 0x7d, 0x2d, 0xdb, 0xb6, 0x67, 0xbf, 0xc9, 0x68,
+		0x2e, 0xb2, 0x0f, 0xcd, 0x78, 0xb4, 0xb7, 0x6b, 0x3d, 0xcf, 0x25, 0x84, 0x79, 0x08, 0xe0, 0xe4,
+		0x92, 0xcc, 0x7e, 0x12, 0x12, 0x20, 0x78, 0xc2, 0x84, 0x4e, 0x98, 0xc0, 0x4d, 0x2f, 0x59, 0xf6,
+		0xdc, 0x4c, 0x6a, 0x68, 0x5e, 0x6d, 0x66, 0xcb, 0x76, 0xfa, 0x4c, 0x2b, 0xab, 0x71, 0x9b, 0xbb,
+		0xae, 0xf7, 0xc4, 0x3d, 0xcc, 0xc5, 0xbd, 0x6b, 0x7a, 0x8a, 0x7b, 0xbf, 0x2d, 0x08, 0xa2, 0x02,
+		0x21, 0x2d, 0x18, 0xd2, 0x02, 0x22, 0x25, 0x28, 0x3c, 0x36, 0x39, 0x77, 0x71, 0x6f, 0x67, 0xb4,
+		0x86, 0x82, 0xce, 0xe6, 0xf0, 0x3e, 0x31, 0x17, 0xb3, 0x5e, 0x52, 0x17, 0x33, 0x9f, 0xa0, 0xc9,
+		0x0a, 0x9c, 0xb2, 0xe0, 0x29, 0x0b, 0xa0, 0x92, 0x20, 0xe6, 0x13, 0xc8, 0x9c, 0x82, 0x29, 0x2c,
+		0xa0, 0xaf, 0x68, 0xbc, 0x6a, 0xd7, 0x4d, 0xef, 0xc2, 0xb6, 0xf8, 0xfc, 0xbf, 0x54, 0x84, 0xa3,
+		0xa1, 0x04, 0xa7, 0x51, 0xce, 0x49, 0x91, 0x6e, 0x52, 0xa7, 0xd2, 0x9c, 0x4e, 0x5e, 0xcc, 0x55,
+		0xc5, 0x9d, 0x4d, 0xec, 0xd9, 0xc4, 0x9f, 0x05, 0x06, 0x62, 0x70, 0x10, 0x84, 0x85, 0x7c, 0x04,
+		0xe6, 0xc5, 0x7a, 0xf7, 0x82, 0x8c, 0xf4, 0x4b, 0x2c, 0xf7, 0x48, 0x57, 0xef, 0x48, 0xdc, 0x3b,
+		0x7c, 0x6c, 0xb9, 0xce, 0x6c, 0xe8, 0xa4, 0x37, 0xff, 0x05, 0x77, 0x77, 0x77, 0xaf, 0x8f, 0x0f,
+		0x2e, 0x7f, 0x3b, 0xdd, 0xbf, 0xbe, 0xfc, 0xf7, 0xd9, 0x12, 0xf7, 0xd2, 0x3b, 0x3a, 0xdd, 0xdb,
+		0x3d, 0x5a, 0xca, 0x7e, 0x6c, 0xbb, 0xfb, 0x87, 0x7f, 0x5c, 0x5c, 0xef, 0x1e, 0x2d, 0xe5, 0xdb,
+		0x5d, 0xee, 0xee, 0xed, 0xee, 0x71, 0xbd, 0x9d, 0x59, 0x3d, 0xe7, 0xd6, 0x16, 0xa5, 0xf4, 0x84,
+		0x7b, 0x9d, 0xcc, 0xd4, 0x77, 0xdb, 0x05, 0x75, 0xdb, 0xbb, 0xd2, 0x6a, 0x3b, 0xa5, 0x3a, 0xc1,
+		0x4d, 0x06, 0xfc, 0xa5, 0x9b, 0xba, 0xb1, 0x74, 0x86, 0x7b, 0xb5, 0x43, 0x9c, 0x62, 0x67, 0xe6,
+		0x5e, 0xe2, 0xc6, 0xb2, 0xac, 0xeb, 0x42, 0xbd, 0x17, 0xf1, 0x24, 0x05, 0x0c, 0x07, 0x6f, 0x55,
+		0xbd, 0x79, 0x50, 0x91, 0x58, 0xce, 0x3e, 0xc4, 0xcf, 0xe8, 0x60, 0x7f, 0xa6, 0xca, 0xd9, 0x74,
+		0x72, 0x41, 0x9e, 0x9a, 0x86, 0x5e, 0x82, 0xfd, 0x00, 0xef, 0xfa, 0xf0, 0x1f, 0xdb, 0xb6, 0xd7,
+		0x9f, 0x3c, 0xb2, 0xf5, 0xc1, 0x67, 0xac, 0x02, 0x77, 0xde, 0xdd, 0xfb, 0x21, 0x4e, 0x05, 0x43,
+		0x20, 0xc3, 0xfb, 0x10, 0x02, 0x29, 0xd0, 0x17, 0x5c, 0xe9, 0x10, 0x48, 0x5f, 0xe0, 0xe4, 0xc3,
+		0x1e, 0x83, 0xdb, 0xe5, 0x42, 0x1d, 0x75, 0x84, 0x3a, 0x8a, 0x10, 0x6f, 0x36, 0x31, 0x67, 0x11,
+		0x77, 0x31, 0xb1, 0x17, 0x14, 0x7f, 0x69, 0x18, 0x8c, 0x2e, 0xd1, 0xd0, 0xf5, 0x4c, 0x71, 0x11,
+		0x0a, 0x65, 0x33, 0x01, 0x44, 0x19, 0x28, 0x1c, 0x80, 0xe1, 0x03, 0x0e, 0x17, 0x80, 0xd8, 0x81,
+		0xc4, 0x0e, 0x28, 0x56, 0x60, 0xc9, 0x01, 0x4c, 0x12, 0x68, 0xca, 0x80, 0x1b, 0x5d, 0x03, 0x43,
+		0x32, 0xe8, 0x70, 0xc0, 0x16, 0xd8, 0x9a, 0x18, 0x53, 0x71, 0x61, 0xd4, 0x32, 0x88, 0xd8, 0x80,
+		0xc9, 0x09, 0x50, 0x7e, 0xa0, 0x72, 0x03, 0x56, 0x1b, 0x70, 0xb5, 0x01, 0x58, 0x0b, 0x90, 0xd5,
+		0x00, 0xad, 0x08, 0xec, 0xe9, 0x28, 0x3c, 0xa3, 0xbc, 0xf1, 0xc4, 0xd5, 0x39, 0xe3, 0xeb, 0x7a,
+		0xe2, 0xec, 0xaf, 0xc6, 0xdb, 0x77, 0xf7, 0xf6, 0x4e, 0xff, 0x38, 0xb9, 0x3c, 0x3c, 0xf9, 0x72,
+		0x7d, 0xf0, 0xe7, 0xc1, 0xc9, 0x25, 0x47, 0xe4, 0x5d, 0x47, 0x04, 0x7e, 0x74, 0xfd, 0x62, 0x1b,
+		0x69, 0xfe, 0x54, 0x1c, 0x9d, 0x7e, 0x39, 0x3c, 0xb1, 0xd8, 0xbe, 0xf0, 0x71, 0xcd, 0xc8, 0x59,
+		0xd8, 0x3b, 0x3d, 0x3e, 0xde, 0x3d, 0xd9, 0x67, 0x9c, 0x07, 0x96, 0x91, 0xae, 0x16, 0xad, 0xc7,
+		0x2a, 0x0b, 0x90, 0x03, 0x2b, 0x76, 0x9d, 0x81, 0x01, 0x62, 0x62, 0x26, 0xc3, 0xf1, 0xc0, 0x4a,
+		0xe6, 0xce, 0x14, 0x58, 0x09, 0x58, 0x49, 0x91, 0xac, 0xc4, 0x0d, 0x7a, 0x5d, 0x37, 0x1e, 0x84,
+		0xa6, 0x19, 0x59, 0x49, 0x93, 0x61, 0xac, 0x83, 0xa0, 0xd7, 0xcf, 0x70, 0x5e, 0x98, 0x02, 0x2d,
+		0xd4, 0xa3, 0x94, 0xdc, 0x22, 0xe0, 0xda, 0x32, 0x18, 0x04, 0xe2, 0x07, 0xff, 0x08, 0xed, 0x1f,
+		0xa8, 0xcf, 0x9a, 0xc4, 0x8c, 0x71, 0xb8, 0xcf, 0x7c, 0x6e, 0xb3, 0xea, 0x81, 0x1b, 0xc4, 0xaf,
+		0x0a, 0x31, 0x38, 0x88, 0x5f, 0x31, 0x1a, 0x92, 0xb1, 0xbc, 0xf8, 0xae, 0xdd, 0x61, 0x4a, 0x15,
+		0xdb, 0x52, 0x18, 0xe3, 0x6c, 0xa8, 0xf0, 0x3e, 0x7c, 0x18, 0x6a, 0xaf, 0xf5, 0x09, 0x58, 0x97,
+		0x58, 0x91, 0x0d, 0xce, 0x65, 0x29, 0xeb, 0xb0, 0xc1, 0x30, 0x0b, 0x0e, 0xbf, 0x37, 0xa0, 0xbe,
+		0xa0, 0xbe, 0x88, 0x10, 0x7e, 0x87, 0xa3, 0x5b, 0x00, 0x50, 0xb9, 0x01, 0xab, 0x0d, 0xb8, 0xda,
+		0x00, 0xac, 0x05, 0xc8, 0x6a, 0x80, 0x56, 0x04, 0x36, 0x1f, 0x3f, 0x21, 0x42, 0xf8, 0x1d, 0xe1,
+		0x77, 0x22, 0x84, 0xdf, 0x89, 0x10, 0x7e, 0x5f, 0xc0, 0xdd, 0x08, 0xbf, 0x13, 0x81, 0x95, 0x80,
+		0x95, 0x80, 0x95, 0x10, 0x21, 0xfc, 0x5e, 0xd8, 0x9d, 0xcb, 0x10, 0x7e, 0xcf, 0x51, 0xab, 0x87,
+		0x6f, 0xd2, 0xf4, 0x26, 0xa6, 0xfe, 0xee, 0x3e, 0x28, 0xb9, 0xc6, 0x4b, 0x79, 0x10, 0x29, 0x1b,
+		0x42, 0xeb, 0xac, 0x2b, 0x0a, 0x33, 0x87, 0x10, 0x5b, 0x52, 0xb1, 0xd0, 0x67, 0x95, 0x90, 0xfa,
+		0xdf, 0x71, 0xbd, 0x6b, 0xdb, 0xd7, 0xbb, 0xe3, 0xef, 0xb8, 0x3e, 0xe8, 0x0f, 0x8e, 0x43, 0x3f,
+		0xb3, 0x66, 0xbf, 0xc8, 0x43, 0x3f, 0x62, 0xb1, 0x6b, 0xa9, 0x58, 0xb5, 0xf4, 0x91, 0x9f, 0x06,
+		0x8e, 0xfc, 0x70, 0x92, 0x3c, 0x54, 0x3d, 0x41, 0xd5, 0x13, 0x1c, 0x05, 0x42, 0xd5, 0x13, 0x22,
+		0x54, 0x3d, 0xd1, 0x1c, 0x16, 0x46, 0xd5, 0x13, 0x22, 0x54, 0x3d, 0x21, 0x42, 0xd5, 0x13, 0xf9,
+		0xb7, 0x43, 0xd5, 0x13, 0x22, 0x54, 0x3d, 0x41, 0xd5, 0x13, 0x54, 0x3d, 0xd1, 0x46, 0x05, 0x09,
+		0x55, 0x4f, 0x4a, 0x16, 0x00, 0x11, 0x08, 0x9b, 0x1a, 0x54, 0xce, 0xfd, 0x8d, 0x37, 0xb6, 0xd6,
+		0x2a, 0x1c, 0x31, 0x35, 0xe9, 0xaa, 0xf0, 0xb3, 0xbf, 0xde, 0xb2, 0x7b, 0xe9, 0x5d, 0xc6, 0x62,
+		0x9d, 0x7c, 0x93, 0xf0, 0xe4, 0x60, 0x3f, 0xbf, 0x0f, 0x35, 0x96, 0x51, 0x63, 0x99, 0x88, 0x88,
+		0x2c, 0xbb, 0xdd, 0xf5, 0x82, 0x6a, 0x5f, 0xc7, 0x0a, 0x07, 0x1c, 0x27, 0xee, 0x45, 0xa1, 0xa1,
+		0xfc, 0x17, 0xa2, 0x8e, 0x12, 0x82, 0x3a, 0xba, 0x64, 0x2b, 0xab, 0xa8, 0x55, 0x54, 0x41, 0xa9,
+		0xa1, 0x42, 0x05, 0x9c, 0x4d, 0xd0, 0x59, 0x04, 0x5e, 0x4c, 0xf0, 0x05, 0x01, 0x20, 0x0d, 0x84,
+		0xd1, 0x35, 0xd4, 0xc2, 0x91, 0x9d, 0x24, 0x3f, 0x54, 0x92, 0x9b, 0xa6, 0xb4, 0xfa, 0x78, 0x3c,
+		0x1c, 0xdd, 0xc2, 0xd9, 0x87, 0x82, 0x81, 0x26, 0x07, 0x38, 0x49, 0xe0, 0x4d, 0x47, 0xca, 0x97,
+		0x21, 0xfc, 0x23, 0x39, 0x03, 0x07, 0x3f, 0x53, 0xb5, 0xc8, 0x33, 0x9f, 0x74, 0x87, 0x4e, 0xd5,
+		0xfd, 0x99, 0x7e, 0x9c, 0x70, 0xd8, 0xee, 0xec, 0xe4, 0xce, 0x6d, 0x57, 0xef, 0xfb, 0x4d, 0xd4,
+		0x58, 0xa5, 0xbe, 0x63, 0xfb, 0x09, 0xa7, 0xd8, 0x17, 0x2d, 0xf0, 0x57, 0x85, 0x1c, 0xa8, 0x7b,
+		0x6e, 0x16, 0x86, 0xcb, 0xc1, 0x6d, 0x6d, 0x46, 0xc3, 0xc2, 0xe8, 0xc0, 0xe8, 0x48, 0xa2, 0x6f,
+		0x55, 0x8d, 0x8e, 0x13, 0x3f, 0x44, 0xe9, 0x13, 0x90, 0x14, 0x8f, 0xcb, 0x15, 0xbe, 0x01, 0xf1,
+		0xb8, 0x3c, 0x89, 0x7b, 0xcf, 0x42, 0x6d, 0xeb, 0x4f, 0x41, 0x12, 0xa9, 0xf2, 0x0f, 0x8f, 0x15,
+		0x0d, 0x73, 0x22, 0x79, 0x3a, 0x5a, 0xe9, 0x54, 0xb4, 0xb2, 0x0b, 0xdd, 0x80, 0x0b, 0xbd, 0x48,
+		0x65, 0x0b, 0x17, 0x3a, 0xbf, 0xd8, 0xc0, 0x85, 0x66, 0x03, 0x12, 0x17, 0xa0, 0xd8, 0x81, 0xc5,
+		0x0e, 0x30, 0x56, 0xa0, 0xc9, 0x01, 0x4e, 0x12, 0x78, 0x1a, 0xd8, 0x0c, 0x5c, 0x68, 0x22, 0xb8,
+		0xd0, 0x44, 0x70, 0xa1, 0xe7, 0x63, 0x05, 0x2e, 0xf4, 0x8c, 0x89, 0x81, 0xd1, 0x91, 0x43, 0x1f,
+		0x5c, 0x68, 0x33, 0x5d, 0x68, 0x69, 0x95, 0x94, 0x79, 0xa7, 0xc3, 0x54, 0x16, 0x16, 0x5d, 0x34,
+		0x1e, 0x0f, 0x4a, 0x08, 0x4a, 0x08, 0x4a, 0xc8, 0x2c, 0xe6, 0x8b, 0xd0, 0xdd, 0x2b, 0xa1, 0x3b,
+		0x89, 0xa3, 0xe3, 0x4b, 0x9d, 0x1d, 0x3a, 0x6b, 0xa2, 0x2c, 0xa1, 0x20, 0xe4, 0xac, 0x14, 0xca,
+		0x67, 0xa3, 0x5f, 0xef, 0x66, 0xa3, 0xff, 0x91, 0x3b, 0x0b, 0x98, 0xe7, 0x0c, 0xae, 0x60, 0x9a,
+		0x91, 0x5c, 0x7a, 0x11, 0xf2, 0xe1, 0x08, 0xf9, 0x70, 0x6a, 0x31, 0xcc, 0xa9, 0xcc, 0x5e, 0xf5,
+		0x93, 0xb8, 0xaf, 0x0e, 0x87, 0xd3, 0xb8, 0xfc, 0x62, 0xcf, 0x26, 0xfe, 0x6c, 0x30, 0x60, 0x81,
+		0x83, 0x18, 0x2c, 0x04, 0xe1, 0xa1, 0xce, 0xb7, 0x70, 0x1a, 0x17, 0xa7, 0x71, 0x89, 0x70, 0x1a,
+		0x57, 0x0e, 0x77, 0x44, 0x38, 0x8d, 0x4b, 0x84, 0xd3, 0xb8, 0xc5, 0xad, 0x05, 0x4e, 0xe3, 0x12,
+		0xe1, 0x34, 0x2e, 0x11, 0x4e, 0xe3, 0x2e, 0x84, 0x0a, 0x12, 0x4e, 0xe3, 0x96, 0x30, 0xde, 0x22,
+		0x92, 0x48, 0x86, 0x92, 0x64, 0x44, 0x44, 0x08, 0x86, 0xc8, 0xc1, 0x1d, 0xc1, 0x10, 0x04, 0x43,
+		0x44, 0x2e, 0x04, 0x43, 0x14, 0x60, 0x21, 0x08, 0x8f, 0xe9, 0xa8, 0x02, 0x82, 0x21, 0x44, 0x08,
+		0x86, 0x20, 0x18, 0x22, 0x7d, 0x21, 0x18, 0x42, 0x84, 0x60, 0x48, 0x11, 0xdf, 0x8b, 0x60, 0x88,
+		0xae, 0xb5, 0x41, 0x30, 0x04, 0xc1, 0x10, 0x5d, 0x54, 0x90, 0x10, 0x0c, 0x29, 0x61, 0x30, 0x84,
+		0xb7, 0x3c, 0xd9, 0xfc, 0xf7, 0xec, 0xaf, 0x7a, 0x22, 0x1e, 0x0b, 0x19, 0xdc, 0x86, 0xc4, 0x90,
+		0x02, 0x9d, 0xc1, 0x95, 0x8e, 0x85, 0x08, 0xd5, 0xf4, 0x7a, 0x55, 0x5a, 0x51, 0x24, 0x49, 0x83,
+		0x50, 0xb3, 0x09, 0x37, 0x9b, 0x90, 0xb3, 0x08, 0xbb, 0x98, 0xd0, 0x0b, 0x0a, 0xbf, 0x34, 0x08,
+		0x46, 0x97, 0x6c, 0xd5, 0xb0, 0x17, 0xe2, 0x22, 0x55, 0x3d, 0x4c, 0x11, 0x20, 0xca, 0x40, 0xe1,
+		0x00, 0x0c, 0x1f, 0x70, 0xb8, 0x00, 0xc4, 0x0e, 0x24, 0x76, 0x40, 0xb1, 0x02, 0x4b, 0x0e, 0x60,
+		0x92, 0x40, 0x53, 0x06, 0xdc, 0xe8, 0xb2, 0x94, 0x0f, 0x55, 0xbf, 0x10, 0x3c, 0xc5, 0x63, 0xd5,
+		0xd3, 0x60, 0x44, 0xdb, 0xc5, 0x22, 0xc1, 0xaa, 0x0d, 0xb4, 0xda, 0xc0, 0xab, 0x05, 0xc4, 0x6a,
+		0x60, 0x56, 0x04, 0xf5, 0x74, 0x00, 0x9e, 0x51, 0xde, 0x94, 0xa3, 0x4b, 0x4c, 0x51, 0x26, 0xae,
+		0x19, 0x52, 0x3e, 0xce, 0x3d, 0xba, 0xf8, 0x51, 0xa3, 0xf5, 0x78, 0xb7, 0xbe, 0x63, 0xde, 0xaf,
+		0xc2, 0xc9, 0xe0, 0xf0, 0xef, 0x9a, 0xba, 0x69, 0x54, 0x3d, 0x08, 0xfe, 0x02, 0x85, 0x3c, 0x47,
+		0xc1, 0xa7, 0xa1, 0x08, 0x43, 0x49, 0x04, 0x43, 0x29, 0x88, 0x6c, 0x18, 0xca, 0x22, 0x8e, 0x9a,
+		0x73, 0x5b, 0xcd, 0xc5, 0x34, 0x67, 0x0f, 0x7d, 0xc6, 0xbd, 0xef, 0xfe, 0x68, 0x50, 0x7c, 0x50,
+		0x7c, 0x50, 0x7c, 0xa5, 0x52, 0x7c, 0xb2, 0x99, 0x46, 0x9c, 0x99, 0x47, 0xb3, 0x5e, 0x53, 0x9d,
+		0xe6, 0x13, 0x1b, 0xd5, 0x27, 0x2d, 0x6e, 0x15, 0xb3, 0xa1, 0x60, 0x12, 0x33, 0x8d, 0x33, 0xc7,
+		0x93, 0xe3, 0xa5, 0x23, 0xe7, 0x4b, 0x6f, 0x0e, 0xd8, 0xcc, 0x09, 0xb9, 0xf8, 0xf7, 0xc5, 0xe5,
+		0xc1, 0xf1, 0xf5, 0xfe, 0xc1, 0xe7, 0xc3, 0x93, 0x83, 0xfd, 0xeb, 0xf3, 0xd3, 0xa3, 0x83, 0x0b,
+		0x6b, 0x8d, 0xf7, 0xbb, 0x18, 0x13, 0xc5, 0xf4, 0x89, 0xc8, 0x5b, 0xb3, 0x93, 0xcd, 0xca, 0xf5,
+		0xee, 0xfe, 0xf1, 0xe1, 0x89, 0xc5, 0xfe, 0x7d, 0x8f, 0xac, 0x23, 0x5e, 0x55, 0xca, 0xf5, 0x5c,
+		0x8f, 0x95, 0xc5, 0xbe, 0xd1, 0x62, 0xa8, 0x6b, 0x92, 0xdc, 0x55, 0xbf, 0xbb, 0x0f, 0x7c, 0xec,
+		0x75, 0x34, 0x20, 0x08, 0xec, 0xdc, 0xa9, 0x02, 0x81, 0x05, 0x81, 0x9d, 0xcb, 0xec, 0x96, 0x39,
+		0xc4, 0xbd, 0x10, 0x8d, 0xa7, 0x5c, 0x31, 0xee, 0xc5, 0xbc, 0x2a, 0xd6, 0x8c, 0x83, 0xce, 0x83,
+		0xce, 0x83, 0xce, 0x83, 0xce, 0xd3, 0x72, 0xe7, 0xa3, 0x6c, 0x42, 0x95, 0x5a, 0xed, 0x3a, 0x8e,
+		0xec, 0xd8, 0x7e, 0xd6, 0xe9, 0xba, 0x74, 0xfb, 0x09, 0xf9, 0x79, 0x93, 0x98, 0x33, 0xc9, 0xb6,
+		0x14, 0xaf, 0x88, 0xab, 0x78, 0x7b, 0x8a, 0x17, 0x12, 0xaa, 0x9a, 0xa3, 0xd5, 0x40, 0x8e, 0x96,
+		0x46, 0xbb, 0x80, 0x1c, 0x2d, 0x22, 0xe4, 0x68, 0x09, 0x83, 0x12, 0x64, 0x6e, 0x91, 0xa0, 0xd5,
+		0x06, 0x5e, 0x2d, 0x20, 0x56, 0x03, 0xb3, 0x22, 0xa8, 0x57, 0x89, 0xcc, 0x21, 0x47, 0x8b, 0x08,
+		0x39, 0x5a, 0xfa, 0xe2, 0xc2, 0xc8, 0xd1, 0x22, 0x82, 0xa1, 0x24, 0x82, 0xa1, 0x7c, 0x1d, 0xd9,
+		0x30, 0x94, 0x44, 0xc8, 0xd1, 0xca, 0x73, 0x21, 0x47, 0x0b, 0x8a, 0x0f, 0x8a, 0x8f, 0x08, 0x39,
+		0x5a, 0x44, 0xc8, 0xd1, 0xd2, 0xe4, 0x56, 0x31, 0x1b, 0x0a, 0x26, 0x31, 0x23, 0x42, 0x8e, 0x16,
+		0x72, 0xb4, 0x0a, 0x16, 0x91, 0xb7, 0x66, 0x07, 0x39, 0x5a, 0x8b, 0x1b, 0x05, 0x39, 0x5a, 0xc8,
+		0xd1, 0x12, 0x99, 0x2a, 0x10, 0x58, 0x10, 0xd8, 0xb9, 0xcc, 0x0e, 0xf9, 0x0a, 0xcc, 0x1a, 0x0f,
+		0x39, 0x5a, 0xd0, 0x79, 0xd0, 0x79, 0xd0, 0x79, 0xc6, 0xe9, 0xbc, 0x15, 0xce, 0xd1, 0x92, 0xe8,
+		0x33, 0x2a, 0x3f, 0x6d, 0x12, 0x53, 0x66, 0xf1, 0xb5, 0x8a, 0x46, 0x93, 0x68, 0x24, 0x6a, 0x49,
+		0x01, 0x16, 0x4d, 0xa2, 0x2d, 0xdf, 0xb5, 0x3b, 0x4c, 0x65, 0xeb, 0xb7, 0x14, 0xc6, 0x38, 0x1b,
+		0x6a, 0xbb, 0x0f, 0x1f, 0x86, 0xc9, 0xa5, 0xeb, 0x63, 0x50, 0x2f, 0x45, 0xef, 0xe9, 0xdf, 0xdd,
+		0x07, 0x05, 0x4d, 0xb5, 0x94, 0xb5, 0xa5, 0xb3, 0x21, 0x96, 0xb5, 0xdf, 0xf7, 0x93, 0x1d, 0xb6,
+		0xa4, 0x92, 0x97, 0xf3, 0xb5, 0xb3, 0xfe, 0x43, 0xb8, 0x68, 0xf4, 0x0a, 0x15, 0x73, 0xee, 0x2f,
+		0x01, 0x5b, 0x31, 0xe7, 0x8a, 0xc2, 0x2c, 0x88, 0xbe, 0xbd, 0xc2, 0x5b, 0x5b, 0x6b, 0x15, 0x2e,
+		0xe9, 0xb2, 0x2a, 0x72, 0x13, 0xf2, 0xc6, 0x64, 0xf4, 0xbb, 0x20, 0x85, 0xb1, 0xf7, 0x7f, 0xf9,
+		0xe6, 0xe2, 0x59, 0xf3, 0xa4, 0xa7, 0xdb, 0xd6, 0x2a, 0xb9, 0xac, 0xd1, 0x5a, 0x85, 0x85, 0xb4,
+		0x89, 0x90, 0x33, 0x71, 0x12, 0x26, 0x4a, 0xb6, 0xa4, 0x49, 0x95, 0x34, 0x79, 0x92, 0x22, 0x49,
+		0x6a, 0x70, 0xc9, 0x9b, 0x85, 0x8e, 0xce, 0xfa, 0x28, 0xa0, 0x9e, 0xf3, 0x2a, 0x41, 0x33, 0xb9,
+		0xb1, 0x02, 0x63, 0xe9, 0x25, 0xf7, 0x62, 0x34, 0xb4, 0x92, 0xe3, 0x17, 0x7a, 0x36, 0xe1, 0x67,
+		0x03, 0x01, 0x0b, 0x18, 0xc4, 0x40, 0x21, 0x08, 0x0e, 0x75, 0x17, 0x15, 0xad, 0xe4, 0xd0, 0x4a,
+		0x6e, 0x21, 0xd9, 0x27, 0x68, 0x25, 0x47, 0x84, 0x56, 0x72, 0x68, 0x25, 0x57, 0xd8, 0xfc, 0xa3,
+		0x95, 0xdc, 0x0a, 0x85, 0xfb, 0xd0, 0x4a, 0x4e, 0x0f, 0x15, 0x24, 0xb4, 0x92, 0x9b, 0x3b, 0x3b,
+		0x05, 0x45, 0x1f, 0xc7, 0x4e, 0xd9, 0x02, 0xda, 0xea, 0xbb, 0xf7, 0x43, 0xa8, 0x0a, 0xc6, 0x42,
+		0x86, 0xf7, 0x21, 0x16, 0x52, 0xa0, 0x3b, 0xb8, 0xd2, 0xb1, 0x90, 0xbe, 0xc0, 0xc9, 0x07, 0x3f,
+		0x06, 0xb7, 0xa3, 0x9d, 0x1c, 0xbf, 0x58, 0xb3, 0x89, 0x37, 0x9b, 0x98, 0xb3, 0x88, 0xbb, 0x98,
+		0xd8, 0x0b, 0x8a, 0xbf, 0x34, 0x0c, 0x46, 0x17, 0xda, 0xc9, 0x11, 0x32, 0x60, 0x0a, 0x07, 0x14,
+		0x2b, 0xb0, 0xe4, 0x00, 0x26, 0x09, 0x34, 0x65, 0xc0, 0x8d, 0xae, 0x81, 0x21, 0x19, 0x1c, 0xc5,
+		0x66, 0x8b, 0x6d, 0x4d, 0x8c, 0x89, 0xbc, 0x66, 0xfd, 0x40, 0xe5, 0x06, 0xac, 0x36, 0xe0, 0x6a,
+		0x03, 0xb0, 0x16, 0x20, 0xab, 0x01, 0x5a, 0x11, 0xd8, 0xd3, 0x81, 0x78, 0x46, 0x79, 0xe3, 0x3d,
+		0x1d, 0xca, 0x79, 0x2a, 0x54, 0xcf, 0x69, 0xd0, 0x67, 0x21, 0xf7, 0xdd, 0x3f, 0x2e, 0x7f, 0x3b,
+		0x3d, 0x3f, 0xfc, 0xdf, 0xdd, 0xcb, 0xc3, 0xd3, 0x93, 0xeb, 0x83, 0x3f, 0x0f, 0x4e, 0x2e, 0x39,
+		0xe2, 0xef, 0x3a, 0xe2, 0xf0, 0xa3, 0x4b, 0xd3, 0x99, 0xd8, 0x59, 0xb3, 0xb1, 0x77, 0x7a, 0xf2,
+		0xf9, 0xf0, 0x0b, 0xdf, 0x11, 0xd0, 0xc7, 0x35, 0xb6, 0xa1, 0x0a, 0x9f, 0x89, 0xe3, 0xe3, 0xdd,
+		0x93, 0x7d, 0xab, 0x64, 0xa7, 0x4d, 0xaf, 0x16, 0xad, 0xd1, 0x70, 0x0e, 0x21, 0x67, 0xf8, 0x6b,
+		0x10, 0x54, 0x1a, 0xfc, 0x63, 0x40, 0xb1, 0x58, 0x06, 0x2a, 0xc8, 0x47, 0x01, 0x71, 0x1a, 0x41,
+		0x07, 0xb5, 0x83, 0x2f, 0xa6, 0x51, 0xe1, 0xac, 0xc0, 0x69, 0x84, 0x09, 0x58, 0xa3, 0xea, 0x75,
+		0xae, 0xf9, 0x47, 0xd5, 0x6b, 0xa8, 0x2f, 0x22, 0x84, 0x92, 0x24, 0x45, 0x0f, 0xa1, 0xa4, 0x42,
+		0x81, 0xca, 0x0d, 0x58, 0x6d, 0xc0, 0xd5, 0x06, 0x60, 0x2d, 0x40, 0x56, 0x03, 0xb4, 0x22, 0xb0,
+		0xf9, 0xf8, 0x09, 0x11, 0x42, 0x49, 0x08, 0x25, 0x11, 0x21, 0x94, 0x44, 0x84, 0x50, 0x12, 0x87,
+		0x46, 0x43, 0x28, 0x49, 0x26, 0x94, 0x54, 0x6c, 0x4d, 0x8b, 0x22, 0x0e, 0x84, 0x4b, 0xd3, 0x3c,
+		0x1c, 0x09, 0x27, 0x03, 0x8e, 0x84, 0xbf, 0x2e, 0xc7, 0xec, 0x67, 0xc2, 0xc7, 0x5f, 0x73, 0x7d,
+		0xd0, 0x1f, 0x1f, 0x59, 0x99, 0x6f, 0xac, 0x41, 0x91, 0x59, 0x99, 0x62, 0x01, 0x19, 0xa9, 0x00,
+		0x8c, 0x74, 0x4e, 0x66, 0x03, 0x39, 0x99, 0x9c, 0xfe, 0x14, 0xce, 0xa7, 0xe2, 0x7c, 0x2a, 0x32,
+		0x36, 0x39, 0xc0, 0x20, 0x06, 0x0a, 0x41, 0x70, 0xa8, 0x07, 0x05, 0x70, 0x3e, 0x15, 0xe7, 0x53,
+		0x17, 0x12, 0xcc, 0xc0, 0xf9, 0x54, 0x22, 0x9c, 0x4f, 0xc5, 0xf9, 0xd4, 0xc2, 0xe6, 0x1f, 0xe7,
+		0x53, 0x57, 0x28, 0xf6, 0x80, 0xf3, 0xa9, 0x7a, 0xa8, 0x20, 0xe1, 0x7c, 0x6a, 0xf9, 0x22, 0x21,
+		0x02, 0x81, 0x54, 0xd3, 0x8b, 0xe3, 0xe5, 0x2d, 0x1c, 0x47, 0xf9, 0xa3, 0x6c, 0x3a, 0x4a, 0xe3,
+		0xe5, 0x3c, 0x07, 0x26, 0x76, 0xde, 0x0b, 0xc5, 0xf0, 0x78, 0x75, 0xdb, 0x02, 0x8a, 0xe1, 0xe9,
+		0x84, 0x47, 0x9e, 0xcc, 0x5c, 0x39, 0x69, 0x4e, 0xdc, 0xf8, 0xde, 0x8d, 0xab, 0xb7, 0x71, 0xd8,
+		0x8b, 0x92, 0xfc, 0x42, 0xfd, 0xfc, 0x36, 0xc8, 0x36, 0x0a, 0x3d, 0xbe, 0x14, 0x27, 0x89, 0x68,
+		0xfa, 0xe4, 0xdd, 0x28, 0x74, 0x90, 0xff, 0x42, 0x50, 0x5d, 0x42, 0x58, 0x47, 0x97, 0xec, 0xc9,
+		0x6e, 0xb5, 0x13, 0xdd, 0x28, 0x75, 0x50, 0xa8, 0x80, 0xb3, 0x09, 0x3a, 0x8b, 0xc0, 0x8b, 0x09,
+		0xbe, 0x20, 0x00, 0xa4, 0x81, 0x30, 0xba, 0x2c, 0x9e, 0x66, 0x1f, 0x68, 0xf4, 0x81, 0xdc, 0xf4,
+		0x45, 0x80, 0x4a, 0x0e, 0x5c, 0x92, 0x20, 0x9b, 0xde, 0xee, 0x59, 0xc9, 0x18, 0xa6, 0xc4, 0x9c,
+		0x59, 0x3c, 0x87, 0xf8, 0x70, 0x7c, 0x0f, 0x3a, 0x06, 0x3a, 0x86, 0x08, 0x9b, 0xc3, 0xaf, 0x6c,
+		0x0e, 0x5f, 0x1c, 0x9c, 0xff, 0x79, 0x70, 0xbe, 0xe4, 0x9b, 0xc3, 0x83, 0x5d, 0xc6, 0xe5, 0xdd,
+		0x1d, 0x5e, 0xb9, 0xbd, 0xd3, 0x92, 0x39, 0x11, 0x45, 0x26, 0xe2, 0x3e, 0x8b, 0x2a, 0x3e, 0xfb,
+		0x4d, 0xaa, 0x36, 0xc1, 0x63, 0x45, 0xc3, 0xa4, 0xc8, 0x39, 0x47, 0x2a, 0x4e, 0x11, 0x52, 0xec,
+		0x16, 0x42, 0x40, 0x90, 0x62, 0x57, 0x4c, 0x3d, 0x00, 0x95, 0x3a, 0x00, 0xaf, 0x9c, 0xff, 0x17,
+		0xef, 0x44, 0xa8, 0x47, 0x4d, 0x0c, 0xb4, 0x57, 0x22, 0xaf, 0x29, 0x46, 0x03, 0x20, 0xac, 0x08,
+		0x65, 0xb1, 0x1c, 0x61, 0xc5, 0x81, 0x44, 0x33, 0x54, 0xbd, 0x18, 0x8c, 0x83, 0x0a, 0xaa, 0x70,
+		0xfb, 0x0b, 0x06, 0x96, 0x1c, 0xc0, 0x24, 0x81, 0xa6, 0x0c, 0xb8, 0xd1, 0x65, 0xd9, 0xed, 0x76,
+		0xec, 0x26, 0x09, 0x9f, 0x77, 0x3d, 0x1a, 0x10, 0x05, 0x2f, 0xf4, 0x43, 0x94, 0x1b, 0xaa, 0xda,
+		0x20, 0xab, 0x0d, 0xba, 0x5a, 0x20, 0xac, 0x06, 0x65, 0x45, 0x48, 0xab, 0x13, 0x6f, 0x0d, 0x44,
+		0x9c, 0x93, 0x98, 0xe7, 0x20, 0xea, 0x23, 0x1d, 0xb2, 0xa8, 0xe3, 0xf9, 0x0a, 0x46, 0x53, 0xb1,
+		0x14, 0xfc, 0x8b, 0x35, 0x53, 0x2a, 0x09, 0xff, 0x62, 0xb9, 0xa0, 0x4d, 0x89, 0xa0, 0x4d, 0x57,
+		0x51, 0x9b, 0xaa, 0x12, 0xa5, 0xd1, 0xc5, 0x46, 0x98, 0x5e, 0xc8, 0x2f, 0x0f, 0x71, 0x9a, 0x86,
+		0x7c, 0x8d, 0x69, 0x38, 0x2e, 0xe8, 0xeb, 0x50, 0x01, 0xfa, 0x54, 0x81, 0x2e, 0x95, 0xa0, 0x5d,
+		0x35, 0x68, 0x57, 0x11, 0x5a, 0x55, 0x05, 0x8f, 0xca, 0x60, 0x52, 0x1d, 0xfc, 0x84, 0xec, 0x85,
+		0xbc, 0x7a, 0x51, 0x95, 0x17, 0xfd, 0xa4, 0x78, 0x42, 0x79, 0xde, 0x1c, 0x7c, 0x65, 0x95, 0x21,
+		0x5e, 0x4c, 0x4d, 0xcd, 0xec, 0x7d, 0x53, 0xc3, 0xdc, 0xbe, 0x98, 0xe3, 0x6d, 0x0d, 0x63, 0x9f,
+		0xd9, 0x69, 0xea, 0xc6, 0x01, 0xfb, 0x74, 0x8f, 0x2e, 0xeb, 0x3f, 0xef, 0xde, 0x7d, 0xad, 0x55,
+		0x77, 0xae, 0xfe, 0xfe, 0x5a, 0xaf, 0xee, 0x5c, 0x0d, 0x7e, 0xac, 0xf7, 0xff, 0x19, 0xfc, 0xdc,
+		0xf8, 0x5a, 0xab, 0x36, 0x47, 0x3f, 0xb7, 0xbe, 0xd6, 0xaa, 0xad, 0xab, 0xf7, 0xdf, 0xbe, 0x7d,
+		0x78, 0xff, 0x6b, 0xe3, 0x51, 0xfc, 0xc6, 0x7f, 0x58, 0xec, 0x2f, 0x71, 0xc5, 0x3a, 0xe2, 0xe3,
+		0x9a, 0x41, 0x42, 0xbd, 0x09, 0xa1, 0x7e, 0x5b, 0xa8, 0xed, 0x6a, 0x67, 0xb7, 0xfa, 0xf9, 0xea,
+		0x57, 0x7d, 0xad, 0xf9, 0xf8, 0xf1, 0xfd, 0xaf, 0xad, 0xc7, 0xe9, 0x3f, 0xfe, 0xfd, 0xda, 0xc7,
+		0xea, 0x6b, 0x5b, 0x8f, 0x1f, 0x67, 0xfc, 0x97, 0xcd, 0xc7, 0x8f, 0x39, 0xc7, 0x68, 0x3d, 0xbe,
+		0x7b, 0xf1, 0xd1, 0xec, 0xef, 0x8d, 0x59, 0x37, 0x34, 0x67, 0xdc, 0xb0, 0x31, 0xeb, 0x86, 0x8d,
+		0x19, 0x37, 0xcc, 0x7c, 0xa4, 0xc6, 0x8c, 0x1b, 0x5a, 0x8f, 0x7f, 0xbf, 0xf8, 0xfc, 0xbb, 0xd7,
+		0x3f, 0xba, 0xf9, 0xf8, 0xfe, 0xef, 0x59, 0xff, 0x6d, 0xeb, 0xf1, 0xef, 0x8f, 0xef, 0x0d, 0x80,
+		0x78, 0xa5, 0x5c, 0xcf, 0xf5, 0x58, 0x86, 0xd4, 0x1c, 0xb5, 0x94, 0xee, 0x99, 0x4a, 0x4a, 0x21,
+		0xc5, 0x7b, 0x96, 0x42, 0x82, 0x2f, 0x43, 0x04, 0x5f, 0x06, 0xbe, 0x0c, 0x1f, 0x8f, 0xd7, 0x20,
+		0xaf, 0xca, 0x29, 0xea, 0xcc, 0x29, 0xeb, 0xfc, 0xeb, 0xc0, 0xa1, 0x73, 0x53, 0xaf, 0xeb, 0x86,
+		0xbd, 0x94, 0x5f, 0xed, 0x8e, 0x06, 0x86, 0xe6, 0x55, 0x9e, 0x4a, 0x68, 0x5e, 0x22, 0x68, 0x5e,
+		0x46, 0xd4, 0x93, 0x5e, 0xcd, 0xdb, 0xf3, 0x82, 0xb4, 0xbe, 0xa9, 0x41, 0xf3, 0x6e, 0x32, 0x0e,
+		0x79, 0x6e, 0x07, 0xb7, 0x46, 0x44, 0x90, 0x8e, 0xbd, 0xc0, 0xfa, 0xa8, 0x61, 0x60, 0xe2, 0x57,
+		0xab, 0xaf, 0x1f, 0x49, 0xd0, 0x38, 0xfe, 0xe7, 0xd8, 0x76, 0xb2, 0xb4, 0xef, 0x7d, 0xef, 0xd6,
+		0xeb, 0x97, 0x6e, 0xaa, 0xb1, 0x7f, 0xcf, 0xe3, 0x9a, 0x86, 0x25, 0xb5, 0x7f, 0x1a, 0xbf, 0xa4,
+		0x9b, 0xad, 0xd6, 0x46, 0xcb, 0xe0, 0x65, 0x85, 0x3f, 0xaf, 0x71, 0x04, 0xd5, 0x4d, 0x52, 0xa6,
+		0x4e, 0x01, 0xdc, 0x07, 0x3c, 0x06, 0xbf, 0x8c, 0xfe, 0xa8, 0xd4, 0x8b, 0x52, 0x7d, 0x9e, 0x15,
+		0xe6, 0xd8, 0x8a, 0xed, 0xb6, 0xd7, 0x63, 0xcc, 0xb5, 0x1b, 0x8e, 0x87, 0xe4, 0x90, 0xb9, 0x33,
+		0x85, 0xe4, 0x10, 0x24, 0x87, 0x14, 0x90, 0x1c, 0xc2, 0x94, 0xfd, 0xf5, 0x42, 0x7c, 0x59, 0xb2,
+		0xc0, 0x98, 0x01, 0xcf, 0x0e, 0x7c, 0x1d, 0x0a, 0x40, 0x9f, 0x22, 0xd0, 0xa5, 0x10, 0xb4, 0x2b,
+		0x06, 0xed, 0x0a, 0x42, 0xab, 0xa2, 0xe0, 0x51, 0x18, 0x4c, 0x8a, 0x83, 0x5d, 0x81, 0x8c, 0x2e,
+		0xcb, 0x76, 0x9c, 0xb4, 0x1a, 0x85, 0x71, 0xca, 0x2f, 0x57, 0xe3, 0x7c, 0xb3, 0xf1, 0x57, 0x30,
+		0x2f, 0xfb, 0xbe, 0xdb, 0xb1, 0x7b, 0x7e, 0x7f, 0xd5, 0xeb, 0xdb, 0xf5, 0x0d, 0xee, 0xe1, 0xf5,
+		0x38, 0x5a, 0xec, 0x5a, 0x4c, 0xa7, 0x36, 0xd3, 0xaf, 0xd5, 0x74, 0x6b, 0xb7, 0xc2, 0xb4, 0x5c,
+		0x61, 0xda, 0xae, 0x10, 0xad, 0xc7, 0xab, 0xfd, 0xc6, 0xa3, 0x31, 0x4b, 0x3c, 0x7b, 0x88, 0xf3,
+		0x85, 0xbc, 0x67, 0x6a, 0xab, 0x1a, 0xf4, 0xba, 0x37, 0xd2, 0x67, 0xfd, 0x0a, 0x8e, 0x7b, 0x6a,
+		0x8e, 0x7f, 0x8e, 0x2e, 0x3d, 0x20, 0x25, 0xdd, 0xf1, 0xd0, 0xe9, 0x89, 0xd7, 0x14, 0x44, 0x7b,
+		0x11, 0x4c, 0xd3, 0xfd, 0x3d, 0x05, 0x04, 0xd4, 0x34, 0x61, 0xb8, 0xb0, 0xb8, 0xe9, 0xa2, 0x96,
+		0x5e, 0x63, 0x1c, 0x75, 0x21, 0xcb, 0x5f, 0x31, 0x63, 0xd4, 0xb2, 0xe6, 0x73, 0x31, 0xc2, 0xa7,
+		0xdf, 0x3a, 0x4d, 0x37, 0x77, 0x1f, 0x7f, 0x85, 0x56, 0xee, 0xde, 0x00, 0x77, 0x07, 0x77, 0x27,
+		0x02, 0x77, 0x2f, 0xa7, 0xd2, 0x22, 0x70, 0x77, 0x22, 0x70, 0x77, 0x22, 0x70, 0x77, 0xb3, 0xc9,
+		0x1b, 0xb8, 0x3b, 0x11, 0xb8, 0x3b, 0x11, 0xb8, 0xfb, 0x82, 0xe1, 0x63, 0xc5, 0x6e, 0x1a, 0xdb,
+		0x41, 0xd2, 0xf5, 0xd2, 0x6a, 0x76, 0x50, 0xa9, 0x1b, 0xa5, 0x89, 0x3e, 0x16, 0xff, 0xda, 0x97,
+		0x81, 0x70, 0x83, 0x70, 0x13, 0x81, 0x70, 0x97, 0x52, 0xd3, 0x90, 0x91, 0x84, 0x3b, 0xcb, 0x0b,
+		0xde, 0xd6, 0x48, 0xb5, 0x5b, 0xa0, 0xda, 0x44, 0xa0, 0xda, 0x66, 0x70, 0x2d, 0x50, 0x6d, 0xa1,
+		0xa5, 0x6f, 0xb4, 0x40, 0xb4, 0x89, 0x40, 0xb4, 0xb9, 0xc1, 0x63, 0x25, 0xae, 0x13, 0xbb, 0x69,
+		0xf5, 0xbb, 0xfb, 0xa0, 0x8f, 0x5f, 0x4f, 0x7c, 0x07, 0x68, 0x35, 0x68, 0x35, 0x11, 0x68, 0x75,
+		0x29, 0xf5, 0x0a, 0x19, 0x49, 0xab, 0xe3, 0xb0, 0x97, 0x7a, 0xc1, 0x6d, 0x35, 0xb2, 0x93, 0xa4,
+		0x2f, 0x3e, 0x3a, 0x2b, 0xb1, 0xac, 0x82, 0x45, 0xe8, 0xa3, 0xbc, 0xca, 0x5d, 0x5f, 0xef, 0xc5,
+		0xba, 0x4d, 0x7d, 0x0f, 0x2c, 0x03, 0x2c, 0x03, 0x11, 0x2c, 0x43, 0x29, 0xf5, 0x0b, 0x19, 0x69,
+		0x19, 0xb4, 0x94, 0xf3, 0xd3, 0x59, 0xd6, 0x6f, 0x7a, 0x6e, 0x8c, 0x8b, 0xba, 0x14, 0x54, 0xee,
+		0xaf, 0x88, 0x0a, 0x69, 0x85, 0x55, 0x4a, 0x9b, 0xae, 0x98, 0x66, 0x6c, 0x19, 0x40, 0x3d, 0x6e,
+		0xb3, 0x26, 0xf5, 0x53, 0x2c, 0x18, 0x36, 0x01, 0x06, 0x39, 0x30, 0xa0, 0x7c, 0xe0, 0x52, 0x94,
+		0x0f, 0xd4, 0xac, 0x1a, 0x56, 0x27, 0xf2, 0x57, 0xaa, 0xd3, 0x76, 0xcc, 0x65, 0x0a, 0x34, 0x97,
+		0x2b, 0x18, 0x1c, 0xd2, 0x67, 0xa9, 0x5a, 0xc0, 0xb7, 0x1c, 0x0c, 0x4b, 0x61, 0x25, 0xa9, 0x9d,
+		0x6a, 0x28, 0x1c, 0x39, 0x18, 0xb6, 0xe4, 0x47, 0x9d, 0x1b, 0x38, 0xea, 0x6c, 0x90, 0x9b, 0x8d,
+		0xa3, 0xce, 0x38, 0xea, 0x8c, 0xa3, 0xce, 0x2f, 0xb5, 0x18, 0x82, 0x89, 0x05, 0x68, 0xb7, 0xc2,
+		0xb4, 0x5c, 0x61, 0xda, 0xae, 0x10, 0xad, 0xc7, 0xab, 0xfd, 0xc6, 0xa3, 0xe1, 0xb8, 0x04, 0x11,
+		0x8e, 0x4b, 0x10, 0x21, 0x87, 0xcb, 0xdc, 0x34, 0x1e, 0xe4, 0x70, 0x11, 0xe1, 0xb8, 0x04, 0x11,
+		0x62, 0x39, 0x0b, 0x86, 0x0f, 0x8e, 0x3a, 0x13, 0x81, 0xbb, 0xe7, 0x5f, 0x4b, 0x70, 0x77, 0x22,
+		0x70, 0xf7, 0x12, 0x28, 0x2e, 0x02, 0x77, 0x27, 0x02, 0x77, 0x57, 0x9c, 0x78, 0x70, 0xf7, 0x45,
+		0x63, 0x98, 0x08, 0xdc, 0xdd, 0xa8, 0xe5, 0x07, 0x77, 0x2f, 0x0b, 0x7c, 0x2c, 0x27, 0xec, 0x05,
+		0xa9, 0x1b, 0x6b, 0xcc, 0xb4, 0x1d, 0x7f, 0x83, 0x1e, 0x6a, 0x5d, 0x07, 0xb5, 0x06, 0xb5, 0x26,
+		0x02, 0xb5, 0x2e, 0x95, 0x5e, 0x21, 0x1d, 0x9b, 0x84, 0xa3, 0x2b, 0xdb, 0xc9, 0x73, 0x93, 0xa4,
+		0x9a, 0xfd, 0xa3, 0xa3, 0x34, 0xc3, 0x0b, 0x58, 0x4d, 0x7d, 0xdf, 0x9a, 0x91, 0xbd, 0x63, 0x74,
+		0x29, 0xb6, 0x22, 0x14, 0xdc, 0x8b, 0x15, 0xd1, 0xa6, 0xe8, 0x8a, 0x52, 0x78, 0x85, 0x2b, 0xbe,
+		0xc2, 0x15, 0x60, 0xa1, 0x8a, 0x50, 0x8f, 0x42, 0x1c, 0x8f, 0xaa, 0x09, 0x31, 0xda, 0x62, 0x0f,
+		0xb3, 0x48, 0xd8, 0x66, 0xb3, 0x80, 0x14, 0x60, 0x9d, 0x19, 0xc0, 0x7a, 0x23, 0x12, 0xa3, 0x4b,
+		0x2f, 0xe4, 0xa9, 0xa8, 0x08, 0xc5, 0xf4, 0xc2, 0x68, 0x76, 0x57, 0x5f, 0xb8, 0xad, 0x45, 0x7d,
+		0x5f, 0x81, 0xae, 0xab, 0x66, 0x8d, 0x50, 0x78, 0x24, 0x63, 0xd1, 0x22, 0x52, 0xdf, 0x6e, 0x36,
+		0x37, 0xb7, 0x9a, 0xcd, 0xda, 0xd6, 0xc6, 0x56, 0x6d, 0xa7, 0xd5, 0xaa, 0x6f, 0xd6, 0x5b, 0x4b,
+		0x2c, 0x35, 0x15, 0x33, 0x47, 0x37, 0x25, 0x71, 0x5e, 0x03, 0x2a, 0x47, 0xdc, 0x3f, 0x76, 0xff,
+		0x3f, 0xd7, 0x29, 0xd0, 0xd7, 0x18, 0x7d, 0x1f, 0x7c, 0x0d, 0x22, 0xf8, 0x1a, 0x44, 0xf0, 0x35,
+		0x88, 0xe0, 0x6b, 0x10, 0xc1, 0xd7, 0x20, 0x82, 0xaf, 0x41, 0x04, 0x5f, 0x83, 0x08, 0xbe, 0x06,
+		0x11, 0x7c, 0x0d, 0x22, 0xf8, 0x1a, 0x85, 0x59, 0x27, 0x22, 0xf8, 0x1a, 0xda, 0x50, 0xd9, 0x2f,
+		0x05, 0xed, 0xb9, 0xed, 0xea, 0xd8, 0x07, 0xf8, 0x6f, 0xcf, 0x4d, 0x8a, 0x70, 0x3a, 0x66, 0x7d,
+		0x31, 0xbc, 0x0f, 0x22, 0x78, 0x1f, 0x44, 0xf0, 0x3e, 0x88, 0xe0, 0x7d, 0x10, 0xc1, 0xfb, 0x20,
+		0x82, 0xf7, 0x41, 0x04, 0xef, 0x83, 0x08, 0xde, 0x07, 0x11, 0xbc, 0x0f, 0x22, 0x78, 0x1f, 0x85,
+		0x59, 0x27, 0x22, 0x78, 0x1f, 0xda, 0x50, 0x69, 0xa5, 0x5e, 0xd7, 0x0d, 0x7b, 0x69, 0xf1, 0xde,
+		0xc7, 0xac, 0x2f, 0x86, 0xf7, 0x41, 0x04, 0xef, 0x83, 0x08, 0xde, 0x07, 0x11, 0xbc, 0x0f, 0x22,
+		0x78, 0x1f, 0x44, 0xf0, 0x3e, 0x88, 0xe0, 0x7d, 0x10, 0xc1, 0xfb, 0x20, 0x82, 0xf7, 0x41, 0x04,
+		0xef, 0xa3, 0x30, 0xeb, 0x44, 0x04, 0xef, 0x43, 0xc7, 0x88, 0x8f, 0xdc, 0x55, 0xf8, 0xf4, 0x14,
+		0x06, 0x2d, 0xa6, 0x40, 0x68, 0xbf, 0xee, 0xe5, 0xba, 0xa6, 0x33, 0x73, 0x83, 0xc7, 0x4f, 0xe3,
+		0x9e, 0x93, 0x06, 0x43, 0xc2, 0x72, 0xd1, 0x7f, 0xd6, 0xeb, 0x5d, 0xdb, 0xbe, 0xbe, 0xe8, 0x3f,
+		0xc8, 0x97, 0xec, 0xe1, 0x86, 0x3f, 0x5f, 0x9f, 0xf7, 0x1f, 0xea, 0x7a, 0x6f, 0xf4, 0x38, 0xe8,
+		0xfd, 0xca, 0x4b, 0x17, 0xd1, 0xfb, 0xb5, 0x48, 0xef, 0x16, 0xc7, 0x24, 0xcb, 0xe7, 0xbd, 0xe2,
+		0x98, 0x24, 0x7a, 0xbf, 0x2e, 0xc0, 0x03, 0x45, 0xed, 0x11, 0x21, 0xf7, 0x01, 0xb5, 0x47, 0x4a,
+		0xe3, 0x49, 0xa2, 0xf7, 0x6b, 0x69, 0x0c, 0x81, 0xbe, 0x51, 0xd1, 0xfb, 0x95, 0xc7, 0xf0, 0xa1,
+		0xf7, 0x2b, 0x11, 0x11, 0x68, 0x35, 0x11, 0x68, 0x35, 0x68, 0x35, 0x11, 0x7a, 0xbf, 0x72, 0xaf,
+		0x1c, 0x7a, 0xbf, 0xce, 0x58, 0x31, 0x58, 0x06, 0x58, 0x06, 0x22, 0x58, 0x86, 0x72, 0xe9, 0x18,
+		0x42, 0xef, 0xd7, 0x5c, 0x73, 0x83, 0xde, 0xaf, 0x39, 0xd7, 0x00, 0xbd, 0x5f, 0xd1, 0xfb, 0x95,
+		0x08, 0xbd, 0x5f, 0xd1, 0xfb, 0x15, 0xbd, 0x5f, 0xd1, 0xfb, 0xb5, 0x6c, 0xcf, 0x89, 0xde, 0xaf,
+		0x65, 0x4b, 0xed, 0x28, 0x4d, 0xeb, 0xd7, 0xca, 0x02, 0xd7, 0x91, 0x7b, 0xfd, 0xb4, 0xae, 0x9b,
+		0xb5, 0x56, 0x59, 0x40, 0xce, 0x8d, 0x55, 0x59, 0xcc, 0xea, 0x2a, 0xac, 0x2c, 0x53, 0x2b, 0x60,
+		0xd6, 0x16, 0xc0, 0x4c, 0x55, 0xc2, 0xd9, 0x5a, 0xfe, 0x72, 0x46, 0x5b, 0xf8, 0xa3, 0x2b, 0xdc,
+		0xd1, 0x14, 0x6d, 0xd1, 0x13, 0x6d, 0xd1, 0x12, 0x2d, 0xd1, 0x91, 0xc5, 0xea, 0x5a, 0xae, 0x2a,
+		0xdc, 0x16, 0x77, 0x5c, 0x76, 0x2c, 0xbf, 0xbc, 0x1e, 0x0a, 0x73, 0x00, 0x96, 0x3d, 0xf0, 0x8a,
+		0x6e, 0xdf, 0x84, 0x6e, 0xdf, 0x7a, 0x55, 0x06, 0x93, 0xea, 0x98, 0x0e, 0x0a, 0xfe, 0xaa, 0x98,
+		0x11, 0x28, 0xd5, 0x11, 0x20, 0xd5, 0x13, 0x18, 0xfd, 0x55, 0x31, 0x35, 0x10, 0xaa, 0x33, 0xe6,
+		0xa3, 0x3d, 0xd6, 0x63, 0x7c, 0xc0, 0xf3, 0xaa, 0xcc, 0xfb, 0x2b, 0xbf, 0x2a, 0xa6, 0x06, 0x34,
+		0x97, 0x42, 0xa8, 0x11, 0xb8, 0x5c, 0x8a, 0xc0, 0x65, 0x59, 0x03, 0x81, 0x57, 0x65, 0x09, 0x60,
+		0x31, 0xb8, 0xbb, 0x4e, 0x18, 0x04, 0x6e, 0x3f, 0xf7, 0xb4, 0x6a, 0xdf, 0x84, 0x71, 0xaa, 0xc1,
+		0xb7, 0x79, 0xf9, 0x15, 0xf0, 0x72, 0x94, 0x27, 0x15, 0x5e, 0x0e, 0x11, 0xbc, 0x1c, 0x46, 0x4d,
+		0x40, 0x7a, 0xbd, 0x1c, 0x1d, 0x55, 0x21, 0x34, 0x54, 0x81, 0xd0, 0x74, 0xe6, 0xe6, 0x57, 0xc5,
+		0xa8, 0x33, 0x36, 0xba, 0xeb, 0x03, 0x69, 0x3e, 0x53, 0x53, 0xc4, 0x71, 0x0a, 0x1d, 0xd5, 0xa7,
+		0x74, 0x9e, 0x9d, 0x29, 0x6a, 0x49, 0x8b, 0xab, 0xb2, 0x50, 0xc8, 0x2a, 0x83, 0x81, 0xea, 0x46,
+		0xc1, 0x24, 0x3d, 0x74, 0xfc, 0x30, 0x71, 0xf5, 0x32, 0xd0, 0xe1, 0x57, 0x80, 0x81, 0x2a, 0x4f,
+		0x2a, 0x18, 0x28, 0x11, 0x18, 0x28, 0xa3, 0x26, 0x20, 0x30, 0x50, 0x30, 0xd0, 0xa9, 0x89, 0x05,
+		0x03, 0xd5, 0x8d, 0x38, 0x22, 0x30, 0xd0, 0x32, 0xaf, 0x32, 0x18, 0xa8, 0x6e, 0x14, 0x4c, 0xd2,
+		0xc3, 0x8e, 0xed, 0xf9, 0xbd, 0x58, 0x33, 0x07, 0x1d, 0x7f, 0x09, 0x58, 0xa8, 0xf2, 0xb4, 0x82,
+		0x85, 0x12, 0x81, 0x85, 0x32, 0x6a, 0x03, 0x02, 0x0b, 0x05, 0x0b, 0x9d, 0x9a, 0x58, 0xb0, 0x50,
+		0xdd, 0x88, 0x23, 0x02, 0x0b, 0x2d, 0xf3, 0x2a, 0x83, 0x85, 0xea, 0x46, 0xc1, 0x24, 0x41, 0xcc,
+		0x0e, 0x6f, 0xe8, 0xa5, 0xa0, 0x83, 0x6f, 0x00, 0xff, 0x54, 0x9e, 0x53, 0xf0, 0x4f, 0x22, 0xf0,
+		0x4f, 0x46, 0x3d, 0x40, 0xe0, 0x9f, 0xe0, 0x9f, 0x53, 0x13, 0x0b, 0xfe, 0xa9, 0x1b, 0x71, 0x44,
+		0xe0, 0x9f, 0x65, 0x5e, 0x65, 0xf0, 0x4f, 0xdd, 0x28, 0x98, 0x64, 0x87, 0xc3, 0x6e, 0x64, 0x7a,
+		0x29, 0xe8, 0xf8, 0x4b, 0xc0, 0x42, 0x95, 0xa7, 0x15, 0x2c, 0x94, 0x08, 0x2c, 0x94, 0x51, 0x1b,
+		0x10, 0x58, 0x28, 0x58, 0xe8, 0xd4, 0xc4, 0x82, 0x85, 0xea, 0x46, 0x1c, 0x11, 0x58, 0x68, 0x99,
+		0x57, 0x19, 0x2c, 0x54, 0x37, 0x0a, 0x2c, 0x37, 0x8e, 0xc3, 0x38, 0x6b, 0x80, 0xeb, 0xb8, 0xde,
+		0xbd, 0xdb, 0xe6, 0x67, 0xa0, 0xd3, 0x5f, 0x00, 0xf6, 0xa9, 0x3c, 0xa5, 0x60, 0x9f, 0x44, 0x60,
+		0x9f, 0x8c, 0x5a, 0x80, 0xc0, 0x3e, 0xc1, 0x3e, 0xa7, 0x26, 0x16, 0xec, 0x53, 0x37, 0xe2, 0x88,
+		0xc0, 0x3e, 0xcb, 0xbc, 0xca, 0x60, 0x9f, 0xba, 0x51, 0x60, 0x75, 0xdd, 0x24, 0xb1, 0x6f, 0x5d,
+		0x9d, 0xfc, 0xf3, 0xe5, 0x57, 0x80, 0x81, 0x2a, 0x4f, 0x2a, 0x18, 0x28, 0x11, 0x18, 0x28, 0xa3,
+		0x26, 0x20, 0x30, 0x50, 0x30, 0xd0, 0xa9, 0x89, 0x05, 0x03, 0xd5, 0x8d, 0x38, 0x22, 0x30, 0xd0,
+		0x32, 0xaf, 0x32, 0x18, 0xa8, 0x6e, 0x14, 0x3c, 0xd1, 0xc3, 0x64, 0x60, 0x62, 0x75, 0xb1, 0xcf,
+		0xfe, 0xf0, 0x60, 0x9e, 0xca, 0x13, 0x0a, 0xe6, 0x49, 0x04, 0xe6, 0xc9, 0xa8, 0x01, 0x08, 0xcc,
+		0x13, 0xcc, 0x73, 0x6a, 0x62, 0xc1, 0x3c, 0x75, 0x23, 0x8e, 0x08, 0xcc, 0xb3, 0xcc, 0xab, 0x0c,
+		0xe6, 0xa9, 0x1b, 0x05, 0xd6, 0xb0, 0x35, 0x0b, 0x33, 0xe1, 0xec, 0x8f, 0x0a, 0x9e, 0xa9, 0x3c,
+		0x8f, 0xe0, 0x99, 0x44, 0xe0, 0x99, 0x8c, 0x78, 0x27, 0xbd, 0x3c, 0x33, 0x49, 0x63, 0x2f, 0xb8,
+		0xd5, 0xd1, 0xd1, 0x60, 0x7b, 0x89, 0x74, 0xee, 0x30, 0x07, 0x9e, 0x5f, 0xed, 0x8e, 0x06, 0x86,
+		0xe6, 0x55, 0x9e, 0x4a, 0x68, 0x5e, 0x22, 0x68, 0x5e, 0x46, 0xd4, 0x93, 0x5e, 0xcd, 0xdb, 0xf3,
+		0x82, 0xb4, 0xbe, 0xa9, 0x41, 0xf3, 0x6e, 0xc2, 0xbd, 0xe7, 0xbd, 0xe0, 0xde, 0x17, 0x05, 0xb7,
+		0xa5, 0x73, 0xef, 0x37, 0x5b, 0xad, 0x0d, 0xf8, 0xf3, 0xcb, 0xeb, 0xcf, 0xa3, 0x35, 0xed, 0xdc,
+		0xd6, 0xb4, 0x0c, 0xbd, 0x84, 0x17, 0xd3, 0x23, 0x36, 0xb5, 0x1d, 0xdb, 0x49, 0xf8, 0x9a, 0xc4,
+		0x0e, 0xc7, 0x2b, 0x59, 0x97, 0xd8, 0x1a, 0xba, 0xc4, 0x96, 0x80, 0xba, 0xa3, 0x4b, 0xec, 0x02,
+		0xba, 0xc4, 0x3a, 0x23, 0x0c, 0xf0, 0x1f, 0x9e, 0xcf, 0xc6, 0xe5, 0xf5, 0xe9, 0xeb, 0xf0, 0xe9,
+		0x19, 0x2e, 0xf8, 0xf4, 0x85, 0x2a, 0x0a, 0x1e, 0x85, 0xc1, 0xa4, 0x38, 0xd8, 0x15, 0xc8, 0xe8,
+		0xb2, 0xa2, 0x30, 0x4e, 0xf9, 0x45, 0x6a, 0x04, 0x82, 0xfe, 0xe8, 0xcc, 0x8b, 0xbd, 0xef, 0x76,
+		0xec, 0x9e, 0xdf, 0x5f, 0xeb, 0xe6, 0x0e, 0xf7, 0xe0, 0x7a, 0x7c, 0x2b, 0x76, 0xcd, 0xa5, 0x53,
+		0x83, 0xe9, 0xd7, 0x64, 0xba, 0x35, 0x5a, 0x61, 0x9a, 0xad, 0x30, 0x0d, 0x57, 0x88, 0xa6, 0xe3,
+		0xd5, 0x78, 0xe3, 0xd1, 0x98, 0x25, 0x9e, 0x3d, 0xaa, 0xf9, 0xaa, 0xd2, 0xaa, 0x06, 0xbd, 0xee,
+		0x8d, 0x1b, 0x6b, 0xec, 0x7e, 0xbb, 0xa9, 0x61, 0x68, 0x3d, 0x21, 0xcf, 0xd1, 0xa5, 0x07, 0xa4,
+		0xa4, 0x3b, 0x04, 0x3a, 0x3d, 0xf1, 0x9a, 0xe2, 0x66, 0x2f, 0xe2, 0x67, 0xba, 0xbf, 0xa7, 0x80,
+		0x18, 0x9a, 0x26, 0x0c, 0x17, 0x16, 0x2a, 0x5d, 0xd4, 0xd2, 0x6b, 0x0c, 0x9d, 0x2e, 0x64, 0xf9,
+		0x2b, 0x66, 0x8c, 0x5a, 0xd6, 0x66, 0xce, 0x8c, 0xf0, 0xb1, 0x12, 0xd7, 0x89, 0xdd, 0xb4, 0xfa,
+		0xdd, 0x7d, 0xd0, 0xc7, 0xda, 0x27, 0xbe, 0x03, 0xf4, 0x1a, 0xf4, 0x9a, 0x08, 0xf4, 0xba, 0x94,
+		0x7a, 0x85, 0x8c, 0xa4, 0xd7, 0x71, 0xd8, 0x4b, 0xbd, 0xe0, 0xb6, 0x1a, 0xd9, 0x49, 0xd2, 0x17,
+		0x1f, 0x7d, 0x1c, 0x9b, 0x29, 0x91, 0x8b, 0x7f, 0xe5, 0x58, 0x2d, 0x42, 0x1f, 0xe5, 0x55, 0xbb,
+		0xdd, 0x8e, 0xdd, 0x24, 0xd1, 0x68, 0x15, 0x9e, 0x7f, 0x0f, 0x2c, 0x03, 0x2c, 0x03, 0x11, 0x2c,
+		0x43, 0x29, 0xf5, 0x0b, 0x19, 0x69, 0x19, 0xbc, 0x48, 0x93, 0x76, 0x99, 0xd4, 0x30, 0xf5, 0x1d,
+		0x0d, 0x63, 0x0f, 0xe7, 0xc6, 0xb8, 0xb8, 0xcb, 0xd3, 0xcc, 0xdf, 0x37, 0x35, 0xce, 0xfd, 0x8b,
+		0x35, 0xd8, 0xd6, 0xf8, 0x1d, 0x67, 0x76, 0x9a, 0xba, 0x71, 0xa0, 0x6d, 0x39, 0x46, 0x97, 0xf5,
+		0x9f, 0x77, 0xef, 0xbe, 0xd6, 0xaa, 0x3b, 0x57, 0x7f, 0x7f, 0xad, 0x57, 0x77, 0xae, 0x06, 0x3f,
+		0xd6, 0xfb, 0xff, 0x0c, 0x7e, 0x6e, 0x7c, 0xad, 0x55, 0x9b, 0xa3, 0x9f, 0x5b, 0x5f, 0x6b, 0xd5,
+		0xd6, 0xd5, 0xfb, 0x6f, 0xdf, 0x3e, 0xbc, 0xff, 0xb5, 0xf1, 0x28, 0x7e, 0xe3, 0x3f, 0x2c, 0x6d,
+		0x2f, 0x73, 0xa5, 0xc7, 0xbd, 0x5f, 0x33, 0x18, 0x0c, 0x9b, 0x00, 0x83, 0x1c, 0x18, 0xec, 0x6a,
+		0x67, 0xb7, 0xfa, 0xf9, 0xea, 0x57, 0x7d, 0xad, 0xf9, 0xf8, 0xf1, 0xfd, 0xaf, 0xad, 0xc7, 0xe9,
+		0x3f, 0xfe, 0xfd, 0xda, 0xc7, 0xea, 0x6b, 0x5b, 0x8f, 0x1f, 0x67, 0xfc, 0x97, 0xcd, 0xc7, 0x8f,
+		0x39, 0xc7, 0x68, 0x3d, 0xbe, 0x7b, 0xf1, 0xd1, 0xec, 0xef, 0x8d, 0x59, 0x37, 0x34, 0x67, 0xdc,
+		0xb0, 0x31, 0xeb, 0x86, 0x8d, 0x19, 0x37, 0xcc, 0x7c, 0xa4, 0xc6, 0x8c, 0x1b, 0x5a, 0x8f, 0x7f,
+		0xbf, 0xf8, 0xfc, 0xbb, 0xd7, 0x3f, 0xba, 0xf9, 0xf8, 0xfe, 0xef, 0x59, 0xff, 0x6d, 0xeb, 0xf1,
+		0xef, 0x8f, 0xef, 0x0d, 0x54, 0x0d, 0xab, 0x13, 0xf9, 0x2b, 0x55, 0xf2, 0x00, 0x73, 0xd2, 0xa5,
+		0xe6, 0xe4, 0xcb, 0x41, 0xce, 0xe1, 0xfa, 0x60, 0x24, 0x6b, 0x89, 0x4e, 0x64, 0x0d, 0xb2, 0x4a,
+		0xd9, 0x53, 0xb7, 0x06, 0xc3, 0x96, 0x3c, 0x73, 0xab, 0x81, 0xcc, 0x2d, 0x83, 0xdc, 0x6c, 0x64,
+		0x6e, 0x21, 0x73, 0x8b, 0x08, 0x99, 0x5b, 0x08, 0x20, 0x16, 0xa4, 0xd1, 0x0a, 0xd3, 0x6c, 0x85,
+		0x69, 0xb8, 0x42, 0x34, 0x1d, 0xaf, 0xc6, 0x1b, 0x8f, 0x86, 0xcc, 0x2d, 0x22, 0x64, 0x6e, 0x11,
+		0x21, 0x73, 0xcb, 0xdc, 0xd4, 0x1d, 0x64, 0x6e, 0x11, 0x21, 0x73, 0x8b, 0x08, 0xf1, 0x9b, 0x05,
+		0xc3, 0x07, 0x99, 0x5b, 0x44, 0xa0, 0xd7, 0x44, 0xa0, 0xd7, 0x5a, 0xbe, 0x08, 0xf4, 0x1a, 0x99,
+		0x5b, 0x45, 0xac, 0x1c, 0x32, 0xb7, 0x66, 0xac, 0x18, 0x2c, 0x03, 0x2c, 0x03, 0x11, 0x2c, 0x43,
+		0xb9, 0x74, 0x0c, 0x21, 0x73, 0x2b, 0xd7, 0xdc, 0x20, 0x73, 0x6b, 0x05, 0x93, 0x55, 0x90, 0xb9,
+		0x55, 0x84, 0xfa, 0x21, 0x42, 0xe6, 0x96, 0x01, 0x60, 0x40, 0xe6, 0x16, 0x32, 0xb7, 0x0a, 0xe6,
+		0x39, 0x84, 0xcc, 0xad, 0x65, 0xce, 0xdc, 0x62, 0xa8, 0x9e, 0xc7, 0xb7, 0x1a, 0x28, 0x77, 0x98,
+		0x77, 0xdd, 0x2c, 0x96, 0x14, 0xb7, 0xb8, 0xe7, 0xa4, 0xc3, 0x76, 0x0f, 0xd6, 0x45, 0xff, 0x61,
+		0xae, 0x77, 0x6d, 0xfb, 0xfa, 0xa2, 0xff, 0x4d, 0x5f, 0xb2, 0x6f, 0x1f, 0xfe, 0x7c, 0x7d, 0x39,
+		0xf8, 0xd6, 0x45, 0x55, 0x59, 0xac, 0x14, 0x28, 0x0f, 0x99, 0x77, 0x6d, 0x7d, 0x24, 0x4b, 0x8d,
+		0xa2, 0x58, 0x47, 0x5e, 0x92, 0xee, 0xa6, 0xa9, 0x5a, 0x3e, 0x51, 0xb6, 0x73, 0x7b, 0xe0, 0xbb,
+		0x99, 0x67, 0x9c, 0x58, 0x1f, 0x29, 0xe8, 0xf9, 0xfe, 0x9a, 0xc2, 0x60, 0xf6, 0x4f, 0xbe, 0xc1,
+		0x4e, 0xe3, 0xb6, 0x1b, 0xbb, 0xed, 0x4f, 0x0f, 0xc3, 0xa1, 0x0a, 0x5d, 0x22, 0x26, 0xa8, 0xea,
+		0x81, 0xa8, 0x02, 0x36, 0x45, 0x30, 0x69, 0x55, 0x8a, 0x81, 0xd2, 0x63, 0x45, 0xe3, 0x8a, 0xaa,
+		0xae, 0x24, 0xf3, 0x0a, 0x5a, 0x15, 0x3d, 0x33, 0x23, 0x30, 0x2b, 0x92, 0x99, 0xc7, 0x4a, 0x19,
+		0xc6, 0x92, 0x99, 0xc4, 0xd2, 0x19, 0xc3, 0x2a, 0xe1, 0x5d, 0xf5, 0xf0, 0xad, 0x6a, 0x78, 0x96,
+		0x2d, 0xfc, 0xca, 0x16, 0x5e, 0x65, 0x09, 0x9f, 0xea, 0xc5, 0xb9, 0x6c, 0x66, 0xad, 0x5a, 0x3b,
+		0x2a, 0x8e, 0xf6, 0x53, 0x8a, 0x7b, 0x27, 0xca, 0x7b, 0x24, 0x1c, 0x7b, 0x21, 0x7c, 0x7b, 0x1e,
+		0x5c, 0x7b, 0x1b, 0xec, 0x7b, 0x18, 0xec, 0x7b, 0x15, 0xac, 0x7b, 0x12, 0xc5, 0x32, 0x58, 0xe5,
+		0xbd, 0x04, 0xbe, 0xf6, 0x4d, 0x8a, 0x7b, 0xc5, 0x12, 0x74, 0x45, 0xc2, 0x20, 0xa4, 0x2a, 0xf3,
+		0x35, 0x9e, 0xab, 0xfe, 0x28, 0xd0, 0x31, 0xd0, 0x31, 0xd0, 0x31, 0x42, 0xf2, 0xe2, 0xb5, 0xdd,
+		0x20, 0xf5, 0xd2, 0x87, 0xd8, 0xed, 0x70, 0x28, 0x1a, 0x85, 0xcc, 0x50, 0xeb, 0x70, 0xf8, 0x28,
+		0x9f, 0xec, 0xc4, 0xe5, 0x6b, 0xcc, 0xb0, 0xbb, 0xbb, 0x7b, 0x7d, 0x71, 0x70, 0xfe, 0xe7, 0xc1,
+		0xf9, 0xf5, 0xe5, 0xbf, 0xcf, 0x0e, 0x54, 0x85, 0xb0, 0x9f, 0x06, 0x9b, 0xb0, 0xec, 0x39, 0x30,
+		0x9f, 0x6e, 0xbc, 0xdc, 0xdd, 0xdb, 0xdd, 0xbb, 0xb0, 0xca, 0x70, 0x80, 0x93, 0xf9, 0xcd, 0xce,
+		0x77, 0xf7, 0x0f, 0xff, 0xb8, 0x58, 0x74, 0x67, 0x83, 0xab, 0xa2, 0x75, 0x03, 0x82, 0x05, 0xaf,
+		0x06, 0x0b, 0xc4, 0x63, 0xe6, 0x8f, 0x15, 0xde, 0x4f, 0xe6, 0x9c, 0xb9, 0x51, 0x2c, 0x53, 0xc0,
+		0xfd, 0x91, 0x0b, 0x5c, 0x2a, 0x05, 0x2a, 0x95, 0x02, 0x93, 0x72, 0x81, 0xc8, 0xbc, 0xf3, 0x27,
+		0x29, 0x71, 0x4c, 0x92, 0x66, 0xad, 0x55, 0x58, 0x23, 0x87, 0x56, 0x85, 0x47, 0x02, 0x1f, 0x2b,
+		0x0a, 0x73, 0x2b, 0x3a, 0xa7, 0xf2, 0x73, 0x69, 0x55, 0xe4, 0x5e, 0xe2, 0x8d, 0x17, 0xc8, 0x19,
+		0x9d, 0x13, 0x8a, 0xc6, 0xe5, 0x8c, 0xbe, 0xe5, 0x8e, 0xb6, 0x89, 0x90, 0x79, 0x71, 0xd2, 0x2e,
+		0x4a, 0xce, 0xa5, 0x49, 0xb8, 0x34, 0xd9, 0x96, 0x22, 0xd5, 0x25, 0x16, 0xe9, 0xf9, 0xd6, 0xe6,
+		0xb1, 0x22, 0xf6, 0x5f, 0x66, 0xbc, 0x4e, 0xde, 0xd7, 0x10, 0x79, 0x7c, 0x6b, 0xad, 0x22, 0xac,
+		0xb2, 0xac, 0x4a, 0xbe, 0x57, 0x79, 0xe5, 0x35, 0x2c, 0xdb, 0xb7, 0xe3, 0xee, 0xec, 0x34, 0xed,
+		0xb1, 0xbc, 0x0f, 0x3f, 0xb7, 0x56, 0x91, 0x40, 0xe4, 0x5c, 0x24, 0xe6, 0x41, 0xe0, 0x33, 0xe4,
+		0xbd, 0xf5, 0x30, 0x22, 0xa0, 0x13, 0x06, 0x9b, 0x30, 0xc8, 0x5e, 0x80, 0x6b, 0xf0, 0xe8, 0x4c,
+		0x02, 0x38, 0x2f, 0x4a, 0x3c, 0x58, 0xb6, 0xfc, 0xea, 0x77, 0xf0, 0x71, 0x66, 0xf5, 0x5b, 0xd3,
+		0xa4, 0x7e, 0xe7, 0x09, 0x81, 0xc1, 0x1a, 0x78, 0x8e, 0x90, 0xf0, 0x28, 0xe1, 0xbc, 0x5b, 0x0c,
+		0xa2, 0x7d, 0xd9, 0xe4, 0xfa, 0xae, 0x09, 0xee, 0xa9, 0x09, 0x87, 0xf0, 0x64, 0x42, 0x76, 0x52,
+		0xe2, 0x26, 0x2b, 0x76, 0xca, 0xe2, 0xa7, 0x2c, 0x86, 0xaa, 0xe2, 0x98, 0x4f, 0x2c, 0x73, 0x8a,
+		0xa7, 0xa8, 0x91, 0x95, 0x37, 0xba, 0xfd, 0xb7, 0x1b, 0xfc, 0x23, 0x54, 0x98, 0xeb, 0xb1, 0xc2,
+		0xf0, 0x8a, 0x96, 0xd7, 0x16, 0x07, 0x96, 0xd7, 0x16, 0x04, 0x55, 0x0d, 0xa0, 0x02, 0xa8, 0x94,
+		0xa2, 0xd1, 0xe3, 0x55, 0xf3, 0x5d, 0xbb, 0x23, 0x16, 0x79, 0x1e, 0x6b, 0xf6, 0x2d, 0x81, 0x7b,
+		0xce, 0x86, 0xb8, 0xfd, 0xf0, 0x61, 0x40, 0xe8, 0xd7, 0xbd, 0x76, 0x91, 0xa8, 0x14, 0x4b, 0x2a,
+		0x91, 0x4a, 0x26, 0x91, 0x36, 0x78, 0x0d, 0x60, 0x73, 0xa9, 0xb1, 0x29, 0x9a, 0xfa, 0x21, 0x62,
+		0x42, 0xe4, 0x4d, 0xc9, 0xb4, 0xd8, 0xd6, 0x64, 0x73, 0x9f, 0x6a, 0x0b, 0xca, 0x7d, 0x12, 0x15,
+		0x67, 0x55, 0xb1, 0x66, 0x13, 0x6f, 0x36, 0x31, 0xe7, 0x12, 0x77, 0x31, 0xb1, 0x17, 0x14, 0x7f,
+		0x79, 0x13, 0xc5, 0x97, 0x8c, 0x21, 0x99, 0x84, 0xa1, 0x27, 0xbf, 0x31, 0x76, 0x13, 0x49, 0xbd,
+		0x3a, 0x9a, 0x87, 0xf1, 0x08, 0x40, 0x3a, 0x90, 0x0e, 0xa4, 0x97, 0x16, 0xe9, 0x89, 0x7b, 0xef,
+		0xc6, 0x5e, 0xfa, 0xa0, 0x90, 0xcc, 0x3c, 0x1a, 0x01, 0x48, 0x07, 0xd2, 0x97, 0x12, 0xe9, 0x6a,
+		0xc9, 0x4f, 0x2a, 0x49, 0x4f, 0x3c, 0xc9, 0x4e, 0xe3, 0x17, 0x39, 0x3d, 0x3b, 0x38, 0xd9, 0x3b,
+		0x3d, 0xf9, 0x7c, 0xf8, 0xe5, 0x7a, 0xf7, 0x68, 0xf7, 0xfc, 0xf8, 0xfa, 0xe2, 0xe0, 0xcf, 0x83,
+		0xf3, 0xc3, 0xcb, 0x7f, 0xcb, 0x4a, 0x12, 0x43, 0x9a, 0x13, 0x53, 0xfe, 0xd6, 0x1f, 0x27, 0xbf,
+		0x9f, 0x9c, 0xfe, 0x75, 0xa2, 0x90, 0x99, 0xb7, 0xb6, 0xe8, 0x57, 0xd8, 0x3b, 0x3f, 0xbc, 0x3c,
+		0xdc, 0xdb, 0x3d, 0x32, 0xf9, 0x1d, 0x8e, 0x77, 0xff, 0x9f, 0xd3, 0x73, 0x93, 0x5f, 0xe0, 0xaf,
+		0xdd, 0xf3, 0x93, 0xc3, 0x93, 0x2f, 0x46, 0xaf, 0xc1, 0xe1, 0x89, 0xd2, 0x1a, 0x54, 0x8a, 0x49,
+		0x7b, 0x7b, 0x2c, 0x01, 0xfb, 0x49, 0xdd, 0x9f, 0xa9, 0x3c, 0xf3, 0xe9, 0xdf, 0x0d, 0xd6, 0x43,
+		0x04, 0xd6, 0x03, 0xff, 0xa6, 0xa4, 0xfe, 0x4d, 0xea, 0x75, 0xdd, 0xaa, 0x13, 0xbb, 0x76, 0xea,
+		0x2a, 0x44, 0x2c, 0x9f, 0x8d, 0x02, 0xc4, 0x03, 0xf1, 0x4b, 0x89, 0xf8, 0x4c, 0xca, 0x53, 0xcf,
+		0xf9, 0x9e, 0x6c, 0x36, 0x15, 0x60, 0x2f, 0x51, 0xcb, 0x49, 0xb1, 0x6a, 0xbf, 0x5a, 0xed, 0x06,
+		0x86, 0x23, 0x4c, 0x2c, 0x85, 0x3b, 0xb9, 0xaa, 0xe6, 0x73, 0x96, 0x45, 0x7f, 0x54, 0xab, 0x64,
+		0x51, 0xba, 0xa9, 0xad, 0x6f, 0x37, 0x9b, 0x9b, 0x5b, 0xcd, 0x66, 0x6d, 0x6b, 0x63, 0xab, 0xb6,
+		0xd3, 0x6a, 0xd5, 0x37, 0xeb, 0xad, 0x12, 0xcd, 0x76, 0x41, 0x07, 0x40, 0xae, 0xca, 0x60, 0x97,
+		0x1f, 0x22, 0xb7, 0xaa, 0xb2, 0x89, 0x38, 0x1a, 0x00, 0xd6, 0x98, 0x08, 0xd6, 0x78, 0x09, 0xad,
+		0x71, 0x2f, 0xc8, 0xd2, 0xcf, 0xd6, 0x2a, 0x45, 0x56, 0x79, 0x55, 0xab, 0xe2, 0xba, 0x0c, 0x67,
+		0xd9, 0xd7, 0x16, 0xf5, 0xe6, 0x4b, 0x7f, 0xc2, 0xf6, 0x45, 0xf0, 0x39, 0x3b, 0x67, 0x7b, 0x7d,
+		0xb8, 0xbf, 0xbc, 0x47, 0x6d, 0x0f, 0xfe, 0xdf, 0xb3, 0xcb, 0x65, 0x3c, 0x68, 0xbb, 0x7b, 0xb8,
+		0x94, 0xe7, 0x87, 0x8f, 0x4e, 0x97, 0xf2, 0xb5, 0x4e, 0x2f, 0x71, 0x26, 0x9a, 0xf9, 0x09, 0x1f,
+		0x57, 0x21, 0x2d, 0x5d, 0xe0, 0x04, 0xb5, 0xee, 0xd3, 0xa8, 0xc3, 0x13, 0xd2, 0x73, 0xd9, 0xbe,
+		0xd8, 0xb9, 0x68, 0xa9, 0xf3, 0xd0, 0x52, 0xe7, 0xa0, 0xc5, 0xce, 0x3f, 0x2f, 0xec, 0x20, 0xe3,
+		0xc4, 0xea, 0x5b, 0xb9, 0xf2, 0x95, 0x5f, 0x3b, 0x1a, 0xd8, 0xbf, 0xdb, 0xcc, 0x93, 0x90, 0x6f,
+		0x38, 0x12, 0xf9, 0x0e, 0x36, 0x3a, 0x7e, 0xe8, 0x7c, 0x9f, 0x7f, 0xae, 0x71, 0xf0, 0x31, 0xc5,
+		0x63, 0x8d, 0x35, 0x9e, 0x63, 0x8d, 0xc9, 0x83, 0x99, 0x67, 0x1a, 0xb3, 0xe7, 0x2e, 0xea, 0x40,
+		0x63, 0xce, 0xb3, 0x68, 0x62, 0x67, 0xd0, 0xca, 0x72, 0xa4, 0xf1, 0x6d, 0x01, 0x10, 0x15, 0x04,
+		0x69, 0x81, 0x90, 0x16, 0x0c, 0x29, 0x01, 0xe1, 0x31, 0x4b, 0xb9, 0x0f, 0x33, 0x66, 0x81, 0xf5,
+		0xff, 0x0b, 0x03, 0xb7, 0x2a, 0x54, 0x28, 0xf1, 0x59, 0x5c, 0xfe, 0xe9, 0xf6, 0xe5, 0x38, 0x85,
+		0x95, 0x4f, 0xec, 0x64, 0xc5, 0x4f, 0x59, 0x0c, 0x95, 0xc5, 0x51, 0x49, 0x2c, 0xf3, 0x89, 0x67,
+		0x4e, 0x31, 0x9d, 0x8e, 0xed, 0x48, 0xac, 0xd7, 0x33, 0x01, 0xac, 0x0a, 0xd6, 0xd0, 0x13, 0x8c,
+		0xc8, 0x98, 0x5a, 0xd9, 0xa4, 0x6f, 0xd4, 0x73, 0x9d, 0xe5, 0x44, 0x51, 0x13, 0x98, 0x20, 0x22,
+		0x98, 0xa0, 0x99, 0x62, 0x06, 0x13, 0xc4, 0x22, 0x8e, 0x4a, 0x62, 0x99, 0x5f, 0x1d, 0x13, 0x4c,
+		0x50, 0xa9, 0x4c, 0x90, 0xc1, 0xb5, 0x88, 0xde, 0x72, 0x8c, 0x69, 0x66, 0xc8, 0x61, 0xaf, 0x7f,
+		0x97, 0x8a, 0xdb, 0xfe, 0xb6, 0x7f, 0x97, 0xcf, 0xaf, 0x83, 0xe3, 0xce, 0xa0, 0x7d, 0x98, 0x1d,
+		0xf7, 0x76, 0xd8, 0xb5, 0xbd, 0x20, 0x9f, 0xc9, 0x1b, 0xcf, 0xed, 0xe4, 0x4d, 0xf9, 0xf8, 0x53,
+		0x0d, 0x2e, 0x3c, 0x8b, 0xc1, 0x5a, 0x00, 0x7f, 0xca, 0x6d, 0x90, 0x24, 0xc4, 0x83, 0x04, 0x3b,
+		0x1c, 0x5a, 0x47, 0x6e, 0x70, 0x9b, 0xde, 0xe5, 0xde, 0xc9, 0x14, 0xab, 0xfc, 0x29, 0x41, 0x5c,
+		0xe4, 0x52, 0x65, 0xc6, 0xb9, 0x56, 0x6b, 0x95, 0xa2, 0x12, 0xa9, 0x1e, 0xc5, 0xea, 0x98, 0x16,
+		0x3e, 0x15, 0x8d, 0xd6, 0x46, 0x81, 0x93, 0xc1, 0xc4, 0x71, 0xae, 0xd6, 0x2a, 0xec, 0xbd, 0x35,
+		0xad, 0x77, 0xef, 0xde, 0x7d, 0xb5, 0xab, 0xff, 0xb7, 0x5b, 0xfd, 0xdf, 0x5a, 0x75, 0xe7, 0xfa,
+		0x6a, 0xe2, 0x97, 0x6f, 0xdf, 0xaa, 0xd7, 0x57, 0xef, 0x7f, 0xd5, 0xd6, 0x36, 0xeb, 0x8f, 0xef,
+		0xff, 0xf9, 0xf4, 0xf7, 0xab, 0xac, 0x5f, 0xec, 0xff, 0xc8, 0xdc, 0xf5, 0xcf, 0xf7, 0x7f, 0x7f,
+		0xfb, 0xf6, 0xc1, 0xaa, 0xa8, 0xed, 0xfd, 0xc9, 0x79, 0xeb, 0x77, 0x61, 0x92, 0x8a, 0x99, 0x9d,
+		0xf1, 0x1d, 0xb0, 0x39, 0xb0, 0x39, 0x44, 0xb0, 0x39, 0x44, 0xb0, 0x39, 0xb0, 0x39, 0x44, 0xb0,
+		0x39, 0xf9, 0xa4, 0xce, 0xf2, 0xc3, 0x5b, 0x2f, 0xa8, 0xde, 0xd8, 0x41, 0xe0, 0xc6, 0xf9, 0xed,
+		0xce, 0xb3, 0xbb, 0x60, 0x7b, 0x60, 0x7b, 0x64, 0x33, 0x72, 0x73, 0x06, 0xd9, 0xe4, 0x64, 0xbb,
+		0x1b, 0xa6, 0x6d, 0x61, 0xd1, 0x9e, 0xbc, 0x09, 0x92, 0x0d, 0xc9, 0x5e, 0x9c, 0x64, 0x2f, 0x36,
+		0xaa, 0xfa, 0xc6, 0x9e, 0x64, 0xce, 0x00, 0x69, 0xd4, 0xcb, 0x51, 0xae, 0xbd, 0xff, 0xa9, 0x72,
+		0x14, 0x6b, 0x47, 0x70, 0x34, 0x47, 0x56, 0x53, 0xd4, 0x13, 0x48, 0x69, 0x8a, 0x7a, 0xc8, 0x67,
+		0xc2, 0x66, 0x32, 0x11, 0x11, 0x91, 0xe5, 0x05, 0x6d, 0xf7, 0xa7, 0x44, 0x09, 0xe9, 0xfe, 0x6d,
+		0xd8, 0x3c, 0xce, 0x7f, 0x61, 0xf3, 0x58, 0xc6, 0xc2, 0x97, 0xa7, 0x7e, 0x74, 0x5f, 0xe0, 0x51,
+		0x42, 0x1a, 0xc0, 0x5c, 0x6a, 0x60, 0x0a, 0x17, 0x8f, 0xbe, 0xb3, 0xe3, 0xf6, 0x0f, 0x3b, 0x76,
+		0xab, 0x5e, 0x90, 0xba, 0x71, 0xdc, 0x8b, 0x14, 0x8a, 0x30, 0xbd, 0x32, 0x56, 0xc1, 0x8d, 0xf5,
+		0x17, 0x74, 0x24, 0x5c, 0x4c, 0xd0, 0x55, 0x05, 0x9e, 0x4d, 0xf0, 0xd9, 0x00, 0xc0, 0x02, 0x04,
+		0x31, 0x40, 0x08, 0x02, 0x43, 0x1a, 0x20, 0xa3, 0xcb, 0xb2, 0xef, 0x6f, 0xd5, 0xab, 0x3e, 0x66,
+		0x83, 0xa0, 0xe5, 0x75, 0x26, 0x1a, 0x59, 0xda, 0x58, 0x82, 0xa6, 0xd7, 0x12, 0xa0, 0x1a, 0xcc,
+		0xdc, 0xca, 0xb5, 0xbd, 0x8e, 0xdc, 0xd8, 0x71, 0x83, 0xd4, 0xbe, 0x75, 0x19, 0xce, 0xe4, 0xab,
+		0x1c, 0xc9, 0x57, 0x2b, 0x90, 0x34, 0xba, 0xd4, 0xa4, 0x96, 0xb8, 0x0a, 0x26, 0x31, 0xa9, 0x97,
+		0x99, 0x5b, 0x5f, 0x5c, 0xe3, 0x31, 0x96, 0xf6, 0x51, 0x14, 0x69, 0xe5, 0xdd, 0xc4, 0xa2, 0x97,
+		0xa0, 0x5e, 0x2b, 0xf3, 0x22, 0x54, 0x16, 0x73, 0xf7, 0x55, 0x51, 0x0d, 0xbe, 0x25, 0x68, 0xa1,
+		0x17, 0x24, 0xa9, 0x1d, 0xa4, 0xea, 0x6c, 0x63, 0x34, 0x10, 0x18, 0x07, 0x18, 0x07, 0x11, 0x18,
+		0x07, 0x11, 0x18, 0x07, 0x11, 0x18, 0xc7, 0xc2, 0x8c, 0x1d, 0x18, 0x47, 0x09, 0x16, 0x01, 0x8c,
+		0x63, 0xfa, 0xb2, 0xfa, 0xf1, 0xba, 0x7b, 0xdb, 0xe7, 0xa0, 0x1c, 0xc3, 0x91, 0xc0, 0x39, 0xc0,
+		0x39, 0x88, 0xc0, 0x39, 0x88, 0x84, 0xb7, 0x7f, 0xaa, 0x8a, 0x20, 0x22, 0xb5, 0x0a, 0xd0, 0xa3,
+		0xcb, 0xfa, 0x23, 0x18, 0xe8, 0x5c, 0x2b, 0xb0, 0x83, 0x30, 0x71, 0x9d, 0x30, 0x68, 0x27, 0x16,
+		0x68, 0x0c, 0xab, 0xc6, 0x9a, 0x69, 0x43, 0x41, 0x63, 0x16, 0xbe, 0x04, 0xfc, 0x15, 0xaa, 0xb5,
+		0xae, 0x0a, 0x78, 0xcd, 0xf4, 0x65, 0x75, 0x15, 0xa4, 0xec, 0x29, 0x75, 0xd5, 0xfe, 0x09, 0x36,
+		0x03, 0x36, 0x43, 0x04, 0x36, 0x43, 0x84, 0x08, 0x0a, 0x11, 0xa8, 0xc7, 0xe2, 0x8c, 0x1c, 0x22,
+		0x28, 0x25, 0x58, 0x04, 0x30, 0x8d, 0xe9, 0x2b, 0x23, 0x09, 0xd5, 0xac, 0x2e, 0x0e, 0x0b, 0xdd,
+		0x18, 0x8c, 0x04, 0xce, 0x01, 0xce, 0x41, 0x04, 0xce, 0x21, 0x28, 0x33, 0x6a, 0x9d, 0xb3, 0x38,
+		0xe3, 0x27, 0x20, 0x1d, 0x02, 0x16, 0x0f, 0xa4, 0x83, 0x08, 0xf1, 0x0e, 0xed, 0xfa, 0x69, 0xc9,
+		0x59, 0x88, 0x02, 0xd0, 0x9f, 0x08, 0x88, 0x17, 0x80, 0x7b, 0x80, 0x7b, 0x10, 0x81, 0x7b, 0x10,
+		0x21, 0xde, 0x41, 0x04, 0xea, 0xb1, 0x38, 0x23, 0x87, 0x78, 0x47, 0x09, 0x16, 0x01, 0x4c, 0x63,
+		0xfa, 0xca, 0x48, 0x02, 0x57, 0xbc, 0x63, 0x34, 0x12, 0x38, 0x07, 0x38, 0x07, 0x11, 0x38, 0x87,
+		0xa0, 0xcc, 0x20, 0xde, 0x41, 0x04, 0xd2, 0x01, 0xd2, 0x41, 0x84, 0x78, 0x87, 0xd1, 0x2c, 0x44,
+		0xeb, 0xb1, 0x5f, 0xc9, 0x76, 0x8d, 0xe2, 0x05, 0x9e, 0xa2, 0x5e, 0x92, 0xfd, 0xcf, 0xb0, 0xea,
+		0x84, 0xf2, 0x89, 0x78, 0x9a, 0x5d, 0x6a, 0x3f, 0xea, 0x5d, 0xff, 0x36, 0x1c, 0xfe, 0x70, 0x3c,
+		0x7a, 0x09, 0xda, 0xc9, 0x7b, 0x6d, 0xdf, 0x95, 0xaf, 0x21, 0xd0, 0xbf, 0x1b, 0x55, 0x03, 0xf4,
+		0x11, 0x3c, 0x54, 0x0d, 0x40, 0xd5, 0x00, 0xed, 0x10, 0xe1, 0x80, 0xca, 0x6b, 0x90, 0x81, 0x77,
+		0x24, 0x0b, 0x2a, 0x44, 0x64, 0x11, 0x91, 0x25, 0x38, 0x47, 0x70, 0x8e, 0x88, 0x10, 0x91, 0x2d,
+		0xbd, 0x2f, 0x84, 0xaa, 0x01, 0x60, 0x1c, 0x44, 0x60, 0x1c, 0x54, 0x00, 0x72, 0x08, 0x8c, 0xe3,
+		0x8d, 0x0b, 0x8c, 0x63, 0xe1, 0xc6, 0x0e, 0x8c, 0xa3, 0x04, 0x8b, 0x00, 0xc6, 0x31, 0x7d, 0xa1,
+		0x6a, 0x00, 0x11, 0x38, 0x07, 0x11, 0x38, 0x07, 0xaa, 0x06, 0x10, 0xa1, 0x6a, 0x40, 0x81, 0x1a,
+		0x6b, 0xa6, 0x0d, 0x05, 0x8d, 0x21, 0xc2, 0xae, 0xb2, 0x76, 0x8d, 0xb7, 0xdc, 0xbc, 0x06, 0x55,
+		0x03, 0xc0, 0x66, 0x88, 0xc0, 0x66, 0x16, 0xc5, 0x66, 0x10, 0x41, 0x21, 0x02, 0xf5, 0x00, 0xf5,
+		0x20, 0x42, 0x04, 0x65, 0xe9, 0x99, 0x06, 0xaa, 0x06, 0x10, 0x38, 0x07, 0x11, 0x38, 0xc7, 0x62,
+		0x39, 0x07, 0xb2, 0xe8, 0x89, 0x40, 0x3a, 0x40, 0x3a, 0x88, 0x10, 0xef, 0x58, 0x39, 0x16, 0x82,
+		0xaa, 0x01, 0xe0, 0x1e, 0x44, 0xe0, 0x1e, 0x0b, 0xe2, 0x1e, 0x88, 0x77, 0x10, 0x81, 0x7a, 0x80,
+		0x7a, 0x10, 0x21, 0xde, 0xb1, 0xf4, 0x4c, 0x03, 0x55, 0x03, 0x08, 0x9c, 0x83, 0x08, 0x9c, 0x63,
+		0xb1, 0x9c, 0x03, 0xf1, 0x0e, 0x22, 0x90, 0x0e, 0x90, 0x0e, 0x22, 0xc4, 0x3b, 0x8c, 0x66, 0x21,
+		0xcb, 0x58, 0x35, 0x40, 0xe2, 0x0c, 0x3c, 0xbd, 0x59, 0x27, 0xe0, 0x30, 0x1b, 0xb0, 0x0c, 0xa5,
+		0x01, 0x82, 0xb6, 0xfb, 0x53, 0xa1, 0x36, 0x40, 0xff, 0xf6, 0xb5, 0x4a, 0x01, 0x6a, 0x00, 0xc5,
+		0x01, 0x50, 0x1c, 0x40, 0x3b, 0x2b, 0x1b, 0xaf, 0x77, 0x2f, 0xc8, 0x54, 0x8c, 0xc4, 0x72, 0x8f,
+		0x2a, 0x5f, 0xec, 0x48, 0xdc, 0x3b, 0x7c, 0x6c, 0x39, 0xde, 0xc5, 0x40, 0x41, 0xdd, 0xa0, 0xd7,
+		0x75, 0xe3, 0x81, 0x76, 0x55, 0xa7, 0xa0, 0xf5, 0xa6, 0xc2, 0x18, 0x07, 0x41, 0xaf, 0x9b, 0xad,
+		0x60, 0xa1, 0x6c, 0x9e, 0x61, 0x0a, 0x7b, 0x5e, 0x90, 0x6e, 0x34, 0x18, 0x66, 0x6f, 0x0b, 0x04,
+		0xfe, 0xf5, 0x89, 0x01, 0x81, 0x5f, 0x3a, 0x02, 0xdf, 0x6c, 0xec, 0x34, 0x77, 0x36, 0xb7, 0x1a,
+		0x3b, 0xa0, 0xed, 0x0b, 0xa3, 0xed, 0x57, 0x25, 0x20, 0xa3, 0xdf, 0xdd, 0x38, 0x70, 0x7d, 0x79,
+		0x36, 0x3a, 0xbc, 0x1f, 0xb5, 0xaa, 0x88, 0x40, 0x47, 0x35, 0xe2, 0x05, 0xb5, 0xaa, 0xf4, 0x42,
+		0x84, 0x03, 0x2a, 0xaf, 0x41, 0x06, 0x31, 0x79, 0x59, 0x50, 0x21, 0x0f, 0x00, 0x79, 0x00, 0x04,
+		0x46, 0x0f, 0x46, 0x4f, 0x84, 0x3c, 0x00, 0xd9, 0x3b, 0x51, 0xab, 0x8a, 0x08, 0x8c, 0x83, 0x08,
+		0x8c, 0x83, 0x08, 0x8c, 0x83, 0x08, 0x8c, 0x83, 0x08, 0x8c, 0x43, 0xb7, 0xb1, 0x03, 0xe3, 0x28,
+		0xc1, 0x22, 0x80, 0x71, 0x4c, 0x5f, 0xa8, 0x55, 0x45, 0x04, 0xce, 0x41, 0x04, 0xce, 0x81, 0x5a,
+		0x55, 0x44, 0xa8, 0x55, 0x55, 0xa0, 0xc6, 0x9a, 0x69, 0x43, 0x41, 0x63, 0x88, 0x90, 0xcb, 0xa8,
+		0x5d, 0xe3, 0x2d, 0x37, 0xaf, 0x41, 0xad, 0x2a, 0xb0, 0x19, 0x22, 0xb0, 0x99, 0x45, 0xb1, 0x19,
+		0x44, 0x50, 0x88, 0x40, 0x3d, 0x40, 0x3d, 0x88, 0x10, 0x41, 0x59, 0x7a, 0xa6, 0x81, 0x5a, 0x55,
+		0x04, 0xce, 0x41, 0x04, 0xce, 0xb1, 0x58, 0xce, 0x81, 0xb3, 0x9b, 0x44, 0x20, 0x1d, 0x20, 0x1d,
+		0x44, 0x88, 0x77, 0xac, 0x1c, 0x0b, 0x41, 0xad, 0x2a, 0x70, 0x0f, 0x22, 0x70, 0x8f, 0x05, 0x71,
+		0x0f, 0xc4, 0x3b, 0x88, 0x40, 0x3d, 0x40, 0x3d, 0x88, 0x10, 0xef, 0x58, 0x7a, 0xa6, 0x81, 0x5a,
+		0x55, 0x04, 0xce, 0x41, 0x04, 0xce, 0xb1, 0x58, 0xce, 0x81, 0x78, 0x07, 0x11, 0x48, 0x07, 0x48,
+		0x07, 0x11, 0xe2, 0x1d, 0x46, 0xb3, 0x90, 0x65, 0xac, 0x55, 0x25, 0x75, 0x0a, 0x9e, 0xde, 0xac,
+		0x56, 0xf5, 0xfb, 0x60, 0xc8, 0x12, 0x94, 0x08, 0x08, 0x3c, 0x09, 0x9e, 0x31, 0xb6, 0x5b, 0xfd,
+		0xbb, 0x51, 0x1e, 0x40, 0x1f, 0x93, 0x43, 0x79, 0x00, 0x94, 0x07, 0xd0, 0x0e, 0x11, 0x0e, 0xa8,
+		0xbc, 0x06, 0x19, 0xb8, 0x41, 0xb2, 0xa0, 0x42, 0xe8, 0x15, 0xa1, 0x57, 0x82, 0x17, 0x04, 0x2f,
+		0x88, 0x08, 0xa1, 0xd7, 0xd2, 0x3b, 0x3d, 0x28, 0x0f, 0x00, 0xc6, 0x41, 0x04, 0xc6, 0x41, 0x05,
+		0x20, 0x87, 0xc0, 0x38, 0xde, 0xb8, 0xc0, 0x38, 0x16, 0x6e, 0xec, 0xc0, 0x38, 0x4a, 0xb0, 0x08,
+		0x60, 0x1c, 0xd3, 0x17, 0xca, 0x03, 0x10, 0x81, 0x73, 0x10, 0x81, 0x73, 0xa0, 0x3c, 0x00, 0x11,
+		0xca, 0x03, 0x14, 0xa8, 0xb1, 0x66, 0xda, 0x50, 0xd0, 0x18, 0x22, 0x6c, 0x1f, 0x6b, 0xd7, 0x78,
+		0xcb, 0xcd, 0x6b, 0x50, 0x1e, 0x00, 0x6c, 0x86, 0x08, 0x6c, 0x66, 0x51, 0x6c, 0x06, 0x11, 0x14,
+		0x22, 0x50, 0x0f, 0x50, 0x0f, 0x22, 0x44, 0x50, 0x96, 0x9e, 0x69, 0xa0, 0x3c, 0x00, 0x81, 0x73,
+		0x10, 0x81, 0x73, 0x2c, 0x96, 0x73, 0x20, 0x5d, 0x9e, 0x08, 0xa4, 0x03, 0xa4, 0x83, 0x08, 0xf1,
+		0x8e, 0x95, 0x63, 0x21, 0x28, 0x0f, 0x00, 0xee, 0x41, 0x04, 0xee, 0xb1, 0x20, 0xee, 0x81, 0x78,
+		0x07, 0x11, 0xa8, 0x07, 0xa8, 0x07, 0x11, 0xe2, 0x1d, 0x4b, 0xcf, 0x34, 0x50, 0x1e, 0x80, 0xc0,
+		0x39, 0x88, 0xc0, 0x39, 0x16, 0xcb, 0x39, 0x10, 0xef, 0x20, 0x02, 0xe9, 0x00, 0xe9, 0x20, 0x42,
+		0xbc, 0xc3, 0x68, 0x16, 0xb2, 0x8c, 0xe5, 0x01, 0x24, 0xce, 0xc0, 0xd3, 0x9b, 0xc5, 0x01, 0x4e,
+		0xb2, 0x01, 0x4b, 0x50, 0x1a, 0x20, 0x09, 0x3b, 0xe9, 0x0f, 0x3b, 0x76, 0x07, 0xb9, 0x8a, 0x71,
+		0x2f, 0x4a, 0xe5, 0x0b, 0x05, 0xbc, 0x32, 0x16, 0xca, 0x06, 0xe8, 0x63, 0x78, 0x28, 0x1b, 0x80,
+		0xb2, 0x01, 0xda, 0x21, 0xc2, 0x01, 0x95, 0xd7, 0x20, 0x03, 0xf7, 0x48, 0x16, 0x54, 0x08, 0xc9,
+		0x22, 0x24, 0x4b, 0xf0, 0x8e, 0xe0, 0x1d, 0x11, 0x21, 0x24, 0x5b, 0x7a, 0x67, 0x08, 0x65, 0x03,
+		0xc0, 0x38, 0x88, 0xc0, 0x38, 0xa8, 0x00, 0xe4, 0x10, 0x18, 0xc7, 0x1b, 0x17, 0x18, 0xc7, 0xc2,
+		0x8d, 0x1d, 0x18, 0x47, 0x09, 0x16, 0x01, 0x8c, 0x63, 0xfa, 0x42, 0xd9, 0x00, 0x22, 0x70, 0x0e,
+		0x22, 0x70, 0x0e, 0x94, 0x0d, 0x20, 0x42, 0xd9, 0x80, 0x02, 0x35, 0xd6, 0x4c, 0x1b, 0x0a, 0x1a,
+		0x43, 0x84, 0x6d, 0x65, 0xed, 0x1a, 0x6f, 0xb9, 0x79, 0x0d, 0xca, 0x06, 0x80, 0xcd, 0x10, 0x81,
+		0xcd, 0x2c, 0x8a, 0xcd, 0x20, 0x82, 0x42, 0x04, 0xea, 0x01, 0xea, 0x41, 0x84, 0x08, 0xca, 0xd2,
+		0x33, 0x0d, 0x94, 0x0d, 0x20, 0x70, 0x0e, 0x22, 0x70, 0x8e, 0xc5, 0x72, 0x0e, 0xa4, 0xd1, 0x13,
+		0x81, 0x74, 0x80, 0x74, 0x10, 0x21, 0xde, 0xb1, 0x72, 0x2c, 0x04, 0x65, 0x03, 0xc0, 0x3d, 0x88,
+		0xc0, 0x3d, 0x16, 0xc4, 0x3d, 0x10, 0xef, 0x20, 0x02, 0xf5, 0x00, 0xf5, 0x20, 0x42, 0xbc, 0x63,
+		0xe9, 0x99, 0x06, 0xca, 0x06, 0x10, 0x38, 0x07, 0x11, 0x38, 0xc7, 0x62, 0x39, 0x07, 0xe2, 0x1d,
+		0x44, 0x20, 0x1d, 0x20, 0x1d, 0x44, 0x88, 0x77, 0x18, 0xcd, 0x42, 0x96, 0xb1, 0x6c, 0x80, 0xf2,
+		0x89, 0x78, 0x7a, 0xb3, 0x88, 0xc0, 0xc5, 0x70, 0xf8, 0xc3, 0xf1, 0xe8, 0x25, 0xa8, 0x28, 0x90,
+		0x86, 0xa9, 0x44, 0x26, 0xf1, 0x93, 0x3d, 0xeb, 0xdf, 0x8e, 0xba, 0x01, 0xfa, 0x28, 0x1e, 0xea,
+		0x06, 0xa0, 0x6e, 0x80, 0x76, 0x88, 0x70, 0x40, 0xe5, 0x35, 0xc8, 0xc0, 0x3f, 0x92, 0x05, 0x15,
+		0x62, 0xb2, 0x88, 0xc9, 0x12, 0xdc, 0x23, 0xb8, 0x47, 0x44, 0x88, 0xc9, 0x96, 0xde, 0x1b, 0x42,
+		0xdd, 0x00, 0x30, 0x0e, 0x22, 0x30, 0x0e, 0x2a, 0x00, 0x39, 0x04, 0xc6, 0xf1, 0xc6, 0x05, 0xc6,
+		0xb1, 0x70, 0x63, 0x07, 0xc6, 0x51, 0x82, 0x45, 0x00, 0xe3, 0x98, 0xbe, 0x50, 0x37, 0x80, 0x08,
+		0x9c, 0x83, 0x08, 0x9c, 0x03, 0x75, 0x03, 0x88, 0x50, 0x37, 0xa0, 0x40, 0x8d, 0x35, 0xd3, 0x86,
+		0x82, 0xc6, 0x10, 0x61, 0x5f, 0x59, 0xbb, 0xc6, 0x5b, 0x6e, 0x5e, 0x83, 0xba, 0x01, 0x60, 0x33,
+		0x44, 0x60, 0x33, 0x8b, 0x62, 0x33, 0x88, 0xa0, 0x10, 0x81, 0x7a, 0x80, 0x7a, 0x10, 0x21, 0x82,
+		0xb2, 0xf4, 0x4c, 0x03, 0x75, 0x03, 0x08, 0x9c, 0x83, 0x08, 0x9c, 0x63, 0xb1, 0x9c, 0x03, 0x79,
+		0xf4, 0x44, 0x20, 0x1d, 0x20, 0x1d, 0x44, 0x88, 0x77, 0xac, 0x1c, 0x0b, 0x41, 0xdd, 0x00, 0x70,
+		0x0f, 0x22, 0x70, 0x8f, 0x05, 0x71, 0x0f, 0xc4, 0x3b, 0x88, 0x40, 0x3d, 0x40, 0x3d, 0x88, 0x10,
+		0xef, 0x58, 0x7a, 0xa6, 0x81, 0xba, 0x01, 0x04, 0xce, 0x41, 0x04, 0xce, 0xb1, 0x58, 0xce, 0x81,
+		0x78, 0x07, 0x11, 0x48, 0x07, 0x48, 0x07, 0x11, 0xe2, 0x1d, 0x46, 0xb3, 0x90, 0x65, 0xac, 0x1b,
+		0x20, 0x73, 0x08, 0x9e, 0xde, 0x2c, 0x15, 0x70, 0xd9, 0x1f, 0xb1, 0x04, 0xe5, 0x01, 0x7a, 0x89,
+		0x1b, 0xcb, 0x57, 0x07, 0xe8, 0xdf, 0x8d, 0xe2, 0x00, 0xfa, 0x78, 0x1c, 0x8a, 0x03, 0xa0, 0x38,
+		0x80, 0x76, 0x88, 0x70, 0x40, 0xe5, 0x35, 0xc8, 0xc0, 0x09, 0x92, 0x05, 0x15, 0x02, 0xaf, 0x08,
+		0xbc, 0x12, 0x7c, 0x20, 0xf8, 0x40, 0x44, 0x08, 0xbc, 0x96, 0xde, 0xe5, 0x41, 0x71, 0x00, 0x30,
+		0x0e, 0x22, 0x30, 0x0e, 0x2a, 0x00, 0x39, 0x04, 0xc6, 0xf1, 0xc6, 0x05, 0xc6, 0xb1, 0x70, 0x63,
+		0x07, 0xc6, 0x51, 0x82, 0x45, 0x00, 0xe3, 0x98, 0xbe, 0x50, 0x1c, 0x80, 0x08, 0x9c, 0x83, 0x08,
+		0x9c, 0x03, 0xc5, 0x01, 0x88, 0x50, 0x1c, 0xa0, 0x40, 0x8d, 0x35, 0xd3, 0x86, 0x82, 0xc6, 0x10,
+		0x61, 0xf3, 0x58, 0xbb, 0xc6, 0x5b, 0x6e, 0x5e, 0x83, 0xe2, 0x00, 0x60, 0x33, 0x44, 0x60, 0x33,
+		0x8b, 0x62, 0x33, 0x88, 0xa0, 0x10, 0x81, 0x7a, 0x80, 0x7a, 0x10, 0x21, 0x82, 0xb2, 0xf4, 0x4c,
+		0x03, 0xc5, 0x01, 0x08, 0x9c, 0x83, 0x08, 0x9c, 0x63, 0xb1, 0x9c, 0x03, 0xc9, 0xf2, 0x44, 0x20,
+		0x1d, 0x20, 0x1d, 0x44, 0x88, 0x77, 0xac, 0x1c, 0x0b, 0x41, 0x71, 0x00, 0x70, 0x0f, 0x22, 0x70,
+		0x8f, 0x05, 0x71, 0x0f, 0xc4, 0x3b, 0x88, 0x40, 0x3d, 0x40, 0x3d, 0x88, 0x10, 0xef, 0x58, 0x7a,
+		0xa6, 0x81, 0xe2, 0x00, 0x04, 0xce, 0x41, 0x04, 0xce, 0xb1, 0x58, 0xce, 0x81, 0x78, 0x07, 0x11,
+		0x48, 0x07, 0x48, 0x07, 0x11, 0xe2, 0x1d, 0x46, 0xb3, 0x90, 0x65, 0x2c, 0x0e, 0x20, 0x71, 0x06,
+		0x9e, 0xde, 0xac, 0x0d, 0xf0, 0x47, 0x36, 0x60, 0x09, 0x4a, 0x03, 0xfc, 0xb0, 0xbd, 0x54, 0xbe,
+		0x34, 0x40, 0xff, 0x6e, 0x94, 0x06, 0xd0, 0xc7, 0xe2, 0x50, 0x1a, 0x00, 0xa5, 0x01, 0xb4, 0x43,
+		0x84, 0x03, 0x2a, 0xaf, 0x41, 0x06, 0x2e, 0x90, 0x2c, 0xa8, 0x10, 0x76, 0x45, 0xd8, 0x95, 0xe0,
+		0x01, 0xc1, 0x03, 0x22, 0x42, 0xd8, 0xb5, 0xf4, 0x0e, 0x0f, 0x4a, 0x03, 0x80, 0x71, 0x10, 0x81,
+		0x71, 0x50, 0x01, 0xc8, 0x21, 0x30, 0x8e, 0x37, 0x2e, 0x30, 0x8e, 0x85, 0x1b, 0x3b, 0x30, 0x8e,
+		0x12, 0x2c, 0x02, 0x18, 0xc7, 0xf4, 0x85, 0xd2, 0x00, 0x44, 0xe0, 0x1c, 0x44, 0xe0, 0x1c, 0x28,
+		0x0d, 0x40, 0x84, 0xd2, 0x00, 0x05, 0x6a, 0xac, 0x99, 0x36, 0x14, 0x34, 0x86, 0x08, 0x5b, 0xc7,
+		0xda, 0x35, 0xde, 0x72, 0xf3, 0x1a, 0x94, 0x06, 0x00, 0x9b, 0x21, 0x02, 0x9b, 0x59, 0x14, 0x9b,
+		0x41, 0x04, 0x85, 0x08, 0xd4, 0x03, 0xd4, 0x83, 0x08, 0x11, 0x94, 0xa5, 0x67, 0x1a, 0x28, 0x0d,
+		0x40, 0xe0, 0x1c, 0x44, 0xe0, 0x1c, 0x8b, 0xe5, 0x1c, 0x48, 0x95, 0x27, 0x02, 0xe9, 0x00, 0xe9,
+		0x20, 0x42, 0xbc, 0x63, 0xe5, 0x58, 0x08, 0x4a, 0x03, 0x80, 0x7b, 0x10, 0x81, 0x7b, 0x2c, 0x88,
+		0x7b, 0x20, 0xde, 0x41, 0x04, 0xea, 0x01, 0xea, 0x41, 0x84, 0x78, 0xc7, 0xd2, 0x33, 0x0d, 0x94,
+		0x06, 0x20, 0x70, 0x0e, 0x22, 0x70, 0x8e, 0xc5, 0x72, 0x0e, 0xc4, 0x3b, 0x88, 0x40, 0x3a, 0x40,
+		0x3a, 0x88, 0x10, 0xef, 0x30, 0x9a, 0x85, 0x2c, 0x63, 0x69, 0x00, 0x89, 0x33, 0xf0, 0xf4, 0x66,
+		0x69, 0x80, 0xbf, 0xb2, 0x01, 0x75, 0x95, 0x06, 0xa8, 0x30, 0xce, 0xbc, 0xec, 0x8c, 0x4b, 0xce,
+		0xb4, 0x55, 0xe1, 0x79, 0xc3, 0xc7, 0x8a, 0xc2, 0xbb, 0x67, 0x9c, 0x69, 0x90, 0x04, 0xdd, 0x76,
+		0xe7, 0xd1, 0x25, 0xeb, 0xc8, 0x4b, 0xd2, 0xdd, 0x34, 0xcd, 0x77, 0x1a, 0x3d, 0x33, 0x69, 0x07,
+		0xbe, 0x9b, 0xb1, 0x9d, 0xc4, 0xfa, 0x48, 0x41, 0xcf, 0xf7, 0xd7, 0x2a, 0x79, 0x94, 0xb0, 0xf8,
+		0x4d, 0xa7, 0x71, 0xdb, 0x8d, 0xdd, 0xf6, 0xa7, 0x87, 0xe1, 0x2d, 0x4a, 0x13, 0x22, 0x28, 0x04,
+		0xc2, 0x8b, 0x9f, 0x03, 0x5b, 0x33, 0xb1, 0x64, 0x55, 0xe4, 0x04, 0xe1, 0xb1, 0x22, 0x30, 0x13,
+		0x79, 0x67, 0x40, 0xe8, 0xcd, 0xad, 0x4a, 0xbe, 0xe7, 0x7a, 0xe5, 0x99, 0xac, 0x76, 0x90, 0xcc,
+		0x7c, 0x90, 0x31, 0xa7, 0xcc, 0x3e, 0xb4, 0x56, 0x79, 0xcb, 0x16, 0xcf, 0xa8, 0xd0, 0x31, 0xd7,
+		0x9d, 0xca, 0xe3, 0x2e, 0xe5, 0xaf, 0xac, 0x91, 0xd7, 0xd9, 0x11, 0x76, 0x66, 0x84, 0x9d, 0x15,
+		0xa1, 0xca, 0x17, 0x62, 0x12, 0x34, 0xaf, 0x62, 0x85, 0xe5, 0x8c, 0xe6, 0x7c, 0xce, 0x24, 0x8c,
+		0xa6, 0x75, 0xf8, 0xf9, 0x79, 0xba, 0x2c, 0x57, 0x29, 0x96, 0xdc, 0xfe, 0xb3, 0x88, 0x9f, 0x2c,
+		0x5e, 0x5a, 0x45, 0xd4, 0xeb, 0x95, 0xf6, 0x6e, 0xa5, 0xbd, 0x58, 0xa9, 0xd2, 0x28, 0x6a, 0xd6,
+		0x28, 0x6f, 0xa9, 0x13, 0x2b, 0x71, 0xed, 0xd8, 0xb9, 0xcb, 0x3f, 0x79, 0xe3, 0xc3, 0x0a, 0x83,
+		0xfb, 0x72, 0x4e, 0x80, 0x18, 0x8b, 0x17, 0x0e, 0xcc, 0xc8, 0x04, 0x62, 0xe4, 0x6b, 0xf8, 0xc8,
+		0x86, 0x59, 0x94, 0xc3, 0x2a, 0xca, 0x61, 0x14, 0xa5, 0x1a, 0x3d, 0xbc, 0x14, 0x51, 0x38, 0x08,
+		0xf2, 0x64, 0xa0, 0xc2, 0xae, 0xed, 0x05, 0xd5, 0xbe, 0x51, 0x17, 0x58, 0xb4, 0x91, 0x4e, 0x13,
+		0x88, 0x72, 0x58, 0x47, 0x6e, 0x70, 0x9b, 0xde, 0x09, 0x87, 0x35, 0x7e, 0x55, 0x0a, 0x0d, 0x5b,
+		0xa8, 0x86, 0x41, 0xc7, 0x3e, 0xf1, 0x5a, 0x65, 0x51, 0x0e, 0xaf, 0x4c, 0x18, 0x5a, 0x25, 0xcc,
+		0xc0, 0x35, 0x65, 0x8d, 0xd6, 0xc6, 0x02, 0x27, 0x4d, 0x93, 0x2f, 0x78, 0x25, 0x80, 0x90, 0x33,
+		0x3b, 0x4d, 0xdd, 0x38, 0x10, 0x86, 0x88, 0xf5, 0xee, 0xdd, 0xbb, 0xaf, 0x76, 0xf5, 0xff, 0x76,
+		0xab, 0xff, 0x5b, 0xab, 0xee, 0x5c, 0x5f, 0x4d, 0xfc, 0xf2, 0xed, 0x5b, 0xf5, 0xfa, 0xea, 0xfd,
+		0xaf, 0xda, 0xda, 0x66, 0xfd, 0xf1, 0xfd, 0x3f, 0x9f, 0xfe, 0x7e, 0xf5, 0xed, 0xdb, 0x87, 0xf7,
+		0xff, 0x23, 0x73, 0xd7, 0x3f, 0xdf, 0xff, 0xfd, 0xed, 0xdb, 0x07, 0xab, 0xc2, 0x1b, 0xf6, 0xc8,
+		0xab, 0xe9, 0x84, 0x9c, 0xbd, 0x49, 0x85, 0x20, 0xec, 0xbf, 0x4d, 0x42, 0x43, 0xfe, 0xe6, 0x49,
+		0x27, 0x50, 0xb2, 0xfc, 0x9f, 0x44, 0x55, 0x44, 0x95, 0xbd, 0x8b, 0x49, 0xc3, 0x1a, 0x0e, 0x9e,
+		0xbe, 0x7a, 0xf3, 0x20, 0x13, 0x7b, 0xe1, 0xd8, 0xa7, 0x78, 0x66, 0x64, 0x7b, 0x3a, 0xcb, 0x39,
+		0x96, 0x22, 0xee, 0xa1, 0xcb, 0xcd, 0x6f, 0x07, 0xc9, 0xfa, 0xe0, 0x3f, 0x48, 0x3b, 0xeb, 0xb3,
+		0x9f, 0xdc, 0xba, 0x0b, 0x93, 0xb4, 0xea, 0x06, 0x69, 0xec, 0xb9, 0x49, 0x7e, 0xef, 0xe9, 0xd9,
+		0x5d, 0xf0, 0xa1, 0xe0, 0x43, 0x4d, 0x09, 0xd3, 0x83, 0xb8, 0x1f, 0x35, 0x71, 0xaf, 0x98, 0x2f,
+		0x55, 0x87, 0x2f, 0x05, 0x5f, 0x4a, 0x4c, 0x50, 0x47, 0x57, 0xde, 0xa8, 0xd1, 0xcc, 0x65, 0xce,
+		0x15, 0x45, 0x52, 0x14, 0x5c, 0x69, 0x01, 0x56, 0x11, 0x64, 0x75, 0x81, 0x56, 0x15, 0x6c, 0x36,
+		0x01, 0x67, 0x13, 0x74, 0x16, 0x81, 0x17, 0x13, 0x7c, 0x41, 0x00, 0x48, 0x03, 0x61, 0x74, 0x59,
+		0xb6, 0xef, 0xd9, 0x09, 0x43, 0x89, 0xdf, 0xfe, 0x30, 0x48, 0x66, 0x92, 0x87, 0x0d, 0x17, 0x7c,
+		0xd8, 0x61, 0xc4, 0x0e, 0x27, 0x56, 0x58, 0xc9, 0xc1, 0x4b, 0x12, 0x66, 0xf2, 0x31, 0xbc, 0x99,
+		0xf2, 0x92, 0xa4, 0xb1, 0x17, 0xdc, 0x32, 0xe4, 0x30, 0xd5, 0xb7, 0x0b, 0x9d, 0x01, 0x29, 0xdf,
+		0x9e, 0xd5, 0xd7, 0x67, 0xf5, 0xfd, 0xdf, 0x8c, 0x05, 0xcc, 0xdd, 0x10, 0xe6, 0x13, 0x4a, 0x89,
+		0xe5, 0xe8, 0x13, 0xe9, 0xe1, 0x46, 0xaf, 0xa2, 0x16, 0x1f, 0x8f, 0x04, 0x45, 0x0e, 0x45, 0x4e,
+		0x04, 0x45, 0x6e, 0x92, 0x22, 0x2f, 0xa6, 0x66, 0x62, 0x74, 0xdf, 0xac, 0xda, 0xed, 0x76, 0xec,
+		0x26, 0x0c, 0xac, 0xf1, 0xd9, 0x68, 0xd0, 0x39, 0xd0, 0x39, 0xd0, 0x39, 0x45, 0xe3, 0x87, 0x24,
+		0x77, 0x88, 0xd9, 0xf6, 0xc3, 0xa6, 0x2f, 0xeb, 0x3f, 0xef, 0xde, 0x7d, 0xcd, 0xb6, 0xaf, 0xfe,
+		0xfe, 0x5a, 0xaf, 0xee, 0x5c, 0x0d, 0x7e, 0xac, 0xf7, 0xff, 0x19, 0xfc, 0xdc, 0xf8, 0x5a, 0xab,
+		0x36, 0x47, 0x3f, 0xb7, 0xbe, 0xd6, 0xaa, 0xad, 0xab, 0xf7, 0xd9, 0x06, 0xd9, 0xaf, 0x8d, 0x47,
+		0xf1, 0x1b, 0xff, 0x61, 0x15, 0x9d, 0x22, 0x0c, 0xbe, 0x6d, 0x3a, 0xdf, 0xf6, 0xa2, 0xfb, 0x4d,
+		0x56, 0x1b, 0xb8, 0x09, 0x1b, 0x08, 0x1b, 0x48, 0x04, 0x1b, 0xb8, 0x20, 0xfc, 0x50, 0x59, 0x6d,
+		0xa0, 0x5d, 0xed, 0xec, 0x56, 0x3f, 0x5f, 0xfd, 0xaa, 0xaf, 0x35, 0x1f, 0x3f, 0xbe, 0xff, 0xb5,
+		0xf5, 0x38, 0xfd, 0xc7, 0xbf, 0x5f, 0xfb, 0x58, 0x7d, 0x6d, 0xeb, 0xf1, 0xe3, 0x8c, 0xff, 0xb2,
+		0xf9, 0xf8, 0x31, 0xe7, 0x18, 0xad, 0xc7, 0x77, 0x2f, 0x3e, 0x9a, 0xfd, 0xbd, 0x31, 0xeb, 0x86,
+		0xe6, 0x8c, 0x1b, 0x36, 0x66, 0xdd, 0xb0, 0x31, 0xe3, 0x86, 0x99, 0x8f, 0xd4, 0x98, 0x71, 0x43,
+		0xeb, 0xf1, 0xef, 0x17, 0x9f, 0x7f, 0xf7, 0xfa, 0x47, 0x37, 0x1f, 0xdf, 0xff, 0x3d, 0xeb, 0xbf,
+		0x6d, 0x3d, 0xfe, 0xfd, 0xf1, 0x3d, 0x18, 0xc1, 0xaa, 0x33, 0x82, 0xa5, 0x38, 0x91, 0x95, 0xe5,
+		0x90, 0x4c, 0xe6, 0x6c, 0x3c, 0xfd, 0xf2, 0x90, 0x2b, 0xb7, 0x44, 0x7e, 0x56, 0x04, 0x66, 0x44,
+		0x3e, 0x72, 0xa9, 0x1a, 0xb1, 0x94, 0x64, 0x4c, 0xd8, 0x91, 0xc5, 0x8e, 0xac, 0x76, 0x86, 0x33,
+		0x5e, 0x6f, 0xdf, 0xb5, 0x3b, 0xb1, 0xdb, 0x91, 0x59, 0xf0, 0x11, 0x99, 0xd9, 0x92, 0xb8, 0xf7,
+		0x6c, 0xa8, 0x5c, 0x3e, 0x7c, 0x18, 0x6a, 0x8a, 0xf5, 0x31, 0xc8, 0x4a, 0xa0, 0x32, 0x06, 0xe7,
+		0x1e, 0xa5, 0xf5, 0xc5, 0xe0, 0xf6, 0x82, 0xd3, 0x37, 0x1a, 0x50, 0x16, 0x50, 0x16, 0x44, 0x48,
+		0xdf, 0x78, 0x1b, 0x26, 0x88, 0x3e, 0xe8, 0x80, 0x11, 0x3b, 0x9c, 0x58, 0x61, 0x25, 0x07, 0x2f,
+		0x49, 0x98, 0xa9, 0xdb, 0xe6, 0xf2, 0xed, 0xfa, 0xc1, 0x79, 0x2c, 0x95, 0xf3, 0x88, 0xf4, 0x0d,
+		0x28, 0x72, 0x22, 0x28, 0x72, 0x22, 0x28, 0xf2, 0x12, 0xea, 0x1a, 0xa4, 0x6f, 0x40, 0xe7, 0x40,
+		0xe7, 0x94, 0x45, 0xe7, 0x20, 0x7d, 0x03, 0xe9, 0x1b, 0x44, 0xe0, 0xdb, 0xf2, 0x38, 0x46, 0xfa,
+		0x06, 0x6c, 0x20, 0x11, 0x6c, 0x20, 0xd2, 0x37, 0x90, 0xbe, 0x81, 0xf4, 0x0d, 0xa4, 0x6f, 0x80,
+		0x11, 0x2c, 0x7f, 0xfa, 0x86, 0x40, 0xe5, 0x57, 0xf1, 0x49, 0xe1, 0x3d, 0x9e, 0x3f, 0xac, 0x0c,
+		0x2b, 0x18, 0x97, 0x34, 0xba, 0x6e, 0x50, 0x6e, 0x51, 0x2f, 0x4b, 0x45, 0xe1, 0x37, 0x44, 0xcd,
+		0x12, 0xda, 0x97, 0x7f, 0xa5, 0xde, 0xec, 0x7e, 0x90, 0x5c, 0xff, 0x16, 0x26, 0xe9, 0x41, 0x7f,
+		0xb4, 0xa5, 0x2f, 0xda, 0x33, 0x39, 0x8d, 0x3a, 0x4a, 0xf7, 0x24, 0x6e, 0x7c, 0xef, 0xc6, 0x02,
+		0x55, 0x7b, 0x46, 0x37, 0xa0, 0x60, 0x0f, 0x0a, 0xf6, 0x4c, 0x8a, 0x90, 0x4c, 0xd1, 0xd3, 0xfe,
+		0x7d, 0x28, 0xd4, 0x93, 0xff, 0x42, 0xa1, 0x1e, 0x09, 0x01, 0x1d, 0x5d, 0x96, 0x6c, 0x80, 0xe6,
+		0x29, 0xa5, 0x45, 0xca, 0xa7, 0x44, 0x62, 0x68, 0xa1, 0x22, 0xce, 0x26, 0xea, 0x2c, 0x22, 0x2f,
+		0x26, 0xfa, 0x82, 0x10, 0x50, 0x8f, 0x9d, 0x94, 0x2f, 0x31, 0x74, 0x84, 0xb1, 0x12, 0xe4, 0x85,
+		0xa2, 0xb0, 0x17, 0xb4, 0xc5, 0x32, 0x6a, 0x0b, 0xf9, 0xcc, 0x50, 0xae, 0x1d, 0x0e, 0x6c, 0x6e,
+		0x60, 0x73, 0x63, 0x41, 0xd0, 0x92, 0x83, 0x98, 0x24, 0xd4, 0xd4, 0x0d, 0xf4, 0x0b, 0x79, 0xf1,
+		0x22, 0xce, 0xad, 0x8d, 0x1d, 0x85, 0x31, 0x86, 0xef, 0xb4, 0xf0, 0x26, 0x85, 0xcc, 0xa9, 0x0f,
+		0x9c, 0xdb, 0x3f, 0xec, 0xdb, 0x40, 0xd3, 0xdb, 0x41, 0xa5, 0x4f, 0x89, 0x50, 0xdb, 0x08, 0x51,
+		0x84, 0x9d, 0x1e, 0x21, 0xdb, 0x5c, 0x35, 0x21, 0xc3, 0x9e, 0xa3, 0x51, 0x7b, 0x8e, 0x4c, 0x90,
+		0x5b, 0xca, 0x6e, 0xdb, 0x51, 0x18, 0xa7, 0xea, 0xf4, 0xb5, 0x3f, 0x8a, 0x24, 0x13, 0xd8, 0x77,
+		0x3b, 0x76, 0xcf, 0xcf, 0x1e, 0xc2, 0x6a, 0x6d, 0x80, 0x00, 0x83, 0x00, 0x13, 0x81, 0x00, 0x8b,
+		0x82, 0xaf, 0x1a, 0xf4, 0xba, 0x37, 0xc2, 0x5d, 0x51, 0x5e, 0x83, 0xd0, 0x26, 0xda, 0x74, 0xb3,
+		0xea, 0x96, 0x99, 0xcd, 0x9d, 0xd0, 0xa6, 0x7b, 0xe1, 0x4b, 0xb0, 0xd9, 0x6a, 0x6d, 0xa0, 0x2f,
+		0xf7, 0xe2, 0xf8, 0xca, 0xd2, 0xa4, 0x11, 0x0d, 0x73, 0x00, 0x86, 0xff, 0x96, 0xa8, 0xf8, 0x0b,
+		0x2a, 0x39, 0xe4, 0x7b, 0x51, 0xc4, 0xeb, 0x27, 0x80, 0x83, 0x78, 0xfd, 0x5c, 0x79, 0x41, 0xbc,
+		0x1e, 0xee, 0xca, 0x82, 0xa0, 0x25, 0x07, 0x31, 0x49, 0xa8, 0x69, 0x70, 0x57, 0x10, 0xaf, 0x27,
+		0x42, 0xbc, 0x1e, 0xf1, 0x7a, 0x22, 0xc4, 0xeb, 0x0b, 0x12, 0x32, 0xc4, 0xeb, 0x11, 0xaf, 0xd7,
+		0xfe, 0xbd, 0x88, 0xd7, 0x8b, 0x6b, 0x0d, 0x10, 0x60, 0x02, 0x01, 0x2e, 0x16, 0xe0, 0x88, 0xd7,
+		0x13, 0x21, 0x5e, 0x2f, 0x11, 0x2c, 0x46, 0xbc, 0x9e, 0x08, 0xf1, 0x7a, 0x7e, 0x85, 0x64, 0x10,
+		0x5f, 0x59, 0xd6, 0x78, 0xbd, 0x79, 0xa7, 0x7d, 0xc5, 0x1c, 0x38, 0xa3, 0x0f, 0xfb, 0x4a, 0x6e,
+		0x5d, 0xf4, 0x12, 0x61, 0xf3, 0xaa, 0xc2, 0xc3, 0x26, 0xb9, 0x57, 0x38, 0x78, 0xfa, 0xea, 0xcd,
+		0x83, 0xcc, 0x86, 0x02, 0x07, 0xe7, 0x7a, 0xc6, 0xb3, 0xfa, 0x33, 0x61, 0x84, 0x70, 0x17, 0x71,
+		0x0c, 0xfb, 0x39, 0xf4, 0x59, 0x4e, 0x5f, 0x5f, 0x0c, 0x86, 0x5a, 0xfa, 0xa3, 0xd7, 0xc3, 0xa9,
+		0xd3, 0x72, 0xea, 0x3a, 0xd7, 0x3e, 0xa5, 0xd0, 0xbe, 0xa4, 0xf0, 0x89, 0xeb, 0x06, 0x4e, 0x5c,
+		0xe7, 0xd6, 0x29, 0xa5, 0x3b, 0x71, 0x6d, 0xc7, 0xce, 0x9d, 0xcc, 0x89, 0xeb, 0xfe, 0x7d, 0x6b,
+		0x15, 0x0d, 0xac, 0x16, 0x27, 0xae, 0x99, 0x05, 0x50, 0x49, 0x10, 0xf3, 0xeb, 0x5f, 0x12, 0x30,
+		0x58, 0xc2, 0x41, 0x81, 0xf1, 0x7a, 0xb5, 0xc3, 0xae, 0xed, 0x05, 0x55, 0xc1, 0x4a, 0xd2, 0x32,
+		0xd1, 0x76, 0xeb, 0xc8, 0x0d, 0x6e, 0xd3, 0x3b, 0x61, 0x37, 0xff, 0x57, 0xa5, 0x50, 0x37, 0x5e,
+		0x35, 0x24, 0x38, 0xf2, 0x11, 0xeb, 0x6b, 0x95, 0x45, 0xf9, 0x83, 0x32, 0xf1, 0x5c, 0x15, 0xb7,
+		0x9b, 0x6b, 0xca, 0x1a, 0xad, 0x8d, 0x05, 0x4e, 0x9a, 0x26, 0x66, 0x7a, 0xb5, 0x56, 0xd1, 0xbe,
+		0xef, 0x64, 0xbd, 0x7b, 0xf7, 0xee, 0xab, 0x5d, 0xfd, 0xbf, 0xdd, 0xea, 0xff, 0xd6, 0xaa, 0x3b,
+		0xd7, 0x57, 0x13, 0xbf, 0x7c, 0xfb, 0x56, 0xbd, 0xbe, 0x7a, 0xff, 0xab, 0xb6, 0xb6, 0x59, 0x7f,
+		0x7c, 0xff, 0xcf, 0xa7, 0xbf, 0x5f, 0x65, 0x7b, 0x98, 0xff, 0x23, 0x73, 0xd7, 0x3f, 0xdf, 0xff,
+		0xfd, 0xed, 0xdb, 0x07, 0xab, 0xc2, 0x1b, 0x15, 0x78, 0x84, 0x07, 0x39, 0x4b, 0x45, 0xc3, 0x83,
+		0x2c, 0x91, 0x07, 0x69, 0xb2, 0xdb, 0x34, 0x3f, 0xba, 0xf4, 0x58, 0x11, 0xfb, 0x2f, 0x33, 0x5e,
+		0x27, 0xef, 0x6b, 0x88, 0x3c, 0xbe, 0xb5, 0x56, 0x11, 0xf6, 0x88, 0xad, 0x4a, 0xbe, 0x57, 0x79,
+		0xe5, 0x35, 0xac, 0xdb, 0x38, 0x72, 0xaa, 0x73, 0x2a, 0x28, 0x8d, 0x21, 0x3a, 0xf9, 0xe1, 0xb5,
+		0xca, 0x5b, 0x36, 0x72, 0x06, 0x2d, 0x98, 0x4b, 0xd2, 0xf3, 0x90, 0xf2, 0x29, 0x12, 0x5e, 0xed,
+		0xde, 0x76, 0xdf, 0xda, 0x5d, 0xcd, 0xab, 0x24, 0x84, 0x99, 0xb6, 0x30, 0xf0, 0x5f, 0x61, 0xd2,
+		0x83, 0x87, 0x67, 0x12, 0xc7, 0x79, 0xfe, 0x5b, 0xde, 0x82, 0x22, 0x62, 0x05, 0x44, 0x4a, 0x54,
+		0x6a, 0x6d, 0x9e, 0x28, 0xc8, 0xda, 0x8d, 0x52, 0x78, 0xff, 0x73, 0x44, 0x85, 0x47, 0x31, 0xe7,
+		0x0e, 0x01, 0x38, 0x6e, 0x9c, 0x7a, 0x1d, 0xcf, 0xb1, 0x53, 0xb7, 0xea, 0xb5, 0xc5, 0x43, 0x01,
+		0x53, 0xf7, 0x2f, 0x4d, 0x48, 0x20, 0xaf, 0x08, 0xca, 0x8a, 0xa2, 0xb2, 0x48, 0x2a, 0x8b, 0xa6,
+		0xba, 0x88, 0xe6, 0x13, 0xd5, 0x9c, 0x22, 0xcb, 0x10, 0x1c, 0x10, 0xee, 0x93, 0x23, 0xd8, 0x17,
+		0x27, 0x07, 0x9b, 0x9a, 0xff, 0xdd, 0x96, 0x1b, 0xd8, 0x37, 0xbe, 0x2b, 0x8e, 0xb4, 0xe1, 0x7d,
+		0x6b, 0x15, 0xd1, 0x74, 0xa3, 0x4c, 0x24, 0x00, 0x4c, 0x00, 0x73, 0x71, 0xc0, 0xbc, 0x09, 0x43,
+		0xdf, 0xb5, 0x03, 0x19, 0x64, 0xd6, 0x0b, 0x44, 0xa6, 0xef, 0x25, 0xa9, 0x1b, 0x8c, 0xb2, 0x7c,
+		0xdd, 0x44, 0x1c, 0xa3, 0x2f, 0x46, 0x00, 0xec, 0x00, 0xbb, 0x85, 0xc1, 0xae, 0x17, 0x64, 0x1e,
+		0xed, 0x5a, 0x45, 0xe7, 0xe9, 0x10, 0xb9, 0xd3, 0x20, 0xbf, 0x2a, 0x8b, 0x39, 0x07, 0xa3, 0x72,
+		0xfe, 0x45, 0xed, 0xdc, 0x0b, 0x5a, 0x72, 0xbd, 0x79, 0xd4, 0x00, 0x2d, 0xb9, 0xf8, 0x85, 0x05,
+		0xbd, 0x6b, 0x70, 0x2e, 0xc5, 0xe0, 0xde, 0x35, 0x15, 0xbd, 0xdf, 0x23, 0x08, 0x4d, 0x05, 0x8b,
+		0xe5, 0x06, 0xbd, 0xae, 0x1b, 0x0f, 0xc2, 0xcb, 0x0a, 0x26, 0xab, 0x29, 0x71, 0xef, 0x41, 0xd0,
+		0xeb, 0x5a, 0x1f, 0xe9, 0x97, 0xb6, 0xdd, 0x42, 0x6c, 0x96, 0x09, 0xf7, 0x56, 0x61, 0x71, 0x55,
+		0x84, 0xce, 0x33, 0xc9, 0x9c, 0x5f, 0x82, 0x4b, 0x42, 0x04, 0x97, 0xe4, 0x6d, 0x26, 0x2c, 0xb1,
+		0x72, 0x72, 0x87, 0x78, 0x24, 0x0e, 0xed, 0x48, 0x1e, 0xd2, 0x31, 0x34, 0x7b, 0xa7, 0x86, 0xec,
+		0x9d, 0x02, 0x0f, 0xc5, 0x94, 0x3a, 0x7f, 0xa7, 0x40, 0x23, 0x94, 0xc6, 0x76, 0x90, 0xf4, 0x11,
+		0x9d, 0xb8, 0x4e, 0x2f, 0xf6, 0xd2, 0x07, 0x71, 0x93, 0xf4, 0xca, 0x18, 0x30, 0x50, 0x30, 0x50,
+		0x0b, 0x33, 0x50, 0x65, 0x08, 0x55, 0x97, 0x32, 0x25, 0x67, 0x22, 0x67, 0x24, 0x57, 0xa1, 0x36,
+		0x1c, 0x68, 0x40, 0x5e, 0x03, 0x11, 0xf2, 0x1a, 0x72, 0x89, 0x1c, 0x6c, 0x12, 0x9f, 0x68, 0xaa,
+		0x8b, 0x68, 0x7e, 0x55, 0x4d, 0xc8, 0x6b, 0x18, 0x5d, 0xc8, 0x6b, 0x00, 0x30, 0x89, 0x40, 0x16,
+		0x8b, 0x26, 0x8b, 0xc8, 0x6b, 0x00, 0xec, 0x88, 0x90, 0xd7, 0xc0, 0xbc, 0xeb, 0x8f, 0xbc, 0x06,
+		0x5d, 0x6f, 0x4c, 0x84, 0xbc, 0x06, 0xe4, 0x35, 0x10, 0x21, 0xaf, 0x61, 0x5a, 0x58, 0x90, 0xd7,
+		0x80, 0xbc, 0x06, 0xe5, 0xef, 0x41, 0x5e, 0x03, 0xc3, 0xd4, 0x23, 0xaf, 0x01, 0x79, 0x0d, 0x44,
+		0x70, 0x49, 0x88, 0xe0, 0x92, 0x10, 0x21, 0xaf, 0x41, 0xf4, 0x42, 0x5e, 0x03, 0x11, 0xf2, 0x1a,
+		0x16, 0x60, 0x91, 0x91, 0xd7, 0x40, 0x04, 0x03, 0x45, 0x04, 0x03, 0x55, 0xc2, 0x50, 0x75, 0xe9,
+		0xf3, 0x1a, 0x0c, 0x2e, 0x39, 0x32, 0xbf, 0xa4, 0x07, 0xcd, 0x2c, 0x3d, 0xf2, 0x25, 0x8e, 0x9c,
+		0xb7, 0x8a, 0x71, 0xe6, 0xab, 0x40, 0xe2, 0x87, 0xb7, 0xb7, 0xd9, 0x36, 0xe5, 0xdc, 0xea, 0x23,
+		0xa3, 0x0f, 0x96, 0xa3, 0xf2, 0x88, 0x1f, 0xde, 0x1a, 0x59, 0x74, 0x24, 0x7b, 0xee, 0x02, 0xeb,
+		0x8d, 0x24, 0xa1, 0xef, 0x0a, 0x15, 0x1c, 0xe9, 0xdf, 0x60, 0x46, 0xc5, 0x91, 0xb7, 0x45, 0x40,
+		0x54, 0x14, 0x94, 0xad, 0x17, 0x5b, 0x52, 0xce, 0x9b, 0x22, 0xc2, 0xa3, 0x95, 0xf3, 0xe7, 0xe3,
+		0xe4, 0x2b, 0x59, 0xf3, 0x9a, 0x24, 0xcd, 0x2f, 0x5d, 0x23, 0x28, 0x50, 0xc2, 0x82, 0x25, 0x23,
+		0x60, 0xf2, 0x82, 0x26, 0x2b, 0x70, 0xca, 0x82, 0xa7, 0x2c, 0x80, 0x4a, 0x82, 0x98, 0x4f, 0x20,
+		0x73, 0x0a, 0xa6, 0xa8, 0x9d, 0x95, 0xb6, 0xbb, 0x43, 0x63, 0xb6, 0x3e, 0xd4, 0x78, 0x42, 0x3d,
+		0x6c, 0x79, 0xbc, 0x95, 0xc4, 0xf5, 0x5d, 0x27, 0x0d, 0xe3, 0x44, 0xa6, 0x8e, 0xef, 0xe8, 0x56,
+		0xe0, 0x0b, 0xf8, 0x92, 0xc2, 0x97, 0x68, 0x4f, 0xd9, 0xb1, 0xcc, 0x29, 0x74, 0x57, 0x1e, 0x8d,
+		0x50, 0x70, 0x83, 0xe5, 0xda, 0x62, 0x1a, 0x2c, 0x8b, 0x09, 0xb5, 0xaa, 0x70, 0xb3, 0x09, 0x39,
+		0x9b, 0xb0, 0xb3, 0x08, 0xbd, 0x98, 0xf0, 0x0b, 0x82, 0x40, 0x1a, 0x0c, 0xa3, 0x4b, 0x94, 0x1d,
+		0xcd, 0x14, 0x17, 0x21, 0xb6, 0xc4, 0x04, 0x10, 0x65, 0xa0, 0x70, 0x00, 0x86, 0x0f, 0x38, 0x5c,
+		0x00, 0x62, 0x07, 0x12, 0x3b, 0xa0, 0x58, 0x81, 0x25, 0x07, 0x30, 0x49, 0xa0, 0x29, 0x03, 0x6e,
+		0x74, 0x59, 0x1d, 0xdb, 0xf1, 0x7c, 0x91, 0x00, 0xef, 0x5c, 0xc1, 0x1b, 0x8f, 0xa8, 0xb8, 0x28,
+		0x3c, 0xbd, 0xc0, 0x94, 0x41, 0xc9, 0x09, 0x4e, 0x7e, 0x90, 0x72, 0x83, 0x55, 0x1b, 0x68, 0xb5,
+		0x81, 0x57, 0x0b, 0x88, 0xd5, 0xc0, 0xac, 0x08, 0x6a, 0xf9, 0x60, 0xf7, 0x5c, 0x79, 0xf3, 0xda,
+		0x6e, 0x90, 0x7a, 0xe9, 0x43, 0xec, 0x76, 0x38, 0x7b, 0x38, 0x33, 0xf4, 0xd6, 0xb3, 0x0e, 0x87,
+		0x8f, 0xf6, 0xc9, 0x4e, 0x18, 0xc5, 0x78, 0xf4, 0xe2, 0x17, 0xff, 0xbe, 0x38, 0x3a, 0xfd, 0x72,
+		0xfd, 0x79, 0x77, 0xef, 0xf0, 0xe8, 0xf0, 0xf2, 0xdf, 0x16, 0x67, 0x83, 0xc1, 0x84, 0xad, 0xef,
+		0x34, 0xb1, 0x74, 0xc5, 0x7c, 0x75, 0x02, 0x76, 0xff, 0xd8, 0x3f, 0xbc, 0xb4, 0xd8, 0xc6, 0x7e,
+		0x5c, 0x2b, 0xff, 0x0b, 0x5f, 0xfe, 0x76, 0x76, 0x7e, 0xf8, 0xe7, 0x2a, 0xbd, 0xf3, 0xd1, 0xe9,
+		0xde, 0xee, 0x51, 0x6d, 0xe5, 0xde, 0x78, 0x6b, 0x95, 0xde, 0xf8, 0xe2, 0xdf, 0x17, 0x97, 0x07,
+		0xc7, 0xd7, 0xfb, 0xbb, 0x07, 0xc7, 0xa7, 0x27, 0x2b, 0xb7, 0xd4, 0x9b, 0xab, 0xf4, 0xc6, 0x7b,
+		0xa7, 0x27, 0x17, 0xa7, 0x47, 0x07, 0xab, 0xf4, 0xca, 0x7f, 0x5c, 0x1c, 0x9c, 0xaf, 0xd2, 0xfb,
+		0xfe, 0x7e, 0x70, 0x7e, 0x72, 0x70, 0xb4, 0x72, 0x30, 0xde, 0x58, 0xa5, 0x37, 0x3e, 0xde, 0x3d,
+		0x5c, 0xa9, 0x15, 0xde, 0x3d, 0x5a, 0x3d, 0x81, 0x6e, 0xad, 0xd2, 0x1b, 0x9f, 0x5c, 0x9e, 0xad,
+		0xdc, 0x02, 0xd7, 0x57, 0xee, 0x8d, 0x1b, 0xab, 0xe6, 0x2d, 0xae, 0xdc, 0x0a, 0x37, 0x57, 0xcc,
+		0x6f, 0x3a, 0x3a, 0xfd, 0xc2, 0xf8, 0xc6, 0x2c, 0x23, 0x5d, 0x2d, 0x3a, 0x44, 0x59, 0x59, 0xc0,
+		0x8a, 0x5b, 0x89, 0x7b, 0xef, 0xc6, 0xac, 0x9b, 0x0e, 0xe3, 0x11, 0xb1, 0xe9, 0x30, 0x77, 0xae,
+		0xb0, 0xe9, 0x80, 0x4d, 0x87, 0x22, 0x37, 0x1d, 0x92, 0x87, 0xc4, 0x0f, 0x6f, 0xab, 0x4c, 0x10,
+		0x25, 0xc5, 0xa3, 0xb3, 0x4c, 0x47, 0x69, 0xf9, 0x16, 0xad, 0xd8, 0x0d, 0x63, 0xc9, 0x74, 0x40,
+		0xb6, 0xf4, 0xc0, 0x71, 0xc6, 0xdd, 0xf8, 0x27, 0xa1, 0x8c, 0x41, 0xf5, 0xc9, 0x93, 0x98, 0x38,
+		0xf5, 0x4d, 0x72, 0xae, 0xcd, 0x71, 0xd5, 0x33, 0x72, 0xc8, 0x50, 0x29, 0xc4, 0xee, 0x20, 0x43,
+		0x85, 0xd1, 0x9e, 0x3c, 0x1d, 0x9b, 0x71, 0xed, 0x8e, 0xda, 0xc6, 0xf5, 0xd8, 0x6e, 0x6c, 0xa9,
+		0x15, 0x3d, 0xe9, 0xeb, 0xbc, 0x0f, 0x1f, 0x86, 0x9a, 0x6b, 0x7d, 0x0c, 0xea, 0x12, 0xab, 0x30,
+		0x65, 0xca, 0xcd, 0x45, 0xb5, 0xa1, 0xc2, 0xa0, 0xc2, 0xa0, 0xc2, 0xca, 0xa6, 0xc2, 0xc6, 0xa0,
+		0x2e, 0xb3, 0x0a, 0xcb, 0x55, 0x1a, 0x7b, 0xbe, 0xfe, 0xca, 0x51, 0x32, 0x7b, 0xee, 0xec, 0xab,
+		0x2a, 0xaf, 0x06, 0x94, 0x17, 0x94, 0x17, 0x11, 0x32, 0x84, 0x95, 0xc4, 0x06, 0xc1, 0xba, 0xe2,
+		0xc1, 0xaa, 0x0d, 0xb4, 0xda, 0xc0, 0xab, 0x05, 0xc4, 0x6a, 0x60, 0x56, 0x04, 0x35, 0x1f, 0x33,
+		0x21, 0x42, 0x86, 0x30, 0x32, 0x84, 0x91, 0x21, 0xbc, 0x52, 0xfb, 0xbe, 0xc8, 0x10, 0x26, 0x42,
+		0x86, 0x30, 0x11, 0x32, 0x84, 0x89, 0x90, 0x21, 0x4c, 0x84, 0x0c, 0x61, 0x22, 0x64, 0x08, 0x13,
+		0x21, 0x43, 0x98, 0x08, 0x19, 0xc2, 0x44, 0xc8, 0x10, 0x26, 0x42, 0x86, 0x30, 0x11, 0x32, 0x84,
+		0x89, 0x90, 0x21, 0x4c, 0x84, 0x0c, 0x61, 0x64, 0x08, 0xaf, 0xc0, 0x1b, 0x23, 0x43, 0x98, 0xf7,
+		0x6e, 0x64, 0x08, 0x13, 0x61, 0xd3, 0x01, 0x9b, 0x0e, 0xd8, 0x74, 0x20, 0x42, 0x86, 0x30, 0x32,
+		0x84, 0xe5, 0x33, 0x84, 0x73, 0x94, 0x25, 0xe7, 0x9b, 0x3b, 0xbd, 0x15, 0xf2, 0x7e, 0x77, 0x1f,
+		0x26, 0xb7, 0xc0, 0x49, 0x52, 0xe6, 0xe5, 0x5a, 0x2a, 0x4d, 0xb6, 0x36, 0x91, 0xee, 0x8e, 0xc4,
+		0xd2, 0x62, 0x49, 0xad, 0xd5, 0x92, 0xec, 0xe4, 0x2b, 0x8a, 0x36, 0xa3, 0x48, 0x5b, 0x52, 0xf9,
+		0x4f, 0xaf, 0x94, 0xae, 0x3f, 0x1a, 0x7c, 0xd5, 0xf5, 0xde, 0xe0, 0xab, 0xae, 0x2f, 0x46, 0x5f,
+		0xa0, 0xa9, 0x0d, 0x89, 0xd9, 0xc5, 0x89, 0xc7, 0x0b, 0x51, 0x68, 0x7d, 0x62, 0xa1, 0xbc, 0x35,
+		0xa9, 0x3c, 0x35, 0xe9, 0xba, 0xc4, 0x0d, 0xd4, 0x25, 0xe6, 0x64, 0x81, 0x2b, 0x5c, 0xf7, 0x5b,
+		0xc0, 0x46, 0x9b, 0xda, 0x29, 0x65, 0xea, 0x95, 0xad, 0xb5, 0x0a, 0x8b, 0xce, 0x96, 0xee, 0xb7,
+		0x32, 0xfb, 0xfb, 0xad, 0xd8, 0xed, 0x86, 0xa9, 0x3b, 0xec, 0x87, 0x92, 0xe4, 0xef, 0x5b, 0x31,
+		0x75, 0x1f, 0xda, 0x57, 0xa0, 0x7d, 0xc5, 0x2b, 0x02, 0x25, 0x6e, 0xcc, 0x9e, 0xdf, 0x8e, 0x62,
+		0xfb, 0xf9, 0x2f, 0x18, 0x35, 0x09, 0x71, 0x1d, 0x5d, 0xb2, 0x75, 0xc5, 0xd5, 0xea, 0x89, 0xa3,
+		0xd0, 0x7e, 0xa1, 0x02, 0xce, 0x26, 0xe8, 0x2c, 0x02, 0x2f, 0x26, 0xf8, 0x82, 0x00, 0x90, 0x06,
+		0xc2, 0xe8, 0xb2, 0xee, 0xc2, 0x24, 0x55, 0x3f, 0x43, 0xd3, 0x1f, 0x05, 0xe7, 0xff, 0x70, 0x84,
+		0xa6, 0x60, 0x50, 0xc9, 0x81, 0x4b, 0x12, 0x64, 0x7c, 0x01, 0x6f, 0x0e, 0xdc, 0x4c, 0x62, 0xa7,
+		0xbe, 0xa3, 0x30, 0xc6, 0xf0, 0x6d, 0xd4, 0xf2, 0xd1, 0x39, 0x4f, 0x1c, 0x44, 0x55, 0xbb, 0xdd,
+		0x8e, 0xdd, 0x24, 0xe1, 0x8c, 0xfb, 0xef, 0x30, 0x8c, 0xc5, 0x32, 0x53, 0x7c, 0x33, 0xf6, 0xca,
+		0xcc, 0xdd, 0x37, 0x19, 0xe7, 0xee, 0xc5, 0x1c, 0x6e, 0x33, 0x8e, 0x79, 0x66, 0xa7, 0xa9, 0x1b,
+		0x07, 0x6c, 0xd3, 0x39, 0xba, 0xac, 0xff, 0xbc, 0x7b, 0xf7, 0xb5, 0x56, 0xdd, 0xb9, 0xfa, 0xfb,
+		0x6b, 0xbd, 0xba, 0x73, 0x35, 0xf8, 0xb1, 0xde, 0xff, 0x67, 0xf0, 0x73, 0xe3, 0x6b, 0xad, 0xda,
+		0x1c, 0xfd, 0xdc, 0xfa, 0x5a, 0xab, 0xb6, 0xae, 0xde, 0x7f, 0xfb, 0xf6, 0xe1, 0xfd, 0xaf, 0x8d,
+		0x47, 0xf1, 0x1b, 0xff, 0xc1, 0x97, 0x1d, 0x70, 0xc5, 0x32, 0xd2, 0xe3, 0x5a, 0x89, 0x85, 0x73,
+		0x13, 0xc2, 0x39, 0x10, 0x4e, 0xbb, 0xda, 0xd9, 0xad, 0x7e, 0xbe, 0xfa, 0x55, 0x5f, 0x6b, 0x3e,
+		0x7e, 0x7c, 0xff, 0x6b, 0xeb, 0x71, 0xfa, 0x8f, 0x7f, 0xbf, 0xf6, 0xb1, 0xfa, 0xda, 0xd6, 0xe3,
+		0xc7, 0x19, 0xff, 0x65, 0xf3, 0xf1, 0x63, 0xce, 0x31, 0x5a, 0x8f, 0xef, 0x5e, 0x7c, 0x34, 0xfb,
+		0x7b, 0x63, 0xd6, 0x0d, 0xcd, 0x19, 0x37, 0x6c, 0xcc, 0xba, 0x61, 0x63, 0xc6, 0x0d, 0x33, 0x1f,
+		0xa9, 0x31, 0xe3, 0x86, 0xd6, 0xe3, 0xdf, 0x2f, 0x3e, 0xff, 0xee, 0xf5, 0x8f, 0x6e, 0x3e, 0xbe,
+		0xff, 0x7b, 0xd6, 0x7f, 0xdb, 0x7a, 0xfc, 0xfb, 0xe3, 0xfb, 0x12, 0x42, 0xb5, 0xb2, 0xd8, 0xe7,
+		0x50, 0x54, 0x15, 0x8c, 0x16, 0xbf, 0x1d, 0x76, 0x6d, 0x2f, 0xa8, 0xf6, 0xa3, 0xa2, 0x6b, 0x95,
+		0x32, 0x69, 0x04, 0xeb, 0xc8, 0x0d, 0x6e, 0xd3, 0x3b, 0x36, 0x45, 0xc0, 0xa8, 0x57, 0x8f, 0xbd,
+		0xc0, 0xfa, 0xc8, 0x38, 0x20, 0xf1, 0x25, 0x33, 0xbd, 0x7e, 0xe8, 0x51, 0xbe, 0x4c, 0xc2, 0xac,
+		0xcb, 0xfa, 0x1c, 0xdb, 0x4e, 0x16, 0xd6, 0xdf, 0xf7, 0x6e, 0xbd, 0xfe, 0xa6, 0x73, 0xad, 0x8c,
+		0xe9, 0x89, 0xd9, 0xc6, 0xb8, 0x71, 0x4b, 0xd5, 0x68, 0x6d, 0x18, 0xb4, 0x58, 0x65, 0x51, 0xc8,
+		0x6b, 0x95, 0xd2, 0x71, 0x0f, 0xeb, 0xdd, 0xbb, 0x77, 0x5f, 0xed, 0xea, 0xff, 0xed, 0x56, 0xff,
+		0xb7, 0x56, 0xdd, 0xb9, 0xbe, 0x9a, 0xf8, 0xe5, 0xdb, 0xb7, 0xea, 0xf5, 0xd5, 0xfb, 0x5f, 0xb5,
+		0xb5, 0xcd, 0xfa, 0xe3, 0xfb, 0x7f, 0x3e, 0xfd, 0xfd, 0x2a, 0xe3, 0xc3, 0xff, 0x23, 0x73, 0xd7,
+		0x3f, 0xdf, 0xff, 0xfd, 0xed, 0xdb, 0x07, 0x6b, 0xd1, 0x96, 0xad, 0x52, 0xec, 0xf7, 0x16, 0x53,
+		0xee, 0x66, 0xb8, 0x65, 0x12, 0x85, 0x31, 0x43, 0xc0, 0x6e, 0x72, 0x30, 0xd9, 0x42, 0x22, 0x6e,
+		0xc7, 0xee, 0xf9, 0xd9, 0xb3, 0x58, 0xad, 0x7a, 0x13, 0xd1, 0x3f, 0x44, 0xff, 0x88, 0x10, 0xfd,
+		0x13, 0x91, 0x97, 0x0c, 0x7d, 0xd5, 0xa0, 0xd7, 0xbd, 0x71, 0x63, 0x86, 0x20, 0xe0, 0xa6, 0xc2,
+		0x10, 0xe7, 0x76, 0x70, 0x5b, 0x8a, 0x20, 0x20, 0x27, 0xab, 0x65, 0xa6, 0x48, 0x63, 0x6a, 0xc4,
+		0x35, 0x9e, 0x06, 0x42, 0xc4, 0xc0, 0x5a, 0x59, 0xd9, 0xaa, 0xae, 0x25, 0xd8, 0x6c, 0xb5, 0x36,
+		0x5a, 0x25, 0x5e, 0x06, 0xd0, 0x97, 0xe9, 0xcb, 0x4a, 0xfa, 0xa6, 0x6d, 0x1c, 0x01, 0x54, 0x2f,
+		0xdb, 0xf7, 0x7c, 0x3c, 0xd0, 0x0f, 0xd0, 0x0f, 0x22, 0xd0, 0x8f, 0xa2, 0x37, 0xda, 0x96, 0x74,
+		0x0b, 0x92, 0x75, 0x23, 0x8d, 0x35, 0x22, 0xc9, 0x1e, 0x1f, 0x30, 0x6d, 0xc3, 0x6c, 0x59, 0xa2,
+		0xde, 0xcc, 0x1b, 0x62, 0x46, 0x08, 0x19, 0x36, 0xbe, 0x8c, 0xdc, 0xf8, 0x5a, 0xfa, 0x70, 0xdc,
+		0x52, 0x9d, 0x32, 0x7b, 0x9e, 0xb3, 0xff, 0xfc, 0x57, 0xa9, 0xe6, 0x2a, 0x8f, 0x15, 0x0d, 0x53,
+		0x23, 0x97, 0x79, 0xa8, 0x92, 0x39, 0x25, 0x49, 0xf6, 0x91, 0x86, 0x8b, 0x34, 0x5c, 0x41, 0x22,
+		0xfb, 0xab, 0xb2, 0x80, 0x8e, 0x00, 0x2a, 0x9d, 0x00, 0x5e, 0xe9, 0x00, 0xd0, 0x07, 0x58, 0x09,
+		0xd4, 0xc4, 0xd3, 0x11, 0x4d, 0x69, 0x5d, 0xf1, 0x34, 0x04, 0xf2, 0xf6, 0xa1, 0x30, 0x96, 0x23,
+		0x6f, 0x7f, 0x24, 0xd3, 0x1c, 0xfd, 0x7b, 0xa4, 0x8f, 0xa0, 0x13, 0x67, 0x0b, 0x0c, 0x84, 0xd0,
+		0x74, 0x80, 0x89, 0x1d, 0x54, 0xac, 0xe0, 0x92, 0x03, 0x99, 0x24, 0xd8, 0x94, 0x41, 0x37, 0xba,
+		0x64, 0x4f, 0x91, 0xcd, 0x14, 0x3b, 0xa9, 0x53, 0x65, 0xcc, 0x40, 0x64, 0x03, 0x24, 0x27, 0x30,
+		0xf9, 0x01, 0xca, 0x0d, 0x54, 0x6d, 0x80, 0xd5, 0x06, 0x5c, 0x2d, 0x00, 0x56, 0x03, 0xb2, 0x22,
+		0xa0, 0xd9, 0x80, 0x3d, 0xba, 0xf8, 0x7a, 0xdc, 0xbc, 0x10, 0x60, 0xa6, 0x5e, 0x37, 0xd3, 0xa0,
+		0xe7, 0xda, 0x60, 0xe5, 0x02, 0xbf, 0x0e, 0x25, 0xa0, 0x4f, 0x19, 0xe8, 0x52, 0x0a, 0xda, 0x95,
+		0x83, 0x76, 0x25, 0xa1, 0x55, 0x59, 0xf0, 0x28, 0x0d, 0x26, 0xe5, 0xa1, 0xee, 0xc3, 0x17, 0xdc,
+		0x4b, 0x47, 0x47, 0x4f, 0x1d, 0xbd, 0xbd, 0x75, 0x66, 0x54, 0x58, 0xe5, 0xee, 0xb1, 0xf3, 0x3c,
+		0x5b, 0x24, 0x61, 0x3f, 0xc5, 0x43, 0xec, 0x25, 0x67, 0x75, 0xf7, 0xde, 0x61, 0x86, 0x48, 0xa1,
+		0x13, 0xc1, 0xdd, 0x93, 0xc7, 0xdc, 0xb9, 0xe0, 0xee, 0xd5, 0x63, 0xf8, 0x4c, 0x6c, 0x61, 0x26,
+		0xb4, 0xf5, 0xf6, 0x31, 0x5c, 0x34, 0x36, 0x31, 0x13, 0x1a, 0x7a, 0x01, 0x99, 0x3b, 0x15, 0xbc,
+		0x3d, 0x82, 0xcc, 0x9d, 0x07, 0xee, 0xde, 0x41, 0x86, 0xab, 0x89, 0x0d, 0xcc, 0x04, 0x77, 0xaf,
+		0x21, 0x73, 0xe7, 0x81, 0xb5, 0x07, 0x91, 0xe1, 0xc0, 0x68, 0x61, 0x26, 0x98, 0x7b, 0x16, 0x19,
+		0x2e, 0x10, 0x75, 0xcc, 0x04, 0x7f, 0x8f, 0x23, 0xb3, 0xbd, 0x72, 0xcc, 0x03, 0x7f, 0x4f, 0x24,
+		0x73, 0x67, 0x82, 0xbb, 0x57, 0x12, 0x6f, 0x68, 0x58, 0x2d, 0x9f, 0x54, 0xdf, 0x73, 0x3d, 0x96,
+		0xe2, 0xc0, 0x1f, 0x5b, 0x6f, 0xa5, 0x17, 0x52, 0xc1, 0xd8, 0xc0, 0x85, 0xb0, 0xd9, 0x85, 0xcd,
+		0x2e, 0x22, 0xc2, 0x66, 0x17, 0x17, 0xee, 0x49, 0xef, 0x66, 0x17, 0x7f, 0x0f, 0x27, 0x1d, 0xbd,
+		0x9c, 0x98, 0x7b, 0x3a, 0xf1, 0x2d, 0xf2, 0x62, 0x13, 0x29, 0x98, 0x7a, 0x3e, 0xe9, 0x39, 0xc2,
+		0xf0, 0x4a, 0x43, 0x28, 0x99, 0x53, 0x0d, 0x7c, 0x33, 0xae, 0x30, 0xdb, 0x7c, 0x99, 0x26, 0xdc,
+		0x19, 0x26, 0x68, 0x6c, 0xb8, 0x48, 0x63, 0x8a, 0x74, 0xb2, 0x12, 0x68, 0x41, 0xfe, 0xc6, 0x86,
+		0xf2, 0xa7, 0x3b, 0x38, 0x4f, 0x7b, 0xbc, 0x84, 0xd6, 0x8b, 0xd3, 0x1f, 0x63, 0x25, 0x82, 0x5e,
+		0xb1, 0xe8, 0x15, 0x2b, 0x34, 0x57, 0x50, 0xa9, 0x50, 0xa9, 0x44, 0x50, 0xa9, 0x2f, 0x54, 0xea,
+		0x58, 0x89, 0x98, 0xa8, 0x52, 0x85, 0x5a, 0x16, 0xce, 0x5d, 0x33, 0x91, 0x56, 0x86, 0x73, 0x57,
+		0x8b, 0x4b, 0x99, 0x36, 0xa0, 0x4c, 0x89, 0xa0, 0x4c, 0x0b, 0x42, 0x23, 0xe1, 0xb8, 0x83, 0x02,
+		0xe8, 0x11, 0x01, 0xe6, 0xb8, 0x10, 0x01, 0x2e, 0x54, 0x59, 0xf0, 0x28, 0x0d, 0x26, 0xe5, 0xc1,
+		0xcf, 0xc8, 0x88, 0x70, 0xdc, 0x21, 0xcf, 0x26, 0x29, 0x8e, 0x3b, 0x10, 0xe1, 0xb8, 0x03, 0x11,
+		0x8e, 0x3b, 0xe4, 0x48, 0xae, 0xc0, 0x71, 0x07, 0x1c, 0x77, 0xc0, 0x71, 0x07, 0x22, 0x1c, 0x77,
+		0x20, 0xc2, 0x71, 0x07, 0x22, 0x1c, 0x77, 0x20, 0xc2, 0x71, 0x07, 0x22, 0x1c, 0x77, 0x20, 0xc2,
+		0x71, 0x07, 0x22, 0x1c, 0x77, 0x20, 0xc2, 0x71, 0x07, 0x22, 0x1c, 0x77, 0x20, 0xc2, 0x71, 0x07,
+		0x22, 0x1c, 0x77, 0xd0, 0xe4, 0x95, 0x63, 0x1e, 0x70, 0xdc, 0x01, 0xc7, 0x1d, 0x16, 0x3d, 0x0a,
+		0x8e, 0x3b, 0x10, 0x61, 0xb3, 0x8b, 0x08, 0x9b, 0x5d, 0x44, 0xd8, 0xec, 0x2a, 0xd3, 0x66, 0x17,
+		0x8e, 0x3b, 0x2c, 0x70, 0x84, 0x55, 0x3b, 0xee, 0x30, 0xc8, 0x9f, 0x5a, 0x54, 0x1e, 0x59, 0xa1,
+		0xb5, 0x7a, 0x7f, 0x77, 0x1f, 0x26, 0xf3, 0x48, 0x48, 0x11, 0x60, 0xd6, 0x91, 0x97, 0xa4, 0xbb,
+		0x69, 0xaa, 0x58, 0x00, 0xf8, 0xd8, 0x0b, 0x0e, 0x7c, 0x37, 0xd3, 0x99, 0x89, 0xf5, 0x91, 0x82,
+		0x9e, 0xef, 0xaf, 0x29, 0x0c, 0x66, 0xff, 0xe4, 0x1b, 0xec, 0x34, 0x6e, 0xbb, 0xb1, 0xdb, 0xfe,
+		0xf4, 0x30, 0x1c, 0xaa, 0xd0, 0xc5, 0x62, 0xc2, 0x91, 0x6e, 0xfc, 0x58, 0x4a, 0x99, 0x90, 0x71,
+		0xcf, 0x49, 0x83, 0x91, 0x1f, 0xd0, 0x7f, 0x8e, 0xeb, 0xa3, 0xc1, 0x73, 0x5c, 0x9f, 0xf7, 0xbf,
+		0xf8, 0xa2, 0xff, 0xbd, 0xd7, 0x17, 0xa3, 0x6f, 0x43, 0x13, 0x1b, 0x6d, 0x4b, 0x5a, 0x8a, 0x06,
+		0x15, 0x52, 0xb9, 0xb1, 0x4a, 0xb9, 0xb0, 0xca, 0x8d, 0x29, 0x1a, 0x68, 0x4c, 0xb1, 0x48, 0x26,
+		0xbe, 0xcc, 0x8d, 0x29, 0xa4, 0xda, 0x3a, 0xbd, 0x10, 0x16, 0x89, 0xf6, 0x4e, 0xd3, 0xe0, 0x40,
+		0x4f, 0x57, 0x42, 0x43, 0x0a, 0xd9, 0xc1, 0x4c, 0xed, 0xe9, 0xaa, 0x80, 0x1b, 0x5a, 0xde, 0x6e,
+		0xae, 0x3a, 0xda, 0x6c, 0xee, 0x30, 0x8c, 0xc5, 0x32, 0x53, 0x7c, 0x33, 0xa6, 0xbb, 0x0f, 0xae,
+		0x8e, 0x56, 0xa5, 0x93, 0x07, 0xae, 0x58, 0x5b, 0x96, 0x4e, 0xb7, 0x2e, 0x35, 0xa6, 0x3f, 0x2e,
+		0x6f, 0xf4, 0xff, 0x71, 0xad, 0xc4, 0xc2, 0xb9, 0x09, 0xe1, 0x44, 0x5f, 0x5d, 0x83, 0xfb, 0xea,
+		0x32, 0x43, 0x15, 0xad, 0xb5, 0x87, 0xaa, 0xa1, 0x1d, 0x76, 0x6d, 0x2f, 0xa8, 0xf6, 0xa3, 0x34,
+		0x25, 0xeb, 0xac, 0x7d, 0xe4, 0x06, 0xb7, 0xe9, 0x1d, 0x9b, 0x22, 0x60, 0xd4, 0xab, 0xc7, 0x5e,
+		0xa0, 0x61, 0x73, 0x8c, 0x75, 0x0b, 0xf3, 0xf9, 0x51, 0x17, 0xf5, 0xc3, 0xbf, 0xd3, 0x97, 0xf5,
+		0x39, 0xb6, 0x9d, 0x2c, 0xc6, 0xb5, 0xef, 0xdd, 0x7a, 0xfd, 0x68, 0x6c, 0x8d, 0x6d, 0x7c, 0xc6,
+		0x24, 0x87, 0x2c, 0x62, 0x6c, 0xdc, 0x52, 0x35, 0x5a, 0x1b, 0x06, 0x2d, 0x56, 0x59, 0x14, 0x72,
+		0x19, 0x7b, 0xf9, 0xbf, 0x7b, 0xf7, 0xee, 0xab, 0x5d, 0xfd, 0xbf, 0xdd, 0xea, 0xff, 0xd6, 0xaa,
+		0x3b, 0xd7, 0x57, 0x13, 0xbf, 0x7c, 0xfb, 0x56, 0xbd, 0xbe, 0x7a, 0xff, 0xab, 0xb6, 0xb6, 0x59,
+		0x7f, 0x7c, 0xff, 0xcf, 0xa7, 0xbf, 0x5f, 0x65, 0x7c, 0xf8, 0x7f, 0x64, 0xee, 0xfa, 0xe7, 0xfb,
+		0xbf, 0xbf, 0x7d, 0xfb, 0x80, 0x0e, 0xf6, 0x1a, 0xb4, 0x8b, 0x35, 0x8c, 0xa9, 0x47, 0x61, 0xcc,
+		0x10, 0xb0, 0x9b, 0x1c, 0x4c, 0xb6, 0x2d, 0xa7, 0xdb, 0xb1, 0x7b, 0x7e, 0xf6, 0x2c, 0x56, 0xab,
+		0xde, 0x44, 0xf4, 0x0f, 0xd1, 0x3f, 0x22, 0x44, 0xff, 0x44, 0xe4, 0x25, 0x43, 0x5f, 0x35, 0xe8,
+		0x75, 0x6f, 0xdc, 0x98, 0x21, 0x08, 0xb8, 0xa9, 0x30, 0xc4, 0xb9, 0x1d, 0xdc, 0x96, 0x22, 0x08,
+		0xc8, 0xc9, 0x6a, 0xb9, 0x13, 0xf2, 0x46, 0xd4, 0x88, 0x6b, 0x3c, 0x0d, 0x84, 0x88, 0x23, 0xe1,
+		0x92, 0x93, 0xad, 0xea, 0x5a, 0x82, 0xcd, 0x56, 0x6b, 0xa3, 0x55, 0xe2, 0x65, 0x00, 0x7d, 0x99,
+		0xbe, 0xac, 0xa4, 0x6f, 0xda, 0xc6, 0x11, 0x40, 0xf5, 0x3e, 0xf8, 0xcf, 0xc7, 0x03, 0xfd, 0x00,
+		0xfd, 0x20, 0x02, 0xfd, 0x28, 0x7a, 0xa3, 0x6d, 0x49, 0xb7, 0x20, 0x59, 0x37, 0xd2, 0x58, 0x23,
+		0x92, 0xec, 0xf1, 0x01, 0xd3, 0x36, 0xcc, 0x96, 0x25, 0xea, 0xcd, 0xbc, 0x21, 0x66, 0x84, 0x90,
+		0x61, 0xe3, 0xcb, 0xc8, 0x8d, 0xaf, 0xa5, 0x0f, 0xc7, 0xad, 0x50, 0x2e, 0xae, 0xf8, 0x59, 0x84,
+		0xc7, 0x0a, 0xef, 0x27, 0x73, 0xce, 0xdf, 0xe8, 0x2c, 0x81, 0x40, 0x8e, 0x94, 0xdc, 0x71, 0x01,
+		0xa5, 0xe3, 0x01, 0x4a, 0xc7, 0x01, 0xe4, 0xd2, 0xff, 0xf3, 0xce, 0x9f, 0xa4, 0xdc, 0xb1, 0xca,
+		0x9b, 0xb5, 0x56, 0xe1, 0xcf, 0xd5, 0xb7, 0x2a, 0x3c, 0xc2, 0xf8, 0x58, 0x51, 0x98, 0x66, 0xd1,
+		0xe9, 0x55, 0x9c, 0x56, 0xab, 0x22, 0xf7, 0x26, 0x8f, 0x15, 0x81, 0x77, 0xcb, 0xfb, 0x4e, 0xa2,
+		0xef, 0xf2, 0x86, 0x14, 0xbc, 0xb9, 0xea, 0x56, 0x25, 0xdf, 0x2b, 0xbd, 0xf2, 0x3a, 0x56, 0xd7,
+		0xed, 0x86, 0xf1, 0xec, 0x53, 0xc3, 0x63, 0x1a, 0x36, 0xfc, 0xdc, 0x5a, 0xe5, 0x4d, 0x52, 0xb5,
+		0x56, 0x91, 0xf2, 0xff, 0xf3, 0xf8, 0xf7, 0x93, 0xfe, 0x7b, 0xf2, 0xf0, 0x16, 0x15, 0xcc, 0xeb,
+		0x9f, 0x0b, 0xfb, 0xdf, 0xc2, 0xfe, 0xf5, 0xb4, 0xff, 0x9c, 0x3d, 0x37, 0x93, 0x08, 0xce, 0xcb,
+		0x64, 0xb7, 0x9c, 0xd1, 0x9c, 0xcf, 0x99, 0x84, 0xd1, 0xb4, 0x0e, 0x3f, 0x3f, 0xe7, 0x85, 0xf2,
+		0x1d, 0xd1, 0xc8, 0x1d, 0xf0, 0x11, 0x09, 0xec, 0xe4, 0x17, 0x00, 0x51, 0x41, 0x90, 0x16, 0x08,
+		0x69, 0xc1, 0x90, 0x12, 0x90, 0x72, 0xeb, 0xe3, 0x81, 0x82, 0xc8, 0xd5, 0xb3, 0xea, 0xb1, 0x22,
+		0xf1, 0xf0, 0x39, 0x4f, 0x24, 0x09, 0x9d, 0x40, 0x12, 0x16, 0xe7, 0x06, 0xc4, 0xb9, 0x64, 0xe2,
+		0x9c, 0xf7, 0x44, 0x8f, 0x15, 0xdd, 0x3d, 0x24, 0x9e, 0x63, 0xfb, 0xf9, 0xa7, 0x6f, 0xbc, 0xff,
+		0x38, 0xba, 0x33, 0x2f, 0x21, 0x17, 0x0a, 0x96, 0x0b, 0x07, 0xc7, 0x65, 0x82, 0xe1, 0xe2, 0xc2,
+		0x26, 0x2b, 0x74, 0xca, 0xc2, 0xa7, 0x2c, 0x84, 0x4a, 0xc2, 0x98, 0x4f, 0x28, 0x73, 0x0a, 0xe7,
+		0x74, 0xe0, 0x56, 0x62, 0xbd, 0x7a, 0x5e, 0x90, 0x6e, 0x8a, 0x64, 0x8d, 0x8c, 0xa4, 0x4f, 0x20,
+		0xbc, 0x25, 0xb9, 0xad, 0xfd, 0xab, 0x52, 0xe8, 0xb6, 0xb5, 0xea, 0x1e, 0x94, 0xe2, 0xb6, 0x34,
+		0xc7, 0xfe, 0xa7, 0xcc, 0x7e, 0xa0, 0xca, 0x36, 0x33, 0xd7, 0x94, 0xd5, 0xb7, 0x9b, 0xcd, 0xcd,
+		0xad, 0x66, 0xb3, 0xb6, 0xb5, 0xb1, 0x55, 0xdb, 0x69, 0xb5, 0xea, 0x9b, 0xf5, 0xd6, 0x02, 0x67,
+		0x51, 0x53, 0x54, 0xe6, 0x8a, 0xcb, 0x5d, 0xce, 0x61, 0xc9, 0x63, 0xb7, 0xef, 0xaf, 0xb6, 0xc5,
+		0x8d, 0xd1, 0xf8, 0x4e, 0x18, 0x23, 0x22, 0x18, 0x23, 0x22, 0x18, 0x23, 0x82, 0x31, 0x82, 0x31,
+		0x5a, 0xd4, 0x2c, 0x96, 0xdd, 0x18, 0x95, 0x39, 0x56, 0x90, 0x63, 0x93, 0xa5, 0xac, 0x11, 0xdb,
+		0x37, 0xc3, 0xa1, 0x34, 0x33, 0x60, 0x7b, 0x3c, 0xb8, 0x4d, 0x21, 0x5e, 0x1b, 0xa4, 0xd1, 0xfc,
+		0x60, 0x6d, 0xf6, 0x21, 0x44, 0x6a, 0x11, 0xa9, 0x45, 0xa4, 0x56, 0x59, 0x30, 0xa4, 0x04, 0x84,
+		0x47, 0xfb, 0xe6, 0x0e, 0x6d, 0xb9, 0x81, 0x7d, 0xe3, 0xbb, 0xd5, 0x20, 0x8d, 0xaa, 0x76, 0x2f,
+		0xbd, 0xcb, 0x75, 0xd3, 0xb3, 0x75, 0x9a, 0x1e, 0x60, 0xad, 0x22, 0x7a, 0xb4, 0xa5, 0x63, 0xfb,
+		0x89, 0x0b, 0x9f, 0x44, 0xe0, 0x82, 0x4f, 0xc2, 0xe2, 0x93, 0xdc, 0x84, 0xa1, 0xef, 0xda, 0x81,
+		0x84, 0x53, 0x52, 0xaf, 0x17, 0xe8, 0xf0, 0x0f, 0x10, 0xd6, 0x96, 0x85, 0x66, 0x1b, 0x90, 0x04,
+		0x24, 0x01, 0x49, 0x56, 0x48, 0x66, 0xd6, 0x4e, 0xf2, 0xb4, 0xc5, 0x24, 0xd1, 0xae, 0x4a, 0x9d,
+		0xb0, 0x00, 0xe0, 0x00, 0x38, 0x36, 0xc0, 0x49, 0x9d, 0x50, 0x90, 0x39, 0x91, 0x20, 0x77, 0x02,
+		0xe1, 0x57, 0x65, 0x51, 0x27, 0x0c, 0x54, 0x92, 0xbd, 0x95, 0x93, 0xbb, 0x4b, 0x7f, 0x62, 0xe0,
+		0x4a, 0x67, 0x3a, 0xef, 0xaf, 0xca, 0xa2, 0x32, 0xfe, 0x4b, 0xb1, 0xe8, 0xc8, 0xe0, 0x37, 0x22,
+		0x83, 0xff, 0x0a, 0xe1, 0x5b, 0x89, 0xe0, 0x67, 0x90, 0x46, 0x1a, 0xf3, 0xbc, 0x32, 0x4e, 0xf5,
+		0xdd, 0x7d, 0x48, 0xf2, 0x07, 0xc4, 0xc6, 0x77, 0x20, 0x24, 0x86, 0x90, 0xd8, 0x33, 0x21, 0x92,
+		0x63, 0xf4, 0xd9, 0x8d, 0x62, 0x34, 0xbe, 0x0e, 0x1a, 0x0f, 0x1a, 0x2f, 0x26, 0xa2, 0xa3, 0x2b,
+		0x6f, 0xf8, 0x7f, 0xe6, 0x32, 0xe7, 0xda, 0x0e, 0x50, 0x14, 0x5c, 0x69, 0x01, 0x56, 0x11, 0x64,
+		0x75, 0x81, 0x56, 0x15, 0x6c, 0x36, 0x01, 0x67, 0x13, 0x74, 0x16, 0x81, 0x17, 0x13, 0x7c, 0x41,
+		0x00, 0x48, 0x03, 0x61, 0x74, 0x59, 0xdf, 0xdd, 0x87, 0xaa, 0xd7, 0x56, 0x2f, 0x7d, 0x31, 0x1c,
+		0x07, 0x25, 0x2f, 0xe4, 0x81, 0xc3, 0x05, 0x20, 0x76, 0x20, 0xb1, 0x03, 0x8a, 0x15, 0x58, 0x72,
+		0x00, 0x93, 0x04, 0xda, 0x74, 0x70, 0x86, 0x41, 0x5e, 0xb2, 0x44, 0xaf, 0xfa, 0x26, 0x8a, 0x6d,
+		0x11, 0xa1, 0xd8, 0x16, 0x8a, 0x6d, 0x11, 0xa1, 0xd8, 0xd6, 0x72, 0x16, 0xdb, 0xca, 0x18, 0x42,
+		0xaa, 0xa2, 0x35, 0x9f, 0x71, 0x8d, 0xfe, 0x48, 0x60, 0x1b, 0x60, 0x1b, 0x44, 0x60, 0x1b, 0x02,
+		0xf2, 0xe2, 0xb5, 0xdd, 0x20, 0xf5, 0xd2, 0x87, 0xd8, 0xed, 0x70, 0x54, 0xd8, 0x52, 0xd0, 0xc1,
+		0xd6, 0xe1, 0xf0, 0x51, 0x3e, 0xd9, 0x09, 0x83, 0xf8, 0x8d, 0x5e, 0xf0, 0xe4, 0xf2, 0xec, 0x3a,
+		0xeb, 0xb6, 0x7f, 0x7d, 0xf9, 0xef, 0xb3, 0x03, 0x55, 0x11, 0xec, 0x9b, 0x9b, 0x44, 0x99, 0x17,
+		0x11, 0x5b, 0x99, 0xfd, 0x97, 0xaf, 0x79, 0xbc, 0xdf, 0xb2, 0x16, 0x6c, 0xaf, 0xae, 0x8a, 0x46,
+		0x51, 0x61, 0xf6, 0xea, 0x7e, 0x48, 0x37, 0x18, 0x0c, 0xd6, 0x60, 0x28, 0x58, 0x2c, 0x58, 0x2c,
+		0x22, 0x58, 0x2c, 0x01, 0x79, 0x49, 0xd2, 0xf8, 0xed, 0x72, 0x2d, 0x79, 0xd1, 0x53, 0xdf, 0x46,
+		0xe9, 0x2e, 0xc1, 0x8d, 0xc7, 0xd1, 0x66, 0xdf, 0xe8, 0x87, 0x5c, 0x3b, 0x91, 0xf2, 0xd3, 0x21,
+		0x30, 0x15, 0xb2, 0x81, 0x4b, 0xb5, 0x80, 0xa5, 0xa4, 0x22, 0x46, 0x24, 0x1f, 0x91, 0x7c, 0xed,
+		0x8a, 0x73, 0xbc, 0xde, 0xbe, 0x6b, 0x77, 0xe4, 0xe8, 0xfd, 0x58, 0x53, 0x6e, 0xc9, 0x65, 0xf3,
+		0xf4, 0x35, 0xca, 0x87, 0x0f, 0x43, 0x2d, 0xb1, 0x3e, 0x84, 0x18, 0x1a, 0x6c, 0x4b, 0xa9, 0x8a,
+		0x06, 0x54, 0x05, 0x54, 0x05, 0x11, 0x36, 0xfd, 0xe6, 0x01, 0x05, 0x4e, 0x8d, 0x0e, 0x20, 0xb1,
+		0x03, 0x8a, 0x15, 0x58, 0x72, 0x00, 0x93, 0x04, 0x9a, 0xba, 0x6d, 0x26, 0xc2, 0xa6, 0x1f, 0x11,
+		0x36, 0xfd, 0xb0, 0xe9, 0x47, 0x84, 0x4d, 0x3f, 0xe6, 0xbb, 0xb1, 0xe9, 0x47, 0x04, 0xb6, 0x41,
+		0x04, 0xb6, 0x41, 0x04, 0xb6, 0x41, 0x84, 0x4d, 0x3f, 0x6c, 0xfa, 0xe9, 0x93, 0x66, 0x35, 0xab,
+		0x43, 0xd8, 0xf4, 0x13, 0x85, 0x1b, 0x2c, 0x16, 0xc1, 0x62, 0x15, 0x8c, 0x35, 0x6c, 0xfa, 0x61,
+		0xd3, 0xef, 0x69, 0xd3, 0xcf, 0xbc, 0xfe, 0x3c, 0x42, 0x81, 0x49, 0x74, 0xe8, 0x61, 0x90, 0x34,
+		0x25, 0x09, 0x53, 0xee, 0xc8, 0x73, 0x92, 0x46, 0xd9, 0xff, 0x67, 0xab, 0xbf, 0xf4, 0x87, 0x81,
+		0x47, 0x93, 0xa7, 0xa5, 0xed, 0xc3, 0xb0, 0xb1, 0x4f, 0xfe, 0xc6, 0x0f, 0xc3, 0x1b, 0x70, 0x18,
+		0x18, 0x87, 0x81, 0x27, 0x45, 0x48, 0xfc, 0x2c, 0xb0, 0x50, 0x6f, 0x2e, 0x1c, 0x05, 0x26, 0x1c,
+		0x05, 0x96, 0x13, 0xd0, 0xd1, 0x65, 0xc9, 0x76, 0xfd, 0x1e, 0xaf, 0xb3, 0x5c, 0xd5, 0x14, 0xa4,
+		0x10, 0x15, 0x2a, 0xe2, 0x6c, 0xa2, 0xce, 0x22, 0xf2, 0x62, 0xa2, 0x2f, 0x08, 0x01, 0x75, 0x37,
+		0xac, 0x7c, 0x29, 0x44, 0x23, 0x8c, 0x95, 0x20, 0x87, 0x08, 0xa5, 0x03, 0xa0, 0x2d, 0x96, 0x51,
+		0x5b, 0x48, 0x67, 0x11, 0xc9, 0x1a, 0xd0, 0x17, 0xf2, 0xa2, 0xd6, 0x70, 0x1c, 0x71, 0x52, 0x4e,
+		0x08, 0xb1, 0x43, 0x89, 0x1d, 0x52, 0xac, 0xd0, 0x92, 0x83, 0x98, 0x24, 0xd4, 0xd4, 0x0d, 0xf4,
+		0x0b, 0x79, 0x11, 0x68, 0x44, 0xcd, 0x55, 0x97, 0x72, 0xd6, 0xdb, 0x2c, 0x3c, 0x8b, 0x48, 0xa9,
+		0x42, 0xa7, 0xce, 0x19, 0xe2, 0x9d, 0x29, 0xbe, 0x19, 0xe3, 0xae, 0x00, 0xaa, 0xa3, 0x48, 0xe4,
+		0x5b, 0x5c, 0x51, 0xa9, 0x78, 0xe4, 0xbc, 0xa2, 0x92, 0xa5, 0xad, 0x24, 0xca, 0xbf, 0x21, 0xcb,
+		0xa0, 0xd2, 0x8a, 0x11, 0xce, 0x4d, 0x08, 0x27, 0x2a, 0x9e, 0x1a, 0x54, 0xf1, 0x54, 0x33, 0x54,
+		0x2b, 0x8b, 0x7d, 0x0e, 0x45, 0x55, 0xc1, 0x68, 0xf1, 0xdb, 0x61, 0xd7, 0xf6, 0x82, 0x6a, 0x7f,
+		0x53, 0x68, 0xad, 0x52, 0x26, 0x8d, 0x60, 0x1d, 0xb9, 0xc1, 0x6d, 0x7a, 0xc7, 0xa6, 0x08, 0x18,
+		0xf5, 0x2a, 0x67, 0xd2, 0xf5, 0xf4, 0xd4, 0xd5, 0xd6, 0x78, 0x87, 0x1d, 0xf7, 0xa3, 0x63, 0x1e,
+		0x57, 0x43, 0x16, 0x30, 0x13, 0x3a, 0xb4, 0x25, 0x67, 0x17, 0xb5, 0x54, 0x8d, 0xd6, 0x86, 0x41,
+		0x8b, 0x55, 0x16, 0x85, 0xbc, 0x56, 0x29, 0x1d, 0xf7, 0xb0, 0xde, 0xbd, 0x7b, 0xf7, 0xd5, 0xae,
+		0xfe, 0xdf, 0x6e, 0xf5, 0x7f, 0x6b, 0xd5, 0x9d, 0xeb, 0xab, 0x89, 0x5f, 0xbe, 0x7d, 0xab, 0x5e,
+		0x5f, 0xbd, 0xff, 0x55, 0x5b, 0xdb, 0xac, 0x3f, 0xbe, 0xff, 0xe7, 0xd3, 0xdf, 0xaf, 0x32, 0x3e,
+		0xfc, 0x3f, 0x32, 0x77, 0xfd, 0xf3, 0xfd, 0xdf, 0xdf, 0xbe, 0x7d, 0xb0, 0x16, 0x6d, 0xd9, 0x96,
+		0x32, 0x17, 0xdf, 0x4e, 0x92, 0xd0, 0xf1, 0xfa, 0x59, 0x09, 0x4c, 0x39, 0xf9, 0x2f, 0x46, 0x94,
+		0x8c, 0x87, 0x4c, 0x34, 0x52, 0xba, 0x38, 0x38, 0xff, 0xf3, 0xe0, 0x1c, 0xa1, 0x40, 0x84, 0x02,
+		0x89, 0x10, 0x0a, 0x14, 0x91, 0x17, 0x37, 0xe8, 0x75, 0xdd, 0xb8, 0x0f, 0x46, 0x8e, 0x88, 0x60,
+		0x53, 0x61, 0x8c, 0x83, 0xa0, 0xd7, 0xcd, 0x5e, 0xaa, 0xcc, 0x99, 0xde, 0xde, 0x4d, 0x2f, 0x4e,
+		0x52, 0x75, 0x1d, 0x38, 0x1c, 0x47, 0x5d, 0xf3, 0x89, 0xb4, 0x90, 0x83, 0xe2, 0x83, 0xe2, 0x83,
+		0xe2, 0x93, 0x6e, 0x81, 0xa7, 0xd8, 0x12, 0x6f, 0x31, 0x0a, 0x2b, 0x0a, 0x63, 0x06, 0x75, 0xd5,
+		0x1f, 0x45, 0x5d, 0x59, 0xd5, 0x1b, 0x1b, 0x50, 0x55, 0x50, 0x55, 0x50, 0x55, 0xe2, 0xe8, 0xab,
+		0x06, 0xbd, 0xee, 0x4d, 0xee, 0x4c, 0x57, 0x22, 0xd4, 0x7e, 0x28, 0x4a, 0xb7, 0xcc, 0x8c, 0x65,
+		0xa1, 0xf6, 0x03, 0x11, 0x6a, 0x3f, 0xf0, 0x2b, 0xa4, 0xe5, 0x8e, 0x37, 0x65, 0x76, 0xc8, 0x8d,
+		0x19, 0x28, 0xcb, 0x60, 0x1c, 0x78, 0x58, 0xa0, 0x2d, 0x44, 0xa0, 0x2d, 0x0b, 0xa0, 0x2d, 0x2b,
+		0xe2, 0x61, 0x65, 0x27, 0xd9, 0x64, 0x4f, 0xd8, 0x3e, 0x9b, 0xaf, 0xd1, 0x40, 0xea, 0x2a, 0xab,
+		0x09, 0x75, 0x05, 0x75, 0x05, 0x75, 0x25, 0x5e, 0x5c, 0x6f, 0x9b, 0x41, 0x59, 0xb5, 0xe0, 0x5f,
+		0xb1, 0x6a, 0x95, 0x99, 0xe4, 0xbe, 0x0e, 0xff, 0x6a, 0xd1, 0x4b, 0xd0, 0x84, 0x6f, 0xb5, 0x30,
+		0xdf, 0x6a, 0x69, 0x2a, 0x87, 0x0c, 0xab, 0x01, 0x0c, 0xff, 0x2d, 0x51, 0xb3, 0x00, 0xd4, 0xff,
+		0xce, 0xf7, 0xa2, 0x38, 0xb9, 0x37, 0x01, 0x1c, 0x9c, 0xdc, 0x9b, 0x2b, 0x2f, 0x38, 0xb9, 0x07,
+		0x27, 0x65, 0x41, 0xd0, 0x92, 0x83, 0x98, 0x24, 0xd4, 0x34, 0x38, 0x29, 0x38, 0xb9, 0x47, 0x84,
+		0x93, 0x7b, 0x44, 0x38, 0xb9, 0xc7, 0x7d, 0x38, 0x0a, 0x27, 0xf7, 0x88, 0x70, 0x72, 0xaf, 0xa4,
+		0xc2, 0x89, 0x93, 0x7b, 0x38, 0xb9, 0x87, 0x93, 0x7b, 0x38, 0xb9, 0x57, 0x96, 0x90, 0xee, 0xf4,
+		0xd4, 0xe1, 0xe4, 0x1e, 0x4e, 0xee, 0xe1, 0xe4, 0x1e, 0x11, 0x4e, 0xee, 0xe1, 0xe4, 0x9e, 0x96,
+		0xef, 0xc3, 0xc9, 0x3d, 0x22, 0x84, 0x02, 0x89, 0x10, 0x0a, 0x24, 0x84, 0x02, 0x71, 0x72, 0x0f,
+		0x27, 0xf7, 0xa0, 0xf8, 0xa0, 0xf8, 0x90, 0x57, 0xba, 0xcc, 0x79, 0xa5, 0x61, 0xa7, 0x93, 0xb8,
+		0x0c, 0x0a, 0x6b, 0x38, 0x0e, 0x14, 0x0d, 0x14, 0x0d, 0x14, 0x8d, 0x70, 0x46, 0xe8, 0x66, 0x93,
+		0x41, 0xcf, 0x6c, 0x23, 0x25, 0x94, 0x55, 0xad, 0xcc, 0x0c, 0x42, 0xe1, 0xc8, 0xdd, 0xc2, 0x97,
+		0xa0, 0xbe, 0xdd, 0x6c, 0x6e, 0x6e, 0x35, 0x9b, 0xb5, 0xad, 0x8d, 0xad, 0xda, 0x4e, 0xab, 0x55,
+		0xdf, 0xac, 0xe3, 0x04, 0x9e, 0x51, 0x71, 0xa3, 0x28, 0xf4, 0xfd, 0xaa, 0x17, 0xa4, 0x6e, 0x7c,
+		0x6f, 0xfb, 0x1c, 0xb5, 0x03, 0x26, 0x87, 0x03, 0x0d, 0x01, 0x0d, 0x21, 0x02, 0x0d, 0x11, 0xa4,
+		0x21, 0x1b, 0x0d, 0x06, 0x1a, 0xb2, 0x05, 0x1a, 0xc2, 0xaa, 0x56, 0x66, 0xda, 0x40, 0xd0, 0x90,
+		0x85, 0x2f, 0x41, 0xb3, 0xb1, 0xd3, 0xdc, 0xd9, 0xdc, 0x6a, 0xec, 0x80, 0x7c, 0x18, 0x46, 0x3e,
+		0x50, 0xaf, 0x08, 0x84, 0x85, 0x08, 0x84, 0x05, 0xf5, 0x8a, 0x50, 0xaf, 0x48, 0x87, 0x6e, 0x99,
+		0x69, 0x32, 0xc1, 0x5a, 0x88, 0x50, 0xaf, 0x88, 0x5f, 0x21, 0x2d, 0x39, 0x61, 0x41, 0xbd, 0x22,
+		0x22, 0xd0, 0x16, 0x22, 0xd0, 0x16, 0x25, 0x9c, 0x63, 0x5f, 0xb9, 0x20, 0x85, 0x15, 0x87, 0x61,
+		0x5a, 0x6d, 0xbb, 0xbe, 0xfd, 0xa0, 0xae, 0xb4, 0x26, 0xc6, 0x82, 0xc2, 0x81, 0xc2, 0x81, 0xc2,
+		0x21, 0x42, 0x60, 0x97, 0x08, 0x2e, 0xd2, 0x02, 0xb8, 0x39, 0x02, 0xbb, 0xe5, 0x59, 0x0b, 0xf8,
+		0x49, 0xd3, 0xd7, 0x90, 0x2a, 0x78, 0x49, 0xc4, 0x55, 0x2e, 0x71, 0x7a, 0x40, 0x10, 0x10, 0x10,
+		0x10, 0x22, 0x10, 0x10, 0x41, 0x02, 0x82, 0x04, 0x37, 0x22, 0x22, 0x10, 0x10, 0x10, 0x10, 0x22,
+		0x24, 0xb8, 0xad, 0x04, 0x15, 0x49, 0xd2, 0xd8, 0x4e, 0x07, 0x27, 0x96, 0xd4, 0x28, 0xc8, 0x68,
+		0x20, 0x50, 0x0f, 0x50, 0x0f, 0x22, 0x50, 0x0f, 0x41, 0xea, 0x81, 0x6a, 0xcb, 0x44, 0x04, 0xe6,
+		0x01, 0xe6, 0x91, 0x73, 0x09, 0x1a, 0x2d, 0x10, 0x0d, 0xa3, 0x88, 0x06, 0x5a, 0x43, 0x10, 0x81,
+		0xac, 0x10, 0x81, 0xac, 0xa8, 0xa0, 0x1c, 0x64, 0x05, 0x64, 0x45, 0xd0, 0x52, 0xa2, 0x35, 0xc4,
+		0xc2, 0x97, 0x00, 0xad, 0x21, 0x16, 0x47, 0x55, 0x96, 0xb5, 0x35, 0xc4, 0xa0, 0xa3, 0x82, 0xae,
+		0xce, 0x10, 0x15, 0xc6, 0xd9, 0xca, 0x0c, 0xbb, 0x78, 0xa9, 0x7b, 0xeb, 0xc8, 0x4b, 0xd2, 0xdd,
+		0x34, 0x15, 0x4b, 0x27, 0xcc, 0x34, 0xf2, 0x81, 0xef, 0x66, 0x46, 0x3a, 0xc3, 0x47, 0xd0, 0xf3,
+		0xfd, 0xb5, 0x8a, 0x88, 0x2e, 0x91, 0xbf, 0xf9, 0x34, 0x6e, 0xbb, 0xb1, 0xdb, 0xfe, 0xf4, 0x30,
+		0xbc, 0x95, 0x75, 0x0a, 0x25, 0x05, 0x4d, 0x41, 0xc0, 0x2c, 0xa1, 0xe6, 0x20, 0x71, 0xcf, 0x49,
+		0x83, 0x21, 0x43, 0xb8, 0xe8, 0x0f, 0x79, 0x7d, 0x92, 0x46, 0xd7, 0x17, 0x83, 0xa1, 0x2a, 0x3c,
+		0x32, 0xf7, 0x58, 0x51, 0x98, 0x4a, 0xd1, 0x29, 0x94, 0x99, 0x3a, 0xab, 0x22, 0xf7, 0xf8, 0x6f,
+		0x3c, 0x7a, 0xce, 0xc6, 0x2b, 0x42, 0x8d, 0x56, 0x72, 0x36, 0x56, 0xc9, 0xdd, 0x48, 0x45, 0xc4,
+		0x1f, 0x11, 0xf7, 0x3b, 0x44, 0xfd, 0x0b, 0x69, 0x3f, 0x42, 0xda, 0x5f, 0x90, 0xf2, 0x0b, 0xd4,
+		0x84, 0x39, 0x6f, 0xe3, 0x12, 0xcb, 0xee, 0xa5, 0x77, 0xd5, 0xae, 0x97, 0x74, 0xed, 0xd4, 0xb9,
+		0xcb, 0x3f, 0x87, 0xa3, 0x55, 0x7a, 0x7e, 0x7b, 0x5e, 0x7d, 0x2f, 0xc4, 0xa2, 0x84, 0xdd, 0x61,
+		0x19, 0xf7, 0x57, 0xde, 0xdd, 0x95, 0x75, 0x6f, 0x95, 0xdd, 0x59, 0x65, 0xf7, 0x55, 0xc9, 0x5d,
+		0xe5, 0x65, 0x00, 0xc2, 0xee, 0xe7, 0x78, 0xbd, 0x9c, 0xb0, 0x97, 0x95, 0x4b, 0x10, 0xda, 0x99,
+		0x97, 0xd8, 0x89, 0x97, 0x74, 0x29, 0x7f, 0x55, 0x0a, 0x75, 0x19, 0x55, 0x03, 0x4f, 0x8a, 0xf1,
+		0x6b, 0x0e, 0xff, 0x43, 0x26, 0x74, 0xa8, 0xe2, 0xe2, 0x71, 0x4d, 0x19, 0xdf, 0x4e, 0x37, 0xcb,
+		0x2c, 0x6a, 0xe2, 0xfd, 0x57, 0x5c, 0x4c, 0x2d, 0x87, 0x59, 0x77, 0x03, 0xfb, 0xc6, 0x77, 0xab,
+		0x41, 0x1a, 0x55, 0x33, 0x2b, 0x23, 0x6e, 0x9b, 0xa6, 0x07, 0x58, 0xab, 0x88, 0x06, 0x80, 0x45,
+		0x8e, 0x07, 0xc1, 0xaa, 0x11, 0xac, 0x1a, 0x97, 0x55, 0x13, 0x3f, 0x5e, 0x23, 0x78, 0x9c, 0x86,
+		0x13, 0xa2, 0x6d, 0x59, 0x68, 0xb6, 0x01, 0x49, 0x40, 0x12, 0x90, 0x64, 0x85, 0x64, 0x66, 0xed,
+		0x92, 0xbe, 0xa0, 0x54, 0x45, 0x7b, 0x50, 0x8e, 0x5f, 0xf5, 0x95, 0x31, 0x00, 0x38, 0x00, 0xae,
+		0x60, 0xc0, 0x49, 0xb5, 0x2a, 0x94, 0x69, 0x49, 0x28, 0xd7, 0x7a, 0xf0, 0x57, 0x65, 0x51, 0xad,
+		0x04, 0x55, 0x7a, 0x30, 0x29, 0x77, 0x40, 0x29, 0x7d, 0x0b, 0xc0, 0x2b, 0x9d, 0x1b, 0x3a, 0xbf,
+		0x2a, 0x8b, 0x6a, 0xd1, 0x57, 0x8a, 0x45, 0x47, 0x6b, 0x3d, 0x23, 0x5a, 0xeb, 0x5d, 0x95, 0xdd,
+		0xfb, 0x2f, 0xef, 0x3e, 0xcd, 0xfc, 0x4d, 0xd3, 0xc7, 0x8a, 0xd8, 0x7f, 0x99, 0xf1, 0x3a, 0x79,
+		0x5f, 0x43, 0xe4, 0xf1, 0xad, 0xb5, 0x8a, 0xf0, 0x16, 0x9c, 0x55, 0xc9, 0xf7, 0x2a, 0xaf, 0xbc,
+		0x86, 0x95, 0x3d, 0x4b, 0xc7, 0x0f, 0x7f, 0xcc, 0x7c, 0x81, 0x27, 0xee, 0x35, 0xfa, 0xe4, 0x5a,
+		0xe5, 0x4d, 0xe5, 0xb6, 0x56, 0x91, 0xe2, 0x89, 0x79, 0x78, 0x61, 0xde, 0x67, 0x21, 0x01, 0xe6,
+		0x27, 0xcc, 0xf4, 0x84, 0x99, 0xdd, 0x73, 0x26, 0x37, 0x7a, 0x72, 0x26, 0x11, 0x9c, 0xb7, 0x49,
+		0x64, 0xd9, 0xb7, 0x83, 0x6f, 0xce, 0xb9, 0xb7, 0x38, 0xf8, 0x38, 0xf3, 0xde, 0x62, 0x4d, 0xc7,
+		0xde, 0xe2, 0x7c, 0x11, 0x10, 0x15, 0x05, 0x69, 0x91, 0x90, 0x16, 0x0d, 0x49, 0x11, 0xe1, 0x51,
+		0xc2, 0xb9, 0xf7, 0x17, 0x9d, 0xd1, 0x3a, 0x0a, 0xfa, 0xa0, 0xc3, 0xfb, 0xc4, 0xfc, 0xce, 0x7a,
+		0x29, 0xfd, 0xce, 0xfc, 0xc2, 0x26, 0x2b, 0x74, 0xca, 0xc2, 0xa7, 0x2c, 0x84, 0x8a, 0xc2, 0x98,
+		0x4f, 0x28, 0x73, 0x0a, 0xa7, 0xb0, 0x90, 0x8e, 0x2e, 0xeb, 0xc6, 0x76, 0xbe, 0x87, 0x9d, 0x8e,
+		0x7c, 0x41, 0xf7, 0xa7, 0x68, 0xd1, 0xf4, 0x48, 0x82, 0xd3, 0x28, 0xb7, 0x2b, 0x25, 0x9d, 0x39,
+		0xae, 0x92, 0x31, 0xae, 0x22, 0xe8, 0xaa, 0x02, 0xcf, 0x26, 0xf8, 0x6c, 0x00, 0x60, 0x02, 0x82,
+		0x18, 0x20, 0x04, 0x81, 0x31, 0x1d, 0xf8, 0xf8, 0x55, 0x51, 0xca, 0xf6, 0x96, 0x2a, 0xcb, 0xa3,
+		0x50, 0x8e, 0x47, 0x31, 0xbd, 0x5b, 0x21, 0xc7, 0x9d, 0x23, 0x9d, 0x9b, 0x29, 0x87, 0x98, 0xeb,
+		0xac, 0x19, 0x67, 0xce, 0xb0, 0x42, 0xba, 0x36, 0x4b, 0x9a, 0x36, 0xf7, 0xd4, 0x72, 0x95, 0xcf,
+		0x61, 0x9d, 0xe3, 0x82, 0xf2, 0xa2, 0x75, 0xc5, 0x10, 0x04, 0x64, 0xc4, 0x6a, 0xdb, 0x69, 0xdf,
+		0xf1, 0xad, 0x7a, 0x6d, 0x79, 0x4b, 0x3c, 0x39, 0x08, 0x8c, 0x30, 0x11, 0x8c, 0xf0, 0xd2, 0x19,
+		0x61, 0x79, 0x11, 0xa7, 0xc5, 0x47, 0x96, 0x27, 0x23, 0x9f, 0xd3, 0x01, 0xd5, 0xc6, 0x63, 0x16,
+		0x64, 0x2e, 0x51, 0x04, 0x54, 0x44, 0x7b, 0x75, 0x6c, 0xcf, 0xef, 0xc5, 0x6e, 0xb5, 0x1b, 0xb6,
+		0x5d, 0x79, 0xf5, 0xf5, 0x6c, 0x14, 0xe8, 0x2f, 0xe8, 0xaf, 0x25, 0xd4, 0x5f, 0x0a, 0x32, 0x4e,
+		0x8a, 0x6d, 0xb9, 0x25, 0xdb, 0x71, 0xeb, 0xd1, 0x18, 0x5e, 0x90, 0xd1, 0xc4, 0x7b, 0x2f, 0x7d,
+		0xa8, 0x46, 0x71, 0x78, 0xa3, 0xa0, 0x35, 0x5e, 0x8c, 0x04, 0xcd, 0x01, 0xcd, 0x81, 0xf0, 0x03,
+		0x11, 0xc2, 0x0f, 0x08, 0x3f, 0x10, 0x21, 0xfc, 0x20, 0x3d, 0xc7, 0x2b, 0x14, 0x7e, 0xe8, 0xda,
+		0x3f, 0xab, 0xc3, 0x38, 0xbe, 0xbc, 0x25, 0x9e, 0x1c, 0x04, 0x46, 0x98, 0x08, 0x46, 0x18, 0x46,
+		0x98, 0x08, 0x46, 0x18, 0x46, 0x98, 0x08, 0x46, 0x58, 0xee, 0x32, 0xde, 0x08, 0x1b, 0x55, 0xfa,
+		0x62, 0xa4, 0xb8, 0xd7, 0xfb, 0xe9, 0x4d, 0xeb, 0x83, 0xcf, 0x58, 0x05, 0x1e, 0xe9, 0xc8, 0x57,
+		0xe1, 0xe1, 0x85, 0x5a, 0xce, 0x53, 0xe9, 0x41, 0x39, 0x81, 0xa6, 0x81, 0x04, 0x1a, 0x5e, 0xee,
+		0x80, 0x04, 0x1a, 0x24, 0xd0, 0x80, 0x3c, 0x83, 0x3c, 0x13, 0x81, 0x3c, 0xb3, 0x42, 0x7b, 0x26,
+		0xc3, 0x03, 0x79, 0x26, 0x02, 0x79, 0x36, 0x87, 0x3c, 0x23, 0x81, 0x86, 0x08, 0x46, 0x98, 0x08,
+		0x46, 0x98, 0x88, 0x90, 0x40, 0x83, 0x04, 0x9a, 0xb7, 0xd7, 0x16, 0x09, 0x34, 0xd0, 0x5f, 0x48,
+		0xa0, 0x21, 0x42, 0x02, 0x8d, 0xc8, 0x8c, 0x22, 0x81, 0x06, 0x9a, 0x03, 0xe1, 0x07, 0x22, 0x84,
+		0x1f, 0x98, 0xa0, 0x3d, 0xd3, 0x47, 0x46, 0xf8, 0x81, 0x08, 0xe1, 0x07, 0xf6, 0xbb, 0x90, 0x40,
+		0x03, 0x23, 0x4c, 0x04, 0x23, 0x4c, 0x30, 0xc2, 0x44, 0x30, 0xc2, 0x73, 0x2c, 0x05, 0x8c, 0x30,
+		0x11, 0x8c, 0xb0, 0x39, 0x46, 0xd8, 0xe4, 0x04, 0x1a, 0x81, 0xc6, 0x44, 0xa6, 0x96, 0x12, 0x7b,
+		0xfe, 0xc6, 0x56, 0xae, 0x1c, 0x9f, 0x57, 0xca, 0x73, 0x9d, 0x0e, 0x87, 0xb9, 0xde, 0xed, 0x0f,
+		0xa3, 0xa1, 0x79, 0xcc, 0x4d, 0xec, 0xb5, 0x6f, 0xdd, 0x24, 0x7f, 0x89, 0xa7, 0xd1, 0x0d, 0x26,
+		0x14, 0x79, 0xba, 0x4f, 0x96, 0xb4, 0x83, 0xcc, 0xf0, 0xc5, 0xca, 0x52, 0xe2, 0x69, 0x20, 0x13,
+		0xe2, 0x09, 0x6a, 0xc3, 0xfb, 0x96, 0xa1, 0xc4, 0x53, 0x6e, 0x51, 0x93, 0x15, 0x39, 0x65, 0xd1,
+		0x53, 0x16, 0x41, 0x35, 0x51, 0xcc, 0xaf, 0xd1, 0x49, 0x67, 0x7e, 0xda, 0x50, 0xc1, 0x4a, 0xba,
+		0xa2, 0xfd, 0xbb, 0x57, 0xc1, 0x07, 0x15, 0x16, 0x67, 0x55, 0xb1, 0x66, 0x13, 0x6f, 0x36, 0x31,
+		0xe7, 0x11, 0x77, 0x31, 0xb1, 0x17, 0x14, 0x7f, 0x46, 0x0f, 0xd4, 0x77, 0xed, 0x4e, 0xec, 0x76,
+		0x54, 0xf6, 0x8e, 0xb6, 0xe4, 0x36, 0xbf, 0xfb, 0x4c, 0xea, 0xc3, 0x87, 0x01, 0x2b, 0x5c, 0xef,
+		0x23, 0xac, 0x04, 0x91, 0x2b, 0xb1, 0x94, 0xeb, 0x17, 0xd3, 0x29, 0x92, 0x7a, 0xfd, 0x62, 0x22,
+		0x65, 0x35, 0x45, 0x03, 0x9a, 0x02, 0x9a, 0x82, 0xd9, 0x60, 0x8e, 0x2e, 0x39, 0xc3, 0xf9, 0x42,
+		0x5c, 0x24, 0x0c, 0xe8, 0x34, 0x3c, 0x96, 0xa3, 0xa7, 0xbe, 0x34, 0x6c, 0xb8, 0xe0, 0xc3, 0x0e,
+		0x23, 0x76, 0x38, 0xf1, 0xc2, 0x4a, 0x0e, 0x5e, 0x92, 0x30, 0x53, 0x37, 0xcc, 0x2f, 0x24, 0x26,
+		0x49, 0x63, 0x2f, 0xb8, 0x65, 0x68, 0xab, 0x5f, 0xdf, 0x2e, 0xaa, 0xb1, 0xb6, 0x84, 0x4d, 0x88,
+		0xe2, 0x30, 0x0d, 0x9d, 0xd0, 0x4f, 0xd4, 0x55, 0xcd, 0xd3, 0x50, 0xd0, 0x37, 0xd0, 0x37, 0xd0,
+		0x37, 0xa6, 0xe9, 0x1b, 0xc9, 0x19, 0x90, 0x6a, 0x0a, 0xff, 0xda, 0x16, 0x95, 0x74, 0x9f, 0x77,
+		0xd6, 0xa6, 0xf1, 0x3c, 0x4d, 0xe4, 0xd5, 0x85, 0x52, 0x2f, 0x2b, 0x95, 0xdc, 0x80, 0x90, 0x0f,
+		0xcb, 0x0f, 0xa3, 0xd8, 0xc3, 0x7f, 0x45, 0x76, 0x24, 0xc4, 0x27, 0x84, 0x37, 0xe2, 0xf5, 0xbb,
+		0xfb, 0x20, 0x48, 0xa4, 0xe5, 0x20, 0xa1, 0x04, 0x01, 0x25, 0x91, 0x97, 0x13, 0xf1, 0xd2, 0xed,
+		0x75, 0x3d, 0x17, 0x31, 0x4b, 0x28, 0xf6, 0xf0, 0xd6, 0x56, 0xd0, 0xa7, 0xc1, 0x78, 0xab, 0xb1,
+		0x7d, 0x36, 0x9c, 0x44, 0x1d, 0x3b, 0x5f, 0x4e, 0x18, 0xa4, 0x71, 0xe8, 0xfb, 0x6e, 0x2c, 0xb0,
+		0xfb, 0x35, 0x79, 0x13, 0xda, 0x9c, 0xa0, 0xcd, 0xc9, 0x6b, 0xe2, 0x24, 0xd5, 0xea, 0x64, 0x74,
+		0x2f, 0xda, 0x9d, 0x90, 0xc0, 0x85, 0x6a, 0x0d, 0x52, 0xc2, 0x3a, 0x29, 0xb4, 0x22, 0xbd, 0x79,
+		0x5e, 0x13, 0xdc, 0xfc, 0x3d, 0x7a, 0x24, 0x85, 0x57, 0x5a, 0x88, 0x55, 0x84, 0x99, 0x43, 0xa8,
+		0x55, 0x85, 0x9b, 0x4d, 0xc8, 0xd9, 0x84, 0x9d, 0x49, 0xe8, 0xc5, 0x84, 0x5f, 0x10, 0x04, 0xd2,
+		0x60, 0x18, 0x5d, 0x88, 0x74, 0xf3, 0x46, 0x9e, 0xe4, 0x81, 0xc3, 0x05, 0x20, 0x76, 0x20, 0xb1,
+		0x03, 0x8a, 0x19, 0x58, 0x72, 0x00, 0x93, 0x04, 0xda, 0x32, 0xc6, 0x9e, 0x56, 0x3d, 0x3c, 0x32,
+		0xe1, 0xe6, 0x4c, 0xfc, 0x2c, 0x54, 0xf9, 0x4c, 0x7c, 0x66, 0x04, 0x66, 0x25, 0x23, 0x1e, 0x81,
+		0xdb, 0x4f, 0x06, 0x4e, 0x94, 0xd8, 0xcb, 0x78, 0x10, 0x50, 0x18, 0x9d, 0x1a, 0x18, 0x14, 0xa6,
+		0x58, 0x0a, 0xf3, 0x24, 0xd9, 0xea, 0x44, 0x66, 0x62, 0x2c, 0x35, 0x3a, 0x53, 0x07, 0x9d, 0x61,
+		0x01, 0x13, 0x3b, 0xa8, 0xd8, 0xc1, 0xc5, 0x0c, 0x32, 0x39, 0xb0, 0x49, 0x82, 0x4e, 0x19, 0x7c,
+		0xa3, 0xcb, 0xb2, 0x7b, 0x3f, 0x65, 0xca, 0x2e, 0xcd, 0x14, 0xbd, 0xe1, 0x78, 0x6b, 0xa5, 0x38,
+		0xdc, 0xa3, 0x0a, 0x4a, 0x4e, 0x70, 0xea, 0x00, 0x29, 0x37, 0x58, 0xb5, 0x81, 0x56, 0x1b, 0x78,
+		0x35, 0x81, 0x58, 0x0d, 0xcc, 0x8a, 0xa0, 0xe6, 0xf3, 0x55, 0x18, 0x13, 0x68, 0x39, 0x13, 0x6a,
+		0x5f, 0xc2, 0xeb, 0x29, 0xc1, 0x76, 0xc0, 0xde, 0xd7, 0x87, 0x6a, 0xa4, 0xb2, 0x98, 0xc5, 0x53,
+		0x58, 0x38, 0xd9, 0x68, 0xe5, 0xcc, 0x25, 0x93, 0x8a, 0x5e, 0xce, 0x5c, 0x2d, 0x28, 0x54, 0x22,
+		0x28, 0xd4, 0x55, 0x55, 0xa8, 0xaa, 0x6c, 0x69, 0x74, 0x59, 0x76, 0xbb, 0x1d, 0xbb, 0x49, 0xc2,
+		0x27, 0x1f, 0x63, 0xfa, 0x34, 0x1c, 0x98, 0x69, 0x11, 0x79, 0x78, 0x14, 0x3b, 0xfc, 0x75, 0xa8,
+		0x01, 0x9d, 0xea, 0x40, 0x97, 0x5a, 0xd0, 0xae, 0x1e, 0xb4, 0xab, 0x09, 0xcd, 0xea, 0x82, 0x47,
+		0x6d, 0x30, 0xa9, 0x0f, 0x7e, 0x5e, 0xf6, 0x42, 0x66, 0xbd, 0xa8, 0xca, 0xab, 0x01, 0x26, 0xb5,
+		0x40, 0x7d, 0x87, 0x71, 0xcc, 0xe1, 0x1c, 0x7c, 0x65, 0x95, 0x22, 0x5e, 0x54, 0x4d, 0xcd, 0xec,
+		0x7d, 0x53, 0xc3, 0xdc, 0x72, 0x14, 0x57, 0xcd, 0x43, 0x8f, 0x95, 0x8a, 0xaf, 0xce, 0xbb, 0xac,
+		0xff, 0xbc, 0x7b, 0x97, 0x95, 0x63, 0xbd, 0xfa, 0xfb, 0x6b, 0xbd, 0xba, 0x73, 0x35, 0xf8, 0xb1,
+		0xde, 0xff, 0x67, 0xf0, 0x73, 0xe3, 0x6b, 0xad, 0xda, 0x1c, 0xfd, 0xdc, 0xfa, 0x5a, 0xab, 0xb6,
+		0xae, 0xde, 0x7f, 0xfb, 0xf6, 0xe1, 0xfd, 0xaf, 0x8d, 0x47, 0xf1, 0x1b, 0xff, 0x61, 0xb1, 0xbf,
+		0xc4, 0x15, 0xeb, 0x88, 0x8f, 0x6b, 0x06, 0x09, 0xf5, 0x26, 0x84, 0xfa, 0x6d, 0xa1, 0x1e, 0xd5,
+		0x18, 0xae, 0xaf, 0x35, 0x1f, 0x3f, 0x66, 0x65, 0x86, 0xa7, 0xff, 0xf8, 0xf7, 0x6b, 0x1f, 0xab,
+		0xaf, 0x6d, 0x3d, 0x7e, 0x9c, 0xf1, 0x5f, 0x36, 0x1f, 0x3f, 0xe6, 0x1c, 0xa3, 0x35, 0x55, 0xe7,
+		0x38, 0xfb, 0x0f, 0xd9, 0xdf, 0x1b, 0xb3, 0x6e, 0x68, 0xce, 0xb8, 0x61, 0x63, 0xd6, 0x0d, 0x1b,
+		0x33, 0x6e, 0x98, 0xf9, 0x48, 0x8d, 0x19, 0x37, 0xb4, 0x1e, 0xff, 0x7e, 0xf1, 0xf9, 0x77, 0xaf,
+		0x7f, 0x74, 0xf3, 0xf1, 0xfd, 0xdf, 0xb3, 0xfe, 0xdb, 0xd6, 0xe3, 0xdf, 0x1f, 0xdf, 0x1b, 0x00,
+		0xf1, 0x4a, 0xb9, 0x9e, 0xeb, 0xb1, 0x52, 0x02, 0xa5, 0xc5, 0x15, 0x0b, 0x7e, 0xa1, 0xa6, 0x58,
+		0x62, 0xc2, 0xd3, 0x4a, 0x09, 0x3e, 0x0d, 0x11, 0x7c, 0x1a, 0xf8, 0x34, 0xa5, 0xf7, 0x69, 0xec,
+		0xde, 0x4f, 0xcf, 0xf7, 0xec, 0xf8, 0x81, 0x4f, 0x07, 0x4c, 0xea, 0x81, 0x16, 0xe3, 0x90, 0x6a,
+		0xc5, 0x06, 0x67, 0x5d, 0x1a, 0x08, 0x20, 0x47, 0x71, 0xc2, 0x79, 0x13, 0x5b, 0x5b, 0xd3, 0x33,
+		0x3c, 0x53, 0x31, 0xc3, 0x22, 0x0a, 0xf0, 0x69, 0x06, 0x1d, 0x7b, 0x51, 0xc4, 0x45, 0x2f, 0x69,
+		0xbd, 0x65, 0xf0, 0x9a, 0x82, 0x60, 0xea, 0x96, 0x79, 0xcb, 0x71, 0xe3, 0xd4, 0xeb, 0x78, 0x8e,
+		0x9d, 0xba, 0x5a, 0x88, 0xe6, 0xd4, 0xf8, 0x20, 0x9c, 0xca, 0x33, 0x0a, 0xc2, 0x49, 0x04, 0xc2,
+		0xc9, 0xac, 0x07, 0x48, 0x2f, 0xe1, 0x54, 0x4e, 0xcc, 0x66, 0x4e, 0xd4, 0xe6, 0x5f, 0x07, 0x0e,
+		0x5d, 0x1c, 0x85, 0x71, 0xca, 0xaf, 0x81, 0xfb, 0xa3, 0x32, 0x49, 0xc8, 0xbe, 0xdb, 0xb1, 0x7b,
+		0x7e, 0x1f, 0x0c, 0x9b, 0x9b, 0xad, 0x0d, 0xa8, 0x73, 0xf5, 0xe5, 0x81, 0x3a, 0x27, 0x82, 0x3a,
+		0x67, 0x56, 0x25, 0xa4, 0x57, 0x9d, 0x67, 0x2a, 0xa5, 0x1a, 0xf4, 0xba, 0x37, 0xb9, 0x0f, 0x1e,
+		0x8b, 0xa8, 0x81, 0x4d, 0x84, 0x0f, 0x78, 0x2f, 0x84, 0x0f, 0x8a, 0xc2, 0xdc, 0xd2, 0x85, 0x0f,
+		0x36, 0x5b, 0xad, 0x0d, 0x44, 0x10, 0x88, 0x10, 0x41, 0x98, 0x75, 0x59, 0x51, 0xec, 0x85, 0xb1,
+		0x97, 0x3e, 0x68, 0x60, 0xae, 0xa3, 0x91, 0x41, 0x33, 0x95, 0xe7, 0x12, 0x34, 0x93, 0x08, 0x34,
+		0x93, 0x19, 0xfb, 0xa4, 0x97, 0x66, 0x66, 0xdd, 0xad, 0xb6, 0xb1, 0x3f, 0x45, 0x04, 0x82, 0x09,
+		0x82, 0xb9, 0xa4, 0x04, 0xb3, 0xd1, 0x02, 0xbd, 0x24, 0x02, 0xbd, 0x9c, 0x75, 0x59, 0x49, 0x9f,
+		0x64, 0x54, 0xbd, 0x20, 0x75, 0xe3, 0x8e, 0xcd, 0x79, 0x00, 0x68, 0x1c, 0x9c, 0x9e, 0xfe, 0x06,
+		0xd0, 0x4d, 0xe5, 0x39, 0x05, 0xdd, 0x24, 0x02, 0xdd, 0x64, 0xd6, 0x05, 0xa4, 0x97, 0x6e, 0xde,
+		0xd8, 0xc9, 0x84, 0x16, 0xa8, 0xf2, 0x1c, 0xca, 0xd5, 0x71, 0x38, 0xf7, 0xc5, 0x21, 0xdd, 0xf5,
+		0xd0, 0xa9, 0x7a, 0x9d, 0x8f, 0xe3, 0x67, 0x4f, 0xa6, 0xff, 0x30, 0xfc, 0x5d, 0xbc, 0x49, 0x8e,
+		0x3e, 0x99, 0xe0, 0xb0, 0x0d, 0x69, 0x6c, 0x07, 0x89, 0x9e, 0x5d, 0xb3, 0xa7, 0xa1, 0xf9, 0xb7,
+		0xce, 0x2e, 0xf7, 0xce, 0x60, 0x63, 0xd4, 0x97, 0x08, 0x36, 0x86, 0x08, 0x36, 0x86, 0x59, 0xa7,
+		0x90, 0x5e, 0x1b, 0xc3, 0xad, 0x56, 0x26, 0x95, 0x40, 0xbd, 0xc9, 0x38, 0xe6, 0x41, 0xd0, 0xeb,
+		0x66, 0x53, 0xf0, 0x58, 0x16, 0x73, 0xb1, 0xd0, 0x53, 0xea, 0x8a, 0x85, 0xf4, 0x74, 0x16, 0xd6,
+		0x1b, 0x55, 0xa2, 0x9b, 0xf8, 0x59, 0xaa, 0xde, 0x1e, 0xdf, 0x84, 0x2b, 0x4c, 0x76, 0xd6, 0x36,
+		0x85, 0xaf, 0x4e, 0x47, 0x36, 0x18, 0x8a, 0x74, 0xe4, 0x99, 0x26, 0xc5, 0x1e, 0x3f, 0xba, 0xcc,
+		0xa7, 0xe9, 0x35, 0x3a, 0x54, 0x7b, 0x00, 0x2d, 0x59, 0x89, 0x0e, 0xa6, 0x3a, 0x3c, 0x2f, 0x04,
+		0x98, 0xa5, 0x1e, 0x0f, 0x33, 0xe4, 0xd9, 0xa1, 0xaf, 0x43, 0x05, 0x68, 0x54, 0x05, 0xba, 0x54,
+		0x82, 0x76, 0xd5, 0xa0, 0x5d, 0x45, 0xe8, 0x55, 0x15, 0x3c, 0x2a, 0x83, 0x49, 0x75, 0xb0, 0xab,
+		0x90, 0xd1, 0x65, 0x45, 0x76, 0x92, 0x78, 0xf7, 0x1a, 0xc4, 0x6a, 0x9c, 0x7b, 0x30, 0xfc, 0x02,
+		0xe6, 0x35, 0x9f, 0x88, 0x02, 0x74, 0x6c, 0x3f, 0x61, 0x1f, 0x5f, 0xcf, 0xae, 0x0d, 0xbb, 0x12,
+		0xd3, 0xa9, 0xcc, 0x0a, 0x50, 0x6a, 0xba, 0x95, 0x5b, 0x61, 0x4a, 0xae, 0x30, 0x65, 0x57, 0x8c,
+		0xd2, 0xe3, 0x55, 0x7e, 0xe3, 0xd1, 0x98, 0x65, 0x9e, 0x3d, 0xba, 0xf0, 0x42, 0xe2, 0x6f, 0xc2,
+		0xd0, 0x77, 0xed, 0x40, 0x67, 0xfd, 0x91, 0x7a, 0x49, 0xb7, 0x3e, 0x19, 0x17, 0xcb, 0xea, 0x05,
+		0xde, 0xcf, 0x6a, 0x12, 0x3a, 0xdf, 0xdd, 0x54, 0x9f, 0xa1, 0x99, 0xfc, 0x12, 0x18, 0x03, 0x18,
+		0x03, 0x22, 0x18, 0x03, 0x2a, 0xa7, 0x6e, 0x21, 0x23, 0x8d, 0x01, 0xfb, 0xd9, 0x3b, 0x4d, 0x67,
+		0xf0, 0xf8, 0xd7, 0xab, 0x5c, 0x3e, 0x10, 0x73, 0x0c, 0xb9, 0xa8, 0x58, 0x72, 0x78, 0x9f, 0xb0,
+		0xc4, 0x93, 0xf9, 0x16, 0x85, 0x25, 0xab, 0xa8, 0xdf, 0xb8, 0x97, 0x3f, 0x95, 0xa8, 0x3f, 0x6c,
+		0xc9, 0x03, 0x51, 0x0d, 0x04, 0xa2, 0x4c, 0x32, 0xcb, 0x08, 0x44, 0x95, 0x39, 0x10, 0xe5, 0xdb,
+		0x49, 0x5a, 0x75, 0xe3, 0x38, 0x8c, 0xf5, 0xb9, 0x08, 0x13, 0xdf, 0x01, 0x0f, 0x01, 0x1e, 0xc2,
+		0xe2, 0x54, 0x51, 0x61, 0x2a, 0xa9, 0x18, 0xd5, 0xc4, 0xab, 0xa2, 0x98, 0x55, 0x15, 0x11, 0x3c,
+		0x04, 0xed, 0xeb, 0xc5, 0xb8, 0x56, 0xd8, 0x92, 0x78, 0x5b, 0x06, 0x60, 0x63, 0x60, 0x63, 0x88,
+		0x60, 0x63, 0xca, 0xa6, 0xb7, 0x08, 0x5b, 0x12, 0x9a, 0x17, 0x8c, 0xd3, 0xc8, 0xc4, 0xa1, 0xaf,
+		0xd1, 0xc2, 0xf4, 0x47, 0x87, 0xfa, 0x87, 0xfa, 0x27, 0x82, 0xfa, 0xa7, 0x72, 0x6a, 0x13, 0x32,
+		0x52, 0xfd, 0xbb, 0x41, 0xaf, 0xeb, 0xc6, 0xb6, 0x42, 0x37, 0xe0, 0xa2, 0x33, 0xe0, 0x35, 0x65,
+		0xc2, 0xf3, 0x0b, 0x04, 0xa7, 0x79, 0x49, 0x5c, 0xa7, 0x9a, 0x78, 0x81, 0xe3, 0x56, 0x87, 0x3b,
+		0x00, 0xfa, 0x6c, 0xcd, 0xcb, 0xaf, 0x82, 0xe1, 0x81, 0xe1, 0x21, 0x82, 0xe1, 0xa1, 0x72, 0xea,
+		0x19, 0x32, 0xd2, 0xf0, 0x64, 0x35, 0x64, 0x36, 0x9b, 0x1a, 0x6d, 0x8e, 0x8e, 0x46, 0x4c, 0x7a,
+		0x8a, 0xca, 0x8c, 0x2e, 0x3d, 0x00, 0x25, 0xdd, 0x45, 0x66, 0xa6, 0x27, 0x5e, 0x53, 0x65, 0x92,
+		0x17, 0x15, 0x4a, 0x74, 0x7f, 0x4f, 0x01, 0x75, 0x4a, 0x34, 0xc1, 0xb7, 0xb0, 0x62, 0x34, 0x8b,
+		0x5a, 0xfa, 0xfa, 0x76, 0xb3, 0xb9, 0xb9, 0xd5, 0x6c, 0xd6, 0xb6, 0x36, 0xb6, 0x6a, 0x3b, 0xad,
+		0x56, 0x7d, 0x53, 0x57, 0x3b, 0x85, 0x85, 0x48, 0x43, 0xc5, 0x8c, 0x51, 0xaf, 0x56, 0x8a, 0x78,
+		0xb7, 0xbd, 0xa4, 0x40, 0xee, 0x3d, 0xf1, 0x6d, 0xa0, 0xdf, 0xa0, 0xdf, 0x44, 0xa0, 0xdf, 0x54,
+		0x4e, 0x6d, 0x43, 0xa0, 0xdf, 0x44, 0xa0, 0xdf, 0x44, 0xa0, 0xdf, 0xc6, 0x12, 0x2e, 0xd0, 0x6f,
+		0x22, 0xd0, 0x6f, 0x22, 0x22, 0xd0, 0xef, 0x32, 0xa1, 0x89, 0x39, 0x1d, 0xfc, 0x85, 0x15, 0xe4,
+		0x4c, 0x0b, 0x27, 0x02, 0xc1, 0x9e, 0x39, 0xd1, 0x20, 0xd8, 0x44, 0x20, 0xd8, 0xa5, 0xd0, 0x29,
+		0x84, 0x8d, 0x55, 0x22, 0x6c, 0xac, 0x12, 0x11, 0x11, 0x59, 0xa9, 0x1d, 0xdf, 0xea, 0x3c, 0x45,
+		0x3c, 0x1c, 0x1f, 0x26, 0x06, 0x26, 0x86, 0x08, 0x26, 0x86, 0xca, 0xa9, 0x51, 0x08, 0xc7, 0x03,
+		0x56, 0xf6, 0x78, 0x00, 0x8a, 0x49, 0x10, 0xc1, 0x16, 0x10, 0xc1, 0x16, 0xc0, 0x16, 0x68, 0xd0,
+		0x2f, 0x04, 0x5b, 0xa0, 0x77, 0xbd, 0x50, 0x4c, 0x82, 0xa9, 0x98, 0xc4, 0x20, 0x18, 0x86, 0xb2,
+		0xd2, 0xe6, 0x95, 0x95, 0x56, 0xaf, 0xa7, 0x3c, 0x78, 0xca, 0x34, 0xee, 0x39, 0x69, 0x30, 0xd4,
+		0x28, 0x17, 0xfd, 0x47, 0xba, 0x3e, 0x1d, 0x3e, 0xd2, 0xf5, 0xde, 0xf8, 0x31, 0xae, 0xf7, 0xc6,
+		0x5f, 0x7d, 0x7d, 0x7a, 0x9f, 0x98, 0x58, 0xce, 0x9a, 0x27, 0xae, 0xcc, 0x1a, 0x47, 0x66, 0x2f,
+		0x69, 0xdd, 0x28, 0x63, 0x49, 0x6b, 0xbe, 0xd6, 0x10, 0xa8, 0x69, 0xad, 0xa9, 0xf5, 0xc3, 0x92,
+		0x14, 0xb5, 0xb6, 0xdb, 0xed, 0xd8, 0x4d, 0x12, 0xfe, 0x5a, 0x42, 0xa3, 0x81, 0xd1, 0x29, 0xa6,
+		0x4c, 0xea, 0x40, 0x97, 0x5a, 0xd0, 0xae, 0x1e, 0xb4, 0xab, 0x09, 0xcd, 0xea, 0x82, 0x47, 0x6d,
+		0x30, 0xa9, 0x0f, 0x7d, 0x1e, 0xd7, 0x58, 0x66, 0xbd, 0xa8, 0xca, 0xab, 0x01, 0x26, 0xb5, 0x40,
+		0x7d, 0x87, 0x71, 0xcc, 0xe1, 0x1c, 0x94, 0xbe, 0x03, 0xee, 0xd3, 0xcc, 0xde, 0x37, 0x35, 0xcc,
+		0xed, 0x8b, 0x39, 0xd6, 0x91, 0x9a, 0x76, 0x66, 0xa7, 0xa9, 0x1b, 0x07, 0xda, 0x92, 0xd3, 0xac,
+		0xff, 0xbc, 0x7b, 0xf7, 0xb5, 0x56, 0xdd, 0xb9, 0xfa, 0xfb, 0x6b, 0xbd, 0xba, 0x73, 0x35, 0xf8,
+		0xb1, 0xde, 0xff, 0x67, 0xf0, 0x73, 0xe3, 0x6b, 0xad, 0xda, 0x1c, 0xfd, 0xdc, 0xfa, 0x5a, 0xab,
+		0xb6, 0xae, 0xde, 0x7f, 0xfb, 0xf6, 0xe1, 0xfd, 0xaf, 0x8d, 0x47, 0xf1, 0x1b, 0xff, 0x61, 0x95,
+		0x3d, 0x5b, 0x65, 0xcd, 0x20, 0xa1, 0xde, 0x84, 0x50, 0xbf, 0x2d, 0xd4, 0x76, 0xb5, 0xb3, 0x5b,
+		0xfd, 0x7c, 0xf5, 0xab, 0xbe, 0xd6, 0x7c, 0xfc, 0xf8, 0xfe, 0xd7, 0xd6, 0xe3, 0xf4, 0x1f, 0xff,
+		0x7e, 0xed, 0x63, 0xf5, 0xb5, 0xad, 0xc7, 0x8f, 0x33, 0xfe, 0xcb, 0xe6, 0xe3, 0xc7, 0x9c, 0x63,
+		0xb4, 0x1e, 0xdf, 0xbd, 0xf8, 0x68, 0xf6, 0xf7, 0xc6, 0xac, 0x1b, 0x9a, 0x33, 0x6e, 0xd8, 0x98,
+		0x75, 0xc3, 0xc6, 0x8c, 0x1b, 0x66, 0x3e, 0x52, 0x63, 0xc6, 0x0d, 0xad, 0xc7, 0xbf, 0x5f, 0x7c,
+		0xfe, 0xdd, 0xeb, 0x1f, 0xdd, 0x7c, 0x7c, 0xff, 0xf7, 0xac, 0xff, 0xb6, 0xf5, 0xf8, 0xf7, 0xc7,
+		0xf7, 0x06, 0x40, 0x1c, 0x5d, 0x97, 0x5f, 0x5e, 0x96, 0xdd, 0xfb, 0x59, 0xf5, 0xda, 0x1a, 0x9c,
+		0x9a, 0xc1, 0xb8, 0xf0, 0x69, 0x94, 0x67, 0x12, 0x3e, 0x0d, 0x11, 0x7c, 0x1a, 0x66, 0xdc, 0x93,
+		0x5e, 0x9f, 0xc6, 0xee, 0xfd, 0xf4, 0x7c, 0xcf, 0x8e, 0x1f, 0xf8, 0x74, 0xc0, 0xa4, 0x1e, 0x60,
+		0x4c, 0x0e, 0xd7, 0x74, 0x06, 0xe4, 0x57, 0xc5, 0xa8, 0x33, 0x1f, 0x9a, 0x13, 0xfe, 0x75, 0x9f,
+		0xf1, 0x28, 0x22, 0x9b, 0x5f, 0xc3, 0x99, 0x0e, 0xad, 0x67, 0x39, 0x8a, 0x5a, 0xd2, 0x7a, 0xcb,
+		0xe0, 0x35, 0x05, 0xc1, 0xd4, 0x2d, 0xf3, 0x96, 0xe3, 0xc6, 0xa9, 0xd7, 0xf1, 0x1c, 0x3b, 0x75,
+		0xb5, 0x10, 0xcd, 0xa9, 0xf1, 0x41, 0x38, 0x95, 0x67, 0x14, 0x84, 0x93, 0x08, 0x84, 0x93, 0x59,
+		0x0f, 0x90, 0x5e, 0xc2, 0xc9, 0x9e, 0xae, 0xc4, 0x9c, 0xa6, 0x54, 0x12, 0x5d, 0x3c, 0xc8, 0xb3,
+		0x70, 0xdb, 0x5a, 0x5a, 0xf3, 0x0e, 0x87, 0x86, 0x06, 0x56, 0x9e, 0x4c, 0x68, 0x60, 0x22, 0x68,
+		0x60, 0x66, 0xf4, 0x93, 0x5e, 0x0d, 0xcc, 0x5f, 0xf7, 0x99, 0xb9, 0xde, 0x73, 0x39, 0x54, 0x70,
+		0x14, 0xc6, 0x29, 0xbf, 0xf6, 0xed, 0x8f, 0xba, 0x56, 0xe1, 0x6e, 0x13, 0xb0, 0xb9, 0xd9, 0xda,
+		0x80, 0x3e, 0x57, 0x5f, 0x1e, 0xe8, 0x73, 0x22, 0xe8, 0x73, 0x66, 0x55, 0x42, 0x7a, 0xf5, 0x79,
+		0xa6, 0x52, 0xaa, 0x41, 0xaf, 0x7b, 0xe3, 0xc6, 0x1a, 0x74, 0xfa, 0x26, 0x22, 0xb8, 0xbc, 0x17,
+		0x22, 0xb8, 0x45, 0x61, 0x6e, 0xe9, 0x22, 0xb8, 0x9b, 0xad, 0xd6, 0x06, 0x82, 0xb8, 0x44, 0x08,
+		0xe2, 0xce, 0xba, 0xac, 0x28, 0xf6, 0xc2, 0xd8, 0x4b, 0x1f, 0x34, 0x30, 0xd7, 0xd1, 0xc8, 0xa0,
+		0x99, 0xca, 0x73, 0x09, 0x9a, 0x49, 0x04, 0x9a, 0xc9, 0x8c, 0x7d, 0xd2, 0x4b, 0x33, 0xb3, 0xba,
+		0x91, 0xdb, 0x48, 0x11, 0x20, 0x02, 0xc1, 0x04, 0xc1, 0x5c, 0x52, 0x82, 0xd9, 0x68, 0x81, 0x5e,
+		0x12, 0x81, 0x5e, 0xce, 0xba, 0xac, 0xa4, 0x4f, 0x32, 0xaa, 0x5e, 0x90, 0xba, 0x71, 0xc7, 0x76,
+		0x74, 0xf4, 0xeb, 0x9f, 0xfe, 0x06, 0xd0, 0x4d, 0xe5, 0x39, 0x05, 0xdd, 0x24, 0x02, 0xdd, 0x64,
+		0xd6, 0x05, 0xa4, 0x79, 0x97, 0xca, 0x4e, 0x26, 0xb4, 0x40, 0x35, 0x76, 0x3b, 0x3a, 0x36, 0xac,
+		0xb6, 0x18, 0xc7, 0x3c, 0x1b, 0x17, 0x79, 0x70, 0xaa, 0x5e, 0xe7, 0xe3, 0xf8, 0xd9, 0x93, 0xe9,
+		0x3f, 0x0c, 0x7f, 0xef, 0x97, 0x5b, 0x58, 0x22, 0xdb, 0x90, 0xc6, 0x76, 0x90, 0xe8, 0xd9, 0x35,
+		0x7b, 0x1a, 0x9a, 0x7f, 0xeb, 0xec, 0x72, 0xef, 0x0c, 0x36, 0x46, 0x7d, 0x89, 0x60, 0x63, 0x88,
+		0x60, 0x63, 0x98, 0x75, 0x0a, 0xe9, 0xb5, 0x31, 0xdc, 0x6a, 0x85, 0x34, 0xd5, 0xe7, 0x65, 0xae,
+		0xcb, 0x8b, 0x1a, 0x4d, 0x85, 0xd6, 0x68, 0x62, 0xa8, 0xae, 0xf5, 0x58, 0x29, 0xf6, 0x4e, 0xc9,
+		0x15, 0xca, 0x14, 0xab, 0xf2, 0x91, 0x42, 0xeb, 0xc8, 0x4b, 0xd2, 0xdd, 0x34, 0x55, 0x2b, 0x06,
+		0x93, 0x45, 0xd8, 0x0e, 0x7c, 0x37, 0xd3, 0x91, 0x89, 0xf5, 0x91, 0x82, 0x9e, 0xef, 0xaf, 0x29,
+		0x0c, 0x66, 0xff, 0xe4, 0x1b, 0xec, 0x34, 0x6e, 0xbb, 0xb1, 0xdb, 0xfe, 0xf4, 0x30, 0x1c, 0xaa,
+		0xd0, 0x15, 0x62, 0xc2, 0x8e, 0x66, 0xcc, 0x58, 0x6b, 0x95, 0x22, 0x6b, 0x99, 0x59, 0x95, 0x62,
+		0xa0, 0xf5, 0x58, 0xd1, 0xb8, 0xc4, 0xaa, 0x4b, 0xab, 0x63, 0x49, 0xad, 0x8a, 0x9e, 0xe9, 0x11,
+		0x98, 0x1a, 0x6b, 0x28, 0x09, 0xbf, 0x2a, 0x52, 0x14, 0xa1, 0x7f, 0xf7, 0x5a, 0x45, 0x86, 0x01,
+		0x08, 0xb2, 0x76, 0x69, 0xba, 0xaf, 0x42, 0xeb, 0x39, 0xe8, 0xbb, 0x2a, 0x4d, 0x67, 0xa3, 0xe3,
+		0x6c, 0xb4, 0x9b, 0x89, 0x5e, 0xeb, 0x85, 0xbb, 0x34, 0x2d, 0x1e, 0xaf, 0xb9, 0xef, 0xda, 0x1d,
+		0xb9, 0xb8, 0x8a, 0x4a, 0xfc, 0x64, 0x1c, 0x27, 0xf9, 0xf0, 0x61, 0x7d, 0xa0, 0x5f, 0xd6, 0xc5,
+		0x23, 0x21, 0x7a, 0x54, 0x85, 0x5c, 0x6d, 0x48, 0xa5, 0x5a, 0x90, 0x92, 0xb5, 0x1f, 0xa5, 0x6b,
+		0x3d, 0x42, 0x59, 0xac, 0xa4, 0xb2, 0x90, 0xad, 0xad, 0x28, 0x67, 0x3d, 0x5f, 0x08, 0x8c, 0x84,
+		0x15, 0x9d, 0x06, 0x88, 0x64, 0x0c, 0x4c, 0x39, 0x88, 0xc6, 0x11, 0x34, 0xe3, 0x0c, 0x92, 0x71,
+		0x05, 0xc5, 0xd8, 0x83, 0x60, 0xec, 0x41, 0x2f, 0xe6, 0x20, 0x57, 0xb1, 0x9e, 0xb0, 0x72, 0xd0,
+		0x8a, 0xef, 0xc0, 0xa4, 0xe2, 0x01, 0x49, 0xb8, 0x39, 0xaf, 0xbb, 0x39, 0x12, 0x61, 0x9d, 0xc7,
+		0x0a, 0xef, 0x27, 0x73, 0x4e, 0xdd, 0x28, 0x2c, 0x23, 0xa0, 0x85, 0xe5, 0x82, 0x30, 0x4a, 0x41,
+		0x17, 0xa5, 0x20, 0x8b, 0x5c, 0x50, 0x25, 0xef, 0xfc, 0x49, 0x8a, 0x1c, 0x97, 0xa8, 0x59, 0x6b,
+		0x15, 0xf6, 0xe8, 0x87, 0x55, 0xe1, 0x11, 0xc3, 0xc7, 0x8a, 0xc2, 0x04, 0x8b, 0x4e, 0xac, 0xd2,
+		0x84, 0x5a, 0x15, 0xb9, 0xf7, 0x78, 0xac, 0x08, 0xbc, 0x59, 0xde, 0x37, 0x12, 0x7e, 0x93, 0x37,
+		0x64, 0xe0, 0xed, 0x35, 0xb7, 0x2a, 0xf9, 0x5e, 0xea, 0x95, 0x17, 0xca, 0x7a, 0xb6, 0xb4, 0x6f,
+		0x66, 0xbe, 0xc7, 0x64, 0xcf, 0x9d, 0xf6, 0xcd, 0x8c, 0x07, 0x9c, 0xe3, 0xdf, 0xcc, 0xa5, 0x67,
+		0x79, 0xe8, 0x97, 0x40, 0xf3, 0x9f, 0xbc, 0xec, 0x49, 0x98, 0x1d, 0x09, 0xb3, 0x1f, 0xb1, 0xe6,
+		0x38, 0x62, 0x42, 0x38, 0x8f, 0xed, 0xe7, 0x74, 0x74, 0x85, 0x1c, 0xdb, 0x9c, 0x8e, 0x6c, 0x6e,
+		0xc7, 0x55, 0x84, 0x77, 0x4b, 0x74, 0x7f, 0x12, 0xa5, 0xd1, 0xd2, 0x74, 0x59, 0x9a, 0x16, 0xcb,
+		0x75, 0x4f, 0x52, 0xd3, 0xc7, 0x79, 0xdd, 0x44, 0xe1, 0x12, 0xfb, 0x92, 0x25, 0xf4, 0x05, 0x9d,
+		0x3f, 0x61, 0x67, 0x4f, 0xc6, 0xb9, 0x53, 0x68, 0x35, 0x26, 0xeb, 0xbb, 0x29, 0xfb, 0x6a, 0xca,
+		0xbe, 0x99, 0x5a, 0x2b, 0x2f, 0x5e, 0x36, 0x2b, 0xec, 0x5a, 0xc9, 0xbb, 0x52, 0x82, 0xae, 0x53,
+		0x0e, 0xba, 0x34, 0xff, 0xbb, 0x25, 0x6a, 0xbf, 0x48, 0xd7, 0x76, 0x01, 0xbe, 0x80, 0x2f, 0x46,
+		0x7c, 0x89, 0x57, 0x16, 0x11, 0xac, 0x1c, 0xc2, 0x03, 0x30, 0xdf, 0x4e, 0xd2, 0xaa, 0x1b, 0xc7,
+		0x61, 0x2c, 0x8e, 0xb0, 0x89, 0x7b, 0x01, 0x31, 0x40, 0x8c, 0x08, 0x26, 0xec, 0xe5, 0x65, 0xc5,
+		0xee, 0xd0, 0x12, 0x55, 0xb3, 0x3e, 0x02, 0xdd, 0x28, 0x95, 0x60, 0x8a, 0xaf, 0x8c, 0x01, 0xc4,
+		0x01, 0x71, 0x85, 0x23, 0x2e, 0x3b, 0xf7, 0xba, 0xd1, 0x90, 0x40, 0x9c, 0xc0, 0xde, 0xb8, 0xe4,
+		0xc1, 0xd5, 0x5f, 0x95, 0x42, 0x0f, 0x9e, 0xaa, 0x6e, 0xc5, 0x29, 0x1e, 0x1c, 0xe5, 0x38, 0x43,
+		0x28, 0xb1, 0x9d, 0xa3, 0x74, 0xb0, 0x93, 0x6b, 0xca, 0x9a, 0x8d, 0x9d, 0xe6, 0xce, 0xe6, 0x56,
+		0x63, 0xa7, 0xb5, 0xc0, 0xb9, 0xd3, 0xb4, 0xd7, 0x71, 0xb5, 0xdc, 0xa1, 0xe8, 0x2c, 0x38, 0x9a,
+		0x67, 0xb3, 0xa8, 0xb4, 0x11, 0xe8, 0x37, 0xa2, 0xbb, 0x34, 0x3b, 0xfc, 0xdc, 0xbf, 0x4b, 0x21,
+		0xf6, 0x1c, 0xc5, 0xa1, 0xe3, 0x26, 0x89, 0x9b, 0xcc, 0x8f, 0x3f, 0x3f, 0x7d, 0xb4, 0x0c, 0x31,
+		0x68, 0xa7, 0x9a, 0x3d, 0x8f, 0x81, 0x31, 0xe8, 0xe1, 0x83, 0x17, 0x15, 0x83, 0x1e, 0xae, 0x5a,
+		0xfe, 0x28, 0xf4, 0xe8, 0x06, 0x13, 0xe2, 0xd0, 0x73, 0x85, 0x40, 0x54, 0x18, 0xa4, 0x85, 0x42,
+		0x5a, 0x38, 0xe4, 0x84, 0x84, 0x47, 0x19, 0xe7, 0x8e, 0x43, 0x47, 0x9e, 0x44, 0x94, 0x2c, 0xf2,
+		0x96, 0x23, 0x3e, 0x96, 0x5b, 0xc8, 0x64, 0x85, 0x4d, 0x59, 0xe8, 0x94, 0x85, 0x4f, 0x4d, 0x08,
+		0xf3, 0x09, 0x63, 0x4e, 0xa1, 0x64, 0x70, 0x25, 0xc4, 0x13, 0x6e, 0x65, 0x12, 0x6d, 0x27, 0x13,
+		0x6c, 0xfb, 0xbc, 0x63, 0x3d, 0x13, 0xf8, 0x02, 0xbd, 0x7f, 0xb1, 0x34, 0x5a, 0xa9, 0xf4, 0x59,
+		0xc1, 0xb4, 0x59, 0xe1, 0x74, 0x59, 0x00, 0xd3, 0x40, 0x60, 0x8a, 0x26, 0xb9, 0x5a, 0x76, 0x7c,
+		0x9b, 0xc8, 0xa7, 0x7b, 0xf7, 0xef, 0x5e, 0x85, 0xa3, 0x21, 0xc2, 0xe2, 0xac, 0x2a, 0xd6, 0x6c,
+		0xe2, 0xcd, 0x26, 0xe6, 0x3c, 0xe2, 0x2e, 0x26, 0xf6, 0x82, 0xe2, 0x2f, 0x6f, 0x9f, 0xd4, 0x83,
+		0xcc, 0x92, 0xc1, 0x66, 0xd9, 0x37, 0x54, 0x3a, 0x21, 0xca, 0x72, 0x32, 0x94, 0xe5, 0x44, 0xa8,
+		0xda, 0x49, 0x50, 0x3d, 0x47, 0x60, 0x9c, 0xa8, 0x57, 0xed, 0x25, 0xf6, 0xad, 0x3b, 0x8c, 0x02,
+		0xc8, 0xab, 0xc7, 0x17, 0x23, 0x41, 0x55, 0x42, 0x55, 0x2e, 0x9d, 0xaa, 0x4c, 0xbd, 0xae, 0x9b,
+		0x7a, 0xce, 0xf7, 0x64, 0xb3, 0xa9, 0xa0, 0x2f, 0x25, 0x5a, 0x78, 0x2b, 0xd6, 0xba, 0x54, 0x3b,
+		0x59, 0xcf, 0x70, 0xfa, 0x84, 0xa5, 0x54, 0x0e, 0x57, 0x2d, 0x4a, 0xce, 0xf2, 0x84, 0x8f, 0x6a,
+		0x75, 0x06, 0x4a, 0x37, 0xb5, 0xf5, 0xed, 0x66, 0x73, 0x73, 0xab, 0xd9, 0xac, 0x6d, 0x6d, 0x6c,
+		0xd5, 0x76, 0x5a, 0xad, 0xfa, 0x66, 0xbd, 0x55, 0xa2, 0xd9, 0x2e, 0xe8, 0x4c, 0xcb, 0x55, 0xa9,
+		0x6c, 0x73, 0x2f, 0x71, 0x63, 0x0e, 0xcb, 0xdc, 0x1f, 0x07, 0x76, 0x99, 0x08, 0x76, 0x59, 0x2b,
+		0x82, 0x60, 0x97, 0xf3, 0x5e, 0xb0, 0xcb, 0xda, 0x2c, 0x05, 0xec, 0x72, 0x91, 0xb3, 0xbd, 0x6a,
+		0x76, 0x39, 0xf5, 0x7c, 0xef, 0xff, 0xe4, 0x8e, 0xa5, 0x3e, 0x37, 0xcc, 0x13, 0x03, 0xc1, 0x32,
+		0x13, 0xc1, 0x32, 0x6b, 0xc5, 0x50, 0xf1, 0x96, 0x39, 0x72, 0x63, 0xc7, 0x0d, 0x52, 0xfb, 0xd6,
+		0x55, 0x30, 0xcc, 0x2d, 0x18, 0x66, 0x35, 0xeb, 0x01, 0xc3, 0xac, 0x6d, 0x6a, 0xeb, 0xb5, 0x1a,
+		0xec, 0x30, 0xd7, 0xf8, 0x22, 0x76, 0xb8, 0xeb, 0x76, 0xc3, 0xf8, 0x61, 0xe0, 0xda, 0xca, 0x1b,
+		0xe1, 0x67, 0xa3, 0xc0, 0x02, 0x13, 0xc1, 0x02, 0x6b, 0x45, 0x4f, 0xf1, 0x16, 0x38, 0xcb, 0x68,
+		0x87, 0x5b, 0x5c, 0x00, 0xb0, 0x67, 0x9a, 0x08, 0x58, 0x5f, 0x22, 0xb8, 0xc5, 0x2b, 0x62, 0x8e,
+		0x39, 0x3c, 0xe3, 0x57, 0xc6, 0x82, 0x69, 0x26, 0x82, 0x69, 0xd6, 0x8a, 0x24, 0x38, 0xc7, 0x39,
+		0x2f, 0x98, 0x67, 0x6d, 0x06, 0x03, 0xce, 0xb1, 0xc6, 0xc9, 0x5d, 0x21, 0x6b, 0x8c, 0x32, 0xe8,
+		0x79, 0xde, 0x13, 0x16, 0x77, 0xd5, 0x2c, 0x6e, 0xe1, 0xb9, 0xae, 0x5a, 0xd0, 0x2d, 0x72, 0x0e,
+		0xea, 0xc5, 0x14, 0x44, 0xc2, 0xbd, 0x5a, 0x80, 0x6d, 0x60, 0x1b, 0x81, 0x2e, 0x22, 0x30, 0xe9,
+		0x39, 0x74, 0x0f, 0x4c, 0x9a, 0x08, 0x81, 0xae, 0x25, 0xa7, 0xd6, 0x49, 0x6a, 0xc7, 0x69, 0x35,
+		0xcb, 0x38, 0x53, 0xea, 0x1d, 0x32, 0x1a, 0x03, 0xa6, 0x98, 0x08, 0xa6, 0x58, 0x2b, 0x72, 0x60,
+		0x8a, 0x73, 0x5c, 0x30, 0xc5, 0xda, 0x8c, 0x03, 0x4c, 0x71, 0x91, 0xb3, 0xbd, 0x42, 0xa6, 0xb8,
+		0x17, 0xa9, 0x99, 0xe1, 0xe1, 0xfd, 0x30, 0xc1, 0x44, 0x30, 0xc1, 0x5a, 0x11, 0x83, 0x23, 0x11,
+		0x79, 0x2f, 0xd8, 0x61, 0x6d, 0x96, 0x01, 0x76, 0xb8, 0xc8, 0xd9, 0x36, 0xdd, 0x0e, 0x1b, 0xd5,
+		0x53, 0x69, 0x5c, 0x14, 0x70, 0xf4, 0x93, 0x48, 0xc3, 0x2e, 0xdd, 0xc5, 0x26, 0x87, 0x0d, 0xb9,
+		0xe6, 0x07, 0xde, 0xc5, 0x4a, 0x5d, 0x48, 0x95, 0xb6, 0x90, 0x2a, 0x65, 0x21, 0x56, 0xba, 0x62,
+		0x51, 0xb5, 0x37, 0x5f, 0xc8, 0x80, 0x95, 0xab, 0x7a, 0xd5, 0x2b, 0xe5, 0x2c, 0xcf, 0x86, 0xf7,
+		0x1b, 0x59, 0xc1, 0x73, 0x3c, 0x0d, 0x2a, 0xf5, 0x38, 0x93, 0xe4, 0xae, 0x9a, 0xb8, 0xf1, 0xfd,
+		0x1b, 0x07, 0x8f, 0x9f, 0x82, 0x59, 0x4f, 0x9f, 0x2d, 0x47, 0x45, 0xce, 0xe4, 0x21, 0xa9, 0xa6,
+		0x6e, 0xdc, 0x35, 0xb2, 0x2a, 0xe7, 0xf8, 0xe1, 0x8b, 0xaa, 0xcc, 0xe9, 0x8c, 0x66, 0x3f, 0x67,
+		0x61, 0xce, 0xe1, 0xe7, 0x99, 0xeb, 0x72, 0xd6, 0xf4, 0xd4, 0xe5, 0xcc, 0x21, 0x0a, 0xa2, 0x22,
+		0x21, 0x2d, 0x1a, 0xd2, 0x22, 0x22, 0x2f, 0x2a, 0x3c, 0xf6, 0x2b, 0x77, 0x7d, 0x4e, 0x37, 0xb0,
+		0x6f, 0x7c, 0x89, 0x5a, 0x80, 0xc3, 0xfb, 0xf2, 0x16, 0x7e, 0x73, 0x3b, 0x76, 0xcf, 0xef, 0x4f,
+		0x42, 0x36, 0x77, 0x4b, 0x52, 0xdc, 0x53, 0x40, 0x52, 0x65, 0x25, 0x56, 0x59, 0x72, 0x95, 0x25,
+		0x58, 0x5d, 0x92, 0xf3, 0x49, 0x74, 0x4e, 0xc9, 0x96, 0x77, 0xb7, 0x4d, 0x6b, 0x82, 0x13, 0xc5,
+		0x61, 0x1a, 0x3a, 0xa1, 0x5f, 0xbd, 0x77, 0xe3, 0x44, 0x84, 0x85, 0x4f, 0xd6, 0x63, 0x7e, 0x3e,
+		0x82, 0x38, 0x5a, 0xff, 0x6c, 0x00, 0xab, 0xc0, 0xea, 0xe2, 0xb0, 0xea, 0x06, 0xbd, 0xae, 0x1b,
+		0x8b, 0x9e, 0x27, 0x18, 0xe3, 0xb5, 0x29, 0x70, 0xcf, 0x41, 0xd0, 0xeb, 0x17, 0xd3, 0x7b, 0x2c,
+		0x10, 0xe3, 0xb1, 0x9d, 0xba, 0x55, 0xdf, 0xeb, 0x7a, 0xa9, 0x38, 0xba, 0x27, 0xee, 0x05, 0x44,
+		0x01, 0xd1, 0x85, 0x41, 0x34, 0xdb, 0x38, 0xae, 0x6f, 0x4a, 0xa0, 0x73, 0x13, 0xed, 0x77, 0x5e,
+		0x8f, 0x92, 0xa2, 0xfd, 0x8e, 0xf0, 0x94, 0x6d, 0xb6, 0x5a, 0x1b, 0xe8, 0xbc, 0xa3, 0xb2, 0xac,
+		0x56, 0xe2, 0x26, 0x19, 0x49, 0x94, 0x35, 0x47, 0xcf, 0x6f, 0x87, 0x45, 0x82, 0x45, 0x22, 0x82,
+		0x45, 0x9a, 0xbe, 0x60, 0x91, 0x84, 0x55, 0x2b, 0x2c, 0xd2, 0xaa, 0x5a, 0xa4, 0x2c, 0x1f, 0x22,
+		0xec, 0x49, 0xd8, 0xa2, 0xd1, 0x8d, 0xb0, 0x42, 0xb0, 0x42, 0x44, 0xb0, 0x42, 0xd3, 0x17, 0xac,
+		0x90, 0xb0, 0x3a, 0x85, 0x15, 0x42, 0x47, 0x52, 0xc9, 0x34, 0x00, 0xe1, 0x74, 0x80, 0xa7, 0xdd,
+		0xf9, 0xf5, 0xc1, 0x7f, 0x97, 0xce, 0x6a, 0x78, 0xb3, 0x29, 0x68, 0x9e, 0x1e, 0x60, 0x42, 0xbd,
+		0xbf, 0xca, 0xd2, 0xd9, 0x11, 0x3b, 0xc8, 0x44, 0xd8, 0x41, 0x26, 0x50, 0x3b, 0x16, 0xc9, 0x55,
+		0x96, 0x60, 0x75, 0x49, 0x16, 0x33, 0x10, 0xd8, 0x41, 0x26, 0x22, 0xec, 0x20, 0x03, 0xab, 0xc0,
+		0x2a, 0x76, 0x90, 0xdf, 0x9a, 0x1a, 0xec, 0x20, 0x03, 0xa2, 0x44, 0x88, 0x94, 0xbc, 0x71, 0x21,
+		0x52, 0x22, 0x3a, 0x06, 0x22, 0x25, 0xd8, 0x41, 0xc6, 0x0e, 0x32, 0x2c, 0x12, 0x2c, 0x12, 0x11,
+		0x2c, 0x12, 0x2c, 0x12, 0x11, 0x2c, 0xd2, 0xc2, 0x2c, 0x12, 0x76, 0x90, 0x61, 0x85, 0x60, 0x85,
+		0x88, 0x60, 0x85, 0x60, 0x85, 0x88, 0x60, 0x85, 0x34, 0x58, 0xa1, 0xb2, 0xef, 0x20, 0xe7, 0x28,
+		0xaa, 0x50, 0xd6, 0x63, 0xf1, 0x73, 0x4f, 0xa9, 0xd3, 0xcc, 0x72, 0x00, 0x17, 0xc9, 0xdd, 0xc5,
+		0xe0, 0x4e, 0x95, 0x13, 0xf5, 0x6f, 0x6e, 0x91, 0xe7, 0xda, 0x1a, 0xcf, 0x7d, 0x8e, 0xbe, 0xc1,
+		0x76, 0x8e, 0xde, 0xd4, 0x23, 0xf4, 0x85, 0x9d, 0x9e, 0xbf, 0x09, 0xc3, 0x9c, 0xc5, 0x40, 0x27,
+		0x37, 0xfc, 0x72, 0xd5, 0xfe, 0xcc, 0xa9, 0x74, 0x8b, 0x38, 0x43, 0xbf, 0xac, 0xc9, 0x0f, 0xba,
+		0xf3, 0x1e, 0x72, 0xd3, 0x35, 0xc9, 0xb2, 0x5d, 0x02, 0x65, 0xba, 0x04, 0xb9, 0xd9, 0xaf, 0x8a,
+		0x56, 0x2e, 0x26, 0x5b, 0x44, 0x4f, 0x92, 0x7b, 0xa9, 0x90, 0x07, 0x91, 0x82, 0x84, 0x32, 0x1c,
+		0x4b, 0x75, 0x2a, 0xd4, 0xcb, 0x60, 0x29, 0xcd, 0x0e, 0x13, 0xf5, 0xb9, 0xd2, 0x90, 0x95, 0xe6,
+		0xf4, 0xe2, 0xd8, 0x0d, 0xd2, 0x6a, 0xdb, 0x4e, 0x5d, 0x31, 0x15, 0xfd, 0xe2, 0x4e, 0x68, 0x6a,
+		0x68, 0xea, 0xa9, 0xf9, 0xce, 0x64, 0xa3, 0x6a, 0x07, 0xed, 0x3c, 0xa6, 0x9c, 0x9e, 0x75, 0x10,
+		0xc9, 0xf1, 0xd9, 0x33, 0x3b, 0x4d, 0xdd, 0x38, 0xc8, 0xad, 0xae, 0xad, 0xff, 0x7c, 0xad, 0x55,
+		0x77, 0xae, 0x7e, 0x35, 0x1f, 0xbf, 0x7d, 0xab, 0x0e, 0x7e, 0x6c, 0x4c, 0xfe, 0x78, 0x39, 0xfa,
+		0xe1, 0xe3, 0x8b, 0x1f, 0xde, 0x7d, 0xfb, 0xf6, 0xa1, 0xff, 0xf3, 0xff, 0xef, 0xfd, 0x3f, 0xff,
+		0xf7, 0xeb, 0xff, 0xaf, 0x7a, 0xf5, 0xe2, 0x13, 0xff, 0xb0, 0x16, 0x02, 0xdf, 0x76, 0xd8, 0xb5,
+		0xbd, 0xa0, 0x9a, 0xab, 0x95, 0xd1, 0xd3, 0xaa, 0x4c, 0xdc, 0x04, 0xd0, 0x02, 0xb4, 0xd2, 0xe2,
+		0x21, 0x0c, 0xd9, 0x23, 0x37, 0xb8, 0x4d, 0xef, 0x72, 0x23, 0xb6, 0xe4, 0x04, 0xab, 0x0e, 0x82,
+		0x35, 0x9a, 0x8a, 0x46, 0x6b, 0xc3, 0x40, 0x3e, 0xa5, 0xc3, 0xc8, 0xbc, 0x7b, 0xf7, 0xee, 0xab,
+		0x5d, 0xfd, 0xbf, 0xdd, 0xea, 0xff, 0xd6, 0xaa, 0x3b, 0xd7, 0x57, 0x13, 0xbf, 0x7c, 0xfb, 0x56,
+		0xbd, 0xbe, 0x7a, 0xff, 0xab, 0xb6, 0xb6, 0x59, 0x7f, 0x7c, 0xff, 0xcf, 0xa7, 0xbf, 0x5f, 0x7d,
+		0xfb, 0xf6, 0xe1, 0xfd, 0xff, 0xc8, 0xdc, 0xf5, 0xcf, 0xf7, 0x7f, 0x7f, 0xfb, 0xf6, 0x61, 0x31,
+		0x96, 0xe7, 0x2e, 0x4c, 0x52, 0x31, 0xb3, 0x33, 0xbe, 0x03, 0x36, 0x07, 0x36, 0x87, 0x08, 0x36,
+		0x87, 0x08, 0x36, 0x07, 0x36, 0x87, 0x08, 0x36, 0x27, 0x9f, 0xd4, 0x59, 0x7e, 0x78, 0xeb, 0x05,
+		0xd5, 0x1b, 0x3b, 0x08, 0xdc, 0x38, 0xbf, 0xdd, 0x79, 0x76, 0x17, 0x6c, 0x0f, 0x6c, 0x8f, 0x6c,
+		0xbf, 0xd3, 0x9c, 0xfd, 0x4d, 0xe5, 0x64, 0xbb, 0x1b, 0xa6, 0x6d, 0x61, 0xd1, 0x9e, 0xbc, 0x09,
+		0x92, 0x0d, 0xc9, 0x5e, 0x9c, 0x64, 0x2f, 0x76, 0xdf, 0x7a, 0xf6, 0x96, 0x7b, 0xbe, 0x8d, 0xe7,
+		0xd4, 0xf5, 0x03, 0x37, 0xcd, 0x5d, 0xcd, 0xfd, 0xf9, 0xc7, 0x51, 0xd0, 0x1d, 0x05, 0xdd, 0xf3,
+		0x2c, 0x79, 0x91, 0x3a, 0x16, 0xc7, 0xf1, 0x97, 0xf7, 0x38, 0x7e, 0xc7, 0xf6, 0x13, 0x9c, 0xc7,
+		0x47, 0xa2, 0xac, 0xac, 0x68, 0x8b, 0x13, 0x0a, 0x53, 0xcf, 0xe3, 0xe3, 0xac, 0x2e, 0xa0, 0x86,
+		0x9c, 0x74, 0x22, 0xe6, 0xbc, 0xa7, 0xd1, 0x85, 0x9c, 0x74, 0xd1, 0x31, 0x90, 0x93, 0x8e, 0xb3,
+		0xba, 0x38, 0xab, 0x0b, 0x8b, 0x04, 0x8b, 0x44, 0x04, 0x8b, 0x04, 0x8b, 0x44, 0x04, 0x8b, 0xb4,
+		0x30, 0x8b, 0x84, 0xb3, 0xba, 0xb0, 0x42, 0xb0, 0x42, 0x44, 0xb0, 0x42, 0xb0, 0x42, 0x44, 0xb0,
+		0x42, 0x1a, 0xac, 0x50, 0x29, 0xcf, 0xea, 0x3e, 0xdb, 0xbd, 0x43, 0xc1, 0x67, 0xec, 0x30, 0x61,
+		0x87, 0x09, 0x3b, 0x4c, 0x2a, 0x22, 0xab, 0x2c, 0xba, 0xca, 0x22, 0xac, 0x2e, 0xca, 0x62, 0x46,
+		0x02, 0x3b, 0x4c, 0x44, 0x84, 0x1d, 0x26, 0x40, 0x0d, 0x9e, 0x14, 0x11, 0x3c, 0x29, 0x22, 0x22,
+		0x78, 0x52, 0x44, 0xf0, 0xa4, 0xb4, 0x79, 0x52, 0xd8, 0x61, 0x12, 0xd6, 0x6b, 0xb0, 0x48, 0xb0,
+		0x48, 0x44, 0xb0, 0x48, 0xb0, 0x48, 0x44, 0xb0, 0x48, 0x8b, 0xb0, 0x48, 0xd8, 0x61, 0x82, 0x15,
+		0x82, 0x15, 0x22, 0x82, 0x15, 0x82, 0x15, 0x22, 0x82, 0x15, 0xd2, 0x60, 0x85, 0x0c, 0xd8, 0x61,
+		0x32, 0xb8, 0x20, 0x6c, 0x9e, 0x83, 0x6e, 0x34, 0xb3, 0x26, 0xec, 0x65, 0xff, 0x6e, 0xc1, 0xb2,
+		0xb0, 0x95, 0x37, 0xde, 0x73, 0xde, 0xfb, 0xe5, 0x7d, 0x2f, 0x6b, 0xad, 0x92, 0xeb, 0x0d, 0xac,
+		0xca, 0xeb, 0x8f, 0xf6, 0x58, 0x99, 0x78, 0xb8, 0x59, 0x0f, 0x65, 0x79, 0xc9, 0x5e, 0xd8, 0x8d,
+		0x62, 0x37, 0x49, 0xdc, 0xf6, 0x45, 0xff, 0xc1, 0x5e, 0x18, 0x54, 0xcb, 0x4b, 0x3e, 0xdb, 0xdf,
+		0xdd, 0xf3, 0x30, 0x7c, 0x69, 0x6c, 0xa7, 0x5f, 0xc6, 0x5a, 0xab, 0xcc, 0x78, 0xd8, 0x7d, 0xf7,
+		0xde, 0x73, 0x86, 0x12, 0xf6, 0x58, 0x79, 0xfc, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00,
+		0x3b, 0x74, 0xbc, 0x87, 0xaf, 0x85, 0x1f, 0x00,
 	}
 )
 
@@ -5461,9 +11767,33 @@ var ΛEnumTypes = map[string][]reflect.Type{
 		reflect.TypeOf((E_OpenconfigPlatformTypes_OPENCONFIG_HARDWARE_COMPONENT)(0)),
 		reflect.TypeOf((E_OpenconfigPlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT)(0)),
 	},
+	"/interfaces/interface/aggregation/config/lag-type": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigIfAggregate_AggregationType)(0)),
+	},
+	"/interfaces/interface/aggregation/switched-vlan/config/interface-mode": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigVlan_VlanModeType)(0)),
+	},
+	"/interfaces/interface/config/tpid": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigVlanTypes_TPID_TYPES)(0)),
+	},
 	"/interfaces/interface/config/type": []reflect.Type{
 		reflect.TypeOf((E_IETFInterfaces_InterfaceType)(0)),
 	},
+	"/interfaces/interface/ethernet/config/duplex-mode": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigIfEthernet_Ethernet_DuplexMode)(0)),
+	},
+	"/interfaces/interface/ethernet/config/port-speed": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigIfEthernet_ETHERNET_SPEED)(0)),
+	},
+	"/interfaces/interface/ethernet/state/negotiated-duplex-mode": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode)(0)),
+	},
+	"/interfaces/interface/ethernet/state/negotiated-port-speed": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigIfEthernet_ETHERNET_SPEED)(0)),
+	},
+	"/interfaces/interface/ethernet/switched-vlan/config/interface-mode": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigVlan_VlanModeType)(0)),
+	},
 	"/interfaces/interface/state/admin-status": []reflect.Type{
 		reflect.TypeOf((E_OpenconfigInterfaces_Interface_AdminStatus)(0)),
 	},
@@ -5476,6 +11806,66 @@ var ΛEnumTypes = map[string][]reflect.Type{
 	"/interfaces/interface/subinterfaces/subinterface/state/oper-status": []reflect.Type{
 		reflect.TypeOf((E_OpenconfigInterfaces_Interface_OperStatus)(0)),
 	},
+	"/lacp/interfaces/interface/config/interval": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLacp_LacpPeriodType)(0)),
+	},
+	"/lacp/interfaces/interface/config/lacp-mode": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLacp_LacpActivityType)(0)),
+	},
+	"/lacp/interfaces/interface/members/member/state/activity": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLacp_LacpActivityType)(0)),
+	},
+	"/lacp/interfaces/interface/members/member/state/synchronization": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLacp_LacpSynchronizationType)(0)),
+	},
+	"/lacp/interfaces/interface/members/member/state/timeout": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLacp_LacpTimeoutType)(0)),
+	},
+	"/lldp/config/chassis-id-type": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLldp_ChassisIdType)(0)),
+	},
+	"/lldp/config/suppress-tlv-advertisement": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLldpTypes_LLDP_TLV)(0)),
+	},
+	"/lldp/interfaces/interface/neighbors/neighbor/capabilities/capability/state/name": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY)(0)),
+	},
+	"/lldp/interfaces/interface/neighbors/neighbor/state/chassis-id-type": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLldp_ChassisIdType)(0)),
+	},
+	"/lldp/interfaces/interface/neighbors/neighbor/state/port-id-type": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigLldp_PortIdType)(0)),
+	},
+	"/stp/global/config/enabled-protocol": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTreeTypes_STP_PROTOCOL)(0)),
+	},
+	"/stp/interfaces/interface/config/edge-port": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTreeTypes_STP_EDGE_PORT)(0)),
+	},
+	"/stp/interfaces/interface/config/guard": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTree_StpGuardType)(0)),
+	},
+	"/stp/interfaces/interface/config/link-type": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTree_StpLinkType)(0)),
+	},
+	"/stp/mstp/mst-instances/mst-instance/interfaces/interface/state/port-state": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTreeTypes_STP_PORT_STATE)(0)),
+	},
+	"/stp/mstp/mst-instances/mst-instance/interfaces/interface/state/role": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE)(0)),
+	},
+	"/stp/rapid-pvst/vlan/interfaces/interface/state/port-state": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTreeTypes_STP_PORT_STATE)(0)),
+	},
+	"/stp/rapid-pvst/vlan/interfaces/interface/state/role": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE)(0)),
+	},
+	"/stp/rstp/interfaces/interface/state/port-state": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTreeTypes_STP_PORT_STATE)(0)),
+	},
+	"/stp/rstp/interfaces/interface/state/role": []reflect.Type{
+		reflect.TypeOf((E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE)(0)),
+	},
 	"/system/aaa/accounting/config/accounting-method": []reflect.Type{
 		reflect.TypeOf((E_OpenconfigAaaTypes_AAA_METHOD_TYPE)(0)),
 	},
@@ -5536,6 +11926,12 @@ var ΛEnumTypes = map[string][]reflect.Type{
 	"/system/openflow/controllers/controller/connections/connection/config/transport": []reflect.Type{
 		reflect.TypeOf((E_OpenconfigOpenflow_Transport)(0)),
 	},
+	"/system/openflow/controllers/controller/connections/connection/ovs/state/role": []reflect.Type{
+		reflect.TypeOf((E_OvsSystem_Ovs_Role)(0)),
+	},
+	"/system/openflow/controllers/controller/connections/connection/ovs/state/state": []reflect.Type{
+		reflect.TypeOf((E_OvsSystem_Ovs_State)(0)),
+	},
 	"/system/ssh-server/config/protocol-version": []reflect.Type{
 		reflect.TypeOf((E_OpenconfigSystemTerminal_SshServer_ProtocolVersion)(0)),
 	},
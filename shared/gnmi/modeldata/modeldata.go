@@ -14,10 +14,23 @@ limitations under the License.
 */
 
 // Package modeldata contains the following model data in gnxi proto struct:
+//	openconfig-if-aggregate 2.3.1,
+//	openconfig-if-ethernet 2.6.1,
 //	openconfig-interfaces 2.0.0,
+//	openconfig-lacp 1.1.0,
+//	openconfig-lldp 0.2.0,
 //	openconfig-openflow 0.1.0,
 //	openconfig-platform 0.5.0,
-//	openconfig-system 0.2.0.
+//	openconfig-spanning-tree 0.2.0,
+//	openconfig-system 0.2.0,
+//	openconfig-vlan 3.0.1,
+//	ovs-bridges 0.1.0,
+//	ovs-flows 0.1.0,
+//	ovs-interfaces 0.1.0,
+//	ovs-mirrors 0.1.0,
+//	ovs-qos 0.1.0,
+//	ovs-stp 0.1.0,
+//	ovs-system 0.1.0.
 package modeldata
 
 import (
@@ -25,22 +38,64 @@ import (
 )
 
 const (
+	// OpenconfigIfAggregateModel is the openconfig YANG model for aggregate interfaces.
+	OpenconfigIfAggregateModel = "openconfig-if-aggregate"
+	// OpenconfigIfEthernetModel is the openconfig YANG model for ethernet interfaces.
+	OpenconfigIfEthernetModel = "openconfig-if-ethernet"
 	// OpenconfigInterfacesModel is the openconfig YANG model for interfaces.
 	OpenconfigInterfacesModel = "openconfig-interfaces"
+	// OpenconfigLacpModel is the openconfig YANG model for LACP.
+	OpenconfigLacpModel = "openconfig-lacp"
+	// OpenconfigLldpModel is the openconfig YANG model for LLDP.
+	OpenconfigLldpModel = "openconfig-lldp"
 	// OpenconfigOpenflowModel is the openconfig YANG model for openflow.
 	OpenconfigOpenflowModel = "openconfig-openflow"
 	// OpenconfigPlatformModel is the openconfig YANG model for platform.
 	OpenconfigPlatformModel = "openconfig-platform"
+	// OpenconfigSpanningTreeModel is the openconfig YANG model for spanning tree.
+	OpenconfigSpanningTreeModel = "openconfig-spanning-tree"
 	// OpenconfigSystemModel is the openconfig YANG model for system.
 	OpenconfigSystemModel = "openconfig-system"
+	// OpenconfigVlanModel is the openconfig YANG model for VLANs.
+	OpenconfigVlanModel = "openconfig-vlan"
+	// OvsBridgesModel is the YANG model for the flow export of Open vSwitch bridges.
+	OvsBridgesModel = "ovs-bridges"
+	// OvsFlowsModel is the YANG model augmenting Open vSwitch bridges with their OpenFlow flow tables.
+	OvsFlowsModel = "ovs-flows"
+	// OvsInterfacesModel is the YANG model augmenting interfaces with Open vSwitch specific data.
+	OvsInterfacesModel = "ovs-interfaces"
+	// OvsMirrorsModel is the YANG model for Open vSwitch port mirrors.
+	OvsMirrorsModel = "ovs-mirrors"
+	// OvsQosModel is the YANG model augmenting interfaces with Open vSwitch QoS.
+	OvsQosModel = "ovs-qos"
+	// OvsStpModel is the YANG model augmenting spanning tree with the Open vSwitch STP protocol.
+	OvsStpModel = "ovs-stp"
+	// OvsSystemModel is the YANG model augmenting system with Open vSwitch specific data.
+	OvsSystemModel = "ovs-system"
 )
 
 var (
 	// ModelData is a list of supported models.
 	ModelData = []*pb.ModelData{{
+		Name:         OpenconfigIfAggregateModel,
+		Organization: "OpenConfig working group",
+		Version:      "2.3.1",
+	}, {
+		Name:         OpenconfigIfEthernetModel,
+		Organization: "OpenConfig working group",
+		Version:      "2.6.1",
+	}, {
 		Name:         OpenconfigInterfacesModel,
 		Organization: "OpenConfig working group",
 		Version:      "2.0.0",
+	}, {
+		Name:         OpenconfigLacpModel,
+		Organization: "OpenConfig working group",
+		Version:      "1.1.0",
+	}, {
+		Name:         OpenconfigLldpModel,
+		Organization: "OpenConfig working group",
+		Version:      "0.2.0",
 	}, {
 		Name:         OpenconfigOpenflowModel,
 		Organization: "OpenConfig working group",
@@ -49,9 +104,45 @@ var (
 		Name:         OpenconfigPlatformModel,
 		Organization: "OpenConfig working group",
 		Version:      "0.5.0",
+	}, {
+		Name:         OpenconfigSpanningTreeModel,
+		Organization: "OpenConfig working group",
+		Version:      "0.2.0",
 	}, {
 		Name:         OpenconfigSystemModel,
 		Organization: "OpenConfig working group",
 		Version:      "0.2.0",
+	}, {
+		Name:         OpenconfigVlanModel,
+		Organization: "OpenConfig working group",
+		Version:      "3.0.1",
+	}, {
+		Name:         OvsBridgesModel,
+		Organization: "ovs-gnxi",
+		Version:      "0.1.0",
+	}, {
+		Name:         OvsFlowsModel,
+		Organization: "ovs-gnxi",
+		Version:      "0.1.0",
+	}, {
+		Name:         OvsInterfacesModel,
+		Organization: "ovs-gnxi",
+		Version:      "0.1.0",
+	}, {
+		Name:         OvsMirrorsModel,
+		Organization: "ovs-gnxi",
+		Version:      "0.1.0",
+	}, {
+		Name:         OvsQosModel,
+		Organization: "ovs-gnxi",
+		Version:      "0.1.0",
+	}, {
+		Name:         OvsStpModel,
+		Organization: "ovs-gnxi",
+		Version:      "0.1.0",
+	}, {
+		Name:         OvsSystemModel,
+		Organization: "ovs-gnxi",
+		Version:      "0.1.0",
 	}}
 )
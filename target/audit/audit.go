@@ -0,0 +1,232 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"os"
+	"ovs-gnxi/shared"
+	"ovs-gnxi/shared/logging"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	redactedValue = "<redacted>"
+	passwordLeaf  = "password"
+)
+
+var log = logging.New("ovs-gnxi")
+
+// Change is the value of a path before and after a Set. A nil value means that the path did not exist.
+type Change struct {
+	Path     string      `json:"path"`
+	OldValue interface{} `json:"old-value"`
+	NewValue interface{} `json:"new-value"`
+}
+
+// Record is a single audit log entry of a mutating RPC.
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	User      string    `json:"user,omitempty"`
+	Backend   string    `json:"backend,omitempty"`
+	Peer      string    `json:"peer,omitempty"`
+	RPC       string    `json:"rpc"`
+	Paths     []string  `json:"paths,omitempty"`
+	Changes   []*Change `json:"changes,omitempty"`
+	Result    string    `json:"result"`
+	Error     string    `json:"error,omitempty"`
+	Duration  float64   `json:"duration-ms"`
+}
+
+// NewRecord starts a record of the RPC for the identity and peer carried by ctx.
+func NewRecord(ctx context.Context, rpc string) *Record {
+	r := &Record{Timestamp: time.Now(), RPC: rpc}
+
+	if identity, ok := shared.IdentityFromContext(ctx); ok {
+		r.User = identity.Username
+		r.Backend = identity.Backend
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.Peer = p.Addr.String()
+	}
+
+	return r
+}
+
+// AddChange adds the old and new value of a path to the record. Password leaves are redacted.
+func (r *Record) AddChange(path string, oldValue, newValue interface{}) {
+	r.Changes = append(r.Changes, &Change{Path: path, OldValue: redact(path, oldValue), NewValue: redact(path, newValue)})
+}
+
+// Finish sets the result and duration of the record from the error returned by the RPC.
+func (r *Record) Finish(err error) {
+	r.Duration = float64(time.Since(r.Timestamp)) / float64(time.Millisecond)
+	r.Result = status.Code(err).String()
+
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+// redact replaces every value of a leaf whose name contains "password", so that neither cleartext nor hashed
+// passwords end up in the audit log.
+func redact(path string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	elems := strings.Split(path, "/")
+	if strings.Contains(elems[len(elems)-1], passwordLeaf) {
+		return redactedValue
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, child := range v {
+			m[k] = redact(k, child)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, child := range v {
+			l[i] = redact("", child)
+		}
+		return l
+	}
+
+	return value
+}
+
+// Logger writes records as JSON lines to a dedicated file. The file is rotated once it would exceed MaxSize bytes and
+// the MaxBackups most recent files are kept as <path>.1 to <path>.<MaxBackups>.
+type Logger struct {
+	Path       string
+	MaxSize    int64
+	MaxBackups int
+
+	file *os.File
+	size int64
+	mu   sync.Mutex
+}
+
+// NewLogger opens or creates the audit log file at path.
+func NewLogger(path string, maxSize int64, maxBackups int) (*Logger, error) {
+	l := &Logger{Path: path, MaxSize: maxSize, MaxBackups: maxBackups}
+
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l *Logger) open() error {
+	f, err := os.OpenFile(l.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to open audit log %v: %v", l.Path, err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("unable to stat audit log %v: %v", l.Path, err)
+	}
+
+	l.file = f
+	l.size = info.Size()
+
+	return nil
+}
+
+func (l *Logger) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+
+	for i := l.MaxBackups - 1; i > 0; i-- {
+		os.Rename(l.backupPath(i), l.backupPath(i+1))
+	}
+
+	if l.MaxBackups > 0 {
+		if err := os.Rename(l.Path, l.backupPath(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(l.Path); err != nil {
+		return err
+	}
+
+	return l.open()
+}
+
+func (l *Logger) backupPath(i int) string {
+	return fmt.Sprintf("%v.%d", l.Path, i)
+}
+
+// Files returns the paths of the current audit log file and its existing backups, newest first.
+func (l *Logger) Files() []string {
+	files := []string{l.Path}
+
+	for i := 1; i <= l.MaxBackups; i++ {
+		if _, err := os.Stat(l.backupPath(i)); err != nil {
+			break
+		}
+		files = append(files, l.backupPath(i))
+	}
+
+	return files
+}
+
+// Write appends the record to the audit log. Failures are logged, as an audit log failure must not change the outcome
+// of the RPC which has already been executed.
+func (l *Logger) Write(r *Record) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		log.Errorf("Unable to marshal audit record of %v: %v", r.RPC, err)
+		return
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.MaxSize > 0 && l.size > 0 && l.size+int64(len(data)) > l.MaxSize {
+		if err := l.rotate(); err != nil {
+			log.Errorf("Unable to rotate audit log %v: %v", l.Path, err)
+			return
+		}
+	}
+
+	n, err := l.file.Write(data)
+	l.size += int64(n)
+	if err != nil {
+		log.Errorf("Unable to write audit record of %v: %v", r.RPC, err)
+	}
+}
+
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.file.Close()
+}
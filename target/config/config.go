@@ -0,0 +1,285 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config contains the runtime settings of the gNXI target. Settings are read from an optional JSON config
+// file, command-line flags take precedence over it.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration which is written as string such as "30s" in the config file.
+type Duration time.Duration
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+func (d *Duration) Set(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(v)
+
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"30s\": %v", err)
+	}
+
+	return d.Set(s)
+}
+
+// OVSDB is the connection to ovsdb-server. Remote uses the ovs-vsctl syntax "unix:FILE", "tcp:HOST:PORT" or
+// "ssl:HOST:PORT", certificates of the active cert package are only used for ssl. PollInterval is how often the state
+// OVSDB has no columns for, such as the OpenFlow flows, is requested from ovs-vswitchd, 0 disables polling.
+type OVSDB struct {
+	Remote            string   `json:"remote"`
+	ReconnectMinDelay Duration `json:"reconnect-min-delay"`
+	ReconnectMaxDelay Duration `json:"reconnect-max-delay"`
+	PollInterval      Duration `json:"poll-interval"`
+}
+
+type Scripts struct {
+	Start   string `json:"start"`
+	Stop    string `json:"stop"`
+	Restart string `json:"restart"`
+}
+
+type GNXI struct {
+	Port string `json:"port"`
+}
+
+type Prometheus struct {
+	Address string `json:"address"`
+	Port    string `json:"port"`
+}
+
+type Credentials struct {
+	UsersFile          string   `json:"users-file"`
+	TokensFile         string   `json:"tokens-file"`
+	PolicyFile         string   `json:"policy-file"`
+	CertificateAuth    bool     `json:"certificate-auth"`
+	LockoutMaxFailures int      `json:"lockout-max-failures"`
+	LockoutBaseDelay   Duration `json:"lockout-base-delay"`
+	LockoutMaxDelay    Duration `json:"lockout-max-delay"`
+}
+
+type AuditLog struct {
+	File       string `json:"file"`
+	MaxSize    int64  `json:"max-size"`
+	MaxBackups int    `json:"max-backups"`
+}
+
+type Config struct {
+	OVSDB       OVSDB       `json:"ovsdb"`
+	Scripts     Scripts     `json:"scripts"`
+	GNXI        GNXI        `json:"gnxi"`
+	Prometheus  Prometheus  `json:"prometheus"`
+	CertRoot    string      `json:"cert-root"`
+	Credentials Credentials `json:"credentials"`
+	AuditLog    AuditLog    `json:"audit-log"`
+}
+
+// Default returns the settings the target has been using before they became configurable.
+func Default() *Config {
+	return &Config{
+		OVSDB: OVSDB{
+			Remote:            "ssl:target.gnxi.lan:6640",
+			ReconnectMinDelay: Duration(1 * time.Second),
+			ReconnectMaxDelay: Duration(1 * time.Minute),
+			PollInterval:      Duration(10 * time.Second),
+		},
+		Scripts: Scripts{
+			Start:   "start_ovs.sh",
+			Stop:    "stop_ovs.sh",
+			Restart: "restart_ovs.sh",
+		},
+		GNXI: GNXI{
+			Port: "10161",
+		},
+		Prometheus: Prometheus{
+			Address: "0.0.0.0",
+			Port:    "8080",
+		},
+		CertRoot: "certs",
+		Credentials: Credentials{
+			UsersFile:          "users.json",
+			PolicyFile:         "policy.json",
+			CertificateAuth:    false,
+			LockoutMaxFailures: 5,
+			LockoutBaseDelay:   Duration(30 * time.Second),
+			LockoutMaxDelay:    Duration(15 * time.Minute),
+		},
+		AuditLog: AuditLog{
+			File:       "audit.log",
+			MaxSize:    10 * 1024 * 1024,
+			MaxBackups: 5,
+		},
+	}
+}
+
+// flagSet binds the command-line flags to the settings of c, using the current settings as defaults.
+func (c *Config) flagSet(name string, configFile *string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+
+	fs.StringVar(configFile, "config", *configFile, "Path of a JSON config file, flags take precedence over it")
+	fs.StringVar(&c.OVSDB.Remote, "ovsdb_remote", c.OVSDB.Remote, "The ovsdb-server remote as unix:FILE, tcp:HOST:PORT or ssl:HOST:PORT")
+	fs.Var(&c.OVSDB.ReconnectMinDelay, "ovsdb_reconnect_min_delay", "The delay before the first reconnect to ovsdb-server")
+	fs.Var(&c.OVSDB.ReconnectMaxDelay, "ovsdb_reconnect_max_delay", "The maximum delay between reconnects to ovsdb-server")
+	fs.Var(&c.OVSDB.PollInterval, "ovsdb_poll_interval", "The interval at which flows and other state missing in OVSDB are polled, 0 disables polling")
+	fs.StringVar(&c.Scripts.Start, "ovs_start_script", c.Scripts.Start, "The script starting OVS")
+	fs.StringVar(&c.Scripts.Stop, "ovs_stop_script", c.Scripts.Stop, "The script stopping OVS")
+	fs.StringVar(&c.Scripts.Restart, "ovs_restart_script", c.Scripts.Restart, "The script restarting OVS")
+	fs.StringVar(&c.GNXI.Port, "gnxi_port", c.GNXI.Port, "The gNMI and gNOI port")
+	fs.StringVar(&c.Prometheus.Address, "prometheus_address", c.Prometheus.Address, "The Prometheus metrics address")
+	fs.StringVar(&c.Prometheus.Port, "prometheus_port", c.Prometheus.Port, "The Prometheus metrics port")
+	fs.StringVar(&c.CertRoot, "cert_root", c.CertRoot, "The directory of the certificate packages")
+	fs.StringVar(&c.Credentials.UsersFile, "users_file", c.Credentials.UsersFile, "The JSON file of the local users")
+	fs.StringVar(&c.Credentials.TokensFile, "tokens_file", c.Credentials.TokensFile, "The optional JSON file of the bearer tokens")
+	fs.StringVar(&c.Credentials.PolicyFile, "policy_file", c.Credentials.PolicyFile, "The JSON authorization policy file")
+	fs.BoolVar(&c.Credentials.CertificateAuth, "certificate_auth", c.Credentials.CertificateAuth, "Authenticate clients by the common name of their certificate")
+	fs.IntVar(&c.Credentials.LockoutMaxFailures, "lockout_max_failures", c.Credentials.LockoutMaxFailures, "The failed authentications before a user is locked, 0 disables the lockout")
+	fs.Var(&c.Credentials.LockoutBaseDelay, "lockout_base_delay", "The duration of the first lockout")
+	fs.Var(&c.Credentials.LockoutMaxDelay, "lockout_max_delay", "The maximum duration of a lockout")
+	fs.StringVar(&c.AuditLog.File, "audit_log_file", c.AuditLog.File, "The audit log file")
+	fs.Int64Var(&c.AuditLog.MaxSize, "audit_log_max_size", c.AuditLog.MaxSize, "The size in bytes at which the audit log is rotated")
+	fs.IntVar(&c.AuditLog.MaxBackups, "audit_log_max_backups", c.AuditLog.MaxBackups, "The number of rotated audit logs to keep")
+
+	return fs
+}
+
+// Load reads the settings from the config file given by the -config flag, if any, and the command-line flags. The
+// result is validated.
+func Load(name string, args []string) (*Config, error) {
+	var configFile string
+
+	c := Default()
+	if err := c.flagSet(name, &configFile).Parse(args); err != nil {
+		return nil, err
+	}
+
+	if configFile != "" {
+		data, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read config file %v: %v", configFile, err)
+		}
+
+		c = Default()
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(c); err != nil {
+			return nil, fmt.Errorf("unable to parse config file %v: %v", configFile, err)
+		}
+
+		// Parse the flags again on top of the config file, so that they take precedence.
+		if err := c.flagSet(name, &configFile).Parse(args); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.Validate(); err != nil {
+		if configFile != "" {
+			return nil, fmt.Errorf("invalid config file %v: %v", configFile, err)
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Validate checks the settings for values the target cannot start with.
+func (c *Config) Validate() error {
+	if !strings.HasPrefix(c.OVSDB.Remote, "unix:") && !strings.HasPrefix(c.OVSDB.Remote, "tcp:") && !strings.HasPrefix(c.OVSDB.Remote, "ssl:") {
+		return fmt.Errorf("ovsdb remote %q must start with unix:, tcp: or ssl:", c.OVSDB.Remote)
+	}
+
+	if c.OVSDB.ReconnectMinDelay <= 0 || c.OVSDB.ReconnectMaxDelay < c.OVSDB.ReconnectMinDelay {
+		return fmt.Errorf("ovsdb reconnect delays must be positive with the maximum not below the minimum")
+	}
+
+	if c.OVSDB.PollInterval < 0 {
+		return fmt.Errorf("ovsdb poll interval must not be negative")
+	}
+
+	if c.Scripts.Start == "" || c.Scripts.Stop == "" || c.Scripts.Restart == "" {
+		return fmt.Errorf("ovs scripts must not be empty")
+	}
+
+	if err := validatePort("gnxi", c.GNXI.Port); err != nil {
+		return err
+	}
+
+	if net.ParseIP(c.Prometheus.Address) == nil {
+		return fmt.Errorf("prometheus address %q is not an IP address", c.Prometheus.Address)
+	}
+
+	if err := validatePort("prometheus", c.Prometheus.Port); err != nil {
+		return err
+	}
+
+	if c.CertRoot == "" {
+		return fmt.Errorf("cert root must not be empty")
+	}
+
+	if c.Credentials.UsersFile == "" || c.Credentials.PolicyFile == "" {
+		return fmt.Errorf("users file and policy file must not be empty")
+	}
+
+	if c.Credentials.LockoutMaxFailures < 0 {
+		return fmt.Errorf("lockout max failures must not be negative")
+	}
+
+	if c.Credentials.LockoutMaxFailures > 0 && (c.Credentials.LockoutBaseDelay <= 0 || c.Credentials.LockoutMaxDelay < c.Credentials.LockoutBaseDelay) {
+		return fmt.Errorf("lockout delays must be positive with the maximum not below the base")
+	}
+
+	if c.AuditLog.File == "" {
+		return fmt.Errorf("audit log file must not be empty")
+	}
+
+	if c.AuditLog.MaxSize < 0 || c.AuditLog.MaxBackups < 0 {
+		return fmt.Errorf("audit log max size and max backups must not be negative")
+	}
+
+	return nil
+}
+
+func validatePort(name, port string) error {
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || p == 0 {
+		return fmt.Errorf("%v port %q is not a valid port", name, port)
+	}
+
+	return nil
+}
@@ -22,46 +22,91 @@ import (
 	"ovs-gnxi/shared/gnmi/modeldata"
 	"ovs-gnxi/shared/gnmi/modeldata/generated/ocstruct"
 	"ovs-gnxi/shared/logging"
+	"ovs-gnxi/target/audit"
 	"ovs-gnxi/target/cert"
+	"ovs-gnxi/target/config"
 	"ovs-gnxi/target/gnxi/service"
 	"ovs-gnxi/target/gnxi/service/gnmi"
 	"ovs-gnxi/target/ovs"
 	"reflect"
-)
-
-const (
-	certRootSystemPath = "certs"
-	adminUsername      = "admin"
-	adminPassword      = "testpassword"
+	"time"
 )
 
 var log = logging.New("ovs-gnxi")
 
 type Server struct {
+	Config            *config.Config
 	Auth              *shared.Authenticator
+	Authz             *shared.Authorizer
+	Users             *shared.LocalUserBackend
+	AuditLog          *audit.Logger
 	CertManager       *cert.Manager
 	SystemBroker      *ovs.SystemBroker
 	Service           *service.Service
 	certificateChange chan struct{}
 }
 
-// NewServer creates an instance of Server.
-func NewServer() (*Server, error) {
+// NewServer creates an instance of Server with the given settings.
+func NewServer(c *config.Config) (*Server, error) {
 	log.Info("Initializing gNXI Server...")
 
-	auth := shared.NewAuthenticator(adminUsername, adminPassword)
+	users, err := shared.NewLocalUserBackendFromFile(c.Credentials.UsersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := newAuthenticator(c.Credentials, users)
+	if err != nil {
+		return nil, err
+	}
 
-	certManager, err := cert.NewCertManager(certRootSystemPath)
+	policy, err := shared.NewPolicyFromFile(c.Credentials.PolicyFile)
 	if err != nil {
 		return nil, err
 	}
 
-	s := &Server{Auth: auth, CertManager: certManager}
-	s.SystemBroker = ovs.NewSystemBroker(s.Service, s.CertManager)
+	auditLog, err := audit.NewLogger(c.AuditLog.File, c.AuditLog.MaxSize, c.AuditLog.MaxBackups)
+	if err != nil {
+		return nil, err
+	}
+
+	certManager, err := cert.NewCertManager(c.CertRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{Config: c, Auth: auth, Authz: shared.NewAuthorizer(policy), Users: users, AuditLog: auditLog, CertManager: certManager}
+	s.SystemBroker = ovs.NewSystemBroker(s.Service, s.CertManager, s.Users, s.Authz, c.OVSDB, c.Scripts)
 
 	return s, nil
 }
 
+// newAuthenticator sets up the authentication backends. Bearer tokens and passwords are checked before the client
+// certificate identity, as every client presents a certificate during the mTLS handshake.
+func newAuthenticator(c config.Credentials, users *shared.LocalUserBackend) (*shared.Authenticator, error) {
+	auth := shared.NewAuthenticator()
+
+	if c.LockoutMaxFailures > 0 {
+		auth.SetLockout(shared.NewLockout(c.LockoutMaxFailures, time.Duration(c.LockoutBaseDelay), time.Duration(c.LockoutMaxDelay)))
+	}
+
+	if c.TokensFile != "" {
+		tokens, err := shared.NewTokenBackendFromFile(c.TokensFile)
+		if err != nil {
+			return nil, err
+		}
+		auth.AddBackend(tokens)
+	}
+
+	auth.AddBackend(users)
+
+	if c.CertificateAuth {
+		auth.AddBackend(shared.NewCertificateBackend())
+	}
+
+	return auth, nil
+}
+
 func (s *Server) InitializeService() {
 	s.Service = s.createService()
 	s.SystemBroker.GNXIService = s.Service
@@ -78,7 +123,7 @@ func (s *Server) createService() *service.Service {
 
 	log.Info("Start generating initial gNMI config from OVS system source...")
 
-	config, err := s.SystemBroker.GenerateConfig(s.SystemBroker.OVSClient.Config)
+	config, err := s.SystemBroker.GenerateConfig(s.SystemBroker.OVSClient.Config.Snapshot())
 	if err != nil {
 		log.Fatalf("Unable to generate gNMI Config: %v", err)
 	}
@@ -86,7 +131,7 @@ func (s *Server) createService() *service.Service {
 	log.Debugf("Using following initial config data: %s", config)
 
 	s.SystemBroker.OVSClient.Config.OverwriteCallback(s.SystemBroker.OVSConfigChangeCallback)
-	c, err := service.NewService(s.Auth, model, s.CertManager, []byte(config), s.SystemBroker.GNMIConfigSetupCallback, s.SystemBroker.GNMIConfigChangeCallback, s.SystemBroker.GNOIRebootCallback, s.SystemBroker.GNOIRotateCertificatesCallback)
+	c, err := service.NewService(s.Auth, s.Authz, s.AuditLog, s.Config.GNXI.Port, model, s.CertManager, []byte(config), s.SystemBroker.GNMIConfigSetupCallback, s.SystemBroker.GNMIConfigChangeCallback, s.SystemBroker.GNOIRebootCallback, s.SystemBroker.GNOIRotateCertificatesCallback)
 	if err != nil {
 		log.Fatalf("Error on creating gNMI service: %v", err)
 	}
@@ -0,0 +1,131 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"golang.org/x/net/context"
+	"ovs-gnxi/target/audit"
+	"reflect"
+	"strings"
+
+	"github.com/openconfig/ygot/experimental/ygotutils"
+	"github.com/openconfig/ygot/ygot"
+
+	pbg "github.com/openconfig/gnmi/proto/gnmi"
+	cpb "google.golang.org/genproto/googleapis/rpc/code"
+)
+
+const (
+	setRPC    = "/gnmi.gNMI/Set"
+	rebootRPC = "/gnoi.system.System/Reboot"
+	rotateRPC = "/gnoi.certificate.CertificateManagement/Rotate"
+
+	gnoiRPCPrefix = "/gnoi."
+)
+
+// writeAudit finishes the record with the outcome of the RPC and writes it to the audit log, if there is one.
+func (s *Service) writeAudit(record *audit.Record, err error) {
+	if s.auditLog == nil {
+		return
+	}
+
+	record.Finish(err)
+	s.auditLog.Write(record)
+}
+
+// auditDenied records Sets and gNOI RPCs rejected by the authorization interceptor, which never reach their handlers.
+func (s *Service) auditDenied(ctx context.Context, rpc string, paths []*pbg.Path, err error) {
+	if rpc != setRPC && !strings.HasPrefix(rpc, gnoiRPCPrefix) {
+		return
+	}
+
+	record := audit.NewRecord(ctx, rpc)
+	for _, path := range paths {
+		p, pathErr := ygot.PathToString(path)
+		if pathErr != nil {
+			p = fmt.Sprint(path)
+		}
+		record.Paths = append(record.Paths, p)
+	}
+
+	s.writeAudit(record, err)
+}
+
+// auditSet records the paths of a Set request and, if it succeeded, their values in the old and the current config.
+// It must be called with the config lock held.
+func (s *Service) auditSet(record *audit.Record, req *pbg.SetRequest, oldConfig ygot.ValidatedGoStruct, err error) {
+	if s.auditLog == nil {
+		return
+	}
+
+	var paths []*pbg.Path
+	for _, path := range req.GetDelete() {
+		paths = append(paths, gnmiFullPath(req.GetPrefix(), path))
+	}
+	for _, upd := range req.GetReplace() {
+		paths = append(paths, gnmiFullPath(req.GetPrefix(), upd.GetPath()))
+	}
+	for _, upd := range req.GetUpdate() {
+		paths = append(paths, gnmiFullPath(req.GetPrefix(), upd.GetPath()))
+	}
+
+	for _, path := range paths {
+		p, pathErr := ygot.PathToString(path)
+		if pathErr != nil {
+			p = fmt.Sprint(path)
+		}
+		record.Paths = append(record.Paths, p)
+
+		if err == nil {
+			record.AddChange(p, s.nodeValue(oldConfig, path), s.nodeValue(s.config, path))
+		}
+	}
+
+	s.writeAudit(record, err)
+}
+
+// nodeValue returns the IETF JSON tree or the scalar value of the node at path, or nil if there is no such node.
+func (s *Service) nodeValue(config ygot.ValidatedGoStruct, path *pbg.Path) interface{} {
+	if isNil(config) {
+		return nil
+	}
+
+	node, stat := ygotutils.GetNode(s.model.SchemaTreeRoot, config, path)
+	if isNil(node) || stat.GetCode() != int32(cpb.Code_OK) {
+		return nil
+	}
+
+	if nodeStruct, ok := node.(ygot.GoStruct); ok {
+		jsonTree, err := ygot.ConstructIETFJSON(nodeStruct, &ygot.RFC7951JSONConfig{})
+		if err != nil {
+			log.Errorf("Unable to construct IETF JSON tree of %v for audit log: %v", path, err)
+			return nil
+		}
+		return jsonTree
+	}
+
+	switch v := reflect.ValueOf(node); v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.Elem().Interface()
+	case reflect.Int64:
+		if enumMap, ok := s.model.EnumData[v.Type().Name()]; ok {
+			return enumMap[v.Int()].Name
+		}
+	}
+
+	return node
+}
@@ -0,0 +1,134 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"crypto/sha256"
+	"io"
+	"os"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbf "github.com/openconfig/gnoi/file"
+	pbt "github.com/openconfig/gnoi/types"
+)
+
+// fileChunkSize is the maximum size of a gNOI File Get message.
+const fileChunkSize = 64 * 1024
+
+// FileService exposes the audit log files over gNOI File. Only the audit log and its rotated backups are served, no
+// other file of the target is accessible. It is kept apart from Service, whose gNMI Get would clash with File Get.
+type FileService struct {
+	s *Service
+}
+
+func (f *FileService) isAuditFile(path string) bool {
+	if f.s.auditLog == nil {
+		return false
+	}
+
+	for _, file := range f.s.auditLog.Files() {
+		if file == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get streams an audit log file in chunks, followed by its SHA256 hash.
+func (f *FileService) Get(req *pbf.GetRequest, stream pbf.File_GetServer) error {
+	if !f.isAuditFile(req.GetRemoteFile()) {
+		return status.Errorf(codes.NotFound, "file %q is not available", req.GetRemoteFile())
+	}
+
+	file, err := os.Open(req.GetRemoteFile())
+	if err != nil {
+		return status.Errorf(codes.NotFound, "unable to open file %q: %v", req.GetRemoteFile(), err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	buf := make([]byte, fileChunkSize)
+
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			if err := stream.Send(&pbf.GetResponse{Response: &pbf.GetResponse_Contents{Contents: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "unable to read file %q: %v", req.GetRemoteFile(), err)
+		}
+	}
+
+	return stream.Send(&pbf.GetResponse{Response: &pbf.GetResponse_Hash{Hash: &pbt.HashType{
+		Method: pbt.HashType_SHA256,
+		Hash:   hash.Sum(nil),
+	}}})
+}
+
+// Stat returns the audit log files. An empty path or the path of the current audit log file lists all of them.
+func (f *FileService) Stat(ctx context.Context, req *pbf.StatRequest) (*pbf.StatResponse, error) {
+	if f.s.auditLog == nil {
+		return nil, status.Error(codes.NotFound, "audit log is not enabled")
+	}
+
+	files := f.s.auditLog.Files()
+	if req.GetPath() != "" && req.GetPath() != f.s.auditLog.Path {
+		if !f.isAuditFile(req.GetPath()) {
+			return nil, status.Errorf(codes.NotFound, "file %q is not available", req.GetPath())
+		}
+		files = []string{req.GetPath()}
+	}
+
+	resp := &pbf.StatResponse{}
+	for _, path := range files {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		resp.Stats = append(resp.Stats, &pbf.StatInfo{
+			Path:         path,
+			LastModified: uint64(info.ModTime().UnixNano()),
+			Permissions:  uint32(info.Mode().Perm()),
+			Size:         uint64(info.Size()),
+		})
+	}
+
+	return resp, nil
+}
+
+func (f *FileService) TransferToRemote(ctx context.Context, req *pbf.TransferToRemoteRequest) (*pbf.TransferToRemoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "TransferToRemote is not implemented.")
+}
+
+func (f *FileService) Put(stream pbf.File_PutServer) error {
+	return status.Error(codes.Unimplemented, "Put is not implemented.")
+}
+
+func (f *FileService) Remove(ctx context.Context, req *pbf.RemoveRequest) (*pbf.RemoveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "Remove is not implemented.")
+}
@@ -31,6 +31,7 @@ import (
 	"net"
 	"ovs-gnxi/shared"
 	"ovs-gnxi/shared/logging"
+	"ovs-gnxi/target/audit"
 	"ovs-gnxi/target/cert"
 	"reflect"
 	"strconv"
@@ -49,6 +50,7 @@ import (
 
 	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	pbg "github.com/openconfig/gnmi/proto/gnmi"
+	pbf "github.com/openconfig/gnoi/file"
 	cpb "google.golang.org/genproto/googleapis/rpc/code"
 	pbc "ovs-gnxi/shared/gnoi/modeldata/generated/cert"
 	pbs "ovs-gnxi/shared/gnoi/modeldata/generated/system"
@@ -60,7 +62,6 @@ var (
 	pbRootPath         = &pbg.Path{}
 	supportedEncodings = []pbg.Encoding{pbg.Encoding_JSON, pbg.Encoding_JSON_IETF}
 	gnxiProtocol       = "tcp"
-	gnxiPort           = "10161"
 )
 
 type ConfigSetupCallback func(ygot.ValidatedGoStruct) error
@@ -82,8 +83,11 @@ type CallbackHandler struct {
 type Service struct {
 	g            *grpc.Server
 	socket       net.Listener
+	port         string
 	certManager  *cert.Manager
 	auth         *shared.Authenticator
+	authz        *shared.Authorizer
+	auditLog     *audit.Logger
 	model        *gnmi.Model
 	config       ygot.ValidatedGoStruct
 	ch           *CallbackHandler
@@ -93,8 +97,8 @@ type Service struct {
 	timeout time.Duration
 }
 
-// NewService creates an instance of Service with given json config.
-func NewService(auth *shared.Authenticator, model *gnmi.Model, certManager *cert.Manager, config []byte,
+// NewService creates an instance of Service with given json config, which listens on the given port.
+func NewService(auth *shared.Authenticator, authz *shared.Authorizer, auditLog *audit.Logger, port string, model *gnmi.Model, certManager *cert.Manager, config []byte,
 	callbackSetup ConfigSetupCallback, callbackChange ConfigChangeCallback, callbackReboot RebootCallback, callbackRotateCerts RotateCertificatesCallback) (*Service, error) {
 	rootStruct, err := model.NewConfigStruct(config)
 
@@ -104,6 +108,9 @@ func NewService(auth *shared.Authenticator, model *gnmi.Model, certManager *cert
 	s := &Service{
 		certManager:  certManager,
 		auth:         auth,
+		authz:        authz,
+		auditLog:     auditLog,
+		port:         port,
 		model:        model,
 		config:       rootStruct,
 		ConfigUpdate: make(chan bool),
@@ -115,6 +122,10 @@ func NewService(auth *shared.Authenticator, model *gnmi.Model, certManager *cert
 		},
 	}
 
+	if authz != nil {
+		authz.OverwriteDeniedCallback(s.auditDenied)
+	}
+
 	if config != nil && s.ch.CallbackSetup != nil {
 		if err := s.ch.CallbackSetup(rootStruct); err != nil {
 			return nil, err
@@ -214,10 +225,10 @@ func (s *Service) doDelete(jsonTree map[string]interface{}, prefix, path *pbg.Pa
 		}
 		if s.ch.CallbackChange != nil {
 			if applyErr := s.ch.CallbackChange(newConfig); applyErr != nil {
-				if rollbackErr := s.ch.CallbackChange(s.config); rollbackErr != nil {
+				if rollbackErr := s.ch.CallbackChange(s.config); rollbackErr != nil && status.Code(rollbackErr) != codes.Unavailable {
 					return nil, status.Errorf(codes.Internal, "error in rollback the failed operation (%v): %v", applyErr, rollbackErr)
 				}
-				return nil, status.Errorf(codes.Aborted, "error in applying operation to device: %v", applyErr)
+				return nil, status.Errorf(applyErrorCode(applyErr), "error in applying operation to device: %v", applyErr)
 			}
 		}
 	}
@@ -311,10 +322,10 @@ func (s *Service) doReplaceOrUpdate(jsonTree map[string]interface{}, op pbg.Upda
 	// Apply the validated operation to the device.
 	if s.ch.CallbackChange != nil {
 		if applyErr := s.ch.CallbackChange(newConfig); applyErr != nil {
-			if rollbackErr := s.ch.CallbackChange(s.config); rollbackErr != nil {
+			if rollbackErr := s.ch.CallbackChange(s.config); rollbackErr != nil && status.Code(rollbackErr) != codes.Unavailable {
 				return nil, status.Errorf(codes.Internal, "error in rollback the failed operation (%v): %v", applyErr, rollbackErr)
 			}
-			return nil, status.Errorf(codes.Aborted, "error in applying operation to device: %v", applyErr)
+			return nil, status.Errorf(applyErrorCode(applyErr), "error in applying operation to device: %v", applyErr)
 		}
 	}
 	return &pbg.UpdateResult{
@@ -485,13 +496,6 @@ func setPathWithoutAttribute(op pbg.UpdateResult_Operation, curNode map[string]i
 
 // Capabilities returns supported encodings and supported models.
 func (s *Service) Capabilities(ctx context.Context, req *pbg.CapabilityRequest) (*pbg.CapabilityResponse, error) {
-	authorized, err := s.auth.AuthorizeUser(ctx)
-	if !authorized {
-		log.Infof("denied a Capabilities request: %v", err)
-		return nil, status.Error(codes.PermissionDenied, fmt.Sprint(err))
-	}
-	log.Infof("allowed a Capabilities request")
-
 	ver, err := getGNMIServiceVersion()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error in getting gnxi service version: %v", err)
@@ -508,15 +512,8 @@ func (s *Service) Capabilities(ctx context.Context, req *pbg.CapabilityRequest)
 	return resp, nil
 }
 
-// Get implements the Get RPC in gNMI spec and provides user auth.
+// Get implements the Get RPC in gNMI spec.
 func (s *Service) Get(ctx context.Context, req *pbg.GetRequest) (*pbg.GetResponse, error) {
-	authorized, err := s.auth.AuthorizeUser(ctx)
-	if !authorized {
-		log.Infof("denied a Get request: %v", err)
-		return nil, status.Error(codes.PermissionDenied, fmt.Sprint(err))
-	}
-	log.Infof("allowed a Get request")
-
 	if req.GetType() != pbg.GetRequest_ALL {
 		return nil, status.Errorf(codes.Unimplemented, "unsupported request type: %s", pbg.GetRequest_DataType_name[int32(req.GetType())])
 	}
@@ -631,18 +628,16 @@ func (s *Service) Get(ctx context.Context, req *pbg.GetRequest) (*pbg.GetRespons
 	return resp, nil
 }
 
-// Set implements the Set RPC in gNMI spec and provides user auth.
-func (s *Service) Set(ctx context.Context, req *pbg.SetRequest) (*pbg.SetResponse, error) {
-	authorized, err := s.auth.AuthorizeUser(ctx)
-	if !authorized {
-		log.Infof("denied a Set request: %v", err)
-		return nil, status.Error(codes.PermissionDenied, fmt.Sprint(err))
-	}
-	log.Infof("allowed a Set request")
+// Set implements the Set RPC in gNMI spec.
+func (s *Service) Set(ctx context.Context, req *pbg.SetRequest) (resp *pbg.SetResponse, err error) {
+	record := audit.NewRecord(ctx, setRPC)
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	oldConfig := s.config
+	defer func() { s.auditSet(record, req, oldConfig, err) }()
+
 	jsonTree, err := ygot.ConstructIETFJSON(s.config, &ygot.RFC7951JSONConfig{})
 	if err != nil {
 		msg := fmt.Sprintf("error in constructing IETF JSON tree from config struct: %v", err)
@@ -688,16 +683,26 @@ func (s *Service) Set(ctx context.Context, req *pbg.SetRequest) (*pbg.SetRespons
 	}
 	s.config = rootStruct
 
-	resp := &pbg.SetResponse{
+	resp = &pbg.SetResponse{
 		Prefix:   req.GetPrefix(),
 		Response: results,
 	}
 
-	log.Infof("Send Set response to client: %v", resp)
+	log.Debugf("Send Set response to client: %v", resp)
 
 	return resp, nil
 }
 
+// applyErrorCode returns the status code of a change the device failed to apply. Changes are Unavailable while the
+// device cannot be reached, which also keeps them from being rolled back there, and Aborted otherwise.
+func applyErrorCode(err error) codes.Code {
+	if status.Code(err) == codes.Unavailable {
+		return codes.Unavailable
+	}
+
+	return codes.Aborted
+}
+
 // Overwrites the internal gNMI config.
 func (s *Service) OverwriteConfig(jsonConfig []byte) {
 	s.mu.Lock()
@@ -712,15 +717,9 @@ func (s *Service) OverwriteConfig(jsonConfig []byte) {
 }
 
 func (s *Service) Subscribe(stream pbg.GNMI_SubscribeServer) error {
-	authorized, err := s.auth.AuthorizeUser(stream.Context())
-	if !authorized {
-		log.Infof("denied a Subscribe request: %v", err)
-		return status.Error(codes.PermissionDenied, fmt.Sprint(err))
-	}
-
 	req, err := stream.Recv()
 
-	log.Infof("allowed Subscribe request: %v", req)
+	log.Infof("received a Subscribe request: %v", req)
 
 	switch {
 	case err == io.EOF:
@@ -954,42 +953,89 @@ func (s *Service) subscribeStream(stream pbg.GNMI_SubscribeServer, req *pbg.Subs
 	log.Infof("serving subscribe STREAM")
 
 	respChan := make(chan *pbg.SubscribeResponse)
+	sampled, interval, others := splitSampled(req)
+
+	var samples <-chan time.Time
+	if sampled != nil {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		samples = ticker.C
+	}
+
+	var updates <-chan bool
+	if others != nil {
+		updates = s.ConfigUpdate
+	}
 
 	for {
+		var r *pbg.SubscribeRequest
 		select {
-		default:
-			<-s.ConfigUpdate
-			go s.processSubscribe(req, respChan, errChan)
+		case <-samples:
+			r = sampled
+		case <-updates:
+			r = others
+		}
+		go s.processSubscribe(r, respChan, errChan)
 
-			resp := <-respChan
-			log.Infof("Send Subscribe STREAM response to client: %v", resp)
+		resp := <-respChan
+		log.Infof("Send Subscribe STREAM response to client: %v", resp)
 
-			err := stream.Send(resp)
-			if err != nil {
-				errChan <- status.Error(codes.Unimplemented, err.Error())
-				return
-			}
+		err := stream.Send(resp)
+		if err != nil {
+			errChan <- status.Error(codes.Unimplemented, err.Error())
+			return
 		}
 	}
 }
 
-func (s *Service) Reboot(ctx context.Context, req *pbs.RebootRequest) (*pbs.RebootResponse, error) {
-	authorized, err := s.auth.AuthorizeUser(ctx)
-	if !authorized {
-		log.Infof("denied a Reboot request: %v", err)
-		return nil, status.Error(codes.PermissionDenied, fmt.Sprint(err))
+// splitSampled splits the subscriptions of a request into the SAMPLE subscriptions with a sample interval, which are
+// sent at the shortest interval among them, and the others, which are sent whenever the config changes and cover
+// ON_CHANGE and TARGET_DEFINED subscriptions. A part without subscriptions is nil, unless the request has none at all.
+func splitSampled(req *pbg.SubscribeRequest) (sampled *pbg.SubscribeRequest, interval time.Duration, others *pbg.SubscribeRequest) {
+	var samples, rest []*pbg.Subscription
+	for _, sub := range req.GetSubscribe().GetSubscription() {
+		if sub.GetMode() != pbg.SubscriptionMode_SAMPLE || sub.GetSampleInterval() == 0 {
+			rest = append(rest, sub)
+			continue
+		}
+
+		samples = append(samples, sub)
+		if i := time.Duration(sub.GetSampleInterval()); interval == 0 || i < interval {
+			interval = i
+		}
+	}
+
+	if len(samples) > 0 {
+		sampled = withSubscriptions(req, samples)
 	}
-	log.Infof("allowed a Reboot request")
+	if len(rest) > 0 || len(samples) == 0 {
+		others = withSubscriptions(req, rest)
+	}
+
+	return sampled, interval, others
+}
+
+// withSubscriptions returns a copy of a subscribe request with only the given subscriptions.
+func withSubscriptions(req *pbg.SubscribeRequest, subs []*pbg.Subscription) *pbg.SubscribeRequest {
+	list := *req.GetSubscribe()
+	list.Subscription = subs
+
+	return &pbg.SubscribeRequest{Request: &pbg.SubscribeRequest_Subscribe{Subscribe: &list}, Extension: req.GetExtension()}
+}
+
+func (s *Service) Reboot(ctx context.Context, req *pbs.RebootRequest) (*pbs.RebootResponse, error) {
+	record := audit.NewRecord(ctx, rebootRPC)
 
 	s.mu.Lock()
 
-	go s.ch.CallbackReboot()
+	// The restart waits for the service lock, so its outcome is audited once it has finished.
+	go func() { s.writeAudit(record, s.ch.CallbackReboot()) }()
 
 	defer s.mu.Unlock()
 
 	resp := &pbs.RebootResponse{}
 
-	log.Infof("Send Reboot response to client: %v", resp)
+	log.Debugf("Send Reboot response to client: %v", resp)
 
 	return resp, nil
 }
@@ -1022,16 +1068,13 @@ func (s *Service) SwitchControlProcessor(ctx context.Context, req *pbs.SwitchCon
 	return nil, status.Error(codes.Unimplemented, "SwitchControlProcessor is not implemented.")
 }
 
-func (s *Service) Rotate(stream pbc.CertificateManagement_RotateServer) error {
-	authorized, err := s.auth.AuthorizeUser(stream.Context())
-	if !authorized {
-		log.Infof("denied a Rotate request: %v", err)
-		return status.Error(codes.PermissionDenied, fmt.Sprint(err))
-	}
+func (s *Service) Rotate(stream pbc.CertificateManagement_RotateServer) (err error) {
+	record := audit.NewRecord(stream.Context(), rotateRPC)
+	defer func() { s.writeAudit(record, err) }()
 
 	req, err := stream.Recv()
 
-	log.Infof("allowed a Rotate request: %v", req)
+	log.Debug("received a Rotate request")
 
 	switch {
 	case err == io.EOF:
@@ -1134,13 +1177,6 @@ func (s *Service) Rotate(stream pbc.CertificateManagement_RotateServer) error {
 }
 
 func (s *Service) GetCertificates(ctx context.Context, req *pbc.GetCertificatesRequest) (*pbc.GetCertificatesResponse, error) {
-	authorized, err := s.auth.AuthorizeUser(ctx)
-	if !authorized {
-		log.Infof("denied a GetCertificates request: %v", err)
-		return nil, status.Error(codes.PermissionDenied, fmt.Sprint(err))
-	}
-	log.Infof("allowed a GetCertificates request")
-
 	resp := &pbc.GetCertificatesResponse{
 		CertificateInfo: s.certManager.GetActivePackage().CertInfo,
 	}
@@ -1166,7 +1202,11 @@ func (s *Service) prepareService() {
 	tlsConfig := &tls.Config{}
 	tlsConfig.GetConfigForClient = s.GetTLSConfigForClientCallback
 
-	opts := []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}
+	opts := []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(chainUnaryInterceptors(s.auth.UnaryServerInterceptor, s.authz.UnaryServerInterceptor)),
+		grpc.StreamInterceptor(chainStreamInterceptors(s.auth.StreamServerInterceptor, s.authz.StreamServerInterceptor)),
+	}
 
 	s.g = grpc.NewServer(opts...)
 
@@ -1177,6 +1217,7 @@ func (s *Service) registerService() {
 	pbg.RegisterGNMIServer(s.g, s)
 	pbs.RegisterSystemServer(s.g, s)
 	pbc.RegisterCertificateManagementServer(s.g, s)
+	pbf.RegisterFileServer(s.g, &FileService{s: s})
 	reflection.Register(s.g)
 }
 
@@ -1188,7 +1229,7 @@ func (s *Service) StartService() {
 	var err error
 
 	log.Infof("Starting to listen")
-	s.socket, err = net.Listen(gnxiProtocol, fmt.Sprintf(":%s", gnxiPort))
+	s.socket, err = net.Listen(gnxiProtocol, fmt.Sprintf(":%s", s.port))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
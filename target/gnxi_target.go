@@ -16,12 +16,15 @@ limitations under the License.
 package main
 
 import (
+	"flag"
 	"fmt"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"net/http"
 	"os"
+	"ovs-gnxi/shared"
 	"ovs-gnxi/shared/logging"
+	"ovs-gnxi/target/config"
 	"ovs-gnxi/target/gnxi"
 	"ovs-gnxi/target/watchdog"
 )
@@ -34,7 +37,16 @@ func main() {
 
 	log.Info("Starting Open vSwitch gNXI interface\n")
 
-	prometheusInstance, err := NewPrometheusMonitoringInstance("0.0.0.0", "8080")
+	c, err := config.Load(os.Args[0], os.Args[1:])
+	if err == flag.ErrHelp {
+		return
+	}
+	if err != nil {
+		log.Errorf("Unable to load configuration: %v", err)
+		os.Exit(1)
+	}
+
+	prometheusInstance, err := NewPrometheusMonitoringInstance(c.Prometheus.Address, c.Prometheus.Port)
 	if err != nil {
 		log.Errorf("Unable to configure Prometheus Monitoring: %v", err)
 		os.Exit(1)
@@ -42,7 +54,7 @@ func main() {
 
 	go RunPrometheus(prometheusInstance)
 
-	gNXIServer, err := gnxi.NewServer()
+	gNXIServer, err := gnxi.NewServer(c)
 	if err != nil {
 		log.Errorf("Unable to create gNXI Server: %v", err)
 		os.Exit(1)
@@ -75,6 +87,7 @@ func (p *PrometheusMonitoringInstance) InitializeMetrics() {
 
 func (p *PrometheusMonitoringInstance) RegisterMetrics() {
 	prometheus.MustRegister(p.ErrorsGaugeMetric)
+	prometheus.MustRegister(shared.FailedAuthenticationsMetric)
 }
 
 func (p *PrometheusMonitoringInstance) StartPrometheus() {
@@ -0,0 +1,177 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ygot/ygot"
+	"ovs-gnxi/shared"
+	oc "ovs-gnxi/shared/gnmi/modeldata/generated/ocstruct"
+)
+
+const (
+	adminUsername = "admin"
+	adminRole     = "admin"
+)
+
+// generateAAAConfig publishes the local users under /system/aaa/authentication. The admin user is published both as
+// list entry and as admin-user. Password hashes are not published, so that they cannot be read back by anyone.
+func (s *SystemBroker) generateAAAConfig(system *oc.System) error {
+	if s.users == nil {
+		return nil
+	}
+
+	auth := &oc.System_Aaa_Authentication{}
+	system.Aaa = &oc.System_Aaa{Authentication: auth}
+
+	for _, u := range s.users.Users() {
+		user, err := auth.NewUser(u.Username)
+		if err != nil {
+			return err
+		}
+
+		user.Role = roleToUnion(u.Role)
+
+		if u.Username == adminUsername {
+			auth.AdminUser = &oc.System_Aaa_Authentication_AdminUser{
+				AdminUsername: ygot.String(u.Username),
+			}
+		}
+	}
+
+	return nil
+}
+
+// aaaUsers returns the local users of /system/aaa/authentication, nil if the device has no authentication config.
+// Users without password-hashed keep their password, which is never published. Cleartext passwords are rejected, as
+// they would otherwise be kept in the gNMI config tree.
+func (s *SystemBroker) aaaUsers(device *oc.Device) ([]shared.LocalUser, error) {
+	if s.users == nil || device.System == nil || device.System.Aaa == nil || device.System.Aaa.Authentication == nil {
+		return nil, nil
+	}
+
+	auth := device.System.Aaa.Authentication
+	prev := s.users.Users()
+	users := make(map[string]shared.LocalUser)
+
+	for name, u := range auth.User {
+		if u.Password != nil {
+			return nil, fmt.Errorf("cleartext password of user %q is not accepted, set password-hashed instead", name)
+		}
+
+		hash := passwordHashOf(prev, name)
+		if u.PasswordHashed != nil {
+			hash = *u.PasswordHashed
+		}
+		if hash == "" {
+			return nil, fmt.Errorf("user %q has no password-hashed", name)
+		}
+
+		role := unionToRole(u.Role)
+		if role != "" && s.authz != nil && !s.authz.HasRole(role) {
+			return nil, fmt.Errorf("user %q has unknown role %q", name, role)
+		}
+
+		users[name] = shared.LocalUser{Username: name, PasswordHash: hash, Role: role}
+	}
+
+	if admin := auth.AdminUser; admin != nil {
+		if admin.AdminPassword != nil {
+			return nil, fmt.Errorf("cleartext admin-password is not accepted, set admin-password-hashed instead")
+		}
+
+		// The admin-user mirrors the admin list entry, so it only takes precedence if its hash has been changed itself.
+		if admin.AdminPasswordHashed != nil && *admin.AdminPasswordHashed != passwordHashOf(prev, adminUsername) {
+			users[adminUsername] = shared.LocalUser{Username: adminUsername, PasswordHash: *admin.AdminPasswordHashed, Role: adminRole}
+		}
+	}
+
+	if len(users) == 0 {
+		return nil, fmt.Errorf("refusing to remove all local users")
+	}
+
+	var list []shared.LocalUser
+	for _, u := range users {
+		list = append(list, u)
+	}
+
+	return list, nil
+}
+
+// syncAAAConfig replaces the local users with users, as returned by aaaUsers, and persists them. If they cannot be
+// persisted, the previous users are restored. It reports whether the users have been changed.
+func (s *SystemBroker) syncAAAConfig(users []shared.LocalUser) (bool, error) {
+	if users == nil {
+		return false, nil
+	}
+
+	prev := s.users.Users()
+	if err := s.users.ReplaceUsers(users); err != nil {
+		return false, err
+	}
+
+	if cmp.Equal(prev, s.users.Users()) {
+		return false, nil
+	}
+
+	log.Info("Local users have been changed by gNMI target, persisting them")
+
+	if err := s.users.Save(); err != nil {
+		if restoreErr := s.users.ReplaceUsers(prev); restoreErr != nil {
+			log.Errorf("Unable to restore the previous local users: %v", restoreErr)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func passwordHashOf(users []shared.LocalUser, username string) string {
+	for _, u := range users {
+		if u.Username == username {
+			return u.PasswordHash
+		}
+	}
+
+	return ""
+}
+
+func roleToUnion(role string) oc.System_Aaa_Authentication_User_Role_Union {
+	switch role {
+	case "":
+		return nil
+	case adminRole:
+		return &oc.System_Aaa_Authentication_User_Role_Union_E_OpenconfigAaaTypes_SYSTEM_DEFINED_ROLES{
+			E_OpenconfigAaaTypes_SYSTEM_DEFINED_ROLES: oc.OpenconfigAaaTypes_SYSTEM_DEFINED_ROLES_SYSTEM_ROLE_ADMIN,
+		}
+	default:
+		return &oc.System_Aaa_Authentication_User_Role_Union_String{String: role}
+	}
+}
+
+func unionToRole(union oc.System_Aaa_Authentication_User_Role_Union) string {
+	switch r := union.(type) {
+	case *oc.System_Aaa_Authentication_User_Role_Union_E_OpenconfigAaaTypes_SYSTEM_DEFINED_ROLES:
+		if r.E_OpenconfigAaaTypes_SYSTEM_DEFINED_ROLES == oc.OpenconfigAaaTypes_SYSTEM_DEFINED_ROLES_SYSTEM_ROLE_ADMIN {
+			return adminRole
+		}
+	case *oc.System_Aaa_Authentication_User_Role_Union_String:
+		return r.String
+	}
+
+	return ""
+}
@@ -0,0 +1,253 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	FailureModeSecure     = "SECURE"
+	FailureModeStandalone = "STANDALONE"
+)
+
+// OpenFlowAgent is the OpenFlow agent configuration as modelled by OpenConfig. OVS keeps the failure mode and datapath
+// ID in the bridge the controllers are attached to and the timers, in milliseconds, in every controller. Nil timers
+// are unset.
+type OpenFlowAgent struct {
+	DatapathID      string
+	FailureMode     string
+	MaxBackoff      *uint32
+	InactivityProbe *uint32
+}
+
+func (a *OpenFlowAgent) String() string {
+	return fmt.Sprintf("OpenFlowAgent(DatapathID: \"%v\", FailureMode: \"%v\", MaxBackoff: \"%v\", InactivityProbe: \"%v\")", a.DatapathID, a.FailureMode, seconds(a.MaxBackoff), seconds(a.InactivityProbe))
+}
+
+// AgentBridge returns the first bridge by name which has controllers, or the only bridge if there is no controller.
+func (c *ObjectCache) AgentBridge() *Bridge {
+	var agent *Bridge
+	for _, controller := range c.Controllers {
+		if b, ok := c.Bridges[controller.Bridge]; ok && (agent == nil || b.Name < agent.Name) {
+			agent = b
+		}
+	}
+	if agent != nil {
+		return agent
+	}
+
+	if len(c.Bridges) == 1 {
+		for _, b := range c.Bridges {
+			return b
+		}
+	}
+
+	return nil
+}
+
+// OpenFlowAgent returns the agent configuration of the agent bridge and the controllers, or nil if there is neither.
+// The datapath ID is the one in use, which is only configured if other_config:datapath-id is set. A bridge without
+// fail_mode is in standalone mode. Timers which differ between the controllers are unset.
+func (c *ObjectCache) OpenFlowAgent() *OpenFlowAgent {
+	b := c.AgentBridge()
+	if b == nil && len(c.Controllers) == 0 {
+		return nil
+	}
+
+	a := &OpenFlowAgent{}
+	if b != nil {
+		id := b.DatapathID
+		if b.ConfiguredDatapathID != "" {
+			id = b.ConfiguredDatapathID
+		}
+		if formatted, err := FormatDatapathID(id); err == nil {
+			a.DatapathID = formatted
+		}
+
+		a.FailureMode = FailureModeStandalone
+		if b.FailMode == "secure" {
+			a.FailureMode = FailureModeSecure
+		}
+	}
+
+	a.MaxBackoff = toSeconds(c.controllerTimer(func(controller *OpenFlowController) *uint32 { return controller.MaxBackoff }))
+	a.InactivityProbe = toSeconds(c.controllerTimer(func(controller *OpenFlowController) *uint32 { return controller.InactivityProbe }))
+
+	return a
+}
+
+// controllerTimer returns the timer all controllers share, or nil if it is unset or differs between them.
+func (c *ObjectCache) controllerTimer(timer func(controller *OpenFlowController) *uint32) *uint32 {
+	var common *uint32
+	first := true
+	for _, controller := range c.Controllers {
+		t := timer(controller)
+		if first {
+			common, first = t, false
+		} else if !equalUint32(common, t) {
+			return nil
+		}
+	}
+
+	return common
+}
+
+// SetOpenFlowAgent applies the values of the agent which differ from the current configuration to the agent bridge
+// and all controllers, so that unchanged values such as a datapath ID chosen by OVS are not pinned. Unset values
+// restore the OVS default, timers which differ between the controllers are kept until they are set.
+func (c *ObjectCache) SetOpenFlowAgent(a *OpenFlowAgent) error {
+	current := c.OpenFlowAgent()
+	if current == nil {
+		return fmt.Errorf("unable to configure the OpenFlow agent without a bridge or controller")
+	}
+
+	if b := c.AgentBridge(); b != nil {
+		if a.DatapathID != current.DatapathID {
+			id, err := ParseDatapathID(a.DatapathID)
+			if err != nil {
+				return err
+			}
+			b.ConfiguredDatapathID = id
+		}
+
+		if a.FailureMode != current.FailureMode {
+			b.FailMode = strings.ToLower(a.FailureMode)
+		}
+	}
+
+	maxBackoff, err := toMilliseconds(a.MaxBackoff)
+	if err != nil {
+		return fmt.Errorf("invalid max-backoff: %v", err)
+	}
+
+	inactivityProbe, err := toMilliseconds(a.InactivityProbe)
+	if err != nil {
+		return fmt.Errorf("invalid inactivity-probe: %v", err)
+	}
+
+	for _, controller := range c.Controllers {
+		if !equalUint32(a.MaxBackoff, current.MaxBackoff) {
+			controller.MaxBackoff = maxBackoff
+		}
+
+		if !equalUint32(a.InactivityProbe, current.InactivityProbe) {
+			controller.InactivityProbe = inactivityProbe
+		}
+	}
+
+	return nil
+}
+
+// FormatDatapathID formats a datapath ID of 16 hex digits as used by OVS in the colon separated notation of
+// OpenConfig.
+func FormatDatapathID(id string) (string, error) {
+	id = strings.ToLower(strings.TrimPrefix(id, "0x"))
+	if len(id) != 16 {
+		return "", fmt.Errorf("invalid datapath ID %q", id)
+	}
+	if _, err := strconv.ParseUint(id, 16, 64); err != nil {
+		return "", fmt.Errorf("invalid datapath ID %q", id)
+	}
+
+	octets := make([]string, 0, 8)
+	for i := 0; i < len(id); i += 2 {
+		octets = append(octets, id[i:i+2])
+	}
+
+	return strings.Join(octets, ":"), nil
+}
+
+// ParseDatapathID converts a datapath ID in the notation of OpenConfig into the 16 hex digits of OVS. An empty ID
+// stays empty.
+func ParseDatapathID(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+
+	id := strings.TrimPrefix(strings.ToLower(strings.Replace(s, ":", "", -1)), "0x")
+	if _, err := FormatDatapathID(id); err != nil {
+		return "", fmt.Errorf("invalid datapath ID %q", s)
+	}
+
+	return id, nil
+}
+
+// parseOpenFlowAgent reads the agent config of the IETF JSON tree.
+func parseOpenFlowAgent(config map[string]interface{}) *OpenFlowAgent {
+	a := &OpenFlowAgent{}
+
+	if id, ok := config["datapath-id"].(string); ok {
+		a.DatapathID = id
+	}
+
+	if mode, ok := config["failure-mode"].(string); ok {
+		a.FailureMode = mode
+	}
+
+	if maxBackoff, ok := config["max-backoff"].(uint32); ok {
+		a.MaxBackoff = &maxBackoff
+	}
+
+	if inactivityProbe, ok := config["inactivity-probe"].(uint32); ok {
+		a.InactivityProbe = &inactivityProbe
+	}
+
+	return a
+}
+
+func toSeconds(ms *uint32) *uint32 {
+	if ms == nil {
+		return nil
+	}
+
+	s := *ms / 1000
+	return &s
+}
+
+// toMilliseconds converts seconds into the milliseconds of the controller columns, which hold at most 32 bits.
+func toMilliseconds(s *uint32) (*uint32, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	ms := uint64(*s) * 1000
+	if ms > math.MaxUint32 {
+		return nil, fmt.Errorf("%v seconds exceed the maximum of %v seconds", *s, math.MaxUint32/1000)
+	}
+
+	v := uint32(ms)
+	return &v, nil
+}
+
+func seconds(s *uint32) string {
+	if s == nil {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(*s), 10)
+}
+
+func equalUint32(a, b *uint32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
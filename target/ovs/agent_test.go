@@ -0,0 +1,57 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"testing"
+)
+
+func TestParseDatapathID(t *testing.T) {
+	tests := []struct {
+		desc    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{desc: "empty", in: "", want: ""},
+		{desc: "colon separated", in: "00:00:00:00:00:00:00:01", want: "0000000000000001"},
+		{desc: "upper case", in: "00:00:5E:C2:CA:F5:11:81", want: "00005ec2caf51181"},
+		{desc: "plain hex digits", in: "00005ec2caf51181", want: "00005ec2caf51181"},
+		{desc: "hex prefix", in: "0x00005ec2caf51181", want: "00005ec2caf51181"},
+		{desc: "too short", in: "00:00:00:00:00:01", wantErr: true},
+		{desc: "too long", in: "00:00:00:00:00:00:00:00:01", wantErr: true},
+		{desc: "not hex", in: "00:00:00:00:00:00:00:zz", wantErr: true},
+		{desc: "sign", in: "+000000000000001", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDatapathID(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%v: ParseDatapathID(%q) = %q, want error", tt.desc, tt.in, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%v: ParseDatapathID(%q) returned error: %v", tt.desc, tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%v: ParseDatapathID(%q) = %q, want %q", tt.desc, tt.in, got, tt.want)
+		}
+	}
+}
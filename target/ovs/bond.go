@@ -0,0 +1,372 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"github.com/openconfig/ygot/ygot"
+	oc "ovs-gnxi/shared/gnmi/modeldata/generated/ocstruct"
+	"sort"
+	"strings"
+)
+
+const (
+	LAGTypeLACP   = "LACP"
+	LAGTypeStatic = "STATIC"
+)
+
+// Bond is the bond configuration of a port with several interfaces, which is published as an aggregate interface.
+// LACP is the lacp column, active, passive or off, the LACP keys are kept in other_config. Empty values and a zero
+// system priority are unset.
+type Bond struct {
+	Mode               string
+	LACP               string
+	LACPTime           string
+	LACPSystemID       string
+	LACPSystemPriority uint16
+	Updelay            uint32
+	Downdelay          uint32
+}
+
+func (b *Bond) String() string {
+	return fmt.Sprintf("Bond(Mode: \"%v\", LACP: \"%v\", LACPTime: \"%v\", LACPSystemID: \"%v\", LACPSystemPriority: \"%v\", Updelay: \"%v\", Downdelay: \"%v\")",
+		b.Mode, b.LACP, b.LACPTime, b.LACPSystemID, b.LACPSystemPriority, b.Updelay, b.Downdelay)
+}
+
+// LAGType returns the OpenConfig aggregation type of the bond, which is static unless LACP is active or passive.
+func (b *Bond) LAGType() string {
+	if b.LACP == "active" || b.LACP == "passive" {
+		return LAGTypeLACP
+	}
+
+	return LAGTypeStatic
+}
+
+// IsBond reports whether the port is a bond, which OVS makes of every port with more than one interface.
+func (p *Port) IsBond() bool {
+	return len(p.Interfaces) > 1
+}
+
+// equalBond reports whether two ports have the same bond configuration and members.
+func (p *Port) equalBond(comp *Port) bool {
+	return p.Bond == comp.Bond && equalStrings(p.Interfaces, comp.Interfaces)
+}
+
+// InterfaceName returns the name of the interface with the given uuid, or an empty string if it is unknown.
+func (c *ObjectCache) InterfaceName(uuid string) string {
+	if i := c.interfaceByUUID(uuid); i != nil {
+		return i.Name
+	}
+
+	return ""
+}
+
+func (c *ObjectCache) interfaceByUUID(uuid string) *Interface {
+	for _, i := range c.Interfaces {
+		if i.uuid == uuid {
+			return i
+		}
+	}
+
+	return nil
+}
+
+// BondMembers returns the sorted names of the member interfaces of a bond.
+func (c *ObjectCache) BondMembers(p *Port) []string {
+	var members []string
+	for _, uuid := range p.Interfaces {
+		if name := c.InterfaceName(uuid); name != "" {
+			members = append(members, name)
+		}
+	}
+	sort.Strings(members)
+
+	return members
+}
+
+// ActiveMember returns the name of the member interface whose MAC address is in bond_active_slave, or an empty string
+// if there is none.
+func (c *ObjectCache) ActiveMember(p *Port) string {
+	if p.BondActiveSlave == "" {
+		return ""
+	}
+
+	for _, uuid := range p.Interfaces {
+		if i := c.interfaceByUUID(uuid); i != nil && strings.EqualFold(i.MAC, p.BondActiveSlave) {
+			return i.Name
+		}
+	}
+
+	return ""
+}
+
+// generateBondConfig publishes every bond as an aggregate interface named after its port, along with the LACP state of
+// its members under /lacp. Bonds whose name is taken by an interface cannot be published.
+func (s *SystemBroker) generateBondConfig(d *oc.Device, cache *ObjectCache) error {
+	for _, p := range cache.Ports {
+		if !p.IsBond() {
+			continue
+		}
+
+		if _, ok := cache.Interfaces[p.Name]; ok {
+			log.Errorf("Unable to publish bond %v, its name is taken by an interface", p.Name)
+			continue
+		}
+
+		o, err := d.NewInterface(p.Name)
+		if err != nil {
+			return err
+		}
+		o.Type = oc.IETFInterfaces_InterfaceType_ieee8023adLag
+		o.Logical = ygot.Bool(true)
+		o.Aggregation = &oc.Interface_Aggregation{
+			LagType: oc.OpenconfigIfAggregate_AggregationType_STATIC,
+			Member:  cache.BondMembers(p),
+		}
+
+		o.OperStatus = oc.OpenconfigInterfaces_Interface_OperStatus_DOWN
+		for _, name := range o.Aggregation.Member {
+			if cache.Interfaces[name].LinkStatus == "up" {
+				o.OperStatus = oc.OpenconfigInterfaces_Interface_OperStatus_UP
+			}
+		}
+
+		o.Ovs = &oc.Interface_Ovs{Bond: &oc.Interface_Ovs_Bond{
+			Updelay:   ygot.Uint32(p.Bond.Updelay),
+			Downdelay: ygot.Uint32(p.Bond.Downdelay),
+		}}
+		if p.Bond.Mode != "" {
+			o.Ovs.Bond.Mode = ygot.String(p.Bond.Mode)
+		}
+		if active := cache.ActiveMember(p); active != "" {
+			o.Ovs.Bond.ActiveMember = ygot.String(active)
+		}
+
+		if p.Bond.LAGType() != LAGTypeLACP {
+			continue
+		}
+		o.Aggregation.LagType = oc.OpenconfigIfAggregate_AggregationType_LACP
+
+		if d.Lacp == nil {
+			d.Lacp = &oc.Lacp{}
+		}
+
+		l, err := d.Lacp.NewInterface(p.Name)
+		if err != nil {
+			return err
+		}
+
+		activity := oc.OpenconfigLacp_LacpActivityType_ACTIVE
+		if p.Bond.LACP == "passive" {
+			activity = oc.OpenconfigLacp_LacpActivityType_PASSIVE
+		}
+		l.LacpMode = activity
+
+		timeout := oc.OpenconfigLacp_LacpTimeoutType_LONG
+		switch p.Bond.LACPTime {
+		case "fast":
+			l.Interval = oc.OpenconfigLacp_LacpPeriodType_FAST
+			timeout = oc.OpenconfigLacp_LacpTimeoutType_SHORT
+		case "slow":
+			l.Interval = oc.OpenconfigLacp_LacpPeriodType_SLOW
+		}
+
+		if p.Bond.LACPSystemID != "" {
+			l.SystemIdMac = ygot.String(p.Bond.LACPSystemID)
+		}
+		if p.Bond.LACPSystemPriority != 0 {
+			l.SystemPriority = ygot.Uint16(p.Bond.LACPSystemPriority)
+		}
+
+		for _, name := range o.Aggregation.Member {
+			m, err := l.NewMember(name)
+			if err != nil {
+				return err
+			}
+			m.Activity, m.Timeout = activity, timeout
+
+			if current := cache.Interfaces[name].LACPCurrent; current != nil {
+				m.Synchronization = oc.OpenconfigLacp_LacpSynchronizationType_OUT_SYNC
+				if *current {
+					m.Synchronization = oc.OpenconfigLacp_LacpSynchronizationType_IN_SYNC
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// aggregationConfig returns the aggregation config of an interface of the IETF JSON tree, nil if it is no aggregate.
+func aggregationConfig(entry map[string]interface{}) map[string]interface{} {
+	aggregation, _ := entry["openconfig-if-aggregate:aggregation"].(map[string]interface{})
+	config, _ := aggregation["config"].(map[string]interface{})
+
+	return config
+}
+
+// overwriteBondsWithJSON replaces the bonds of the cache with the aggregate interfaces of the IETF JSON tree and the
+// interfaces whose aggregate-id refers to them. Bonds missing in the tree are split into a port per member, members
+// joining a bond leave their own port. Aggregates which cannot be read are left as they are.
+func overwriteBondsWithJSON(cache *ObjectCache, jsonConfig map[string]interface{}) {
+	interfaces, _ := jsonConfig["openconfig-interfaces:interfaces"].(map[string]interface{})
+	list, _ := interfaces["interface"].([]interface{})
+
+	lacp, _ := jsonConfig["openconfig-lacp:lacp"].(map[string]interface{})
+	lacpInterfaces, _ := lacp["interfaces"].(map[string]interface{})
+	lacpList, _ := lacpInterfaces["interface"].([]interface{})
+
+	lacpConfigs := make(map[string]map[string]interface{})
+	for _, i := range lacpList {
+		config, _ := i.(map[string]interface{})["config"].(map[string]interface{})
+		if name, ok := config["name"].(string); ok {
+			lacpConfigs[name] = config
+		}
+	}
+
+	aggregates := make(map[string]map[string]interface{})
+	members := make(map[string][]string)
+	for _, i := range list {
+		entry, _ := i.(map[string]interface{})
+		config, _ := entry["config"].(map[string]interface{})
+		name, _ := config["name"].(string)
+
+		if aggregationConfig(entry) != nil {
+			aggregates[name] = entry
+			continue
+		}
+
+		// The aggregate-id augment of the ethernet config is a config container of its own in the IETF JSON tree.
+		ethernet, _ := entry["openconfig-if-ethernet:ethernet"].(map[string]interface{})
+		aggregateConfig, _ := ethernet["openconfig-if-aggregate:config"].(map[string]interface{})
+		if id, ok := aggregateConfig["aggregate-id"].(string); ok {
+			members[id] = append(members[id], name)
+		}
+	}
+
+	for name, p := range cache.Ports {
+		if p.IsBond() && aggregates[name] == nil {
+			cache.releaseMembers(p, nil)
+			delete(cache.Ports, name)
+		}
+	}
+
+	for name, entry := range aggregates {
+		if err := cache.setBond(name, entry, lacpConfigs[name], members[name]); err != nil {
+			log.Errorf("Unable to configure aggregate interface %v: %v", name, err)
+		}
+	}
+}
+
+// setBond sets the configuration and members of the bond of the aggregate interface name, which is added to the cache
+// without a uuid if it is new.
+func (c *ObjectCache) setBond(name string, entry, lacpConfig map[string]interface{}, memberNames []string) error {
+	p, ok := c.Ports[name]
+	if !ok {
+		p = &Port{Name: name}
+	} else if !p.IsBond() {
+		return fmt.Errorf("port %v is no bond", name)
+	}
+
+	var uuids []string
+	for _, member := range memberNames {
+		i, ok := c.Interfaces[member]
+		if !ok || i.uuid == "" {
+			return fmt.Errorf("member %v is no existing interface", member)
+		}
+
+		if other := c.PortOfInterface(i); other != nil && other != p && other.IsBond() {
+			return fmt.Errorf("member %v belongs to aggregate interface %v", member, other.Name)
+		}
+
+		uuids = append(uuids, i.uuid)
+	}
+
+	if len(uuids) < 2 {
+		return fmt.Errorf("an aggregate interface requires at least two members")
+	}
+
+	bond, err := parseBond(entry, lacpConfig, p.Bond)
+	if err != nil {
+		return err
+	}
+
+	for _, uuid := range uuids {
+		if other := c.PortOfInterface(c.interfaceByUUID(uuid)); other != nil && other != p {
+			delete(c.Ports, other.Name)
+		}
+	}
+	c.releaseMembers(p, uuids)
+
+	sort.Strings(uuids)
+	p.Interfaces, p.Bond = uuids, bond
+	c.Ports[name] = p
+
+	return nil
+}
+
+// releaseMembers gives every member of a bond which is not in keep a port of its own, as OVS requires every interface
+// of a bridge to be part of a port.
+func (c *ObjectCache) releaseMembers(p *Port, keep []string) {
+	kept := make(map[string]bool)
+	for _, uuid := range keep {
+		kept[uuid] = true
+	}
+
+	for _, uuid := range p.Interfaces {
+		if i := c.interfaceByUUID(uuid); i != nil && !kept[uuid] {
+			c.Ports[i.Name] = &Port{Name: i.Name, Interfaces: []string{uuid}}
+		}
+	}
+}
+
+// parseBond reads the bond of an aggregate interface and its LACP config of the IETF JSON tree. The LACP keys of a
+// static bond are not published, so they are kept from prev, as is a lacp column which is unset rather than off.
+func parseBond(entry, lacpConfig map[string]interface{}, prev Bond) (Bond, error) {
+	ovs, _ := entry["ovs-interfaces:ovs"].(map[string]interface{})
+	bond, _ := ovs["bond"].(map[string]interface{})
+	bondConfig, _ := bond["config"].(map[string]interface{})
+
+	b := Bond{}
+	b.Mode, _ = bondConfig["mode"].(string)
+	b.Updelay, _ = bondConfig["updelay"].(uint32)
+	b.Downdelay, _ = bondConfig["downdelay"].(uint32)
+
+	switch lagType, _ := aggregationConfig(entry)["lag-type"].(string); lagType {
+	case LAGTypeStatic:
+		b.LACP = "off"
+		if prev.LAGType() == LAGTypeStatic {
+			b.LACP = prev.LACP
+		}
+		b.LACPTime, b.LACPSystemID, b.LACPSystemPriority = prev.LACPTime, prev.LACPSystemID, prev.LACPSystemPriority
+	case LAGTypeLACP:
+		b.LACP = "active"
+		if mode, ok := lacpConfig["lacp-mode"].(string); ok {
+			b.LACP = strings.ToLower(mode)
+		}
+
+		if interval, ok := lacpConfig["interval"].(string); ok {
+			b.LACPTime = strings.ToLower(interval)
+		}
+
+		b.LACPSystemID, _ = lacpConfig["system-id-mac"].(string)
+		b.LACPSystemPriority, _ = lacpConfig["system-priority"].(uint16)
+	default:
+		return Bond{}, fmt.Errorf("unsupported aggregation type %v", lagType)
+	}
+
+	return b, nil
+}
@@ -18,26 +18,58 @@ limitations under the License.
 package ovs
 
 import (
+	"crypto/tls"
 	"fmt"
+	"github.com/cenkalti/rpc2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/socketplane/libovsdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net"
 	"os/exec"
 	"ovs-gnxi/shared/logging"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
-	DefaultDatabase = "Open_vSwitch"
-	SystemTable     = "Open_vSwitch"
-	ControllerTable = "Controller"
-	InterfaceTable  = "Interface"
-	StartOVS        = "start_ovs.sh"
-	StopOVS         = "stop_ovs.sh"
-	RestartOVS      = "restart_ovs.sh"
+	DefaultDatabase   = "Open_vSwitch"
+	SystemTable       = "Open_vSwitch"
+	ControllerTable   = "Controller"
+	InterfaceTable    = "Interface"
+	PortTable         = "Port"
+	BridgeTable       = "Bridge"
+	MirrorTable       = "Mirror"
+	SFlowTable        = "sFlow"
+	NetFlowTable      = "NetFlow"
+	IPFIXTable        = "IPFIX"
+	QoSTable          = "QoS"
+	QueueTable        = "Queue"
+	StartOVS          = "start_ovs.sh"
+	StopOVS           = "stop_ovs.sh"
+	RestartOVS        = "restart_ovs.sh"
+	ReconnectMinDelay = 1 * time.Second
+	ReconnectMaxDelay = 1 * time.Minute
 )
 
 var log = logging.New("ovs-gnxi")
 
-var update chan *libovsdb.TableUpdates
+// ErrNotConnected is returned when a change cannot be applied because the connection to ovsdb-server is down. It is
+// an Unavailable status, so that gNMI clients know to retry.
+var ErrNotConnected = status.Error(codes.Unavailable, "not connected to ovsdb-server")
+
+// ConnectionState describes the connection to ovsdb-server. ReconnectAttempts and LastError are reset once the
+// connection has been established again.
+type ConnectionState struct {
+	Connected         bool
+	ReconnectAttempts uint32
+	LastError         string
+}
+
+type ConnectionStateCallback func(state ConnectionState)
 
 type Client struct {
 	Address    string
@@ -48,157 +80,1321 @@ type Client struct {
 	Notifier   *Notifier
 	Config     *Config
 	ErrorChan  chan error
-	QuitChan   chan bool
+
+	// StartScript, StopScript and RestartScript are the shell scripts controlling the OVS system.
+	StartScript   string
+	StopScript    string
+	RestartScript string
+
+	// Executor runs the OVS utilities for changes which are not possible through OVSDB.
+	Executor Executor
+
+	// ReconnectMinDelay is the delay after the first failed connection attempt, which doubles with every further
+	// failure up to ReconnectMaxDelay.
+	ReconnectMinDelay time.Duration
+	ReconnectMaxDelay time.Duration
+
+	privateKeyPath string
+	publicKeyPath  string
+	caPath         string
+	state          ConnectionState
+	stateCallback  ConnectionStateCallback
+	stopped        bool
+	stop           chan struct{}
+	updates        chan *libovsdb.TableUpdates
+	mu             sync.RWMutex
 }
 
 func (o *Client) String() string {
 	return fmt.Sprintf("OVSClient(Address: \"%v\", Protocol: \"%v\", Port: \"%v\")", o.Address, o.Protocol, o.Port)
 }
 
-func NewClient(address, protocol, port string) (*Client, error) {
-	o := Client{Address: address, Protocol: protocol, Port: port, Database: DefaultDatabase, ErrorChan: make(chan error), Config: NewConfig()}
+// NewClient creates a client for an ovsdb-server remote in ovs-vsctl syntax, such as "ssl:target.gnxi.lan:6640" or
+// "unix:/var/run/openvswitch/db.sock".
+func NewClient(remote string) (*Client, error) {
+	r, err := ParseRemote(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	o := Client{Address: r.Address, Protocol: r.Protocol, Port: r.Port, Database: DefaultDatabase, ErrorChan: make(chan error, 1), Config: NewConfig(),
+		StartScript: StartOVS, StopScript: StopOVS, RestartScript: RestartOVS, Executor: LocalExecutor{Timeout: ExecutorTimeout}, ReconnectMinDelay: ReconnectMinDelay, ReconnectMaxDelay: ReconnectMaxDelay}
 	return &o, nil
 }
 
-func (o *Client) StartClient(privateKeyPath, publicKeyPath, caPath string) {
-	log.Info("Start OVS Client")
+// Remote returns the ovsdb-server the client connects to in ovs-vsctl remote syntax.
+func (o *Client) Remote() string {
+	return (&Remote{Protocol: o.Protocol, Address: o.Address, Port: o.Port}).String()
+}
+
+// StartClient connects to ovsdb-server and monitors its database. Failed connection attempts and lost connections
+// are retried with exponential backoff until the client is stopped. The key and certificate paths are only used for
+// ssl remotes.
+func (o *Client) StartClient(privateKeyPath, publicKeyPath, caPath string) {
+	log.Info("Start OVS Client")
+
+	o.mu.Lock()
+	o.privateKeyPath, o.publicKeyPath, o.caPath = privateKeyPath, publicKeyPath, caPath
+	o.stopped = false
+	o.stop = make(chan struct{})
+	o.updates = make(chan *libovsdb.TableUpdates)
+	o.Notifier = &Notifier{client: o}
+	o.mu.Unlock()
+
+	go o.receivedMonitorUpdate(o.stop, o.updates)
+
+	o.connectWithBackoff()
+	o.Config.DumpRawCache()
+}
+
+// StopMonitoring stops processing updates and prevents reconnects, so that ovsdb-server can be restarted on purpose.
+func (o *Client) StopMonitoring() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.stopped {
+		return
+	}
+
+	o.stopped = true
+	close(o.stop)
+}
+
+func (o *Client) StopClient() {
+	log.Info("Stop OVS Client")
+	o.StopMonitoring()
+
+	o.mu.Lock()
+	conn := o.Connection
+	o.state.Connected = false
+	o.mu.Unlock()
+
+	if conn != nil {
+		conn.Disconnect()
+	}
+	o.Config = NewConfig()
+}
+
+// ConnectionState returns the current state of the connection to ovsdb-server.
+func (o *Client) ConnectionState() ConnectionState {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return o.state
+}
+
+// IsConnected reports whether the client is connected to ovsdb-server.
+func (o *Client) IsConnected() bool {
+	return o.ConnectionState().Connected
+}
+
+func (o *Client) OverwriteStateCallback(callback ConnectionStateCallback) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.stateCallback = callback
+}
+
+func (o *Client) updateState(update func(state *ConnectionState)) {
+	o.mu.Lock()
+	update(&o.state)
+	state, callback := o.state, o.stateCallback
+	o.mu.Unlock()
+
+	if callback != nil {
+		callback(state)
+	}
+}
+
+func (o *Client) isStopped() bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return o.stopped
+}
+
+// reportError passes err on to ErrorChan without blocking if nobody is reading it.
+func (o *Client) reportError(err error) {
+	select {
+	case o.ErrorChan <- err:
+	default:
+	}
+}
+
+func (o *Client) connect() error {
+	o.mu.RLock()
+	privateKeyPath, publicKeyPath, caPath := o.privateKeyPath, o.publicKeyPath, o.caPath
+	o.mu.RUnlock()
+
+	var conn *libovsdb.OvsdbClient
+	var err error
+
+	switch o.Protocol {
+	case RemoteUnix:
+		conn, err = libovsdb.ConnectWithUnixSocket(o.Address)
+	case RemoteTCP:
+		conn, err = libovsdb.ConnectUsingProtocol("tcp", net.JoinHostPort(o.Address, o.Port))
+	case RemoteSSL:
+		// libovsdb exits the process on unreadable certificates, so they are checked here first.
+		if err := checkTLSFiles(privateKeyPath, publicKeyPath, caPath); err != nil {
+			return err
+		}
+		conn, err = libovsdb.ConnectUsingProtocolWithTLS("tcp", net.JoinHostPort(o.Address, o.Port), privateKeyPath, publicKeyPath, caPath)
+	default:
+		return fmt.Errorf("unsupported ovsdb protocol %q", o.Protocol)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := o.MonitorAll(conn); err != nil {
+		conn.Disconnect()
+		return err
+	}
+
+	return nil
+}
+
+func checkTLSFiles(privateKeyPath, publicKeyPath, caPath string) error {
+	if _, err := ioutil.ReadFile(caPath); err != nil {
+		return fmt.Errorf("unable to read CA certificate: %v", err)
+	}
+
+	if _, err := tls.LoadX509KeyPair(publicKeyPath, privateKeyPath); err != nil {
+		return fmt.Errorf("unable to load certificate and key: %v", err)
+	}
+
+	return nil
+}
+
+// connectWithBackoff connects to ovsdb-server, doubling the delay between attempts up to ReconnectMaxDelay.
+func (o *Client) connectWithBackoff() {
+	delay := o.ReconnectMinDelay
+
+	for {
+		o.mu.RLock()
+		stopped, stop := o.stopped, o.stop
+		o.mu.RUnlock()
+
+		if stopped {
+			return
+		}
+
+		err := o.connect()
+		if err == nil {
+			log.Infof("Connected to OVSDB %v", o.Remote())
+			o.updateState(func(state *ConnectionState) {
+				*state = ConnectionState{Connected: true}
+			})
+			return
+		}
+
+		o.reportError(err)
+		o.updateState(func(state *ConnectionState) {
+			state.ReconnectAttempts++
+			state.LastError = err.Error()
+		})
+		log.Warningf("Unable to connect to OVSDB %v, retrying in %v: %v", o.Remote(), delay, err)
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > o.ReconnectMaxDelay {
+			delay = o.ReconnectMaxDelay
+		}
+	}
+}
+
+// handleDisconnect reconnects after the monitored connection has been lost, unless the client has been stopped.
+func (o *Client) handleDisconnect(conn *libovsdb.OvsdbClient) {
+	o.mu.RLock()
+	current := !o.stopped && o.state.Connected && conn == o.Connection
+	o.mu.RUnlock()
+
+	if !current {
+		return
+	}
+
+	log.Warningf("Lost connection to OVSDB %v, reconnecting", o.Remote())
+	o.reportError(ErrNotConnected)
+	o.updateState(func(state *ConnectionState) {
+		state.Connected = false
+	})
+
+	o.connectWithBackoff()
+}
+
+// transact runs the operations as a single transaction and returns the first error reported by ovsdb-server. While
+// the client reconnects, or if the connection is lost during the transaction, ErrNotConnected is returned.
+func (o *Client) transact(operations ...libovsdb.Operation) error {
+	o.mu.RLock()
+	conn, connected := o.Connection, o.state.Connected
+	o.mu.RUnlock()
+
+	if conn == nil || !connected {
+		return ErrNotConnected
+	}
+
+	log.Debug(operations)
+
+	reply, err := conn.Transact(o.Database, operations...)
+	if err == rpc2.ErrShutdown {
+		return ErrNotConnected
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(reply) < len(operations) {
+		return fmt.Errorf("transaction returned %d results for %d operations", len(reply), len(operations))
+	}
+
+	for i, r := range reply {
+		if r.Error == "" {
+			continue
+		}
+
+		if i < len(operations) {
+			return fmt.Errorf("transaction failed on %v of table %v: %v: %v", operations[i].Op, operations[i].Table, r.Error, r.Details)
+		}
+		return fmt.Errorf("transaction failed: %v: %v", r.Error, r.Details)
+	}
+
+	return nil
+}
+
+func (o *Client) SetSystem(system *System) error {
+	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: system.uuid})
+
+	row := make(map[string]interface{})
+	row["hostname"] = system.Hostname
+
+	updateOp := libovsdb.Operation{
+		Op:    "update",
+		Table: SystemTable,
+		Where: []interface{}{condition},
+		Row:   row,
+	}
+
+	if err := o.transact(updateOp); err != nil {
+		return fmt.Errorf("unable to set system information: %v", err)
+	}
+
+	return nil
+}
+
+func (o *Client) SetOpenFlowController(controller *OpenFlowController) error {
+	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: controller.uuid})
+
+	row := make(map[string]interface{})
+	row["target"] = controller.Target.String()
+	row["max_backoff"] = optionalInteger(controller.MaxBackoff)
+	row["inactivity_probe"] = optionalInteger(controller.InactivityProbe)
+
+	updateOp := libovsdb.Operation{
+		Op:    "update",
+		Table: ControllerTable,
+		Where: []interface{}{condition},
+		Row:   row,
+	}
+
+	if err := o.transact(updateOp); err != nil {
+		return fmt.Errorf("unable to set controller %v: %v", controller.Target, err)
+	}
+
+	return nil
+}
+
+func (o *Client) SetInterface(interf *Interface) error {
+	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: interf.uuid})
+
+	row := make(map[string]interface{})
+	row["name"] = interf.Name
+	// The mtu column is read-only, the MTU is requested through mtu_request.
+	row["mtu_request"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if interf.MTU != 0 {
+		row["mtu_request"] = interf.MTU
+	}
+	row["type"] = interf.Type
+
+	options, err := libovsdb.NewOvsMap(interf.TunnelOptions())
+	if err != nil {
+		return err
+	}
+	row["options"] = options
+	row["ingress_policing_rate"] = interf.IngressPolicingRate
+	row["ingress_policing_burst"] = interf.IngressPolicingBurst
+
+	mutations, err := mapMutations("lldp", []string{"enable"}, interf.lldp())
+	if err != nil {
+		return err
+	}
+
+	operations := []libovsdb.Operation{{
+		Op:    "update",
+		Table: InterfaceTable,
+		Where: []interface{}{condition},
+		Row:   row,
+	}, {
+		Op:        "mutate",
+		Table:     InterfaceTable,
+		Where:     []interface{}{condition},
+		Mutations: mutations,
+	}}
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to set interface %v: %v", interf.Name, err)
+	}
+
+	return nil
+}
+
+// SetBridge writes the failure mode and configured datapath ID of a bridge. Only the datapath-id key of other_config
+// is replaced.
+func (o *Client) SetBridge(bridge *Bridge) error {
+	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: bridge.uuid})
+
+	row := make(map[string]interface{})
+	row["fail_mode"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if bridge.FailMode != "" {
+		row["fail_mode"] = bridge.FailMode
+	}
+
+	mutations := []interface{}{libovsdb.NewMutation("other_config", "delete", libovsdb.OvsSet{GoSet: []interface{}{"datapath-id"}})}
+	if bridge.ConfiguredDatapathID != "" {
+		otherConfig, err := libovsdb.NewOvsMap(map[string]string{"datapath-id": bridge.ConfiguredDatapathID})
+		if err != nil {
+			return err
+		}
+		mutations = append(mutations, libovsdb.NewMutation("other_config", "insert", otherConfig))
+	}
+
+	operations := []libovsdb.Operation{{
+		Op:    "update",
+		Table: BridgeTable,
+		Where: []interface{}{condition},
+		Row:   row,
+	}, {
+		Op:        "mutate",
+		Table:     BridgeTable,
+		Where:     []interface{}{condition},
+		Mutations: mutations,
+	}}
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to set bridge %v: %v", bridge.Name, err)
+	}
+
+	return nil
+}
+
+// SetPort writes the VLAN configuration of a port.
+func (o *Client) SetPort(port *Port) error {
+	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: port.uuid})
+
+	updateOp := libovsdb.Operation{
+		Op:    "update",
+		Table: PortTable,
+		Where: []interface{}{condition},
+		Row:   portVLANRow(port),
+	}
+
+	if err := o.transact(updateOp); err != nil {
+		return fmt.Errorf("unable to set port %v: %v", port.Name, err)
+	}
+
+	return nil
+}
+
+// optionalInteger returns the value of an optional integer column, an empty set if it is unset.
+func optionalInteger(i *uint32) interface{} {
+	if i == nil {
+		return libovsdb.OvsSet{GoSet: []interface{}{}}
+	}
+
+	return *i
+}
+
+// portVLANRow returns the VLAN columns of a port, unset optional columns are written as empty sets.
+func portVLANRow(port *Port) map[string]interface{} {
+	row := make(map[string]interface{})
+
+	row["vlan_mode"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if port.VLANMode != "" {
+		row["vlan_mode"] = port.VLANMode
+	}
+
+	row["tag"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if port.Tag != nil {
+		row["tag"] = *port.Tag
+	}
+
+	trunks := libovsdb.OvsSet{GoSet: []interface{}{}}
+	for _, t := range port.Trunks {
+		trunks.GoSet = append(trunks.GoSet, t)
+	}
+	row["trunks"] = trunks
+
+	return row
+}
+
+// CreateInterface adds a new interface to bridge. The interface gets a port of the same name, which is added to the
+// ports of the bridge in the same transaction. The VLAN configuration and QoS of the port are taken from port, which may
+// be nil.
+func (o *Client) CreateInterface(bridge *Bridge, port *Port, interf *Interface) error {
+	interfaceRow := make(map[string]interface{})
+	interfaceRow["name"] = interf.Name
+	interfaceRow["type"] = interf.Type
+	if interf.MTU != 0 {
+		interfaceRow["mtu_request"] = interf.MTU
+	}
+	if interf.IngressPolicingRate != 0 {
+		interfaceRow["ingress_policing_rate"] = interf.IngressPolicingRate
+		interfaceRow["ingress_policing_burst"] = interf.IngressPolicingBurst
+	}
+	if options := interf.TunnelOptions(); len(options) > 0 {
+		m, err := libovsdb.NewOvsMap(options)
+		if err != nil {
+			return err
+		}
+		interfaceRow["options"] = m
+	}
+	if lldp := interf.lldp(); len(lldp) > 0 {
+		m, err := libovsdb.NewOvsMap(lldp)
+		if err != nil {
+			return err
+		}
+		interfaceRow["lldp"] = m
+	}
+
+	portRow := make(map[string]interface{})
+	if port != nil {
+		portRow = portVLANRow(port)
+	}
+	portRow["name"] = interf.Name
+	portRow["interfaces"] = libovsdb.UUID{GoUUID: "new_interface"}
+
+	var operations []libovsdb.Operation
+	if port != nil && port.QoS != nil {
+		qosOperations, ref, err := qosOperations(port.QoS)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, qosOperations...)
+		portRow["qos"] = ref
+	}
+
+	ports, err := libovsdb.NewOvsSet([]libovsdb.UUID{{GoUUID: "new_port"}})
+	if err != nil {
+		return err
+	}
+
+	operations = append(operations, []libovsdb.Operation{{
+		Op:       "insert",
+		Table:    InterfaceTable,
+		Row:      interfaceRow,
+		UUIDName: "new_interface",
+	}, {
+		Op:       "insert",
+		Table:    PortTable,
+		Row:      portRow,
+		UUIDName: "new_port",
+	}, {
+		Op:        "mutate",
+		Table:     BridgeTable,
+		Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: bridge.uuid})},
+		Mutations: []interface{}{libovsdb.NewMutation("ports", "insert", ports)},
+	}}...)
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to create interface %v on bridge %v: %v", interf.Name, bridge.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteInterface removes an interface. Its port is removed from the bridge as well, unless it is a bond with further
+// interfaces, in which case only the interface is removed from the port.
+func (o *Client) DeleteInterface(bridge *Bridge, port *Port, interf *Interface) error {
+	var operations []libovsdb.Operation
+
+	if len(port.Interfaces) > 1 {
+		interfaces, err := libovsdb.NewOvsSet([]libovsdb.UUID{{GoUUID: interf.uuid}})
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, libovsdb.Operation{
+			Op:        "mutate",
+			Table:     PortTable,
+			Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: port.uuid})},
+			Mutations: []interface{}{libovsdb.NewMutation("interfaces", "delete", interfaces)},
+		})
+	} else {
+		ports, err := libovsdb.NewOvsSet([]libovsdb.UUID{{GoUUID: port.uuid}})
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, libovsdb.Operation{
+			Op:        "mutate",
+			Table:     BridgeTable,
+			Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: bridge.uuid})},
+			Mutations: []interface{}{libovsdb.NewMutation("ports", "delete", ports)},
+		}, libovsdb.Operation{
+			Op:    "delete",
+			Table: PortTable,
+			Where: []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: port.uuid})},
+		})
+	}
+
+	operations = append(operations, libovsdb.Operation{
+		Op:    "delete",
+		Table: InterfaceTable,
+		Where: []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: interf.uuid})},
+	})
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to delete interface %v from bridge %v: %v", interf.Name, bridge.Name, err)
+	}
+
+	return nil
+}
+
+// bondRow returns the bond columns and members of a port, unset optional columns are written as empty sets.
+func bondRow(port *Port) (map[string]interface{}, error) {
+	row := make(map[string]interface{})
+
+	row["bond_mode"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if port.Bond.Mode != "" {
+		row["bond_mode"] = port.Bond.Mode
+	}
+
+	row["lacp"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if port.Bond.LACP != "" {
+		row["lacp"] = port.Bond.LACP
+	}
+
+	row["bond_updelay"] = port.Bond.Updelay
+	row["bond_downdelay"] = port.Bond.Downdelay
+
+	var interfaces []libovsdb.UUID
+	for _, uuid := range port.Interfaces {
+		interfaces = append(interfaces, libovsdb.UUID{GoUUID: uuid})
+	}
+
+	set, err := libovsdb.NewOvsSet(interfaces)
+	if err != nil {
+		return nil, err
+	}
+	row["interfaces"] = set
+
+	return row, nil
+}
+
+// bondOtherConfig returns the LACP keys of other_config of a bond which are set.
+func bondOtherConfig(bond Bond) map[string]string {
+	entries := make(map[string]string)
+	if bond.LACPTime != "" {
+		entries["lacp-time"] = bond.LACPTime
+	}
+	if bond.LACPSystemID != "" {
+		entries["lacp-system-id"] = bond.LACPSystemID
+	}
+	if bond.LACPSystemPriority != 0 {
+		entries["lacp-system-priority"] = strconv.FormatUint(uint64(bond.LACPSystemPriority), 10)
+	}
+
+	return entries
+}
+
+// memberPortOperations returns the operations which give each released interface a port of its own, and the references
+// to the new ports.
+func memberPortOperations(released []*Interface) ([]libovsdb.Operation, []libovsdb.UUID) {
+	var operations []libovsdb.Operation
+	var refs []libovsdb.UUID
+
+	for n, interf := range released {
+		ref := libovsdb.UUID{GoUUID: fmt.Sprintf("new_port_%d", n)}
+		operations = append(operations, libovsdb.Operation{
+			Op:       "insert",
+			Table:    PortTable,
+			Row:      map[string]interface{}{"name": interf.Name, "interfaces": libovsdb.UUID{GoUUID: interf.uuid}},
+			UUIDName: ref.GoUUID,
+		})
+		refs = append(refs, ref)
+	}
+
+	return operations, refs
+}
+
+// bridgePortsOperation returns the operation which removes and adds ports to the ports of bridge. Removed ports are
+// no root rows, so OVS deletes them along with the interfaces no other port references.
+func bridgePortsOperation(bridge *Bridge, removed []*Port, added []libovsdb.UUID) (libovsdb.Operation, error) {
+	var removedRefs []libovsdb.UUID
+	for _, p := range removed {
+		removedRefs = append(removedRefs, libovsdb.UUID{GoUUID: p.uuid})
+	}
+
+	var mutations []interface{}
+	if len(removedRefs) > 0 {
+		set, err := libovsdb.NewOvsSet(removedRefs)
+		if err != nil {
+			return libovsdb.Operation{}, err
+		}
+		mutations = append(mutations, libovsdb.NewMutation("ports", "delete", set))
+	}
+
+	if len(added) > 0 {
+		set, err := libovsdb.NewOvsSet(added)
+		if err != nil {
+			return libovsdb.Operation{}, err
+		}
+		mutations = append(mutations, libovsdb.NewMutation("ports", "insert", set))
+	}
+
+	return libovsdb.Operation{
+		Op:        "mutate",
+		Table:     BridgeTable,
+		Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: bridge.uuid})},
+		Mutations: mutations,
+	}, nil
+}
+
+// CreateBond adds a new bond port to bridge. Its members leave the ports in replaced, which are removed from the bridge.
+func (o *Client) CreateBond(bridge *Bridge, port *Port, replaced []*Port) error {
+	row, err := bondRow(port)
+	if err != nil {
+		return err
+	}
+	row["name"] = port.Name
+
+	if entries := bondOtherConfig(port.Bond); len(entries) > 0 {
+		otherConfig, err := libovsdb.NewOvsMap(entries)
+		if err != nil {
+			return err
+		}
+		row["other_config"] = otherConfig
+	}
+
+	bridgeOp, err := bridgePortsOperation(bridge, replaced, []libovsdb.UUID{{GoUUID: "new_bond"}})
+	if err != nil {
+		return err
+	}
+
+	operations := []libovsdb.Operation{{
+		Op:       "insert",
+		Table:    PortTable,
+		Row:      row,
+		UUIDName: "new_bond",
+	}, bridgeOp}
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to create bond %v on bridge %v: %v", port.Name, bridge.Name, err)
+	}
+
+	return nil
+}
+
+// SetBond writes the bond configuration and members of a port of bridge. Joining members leave the ports in replaced,
+// released members get a port of their own. Only the LACP keys of other_config are replaced.
+func (o *Client) SetBond(bridge *Bridge, port *Port, replaced []*Port, released []*Interface) error {
+	row, err := bondRow(port)
+	if err != nil {
+		return err
+	}
+
+	keys := libovsdb.OvsSet{GoSet: []interface{}{"lacp-time", "lacp-system-id", "lacp-system-priority"}}
+	mutations := []interface{}{libovsdb.NewMutation("other_config", "delete", keys)}
+	if entries := bondOtherConfig(port.Bond); len(entries) > 0 {
+		otherConfig, err := libovsdb.NewOvsMap(entries)
+		if err != nil {
+			return err
+		}
+		mutations = append(mutations, libovsdb.NewMutation("other_config", "insert", otherConfig))
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: port.uuid})
+	operations := []libovsdb.Operation{{
+		Op:    "update",
+		Table: PortTable,
+		Where: []interface{}{condition},
+		Row:   row,
+	}, {
+		Op:        "mutate",
+		Table:     PortTable,
+		Where:     []interface{}{condition},
+		Mutations: mutations,
+	}}
+
+	if len(replaced) > 0 || len(released) > 0 {
+		memberOperations, refs := memberPortOperations(released)
+		bridgeOp, err := bridgePortsOperation(bridge, replaced, refs)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, memberOperations...)
+		operations = append(operations, bridgeOp)
+	}
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to set bond %v: %v", port.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteBond removes a bond port from bridge. Its members which still exist, released, get a port of their own.
+func (o *Client) DeleteBond(bridge *Bridge, port *Port, released []*Interface) error {
+	operations, refs := memberPortOperations(released)
+	bridgeOp, err := bridgePortsOperation(bridge, []*Port{port}, refs)
+	if err != nil {
+		return err
+	}
+	operations = append(operations, bridgeOp)
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to delete bond %v from bridge %v: %v", port.Name, bridge.Name, err)
+	}
+
+	return nil
+}
+
+// mapMutations replaces the keys of a map column with entries, removing those without an entry.
+func mapMutations(column string, keys []string, entries map[string]string) ([]interface{}, error) {
+	set := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		set = append(set, key)
+	}
+
+	mutations := []interface{}{libovsdb.NewMutation(column, "delete", libovsdb.OvsSet{GoSet: set})}
+	if len(entries) > 0 {
+		m, err := libovsdb.NewOvsMap(entries)
+		if err != nil {
+			return nil, err
+		}
+		mutations = append(mutations, libovsdb.NewMutation(column, "insert", m))
+	}
+
+	return mutations, nil
+}
+
+// SetBridgeSpanningTree writes the spanning tree protocols of a bridge and the settings of both.
+func (o *Client) SetBridgeSpanningTree(bridge *Bridge) error {
+	entries := bridge.STP.otherConfig(SpanningTreeSTP)
+	for key, value := range bridge.RSTP.otherConfig(SpanningTreeRSTP) {
+		entries[key] = value
+	}
+
+	mutations, err := mapMutations("other_config", stpBridgeKeys, entries)
+	if err != nil {
+		return err
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: bridge.uuid})
+	operations := []libovsdb.Operation{{
+		Op:    "update",
+		Table: BridgeTable,
+		Where: []interface{}{condition},
+		Row:   map[string]interface{}{"stp_enable": bridge.STPEnable, "rstp_enable": bridge.RSTPEnable},
+	}, {
+		Op:        "mutate",
+		Table:     BridgeTable,
+		Where:     []interface{}{condition},
+		Mutations: mutations,
+	}}
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to set spanning tree of bridge %v: %v", bridge.Name, err)
+	}
+
+	return nil
+}
+
+// SetPortSpanningTree writes the STP and RSTP path cost and priority of a port.
+func (o *Client) SetPortSpanningTree(port *Port) error {
+	entries := port.SpanningTree.STP.otherConfig(SpanningTreeSTP)
+	for key, value := range port.SpanningTree.RSTP.otherConfig(SpanningTreeRSTP) {
+		entries[key] = value
+	}
+
+	mutations, err := mapMutations("other_config", stpPortKeys, entries)
+	if err != nil {
+		return err
+	}
+
+	operation := libovsdb.Operation{
+		Op:        "mutate",
+		Table:     PortTable,
+		Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: port.uuid})},
+		Mutations: mutations,
+	}
+
+	if err := o.transact(operation); err != nil {
+		return fmt.Errorf("unable to set spanning tree of port %v: %v", port.Name, err)
+	}
+
+	return nil
+}
+
+// mirrorRow returns the columns of a mirror, unset optional columns are written as empty sets.
+func mirrorRow(mirror *Mirror) map[string]interface{} {
+	uuids := func(refs []string) libovsdb.OvsSet {
+		set := libovsdb.OvsSet{GoSet: []interface{}{}}
+		for _, uuid := range refs {
+			set.GoSet = append(set.GoSet, libovsdb.UUID{GoUUID: uuid})
+		}
+		return set
+	}
+
+	row := make(map[string]interface{})
+	row["name"] = mirror.Name
+	row["select_all"] = mirror.SelectAll
+	row["select_src_port"] = uuids(mirror.SelectSrcPorts)
+	row["select_dst_port"] = uuids(mirror.SelectDstPorts)
+
+	vlans := libovsdb.OvsSet{GoSet: []interface{}{}}
+	for _, vlan := range mirror.SelectVLANs {
+		vlans.GoSet = append(vlans.GoSet, vlan)
+	}
+	row["select_vlan"] = vlans
+
+	row["output_port"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if mirror.OutputPort != "" {
+		row["output_port"] = libovsdb.UUID{GoUUID: mirror.OutputPort}
+	}
+
+	row["output_vlan"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if mirror.OutputVLAN != nil {
+		row["output_vlan"] = *mirror.OutputVLAN
+	}
+
+	return row
+}
+
+// CreateMirror adds a new mirror to the mirrors of bridge.
+func (o *Client) CreateMirror(bridge *Bridge, mirror *Mirror) error {
+	mirrors, err := libovsdb.NewOvsSet([]libovsdb.UUID{{GoUUID: "new_mirror"}})
+	if err != nil {
+		return err
+	}
+
+	operations := []libovsdb.Operation{{
+		Op:       "insert",
+		Table:    MirrorTable,
+		Row:      mirrorRow(mirror),
+		UUIDName: "new_mirror",
+	}, {
+		Op:        "mutate",
+		Table:     BridgeTable,
+		Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: bridge.uuid})},
+		Mutations: []interface{}{libovsdb.NewMutation("mirrors", "insert", mirrors)},
+	}}
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to create mirror %v on bridge %v: %v", mirror.Name, bridge.Name, err)
+	}
+
+	return nil
+}
+
+// SetMirror writes the port and VLAN selections and the output of a mirror.
+func (o *Client) SetMirror(mirror *Mirror) error {
+	updateOp := libovsdb.Operation{
+		Op:    "update",
+		Table: MirrorTable,
+		Where: []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: mirror.uuid})},
+		Row:   mirrorRow(mirror),
+	}
+
+	if err := o.transact(updateOp); err != nil {
+		return fmt.Errorf("unable to set mirror %v: %v", mirror.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteMirror removes a mirror from the mirrors of bridge along with its row.
+func (o *Client) DeleteMirror(bridge *Bridge, mirror *Mirror) error {
+	mirrors, err := libovsdb.NewOvsSet([]libovsdb.UUID{{GoUUID: mirror.uuid}})
+	if err != nil {
+		return err
+	}
+
+	operations := []libovsdb.Operation{{
+		Op:        "mutate",
+		Table:     BridgeTable,
+		Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: bridge.uuid})},
+		Mutations: []interface{}{libovsdb.NewMutation("mirrors", "delete", mirrors)},
+	}, {
+		Op:    "delete",
+		Table: MirrorTable,
+		Where: []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: mirror.uuid})},
+	}}
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to delete mirror %v from bridge %v: %v", mirror.Name, bridge.Name, err)
+	}
+
+	return nil
+}
+
+// SetQoS writes the QoS of a port. A new QoS and new queues are inserted, the queues of prev which are gone are
+// deleted, and without a QoS the port no longer references prev, which is deleted unless another port uses it. Only the
+// modelled keys of other_config are replaced.
+func (o *Client) SetQoS(port *Port, prev *QoS, shared bool) error {
+	portCondition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: port.uuid})
+
+	var operations []libovsdb.Operation
+	if port.QoS == nil {
+		operations = append(operations, libovsdb.Operation{
+			Op:    "update",
+			Table: PortTable,
+			Where: []interface{}{portCondition},
+			Row:   map[string]interface{}{"qos": libovsdb.OvsSet{GoSet: []interface{}{}}},
+		})
+
+		if prev != nil && !shared {
+			operations = append(operations, deleteQoSOperations(prev, nil)...)
+		}
+	} else {
+		qosOperations, ref, err := qosOperations(port.QoS)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, qosOperations...)
+
+		if port.QoS.uuid == "" {
+			operations = append(operations, libovsdb.Operation{
+				Op:    "update",
+				Table: PortTable,
+				Where: []interface{}{portCondition},
+				Row:   map[string]interface{}{"qos": ref},
+			})
+		}
+
+		if prev != nil && prev.uuid == port.QoS.uuid {
+			operations = append(operations, deleteQoSOperations(prev, port.QoS)...)
+		}
+	}
+
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to set QoS of port %v: %v", port.Name, err)
+	}
+
+	return nil
+}
+
+// qosOperations returns the operations which insert or update a QoS and its queues, and the reference to the QoS row.
+func qosOperations(qos *QoS) ([]libovsdb.Operation, libovsdb.UUID, error) {
+	var operations []libovsdb.Operation
+
+	queues := make(map[int]libovsdb.UUID)
+	for id, queue := range qos.Queues {
+		rates := map[string]*uint64{"min-rate": queue.MinRate, "max-rate": queue.MaxRate}
+
+		if queue.uuid == "" {
+			otherConfig, err := libovsdb.NewOvsMap(setRates(rates))
+			if err != nil {
+				return nil, libovsdb.UUID{}, err
+			}
+
+			name := fmt.Sprintf("new_queue_%d", id)
+			operations = append(operations, libovsdb.Operation{
+				Op:       "insert",
+				Table:    QueueTable,
+				Row:      map[string]interface{}{"other_config": otherConfig},
+				UUIDName: name,
+			})
+			queues[int(id)] = libovsdb.UUID{GoUUID: name}
+			continue
+		}
+
+		mutations, err := rateMutations(rates)
+		if err != nil {
+			return nil, libovsdb.UUID{}, err
+		}
+		operations = append(operations, libovsdb.Operation{
+			Op:        "mutate",
+			Table:     QueueTable,
+			Where:     []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: queue.uuid})},
+			Mutations: mutations,
+		})
+		queues[int(id)] = libovsdb.UUID{GoUUID: queue.uuid}
+	}
 
-	var err error
-	o.Connection, err = libovsdb.ConnectUsingProtocolWithTLS(o.Protocol, fmt.Sprintf("%v:%v", o.Address, o.Port), privateKeyPath, publicKeyPath, caPath)
+	queueMap, err := libovsdb.NewOvsMap(queues)
 	if err != nil {
-		o.ErrorChan <- err
+		return nil, libovsdb.UUID{}, err
 	}
 
-	o.MonitorAll()
-	o.Config.DumpRawCache()
-}
+	rates := map[string]*uint64{"max-rate": qos.MaxRate}
+	row := map[string]interface{}{"type": qos.Type, "queues": queueMap}
 
-func (o *Client) StopMonitoring() {
-	o.QuitChan <- true
-}
+	if qos.uuid == "" {
+		otherConfig, err := libovsdb.NewOvsMap(setRates(rates))
+		if err != nil {
+			return nil, libovsdb.UUID{}, err
+		}
+		row["other_config"] = otherConfig
 
-func (o *Client) StopClient() {
-	log.Info("Stop OVS Client")
-	o.Connection.Disconnect()
-	o.Config = NewConfig()
-}
+		operations = append(operations, libovsdb.Operation{
+			Op:       "insert",
+			Table:    QoSTable,
+			Row:      row,
+			UUIDName: "new_qos",
+		})
 
-func (o *Client) SetSystem(system *System) error {
-	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: system.uuid})
+		return operations, libovsdb.UUID{GoUUID: "new_qos"}, nil
+	}
 
-	row := make(map[string]interface{})
-	row["hostname"] = system.Hostname
+	mutations, err := rateMutations(rates)
+	if err != nil {
+		return nil, libovsdb.UUID{}, err
+	}
 
-	updateOp := libovsdb.Operation{
+	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: qos.uuid})
+	operations = append(operations, libovsdb.Operation{
 		Op:    "update",
-		Table: SystemTable,
+		Table: QoSTable,
 		Where: []interface{}{condition},
 		Row:   row,
+	}, libovsdb.Operation{
+		Op:        "mutate",
+		Table:     QoSTable,
+		Where:     []interface{}{condition},
+		Mutations: mutations,
+	})
+
+	return operations, libovsdb.UUID{GoUUID: qos.uuid}, nil
+}
+
+// deleteQoSOperations returns the operations which delete the queues of prev that are not in qos, and prev itself if
+// qos is nil. The QoS row goes first, as it references the queues.
+func deleteQoSOperations(prev, qos *QoS) []libovsdb.Operation {
+	var operations []libovsdb.Operation
+	if qos == nil {
+		operations = append(operations, libovsdb.Operation{
+			Op:    "delete",
+			Table: QoSTable,
+			Where: []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: prev.uuid})},
+		})
 	}
 
-	log.Debug(updateOp)
+	for id, queue := range prev.Queues {
+		if qos != nil && qos.Queues[id] != nil && qos.Queues[id].uuid == queue.uuid {
+			continue
+		}
 
-	operations := []libovsdb.Operation{updateOp}
-	reply, _ := o.Connection.Transact(o.Database, operations...)
+		operations = append(operations, libovsdb.Operation{
+			Op:    "delete",
+			Table: QueueTable,
+			Where: []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: queue.uuid})},
+		})
+	}
 
-	if len(reply) < len(operations) {
-		log.Error("number of Replies should be at least equal to number of Operations")
+	return operations
+}
+
+// setRates returns the rates which are set as other_config entries.
+func setRates(rates map[string]*uint64) map[string]string {
+	entries := make(map[string]string)
+	for key, rate := range rates {
+		if rate != nil {
+			entries[key] = strconv.FormatUint(*rate, 10)
+		}
+	}
+
+	return entries
+}
+
+// rateMutations returns the mutations which replace the rate keys of other_config, keeping all other keys.
+func rateMutations(rates map[string]*uint64) ([]interface{}, error) {
+	keys := libovsdb.OvsSet{GoSet: []interface{}{}}
+	for key := range rates {
+		keys.GoSet = append(keys.GoSet, key)
 	}
-	ok := true
-	for i, o := range reply {
-		if o.Error != "" && i < len(operations) {
-			log.Errorf("transaction failed due to an error :", o.Error, " details:", o.Details, " in ", operations[i])
-			ok = false
-		} else if o.Error != "" {
-			log.Errorf("transaction failed due to an error :", o.Error)
-			ok = false
+
+	mutations := []interface{}{libovsdb.NewMutation("other_config", "delete", keys)}
+	if entries := setRates(rates); len(entries) > 0 {
+		otherConfig, err := libovsdb.NewOvsMap(entries)
+		if err != nil {
+			return nil, err
 		}
+		mutations = append(mutations, libovsdb.NewMutation("other_config", "insert", otherConfig))
 	}
-	if ok {
-		return nil
+
+	return mutations, nil
+}
+
+// QueueStatistics returns the statistics of the queues of a port of bridge by queue number. OVSDB has no column for
+// them, so they are requested from ovs-vswitchd.
+func (o *Client) QueueStatistics(bridge, port string) (map[uint32]*QueueStatistics, error) {
+	out, err := o.Executor.Run(OFCtl, "queue-stats", bridge, port)
+	if err != nil {
+		return nil, err
 	}
 
-	return fmt.Errorf("unable to set system information")
+	return ParseQueueStatistics(string(out))
 }
 
-func (o *Client) SetOpenFlowController(controller *OpenFlowController) error {
-	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: controller.uuid})
+// LLDPNeighbors returns the LLDP neighbors of the interfaces by interface name. OVSDB has no column for them, so they
+// are requested from ovs-vswitchd.
+func (o *Client) LLDPNeighbors() (map[string][]*LLDPNeighbor, error) {
+	out, err := o.Executor.Run(AppCtl, "lldp/neighbor")
+	if err != nil {
+		return nil, err
+	}
 
-	row := make(map[string]interface{})
-	row["target"] = fmt.Sprintf("%v:%v:%v", controller.Target.Protocol, controller.Target.Address, controller.Target.Port)
+	return ParseLLDPNeighbors(string(out)), nil
+}
 
-	updateOp := libovsdb.Operation{
-		Op:    "update",
-		Table: ControllerTable,
-		Where: []interface{}{condition},
-		Row:   row,
+// Flows returns the OpenFlow flows of a bridge. The flows are owned by the controllers and OVSDB has no table for
+// them, so they are requested from ovs-vswitchd with the OpenFlow versions the bridge allows.
+func (o *Client) Flows(bridge string, protocols []string) ([]*Flow, error) {
+	args := []string{"dump-flows", bridge}
+	if len(protocols) > 0 {
+		args = append([]string{"-O", strings.Join(protocols, ",")}, args...)
+	}
+
+	out, err := o.Executor.Run(OFCtl, args...)
+	if err != nil {
+		return nil, err
 	}
 
-	log.Debug(updateOp)
+	return ParseFlows(string(out))
+}
 
-	operations := []libovsdb.Operation{updateOp}
-	reply, _ := o.Connection.Transact(o.Database, operations...)
+// SetSFlow writes the sFlow exporter of a bridge, creating or removing it as needed.
+func (o *Client) SetSFlow(bridge *Bridge) error {
+	if bridge.SFlow == nil {
+		return o.setFlowExport(bridge, "sflow", SFlowTable, "", nil)
+	}
 
-	if len(reply) < len(operations) {
-		log.Error("number of Replies should be at least equal to number of Operations")
+	row := make(map[string]interface{})
+	row["targets"] = stringSet(bridge.SFlow.Targets)
+	row["sampling"] = optionalInteger(bridge.SFlow.Sampling)
+	row["polling"] = optionalInteger(bridge.SFlow.Polling)
+	row["header"] = optionalInteger(bridge.SFlow.Header)
+	row["agent"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if bridge.SFlow.Agent != "" {
+		row["agent"] = bridge.SFlow.Agent
 	}
-	ok := true
-	for i, o := range reply {
-		if o.Error != "" && i < len(operations) {
-			log.Errorf("transaction failed due to an error :", o.Error, " details:", o.Details, " in ", operations[i])
-			ok = false
-		} else if o.Error != "" {
-			log.Errorf("transaction failed due to an error :", o.Error)
-			ok = false
-		}
+
+	return o.setFlowExport(bridge, "sflow", SFlowTable, bridge.SFlow.uuid, row)
+}
+
+// SetNetFlow writes the NetFlow exporter of a bridge, creating or removing it as needed.
+func (o *Client) SetNetFlow(bridge *Bridge) error {
+	if bridge.NetFlow == nil {
+		return o.setFlowExport(bridge, "netflow", NetFlowTable, "", nil)
+	}
+
+	row := make(map[string]interface{})
+	row["targets"] = stringSet(bridge.NetFlow.Targets)
+	row["active_timeout"] = bridge.NetFlow.ActiveTimeout
+	row["engine_type"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if bridge.NetFlow.EngineType != nil {
+		row["engine_type"] = *bridge.NetFlow.EngineType
 	}
-	if ok {
-		return nil
+	row["engine_id"] = libovsdb.OvsSet{GoSet: []interface{}{}}
+	if bridge.NetFlow.EngineID != nil {
+		row["engine_id"] = *bridge.NetFlow.EngineID
 	}
 
-	return fmt.Errorf("unable to set system information")
+	return o.setFlowExport(bridge, "netflow", NetFlowTable, bridge.NetFlow.uuid, row)
 }
 
-func (o *Client) SetInterface(interf *Interface) error {
-	condition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: interf.uuid})
+// SetIPFIX writes the IPFIX exporter of a bridge, creating or removing it as needed.
+func (o *Client) SetIPFIX(bridge *Bridge) error {
+	if bridge.IPFIX == nil {
+		return o.setFlowExport(bridge, "ipfix", IPFIXTable, "", nil)
+	}
 
 	row := make(map[string]interface{})
-	row["name"] = interf.Name
-	row["mtu"] = interf.MTU
+	row["targets"] = stringSet(bridge.IPFIX.Targets)
+	row["sampling"] = optionalInteger(bridge.IPFIX.Sampling)
+	row["obs_domain_id"] = optionalInteger(bridge.IPFIX.ObsDomainID)
+	row["obs_point_id"] = optionalInteger(bridge.IPFIX.ObsPointID)
 
-	updateOp := libovsdb.Operation{
-		Op:    "update",
-		Table: InterfaceTable,
-		Where: []interface{}{condition},
-		Row:   row,
+	return o.setFlowExport(bridge, "ipfix", IPFIXTable, bridge.IPFIX.uuid, row)
+}
+
+// setFlowExport updates the exporter row with the given uuid. Without a uuid the row is inserted and referenced by
+// column of the bridge, without a row the reference is cleared and OVS deletes the exporter as it is no root table.
+func (o *Client) setFlowExport(bridge *Bridge, column, table, uuid string, row map[string]interface{}) error {
+	bridgeCondition := libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: bridge.uuid})
+
+	var operations []libovsdb.Operation
+	switch {
+	case row == nil:
+		operations = append(operations, libovsdb.Operation{
+			Op:    "update",
+			Table: BridgeTable,
+			Where: []interface{}{bridgeCondition},
+			Row:   map[string]interface{}{column: libovsdb.OvsSet{GoSet: []interface{}{}}},
+		})
+	case uuid == "":
+		operations = append(operations, libovsdb.Operation{
+			Op:       "insert",
+			Table:    table,
+			Row:      row,
+			UUIDName: "new_" + column,
+		}, libovsdb.Operation{
+			Op:    "update",
+			Table: BridgeTable,
+			Where: []interface{}{bridgeCondition},
+			Row:   map[string]interface{}{column: libovsdb.UUID{GoUUID: "new_" + column}},
+		})
+	default:
+		operations = append(operations, libovsdb.Operation{
+			Op:    "update",
+			Table: table,
+			Where: []interface{}{libovsdb.NewCondition("_uuid", "==", libovsdb.UUID{GoUUID: uuid})},
+			Row:   row,
+		})
 	}
 
-	log.Debug(updateOp)
+	if err := o.transact(operations...); err != nil {
+		return fmt.Errorf("unable to set %v of bridge %v: %v", table, bridge.Name, err)
+	}
 
-	operations := []libovsdb.Operation{updateOp}
-	reply, _ := o.Connection.Transact(o.Database, operations...)
+	return nil
+}
 
-	if len(reply) < len(operations) {
-		log.Error("number of Replies should be at least equal to number of Operations")
+func stringSet(values []string) libovsdb.OvsSet {
+	set := libovsdb.OvsSet{GoSet: []interface{}{}}
+	for _, v := range values {
+		set.GoSet = append(set.GoSet, v)
 	}
-	ok := true
-	for i, o := range reply {
-		if o.Error != "" && i < len(operations) {
-			log.Errorf("transaction failed due to an error :", o.Error, " details:", o.Details, " in ", operations[i])
-			ok = false
-		} else if o.Error != "" {
-			log.Errorf("transaction failed due to an error :", o.Error)
-			ok = false
-		}
+
+	return set
+}
+
+// SetInterfaceEnabled sets the administrative state of an interface of bridge. OVSDB has no writable column for it, so
+// it is changed with an OpenFlow port modification, which OVS reflects in admin_state. The modification is sent to the
+// local ovs-vswitchd, so it only works if the target runs next to the OVS it manages.
+func (o *Client) SetInterfaceEnabled(bridge string, interf *Interface) error {
+	state := "down"
+	if interf.Enabled {
+		state = "up"
 	}
-	if ok {
-		return nil
+
+	if _, err := o.Executor.Run(OFCtl, "mod-port", bridge, interf.Name, state); err != nil {
+		return fmt.Errorf("unable to set admin state of interface %v to %v: %v", interf.Name, state, err)
 	}
 
-	return fmt.Errorf("unable to set system information")
+	return nil
 }
 
 func (o *Client) SyncChangesToRemote(prev, new *ObjectCache) error {
+	if !o.IsConnected() {
+		return ErrNotConnected
+	}
+
 	if !cmp.Equal(prev.System, new.System) {
 		log.Info("target is in inconsistent state with OVS device, syncing System")
 
@@ -208,9 +1404,9 @@ func (o *Client) SyncChangesToRemote(prev, new *ObjectCache) error {
 		}
 	}
 
-	for _, controller := range new.Controllers {
-		if prev.Controllers[controller.Name].uuid == controller.uuid {
-			if !cmp.Equal(prev.Controllers[controller.Name], controller) {
+	for uuid, controller := range new.Controllers {
+		if prevController, ok := prev.Controllers[uuid]; ok {
+			if !cmp.Equal(prevController, controller) {
 				log.Info("target is in inconsistent state with OVS device, syncing Controller")
 
 				err := o.SetOpenFlowController(controller)
@@ -222,28 +1418,354 @@ func (o *Client) SyncChangesToRemote(prev, new *ObjectCache) error {
 	}
 
 	for _, interf := range new.Interfaces {
-		if prev.Interfaces[interf.Name].uuid == interf.uuid {
-			if !cmp.Equal(prev.Interfaces[interf.Name], interf) {
+		prevInterf, ok := prev.Interfaces[interf.Name]
+		if !ok {
+			log.Infof("target is in inconsistent state with OVS device, creating Interface %v", interf.Name)
+
+			bridge, err := bridgeForNew(new, "interface "+interf.Name, interf.Bridge)
+			if err != nil {
+				return err
+			}
+
+			// The port of a new interface has no uuid yet, see vlanPort.
+			port, ok := new.Ports[interf.Name]
+			if ok && port.uuid != "" {
+				port = nil
+			}
+
+			err = o.CreateInterface(bridge, port, interf)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if prevInterf.uuid == interf.uuid {
+			if !cmp.Equal(prevInterf, interf) {
 				log.Info("target is in inconsistent state with OVS device, syncing Interface")
 
 				err := o.SetInterface(interf)
 				if err != nil {
 					return err
 				}
+
+				if prevInterf.Enabled != interf.Enabled {
+					bridge := new.BridgeOfInterface(interf)
+					if bridge == nil {
+						return fmt.Errorf("unable to set admin state of interface %v without a bridge", interf.Name)
+					}
+
+					err := o.SetInterfaceEnabled(bridge.Name, interf)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	for _, port := range new.Ports {
+		if prevPort, ok := prev.Ports[port.Name]; ok && port.uuid != "" && prevPort.uuid == port.uuid {
+			if !cmp.Equal(prevPort, port) {
+				log.Info("target is in inconsistent state with OVS device, syncing Port")
+
+				err := o.SetPort(port)
+				if err != nil {
+					return err
+				}
+			}
+
+			if !cmp.Equal(prevPort.QoS, port.QoS) {
+				log.Info("target is in inconsistent state with OVS device, syncing QoS")
+
+				err := o.SetQoS(port, prevPort.QoS, prev.sharedQoS(prevPort))
+				if err != nil {
+					return err
+				}
+			}
+
+			if !prevPort.SpanningTree.equalConfig(&port.SpanningTree) {
+				log.Info("target is in inconsistent state with OVS device, syncing Port spanning tree")
+
+				err := o.SetPortSpanningTree(port)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, port := range new.Ports {
+		if !port.IsBond() {
+			continue
+		}
+
+		prevPort, ok := prev.Ports[port.Name]
+		if ok && prevPort.uuid == port.uuid && prevPort.equalBond(port) {
+			continue
+		}
+
+		bridge, replaced, released, err := bondChanges(prev, new, port)
+		if err != nil {
+			return err
+		}
+
+		if port.uuid == "" {
+			log.Infof("target is in inconsistent state with OVS device, creating Bond %v", port.Name)
+
+			err = o.CreateBond(bridge, port, replaced)
+		} else {
+			log.Info("target is in inconsistent state with OVS device, syncing Bond")
+
+			err = o.SetBond(bridge, port, replaced, released)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, port := range prev.Ports {
+		if p, ok := new.Ports[port.Name]; !port.IsBond() || ok && p.IsBond() {
+			continue
+		}
+
+		log.Infof("target is in inconsistent state with OVS device, deleting Bond %v", port.Name)
+
+		bridge, _, released, err := bondChanges(prev, new, port)
+		if err != nil {
+			return err
+		}
+
+		err = o.DeleteBond(bridge, port, released)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, bridge := range new.Bridges {
+		if prevBridge, ok := prev.Bridges[bridge.Name]; ok && prevBridge.uuid == bridge.uuid {
+			if !cmp.Equal(prevBridge, bridge) {
+				log.Info("target is in inconsistent state with OVS device, syncing Bridge")
+
+				err := o.SetBridge(bridge)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, bridge := range new.Bridges {
+		prevBridge, ok := prev.Bridges[bridge.Name]
+		if !ok || prevBridge.uuid != bridge.uuid {
+			continue
+		}
+
+		if !cmp.Equal(prevBridge.SFlow, bridge.SFlow) {
+			log.Info("target is in inconsistent state with OVS device, syncing sFlow")
+
+			err := o.SetSFlow(bridge)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !cmp.Equal(prevBridge.NetFlow, bridge.NetFlow) {
+			log.Info("target is in inconsistent state with OVS device, syncing NetFlow")
+
+			err := o.SetNetFlow(bridge)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !cmp.Equal(prevBridge.IPFIX, bridge.IPFIX) {
+			log.Info("target is in inconsistent state with OVS device, syncing IPFIX")
+
+			err := o.SetIPFIX(bridge)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !prevBridge.equalSpanningTree(bridge) {
+			log.Info("target is in inconsistent state with OVS device, syncing Bridge spanning tree")
+
+			err := o.SetBridgeSpanningTree(bridge)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, mirror := range new.Mirrors {
+		prevMirror, ok := prev.Mirrors[mirror.Name]
+		if !ok {
+			log.Infof("target is in inconsistent state with OVS device, creating Mirror %v", mirror.Name)
+
+			bridge, err := bridgeForNew(new, "mirror "+mirror.Name, mirror.Bridge)
+			if err != nil {
+				return err
+			}
+
+			err = o.CreateMirror(bridge, mirror)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if prevMirror.uuid == mirror.uuid {
+			if !cmp.Equal(prevMirror, mirror) {
+				log.Info("target is in inconsistent state with OVS device, syncing Mirror")
+
+				err := o.SetMirror(mirror)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
 
+	// Mirrors are deleted ahead of interfaces, as they may reference the ports of deleted interfaces.
+	for _, mirror := range prev.Mirrors {
+		if _, ok := new.Mirrors[mirror.Name]; ok {
+			continue
+		}
+
+		log.Infof("target is in inconsistent state with OVS device, deleting Mirror %v", mirror.Name)
+
+		bridge := prev.BridgeOfMirror(mirror)
+		if bridge == nil {
+			return fmt.Errorf("unable to delete mirror %v without a bridge", mirror.Name)
+		}
+
+		err := o.DeleteMirror(bridge, mirror)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, interf := range prev.Interfaces {
+		if _, ok := new.Interfaces[interf.Name]; ok {
+			continue
+		}
+
+		log.Infof("target is in inconsistent state with OVS device, deleting Interface %v", interf.Name)
+
+		port, bridge := prev.PortOfInterface(interf), prev.BridgeOfInterface(interf)
+		if port == nil || bridge == nil {
+			return fmt.Errorf("unable to delete interface %v without a port or bridge", interf.Name)
+		}
+
+		if port.Name == bridge.Name {
+			return fmt.Errorf("unable to delete interface %v, which is the local port of its bridge", interf.Name)
+		}
+
+		err := o.DeleteInterface(bridge, port, interf)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// bondChanges returns the bridge of a bond, the ports its members leave to join it and the former members which still
+// exist but are no longer in new. All members have to be on the same bridge. For a bond which is gone from new, every
+// remaining member is released.
+func bondChanges(prev, new *ObjectCache, port *Port) (*Bridge, []*Port, []*Interface, error) {
+	members := make(map[string]bool)
+	if p, ok := new.Ports[port.Name]; ok && p.IsBond() {
+		for _, uuid := range p.Interfaces {
+			members[uuid] = true
+		}
+	}
+
+	var bridge *Bridge
+	var replaced []*Port
+	for uuid := range members {
+		interf := prev.interfaceByUUID(uuid)
+		if interf == nil {
+			return nil, nil, nil, fmt.Errorf("unable to add unknown interface %v to bond %v", uuid, port.Name)
+		}
+
+		b := prev.BridgeOfInterface(interf)
+		if b == nil || bridge != nil && b != bridge {
+			return nil, nil, nil, fmt.Errorf("unable to add interface %v to bond %v, its members have to share a bridge", interf.Name, port.Name)
+		}
+		bridge = b
+
+		if p := prev.PortOfInterface(interf); p.uuid != port.uuid {
+			replaced = append(replaced, p)
+		}
+	}
+
+	var released []*Interface
+	if prevPort, ok := prev.Ports[port.Name]; ok {
+		for _, uuid := range prevPort.Interfaces {
+			if interf := new.interfaceByUUID(uuid); interf != nil && !members[uuid] {
+				released = append(released, interf)
+			}
+		}
+
+		if bridge == nil {
+			for _, b := range prev.Bridges {
+				for _, uuid := range b.Ports {
+					if uuid == prevPort.uuid {
+						bridge = b
+					}
+				}
+			}
+		}
+	}
+
+	if bridge == nil {
+		return nil, nil, nil, fmt.Errorf("unable to find the bridge of bond %v", port.Name)
+	}
+
+	return bridge, replaced, released, nil
+}
+
+// bridgeForNew returns the bridge a new interface or mirror is added to. Without a configured bridge, the only bridge
+// is used.
+func bridgeForNew(cache *ObjectCache, object, name string) (*Bridge, error) {
+	if name != "" {
+		bridge, ok := cache.Bridges[name]
+		if !ok {
+			return nil, fmt.Errorf("unable to create %v on unknown bridge %v", object, name)
+		}
+		return bridge, nil
+	}
+
+	if len(cache.Bridges) != 1 {
+		return nil, fmt.Errorf("unable to create %v without a bridge, there are %d bridges", object, len(cache.Bridges))
+	}
+
+	for _, bridge := range cache.Bridges {
+		return bridge, nil
+	}
+
+	return nil, nil
+}
+
 type Notifier struct {
-	config *Config
+	client *Client
 }
 
 func (n Notifier) Update(context interface{}, tableUpdates libovsdb.TableUpdates) {
-	n.config.SyncCache(&tableUpdates)
-	update <- &tableUpdates
+	n.client.Config.SyncCache(&tableUpdates)
+
+	n.client.mu.RLock()
+	stop, updates := n.client.stop, n.client.updates
+	n.client.mu.RUnlock()
+
+	select {
+	case updates <- &tableUpdates:
+	case <-stop:
+	}
 }
 func (n Notifier) Locked([]interface{}) {
 }
@@ -251,15 +1773,18 @@ func (n Notifier) Stolen([]interface{}) {
 }
 func (n Notifier) Echo([]interface{}) {
 }
+
+// Disconnected is called by libovsdb while it holds its connection lock, so the reconnect has to happen elsewhere.
 func (n Notifier) Disconnected(client *libovsdb.OvsdbClient) {
+	go n.client.handleDisconnect(client)
 }
 
-func (o *Client) receivedMonitorUpdate() {
+func (o *Client) receivedMonitorUpdate(stop <-chan struct{}, updates <-chan *libovsdb.TableUpdates) {
 	for {
 		select {
-		case <-o.QuitChan:
+		case <-stop:
 			return
-		case currUpdate := <-update:
+		case currUpdate := <-updates:
 			for tableName, tableUpdate := range currUpdate.Updates {
 				log.Debugf("Received Table update for \"%v\" with content: %v", tableName, tableUpdate)
 			}
@@ -267,10 +1792,9 @@ func (o *Client) receivedMonitorUpdate() {
 	}
 }
 
-func (o *Client) MonitorAll() {
-	o.QuitChan = make(chan bool)
-	update = make(chan *libovsdb.TableUpdates)
-
+// MonitorAll monitors the tables of the target on conn and makes it the connection of the client. The config cache
+// is initialized from the initial dump, or replaced by it on a reconnect.
+func (o *Client) MonitorAll(conn *libovsdb.OvsdbClient) error {
 	request := libovsdb.MonitorRequest{
 		Select: libovsdb.MonitorSelect{
 			Initial: true,
@@ -284,24 +1808,43 @@ func (o *Client) MonitorAll() {
 	requests[SystemTable] = request
 	requests[ControllerTable] = request
 	requests[InterfaceTable] = request
+	requests[PortTable] = request
+	requests[BridgeTable] = request
+	requests[MirrorTable] = request
+	requests[SFlowTable] = request
+	requests[NetFlowTable] = request
+	requests[IPFIXTable] = request
+	requests[QoSTable] = request
+	requests[QueueTable] = request
+
+	o.mu.Lock()
+	o.Connection = conn
+	o.mu.Unlock()
+
+	if schema, ok := conn.Schema[DefaultDatabase]; ok {
+		o.Config.SetSchema(&schema)
+	}
 
-	o.Notifier = &Notifier{config: o.Config}
-	o.Connection.Register(o.Notifier)
+	conn.Register(o.Notifier)
 
-	initial, err := o.Connection.Monitor(DefaultDatabase, "", requests)
+	initial, err := conn.Monitor(DefaultDatabase, "", requests)
 	if err != nil {
-		o.ErrorChan <- err
+		return err
 	}
 
-	o.Config.InitializeCache(initial)
+	if o.Config.IsInitialized() {
+		o.Config.ResyncCache(initial)
+	} else {
+		o.Config.InitializeCache(initial)
+	}
 
-	go o.receivedMonitorUpdate()
+	return nil
 }
 
 func (o *Client) StartSystem() error {
 	log.Debug("Starting OVS system...")
 
-	cmd := exec.Command("/bin/sh", StartOVS)
+	cmd := exec.Command("/bin/sh", o.StartScript)
 
 	_, err := cmd.Output()
 	if err != nil {
@@ -314,7 +1857,7 @@ func (o *Client) StartSystem() error {
 func (o *Client) StopSystem() error {
 	log.Debug("Stopping OVS system...")
 
-	cmd := exec.Command("/bin/sh", StopOVS)
+	cmd := exec.Command("/bin/sh", o.StopScript)
 
 	_, err := cmd.Output()
 	if err != nil {
@@ -327,7 +1870,7 @@ func (o *Client) StopSystem() error {
 func (o *Client) RestartSystem() error {
 	log.Debug("Restarting OVS system...")
 
-	cmd := exec.Command("/bin/sh", RestartOVS)
+	cmd := exec.Command("/bin/sh", o.RestartScript)
 
 	_, err := cmd.Output()
 	if err != nil {
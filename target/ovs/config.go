@@ -20,13 +20,10 @@ import (
 	"fmt"
 	"github.com/socketplane/libovsdb"
 	"reflect"
+	"sort"
 	"strconv"
-	"strings"
 	"sync"
-)
-
-const (
-	primaryControllerName = "main"
+	"time"
 )
 
 type ConfigCallback func(config *Config) error
@@ -50,34 +47,54 @@ func (s *System) String() string {
 	return fmt.Sprintf("System(uuid: \"%v\", Version: \"%v\", Hostname: \"%v\")", s.uuid, s.Version, s.Hostname)
 }
 
+// OpenFlowControllerTarget is the target of a controller. Protocol is tcp, ssl or unix, for which Address is the socket
+// path. A passive target listens on Address, or on all addresses if it is empty.
 type OpenFlowControllerTarget struct {
 	Address  string
 	Protocol string
 	Port     uint16
+	Passive  bool
 }
 
-func (t *OpenFlowControllerTarget) String() string {
-	return fmt.Sprintf("OpenFlowControllerTarget(Address: \"%v\", Protocol: \"%v\", Port: \"%v\")", t.Address, t.Protocol, t.Port)
+// OpenFlowController is a row of the Controller table. Controllers are cached by uuid and published as the connections
+// of an OpenConfig controller named after their bridge, numbered in the order of their uuids.
+type OpenFlowController struct {
+	uuid         string
+	Bridge       string
+	ConnectionID uint8
+	Connected    bool
+	Target       *OpenFlowControllerTarget
+
+	// MaxBackoff and InactivityProbe are in milliseconds, nil if OVS uses its default.
+	MaxBackoff      *uint32
+	InactivityProbe *uint32
+
+	// Role and Status are reported by OVS, the status keys are only present while they apply.
+	Role   string
+	Status ControllerStatus
 }
 
-type OpenFlowController struct {
-	uuid      string
-	Name      string
-	Connected bool
-	Target    *OpenFlowControllerTarget
+// ControllerStatus is the status column of a controller.
+type ControllerStatus struct {
+	State                  string
+	SecondsSinceConnect    *uint64
+	SecondsSinceDisconnect *uint64
+	LastError              string
 }
 
 func (c *OpenFlowController) Equal(comp *OpenFlowController) bool {
 	log.Debugf("COMPARE: %v against %v", c, comp)
 
 	switch {
-	case c.Name != comp.Name:
+	case c.Bridge != comp.Bridge:
 		return false
-	case c.Target.Protocol != comp.Target.Protocol:
+	case c.ConnectionID != comp.ConnectionID:
 		return false
-	case c.Target.Port != comp.Target.Port:
+	case !c.Target.Equal(comp.Target):
 		return false
-	case c.Target.Address != comp.Target.Address:
+	case !equalUint32(c.MaxBackoff, comp.MaxBackoff):
+		return false
+	case !equalUint32(c.InactivityProbe, comp.InactivityProbe):
 		return false
 	default:
 		return true
@@ -85,20 +102,11 @@ func (c *OpenFlowController) Equal(comp *OpenFlowController) bool {
 }
 
 func (c *OpenFlowController) String() string {
-	return fmt.Sprintf("OpenFlowController(uuid: \"%v\", Name: \"%v\", Connected: \"%v\", Address: \"%v\", Protocol: \"%v\", Port: \"%v\")", c.uuid, c.Name, c.Connected, c.Target.Address, c.Target.Protocol, c.Target.Port)
-}
-
-func ParseOpenFlowControllerTarget(target string) (*OpenFlowControllerTarget, error) {
-	s := strings.Split(target, ":")
-
-	port, err := strconv.ParseUint(s[2], 10, 16)
-	if err != nil {
-		return nil, err
-	}
-
-	return &OpenFlowControllerTarget{Address: s[1], Protocol: s[0], Port: uint16(port)}, nil
+	return fmt.Sprintf("OpenFlowController(uuid: \"%v\", Bridge: \"%v\", ConnectionID: \"%v\", Connected: \"%v\", Target: \"%v\")", c.uuid, c.Bridge, c.ConnectionID, c.Connected, c.Target)
 }
 
+// InterfaceStatistics are the counters of the statistics column. Counters which not every datapath reports are nil if
+// they are missing, Counters holds every reported key.
 type InterfaceStatistics struct {
 	ReceivedPackets    uint64
 	ReceivedErrors     uint64
@@ -106,20 +114,111 @@ type InterfaceStatistics struct {
 	TransmittedPackets uint64
 	TransmittedErrors  uint64
 	TransmittedDropped uint64
+
+	ReceivedBytes               *uint64
+	ReceivedCRCErrors           *uint64
+	ReceivedMulticastPackets    *uint64
+	ReceivedBroadcastPackets    *uint64
+	TransmittedBytes            *uint64
+	TransmittedMulticastPackets *uint64
+	TransmittedBroadcastPackets *uint64
+	ReceivedOversizeErrors      *uint64
+	ReceivedUndersizedErrors    *uint64
+	ReceivedJabberErrors        *uint64
+	ReceivedFragmentedErrors    *uint64
+
+	Counters map[string]uint64
 }
 
 func (s *InterfaceStatistics) String() string {
-	return fmt.Sprintf("InterfaceStatistics(ReceivedPackets: \"%v\", ReceivedErrors: \"%v\", ReceivedDropped: \"%v\", TransmittedPackets: \"%v\", TransmittedErrors: \"%v\", TransmittedDropped: \"%v\")",
-		s.ReceivedPackets, s.ReceivedErrors, s.ReceivedDropped, s.TransmittedPackets, s.TransmittedErrors, s.TransmittedDropped)
+	return fmt.Sprintf("InterfaceStatistics(ReceivedPackets: \"%v\", ReceivedErrors: \"%v\", ReceivedDropped: \"%v\", TransmittedPackets: \"%v\", TransmittedErrors: \"%v\", TransmittedDropped: \"%v\", Counters: \"%v\")",
+		s.ReceivedPackets, s.ReceivedErrors, s.ReceivedDropped, s.TransmittedPackets, s.TransmittedErrors, s.TransmittedDropped, s.Counters)
+}
+
+// InterfaceTunnel holds the tunnel options of a vxlan, gre, geneve or other tunnel interface. Empty values are unset.
+type InterfaceTunnel struct {
+	RemoteIP string
+	LocalIP  string
+	Key      string
+	DstPort  uint16
+}
+
+func (t *InterfaceTunnel) IsSet() bool {
+	return *t != InterfaceTunnel{}
+}
+
+// options returns the tunnel options in the notation of the options column.
+func (t *InterfaceTunnel) options() map[string]string {
+	options := make(map[string]string)
+	if t.RemoteIP != "" {
+		options["remote_ip"] = t.RemoteIP
+	}
+	if t.LocalIP != "" {
+		options["local_ip"] = t.LocalIP
+	}
+	if t.Key != "" {
+		options["key"] = t.Key
+	}
+	if t.DstPort != 0 {
+		options["dst_port"] = strconv.FormatUint(uint64(t.DstPort), 10)
+	}
+
+	return options
+}
+
+func (t *InterfaceTunnel) String() string {
+	return fmt.Sprintf("InterfaceTunnel(RemoteIP: \"%v\", LocalIP: \"%v\", Key: \"%v\", DstPort: \"%v\")", t.RemoteIP, t.LocalIP, t.Key, t.DstPort)
 }
 
 type Interface struct {
 	uuid        string
 	Name        string
+	Type        string
 	MTU         uint16
 	AdminStatus string
 	LinkStatus  string
+	MAC         string
+	IfIndex     *uint32
+	LinkSpeed   *uint64
+	Duplex      string
+	LinkResets  *uint64
 	Statistics  *InterfaceStatistics
+
+	// Enabled is the configured admin state, OVS reports it as admin_state.
+	Enabled bool
+	// Bridge is the bridge of the port of the interface, or the bridge a new interface is to be added to.
+	Bridge string
+	// LastChange is the time of the last observed link state transition in nanoseconds since the Unix epoch, or 0 if
+	// none has been observed yet.
+	LastChange uint64
+	// Options is the options column, of which the tunnel options are also decoded into Tunnel.
+	Options map[string]string
+	Tunnel  InterfaceTunnel
+	// IngressPolicingRate and IngressPolicingBurst are in kbps and kb, 0 disables policing and uses the default burst.
+	IngressPolicingRate  uint32
+	IngressPolicingBurst uint32
+	// LACPCurrent reports whether the LACP partner of a bond member is in sync, nil if the member does not run LACP.
+	LACPCurrent *bool
+	// LLDPEnable is the enable key of the lldp column.
+	LLDPEnable bool
+}
+
+// TunnelOptions returns the options column with the tunnel options replaced by those of Tunnel, so that options which
+// are not modelled are kept when the interface is written.
+func (i *Interface) TunnelOptions() map[string]string {
+	mapped := MappedKeys(InterfaceTable, "options")
+	options := make(map[string]string)
+	for key, value := range i.Options {
+		if !mapped[key] {
+			options[key] = value
+		}
+	}
+
+	for key, value := range i.Tunnel.options() {
+		options[key] = value
+	}
+
+	return options
 }
 
 func (i *Interface) Equal(comp *Interface) bool {
@@ -128,40 +227,219 @@ func (i *Interface) Equal(comp *Interface) bool {
 		return false
 	case i.MTU != comp.MTU:
 		return false
+	case i.Enabled != comp.Enabled:
+		return false
+	case i.Type != comp.Type:
+		return false
+	case i.Tunnel != comp.Tunnel:
+		return false
+	case i.IngressPolicingRate != comp.IngressPolicingRate:
+		return false
+	case i.IngressPolicingBurst != comp.IngressPolicingBurst:
+		return false
+	case i.LLDPEnable != comp.LLDPEnable:
+		return false
 	default:
 		return true
 	}
 }
 
 func (i *Interface) String() string {
-	return fmt.Sprintf("Interface(uuid: \"%v\", Name: \"%v\", MTU: \"%v\", AdminStatus: \"%v\", LinkStatus: \"%v\", Statistics: \"%v\")", i.uuid, i.Name, i.MTU, i.AdminStatus, i.LinkStatus, i.Statistics)
+	return fmt.Sprintf("Interface(uuid: \"%v\", Name: \"%v\", Type: \"%v\", MTU: \"%v\", Enabled: \"%v\", AdminStatus: \"%v\", LinkStatus: \"%v\", MAC: \"%v\", LastChange: \"%v\", Tunnel: \"%v\", Statistics: \"%v\")", i.uuid, i.Name, i.Type, i.MTU, i.Enabled, i.AdminStatus, i.LinkStatus, i.MAC, i.LastChange, &i.Tunnel, i.Statistics)
+}
+
+// Port groups the interfaces of a bridge port, which is a single interface unless the port is a bond.
+type Port struct {
+	uuid       string
+	Name       string
+	Interfaces []string
+	VLANMode   string
+	Tag        *uint16
+	Trunks     []uint16
+	// QoS is the egress QoS of the port, nil if it has none.
+	QoS *QoS
+	// Bond is the bond configuration, which only applies to ports with several interfaces. BondActiveSlave is the MAC
+	// address of the active member of an active-backup bond.
+	Bond            Bond
+	BondActiveSlave string
+	// SpanningTree is the spanning tree configuration and state of the port, see PortSpanningTree.
+	SpanningTree PortSpanningTree
+}
+
+func (p *Port) Equal(comp *Port) bool {
+	switch {
+	case p.Name != comp.Name:
+		return false
+	case p.VLANMode != comp.VLANMode:
+		return false
+	case (p.Tag == nil) != (comp.Tag == nil):
+		return false
+	case p.Tag != nil && *p.Tag != *comp.Tag:
+		return false
+	case !equalVLANs(p.Trunks, comp.Trunks):
+		return false
+	default:
+		return true
+	}
+}
+
+func (p *Port) String() string {
+	var tag string
+	if p.Tag != nil {
+		tag = strconv.FormatUint(uint64(*p.Tag), 10)
+	}
+
+	return fmt.Sprintf("Port(uuid: \"%v\", Name: \"%v\", Interfaces: \"%v\", VLANMode: \"%v\", Tag: \"%v\", Trunks: \"%v\")", p.uuid, p.Name, p.Interfaces, p.VLANMode, tag, p.Trunks)
+}
+
+type Bridge struct {
+	uuid        string
+	Name        string
+	Ports       []string
+	Mirrors     []string
+	Controllers []string
+	FailMode    string
+	Protocols   []string
+	// DatapathID is the datapath ID in use, ConfiguredDatapathID the one set in other_config if any.
+	DatapathID           string
+	ConfiguredDatapathID string
+	// SFlow, NetFlow and IPFIX are the flow exporters of the bridge, nil if it has none.
+	SFlow   *SFlow
+	NetFlow *NetFlow
+	IPFIX   *IPFIX
+	// STPEnable and RSTPEnable enable the spanning tree protocols, with STP and RSTP holding their settings.
+	STPEnable    bool
+	RSTPEnable   bool
+	STP          SpanningTreeConfig
+	RSTP         SpanningTreeConfig
+	SpanningTree SpanningTreeStatus
+}
+
+func (b *Bridge) Equal(comp *Bridge) bool {
+	switch {
+	case b.Name != comp.Name:
+		return false
+	case b.FailMode != comp.FailMode:
+		return false
+	case b.ConfiguredDatapathID != comp.ConfiguredDatapathID:
+		return false
+	default:
+		return true
+	}
+}
+
+func (b *Bridge) String() string {
+	return fmt.Sprintf("Bridge(uuid: \"%v\", Name: \"%v\", Ports: \"%v\", FailMode: \"%v\", DatapathID: \"%v\", ConfiguredDatapathID: \"%v\")", b.uuid, b.Name, b.Ports, b.FailMode, b.DatapathID, b.ConfiguredDatapathID)
+}
+
+type linkState struct {
+	status  string
+	changed uint64
 }
 
 type ObjectCache struct {
 	System      *System
 	Controllers map[string]*OpenFlowController
 	Interfaces  map[string]*Interface
+	Ports       map[string]*Port
+	Bridges     map[string]*Bridge
+	Mirrors     map[string]*Mirror
 }
-type Config struct {
-	rawCache    map[string]map[string]libovsdb.Row
-	ObjCache    *ObjectCache
-	mu          sync.RWMutex
-	callback    ConfigCallback
-	Initialized chan struct{}
+
+func newObjectCache() *ObjectCache {
+	return &ObjectCache{System: &System{}, Controllers: make(map[string]*OpenFlowController), Interfaces: make(map[string]*Interface),
+		Ports: make(map[string]*Port), Bridges: make(map[string]*Bridge), Mirrors: make(map[string]*Mirror)}
 }
 
-func (c *Config) getInterfaceByUUID(uuid string) *Interface {
-	for _, i := range c.ObjCache.Interfaces {
-		if i.uuid == uuid {
-			return i
+// resolveBridges sets the bridge of every interface, mirror and controller from the ports, mirrors and controllers of
+// the bridges.
+func (c *ObjectCache) resolveBridges() {
+	for _, i := range c.Interfaces {
+		i.Bridge = ""
+		if b := c.BridgeOfInterface(i); b != nil {
+			i.Bridge = b.Name
+		}
+	}
+
+	for _, m := range c.Mirrors {
+		m.Bridge = ""
+		if b := c.BridgeOfMirror(m); b != nil {
+			m.Bridge = b.Name
+		}
+	}
+
+	for _, controller := range c.Controllers {
+		controller.Bridge, controller.ConnectionID = "", 0
+	}
+
+	for _, b := range c.Bridges {
+		uuids := append([]string(nil), b.Controllers...)
+		sort.Strings(uuids)
+
+		for id, uuid := range uuids {
+			if controller, ok := c.Controllers[uuid]; ok {
+				controller.Bridge, controller.ConnectionID = b.Name, uint8(id)
+			}
+		}
+	}
+}
+
+// BridgeController returns the controller of a bridge published as the given connection, or nil if there is none.
+func (c *ObjectCache) BridgeController(bridge string, connectionID uint8) *OpenFlowController {
+	for _, controller := range c.Controllers {
+		if controller.Bridge == bridge && controller.ConnectionID == connectionID {
+			return controller
+		}
+	}
+
+	return nil
+}
+
+// PortOfInterface returns the port an interface belongs to, or nil if it is not part of any port.
+func (c *ObjectCache) PortOfInterface(interf *Interface) *Port {
+	for _, p := range c.Ports {
+		for _, uuid := range p.Interfaces {
+			if uuid == interf.uuid {
+				return p
+			}
+		}
+	}
+
+	return nil
+}
+
+// BridgeOfInterface returns the bridge an interface belongs to, or nil if it is not part of any bridge.
+func (c *ObjectCache) BridgeOfInterface(interf *Interface) *Bridge {
+	p := c.PortOfInterface(interf)
+	if p == nil {
+		return nil
+	}
+
+	for _, b := range c.Bridges {
+		for _, uuid := range b.Ports {
+			if uuid == p.uuid {
+				return b
+			}
 		}
 	}
 
 	return nil
 }
 
-func (c *Config) getControllerByUUID(uuid string) *OpenFlowController {
-	for _, i := range c.ObjCache.Controllers {
+type Config struct {
+	rawCache       map[string]map[string]libovsdb.Row
+	ObjCache       *ObjectCache
+	schema         *libovsdb.DatabaseSchema
+	linkStates     map[string]*linkState
+	stpStates      map[string]*stpPortState
+	topologyStates map[string]*topologyState
+	mu             sync.RWMutex
+	callback       ConfigCallback
+	Initialized    chan struct{}
+}
+
+func (c *Config) getInterfaceByUUID(uuid string) *Interface {
+	for _, i := range c.ObjCache.Interfaces {
 		if i.uuid == uuid {
 			return i
 		}
@@ -171,13 +449,13 @@ func (c *Config) getControllerByUUID(uuid string) *OpenFlowController {
 }
 
 func NewConfig() *Config {
-	c := &Config{rawCache: make(map[string]map[string]libovsdb.Row), Initialized: make(chan struct{}),
-		ObjCache: &ObjectCache{System: &System{}, Controllers: make(map[string]*OpenFlowController), Interfaces: make(map[string]*Interface)}}
+	c := &Config{rawCache: make(map[string]map[string]libovsdb.Row), Initialized: make(chan struct{}), linkStates: make(map[string]*linkState),
+		stpStates: make(map[string]*stpPortState), topologyStates: make(map[string]*topologyState), ObjCache: newObjectCache()}
 	return c
 }
 
 func CopyConfigObjectCache(c *ObjectCache) *ObjectCache {
-	cache := &ObjectCache{System: &System{}, Controllers: make(map[string]*OpenFlowController), Interfaces: make(map[string]*Interface)}
+	cache := newObjectCache()
 
 	cache.System = &System{
 		uuid:     c.System.uuid,
@@ -185,24 +463,48 @@ func CopyConfigObjectCache(c *ObjectCache) *ObjectCache {
 		Hostname: c.System.Hostname,
 	}
 
-	cache.Controllers[primaryControllerName] = &OpenFlowController{
-		uuid:      c.Controllers[primaryControllerName].uuid,
-		Name:      c.Controllers[primaryControllerName].Name,
-		Connected: c.Controllers[primaryControllerName].Connected,
-		Target: &OpenFlowControllerTarget{
-			Address:  c.Controllers[primaryControllerName].Target.Address,
-			Port:     c.Controllers[primaryControllerName].Target.Port,
-			Protocol: c.Controllers[primaryControllerName].Target.Protocol,
-		},
+	for uuid, controller := range c.Controllers {
+		cache.Controllers[uuid] = &OpenFlowController{
+			uuid:         controller.uuid,
+			Bridge:       controller.Bridge,
+			ConnectionID: controller.ConnectionID,
+			Connected:    controller.Connected,
+			Target: &OpenFlowControllerTarget{
+				Address:  controller.Target.Address,
+				Port:     controller.Target.Port,
+				Protocol: controller.Target.Protocol,
+				Passive:  controller.Target.Passive,
+			},
+			MaxBackoff:      controller.MaxBackoff,
+			InactivityProbe: controller.InactivityProbe,
+			Role:            controller.Role,
+			Status:          controller.Status,
+		}
 	}
 
 	for _, i := range c.Interfaces {
 		cache.Interfaces[i.Name] = &Interface{
 			uuid:        i.uuid,
 			Name:        i.Name,
+			Type:        i.Type,
+			Bridge:      i.Bridge,
 			MTU:         i.MTU,
 			AdminStatus: i.AdminStatus,
 			LinkStatus:  i.LinkStatus,
+			Enabled:     i.Enabled,
+			MAC:         i.MAC,
+			IfIndex:     i.IfIndex,
+			LinkSpeed:   i.LinkSpeed,
+			Duplex:      i.Duplex,
+			LinkResets:  i.LinkResets,
+			LastChange:  i.LastChange,
+			Options:     i.Options,
+			Tunnel:      i.Tunnel,
+
+			IngressPolicingRate:  i.IngressPolicingRate,
+			IngressPolicingBurst: i.IngressPolicingBurst,
+			LACPCurrent:          i.LACPCurrent,
+			LLDPEnable:           i.LLDPEnable,
 			Statistics: &InterfaceStatistics{
 				ReceivedPackets:    i.Statistics.ReceivedPackets,
 				ReceivedErrors:     i.Statistics.ReceivedErrors,
@@ -210,78 +512,260 @@ func CopyConfigObjectCache(c *ObjectCache) *ObjectCache {
 				TransmittedPackets: i.Statistics.TransmittedPackets,
 				TransmittedErrors:  i.Statistics.TransmittedErrors,
 				TransmittedDropped: i.Statistics.TransmittedDropped,
+
+				ReceivedBytes:               i.Statistics.ReceivedBytes,
+				ReceivedCRCErrors:           i.Statistics.ReceivedCRCErrors,
+				ReceivedMulticastPackets:    i.Statistics.ReceivedMulticastPackets,
+				ReceivedBroadcastPackets:    i.Statistics.ReceivedBroadcastPackets,
+				TransmittedBytes:            i.Statistics.TransmittedBytes,
+				TransmittedMulticastPackets: i.Statistics.TransmittedMulticastPackets,
+				TransmittedBroadcastPackets: i.Statistics.TransmittedBroadcastPackets,
+				ReceivedOversizeErrors:      i.Statistics.ReceivedOversizeErrors,
+				ReceivedUndersizedErrors:    i.Statistics.ReceivedUndersizedErrors,
+				ReceivedJabberErrors:        i.Statistics.ReceivedJabberErrors,
+				ReceivedFragmentedErrors:    i.Statistics.ReceivedFragmentedErrors,
+
+				Counters: i.Statistics.Counters,
 			},
 		}
 	}
 
+	for _, p := range c.Ports {
+		cache.Ports[p.Name] = &Port{
+			uuid:       p.uuid,
+			Name:       p.Name,
+			Interfaces: append([]string(nil), p.Interfaces...),
+			VLANMode:   p.VLANMode,
+			Tag:        p.Tag,
+			Trunks:     append([]uint16(nil), p.Trunks...),
+			QoS:        copyQoS(p.QoS),
+
+			Bond:            p.Bond,
+			BondActiveSlave: p.BondActiveSlave,
+			SpanningTree:    p.SpanningTree,
+		}
+	}
+
+	for _, b := range c.Bridges {
+		cache.Bridges[b.Name] = &Bridge{
+			uuid:  b.uuid,
+			Name:  b.Name,
+			Ports: append([]string(nil), b.Ports...),
+
+			Mirrors:              append([]string(nil), b.Mirrors...),
+			Controllers:          append([]string(nil), b.Controllers...),
+			FailMode:             b.FailMode,
+			Protocols:            append([]string(nil), b.Protocols...),
+			DatapathID:           b.DatapathID,
+			ConfiguredDatapathID: b.ConfiguredDatapathID,
+
+			STPEnable:    b.STPEnable,
+			RSTPEnable:   b.RSTPEnable,
+			STP:          b.STP,
+			RSTP:         b.RSTP,
+			SpanningTree: b.SpanningTree,
+		}
+		copyFlowExports(cache.Bridges[b.Name], b)
+	}
+
+	for _, m := range c.Mirrors {
+		cache.Mirrors[m.Name] = copyMirror(m)
+	}
+
 	return cache
 }
 
-func OverwriteObjectCacheWithJSON(cache *ObjectCache, jsonConfig map[string]interface{}) {
-	for _, i := range jsonConfig["openconfig-platform:components"].(map[string]interface{})["component"].([]interface{}) {
-		if i.(map[string]interface{})["config"].(map[string]interface{})["name"] == "os" {
-			cache.System.Version = i.(map[string]interface{})["state"].(map[string]interface{})["description"].(string)
+// OverwriteObjectCacheWithJSON applies the IETF JSON tree to the cache. Invalid parts are logged and skipped, except for
+// an invalid OpenFlow agent, which is returned as an error.
+func OverwriteObjectCacheWithJSON(cache *ObjectCache, jsonConfig map[string]interface{}) error {
+	components, _ := jsonConfig["openconfig-platform:components"].(map[string]interface{})
+	componentList, _ := components["component"].([]interface{})
+	for _, i := range componentList {
+		component, _ := i.(map[string]interface{})
+		config, _ := component["config"].(map[string]interface{})
+		state, _ := component["state"].(map[string]interface{})
+		if version, ok := state["description"].(string); ok && config["name"] == "os" {
+			cache.System.Version = version
 		}
 	}
 
-	cache.System.Hostname = jsonConfig["openconfig-system:system"].(map[string]interface{})["config"].(map[string]interface{})["hostname"].(string)
+	system, _ := jsonConfig["openconfig-system:system"].(map[string]interface{})
+	if err := ReadRowJSON(SystemTable, cache.System, system); err != nil {
+		log.Errorf("Unable to read system: %v", err)
+	}
+	openflow, _ := system["openconfig-openflow:openflow"].(map[string]interface{})
+	controllers, _ := openflow["controllers"].(map[string]interface{})
+	controllerList, _ := controllers["controller"].([]interface{})
+
+	// Targets which cannot be fully published, such as those with a host name, are kept unless they are changed.
+	for _, i := range controllerList {
+		entry, _ := i.(map[string]interface{})
+		config, _ := entry["config"].(map[string]interface{})
+		connections, _ := entry["connections"].(map[string]interface{})
+		connectionList, _ := connections["connection"].([]interface{})
+
+		name, _ := config["name"].(string)
+
+		for _, j := range connectionList {
+			connection, _ := j.(map[string]interface{})
+			connectionConfig, _ := connection["config"].(map[string]interface{})
+			id, ok := connectionConfig["aux-id"].(uint8)
+			if !ok {
+				continue
+			}
 
-	for _, i := range jsonConfig["openconfig-system:system"].(map[string]interface{})["openconfig-openflow:openflow"].(map[string]interface{})["controllers"].(map[string]interface{})["controller"].([]interface{}) {
-		name := i.(map[string]interface{})["config"].(map[string]interface{})["name"].(string)
+			controller := cache.BridgeController(name, id)
+			if controller == nil {
+				continue
+			}
 
-		for _, j := range i.(map[string]interface{})["connections"].(map[string]interface{})["connection"].([]interface{}) {
-			cache.Controllers[name].Target.Address = j.(map[string]interface{})["config"].(map[string]interface{})["address"].(string)
-			cache.Controllers[name].Target.Port = j.(map[string]interface{})["config"].(map[string]interface{})["port"].(uint16)
+			target, err := targetFromJSON(connection)
+			if err != nil {
+				log.Errorf("Unable to read target of controller %v connection %v: %v", name, id, err)
+				continue
+			}
 
-			switch protocol := strings.ToLower(j.(map[string]interface{})["config"].(map[string]interface{})["transport"].(string)); protocol {
-			case "tls":
-				cache.Controllers[name].Target.Protocol = "ssl"
-			default:
-				cache.Controllers[name].Target.Protocol = protocol
+			if target.Equal(controller.Target.published()) {
+				continue
 			}
 
-			cache.Controllers[name].Connected = j.(map[string]interface{})["state"].(map[string]interface{})["connected"].(bool)
+			if _, err := ParseOpenFlowControllerTarget(target.String()); err != nil {
+				log.Errorf("Unable to set target of controller %v connection %v: %v", name, id, err)
+				continue
+			}
+
+			controller.Target = target
 		}
 	}
 
-	for _, i := range jsonConfig["openconfig-interfaces:interfaces"].(map[string]interface{})["interface"].([]interface{}) {
-		name := i.(map[string]interface{})["config"].(map[string]interface{})["name"].(string)
+	agent, _ := openflow["agent"].(map[string]interface{})
+	agentConfig, _ := agent["config"].(map[string]interface{})
 
-		cache.Interfaces[name].Name = name
-		cache.Interfaces[name].MTU = i.(map[string]interface{})["config"].(map[string]interface{})["mtu"].(uint16)
+	if cache.OpenFlowAgent() != nil {
+		if err := cache.SetOpenFlowAgent(parseOpenFlowAgent(agentConfig)); err != nil {
+			return fmt.Errorf("unable to read OpenFlow agent: %v", err)
+		}
+	}
+
+	// Interfaces missing in the config have been deleted, new ones are created without a uuid.
+	interfaces, _ := jsonConfig["openconfig-interfaces:interfaces"].(map[string]interface{})
+	list, _ := interfaces["interface"].([]interface{})
+	configured := make(map[string]bool)
+
+	for _, i := range list {
+		// Aggregate interfaces are the bond ports, see overwriteBondsWithJSON.
+		entry, _ := i.(map[string]interface{})
+		if aggregationConfig(entry) != nil {
+			continue
+		}
 
-		if _, ok := i.(map[string]interface{})["state"].(map[string]interface{})["admin-status"]; ok {
-			cache.Interfaces[name].AdminStatus = i.(map[string]interface{})["state"].(map[string]interface{})["admin-status"].(string)
+		config, _ := entry["config"].(map[string]interface{})
+		state, _ := entry["state"].(map[string]interface{})
+		ovs, _ := entry["ovs-interfaces:ovs"].(map[string]interface{})
+		ovsConfig, _ := ovs["config"].(map[string]interface{})
+		ethernet, _ := entry["openconfig-if-ethernet:ethernet"].(map[string]interface{})
+		switchedVLAN, _ := ethernet["openconfig-vlan:switched-vlan"].(map[string]interface{})
+		vlanConfig, _ := switchedVLAN["config"].(map[string]interface{})
+		qos, _ := entry["ovs-qos:qos"].(map[string]interface{})
+		ingressPolicing, _ := qos["ingress-policing"].(map[string]interface{})
+		policingConfig, _ := ingressPolicing["config"].(map[string]interface{})
+
+		name, ok := config["name"].(string)
+		if !ok {
+			continue
 		}
+		configured[name] = true
 
-		if _, ok := i.(map[string]interface{})["state"].(map[string]interface{})["oper-status"]; ok {
-			cache.Interfaces[name].LinkStatus = i.(map[string]interface{})["state"].(map[string]interface{})["oper-status"].(string)
+		if _, ok := cache.Interfaces[name]; !ok {
+			cache.Interfaces[name] = &Interface{Name: name, Enabled: true, Statistics: &InterfaceStatistics{}}
 		}
 
-		if inPkts, err := strconv.ParseUint(i.(map[string]interface{})["state"].(map[string]interface{})["counters"].(map[string]interface{})["in-pkts"].(string), 10, 64); err == nil {
-			cache.Interfaces[name].Statistics.ReceivedPackets = inPkts
+		cache.Interfaces[name].Name = name
+
+		// Tunnel options which are missing in the configuration are removed.
+		cache.Interfaces[name].Tunnel = InterfaceTunnel{}
+		if err := ReadRowJSON(InterfaceTable, cache.Interfaces[name], entry); err != nil {
+			log.Errorf("Unable to read interface %v: %v", name, err)
 		}
 
-		if inErrs, err := strconv.ParseUint(i.(map[string]interface{})["state"].(map[string]interface{})["counters"].(map[string]interface{})["in-errors"].(string), 10, 64); err == nil {
-			cache.Interfaces[name].Statistics.ReceivedErrors = inErrs
+		if bridge, ok := ovsConfig["bridge"].(string); ok {
+			cache.Interfaces[name].Bridge = bridge
 		}
 
-		if inDisc, err := strconv.ParseUint(i.(map[string]interface{})["state"].(map[string]interface{})["counters"].(map[string]interface{})["in-discards"].(string), 10, 64); err == nil {
-			cache.Interfaces[name].Statistics.ReceivedDropped = inDisc
+		// The VLAN columns are only rewritten if the configuration differs, so that modes which read back the same,
+		// such as native-tagged, are kept.
+		if vlan, err := parseSwitchedVLAN(vlanConfig); err != nil {
+			log.Errorf("Unable to read switched VLAN of interface %v: %v", name, err)
+		} else if port := cache.vlanPort(cache.Interfaces[name]); port != nil && !vlan.Equal(port.SwitchedVLAN()) {
+			port.SetSwitchedVLAN(vlan)
 		}
 
-		if outPkts, err := strconv.ParseUint(i.(map[string]interface{})["state"].(map[string]interface{})["counters"].(map[string]interface{})["out-pkts"].(string), 10, 64); err == nil {
-			cache.Interfaces[name].Statistics.TransmittedPackets = outPkts
+		if port := cache.vlanPort(cache.Interfaces[name]); port != nil {
+			if err := readQoSJSON(port, qos); err != nil {
+				log.Errorf("Unable to read QoS of interface %v: %v", name, err)
+			}
 		}
 
-		if outErrs, err := strconv.ParseUint(i.(map[string]interface{})["state"].(map[string]interface{})["counters"].(map[string]interface{})["out-errors"].(string), 10, 64); err == nil {
-			cache.Interfaces[name].Statistics.TransmittedErrors = outErrs
+		cache.Interfaces[name].IngressPolicingRate, _ = policingConfig["rate"].(uint32)
+		cache.Interfaces[name].IngressPolicingBurst, _ = policingConfig["burst"].(uint32)
+
+		if adminStatus, ok := state["admin-status"].(string); ok {
+			cache.Interfaces[name].AdminStatus = adminStatus
 		}
 
-		if outDisc, err := strconv.ParseUint(i.(map[string]interface{})["state"].(map[string]interface{})["counters"].(map[string]interface{})["out-discards"].(string), 10, 64); err == nil {
-			cache.Interfaces[name].Statistics.TransmittedDropped = outDisc
+		if linkStatus, ok := state["oper-status"].(string); ok {
+			cache.Interfaces[name].LinkStatus = linkStatus
+		}
+	}
+
+	for name := range cache.Interfaces {
+		if !configured[name] {
+			delete(cache.Interfaces, name)
 		}
 	}
+
+	overwriteBondsWithJSON(cache, jsonConfig)
+	overwriteMirrorsWithJSON(cache, jsonConfig)
+	overwriteFlowExportsWithJSON(cache, jsonConfig)
+	overwriteSpanningTreeWithJSON(cache, jsonConfig)
+	overwriteLLDPWithJSON(cache, jsonConfig)
+
+	return nil
+}
+
+// targetFromJSON reads the target of a controller connection of the IETF JSON tree.
+func targetFromJSON(connection map[string]interface{}) (*OpenFlowControllerTarget, error) {
+	config, _ := connection["config"].(map[string]interface{})
+	ovs, _ := connection["ovs-system:ovs"].(map[string]interface{})
+	ovsConfig, _ := ovs["config"].(map[string]interface{})
+
+	t := &OpenFlowControllerTarget{Protocol: RemoteTCP, Port: defaultOpenFlowPort}
+	if passive, ok := ovsConfig["passive"].(bool); ok {
+		t.Passive = passive
+	}
+
+	if socket, ok := ovsConfig["unix-socket"].(string); ok && socket != "" {
+		t.Protocol, t.Address, t.Port = RemoteUnix, socket, 0
+		return t, nil
+	}
+
+	if address, ok := config["address"].(string); ok {
+		t.Address = address
+	}
+
+	if port, ok := config["port"].(uint16); ok {
+		t.Port = port
+	}
+
+	switch transport, _ := config["transport"].(string); transport {
+	case "", "TCP":
+	case "TLS":
+		t.Protocol = RemoteSSL
+	default:
+		return nil, fmt.Errorf("unsupported transport %v", transport)
+	}
+
+	return t, nil
 }
 
 func (c *Config) InitializeCache(updates *libovsdb.TableUpdates) {
@@ -289,12 +773,38 @@ func (c *Config) InitializeCache(updates *libovsdb.TableUpdates) {
 	close(c.Initialized)
 }
 
+// IsInitialized reports whether the cache has been initialized from a first initial dump.
+func (c *Config) IsInitialized() bool {
+	select {
+	case <-c.Initialized:
+		return true
+	default:
+		return false
+	}
+}
+
+// ResyncCache replaces the whole cache with the initial dump of a new monitor, so that rows which have been deleted
+// while the connection was down disappear as well.
+func (c *Config) ResyncCache(updates *libovsdb.TableUpdates) {
+	c.syncCache(updates, true)
+}
+
 func (c *Config) SyncCache(updates *libovsdb.TableUpdates) {
+	c.syncCache(updates, false)
+}
+
+// syncCache applies the updates to the cache and then calls the callback. The callback is called without holding the
+// lock, as publishing waits for the gNMI service, whose Sets overwrite the cache.
+func (c *Config) syncCache(updates *libovsdb.TableUpdates, reset bool) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 
 	log.Debug("Syncing config cache...")
 
+	if reset {
+		c.rawCache = make(map[string]map[string]libovsdb.Row)
+		c.ObjCache = newObjectCache()
+	}
+
 	for tableName, tableUpdate := range updates.Updates {
 		c.initializeCacheTableIfNotExists(tableName)
 
@@ -309,75 +819,140 @@ func (c *Config) SyncCache(updates *libovsdb.TableUpdates) {
 			} else {
 				delete(c.rawCache[tableName], uuid)
 				err := c.DeleteObjectCacheEntry(tableName, uuid)
-				log.Error(err)
+				if err != nil {
+					log.Error(err)
+				}
 			}
 		}
 	}
 
-	if c.callback != nil {
-		if err := c.callback(c); err != nil {
-			log.Errorf("Config callback error: %v", err)
-		}
-	}
+	c.ObjCache.resolveBridges()
+	c.resolveFlowExports()
+	c.resolveQoS()
+	c.resolveSpanningTree()
 
 	c.DumpRawCache()
 	c.DumpObjectCache()
 
+	callback := c.callback
+	c.mu.Unlock()
+
+	if callback != nil {
+		if err := callback(c); err != nil {
+			log.Errorf("Config callback error: %v", err)
+		}
+	}
+
 	log.Debug("Syncing config cache complete")
 }
 
 func (c *Config) UpdateObjectCacheEntry(tableName, uuid string, row libovsdb.Row) error {
+	r := c.rowReader(tableName, row)
+
 	switch tableName {
 	case SystemTable:
-		c.ObjCache.System = &System{
-			uuid:     uuid,
-			Version:  row.Fields["ovs_version"].(string),
-			Hostname: row.Fields["external_ids"].(libovsdb.OvsMap).GoMap["hostname"].(string),
-		}
+		system := &System{uuid: uuid}
+		c.ObjCache.System = system
+
+		return DecodeRow(r, system)
 	case ControllerTable:
-		target, err := ParseOpenFlowControllerTarget(row.Fields["target"].(string))
-		if err != nil {
-			return err
+		controller := &OpenFlowController{uuid: uuid}
+		err := DecodeRow(r, controller)
+		if controller.Target == nil {
+			return fmt.Errorf("unable to cache controller %v without a valid target: %v", uuid, err)
 		}
 
-		c.ObjCache.Controllers[primaryControllerName] = &OpenFlowController{
-			uuid:      uuid,
-			Name:      primaryControllerName,
-			Connected: row.Fields["is_connected"].(bool),
-			Target:    target,
-		}
+		c.ObjCache.Controllers[uuid] = controller
+
+		return err
 	case InterfaceTable:
-		var mtu uint16
-
-		switch row.Fields["mtu"].(type) {
-		case float64:
-			mtu = uint16(row.Fields["mtu"].(float64))
-		case libovsdb.OvsSet:
-			log.Errorf("Unable to perform correct type conversion OvsSet for interface mtu: %v", row)
-		default:
-			log.Errorf("Unable to perform correct type conversion for interface mtu: %v", row)
-		}
-
-		c.ObjCache.Interfaces[row.Fields["name"].(string)] = &Interface{
-			uuid:        uuid,
-			Name:        row.Fields["name"].(string),
-			MTU:         mtu,
-			AdminStatus: row.Fields["admin_state"].(string),
-			LinkStatus:  row.Fields["link_state"].(string),
-			Statistics: &InterfaceStatistics{
-				ReceivedPackets:    uint64(row.Fields["statistics"].(libovsdb.OvsMap).GoMap["rx_packets"].(float64)),
-				ReceivedErrors:     uint64(row.Fields["statistics"].(libovsdb.OvsMap).GoMap["rx_errors"].(float64)),
-				ReceivedDropped:    uint64(row.Fields["statistics"].(libovsdb.OvsMap).GoMap["rx_dropped"].(float64)),
-				TransmittedPackets: uint64(row.Fields["statistics"].(libovsdb.OvsMap).GoMap["tx_packets"].(float64)),
-				TransmittedErrors:  uint64(row.Fields["statistics"].(libovsdb.OvsMap).GoMap["tx_errors"].(float64)),
-				TransmittedDropped: uint64(row.Fields["statistics"].(libovsdb.OvsMap).GoMap["tx_dropped"].(float64)),
-			},
+		interf := &Interface{uuid: uuid, Enabled: true, Statistics: &InterfaceStatistics{}}
+		err := DecodeRow(r, interf)
+		if interf.Name == "" {
+			return fmt.Errorf("unable to cache interface %v without a name: %v", uuid, err)
+		}
+
+		interf.LastChange = c.linkChange(interf.Name, interf.LinkStatus)
+
+		c.ObjCache.Interfaces[interf.Name] = interf
+
+		return err
+	case PortTable:
+		port := &Port{uuid: uuid}
+		err := DecodeRow(r, port)
+		if port.Name == "" {
+			return fmt.Errorf("unable to cache port %v without a name: %v", uuid, err)
+		}
+
+		c.ObjCache.Ports[port.Name] = port
+
+		return err
+	case BridgeTable:
+		bridge := &Bridge{uuid: uuid}
+		err := DecodeRow(r, bridge)
+		if bridge.Name == "" {
+			return fmt.Errorf("unable to cache bridge %v without a name: %v", uuid, err)
+		}
+
+		c.ObjCache.Bridges[bridge.Name] = bridge
+
+		return err
+	case MirrorTable:
+		mirror := &Mirror{uuid: uuid}
+		err := DecodeRow(r, mirror)
+		if mirror.Name == "" {
+			return fmt.Errorf("unable to cache mirror %v without a name: %v", uuid, err)
 		}
+
+		c.ObjCache.Mirrors[mirror.Name] = mirror
+
+		return err
+	case SFlowTable, NetFlowTable, IPFIXTable:
+		// Flow exporters are decoded along with their bridge, see resolveFlowExports.
+		return nil
+	case QoSTable, QueueTable:
+		// QoS is decoded along with its port, see resolveQoS.
+		return nil
 	default:
 		return errors.New("unable to update unsupported table entry")
 	}
+}
 
-	return nil
+// linkChange returns the time of the last link state transition of an interface. The first state seen of an interface
+// is not a transition. The states are kept across a resync, so that a reconnect does not lose them.
+func (c *Config) linkChange(name, linkStatus string) uint64 {
+	state, ok := c.linkStates[name]
+	switch {
+	case !ok:
+		state = &linkState{status: linkStatus}
+		c.linkStates[name] = state
+	case state.status != linkStatus:
+		state.status = linkStatus
+		state.changed = uint64(time.Now().UnixNano())
+	}
+
+	return state.changed
+}
+
+// SetSchema sets the database schema of the server, which the rows are checked against.
+func (c *Config) SetSchema(schema *libovsdb.DatabaseSchema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.schema = schema
+}
+
+func (c *Config) rowReader(tableName string, row libovsdb.Row) *RowReader {
+	if c.schema == nil {
+		return NewRowReader(tableName, row, nil)
+	}
+
+	table, ok := c.schema.Tables[tableName]
+	if !ok {
+		return NewRowReader(tableName, row, nil)
+	}
+
+	return NewRowReader(tableName, row, &table)
 }
 
 func (c *Config) DeleteObjectCacheEntry(tableName, uuid string) error {
@@ -387,13 +962,33 @@ func (c *Config) DeleteObjectCacheEntry(tableName, uuid string) error {
 			c.ObjCache.System = nil
 		}
 	case ControllerTable:
-		if c.getControllerByUUID(uuid) != nil {
-			delete(c.ObjCache.Controllers, uuid)
-		}
+		delete(c.ObjCache.Controllers, uuid)
 	case InterfaceTable:
-		if c.getInterfaceByUUID(uuid) != nil {
-			delete(c.ObjCache.Interfaces, uuid)
+		if i := c.getInterfaceByUUID(uuid); i != nil {
+			delete(c.ObjCache.Interfaces, i.Name)
+			delete(c.linkStates, i.Name)
+		}
+	case PortTable:
+		for name, p := range c.ObjCache.Ports {
+			if p.uuid == uuid {
+				delete(c.ObjCache.Ports, name)
+				delete(c.stpStates, name)
+			}
 		}
+	case BridgeTable:
+		for name, b := range c.ObjCache.Bridges {
+			if b.uuid == uuid {
+				delete(c.ObjCache.Bridges, name)
+				delete(c.topologyStates, name)
+			}
+		}
+	case MirrorTable:
+		for name, m := range c.ObjCache.Mirrors {
+			if m.uuid == uuid {
+				delete(c.ObjCache.Mirrors, name)
+			}
+		}
+	case SFlowTable, NetFlowTable, IPFIXTable, QoSTable, QueueTable:
 	default:
 		return errors.New("unable to delete unsupported table entry")
 	}
@@ -415,6 +1010,14 @@ func (c *Config) DumpObjectCache() {
 	log.Debug(c.ObjCache)
 }
 
+// Snapshot returns a config holding a copy of the object cache, which can be read without holding the lock of c.
+func (c *Config) Snapshot() *Config {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return &Config{ObjCache: CopyConfigObjectCache(c.ObjCache)}
+}
+
 func (c *Config) OverwriteObjectCache(cache *ObjectCache) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
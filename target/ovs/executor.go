@@ -0,0 +1,68 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	OFCtl  = "ovs-ofctl"
+	AppCtl = "ovs-appctl"
+
+	ExecutorTimeout = 10 * time.Second
+)
+
+// Executor runs the OVS command line utilities for what cannot be done through OVSDB, such as OpenFlow port
+// modifications. The utilities talk to the local ovs-vswitchd, whatever the ovsdb-server remote is, so the target has
+// to run next to the OVS it manages.
+type Executor interface {
+	Run(name string, args ...string) ([]byte, error)
+}
+
+// LocalExecutor runs the utilities as local processes, which are killed after Timeout, or ExecutorTimeout if it is 0.
+type LocalExecutor struct {
+	Timeout time.Duration
+}
+
+func (e LocalExecutor) Run(name string, args ...string) ([]byte, error) {
+	log.Debugf("Running %v %v", name, strings.Join(args, " "))
+
+	timeout := e.Timeout
+	if timeout == 0 {
+		timeout = ExecutorTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, name, args...).Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("%v %v: timed out after %v", name, strings.Join(args, " "), timeout)
+	}
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("%v %v: %v: %v", name, strings.Join(args, " "), err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("%v %v: %v", name, strings.Join(args, " "), err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,338 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/socketplane/libovsdb"
+)
+
+// SFlow is the sFlow exporter of a bridge. Nil values are unset and use the OVS default.
+type SFlow struct {
+	uuid     string
+	Targets  []string
+	Sampling *uint32
+	Polling  *uint32
+	Header   *uint32
+	Agent    string
+}
+
+func (s *SFlow) Equal(comp *SFlow) bool {
+	if s == nil || comp == nil {
+		return s == comp
+	}
+
+	switch {
+	case !equalStrings(s.Targets, comp.Targets):
+		return false
+	case !equalUint32(s.Sampling, comp.Sampling):
+		return false
+	case !equalUint32(s.Polling, comp.Polling):
+		return false
+	case !equalUint32(s.Header, comp.Header):
+		return false
+	case s.Agent != comp.Agent:
+		return false
+	default:
+		return true
+	}
+}
+
+func (s *SFlow) String() string {
+	return fmt.Sprintf("SFlow(uuid: \"%v\", Targets: \"%v\", Sampling: \"%v\", Polling: \"%v\", Header: \"%v\", Agent: \"%v\")",
+		s.uuid, s.Targets, optionalString(s.Sampling), optionalString(s.Polling), optionalString(s.Header), s.Agent)
+}
+
+// NetFlow is the NetFlow exporter of a bridge. Nil engine values are derived from the datapath ID by OVS.
+type NetFlow struct {
+	uuid          string
+	Targets       []string
+	EngineType    *uint8
+	EngineID      *uint8
+	ActiveTimeout int32
+}
+
+func (n *NetFlow) Equal(comp *NetFlow) bool {
+	if n == nil || comp == nil {
+		return n == comp
+	}
+
+	switch {
+	case !equalStrings(n.Targets, comp.Targets):
+		return false
+	case !equalUint8(n.EngineType, comp.EngineType):
+		return false
+	case !equalUint8(n.EngineID, comp.EngineID):
+		return false
+	case n.ActiveTimeout != comp.ActiveTimeout:
+		return false
+	default:
+		return true
+	}
+}
+
+func (n *NetFlow) String() string {
+	return fmt.Sprintf("NetFlow(uuid: \"%v\", Targets: \"%v\", EngineType: \"%v\", EngineID: \"%v\", ActiveTimeout: \"%v\")",
+		n.uuid, n.Targets, optionalString(n.EngineType), optionalString(n.EngineID), n.ActiveTimeout)
+}
+
+// IPFIX is the bridge wide IPFIX exporter of a bridge. Nil values are unset and use the OVS default.
+type IPFIX struct {
+	uuid        string
+	Targets     []string
+	Sampling    *uint32
+	ObsDomainID *uint32
+	ObsPointID  *uint32
+}
+
+func (i *IPFIX) Equal(comp *IPFIX) bool {
+	if i == nil || comp == nil {
+		return i == comp
+	}
+
+	switch {
+	case !equalStrings(i.Targets, comp.Targets):
+		return false
+	case !equalUint32(i.Sampling, comp.Sampling):
+		return false
+	case !equalUint32(i.ObsDomainID, comp.ObsDomainID):
+		return false
+	case !equalUint32(i.ObsPointID, comp.ObsPointID):
+		return false
+	default:
+		return true
+	}
+}
+
+func (i *IPFIX) String() string {
+	return fmt.Sprintf("IPFIX(uuid: \"%v\", Targets: \"%v\", Sampling: \"%v\", ObsDomainID: \"%v\", ObsPointID: \"%v\")",
+		i.uuid, i.Targets, optionalString(i.Sampling), optionalString(i.ObsDomainID), optionalString(i.ObsPointID))
+}
+
+// resolveFlowExports sets the exporters of every bridge from the rows its sflow, netflow and ipfix columns reference.
+// The exporter rows have no name to cache them by, so they are decoded from the raw cache once the bridges are known.
+func (c *Config) resolveFlowExports() {
+	for _, b := range c.ObjCache.Bridges {
+		b.SFlow, b.NetFlow, b.IPFIX = nil, nil, nil
+
+		bridgeRow, ok := c.rawCache[BridgeTable][b.uuid]
+		if !ok {
+			continue
+		}
+		r := c.rowReader(BridgeTable, bridgeRow)
+
+		if uuid, row, ok := c.referencedRow(r, "sflow", SFlowTable); ok {
+			b.SFlow = &SFlow{uuid: uuid}
+			if err := DecodeRow(c.rowReader(SFlowTable, row), b.SFlow); err != nil {
+				log.Errorf("Unable to decode sFlow of bridge %v: %v", b.Name, err)
+			}
+		}
+
+		if uuid, row, ok := c.referencedRow(r, "netflow", NetFlowTable); ok {
+			b.NetFlow = &NetFlow{uuid: uuid}
+			if err := DecodeRow(c.rowReader(NetFlowTable, row), b.NetFlow); err != nil {
+				log.Errorf("Unable to decode NetFlow of bridge %v: %v", b.Name, err)
+			}
+		}
+
+		if uuid, row, ok := c.referencedRow(r, "ipfix", IPFIXTable); ok {
+			b.IPFIX = &IPFIX{uuid: uuid}
+			if err := DecodeRow(c.rowReader(IPFIXTable, row), b.IPFIX); err != nil {
+				log.Errorf("Unable to decode IPFIX of bridge %v: %v", b.Name, err)
+			}
+		}
+	}
+}
+
+// referencedRow returns the uuid and raw row of the row of table referenced by an optional UUID column.
+func (c *Config) referencedRow(r *RowReader, column, table string) (string, libovsdb.Row, bool) {
+	uuid, err := r.UUID(column)
+	if err != nil {
+		return "", libovsdb.Row{}, false
+	}
+
+	row, ok := c.rawCache[table][uuid]
+	return uuid, row, ok
+}
+
+func copyFlowExports(dst, src *Bridge) {
+	if src.SFlow != nil {
+		s := *src.SFlow
+		s.Targets = append([]string(nil), src.SFlow.Targets...)
+		dst.SFlow = &s
+	}
+
+	if src.NetFlow != nil {
+		n := *src.NetFlow
+		n.Targets = append([]string(nil), src.NetFlow.Targets...)
+		dst.NetFlow = &n
+	}
+
+	if src.IPFIX != nil {
+		i := *src.IPFIX
+		i.Targets = append([]string(nil), src.IPFIX.Targets...)
+		dst.IPFIX = &i
+	}
+}
+
+// overwriteFlowExportsWithJSON replaces the exporters of the bridges with those of the IETF JSON tree. A bridge
+// missing in the tree, or an exporter without config, has its exporter removed. Exporters which cannot be read are
+// left as they are.
+func overwriteFlowExportsWithJSON(cache *ObjectCache, jsonConfig map[string]interface{}) {
+	bridges, _ := jsonConfig["ovs-bridges:bridges"].(map[string]interface{})
+	list, _ := bridges["bridge"].([]interface{})
+	configs := make(map[string]map[string]interface{})
+
+	for _, i := range list {
+		entry, _ := i.(map[string]interface{})
+		name, _ := entry["name"].(string)
+		if _, ok := cache.Bridges[name]; !ok {
+			log.Errorf("Unable to configure flow export of unknown bridge %v", name)
+			continue
+		}
+		configs[name] = entry
+	}
+
+	// exporter returns the container of an exporter, nil if it has no config.
+	exporter := func(entry map[string]interface{}, name string) map[string]interface{} {
+		e, _ := entry[name].(map[string]interface{})
+		if config, _ := e["config"].(map[string]interface{}); config == nil {
+			return nil
+		}
+		return e
+	}
+
+	for name, b := range cache.Bridges {
+		entry := configs[name]
+
+		if e := exporter(entry, "sflow"); e == nil {
+			b.SFlow = nil
+		} else if err := readSFlowJSON(b, e); err != nil {
+			log.Errorf("Unable to read sFlow of bridge %v: %v", name, err)
+		}
+
+		if e := exporter(entry, "netflow"); e == nil {
+			b.NetFlow = nil
+		} else if err := readNetFlowJSON(b, e); err != nil {
+			log.Errorf("Unable to read NetFlow of bridge %v: %v", name, err)
+		}
+
+		if e := exporter(entry, "ipfix"); e == nil {
+			b.IPFIX = nil
+		} else if err := readIPFIXJSON(b, e); err != nil {
+			log.Errorf("Unable to read IPFIX of bridge %v: %v", name, err)
+		}
+	}
+}
+
+func readSFlowJSON(b *Bridge, sflow map[string]interface{}) error {
+	config, _ := sflow["config"].(map[string]interface{})
+	targets := jsonStrings(config["targets"])
+	if len(targets) == 0 {
+		return fmt.Errorf("sFlow requires at least one target")
+	}
+
+	s := &SFlow{Targets: targets}
+	if b.SFlow != nil {
+		s.uuid = b.SFlow.uuid
+	}
+
+	if err := ReadRowJSON(SFlowTable, s, sflow); err != nil {
+		return err
+	}
+
+	b.SFlow = s
+
+	return nil
+}
+
+func readNetFlowJSON(b *Bridge, netflow map[string]interface{}) error {
+	config, _ := netflow["config"].(map[string]interface{})
+	targets := jsonStrings(config["targets"])
+	if len(targets) == 0 {
+		return fmt.Errorf("NetFlow requires at least one target")
+	}
+
+	n := &NetFlow{Targets: targets}
+	if b.NetFlow != nil {
+		n.uuid = b.NetFlow.uuid
+	}
+
+	if err := ReadRowJSON(NetFlowTable, n, netflow); err != nil {
+		return err
+	}
+
+	b.NetFlow = n
+
+	return nil
+}
+
+func readIPFIXJSON(b *Bridge, ipfix map[string]interface{}) error {
+	config, _ := ipfix["config"].(map[string]interface{})
+	i := &IPFIX{Targets: jsonStrings(config["targets"])}
+	if b.IPFIX != nil {
+		i.uuid = b.IPFIX.uuid
+	}
+
+	if err := ReadRowJSON(IPFIXTable, i, ipfix); err != nil {
+		return err
+	}
+
+	b.IPFIX = i
+
+	return nil
+}
+
+func jsonStrings(v interface{}) []string {
+	list, _ := v.([]interface{})
+
+	var values []string
+	for _, i := range list {
+		if s, ok := i.(string); ok {
+			values = append(values, s)
+		}
+	}
+
+	return values
+}
+
+func jsonUint32(v interface{}) *uint32 {
+	if i, ok := v.(uint32); ok {
+		return &i
+	}
+
+	return nil
+}
+
+func equalUint8(a, b *uint8) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// optionalString formats the value of a pointer, or returns an empty string if it is nil.
+func optionalString(p interface{}) string {
+	v := reflect.ValueOf(p)
+	if v.IsNil() {
+		return ""
+	}
+
+	return fmt.Sprint(v.Elem())
+}
@@ -0,0 +1,233 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"github.com/openconfig/ygot/ygot"
+	oc "ovs-gnxi/shared/gnmi/modeldata/generated/ocstruct"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// flowDefaultPriority is the priority of flows added without one, which ovs-ofctl does not print.
+const flowDefaultPriority = 32768
+
+// Flow is an OpenFlow flow of a bridge with its statistics. Match and Actions are as printed by ovs-ofctl, Duration is
+// the time since the flow was added.
+type Flow struct {
+	Table       uint8
+	Priority    uint16
+	Match       string
+	Actions     string
+	Cookie      uint64
+	PacketCount uint64
+	ByteCount   uint64
+	Duration    time.Duration
+	IdleTimeout *uint16
+	HardTimeout *uint16
+}
+
+// ID returns the key the flow is published with. A table has at most one flow of a priority and match.
+func (f *Flow) ID() string {
+	if f.Match == "" {
+		return fmt.Sprintf("priority=%v", f.Priority)
+	}
+
+	return fmt.Sprintf("priority=%v,%v", f.Priority, f.Match)
+}
+
+func (f *Flow) String() string {
+	return fmt.Sprintf("Flow(Table: \"%v\", ID: \"%v\", Actions: \"%v\", PacketCount: \"%v\", ByteCount: \"%v\")",
+		f.Table, f.ID(), f.Actions, f.PacketCount, f.ByteCount)
+}
+
+// ParseFlows parses the output of ovs-ofctl dump-flows into the flows of a bridge. Each flow line starts with its
+// statistics as "key=value," fields, followed by the priority and match and the actions. Lines without actions, such
+// as the reply header, are skipped.
+func ParseFlows(out string) ([]*Flow, error) {
+	var flows []*Flow
+
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, " actions=", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		f := &Flow{Priority: flowDefaultPriority, Actions: strings.TrimSpace(fields[1])}
+		tokens := strings.Fields(fields[0])
+
+		// The priority and match are the only field not ending with a comma, flows matching all packets with the
+		// default priority have none.
+		if n := len(tokens); n > 0 && !strings.HasSuffix(tokens[n-1], ",") {
+			var match []string
+			for _, m := range strings.Split(tokens[n-1], ",") {
+				if !strings.HasPrefix(m, "priority=") {
+					match = append(match, m)
+					continue
+				}
+
+				priority, err := strconv.ParseUint(strings.TrimPrefix(m, "priority="), 10, 16)
+				if err != nil {
+					return nil, fmt.Errorf("invalid priority in %q", line)
+				}
+				f.Priority = uint16(priority)
+			}
+			f.Match = strings.Join(match, ",")
+			tokens = tokens[:n-1]
+		}
+
+		for _, token := range tokens {
+			kv := strings.SplitN(strings.TrimSuffix(token, ","), "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+
+			switch kv[0] {
+			case "table":
+				table, err := strconv.ParseUint(kv[1], 10, 8)
+				if err != nil {
+					return nil, fmt.Errorf("invalid table in %q", line)
+				}
+				f.Table = uint8(table)
+			case "cookie":
+				f.Cookie, _ = strconv.ParseUint(kv[1], 0, 64)
+			case "n_packets":
+				f.PacketCount, _ = strconv.ParseUint(kv[1], 10, 64)
+			case "n_bytes":
+				f.ByteCount, _ = strconv.ParseUint(kv[1], 10, 64)
+			case "duration":
+				if seconds, err := strconv.ParseFloat(strings.TrimSuffix(kv[1], "s"), 64); err == nil {
+					f.Duration = time.Duration(seconds * float64(time.Second))
+				}
+			case "idle_timeout":
+				if timeout, err := strconv.ParseUint(kv[1], 10, 16); err == nil {
+					t := uint16(timeout)
+					f.IdleTimeout = &t
+				}
+			case "hard_timeout":
+				if timeout, err := strconv.ParseUint(kv[1], 10, 16); err == nil {
+					t := uint16(timeout)
+					f.HardTimeout = &t
+				}
+			}
+		}
+
+		flows = append(flows, f)
+	}
+
+	return flows, nil
+}
+
+// equalFlows reports whether two flow tables hold the same flows, ignoring their counters and durations, which change
+// with every poll.
+func equalFlows(a, b []*Flow) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		switch {
+		case a[i].Table != b[i].Table, a[i].Priority != b[i].Priority, a[i].Match != b[i].Match:
+			return false
+		case a[i].Actions != b[i].Actions, a[i].Cookie != b[i].Cookie:
+			return false
+		case !equalUint16(a[i].IdleTimeout, b[i].IdleTimeout), !equalUint16(a[i].HardTimeout, b[i].HardTimeout):
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalUint16(a, b *uint16) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// collectFlows requests the flows of all bridges for generateFlowConfig and reports whether they changed. Bridges whose
+// flows cannot be requested are published without flows and returned in the error.
+func (s *SystemBroker) collectFlows() (bool, error) {
+	config := s.OVSClient.Config
+	config.mu.RLock()
+	protocols := make(map[string][]string)
+	for _, b := range config.ObjCache.Bridges {
+		protocols[b.Name] = append([]string(nil), b.Protocols...)
+	}
+	config.mu.RUnlock()
+
+	flows := make(map[string][]*Flow)
+	var failed []string
+	for bridge, p := range protocols {
+		f, err := s.OVSClient.Flows(bridge, p)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("bridge %v: %v", bridge, err))
+			continue
+		}
+		flows[bridge] = f
+	}
+
+	s.polled.mu.Lock()
+	changed := len(flows) != len(s.polled.flows)
+	for bridge, f := range flows {
+		if prev, ok := s.polled.flows[bridge]; !ok || !equalFlows(prev, f) {
+			changed = true
+		}
+	}
+	s.polled.flows = flows
+	s.polled.mu.Unlock()
+
+	return changed, collectError(failed)
+}
+
+// generateFlowConfig publishes the flow tables of a bridge which have flows, as last collected by collectFlows.
+func (s *SystemBroker) generateFlowConfig(o *oc.Bridge, b *Bridge) error {
+	s.polled.mu.RLock()
+	flows := s.polled.flows[b.Name]
+	s.polled.mu.RUnlock()
+
+	for _, flow := range flows {
+		t, ok := o.FlowTable[flow.Table]
+		if !ok {
+			var err error
+			if t, err = o.NewFlowTable(flow.Table); err != nil {
+				return err
+			}
+		}
+
+		f, err := t.NewFlow(flow.ID())
+		if err != nil {
+			log.Errorf("Unable to publish flow %v of bridge %v: %v", flow.ID(), b.Name, err)
+			continue
+		}
+
+		f.Priority = ygot.Uint16(flow.Priority)
+		f.Match = ygot.String(flow.Match)
+		f.Actions = ygot.String(flow.Actions)
+		f.Cookie = ygot.Uint64(flow.Cookie)
+		f.PacketCount = ygot.Uint64(flow.PacketCount)
+		f.ByteCount = ygot.Uint64(flow.ByteCount)
+		f.Duration = ygot.Uint64(uint64(flow.Duration / time.Millisecond))
+		f.IdleTimeout = flow.IdleTimeout
+		f.HardTimeout = flow.HardTimeout
+	}
+
+	return nil
+}
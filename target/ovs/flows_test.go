@@ -0,0 +1,87 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+	"time"
+)
+
+func TestParseFlows(t *testing.T) {
+	timeout := func(t uint16) *uint16 { return &t }
+
+	tests := []struct {
+		desc    string
+		in      string
+		want    []*Flow
+		wantErr bool
+	}{
+		{desc: "empty output", in: "", want: nil},
+		{desc: "reply header only", in: "NXST_FLOW reply (xid=0x4):\n", want: nil},
+		{
+			desc: "priority and match",
+			in: "NXST_FLOW reply (xid=0x4):\n" +
+				" cookie=0x0, duration=12.5s, table=0, n_packets=1, n_bytes=98, idle_age=3, priority=100,in_port=1 actions=output:2\n",
+			want: []*Flow{{Table: 0, Priority: 100, Match: "in_port=1", Actions: "output:2", PacketCount: 1, ByteCount: 98, Duration: 12500 * time.Millisecond}},
+		},
+		{
+			desc: "timeouts, cookie and flags",
+			in:   " cookie=0xa, duration=1.5s, table=3, n_packets=0, n_bytes=0, idle_timeout=60, hard_timeout=300, idle_age=1, reset_counts ip,nw_src=10.0.0.1 actions=drop",
+			want: []*Flow{{Table: 3, Priority: flowDefaultPriority, Match: "ip,nw_src=10.0.0.1", Actions: "drop", Cookie: 10,
+				Duration: 1500 * time.Millisecond, IdleTimeout: timeout(60), HardTimeout: timeout(300)}},
+		},
+		{
+			desc: "priority without match",
+			in:   " cookie=0x0, duration=100.25s, table=0, n_packets=7, n_bytes=700, priority=0 actions=resubmit(,1)",
+			want: []*Flow{{Priority: 0, Actions: "resubmit(,1)", PacketCount: 7, ByteCount: 700, Duration: 100250 * time.Millisecond}},
+		},
+		{
+			desc: "match all with default priority",
+			in:   " cookie=0x0, duration=2s, table=1, n_packets=7, n_bytes=700, idle_age=5, actions=NORMAL",
+			want: []*Flow{{Table: 1, Priority: flowDefaultPriority, Actions: "NORMAL", PacketCount: 7, ByteCount: 700, Duration: 2 * time.Second}},
+		},
+		{
+			desc: "several flows",
+			in: " cookie=0x0, duration=1s, table=0, n_packets=0, n_bytes=0, priority=10,dl_vlan=5 actions=strip_vlan,output:3\n" +
+				" cookie=0x0, duration=1s, table=0, n_packets=0, n_bytes=0, priority=0 actions=drop\n",
+			want: []*Flow{
+				{Priority: 10, Match: "dl_vlan=5", Actions: "strip_vlan,output:3", Duration: time.Second},
+				{Priority: 0, Actions: "drop", Duration: time.Second},
+			},
+		},
+		{desc: "invalid priority", in: " cookie=0x0, table=0, priority=70000,in_port=1 actions=drop", wantErr: true},
+		{desc: "invalid table", in: " cookie=0x0, table=300, priority=1 actions=drop", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseFlows(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%v: ParseFlows(%q) = %v, want error", tt.desc, tt.in, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%v: ParseFlows(%q) returned error: %v", tt.desc, tt.in, err)
+			continue
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%v: ParseFlows(%q) returned diff (-want +got):\n%v", tt.desc, tt.in, diff)
+		}
+	}
+}
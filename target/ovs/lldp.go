@@ -0,0 +1,284 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"github.com/openconfig/ygot/ygot"
+	oc "ovs-gnxi/shared/gnmi/modeldata/generated/ocstruct"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	lldpChassisIDTypes = map[string]oc.E_OpenconfigLldp_ChassisIdType{
+		"mac":     oc.OpenconfigLldp_ChassisIdType_MAC_ADDRESS,
+		"ip":      oc.OpenconfigLldp_ChassisIdType_NETWORK_ADDRESS,
+		"ifname":  oc.OpenconfigLldp_ChassisIdType_INTERFACE_NAME,
+		"ifalias": oc.OpenconfigLldp_ChassisIdType_INTERFACE_ALIAS,
+		"local":   oc.OpenconfigLldp_ChassisIdType_LOCAL,
+	}
+
+	lldpPortIDTypes = map[string]oc.E_OpenconfigLldp_PortIdType{
+		"mac":     oc.OpenconfigLldp_PortIdType_MAC_ADDRESS,
+		"ip":      oc.OpenconfigLldp_PortIdType_NETWORK_ADDRESS,
+		"ifname":  oc.OpenconfigLldp_PortIdType_INTERFACE_NAME,
+		"ifalias": oc.OpenconfigLldp_PortIdType_INTERFACE_ALIAS,
+		"local":   oc.OpenconfigLldp_PortIdType_LOCAL,
+	}
+
+	lldpCapabilities = map[string]oc.E_OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY{
+		"other":     oc.OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_OTHER,
+		"repeater":  oc.OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_REPEATER,
+		"bridge":    oc.OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_MAC_BRIDGE,
+		"wlan":      oc.OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_WLAN_ACCESS_POINT,
+		"router":    oc.OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_ROUTER,
+		"telephone": oc.OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_TELEPHONE,
+		"docsis":    oc.OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_DOCSIS_CABLE_DEVICE,
+		"station":   oc.OpenconfigLldpTypes_LLDP_SYSTEM_CAPABILITY_STATION_ONLY,
+	}
+)
+
+// LLDPNeighbor is a neighbor ovs-vswitchd has learned on an interface. The ID types are those of ovs-appctl, such as
+// mac or ifname. Capabilities holds whether each advertised capability is enabled.
+type LLDPNeighbor struct {
+	ChassisID         string
+	ChassisIDType     string
+	PortID            string
+	PortIDType        string
+	PortDescription   string
+	SystemName        string
+	SystemDescription string
+	ManagementAddress string
+	TTL               *uint16
+	Capabilities      map[string]bool
+}
+
+// ID returns the key the neighbor is published with, which stays the same as long as the neighbor does.
+func (n *LLDPNeighbor) ID() string {
+	return n.ChassisID + "/" + n.PortID
+}
+
+func (n *LLDPNeighbor) String() string {
+	return fmt.Sprintf("LLDPNeighbor(ChassisID: \"%v\", PortID: \"%v\", SystemName: \"%v\", TTL: \"%v\", Capabilities: \"%v\")",
+		n.ChassisID, n.PortID, n.SystemName, optionalString(n.TTL), n.Capabilities)
+}
+
+// lldp returns the lldp column of an interface, which has no enable key unless LLDP is enabled.
+func (i *Interface) lldp() map[string]string {
+	if !i.LLDPEnable {
+		return map[string]string{}
+	}
+
+	return map[string]string{"enable": "true"}
+}
+
+// splitLLDPID splits an ID as ovs-appctl prints it, such as "mac 00:00:00:00:00:01", into the ID and its type. IDs
+// without a type have an empty one.
+func splitLLDPID(s string) (string, string) {
+	fields := strings.SplitN(s, " ", 2)
+	if len(fields) < 2 {
+		return s, ""
+	}
+
+	return strings.TrimSpace(fields[1]), strings.ToLower(fields[0])
+}
+
+// ParseLLDPNeighbors parses the output of ovs-appctl lldp/neighbor into the neighbors of each interface. Each neighbor
+// follows an Interface line with indented "Key: value" lines of its chassis and port. Lines which are not understood,
+// such as separators, are skipped.
+func ParseLLDPNeighbors(out string) map[string][]*LLDPNeighbor {
+	neighbors := make(map[string][]*LLDPNeighbor)
+
+	var interf string
+	var n *LLDPNeighbor
+	neighbor := func() *LLDPNeighbor {
+		if n == nil {
+			n = &LLDPNeighbor{Capabilities: make(map[string]bool)}
+			neighbors[interf] = append(neighbors[interf], n)
+		}
+		return n
+	}
+
+	for _, line := range strings.Split(out, "\n") {
+		kv := strings.SplitN(strings.TrimSpace(line), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key := strings.ToLower(strings.Replace(kv[0], " ", "", -1))
+		value := strings.TrimSpace(kv[1])
+
+		if key == "interface" {
+			interf = strings.TrimSpace(strings.SplitN(value, ",", 2)[0])
+			n = nil
+			continue
+		}
+		if interf == "" {
+			continue
+		}
+
+		switch key {
+		case "chassis":
+			// A second chassis on the same interface is another neighbor.
+			if n != nil && n.ChassisID != "" {
+				n = nil
+			}
+		case "chassisid":
+			neighbor().ChassisID, neighbor().ChassisIDType = splitLLDPID(value)
+		case "portid":
+			neighbor().PortID, neighbor().PortIDType = splitLLDPID(value)
+		case "portdescr":
+			neighbor().PortDescription = value
+		case "sysname":
+			neighbor().SystemName = value
+		case "sysdescr":
+			neighbor().SystemDescription = value
+		case "mgmtip":
+			neighbor().ManagementAddress = value
+		case "ttl":
+			if ttl, err := strconv.ParseUint(value, 10, 16); err == nil {
+				t := uint16(ttl)
+				neighbor().TTL = &t
+			}
+		case "capability":
+			fields := strings.SplitN(value, ",", 2)
+			if len(fields) == 2 {
+				neighbor().Capabilities[strings.ToLower(strings.TrimSpace(fields[0]))] = strings.TrimSpace(fields[1]) == "on"
+			}
+		}
+	}
+
+	return neighbors
+}
+
+// collectLLDPNeighbors requests the LLDP neighbors for generateLLDPConfig, unless no interface has LLDP enabled, and
+// reports whether they changed.
+func (s *SystemBroker) collectLLDPNeighbors() (bool, error) {
+	config := s.OVSClient.Config
+	config.mu.RLock()
+	enabled := false
+	for _, i := range config.ObjCache.Interfaces {
+		if i.LLDPEnable {
+			enabled = true
+			break
+		}
+	}
+	config.mu.RUnlock()
+
+	var neighbors map[string][]*LLDPNeighbor
+	var err error
+	if enabled {
+		neighbors, err = s.OVSClient.LLDPNeighbors()
+	}
+
+	s.polled.mu.Lock()
+	changed := !reflect.DeepEqual(neighbors, s.polled.lldpNeighbors)
+	s.polled.lldpNeighbors = neighbors
+	s.polled.mu.Unlock()
+
+	return changed, err
+}
+
+// generateLLDPConfig publishes whether LLDP is enabled on each interface and the neighbors ovs-vswitchd has learned,
+// as last collected by collectLLDPNeighbors.
+func (s *SystemBroker) generateLLDPConfig(d *oc.Device, cache *ObjectCache) error {
+	s.polled.mu.RLock()
+	neighbors := s.polled.lldpNeighbors
+	s.polled.mu.RUnlock()
+
+	d.Lldp = &oc.Lldp{}
+	for _, i := range cache.Interfaces {
+		if _, ok := d.Interface[i.Name]; !ok {
+			continue
+		}
+
+		l, err := d.Lldp.NewInterface(i.Name)
+		if err != nil {
+			return err
+		}
+		l.Enabled = ygot.Bool(i.LLDPEnable)
+
+		if !i.LLDPEnable {
+			continue
+		}
+
+		for _, neighbor := range neighbors[i.Name] {
+			n, err := l.NewNeighbor(neighbor.ID())
+			if err != nil {
+				log.Errorf("Unable to publish LLDP neighbor %v of interface %v: %v", neighbor.ID(), i.Name, err)
+				continue
+			}
+
+			n.ChassisId = ygot.String(neighbor.ChassisID)
+			n.ChassisIdType = lldpChassisIDTypes[neighbor.ChassisIDType]
+			n.PortId = ygot.String(neighbor.PortID)
+			n.PortIdType = lldpPortIDTypes[neighbor.PortIDType]
+			n.Ttl = neighbor.TTL
+
+			if neighbor.PortDescription != "" {
+				n.PortDescription = ygot.String(neighbor.PortDescription)
+			}
+			if neighbor.SystemName != "" {
+				n.SystemName = ygot.String(neighbor.SystemName)
+			}
+			if neighbor.SystemDescription != "" {
+				n.SystemDescription = ygot.String(neighbor.SystemDescription)
+			}
+			if neighbor.ManagementAddress != "" {
+				n.ManagementAddress = ygot.String(neighbor.ManagementAddress)
+			}
+
+			for name, enabled := range neighbor.Capabilities {
+				capability, ok := lldpCapabilities[name]
+				if !ok {
+					continue
+				}
+
+				c, err := n.NewCapability(capability)
+				if err != nil {
+					return err
+				}
+				c.Enabled = ygot.Bool(enabled)
+			}
+		}
+	}
+
+	return d.Lldp.Validate()
+}
+
+// overwriteLLDPWithJSON reads whether LLDP is enabled on the interfaces. Interfaces missing in the configuration are
+// kept as they are.
+func overwriteLLDPWithJSON(cache *ObjectCache, jsonConfig map[string]interface{}) {
+	lldp, _ := jsonConfig["openconfig-lldp:lldp"].(map[string]interface{})
+	interfaces, _ := lldp["interfaces"].(map[string]interface{})
+	list, _ := interfaces["interface"].([]interface{})
+
+	for _, i := range list {
+		config, _ := i.(map[string]interface{})["config"].(map[string]interface{})
+		name, _ := config["name"].(string)
+
+		interf, ok := cache.Interfaces[name]
+		if !ok {
+			log.Errorf("Unable to set LLDP of interface %v, which does not exist", name)
+			continue
+		}
+
+		if enabled, ok := config["enabled"].(bool); ok {
+			interf.LLDPEnable = enabled
+		}
+	}
+}
@@ -0,0 +1,104 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestParseLLDPNeighbors(t *testing.T) {
+	ttl := func(t uint16) *uint16 { return &t }
+
+	tests := []struct {
+		desc string
+		in   string
+		want map[string][]*LLDPNeighbor
+	}{
+		{desc: "empty output", in: "", want: map[string][]*LLDPNeighbor{}},
+		{
+			desc: "single neighbor",
+			in: `LLDP neighbor:
+-------------------------------------------------------------------------------
+Interface:    eth1, via: LLDP
+  Chassis:
+    ChassisID:    mac 5e:c2:ca:f5:11:81
+    SysName:      switch2
+    SysDescr:     Open vSwitch
+    MgmtIP:       10.0.0.2
+    Capability:   Bridge, on
+    Capability:   Router, off
+  Port:
+    PortID:       ifname eth3
+    PortDescr:    uplink
+    TTL:          120
+-------------------------------------------------------------------------------
+`,
+			want: map[string][]*LLDPNeighbor{
+				"eth1": {{
+					ChassisID: "5e:c2:ca:f5:11:81", ChassisIDType: "mac", PortID: "eth3", PortIDType: "ifname",
+					PortDescription: "uplink", SystemName: "switch2", SystemDescription: "Open vSwitch",
+					ManagementAddress: "10.0.0.2", TTL: ttl(120), Capabilities: map[string]bool{"bridge": true, "router": false},
+				}},
+			},
+		},
+		{
+			desc: "two neighbors on one interface and one on another",
+			in: `Interface:    eth1, via: LLDP
+  Chassis:
+    ChassisID:    mac 00:00:00:00:00:01
+  Port:
+    PortID:       ifname eth1
+  Chassis:
+    ChassisID:    mac 00:00:00:00:00:02
+  Port:
+    PortID:       ifname eth2
+Interface:    eth2, via: LLDP
+  Chassis:
+    ChassisID:    local sw3
+  Port:
+    PortID:       7
+`,
+			want: map[string][]*LLDPNeighbor{
+				"eth1": {
+					{ChassisID: "00:00:00:00:00:01", ChassisIDType: "mac", PortID: "eth1", PortIDType: "ifname", Capabilities: map[string]bool{}},
+					{ChassisID: "00:00:00:00:00:02", ChassisIDType: "mac", PortID: "eth2", PortIDType: "ifname", Capabilities: map[string]bool{}},
+				},
+				"eth2": {
+					{ChassisID: "sw3", ChassisIDType: "local", PortID: "7", Capabilities: map[string]bool{}},
+				},
+			},
+		},
+		{
+			desc: "lines before the first interface and invalid TTL",
+			in: `ChassisID:    mac 00:00:00:00:00:09
+Interface:    eth1, via: LLDP
+    ChassisID:    mac 00:00:00:00:00:01
+    TTL:          forever
+`,
+			want: map[string][]*LLDPNeighbor{
+				"eth1": {{ChassisID: "00:00:00:00:00:01", ChassisIDType: "mac", Capabilities: map[string]bool{}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got := ParseLLDPNeighbors(tt.in)
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%v: ParseLLDPNeighbors returned diff (-want +got):\n%v", tt.desc, diff)
+		}
+	}
+}
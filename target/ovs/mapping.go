@@ -0,0 +1,706 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	oc "ovs-gnxi/shared/gnmi/modeldata/generated/ocstruct"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ColumnMapping maps a column of an OVSDB table, or a single key of a map column, to a field of the cached object and
+// the OpenConfig leaf the field is published at.
+type ColumnMapping struct {
+	Column string
+	// Key selects a single key of a map column, such as "hostname" of external_ids.
+	Key string
+	// Field is the name of the field in the cached object, fields of nested structs are separated by dots.
+	Field string
+	// Path is the leaf the field is published at, relative to the node the rows of the table are published as. It is
+	// empty if the field is published by the generate functions, as it needs a conversion or is spread over models.
+	Path string
+	// OmitEmpty leaves the leaf unset while the field has its zero value.
+	OmitEmpty bool
+	// Parse converts a string column into the value of the field, if the field is not a plain Go type.
+	Parse func(s string) (interface{}, error)
+}
+
+// tableMappings declares how the rows of the monitored tables are decoded into the object cache and, for mappings with
+// a Path, how the cached fields are published with PublishRow and read back with ReadRowJSON. A plain column only
+// needs a new entry here and a field in the cached object.
+var tableMappings = map[string][]ColumnMapping{
+	SystemTable: {
+		{Column: "ovs_version", Field: "Version"},
+		{Column: "external_ids", Key: "hostname", Field: "Hostname", Path: "config/hostname"},
+	},
+	ControllerTable: {
+		{Column: "target", Field: "Target",
+			Parse: func(s string) (interface{}, error) { return ParseOpenFlowControllerTarget(s) }},
+		{Column: "is_connected", Field: "Connected"},
+		{Column: "max_backoff", Field: "MaxBackoff"},
+		{Column: "inactivity_probe", Field: "InactivityProbe"},
+		{Column: "role", Field: "Role"},
+		{Column: "status", Key: "state", Field: "Status.State"},
+		{Column: "status", Key: "sec_since_connect", Field: "Status.SecondsSinceConnect"},
+		{Column: "status", Key: "sec_since_disconnect", Field: "Status.SecondsSinceDisconnect"},
+		{Column: "status", Key: "last_error", Field: "Status.LastError"},
+	},
+	InterfaceTable: {
+		{Column: "name", Field: "Name"},
+		{Column: "mtu", Field: "MTU", Path: "config/mtu"},
+		{Column: "admin_state", Field: "AdminStatus"},
+		{Column: "admin_state", Field: "Enabled", Path: "config/enabled",
+			Parse: func(s string) (interface{}, error) { return s != "down", nil }},
+		{Column: "link_state", Field: "LinkStatus"},
+		{Column: "type", Field: "Type", Path: "ovs/config/type"},
+		{Column: "mac_in_use", Field: "MAC"},
+		{Column: "ifindex", Field: "IfIndex"},
+		{Column: "link_speed", Field: "LinkSpeed"},
+		{Column: "duplex", Field: "Duplex"},
+		{Column: "link_resets", Field: "LinkResets", Path: "state/counters/carrier-transitions"},
+		{Column: "ingress_policing_rate", Field: "IngressPolicingRate"},
+		{Column: "ingress_policing_burst", Field: "IngressPolicingBurst"},
+		{Column: "lacp_current", Field: "LACPCurrent"},
+		{Column: "options", Field: "Options"},
+		{Column: "options", Key: "remote_ip", Field: "Tunnel.RemoteIP", Path: "ovs/tunnel/config/remote-ip", OmitEmpty: true},
+		{Column: "options", Key: "local_ip", Field: "Tunnel.LocalIP", Path: "ovs/tunnel/config/local-ip", OmitEmpty: true},
+		{Column: "options", Key: "key", Field: "Tunnel.Key", Path: "ovs/tunnel/config/key", OmitEmpty: true},
+		{Column: "options", Key: "dst_port", Field: "Tunnel.DstPort", Path: "ovs/tunnel/config/dst-port", OmitEmpty: true},
+		{Column: "statistics", Key: "rx_packets", Field: "Statistics.ReceivedPackets", Path: "state/counters/in-pkts"},
+		{Column: "statistics", Key: "rx_errors", Field: "Statistics.ReceivedErrors", Path: "state/counters/in-errors"},
+		{Column: "statistics", Key: "rx_dropped", Field: "Statistics.ReceivedDropped", Path: "state/counters/in-discards"},
+		{Column: "statistics", Key: "tx_packets", Field: "Statistics.TransmittedPackets", Path: "state/counters/out-pkts"},
+		{Column: "statistics", Key: "tx_errors", Field: "Statistics.TransmittedErrors", Path: "state/counters/out-errors"},
+		{Column: "statistics", Key: "tx_dropped", Field: "Statistics.TransmittedDropped", Path: "state/counters/out-discards"},
+		{Column: "statistics", Key: "rx_bytes", Field: "Statistics.ReceivedBytes", Path: "state/counters/in-octets"},
+		{Column: "statistics", Key: "rx_crc_err", Field: "Statistics.ReceivedCRCErrors", Path: "state/counters/in-fcs-errors"},
+		{Column: "statistics", Key: "rx_multicast_packets", Field: "Statistics.ReceivedMulticastPackets", Path: "state/counters/in-multicast-pkts"},
+		{Column: "statistics", Key: "rx_broadcast_packets", Field: "Statistics.ReceivedBroadcastPackets", Path: "state/counters/in-broadcast-pkts"},
+		{Column: "statistics", Key: "tx_bytes", Field: "Statistics.TransmittedBytes", Path: "state/counters/out-octets"},
+		{Column: "statistics", Key: "tx_multicast_packets", Field: "Statistics.TransmittedMulticastPackets", Path: "state/counters/out-multicast-pkts"},
+		{Column: "statistics", Key: "tx_broadcast_packets", Field: "Statistics.TransmittedBroadcastPackets", Path: "state/counters/out-broadcast-pkts"},
+		{Column: "statistics", Key: "rx_oversize_errors", Field: "Statistics.ReceivedOversizeErrors"},
+		{Column: "statistics", Key: "rx_undersized_errors", Field: "Statistics.ReceivedUndersizedErrors"},
+		{Column: "statistics", Key: "rx_jabber_errors", Field: "Statistics.ReceivedJabberErrors"},
+		{Column: "statistics", Key: "rx_fragmented_errors", Field: "Statistics.ReceivedFragmentedErrors"},
+		{Column: "statistics", Field: "Statistics.Counters"},
+		{Column: "lldp", Key: "enable", Field: "LLDPEnable"},
+	},
+	PortTable: {
+		{Column: "name", Field: "Name"},
+		{Column: "interfaces", Field: "Interfaces"},
+		{Column: "vlan_mode", Field: "VLANMode"},
+		{Column: "tag", Field: "Tag"},
+		{Column: "trunks", Field: "Trunks"},
+		{Column: "bond_mode", Field: "Bond.Mode"},
+		{Column: "bond_updelay", Field: "Bond.Updelay"},
+		{Column: "bond_downdelay", Field: "Bond.Downdelay"},
+		{Column: "bond_active_slave", Field: "BondActiveSlave"},
+		{Column: "lacp", Field: "Bond.LACP"},
+		{Column: "other_config", Key: "lacp-time", Field: "Bond.LACPTime"},
+		{Column: "other_config", Key: "lacp-system-id", Field: "Bond.LACPSystemID"},
+		{Column: "other_config", Key: "lacp-system-priority", Field: "Bond.LACPSystemPriority"},
+		{Column: "other_config", Key: "stp-path-cost", Field: "SpanningTree.STP.PathCost"},
+		{Column: "other_config", Key: "stp-port-priority", Field: "SpanningTree.STP.Priority"},
+		{Column: "other_config", Key: "rstp-path-cost", Field: "SpanningTree.RSTP.PathCost"},
+		{Column: "other_config", Key: "rstp-port-priority", Field: "SpanningTree.RSTP.Priority"},
+		{Column: "status", Key: "stp_port_id", Field: "SpanningTree.PortID"},
+		{Column: "status", Key: "stp_role", Field: "SpanningTree.Role"},
+		{Column: "status", Key: "stp_state", Field: "SpanningTree.State"},
+		{Column: "statistics", Key: "stp_tx_count", Field: "SpanningTree.BPDUSent"},
+		{Column: "statistics", Key: "stp_rx_count", Field: "SpanningTree.BPDUReceived"},
+		{Column: "rstp_status", Key: "rstp_port_id", Field: "SpanningTree.PortID"},
+		{Column: "rstp_status", Key: "rstp_port_role", Field: "SpanningTree.Role"},
+		{Column: "rstp_status", Key: "rstp_port_state", Field: "SpanningTree.State"},
+		{Column: "rstp_status", Key: "rstp_designated_bridge_id", Field: "SpanningTree.DesignatedBridgeID"},
+		{Column: "rstp_status", Key: "rstp_designated_port_id", Field: "SpanningTree.DesignatedPortID"},
+		{Column: "rstp_status", Key: "rstp_designated_path_cost", Field: "SpanningTree.DesignatedPathCost"},
+		{Column: "rstp_statistics", Key: "rstp_tx_count", Field: "SpanningTree.BPDUSent"},
+		{Column: "rstp_statistics", Key: "rstp_rx_count", Field: "SpanningTree.BPDUReceived"},
+	},
+	BridgeTable: {
+		{Column: "name", Field: "Name"},
+		{Column: "ports", Field: "Ports"},
+		{Column: "mirrors", Field: "Mirrors"},
+		{Column: "controller", Field: "Controllers"},
+		{Column: "protocols", Field: "Protocols"},
+		{Column: "fail_mode", Field: "FailMode"},
+		{Column: "datapath_id", Field: "DatapathID"},
+		{Column: "other_config", Key: "datapath-id", Field: "ConfiguredDatapathID"},
+		{Column: "stp_enable", Field: "STPEnable"},
+		{Column: "rstp_enable", Field: "RSTPEnable"},
+		{Column: "other_config", Key: "stp-priority", Field: "STP.Priority"},
+		{Column: "other_config", Key: "stp-hello-time", Field: "STP.HelloTime"},
+		{Column: "other_config", Key: "stp-max-age", Field: "STP.MaxAge"},
+		{Column: "other_config", Key: "stp-forward-delay", Field: "STP.ForwardDelay"},
+		{Column: "other_config", Key: "rstp-priority", Field: "RSTP.Priority"},
+		{Column: "other_config", Key: "rstp-hello-time", Field: "RSTP.HelloTime"},
+		{Column: "other_config", Key: "rstp-max-age", Field: "RSTP.MaxAge"},
+		{Column: "other_config", Key: "rstp-forward-delay", Field: "RSTP.ForwardDelay"},
+		{Column: "other_config", Key: "rstp-transmit-hold-count", Field: "RSTP.HoldCount"},
+		{Column: "status", Key: "stp_bridge_id", Field: "SpanningTree.BridgeID"},
+		{Column: "status", Key: "stp_designated_root", Field: "SpanningTree.RootID"},
+		{Column: "status", Key: "stp_root_path_cost", Field: "SpanningTree.RootPathCost"},
+		{Column: "rstp_status", Key: "rstp_bridge_id", Field: "SpanningTree.BridgeID"},
+		{Column: "rstp_status", Key: "rstp_root_id", Field: "SpanningTree.RootID"},
+		{Column: "rstp_status", Key: "rstp_root_path_cost", Field: "SpanningTree.RootPathCost"},
+	},
+	MirrorTable: {
+		{Column: "name", Field: "Name"},
+		{Column: "select_all", Field: "SelectAll", Path: "config/select-all"},
+		{Column: "select_src_port", Field: "SelectSrcPorts"},
+		{Column: "select_dst_port", Field: "SelectDstPorts"},
+		{Column: "select_vlan", Field: "SelectVLANs"},
+		{Column: "output_port", Field: "OutputPort"},
+		{Column: "output_vlan", Field: "OutputVLAN", Path: "config/output-vlan"},
+		{Column: "statistics", Key: "tx_packets", Field: "TxPackets", Path: "state/counters/tx-packets"},
+		{Column: "statistics", Key: "tx_bytes", Field: "TxBytes", Path: "state/counters/tx-bytes"},
+	},
+	QoSTable: {
+		{Column: "type", Field: "Type", Path: "config/type"},
+		{Column: "other_config", Key: "max-rate", Field: "MaxRate", Path: "config/max-rate"},
+	},
+	QueueTable: {
+		{Column: "other_config", Key: "min-rate", Field: "MinRate", Path: "config/min-rate"},
+		{Column: "other_config", Key: "max-rate", Field: "MaxRate", Path: "config/max-rate"},
+	},
+	SFlowTable: {
+		{Column: "targets", Field: "Targets"},
+		{Column: "sampling", Field: "Sampling", Path: "config/sampling"},
+		{Column: "polling", Field: "Polling", Path: "config/polling"},
+		{Column: "header", Field: "Header", Path: "config/header"},
+		{Column: "agent", Field: "Agent", Path: "config/agent", OmitEmpty: true},
+	},
+	NetFlowTable: {
+		{Column: "targets", Field: "Targets"},
+		{Column: "engine_type", Field: "EngineType", Path: "config/engine-type"},
+		{Column: "engine_id", Field: "EngineID", Path: "config/engine-id"},
+		{Column: "active_timeout", Field: "ActiveTimeout", Path: "config/active-timeout"},
+	},
+	IPFIXTable: {
+		{Column: "targets", Field: "Targets"},
+		{Column: "sampling", Field: "Sampling", Path: "config/sampling"},
+		{Column: "obs_domain_id", Field: "ObsDomainID", Path: "config/obs-domain-id"},
+		{Column: "obs_point_id", Field: "ObsPointID", Path: "config/obs-point-id"},
+	},
+}
+
+// tableNodes are the ygot structs the rows of a table with published mappings are published as.
+var tableNodes = map[string]reflect.Type{
+	SystemTable:    reflect.TypeOf(oc.System{}),
+	InterfaceTable: reflect.TypeOf(oc.Interface{}),
+	MirrorTable:    reflect.TypeOf(oc.Mirror{}),
+	QoSTable:       reflect.TypeOf(oc.Interface_Qos{}),
+	QueueTable:     reflect.TypeOf(oc.Interface_Qos_Queue{}),
+	SFlowTable:     reflect.TypeOf(oc.Bridge_Sflow{}),
+	NetFlowTable:   reflect.TypeOf(oc.Bridge_Netflow{}),
+	IPFIXTable:     reflect.TypeOf(oc.Bridge_Ipfix{}),
+}
+
+// MappedKeys returns the keys of a map column which are mapped to a field of their own.
+func MappedKeys(table, column string) map[string]bool {
+	keys := make(map[string]bool)
+	for _, m := range tableMappings[table] {
+		if m.Column == column && m.Key != "" {
+			keys[m.Key] = true
+		}
+	}
+
+	return keys
+}
+
+// DecodeRow sets the fields of obj, a pointer to a struct, from the columns of the row according to the mappings of
+// its table. Columns without a value leave their field unset. A column which cannot be decoded does not stop the
+// others, all failures are returned together.
+func DecodeRow(r *RowReader, obj interface{}) error {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unable to decode row of table %v into %T", r.Table, obj)
+	}
+
+	var failures []string
+	for _, m := range tableMappings[r.Table] {
+		field, err := fieldByName(v.Elem(), m.Field)
+		if err == nil {
+			err = m.decode(r, field)
+		}
+
+		if err != nil && err != ErrNoValue {
+			failures = append(failures, fmt.Sprintf("%v: %v", m.name(), err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("unable to decode row of table %v: %v", r.Table, strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// PublishRow sets the leaves of node, a pointer to the ygot struct the rows of table are published as, from the
+// fields of obj which have a Path. Fields which are nil pointers, or empty with OmitEmpty, leave their leaf unset.
+func PublishRow(table string, obj interface{}, node interface{}) error {
+	if err := checkTableNode(table, node); err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(obj)
+	for _, m := range tableMappings[table] {
+		if m.Path == "" {
+			continue
+		}
+
+		field, ok := lookupField(v, m.Field)
+		if !ok || (m.OmitEmpty && field.IsZero()) {
+			continue
+		}
+
+		if err := setLeaf(reflect.ValueOf(node).Elem(), strings.Split(m.Path, "/"), field); err != nil {
+			return fmt.Errorf("unable to publish %v at %v: %v", m.name(), m.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// ReadRowJSON sets the fields of obj, a pointer to a struct, which have a Path from entry, the IETF JSON of the node
+// the rows of table are published as. Leaves missing in entry leave their field unchanged, leaves of the wrong type
+// are returned as error.
+func ReadRowJSON(table string, obj interface{}, entry map[string]interface{}) error {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unable to read %T of table %v", obj, table)
+	}
+
+	var failures []string
+	for _, m := range tableMappings[table] {
+		if m.Path == "" {
+			continue
+		}
+
+		value, ok := jsonLeaf(entry, strings.Split(m.Path, "/"))
+		if !ok {
+			continue
+		}
+
+		field, err := fieldByName(v.Elem(), m.Field)
+		if err == nil {
+			err = setFromJSON(field, value)
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%v: %v", m.Path, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("unable to read %v: %v", table, strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+func checkTableNode(table string, node interface{}) error {
+	t, ok := tableNodes[table]
+	if !ok {
+		return fmt.Errorf("table %v is not published as a node", table)
+	}
+
+	if nt := reflect.TypeOf(node); nt == nil || nt.Kind() != reflect.Ptr || nt.Elem() != t || reflect.ValueOf(node).IsNil() {
+		return fmt.Errorf("rows of table %v are published as %v, not %T", table, t, node)
+	}
+
+	return nil
+}
+
+// lookupField returns the possibly nested field of a struct without allocating anything, reporting false if a struct
+// pointer on the way or the field itself is a nil pointer.
+func lookupField(v reflect.Value, name string) (reflect.Value, bool) {
+	for _, part := range strings.Split(name, ".") {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		if v = v.FieldByName(part); !v.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+
+	return v, true
+}
+
+// setLeaf sets the leaf at path below the ygot struct v, allocating the containers on the way. The fields of a ygot
+// struct carry their path relative to the struct in their path tag, alternatives being separated by "|".
+func setLeaf(v reflect.Value, path []string, value reflect.Value) error {
+	for i := 0; i < v.NumField(); i++ {
+		ft := v.Type().Field(i)
+		for _, p := range strings.Split(ft.Tag.Get("path"), "|") {
+			elems := strings.Split(p, "/")
+			if p == "" || len(elems) > len(path) || strings.Join(path[:len(elems)], "/") != p {
+				continue
+			}
+
+			field := v.Field(i)
+			if len(elems) < len(path) {
+				if field.Kind() != reflect.Ptr || field.Type().Elem().Kind() != reflect.Struct {
+					return fmt.Errorf("%v is not a container", p)
+				}
+				if field.IsNil() {
+					field.Set(reflect.New(field.Type().Elem()))
+				}
+				return setLeaf(field.Elem(), path[len(elems):], value)
+			}
+
+			leafType := field.Type()
+			if leafType.Kind() == reflect.Ptr {
+				leafType = leafType.Elem()
+			}
+			if value.Kind() != leafType.Kind() {
+				return fmt.Errorf("%v cannot be published as %v", value.Type(), leafType)
+			}
+
+			leaf := reflect.New(leafType)
+			leaf.Elem().Set(value.Convert(leafType))
+			if field.Kind() == reflect.Ptr {
+				field.Set(leaf)
+			} else {
+				field.Set(leaf.Elem())
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%v is not part of %v", strings.Join(path, "/"), v.Type())
+}
+
+// jsonLeaf returns the leaf at path below a node of the IETF JSON tree, whose names may carry a module prefix.
+func jsonLeaf(node map[string]interface{}, path []string) (interface{}, bool) {
+	for n, elem := range path {
+		var value interface{}
+		found := false
+		for name, v := range node {
+			if name == elem || strings.HasSuffix(name, ":"+elem) {
+				value, found = v, true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+
+		if n == len(path)-1 {
+			return value, true
+		}
+
+		if node, found = value.(map[string]interface{}); !found {
+			return nil, false
+		}
+	}
+
+	return nil, false
+}
+
+// setFromJSON sets a field, or the value a pointer field points to, from a leaf of the IETF JSON tree, which encodes
+// 64 bit integers as strings.
+func setFromJSON(field reflect.Value, value interface{}) error {
+	t := field.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	v := reflect.ValueOf(value)
+	switch {
+	case v.Kind() == reflect.String && t.Kind() == reflect.Uint64:
+		u, err := strconv.ParseUint(v.String(), 10, 64)
+		if err != nil {
+			return err
+		}
+		v = reflect.ValueOf(u).Convert(t)
+	case v.Kind() == reflect.String && t.Kind() == reflect.Int64:
+		i, err := strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return err
+		}
+		v = reflect.ValueOf(i).Convert(t)
+	case v.Kind() != t.Kind():
+		return fmt.Errorf("%T cannot be read as %v", value, t)
+	default:
+		v = v.Convert(t)
+	}
+
+	if field.Kind() == reflect.Ptr {
+		p := reflect.New(t)
+		p.Elem().Set(v)
+		field.Set(p)
+	} else {
+		field.Set(v)
+	}
+
+	return nil
+}
+
+// fieldByName returns the possibly nested field of a struct, allocating nil struct pointers on the way.
+func fieldByName(v reflect.Value, name string) (reflect.Value, error) {
+	for _, part := range strings.Split(name, ".") {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("field %v is not within a struct", name)
+		}
+
+		v = v.FieldByName(part)
+		if !v.IsValid() || !v.CanSet() {
+			return reflect.Value{}, fmt.Errorf("field %v does not exist or is not exported", name)
+		}
+	}
+
+	return v, nil
+}
+
+// decode reads the column with the accessor matching the type of the field and sets the field.
+func (m *ColumnMapping) decode(r *RowReader, field reflect.Value) error {
+	if m.Parse != nil {
+		s, err := m.stringValue(r)
+		if err != nil {
+			return err
+		}
+
+		value, err := m.Parse(s)
+		if err != nil {
+			return err
+		}
+
+		pv := reflect.ValueOf(value)
+		if !pv.IsValid() || !pv.Type().AssignableTo(field.Type()) {
+			return fmt.Errorf("parsed value %v cannot be assigned to field %v", value, m.Field)
+		}
+		field.Set(pv)
+
+		return nil
+	}
+
+	// Pointers to plain Go types stay nil unless the column has a value.
+	if field.Kind() == reflect.Ptr && field.Type().Elem().Kind() != reflect.Struct {
+		value := reflect.New(field.Type().Elem())
+		if err := m.decode(r, value.Elem()); err != nil {
+			return err
+		}
+		field.Set(value)
+
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		s, err := m.stringValue(r)
+		if err != nil {
+			return err
+		}
+		field.SetString(s)
+	case reflect.Bool:
+		var b bool
+		var err error
+		if m.Key != "" {
+			b, err = r.MapBool(m.Column, m.Key)
+		} else {
+			b, err = r.Bool(m.Column)
+		}
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := m.integerValue(r)
+		if err != nil {
+			return err
+		}
+		if err := setInteger(field, i); err != nil {
+			return fmt.Errorf("%v of field %v", err, m.Field)
+		}
+	case reflect.Float32, reflect.Float64:
+		f, err := r.Real(m.Column)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		return m.decodeSlice(r, field)
+	case reflect.Map:
+		if field.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %v of field %v", field.Type(), m.Field)
+		}
+		return m.decodeMap(r, field)
+	default:
+		return fmt.Errorf("unsupported type %v of field %v", field.Type(), m.Field)
+	}
+
+	return nil
+}
+
+func (m *ColumnMapping) decodeSlice(r *RowReader, field reflect.Value) error {
+	switch field.Type().Elem().Kind() {
+	case reflect.String:
+		values, err := m.stringSet(r)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(values))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		values, err := r.IntegerSet(m.Column)
+		if err != nil {
+			return err
+		}
+		result := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for n, i := range values {
+			if err := setInteger(result.Index(n), i); err != nil {
+				return fmt.Errorf("%v of field %v", err, m.Field)
+			}
+		}
+		field.Set(result)
+	default:
+		return fmt.Errorf("unsupported type %v of field %v", field.Type(), m.Field)
+	}
+
+	return nil
+}
+
+func (m *ColumnMapping) decodeMap(r *RowReader, field reflect.Value) error {
+	switch field.Type().Elem().Kind() {
+	case reflect.String:
+		values, err := r.StringMap(m.Column)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(values))
+	case reflect.Int64:
+		values, err := r.IntegerMap(m.Column)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(values))
+	case reflect.Uint64:
+		values, err := r.IntegerMap(m.Column)
+		if err != nil {
+			return err
+		}
+		result := make(map[string]uint64, len(values))
+		for key, i := range values {
+			if i < 0 {
+				continue
+			}
+			result[key] = uint64(i)
+		}
+		field.Set(reflect.ValueOf(result))
+	default:
+		return fmt.Errorf("unsupported type %v of field %v", field.Type(), m.Field)
+	}
+
+	return nil
+}
+
+// setInteger sets a signed or unsigned integer field, failing if the value does not fit.
+func setInteger(field reflect.Value, i int64) error {
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if field.OverflowInt(i) {
+			return fmt.Errorf("value %v overflows", i)
+		}
+		field.SetInt(i)
+	default:
+		if i < 0 || field.OverflowUint(uint64(i)) {
+			return fmt.Errorf("value %v overflows", i)
+		}
+		field.SetUint(uint64(i))
+	}
+
+	return nil
+}
+
+// stringSet returns the elements of a set of strings or of UUID references.
+func (m *ColumnMapping) stringSet(r *RowReader) ([]string, error) {
+	set, err := r.Set(m.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(set))
+	for _, v := range set {
+		s, err := toString(v)
+		if err != nil {
+			if s, err = toUUID(v); err != nil {
+				return nil, r.wrap(m.Column, fmt.Errorf("value %v is neither a string nor a UUID", v))
+			}
+		}
+		values = append(values, s)
+	}
+
+	return values, nil
+}
+
+func (m *ColumnMapping) name() string {
+	if m.Key != "" {
+		return fmt.Sprintf("%v:%v", m.Column, m.Key)
+	}
+
+	return m.Column
+}
+
+// stringValue returns the value of a string column or the row referenced by a UUID column.
+func (m *ColumnMapping) stringValue(r *RowReader) (string, error) {
+	if m.Key != "" {
+		return r.MapString(m.Column, m.Key)
+	}
+
+	s, err := r.String(m.Column)
+	if err != nil && err != ErrNoValue {
+		if u, uuidErr := r.UUID(m.Column); uuidErr == nil || uuidErr == ErrNoValue {
+			return u, uuidErr
+		}
+	}
+
+	return s, err
+}
+
+func (m *ColumnMapping) integerValue(r *RowReader) (int64, error) {
+	if m.Key != "" {
+		return r.MapInteger(m.Column, m.Key)
+	}
+
+	return r.Integer(m.Column)
+}
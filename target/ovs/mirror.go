@@ -0,0 +1,242 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Mirror is a port mirror of a bridge. Ports are referenced by the uuid of their row as in OVSDB.
+type Mirror struct {
+	uuid           string
+	Name           string
+	SelectAll      bool
+	SelectSrcPorts []string
+	SelectDstPorts []string
+	SelectVLANs    []uint16
+	OutputPort     string
+	OutputVLAN     *uint16
+	TxPackets      *uint64
+	TxBytes        *uint64
+
+	// Bridge is the bridge of the mirror, or the bridge a new mirror is to be added to.
+	Bridge string
+}
+
+func (m *Mirror) Equal(comp *Mirror) bool {
+	switch {
+	case m.Name != comp.Name:
+		return false
+	case m.SelectAll != comp.SelectAll:
+		return false
+	case !equalStrings(m.SelectSrcPorts, comp.SelectSrcPorts):
+		return false
+	case !equalStrings(m.SelectDstPorts, comp.SelectDstPorts):
+		return false
+	case !equalVLANs(m.SelectVLANs, comp.SelectVLANs):
+		return false
+	case m.OutputPort != comp.OutputPort:
+		return false
+	case (m.OutputVLAN == nil) != (comp.OutputVLAN == nil):
+		return false
+	case m.OutputVLAN != nil && *m.OutputVLAN != *comp.OutputVLAN:
+		return false
+	default:
+		return true
+	}
+}
+
+func (m *Mirror) String() string {
+	var outputVLAN string
+	if m.OutputVLAN != nil {
+		outputVLAN = strconv.FormatUint(uint64(*m.OutputVLAN), 10)
+	}
+
+	return fmt.Sprintf("Mirror(uuid: \"%v\", Name: \"%v\", Bridge: \"%v\", SelectAll: \"%v\", SelectSrcPorts: \"%v\", SelectDstPorts: \"%v\", SelectVLANs: \"%v\", OutputPort: \"%v\", OutputVLAN: \"%v\")",
+		m.uuid, m.Name, m.Bridge, m.SelectAll, m.SelectSrcPorts, m.SelectDstPorts, m.SelectVLANs, m.OutputPort, outputVLAN)
+}
+
+func copyMirror(m *Mirror) *Mirror {
+	c := *m
+	c.SelectSrcPorts = append([]string(nil), m.SelectSrcPorts...)
+	c.SelectDstPorts = append([]string(nil), m.SelectDstPorts...)
+	c.SelectVLANs = append([]uint16(nil), m.SelectVLANs...)
+
+	return &c
+}
+
+// PortName returns the name of the port with the given uuid, or an empty string if it is unknown.
+func (c *ObjectCache) PortName(uuid string) string {
+	for _, p := range c.Ports {
+		if p.uuid == uuid {
+			return p.Name
+		}
+	}
+
+	return ""
+}
+
+// BridgeOfMirror returns the bridge a mirror belongs to, or nil if it is not part of any bridge.
+func (c *ObjectCache) BridgeOfMirror(m *Mirror) *Bridge {
+	for _, b := range c.Bridges {
+		for _, uuid := range b.Mirrors {
+			if uuid == m.uuid {
+				return b
+			}
+		}
+	}
+
+	return nil
+}
+
+// overwriteMirrorsWithJSON replaces the mirrors of the cache with those of the IETF JSON tree. Mirrors missing in the
+// tree have been deleted, new ones are added without a uuid. A mirror referencing an unknown port is left as it is.
+func overwriteMirrorsWithJSON(cache *ObjectCache, jsonConfig map[string]interface{}) {
+	mirrors, _ := jsonConfig["ovs-mirrors:mirrors"].(map[string]interface{})
+	list, _ := mirrors["mirror"].([]interface{})
+	configured := make(map[string]bool)
+
+	for _, i := range list {
+		entry, _ := i.(map[string]interface{})
+		config, _ := entry["config"].(map[string]interface{})
+
+		name, ok := config["name"].(string)
+		if !ok {
+			continue
+		}
+		configured[name] = true
+
+		m := &Mirror{Name: name}
+		if prev, ok := cache.Mirrors[name]; ok {
+			m = copyMirror(prev)
+		}
+
+		if err := m.readJSON(cache, entry); err != nil {
+			log.Errorf("Unable to read mirror %v: %v", name, err)
+			continue
+		}
+
+		cache.Mirrors[name] = m
+	}
+
+	for name := range cache.Mirrors {
+		if !configured[name] {
+			delete(cache.Mirrors, name)
+		}
+	}
+}
+
+// readJSON sets the configuration of the mirror from its list entry in the IETF JSON tree.
+func (m *Mirror) readJSON(cache *ObjectCache, entry map[string]interface{}) error {
+	config, _ := entry["config"].(map[string]interface{})
+
+	portUUID := func(name string) (string, error) {
+		p, ok := cache.Ports[name]
+		if !ok || p.uuid == "" {
+			return "", fmt.Errorf("unknown port %v", name)
+		}
+		return p.uuid, nil
+	}
+
+	portUUIDs := func(leaf string) ([]string, error) {
+		names, _ := config[leaf].([]interface{})
+
+		var uuids []string
+		for _, n := range names {
+			name, _ := n.(string)
+			uuid, err := portUUID(name)
+			if err != nil {
+				return nil, err
+			}
+			uuids = append(uuids, uuid)
+		}
+
+		return uuids, nil
+	}
+
+	var err error
+	if m.SelectSrcPorts, err = portUUIDs("select-src-port"); err != nil {
+		return err
+	}
+	if m.SelectDstPorts, err = portUUIDs("select-dst-port"); err != nil {
+		return err
+	}
+
+	m.OutputPort = ""
+	if name, ok := config["output-port"].(string); ok {
+		if m.OutputPort, err = portUUID(name); err != nil {
+			return err
+		}
+	}
+
+	m.SelectAll, m.OutputVLAN = false, nil
+	if err := ReadRowJSON(MirrorTable, m, entry); err != nil {
+		return err
+	}
+
+	if m.OutputPort != "" && m.OutputVLAN != nil {
+		return fmt.Errorf("output-port and output-vlan are exclusive")
+	}
+
+	m.SelectVLANs = nil
+	vlans, _ := config["select-vlan"].([]interface{})
+	for _, v := range vlans {
+		if vlan, ok := v.(uint16); ok {
+			m.SelectVLANs = append(m.SelectVLANs, vlan)
+		}
+	}
+
+	if bridge, ok := config["bridge"].(string); ok {
+		m.Bridge = bridge
+	}
+
+	return nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = append([]string(nil), a...), append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalVLANs(a, b []uint16) bool {
+	a, b = sortVLANs(a), sortVLANs(b)
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
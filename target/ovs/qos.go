@@ -0,0 +1,296 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// QoS is the egress QoS of a port, such as linux-htb or linux-hfsc, with its queues by queue number. Rates are in bits
+// per second, nil rates are unset.
+type QoS struct {
+	uuid    string
+	Type    string
+	MaxRate *uint64
+	Queues  map[uint32]*Queue
+}
+
+func (q *QoS) Equal(comp *QoS) bool {
+	if q == nil || comp == nil {
+		return q == comp
+	}
+
+	switch {
+	case q.Type != comp.Type:
+		return false
+	case !equalUint64(q.MaxRate, comp.MaxRate):
+		return false
+	case len(q.Queues) != len(comp.Queues):
+		return false
+	}
+
+	for id, queue := range q.Queues {
+		if !queue.Equal(comp.Queues[id]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (q *QoS) String() string {
+	return fmt.Sprintf("QoS(uuid: \"%v\", Type: \"%v\", MaxRate: \"%v\", Queues: \"%v\")", q.uuid, q.Type, optionalString(q.MaxRate), q.queueIDs())
+}
+
+// queueIDs returns the sorted numbers of the queues.
+func (q *QoS) queueIDs() []uint32 {
+	ids := make([]uint32, 0, len(q.Queues))
+	for id := range q.Queues {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+// Queue is a queue of a QoS. Rates are in bits per second, nil rates are unset.
+type Queue struct {
+	uuid    string
+	MinRate *uint64
+	MaxRate *uint64
+}
+
+func (q *Queue) Equal(comp *Queue) bool {
+	if q == nil || comp == nil {
+		return q == comp
+	}
+
+	return equalUint64(q.MinRate, comp.MinRate) && equalUint64(q.MaxRate, comp.MaxRate)
+}
+
+func (q *Queue) String() string {
+	return fmt.Sprintf("Queue(uuid: \"%v\", MinRate: \"%v\", MaxRate: \"%v\")", q.uuid, optionalString(q.MinRate), optionalString(q.MaxRate))
+}
+
+// QueueStatistics are the OpenFlow statistics of a queue of a port.
+type QueueStatistics struct {
+	TxPackets *uint64
+	TxBytes   *uint64
+	TxErrors  *uint64
+}
+
+func copyQoS(q *QoS) *QoS {
+	if q == nil {
+		return nil
+	}
+
+	c := *q
+	c.Queues = make(map[uint32]*Queue, len(q.Queues))
+	for id, queue := range q.Queues {
+		copied := *queue
+		c.Queues[id] = &copied
+	}
+
+	return &c
+}
+
+// sharedQoS reports whether the QoS of a port is also used by another port.
+func (c *ObjectCache) sharedQoS(port *Port) bool {
+	if port.QoS == nil {
+		return false
+	}
+
+	for _, p := range c.Ports {
+		if p != port && p.QoS != nil && p.QoS.uuid == port.QoS.uuid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveQoS sets the QoS of every port from the row its qos column references and the queues of that row. Like the
+// flow exporters, QoS and queue rows have no name to cache them by.
+func (c *Config) resolveQoS() {
+	for _, p := range c.ObjCache.Ports {
+		p.QoS = nil
+
+		portRow, ok := c.rawCache[PortTable][p.uuid]
+		if !ok {
+			continue
+		}
+
+		uuid, row, ok := c.referencedRow(c.rowReader(PortTable, portRow), "qos", QoSTable)
+		if !ok {
+			continue
+		}
+
+		qos := &QoS{uuid: uuid, Queues: make(map[uint32]*Queue)}
+		r := c.rowReader(QoSTable, row)
+		if err := DecodeRow(r, qos); err != nil {
+			log.Errorf("Unable to decode QoS of port %v: %v", p.Name, err)
+		}
+
+		queues, err := r.UUIDMap("queues")
+		if err != nil && err != ErrNoValue {
+			log.Errorf("Unable to decode queues of port %v: %v", p.Name, err)
+		}
+
+		for key, queueUUID := range queues {
+			id, err := strconv.ParseUint(key, 10, 32)
+			if err != nil {
+				log.Errorf("Unable to decode queue %v of port %v: %v", key, p.Name, err)
+				continue
+			}
+
+			queueRow, ok := c.rawCache[QueueTable][queueUUID]
+			if !ok {
+				continue
+			}
+
+			queue := &Queue{uuid: queueUUID}
+			if err := DecodeRow(c.rowReader(QueueTable, queueRow), queue); err != nil {
+				log.Errorf("Unable to decode queue %v of port %v: %v", id, p.Name, err)
+			}
+			qos.Queues[uint32(id)] = queue
+		}
+
+		p.QoS = qos
+	}
+}
+
+// readQoSJSON sets the QoS of a port from the qos container of its interface in the IETF JSON tree. Without a QoS
+// config the QoS is removed. Queues keep the rows they are configured in.
+func readQoSJSON(p *Port, qos map[string]interface{}) error {
+	config, _ := qos["config"].(map[string]interface{})
+	if config == nil {
+		p.QoS = nil
+		return nil
+	}
+
+	q := &QoS{Queues: make(map[uint32]*Queue)}
+	prev := p.QoS
+	if prev != nil {
+		q.uuid = prev.uuid
+	}
+
+	if err := ReadRowJSON(QoSTable, q, qos); err != nil {
+		return err
+	}
+	if q.Type == "" {
+		return fmt.Errorf("QoS requires a type")
+	}
+
+	queues, _ := qos["queues"].(map[string]interface{})
+	list, _ := queues["queue"].([]interface{})
+	for _, i := range list {
+		entry, _ := i.(map[string]interface{})
+		queueConfig, _ := entry["config"].(map[string]interface{})
+		id, ok := queueConfig["id"].(uint32)
+		if !ok {
+			continue
+		}
+
+		queue := &Queue{}
+		if err := ReadRowJSON(QueueTable, queue, entry); err != nil {
+			return err
+		}
+		if prev != nil && prev.Queues[id] != nil {
+			queue.uuid = prev.Queues[id].uuid
+		}
+		q.Queues[id] = queue
+	}
+
+	p.QoS = q
+
+	return nil
+}
+
+// equalQueues reports whether the statistics of the same queues are known, whatever their values are.
+func equalQueues(a, b map[string]map[uint32]*QueueStatistics) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for port, queues := range a {
+		other, ok := b[port]
+		if !ok || len(queues) != len(other) {
+			return false
+		}
+
+		for id := range queues {
+			if _, ok := other[id]; !ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ParseQueueStatistics parses the output of ovs-ofctl queue-stats for a single port into the statistics of its queues
+// by queue number. Statistics a datapath does not report, shown as ?, are left unset.
+func ParseQueueStatistics(out string) (map[uint32]*QueueStatistics, error) {
+	stats := make(map[uint32]*QueueStatistics)
+
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[0] != "port" || fields[2] != "queue" {
+			continue
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(fields[3], ":"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid queue in %q", line)
+		}
+
+		s := &QueueStatistics{}
+		for _, field := range fields[4:] {
+			kv := strings.SplitN(strings.TrimSuffix(field, ","), "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+
+			value, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				continue
+			}
+
+			switch kv[0] {
+			case "pkts":
+				s.TxPackets = &value
+			case "bytes":
+				s.TxBytes = &value
+			case "errors":
+				s.TxErrors = &value
+			}
+		}
+		stats[uint32(id)] = s
+	}
+
+	return stats, nil
+}
+
+func equalUint64(a, b *uint64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,213 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultOVSDBPort    = "6640"
+	defaultOpenFlowPort = 6653
+
+	RemoteUnix = "unix"
+	RemoteTCP  = "tcp"
+	RemoteSSL  = "ssl"
+)
+
+// Remote is an active ovsdb-server remote in ovs-vsctl syntax: "unix:FILE", "tcp:HOST:PORT" or "ssl:HOST:PORT". IPv6
+// hosts are enclosed in square brackets, such as "ssl:[::1]:6640".
+type Remote struct {
+	Protocol string
+	Address  string
+	Port     string
+}
+
+// ParseRemote parses an active ovsdb-server remote. A missing port defaults to 6640 as with ovs-vsctl.
+func ParseRemote(s string) (*Remote, error) {
+	i := strings.Index(s, ":")
+	if i < 0 {
+		return nil, fmt.Errorf("remote %q has no protocol, expected unix:, tcp: or ssl:", s)
+	}
+
+	protocol, rest := s[:i], s[i+1:]
+
+	switch protocol {
+	case RemoteUnix:
+		if rest == "" {
+			return nil, fmt.Errorf("remote %q has no socket path", s)
+		}
+		return &Remote{Protocol: protocol, Address: rest}, nil
+	case RemoteTCP, RemoteSSL:
+	default:
+		return nil, fmt.Errorf("remote %q has unsupported protocol %q, expected unix:, tcp: or ssl:", s, protocol)
+	}
+
+	host, port, err := parseHostPort(rest, defaultOVSDBPort)
+	if err != nil {
+		return nil, fmt.Errorf("remote %q %v", s, err)
+	}
+
+	return &Remote{Protocol: protocol, Address: host, Port: port}, nil
+}
+
+// String returns the remote in ovs-vsctl syntax.
+func (r *Remote) String() string {
+	if r.Protocol == RemoteUnix {
+		return fmt.Sprintf("%v:%v", r.Protocol, r.Address)
+	}
+
+	return fmt.Sprintf("%v:%v", r.Protocol, net.JoinHostPort(r.Address, r.Port))
+}
+
+// parseHostPort parses HOST[:PORT] of an active connection, IPv6 hosts being enclosed in square brackets.
+func parseHostPort(s, defaultPort string) (string, string, error) {
+	host, port := s, defaultPort
+	if strings.HasPrefix(s, "[") {
+		end := strings.Index(s, "]")
+		if end < 0 {
+			return "", "", fmt.Errorf("has an unterminated IPv6 address")
+		}
+		host = s[1:end]
+		if ip := net.ParseIP(host); ip == nil || ip.To4() != nil {
+			return "", "", fmt.Errorf("has invalid IPv6 address %q", host)
+		}
+		switch tail := s[end+1:]; {
+		case tail == "":
+		case strings.HasPrefix(tail, ":"):
+			port = tail[1:]
+		default:
+			return "", "", fmt.Errorf("has unexpected %q after the IPv6 address", tail)
+		}
+	} else if j := strings.LastIndex(s, ":"); j >= 0 {
+		host, port = s[:j], s[j+1:]
+		if strings.Contains(host, ":") {
+			return "", "", fmt.Errorf("has an IPv6 address not enclosed in square brackets")
+		}
+	}
+
+	if host == "" {
+		return "", "", fmt.Errorf("has no host")
+	}
+
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return "", "", fmt.Errorf("has invalid port %q", port)
+	}
+
+	return host, port, nil
+}
+
+// ParseOpenFlowControllerTarget parses a controller target of the OVS Controller table. Active targets are
+// "tcp:HOST[:PORT]", "ssl:HOST[:PORT]" and "unix:FILE", passive targets on which the switch listens for controllers
+// are "ptcp:[PORT][:IP]", "pssl:[PORT][:IP]" and "punix:FILE". IPv6 addresses are enclosed in square brackets and a
+// missing port defaults to 6653.
+func ParseOpenFlowControllerTarget(target string) (*OpenFlowControllerTarget, error) {
+	i := strings.Index(target, ":")
+	if i < 0 {
+		return nil, fmt.Errorf("controller target %q has no connection method", target)
+	}
+
+	method, rest := target[:i], target[i+1:]
+
+	t := &OpenFlowControllerTarget{Protocol: strings.TrimPrefix(method, "p"), Passive: strings.HasPrefix(method, "p")}
+	switch t.Protocol {
+	case RemoteUnix:
+		if rest == "" {
+			return nil, fmt.Errorf("controller target %q has no socket path", target)
+		}
+		t.Address = rest
+		return t, nil
+	case RemoteTCP, RemoteSSL:
+	default:
+		return nil, fmt.Errorf("controller target %q has unsupported connection method %q", target, method)
+	}
+
+	host, port := "", strconv.Itoa(defaultOpenFlowPort)
+	if t.Passive {
+		if j := strings.Index(rest, ":"); j >= 0 {
+			if host = rest[j+1:]; host == "" {
+				return nil, fmt.Errorf("controller target %q has an empty listening address", target)
+			}
+			rest = rest[:j]
+		}
+		if rest != "" {
+			port = rest
+		}
+
+		if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+			host = host[1 : len(host)-1]
+		}
+		if host != "" && net.ParseIP(host) == nil {
+			return nil, fmt.Errorf("controller target %q has invalid listening address %q", target, host)
+		}
+	} else {
+		var err error
+		if host, port, err = parseHostPort(rest, port); err != nil {
+			return nil, fmt.Errorf("controller target %q %v", target, err)
+		}
+	}
+
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || (p == 0 && !t.Passive) {
+		return nil, fmt.Errorf("controller target %q has invalid port %q", target, port)
+	}
+
+	t.Address, t.Port = host, uint16(p)
+	return t, nil
+}
+
+// String returns the target in the syntax of the OVS Controller table.
+func (t *OpenFlowControllerTarget) String() string {
+	method := t.Protocol
+	if t.Passive {
+		method = "p" + method
+	}
+
+	switch {
+	case t.Protocol == RemoteUnix:
+		return fmt.Sprintf("%v:%v", method, t.Address)
+	case t.Passive && t.Address == "":
+		return fmt.Sprintf("%v:%v", method, t.Port)
+	case t.Passive && strings.Contains(t.Address, ":"):
+		return fmt.Sprintf("%v:%v:[%v]", method, t.Port, t.Address)
+	case t.Passive:
+		return fmt.Sprintf("%v:%v:%v", method, t.Port, t.Address)
+	default:
+		return fmt.Sprintf("%v:%v", method, net.JoinHostPort(t.Address, strconv.FormatUint(uint64(t.Port), 10)))
+	}
+}
+
+func (t *OpenFlowControllerTarget) Equal(comp *OpenFlowControllerTarget) bool {
+	if t == nil || comp == nil {
+		return t == comp
+	}
+
+	return *t == *comp
+}
+
+// published returns the target as it is published in OpenConfig, which only has room for IP addresses, so that a
+// target read back from the gNMI config can be compared with it.
+func (t *OpenFlowControllerTarget) published() *OpenFlowControllerTarget {
+	p := *t
+	if p.Protocol != RemoteUnix && net.ParseIP(p.Address) == nil {
+		p.Address = ""
+	}
+
+	return &p
+}
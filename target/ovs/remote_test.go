@@ -0,0 +1,113 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"testing"
+)
+
+func TestParseRemote(t *testing.T) {
+	tests := []struct {
+		desc    string
+		in      string
+		want    *Remote
+		wantErr bool
+	}{
+		{desc: "unix socket", in: "unix:/var/run/openvswitch/db.sock", want: &Remote{Protocol: RemoteUnix, Address: "/var/run/openvswitch/db.sock"}},
+		{desc: "tcp with port", in: "tcp:10.0.0.1:6641", want: &Remote{Protocol: RemoteTCP, Address: "10.0.0.1", Port: "6641"}},
+		{desc: "ssl with host name", in: "ssl:target.gnxi.lan:6640", want: &Remote{Protocol: RemoteSSL, Address: "target.gnxi.lan", Port: "6640"}},
+		{desc: "default port", in: "tcp:10.0.0.1", want: &Remote{Protocol: RemoteTCP, Address: "10.0.0.1", Port: "6640"}},
+		{desc: "IPv6 with port", in: "ssl:[fd00::1]:6641", want: &Remote{Protocol: RemoteSSL, Address: "fd00::1", Port: "6641"}},
+		{desc: "IPv6 default port", in: "tcp:[::1]", want: &Remote{Protocol: RemoteTCP, Address: "::1", Port: "6640"}},
+		{desc: "no protocol", in: "10.0.0.1", wantErr: true},
+		{desc: "unsupported protocol", in: "ptcp:6640", wantErr: true},
+		{desc: "empty socket path", in: "unix:", wantErr: true},
+		{desc: "no host", in: "tcp::6640", wantErr: true},
+		{desc: "invalid port", in: "tcp:10.0.0.1:ovsdb", wantErr: true},
+		{desc: "port out of range", in: "tcp:10.0.0.1:65536", wantErr: true},
+		{desc: "zero port", in: "tcp:10.0.0.1:0", wantErr: true},
+		{desc: "IPv6 without brackets", in: "tcp:fd00::1:6640", wantErr: true},
+		{desc: "unterminated IPv6", in: "tcp:[fd00::1:6640", wantErr: true},
+		{desc: "IPv4 in brackets", in: "tcp:[10.0.0.1]:6640", wantErr: true},
+		{desc: "garbage after IPv6", in: "tcp:[fd00::1]6640", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseRemote(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%v: ParseRemote(%q) = %+v, want error", tt.desc, tt.in, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%v: ParseRemote(%q) returned error: %v", tt.desc, tt.in, err)
+			continue
+		}
+		if *got != *tt.want {
+			t.Errorf("%v: ParseRemote(%q) = %+v, want %+v", tt.desc, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseOpenFlowControllerTarget(t *testing.T) {
+	tests := []struct {
+		desc    string
+		in      string
+		want    *OpenFlowControllerTarget
+		wantErr bool
+	}{
+		{desc: "tcp", in: "tcp:10.0.0.1:6654", want: &OpenFlowControllerTarget{Protocol: RemoteTCP, Address: "10.0.0.1", Port: 6654}},
+		{desc: "ssl default port", in: "ssl:10.0.0.1", want: &OpenFlowControllerTarget{Protocol: RemoteSSL, Address: "10.0.0.1", Port: 6653}},
+		{desc: "ssl IPv6", in: "ssl:[fd00::1]:6653", want: &OpenFlowControllerTarget{Protocol: RemoteSSL, Address: "fd00::1", Port: 6653}},
+		{desc: "host name", in: "tcp:faucet.lan:6653", want: &OpenFlowControllerTarget{Protocol: RemoteTCP, Address: "faucet.lan", Port: 6653}},
+		{desc: "unix", in: "unix:/var/run/controller.sock", want: &OpenFlowControllerTarget{Protocol: RemoteUnix, Address: "/var/run/controller.sock"}},
+		{desc: "punix", in: "punix:/var/run/br0.mgmt", want: &OpenFlowControllerTarget{Protocol: RemoteUnix, Address: "/var/run/br0.mgmt", Passive: true}},
+		{desc: "ptcp with port", in: "ptcp:6653", want: &OpenFlowControllerTarget{Protocol: RemoteTCP, Port: 6653, Passive: true}},
+		{desc: "ptcp default port", in: "ptcp:", want: &OpenFlowControllerTarget{Protocol: RemoteTCP, Port: 6653, Passive: true}},
+		{desc: "ptcp any port", in: "ptcp:0", want: &OpenFlowControllerTarget{Protocol: RemoteTCP, Passive: true}},
+		{desc: "ptcp listening address", in: "ptcp:6653:127.0.0.1", want: &OpenFlowControllerTarget{Protocol: RemoteTCP, Address: "127.0.0.1", Port: 6653, Passive: true}},
+		{desc: "pssl IPv6 listening address", in: "pssl:6653:[::1]", want: &OpenFlowControllerTarget{Protocol: RemoteSSL, Address: "::1", Port: 6653, Passive: true}},
+		{desc: "no method", in: "10.0.0.1", wantErr: true},
+		{desc: "unsupported method", in: "udp:10.0.0.1:6653", wantErr: true},
+		{desc: "empty socket path", in: "punix:", wantErr: true},
+		{desc: "no host", in: "tcp::6653", wantErr: true},
+		{desc: "zero port", in: "tcp:10.0.0.1:0", wantErr: true},
+		{desc: "invalid passive port", in: "ptcp:openflow", wantErr: true},
+		{desc: "empty listening address", in: "ptcp:6653:", wantErr: true},
+		{desc: "listening host name", in: "ptcp:6653:faucet.lan", wantErr: true},
+		{desc: "IPv6 without brackets", in: "ssl:fd00::1:6653", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseOpenFlowControllerTarget(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%v: ParseOpenFlowControllerTarget(%q) = %+v, want error", tt.desc, tt.in, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%v: ParseOpenFlowControllerTarget(%q) returned error: %v", tt.desc, tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%v: ParseOpenFlowControllerTarget(%q) = %+v, want %+v", tt.desc, tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,505 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"errors"
+	"fmt"
+	"github.com/socketplane/libovsdb"
+	"math"
+	"strconv"
+)
+
+// ErrNoValue is returned by the accessors of RowReader for a column which is missing in the row, an optional column
+// whose set is empty or a map key which is not present.
+var ErrNoValue = errors.New("column has no value")
+
+// RowReader provides typed access to the columns of an OVSDB row. If the table schema of the server is known, columns
+// are checked against it, so that a column which does not exist in the schema is reported rather than silently unset.
+// The OVSDB notation encodes a set of exactly one element as the bare element and an optional value as a set of zero
+// or one element, the accessors accept both.
+type RowReader struct {
+	Table  string
+	Row    libovsdb.Row
+	Schema *libovsdb.TableSchema
+}
+
+func NewRowReader(table string, row libovsdb.Row, schema *libovsdb.TableSchema) *RowReader {
+	return &RowReader{Table: table, Row: row, Schema: schema}
+}
+
+// String returns the value of an optional or mandatory string column.
+func (r *RowReader) String(column string) (string, error) {
+	v, err := r.atom(column, "string")
+	if err != nil {
+		return "", err
+	}
+
+	s, err := toString(v)
+	return s, r.wrap(column, err)
+}
+
+// Integer returns the value of an optional or mandatory integer column.
+func (r *RowReader) Integer(column string) (int64, error) {
+	v, err := r.atom(column, "integer")
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := toInteger(v)
+	return i, r.wrap(column, err)
+}
+
+// Real returns the value of an optional or mandatory real or integer column.
+func (r *RowReader) Real(column string) (float64, error) {
+	v, err := r.atom(column, "real", "integer")
+	if err != nil {
+		return 0, err
+	}
+
+	f, ok := v.(float64)
+	if !ok {
+		return 0, r.wrap(column, fmt.Errorf("value %v is not a number", v))
+	}
+
+	return f, nil
+}
+
+// Bool returns the value of an optional or mandatory boolean column.
+func (r *RowReader) Bool(column string) (bool, error) {
+	v, err := r.atom(column, "boolean")
+	if err != nil {
+		return false, err
+	}
+
+	b, err := toBool(v)
+	return b, r.wrap(column, err)
+}
+
+// UUID returns the referenced row of an optional or mandatory UUID column.
+func (r *RowReader) UUID(column string) (string, error) {
+	v, err := r.atom(column, "uuid")
+	if err != nil {
+		return "", err
+	}
+
+	u, err := toUUID(v)
+	return u, r.wrap(column, err)
+}
+
+// Set returns the elements of a set column, which are empty for an empty set.
+func (r *RowReader) Set(column string) ([]interface{}, error) {
+	if err := r.checkType(column, false); err != nil {
+		return nil, err
+	}
+
+	v, err := r.value(column)
+	if err != nil {
+		return nil, err
+	}
+
+	if set, ok := v.(libovsdb.OvsSet); ok {
+		return set.GoSet, nil
+	}
+
+	return []interface{}{v}, nil
+}
+
+// StringSet returns the elements of a set of strings.
+func (r *RowReader) StringSet(column string) ([]string, error) {
+	if err := r.checkType(column, false, "string"); err != nil {
+		return nil, err
+	}
+
+	set, err := r.Set(column)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(set))
+	for _, v := range set {
+		s, err := toString(v)
+		if err != nil {
+			return nil, r.wrap(column, err)
+		}
+		values = append(values, s)
+	}
+
+	return values, nil
+}
+
+// IntegerSet returns the elements of a set of integers.
+func (r *RowReader) IntegerSet(column string) ([]int64, error) {
+	if err := r.checkType(column, false, "integer"); err != nil {
+		return nil, err
+	}
+
+	set, err := r.Set(column)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]int64, 0, len(set))
+	for _, v := range set {
+		i, err := toInteger(v)
+		if err != nil {
+			return nil, r.wrap(column, err)
+		}
+		values = append(values, i)
+	}
+
+	return values, nil
+}
+
+// UUIDSet returns the referenced rows of a set of UUIDs.
+func (r *RowReader) UUIDSet(column string) ([]string, error) {
+	if err := r.checkType(column, false, "uuid"); err != nil {
+		return nil, err
+	}
+
+	set, err := r.Set(column)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(set))
+	for _, v := range set {
+		u, err := toUUID(v)
+		if err != nil {
+			return nil, r.wrap(column, err)
+		}
+		values = append(values, u)
+	}
+
+	return values, nil
+}
+
+// Map returns the entries of a map column with their keys formatted as strings, which are empty for an empty map.
+func (r *RowReader) Map(column string) (map[string]interface{}, error) {
+	if err := r.checkType(column, true); err != nil {
+		return nil, err
+	}
+
+	v, err := r.value(column)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := v.(libovsdb.OvsMap)
+	if !ok {
+		return nil, r.wrap(column, fmt.Errorf("value %v is not a map", v))
+	}
+
+	values := make(map[string]interface{}, len(m.GoMap))
+	for key, value := range m.GoMap {
+		values[fmt.Sprint(key)] = value
+	}
+
+	return values, nil
+}
+
+// StringMap returns the entries of a map column with string values.
+func (r *RowReader) StringMap(column string) (map[string]string, error) {
+	if err := r.checkType(column, true, "string"); err != nil {
+		return nil, err
+	}
+
+	m, err := r.Map(column)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(m))
+	for key, value := range m {
+		s, err := toString(value)
+		if err != nil {
+			return nil, r.wrap(column, fmt.Errorf("key %v: %v", key, err))
+		}
+		values[key] = s
+	}
+
+	return values, nil
+}
+
+// IntegerMap returns the entries of a map column with integer values, or with string values holding integers.
+func (r *RowReader) IntegerMap(column string) (map[string]int64, error) {
+	if err := r.checkType(column, true, "integer", "string"); err != nil {
+		return nil, err
+	}
+
+	m, err := r.Map(column)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]int64, len(m))
+	for key, value := range m {
+		i, err := toInteger(value)
+		if err != nil {
+			return nil, r.wrap(column, fmt.Errorf("key %v: %v", key, err))
+		}
+		values[key] = i
+	}
+
+	return values, nil
+}
+
+// UUIDMap returns the entries of a map column with UUID values, such as the queues of a QoS.
+func (r *RowReader) UUIDMap(column string) (map[string]string, error) {
+	if err := r.checkType(column, true, "uuid"); err != nil {
+		return nil, err
+	}
+
+	m, err := r.Map(column)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(m))
+	for key, value := range m {
+		u, err := toUUID(value)
+		if err != nil {
+			return nil, r.wrap(column, fmt.Errorf("key %v: %v", key, err))
+		}
+		values[key] = u
+	}
+
+	return values, nil
+}
+
+// MapString returns the string value of a key in a map column, such as external_ids:hostname.
+func (r *RowReader) MapString(column, key string) (string, error) {
+	v, err := r.mapValue(column, key, "string")
+	if err != nil {
+		return "", err
+	}
+
+	s, err := toString(v)
+	return s, r.wrap(column, err)
+}
+
+// MapInteger returns the integer value of a key in a map column, such as statistics:rx_packets. String values such as
+// in other_config are parsed.
+func (r *RowReader) MapInteger(column, key string) (int64, error) {
+	v, err := r.mapValue(column, key, "integer", "string")
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := toInteger(v)
+	return i, r.wrap(column, err)
+}
+
+// MapBool returns the boolean value of a key in a map column. String values such as in other_config are parsed.
+func (r *RowReader) MapBool(column, key string) (bool, error) {
+	v, err := r.mapValue(column, key, "boolean", "string")
+	if err != nil {
+		return false, err
+	}
+
+	b, err := toBool(v)
+	return b, r.wrap(column, err)
+}
+
+func (r *RowReader) mapValue(column, key string, types ...string) (interface{}, error) {
+	if err := r.checkType(column, true, types...); err != nil {
+		return nil, err
+	}
+
+	m, err := r.Map(column)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := m[key]
+	if !ok {
+		return nil, ErrNoValue
+	}
+
+	return v, nil
+}
+
+// value returns the raw value of a column in the notation of libovsdb.
+func (r *RowReader) value(column string) (interface{}, error) {
+	if r.Schema != nil && column != "_uuid" && column != "_version" {
+		if _, ok := r.Schema.Columns[column]; !ok {
+			return nil, fmt.Errorf("column %v is not in the schema of table %v", column, r.Table)
+		}
+	}
+
+	v, ok := r.Row.Fields[column]
+	if !ok {
+		return nil, ErrNoValue
+	}
+
+	return v, nil
+}
+
+// atom returns the single value of a column holding at most one atomic value.
+func (r *RowReader) atom(column string, types ...string) (interface{}, error) {
+	if err := r.checkType(column, false, types...); err != nil {
+		return nil, err
+	}
+
+	v, err := r.value(column)
+	if err != nil {
+		return nil, err
+	}
+
+	set, ok := v.(libovsdb.OvsSet)
+	if !ok {
+		return v, nil
+	}
+
+	switch len(set.GoSet) {
+	case 0:
+		return nil, ErrNoValue
+	case 1:
+		return set.GoSet[0], nil
+	default:
+		return nil, r.wrap(column, fmt.Errorf("%d values where at most one is expected", len(set.GoSet)))
+	}
+}
+
+// checkType verifies that the column is a map or not, and that its base type, which is the value type of a map, is
+// one of types. Nothing is checked without a schema.
+func (r *RowReader) checkType(column string, isMap bool, types ...string) error {
+	if r.Schema == nil {
+		return nil
+	}
+
+	c, ok := r.Schema.Columns[column]
+	if !ok {
+		// Reported by value, which also knows the implicit columns.
+		return nil
+	}
+
+	key, value := columnBaseTypes(c.Type)
+	if isMap != (value != "") {
+		if isMap {
+			return r.wrap(column, fmt.Errorf("column is not a map"))
+		}
+		return r.wrap(column, fmt.Errorf("column is a map"))
+	}
+
+	base := key
+	if isMap {
+		base = value
+	}
+
+	if len(types) == 0 {
+		return nil
+	}
+	for _, t := range types {
+		if base == t {
+			return nil
+		}
+	}
+
+	return r.wrap(column, fmt.Errorf("column has type %v, expected %v", base, types))
+}
+
+func (r *RowReader) wrap(column string, err error) error {
+	if err == nil || err == ErrNoValue {
+		return err
+	}
+
+	return fmt.Errorf("column %v of table %v: %v", column, r.Table, err)
+}
+
+// columnBaseTypes returns the key and value type of a column type in the schema. The value type is empty unless the
+// column is a map.
+func columnBaseTypes(t interface{}) (key, value string) {
+	switch t := t.(type) {
+	case string:
+		return t, ""
+	case map[string]interface{}:
+		return baseType(t["key"]), baseType(t["value"])
+	default:
+		return "", ""
+	}
+}
+
+func baseType(t interface{}) string {
+	switch t := t.(type) {
+	case string:
+		return t
+	case map[string]interface{}:
+		if s, ok := t["type"].(string); ok {
+			return s
+		}
+	}
+
+	return ""
+}
+
+func toString(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("value %v is not a string", v)
+	}
+
+	return s, nil
+}
+
+func toInteger(v interface{}) (int64, error) {
+	switch v := v.(type) {
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, fmt.Errorf("value %v is not an integer", v)
+		}
+		return int64(v), nil
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value %q is not an integer", v)
+		}
+		return i, nil
+	default:
+		return 0, fmt.Errorf("value %v is not an integer", v)
+	}
+}
+
+func toBool(v interface{}) (bool, error) {
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("value %q is not a boolean", v)
+		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("value %v is not a boolean", v)
+	}
+}
+
+// toUUID accepts both a decoded UUID and the raw ["uuid", "..."] notation, which libovsdb leaves in map values.
+func toUUID(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case libovsdb.UUID:
+		return v.GoUUID, nil
+	case []interface{}:
+		if len(v) == 2 && (v[0] == "uuid" || v[0] == "named-uuid") {
+			if s, ok := v[1].(string); ok {
+				return s, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("value %v is not a UUID", v)
+}
@@ -0,0 +1,607 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"github.com/openconfig/ygot/ygot"
+	oc "ovs-gnxi/shared/gnmi/modeldata/generated/ocstruct"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SpanningTreeSTP  = "STP"
+	SpanningTreeRSTP = "RSTP"
+
+	stpStateForwarding = "forwarding"
+)
+
+var (
+	stpBridgeKeys = []string{"stp-priority", "stp-hello-time", "stp-max-age", "stp-forward-delay",
+		"rstp-priority", "rstp-hello-time", "rstp-max-age", "rstp-forward-delay", "rstp-transmit-hold-count"}
+	stpPortKeys = []string{"stp-path-cost", "stp-port-priority", "rstp-path-cost", "rstp-port-priority"}
+
+	stpProtocols = map[string]oc.E_OpenconfigSpanningTreeTypes_STP_PROTOCOL{
+		SpanningTreeSTP:  oc.OpenconfigSpanningTreeTypes_STP_PROTOCOL_STP,
+		SpanningTreeRSTP: oc.OpenconfigSpanningTreeTypes_STP_PROTOCOL_RSTP,
+	}
+
+	stpRoles = map[string]oc.E_OpenconfigSpanningTreeTypes_STP_PORT_ROLE{
+		"root":       oc.OpenconfigSpanningTreeTypes_STP_PORT_ROLE_ROOT,
+		"designated": oc.OpenconfigSpanningTreeTypes_STP_PORT_ROLE_DESIGNATED,
+		"alternate":  oc.OpenconfigSpanningTreeTypes_STP_PORT_ROLE_ALTERNATE,
+		"backup":     oc.OpenconfigSpanningTreeTypes_STP_PORT_ROLE_BACKUP,
+	}
+
+	// RSTP reports discarding ports, which STP calls blocking.
+	stpStates = map[string]oc.E_OpenconfigSpanningTreeTypes_STP_PORT_STATE{
+		"disabled":         oc.OpenconfigSpanningTreeTypes_STP_PORT_STATE_DISABLED,
+		"listening":        oc.OpenconfigSpanningTreeTypes_STP_PORT_STATE_LISTENING,
+		"learning":         oc.OpenconfigSpanningTreeTypes_STP_PORT_STATE_LEARNING,
+		stpStateForwarding: oc.OpenconfigSpanningTreeTypes_STP_PORT_STATE_FORWARDING,
+		"blocking":         oc.OpenconfigSpanningTreeTypes_STP_PORT_STATE_BLOCKING,
+		"discarding":       oc.OpenconfigSpanningTreeTypes_STP_PORT_STATE_BLOCKING,
+	}
+)
+
+// SpanningTreeConfig holds the bridge settings of STP or RSTP from other_config, nil if OVS uses its default. Times are
+// in seconds, HoldCount only applies to RSTP.
+type SpanningTreeConfig struct {
+	Priority     *uint32
+	HelloTime    *uint8
+	MaxAge       *uint8
+	ForwardDelay *uint8
+	HoldCount    *uint8
+}
+
+func (c *SpanningTreeConfig) Equal(comp *SpanningTreeConfig) bool {
+	switch {
+	case !equalUint32(c.Priority, comp.Priority):
+		return false
+	case !equalUint8(c.HelloTime, comp.HelloTime):
+		return false
+	case !equalUint8(c.MaxAge, comp.MaxAge):
+		return false
+	case !equalUint8(c.ForwardDelay, comp.ForwardDelay):
+		return false
+	case !equalUint8(c.HoldCount, comp.HoldCount):
+		return false
+	default:
+		return true
+	}
+}
+
+func (c *SpanningTreeConfig) String() string {
+	return fmt.Sprintf("SpanningTreeConfig(Priority: \"%v\", HelloTime: \"%v\", MaxAge: \"%v\", ForwardDelay: \"%v\", HoldCount: \"%v\")",
+		optionalString(c.Priority), optionalString(c.HelloTime), optionalString(c.MaxAge), optionalString(c.ForwardDelay), optionalString(c.HoldCount))
+}
+
+// published returns the settings which are within the ranges of the model, the others are not published.
+func (c *SpanningTreeConfig) published() *SpanningTreeConfig {
+	return &SpanningTreeConfig{
+		Priority:     rangeUint32(c.Priority, 1, 611440),
+		HelloTime:    rangeUint8(c.HelloTime, 1, 10),
+		MaxAge:       rangeUint8(c.MaxAge, 6, 40),
+		ForwardDelay: rangeUint8(c.ForwardDelay, 4, 30),
+		HoldCount:    rangeUint8(c.HoldCount, 1, 10),
+	}
+}
+
+// otherConfig returns the settings in the notation of other_config, with the keys of protocol.
+func (c *SpanningTreeConfig) otherConfig(protocol string) map[string]string {
+	prefix := strings.ToLower(protocol) + "-"
+	values := map[string]string{
+		prefix + "priority":      optionalString(c.Priority),
+		prefix + "hello-time":    optionalString(c.HelloTime),
+		prefix + "max-age":       optionalString(c.MaxAge),
+		prefix + "forward-delay": optionalString(c.ForwardDelay),
+	}
+	if protocol == SpanningTreeRSTP {
+		values[prefix+"transmit-hold-count"] = optionalString(c.HoldCount)
+	}
+
+	entries := make(map[string]string)
+	for key, value := range values {
+		if value != "" {
+			entries[key] = value
+		}
+	}
+
+	return entries
+}
+
+// SpanningTreeStatus is the spanning tree state of a bridge. The IDs are as OVS reports them, see parseBridgeID.
+// TopologyChanges and LastTopologyChange, in nanoseconds since the Unix epoch, are observed, see resolveSpanningTree.
+type SpanningTreeStatus struct {
+	BridgeID     string
+	RootID       string
+	RootPathCost *uint32
+
+	TopologyChanges    uint64
+	LastTopologyChange uint64
+}
+
+// PortSpanningTreeConfig holds the path cost and priority of a port from other_config, nil if OVS uses its default.
+type PortSpanningTreeConfig struct {
+	PathCost *uint32
+	Priority *uint8
+}
+
+func (c *PortSpanningTreeConfig) Equal(comp *PortSpanningTreeConfig) bool {
+	return equalUint32(c.PathCost, comp.PathCost) && equalUint8(c.Priority, comp.Priority)
+}
+
+// published returns the settings which are within the ranges of the model, the others are not published.
+func (c *PortSpanningTreeConfig) published() *PortSpanningTreeConfig {
+	return &PortSpanningTreeConfig{PathCost: rangeUint32(c.PathCost, 1, 200000000), Priority: rangeUint8(c.Priority, 1, 240)}
+}
+
+// otherConfig returns the settings in the notation of other_config, with the keys of protocol.
+func (c *PortSpanningTreeConfig) otherConfig(protocol string) map[string]string {
+	prefix := strings.ToLower(protocol) + "-"
+	entries := make(map[string]string)
+	if c.PathCost != nil {
+		entries[prefix+"path-cost"] = optionalString(c.PathCost)
+	}
+	if c.Priority != nil {
+		entries[prefix+"port-priority"] = optionalString(c.Priority)
+	}
+
+	return entries
+}
+
+// PortSpanningTree is the spanning tree configuration and state of a port, for the protocol its bridge runs. The IDs
+// are as OVS reports them, see parsePortID. ForwardTransitions is observed, see resolveSpanningTree.
+type PortSpanningTree struct {
+	STP  PortSpanningTreeConfig
+	RSTP PortSpanningTreeConfig
+
+	PortID             string
+	Role               string
+	State              string
+	DesignatedBridgeID string
+	DesignatedPortID   string
+	DesignatedPathCost *uint32
+	BPDUSent           *uint64
+	BPDUReceived       *uint64
+	ForwardTransitions uint64
+}
+
+// config returns the settings of protocol.
+func (s *PortSpanningTree) config(protocol string) *PortSpanningTreeConfig {
+	if protocol == SpanningTreeRSTP {
+		return &s.RSTP
+	}
+
+	return &s.STP
+}
+
+func (s *PortSpanningTree) equalConfig(comp *PortSpanningTree) bool {
+	return s.STP.Equal(&comp.STP) && s.RSTP.Equal(&comp.RSTP)
+}
+
+// SpanningTreeProtocol returns the spanning tree protocol a bridge runs, or an empty string if it runs none. As in OVS,
+// RSTP takes precedence if both are enabled.
+func (b *Bridge) SpanningTreeProtocol() string {
+	switch {
+	case b.RSTPEnable:
+		return SpanningTreeRSTP
+	case b.STPEnable:
+		return SpanningTreeSTP
+	default:
+		return ""
+	}
+}
+
+// spanningTreeConfig returns the settings of the protocol the bridge runs, or those of STP if it runs none.
+func (b *Bridge) spanningTreeConfig() *SpanningTreeConfig {
+	if b.SpanningTreeProtocol() == SpanningTreeRSTP {
+		return &b.RSTP
+	}
+
+	return &b.STP
+}
+
+func (b *Bridge) equalSpanningTree(comp *Bridge) bool {
+	switch {
+	case b.STPEnable != comp.STPEnable:
+		return false
+	case b.RSTPEnable != comp.RSTPEnable:
+		return false
+	case !b.STP.Equal(&comp.STP):
+		return false
+	case !b.RSTP.Equal(&comp.RSTP):
+		return false
+	default:
+		return true
+	}
+}
+
+// spanningTreeProtocols returns the sorted spanning tree protocols which any bridge runs.
+func (c *ObjectCache) spanningTreeProtocols() []string {
+	enabled := make(map[string]bool)
+	for _, b := range c.Bridges {
+		if protocol := b.SpanningTreeProtocol(); protocol != "" {
+			enabled[protocol] = true
+		}
+	}
+
+	var protocols []string
+	for protocol := range enabled {
+		protocols = append(protocols, protocol)
+	}
+	sort.Strings(protocols)
+
+	return protocols
+}
+
+// setSpanningTreeProtocol enables protocols on all bridges, of which there may be one at most as OVS does not run STP
+// and RSTP side by side. No protocols disable spanning tree.
+func (c *ObjectCache) setSpanningTreeProtocol(protocols []string) error {
+	var protocol string
+	switch len(protocols) {
+	case 0:
+	case 1:
+		if _, ok := stpProtocols[protocols[0]]; !ok {
+			return fmt.Errorf("unsupported spanning tree protocol %v", protocols[0])
+		}
+		protocol = protocols[0]
+	default:
+		return fmt.Errorf("unable to enable several spanning tree protocols %v", protocols)
+	}
+
+	for _, b := range c.Bridges {
+		b.STPEnable = protocol == SpanningTreeSTP
+		b.RSTPEnable = protocol == SpanningTreeRSTP
+	}
+
+	return nil
+}
+
+// spanningTreeBridge returns the first bridge by name which runs spanning tree, whose spanning tree is the one
+// published, or nil if there is none.
+func (c *ObjectCache) spanningTreeBridge() *Bridge {
+	var names []string
+	for name, b := range c.Bridges {
+		if b.SpanningTreeProtocol() != "" {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Strings(names)
+
+	return c.Bridges[names[0]]
+}
+
+// bridgePorts returns the ports of a bridge sorted by name.
+func (c *ObjectCache) bridgePorts(b *Bridge) []*Port {
+	var ports []*Port
+	for _, uuid := range b.Ports {
+		if p, ok := c.Ports[c.PortName(uuid)]; ok {
+			ports = append(ports, p)
+		}
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i].Name < ports[j].Name })
+
+	return ports
+}
+
+type stpPortState struct {
+	state       string
+	transitions uint64
+}
+
+type topologyState struct {
+	changes uint64
+	changed uint64
+}
+
+// resolveSpanningTree counts the forward transitions of the ports of the bridges running spanning tree and the
+// topology changes of the bridges, which OVS does not report. A port entering or leaving the forwarding state changes
+// the topology. As with linkChange the first state seen of a port is not a transition, and the counts are kept across
+// a resync.
+func (c *Config) resolveSpanningTree() {
+	now := uint64(time.Now().UnixNano())
+
+	for _, b := range c.ObjCache.Bridges {
+		if b.SpanningTreeProtocol() == "" {
+			delete(c.topologyStates, b.Name)
+			continue
+		}
+
+		topology, ok := c.topologyStates[b.Name]
+		if !ok {
+			topology = &topologyState{}
+			c.topologyStates[b.Name] = topology
+		}
+
+		for _, p := range c.ObjCache.bridgePorts(b) {
+			state := strings.ToLower(p.SpanningTree.State)
+			if state == "" {
+				delete(c.stpStates, p.Name)
+				continue
+			}
+
+			s, ok := c.stpStates[p.Name]
+			switch {
+			case !ok:
+				s = &stpPortState{state: state}
+				c.stpStates[p.Name] = s
+			case s.state != state:
+				if state == stpStateForwarding {
+					s.transitions++
+				}
+				if state == stpStateForwarding || s.state == stpStateForwarding {
+					topology.changes++
+					topology.changed = now
+				}
+				s.state = state
+			}
+
+			p.SpanningTree.ForwardTransitions = s.transitions
+		}
+
+		b.SpanningTree.TopologyChanges = topology.changes
+		b.SpanningTree.LastTopologyChange = topology.changed
+	}
+}
+
+// parseBridgeID splits a bridge ID as OVS reports it into the priority and the MAC address. STP IDs are
+// xxxx.yyyyyyyyyyyy with the priority in the first part. RSTP IDs are x.yyy.zzzzzzzzzzzz with the priority in units of
+// 4096 and the system ID extension, which together make up the priority.
+func parseBridgeID(id string) (uint32, string, error) {
+	parts := strings.Split(id, ".")
+
+	var priority, extension uint64
+	var err error
+	switch len(parts) {
+	case 2:
+		priority, err = strconv.ParseUint(parts[0], 16, 16)
+	case 3:
+		if priority, err = strconv.ParseUint(parts[0], 16, 4); err == nil {
+			extension, err = strconv.ParseUint(parts[1], 16, 12)
+		}
+	default:
+		return 0, "", fmt.Errorf("invalid bridge ID %v", id)
+	}
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid bridge ID %v: %v", id, err)
+	}
+	if len(parts) == 3 {
+		priority = priority<<12 | extension
+	}
+
+	mac := parts[len(parts)-1]
+	if _, err := strconv.ParseUint(mac, 16, 48); err != nil || len(mac) != 12 {
+		return 0, "", fmt.Errorf("invalid MAC address in bridge ID %v", id)
+	}
+
+	octets := make([]string, 6)
+	for i := range octets {
+		octets[i] = strings.ToLower(mac[2*i : 2*i+2])
+	}
+
+	return uint32(priority), strings.Join(octets, ":"), nil
+}
+
+// parsePortID splits a port ID, four hex digits, into the priority and the port number. STP IDs carry the priority in
+// the first byte, RSTP IDs in the first four bits in units of 16.
+func parsePortID(id, protocol string) (uint8, uint16, error) {
+	i, err := strconv.ParseUint(id, 16, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port ID %v", id)
+	}
+
+	if protocol == SpanningTreeRSTP {
+		return uint8(i>>12) * 16, uint16(i & 0xfff), nil
+	}
+
+	return uint8(i >> 8), uint16(i & 0xff), nil
+}
+
+func rangeUint32(i *uint32, min, max uint32) *uint32 {
+	if i == nil || *i < min || *i > max {
+		return nil
+	}
+
+	return i
+}
+
+func rangeUint8(i *uint8, min, max uint8) *uint8 {
+	if i == nil || *i < min || *i > max {
+		return nil
+	}
+
+	return i
+}
+
+func jsonUint8(v interface{}) *uint8 {
+	if i, ok := v.(uint8); ok {
+		return &i
+	}
+
+	return nil
+}
+
+// generateSpanningTreeConfig publishes the spanning tree protocols of the bridges. The model has room for a single
+// bridge, so rstp holds the spanning tree of the first bridge by name running one, whichever the protocol.
+func (s *SystemBroker) generateSpanningTreeConfig(d *oc.Device, cache *ObjectCache) error {
+	b := cache.spanningTreeBridge()
+	if b == nil {
+		return nil
+	}
+
+	d.Stp = &oc.Stp{Global: &oc.Stp_Global{}}
+	for _, protocol := range cache.spanningTreeProtocols() {
+		d.Stp.Global.EnabledProtocol = append(d.Stp.Global.EnabledProtocol, stpProtocols[protocol])
+	}
+
+	protocol := b.SpanningTreeProtocol()
+	config := b.spanningTreeConfig().published()
+	r := &oc.Stp_Rstp{
+		Bridge:          ygot.String(b.Name),
+		BridgePriority:  config.Priority,
+		HelloTime:       config.HelloTime,
+		MaxAge:          config.MaxAge,
+		ForwardingDelay: config.ForwardDelay,
+		HoldCount:       config.HoldCount,
+		RootCost:        b.SpanningTree.RootPathCost,
+		TopologyChanges: ygot.Uint64(b.SpanningTree.TopologyChanges),
+	}
+	d.Stp.Rstp = r
+
+	if _, address, err := parseBridgeID(b.SpanningTree.BridgeID); err == nil {
+		r.BridgeAddress = ygot.String(address)
+	}
+	if priority, address, err := parseBridgeID(b.SpanningTree.RootID); err == nil {
+		r.DesignatedRootAddress = ygot.String(address)
+		r.DesignatedRootPriority = rangeUint32(&priority, 1, 611440)
+	}
+
+	// The time since the last topology change is in hundredths of a second.
+	if last := b.SpanningTree.LastTopologyChange; last != 0 {
+		r.TimeSinceTopologyChange = ygot.Uint64((uint64(time.Now().UnixNano()) - last) / uint64(10*time.Millisecond))
+	}
+
+	for _, p := range cache.bridgePorts(b) {
+		// Ports without a state, such as the internal port, do not take part in spanning tree.
+		if p.SpanningTree.State == "" {
+			continue
+		}
+
+		if _, ok := d.Interface[p.Name]; !ok {
+			log.Errorf("Unable to publish spanning tree of port %v without an interface", p.Name)
+			continue
+		}
+
+		i, err := r.NewInterface(p.Name)
+		if err != nil {
+			return err
+		}
+
+		portConfig := p.SpanningTree.config(protocol).published()
+		i.Cost = portConfig.PathCost
+		i.PortPriority = portConfig.Priority
+		i.Role = stpRoles[strings.ToLower(p.SpanningTree.Role)]
+		i.PortState = stpStates[strings.ToLower(p.SpanningTree.State)]
+		i.DesignatedCost = p.SpanningTree.DesignatedPathCost
+		i.ForwardTransisitions = ygot.Uint64(p.SpanningTree.ForwardTransitions)
+
+		if _, num, err := parsePortID(p.SpanningTree.PortID, protocol); err == nil {
+			i.PortNum = ygot.Uint16(num)
+			if i.Role == oc.OpenconfigSpanningTreeTypes_STP_PORT_ROLE_ROOT {
+				r.RootPort = ygot.Uint16(num)
+			}
+		}
+
+		if priority, address, err := parseBridgeID(p.SpanningTree.DesignatedBridgeID); err == nil {
+			i.DesignatedBridgeAddress = ygot.String(address)
+			i.DesignatedBridgePriority = rangeUint32(&priority, 1, 611440)
+		}
+		if priority, num, err := parsePortID(p.SpanningTree.DesignatedPortID, protocol); err == nil {
+			i.DesignatedPortNum = ygot.Uint16(num)
+			i.DesignatedPortPriority = rangeUint8(&priority, 1, 240)
+		}
+
+		if p.SpanningTree.BPDUSent != nil || p.SpanningTree.BPDUReceived != nil {
+			i.Counters = &oc.Stp_Rstp_Interface_Counters{
+				BpduSent:     p.SpanningTree.BPDUSent,
+				BpduReceived: p.SpanningTree.BPDUReceived,
+			}
+		}
+	}
+
+	return d.Stp.Validate()
+}
+
+// overwriteSpanningTreeWithJSON reads the spanning tree configuration. As settings outside the ranges of the model are
+// not published, the protocols and each setting are only changed if they differ from what has been published. The
+// settings apply to the protocol the bridge runs once the protocols are set, so that changing the protocol keeps them.
+func overwriteSpanningTreeWithJSON(cache *ObjectCache, jsonConfig map[string]interface{}) {
+	stp, _ := jsonConfig["openconfig-spanning-tree:stp"].(map[string]interface{})
+	global, _ := stp["global"].(map[string]interface{})
+	globalConfig, _ := global["config"].(map[string]interface{})
+	rstp, _ := stp["rstp"].(map[string]interface{})
+	rstpConfig, _ := rstp["config"].(map[string]interface{})
+	interfaces, _ := rstp["interfaces"].(map[string]interface{})
+	list, _ := interfaces["interface"].([]interface{})
+
+	published := &SpanningTreeConfig{}
+	publishedPorts := make(map[string]*PortSpanningTreeConfig)
+	if b := cache.spanningTreeBridge(); b != nil {
+		published = b.spanningTreeConfig().published()
+		for _, p := range cache.bridgePorts(b) {
+			publishedPorts[p.Name] = p.SpanningTree.config(b.SpanningTreeProtocol()).published()
+		}
+	}
+
+	// The protocols are identities, prefixed with the module defining them.
+	var protocols []string
+	for _, protocol := range jsonStrings(globalConfig["enabled-protocol"]) {
+		protocols = append(protocols, protocol[strings.LastIndex(protocol, ":")+1:])
+	}
+	sort.Strings(protocols)
+
+	if strings.Join(protocols, ",") != strings.Join(cache.spanningTreeProtocols(), ",") {
+		if err := cache.setSpanningTreeProtocol(protocols); err != nil {
+			log.Errorf("Unable to set spanning tree protocol: %v", err)
+		}
+	}
+
+	b := cache.spanningTreeBridge()
+	if b == nil {
+		return
+	}
+
+	config := &SpanningTreeConfig{
+		Priority:     jsonUint32(rstpConfig["bridge-priority"]),
+		HelloTime:    jsonUint8(rstpConfig["hello-time"]),
+		MaxAge:       jsonUint8(rstpConfig["max-age"]),
+		ForwardDelay: jsonUint8(rstpConfig["forwarding-delay"]),
+		HoldCount:    jsonUint8(rstpConfig["hold-count"]),
+	}
+	if !config.Equal(published) {
+		*b.spanningTreeConfig() = *config
+	}
+
+	protocol := b.SpanningTreeProtocol()
+	bridgePorts := make(map[string]*Port)
+	for _, p := range cache.bridgePorts(b) {
+		bridgePorts[p.Name] = p
+	}
+
+	for _, i := range list {
+		config, _ := i.(map[string]interface{})["config"].(map[string]interface{})
+		name, _ := config["name"].(string)
+
+		p, ok := bridgePorts[name]
+		if !ok {
+			log.Errorf("Unable to set spanning tree of port %v, which is not a port of bridge %v", name, b.Name)
+			continue
+		}
+
+		portConfig := &PortSpanningTreeConfig{PathCost: jsonUint32(config["cost"]), Priority: jsonUint8(config["port-priority"])}
+		if prev, ok := publishedPorts[name]; !ok || !portConfig.Equal(prev) {
+			*p.SpanningTree.config(protocol) = *portConfig
+		}
+	}
+}
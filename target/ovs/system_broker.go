@@ -16,42 +16,70 @@ limitations under the License.
 package ovs
 
 import (
+	"errors"
+	"fmt"
 	"github.com/openconfig/ygot/ygot"
 	"os"
+	"ovs-gnxi/shared"
 	oc "ovs-gnxi/shared/gnmi/modeldata/generated/ocstruct"
 	"ovs-gnxi/target/cert"
+	"ovs-gnxi/target/config"
 	gnxi "ovs-gnxi/target/gnxi/service"
+	"reflect"
+	"sort"
 	"strings"
-)
-
-const (
-	ovsAddress  = "target.gnxi.lan"
-	ovsProtocol = "tcp"
-	ovsPort     = "6640"
+	"sync"
+	"time"
 )
 
 type SystemBroker struct {
 	GNXIService          *gnxi.Service
 	certManager          *cert.Manager
+	users                *shared.LocalUserBackend
+	authz                *shared.Authorizer
 	OVSClient            *Client
 	startOVSClientChan   chan bool
 	startGNXIServiceChan chan bool
 	stopOVSClientChan    chan bool
 	stopGNXIServiceChan  chan bool
+	polled               polledState
+	publishMu            sync.Mutex
+
+	// notified is the OVS config without its counters as of the last notification of the subscriptions.
+	notified *ObjectCache
 }
 
-func NewSystemBroker(gnxiService *gnxi.Service, certManager *cert.Manager) *SystemBroker {
+// polledState is the state OVSDB has no columns for. pollState requests it from ovs-vswitchd without holding the
+// config lock, so that generating the gNMI config only reads it.
+type polledState struct {
+	mu            sync.RWMutex
+	flows         map[string][]*Flow
+	lldpNeighbors map[string][]*LLDPNeighbor
+	queueStats    map[string]map[uint32]*QueueStatistics
+}
+
+func NewSystemBroker(gnxiService *gnxi.Service, certManager *cert.Manager, users *shared.LocalUserBackend, authz *shared.Authorizer, ovsdb config.OVSDB, scripts config.Scripts) *SystemBroker {
 	var err error
-	s := &SystemBroker{GNXIService: gnxiService, certManager: certManager}
+	s := &SystemBroker{GNXIService: gnxiService, certManager: certManager, users: users, authz: authz}
 
 	log.Info("Initializing OVS Client...")
 
-	s.OVSClient, err = NewClient(ovsAddress, ovsProtocol, ovsPort)
+	s.OVSClient, err = NewClient(ovsdb.Remote)
 	if err != nil {
 		log.Errorf("Unable to initialize OVS Client: %v", err)
 		os.Exit(1)
 	}
 
+	s.OVSClient.ReconnectMinDelay = time.Duration(ovsdb.ReconnectMinDelay)
+	s.OVSClient.ReconnectMaxDelay = time.Duration(ovsdb.ReconnectMaxDelay)
+	s.OVSClient.StartScript = scripts.Start
+	s.OVSClient.StopScript = scripts.Stop
+	s.OVSClient.RestartScript = scripts.Restart
+
+	s.OVSClient.OverwriteStateCallback(s.OVSConnectionStateCallback)
+
+	go s.pollState(time.Duration(ovsdb.PollInterval))
+
 	return s
 }
 
@@ -65,11 +93,28 @@ func (s *SystemBroker) RegisterWatchdogChannels(startOVSClientChan, startGNXISer
 func (s *SystemBroker) GenerateConfig(config *Config) ([]byte, error) {
 	d := &oc.Device{
 		System: &oc.System{
-			Hostname: ygot.String(config.ObjCache.System.Hostname),
 			Openflow: &oc.System_Openflow{},
 		},
 	}
 
+	if err := PublishRow(SystemTable, config.ObjCache.System, d.System); err != nil {
+		return []byte(""), err
+	}
+
+	if err := s.generateAAAConfig(d.System); err != nil {
+		return []byte(""), err
+	}
+
+	state := s.OVSClient.ConnectionState()
+	d.System.Ovsdb = &oc.System_Ovsdb{
+		Address:           ygot.String(s.OVSClient.Remote()),
+		Connected:         ygot.Bool(state.Connected),
+		ReconnectAttempts: ygot.Uint32(state.ReconnectAttempts),
+	}
+	if state.LastError != "" {
+		d.System.Ovsdb.LastError = ygot.String(state.LastError)
+	}
+
 	v, err := d.NewComponent("os")
 	if err != nil {
 		return []byte(""), err
@@ -80,12 +125,17 @@ func (s *SystemBroker) GenerateConfig(config *Config) ([]byte, error) {
 	}
 	v.Description = ygot.String(config.ObjCache.System.Version)
 
+	mapped := MappedKeys(InterfaceTable, "statistics")
 	for _, i := range config.ObjCache.Interfaces {
 		o, err := d.NewInterface(i.Name)
 		if err != nil {
 			return []byte(""), err
 		}
 
+		if err := PublishRow(InterfaceTable, i, o); err != nil {
+			return []byte(""), err
+		}
+
 		switch adminStatus := i.AdminStatus; adminStatus {
 		case "up":
 			o.AdminStatus = oc.OpenconfigInterfaces_Interface_AdminStatus_UP
@@ -104,15 +154,89 @@ func (s *SystemBroker) GenerateConfig(config *Config) ([]byte, error) {
 			o.OperStatus = oc.OpenconfigInterfaces_Interface_OperStatus_UNSET
 		}
 
-		o.Mtu = ygot.Uint16(i.MTU)
+		o.Type = interfaceType(i.Type)
+		if i.Bridge != "" {
+			o.Ovs.Bridge = ygot.String(i.Bridge)
+		}
+		o.Logical = ygot.Bool(o.Type != oc.IETFInterfaces_InterfaceType_ethernetCsmacd)
+
+		if i.IfIndex != nil && *i.IfIndex != 0 {
+			o.Ifindex = i.IfIndex
+		}
 
-		o.Counters = &oc.Interface_Counters{
-			InPkts:      ygot.Uint64(i.Statistics.ReceivedPackets),
-			InErrors:    ygot.Uint64(i.Statistics.ReceivedErrors),
-			InDiscards:  ygot.Uint64(i.Statistics.ReceivedDropped),
-			OutPkts:     ygot.Uint64(i.Statistics.TransmittedPackets),
-			OutErrors:   ygot.Uint64(i.Statistics.TransmittedErrors),
-			OutDiscards: ygot.Uint64(i.Statistics.TransmittedDropped),
+		if i.LastChange != 0 {
+			o.LastChange = ygot.Uint64(i.LastChange)
+		}
+
+		if i.MAC != "" || i.LinkSpeed != nil || i.Duplex != "" {
+			o.Ethernet = &oc.Interface_Ethernet{
+				NegotiatedPortSpeed: ethernetSpeed(i.LinkSpeed),
+				Counters: &oc.Interface_Ethernet_Counters{
+					InOversizeFrames:  i.Statistics.ReceivedOversizeErrors,
+					InUndersizeFrames: i.Statistics.ReceivedUndersizedErrors,
+					InJabberFrames:    i.Statistics.ReceivedJabberErrors,
+					InFragmentFrames:  i.Statistics.ReceivedFragmentedErrors,
+				},
+			}
+
+			if i.MAC != "" {
+				o.Ethernet.HwMacAddress = ygot.String(i.MAC)
+			}
+
+			switch duplex := i.Duplex; duplex {
+			case "full":
+				o.Ethernet.NegotiatedDuplexMode = oc.OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_FULL
+			case "half":
+				o.Ethernet.NegotiatedDuplexMode = oc.OpenconfigIfEthernet_Ethernet_NegotiatedDuplexMode_HALF
+			}
+		}
+
+		if p := config.ObjCache.PortOfInterface(i); p != nil && len(p.Interfaces) == 1 {
+			if v := p.SwitchedVLAN(); v != nil {
+				if o.Ethernet == nil {
+					o.Ethernet = &oc.Interface_Ethernet{}
+				}
+				o.Ethernet.SwitchedVlan = switchedVLAN(v)
+			}
+
+			if p.QoS != nil {
+				if o.Qos, err = s.qos(p); err != nil {
+					return []byte(""), err
+				}
+			}
+		}
+
+		if p := config.ObjCache.PortOfInterface(i); p != nil && p.IsBond() {
+			if o.Ethernet == nil {
+				o.Ethernet = &oc.Interface_Ethernet{}
+			}
+			o.Ethernet.AggregateId = ygot.String(p.Name)
+		}
+
+		if i.IngressPolicingRate != 0 || i.IngressPolicingBurst != 0 {
+			if o.Qos == nil {
+				o.Qos = &oc.Interface_Qos{}
+			}
+			o.Qos.IngressPolicing = &oc.Interface_Qos_IngressPolicing{
+				Rate:  ygot.Uint32(i.IngressPolicingRate),
+				Burst: ygot.Uint32(i.IngressPolicingBurst),
+			}
+		}
+
+		// Statistics without an OpenConfig counter are published under the ovs-interfaces augment.
+		if o.Counters == nil {
+			o.Counters = &oc.Interface_Counters{}
+		}
+		for name, value := range i.Statistics.Counters {
+			if mapped[name] {
+				continue
+			}
+
+			statistic, err := o.Counters.NewStatistic(name)
+			if err != nil {
+				return []byte(""), err
+			}
+			statistic.Value = ygot.Uint64(value)
 		}
 
 		if err := d.Interface[i.Name].Validate(); err != nil {
@@ -120,25 +244,179 @@ func (s *SystemBroker) GenerateConfig(config *Config) ([]byte, error) {
 		}
 	}
 
+	// Controllers which are not attached to a bridge are not published.
 	for _, i := range config.ObjCache.Controllers {
-		c, err := d.System.Openflow.NewController(i.Name)
+		if i.Bridge == "" {
+			continue
+		}
+
+		c, ok := d.System.Openflow.Controller[i.Bridge]
+		if !ok {
+			var err error
+			if c, err = d.System.Openflow.NewController(i.Bridge); err != nil {
+				return []byte(""), err
+			}
+		}
+
+		n, err := c.NewConnection(i.ConnectionID)
 		if err != nil {
 			return []byte(""), err
 		}
-
-		n, err := c.NewConnection(0)
-		n.Address = ygot.String(i.Target.Address)
-		n.Port = ygot.Uint16(i.Target.Port)
 		n.Connected = ygot.Bool(i.Connected)
 
-		switch protocol := strings.ToLower(i.Target.Protocol); protocol {
-		case "tcp":
+		n.Ovs = &oc.System_Openflow_Controller_Connection_Ovs{
+			Passive:            ygot.Bool(i.Target.Passive),
+			Target:             ygot.String(i.Target.String()),
+			SecSinceConnect:    i.Status.SecondsSinceConnect,
+			SecSinceDisconnect: i.Status.SecondsSinceDisconnect,
+		}
+
+		// Only IP addresses fit the address leaf, the full target is published by ovs-system.
+		target := i.Target.published()
+		switch protocol := strings.ToLower(target.Protocol); protocol {
+		case RemoteTCP:
 			n.Transport = oc.OpenconfigOpenflow_Transport_TCP
-		case "ssl":
+		case RemoteSSL:
 			n.Transport = oc.OpenconfigOpenflow_Transport_TLS
+		case RemoteUnix:
+			n.Ovs.UnixSocket = ygot.String(target.Address)
 		default:
 			n.Transport = oc.OpenconfigOpenflow_Transport_UNSET
 		}
+
+		if target.Protocol != RemoteUnix {
+			n.Port = ygot.Uint16(target.Port)
+			if target.Address != "" {
+				n.Address = ygot.String(target.Address)
+			}
+		}
+
+		switch role := i.Role; role {
+		case "other":
+			n.Ovs.Role = oc.OvsSystem_Ovs_Role_EQUAL
+		case "master":
+			n.Ovs.Role = oc.OvsSystem_Ovs_Role_MASTER
+		case "slave":
+			n.Ovs.Role = oc.OvsSystem_Ovs_Role_SLAVE
+		}
+
+		switch state := i.Status.State; state {
+		case "VOID":
+			n.Ovs.State = oc.OvsSystem_Ovs_State_VOID
+		case "BACKOFF":
+			n.Ovs.State = oc.OvsSystem_Ovs_State_BACKOFF
+		case "CONNECTING":
+			n.Ovs.State = oc.OvsSystem_Ovs_State_CONNECTING
+		case "ACTIVE":
+			n.Ovs.State = oc.OvsSystem_Ovs_State_ACTIVE
+		case "IDLE":
+			n.Ovs.State = oc.OvsSystem_Ovs_State_IDLE
+		}
+
+		if i.Status.LastError != "" {
+			n.Ovs.LastError = ygot.String(i.Status.LastError)
+		}
+	}
+
+	if err := s.generateBondConfig(d, config.ObjCache); err != nil {
+		return []byte(""), err
+	}
+
+	if err := s.generateSpanningTreeConfig(d, config.ObjCache); err != nil {
+		return []byte(""), err
+	}
+
+	if err := s.generateLLDPConfig(d, config.ObjCache); err != nil {
+		return []byte(""), err
+	}
+
+	for _, b := range config.ObjCache.Bridges {
+		o, err := d.System.Openflow.NewBridge(b.Name)
+		if err != nil {
+			return []byte(""), err
+		}
+		o.Protocols = append([]string(nil), b.Protocols...)
+		sort.Strings(o.Protocols)
+	}
+
+	for _, b := range config.ObjCache.Bridges {
+		o, err := d.NewBridge(b.Name)
+		if err != nil {
+			return []byte(""), err
+		}
+
+		if b.SFlow != nil {
+			o.Sflow = &oc.Bridge_Sflow{Targets: sortedStrings(b.SFlow.Targets)}
+			if err := PublishRow(SFlowTable, b.SFlow, o.Sflow); err != nil {
+				return []byte(""), err
+			}
+		}
+
+		if b.NetFlow != nil {
+			o.Netflow = &oc.Bridge_Netflow{Targets: sortedStrings(b.NetFlow.Targets)}
+			if err := PublishRow(NetFlowTable, b.NetFlow, o.Netflow); err != nil {
+				return []byte(""), err
+			}
+		}
+
+		if b.IPFIX != nil {
+			o.Ipfix = &oc.Bridge_Ipfix{Targets: sortedStrings(b.IPFIX.Targets)}
+			if err := PublishRow(IPFIXTable, b.IPFIX, o.Ipfix); err != nil {
+				return []byte(""), err
+			}
+		}
+
+		if err := s.generateFlowConfig(o, b); err != nil {
+			return []byte(""), err
+		}
+	}
+
+	for _, m := range config.ObjCache.Mirrors {
+		o, err := d.NewMirror(m.Name)
+		if err != nil {
+			return []byte(""), err
+		}
+
+		if err := PublishRow(MirrorTable, m, o); err != nil {
+			return []byte(""), err
+		}
+		o.SelectVlan = sortVLANs(m.SelectVLANs)
+
+		if m.Bridge != "" {
+			o.Bridge = ygot.String(m.Bridge)
+		}
+
+		for _, uuid := range m.SelectSrcPorts {
+			o.SelectSrcPort = append(o.SelectSrcPort, config.ObjCache.PortName(uuid))
+		}
+		sort.Strings(o.SelectSrcPort)
+
+		for _, uuid := range m.SelectDstPorts {
+			o.SelectDstPort = append(o.SelectDstPort, config.ObjCache.PortName(uuid))
+		}
+		sort.Strings(o.SelectDstPort)
+
+		if m.OutputPort != "" {
+			o.OutputPort = ygot.String(config.ObjCache.PortName(m.OutputPort))
+		}
+
+	}
+
+	if a := config.ObjCache.OpenFlowAgent(); a != nil {
+		d.System.Openflow.Agent = &oc.System_Openflow_Agent{
+			MaxBackoff:      a.MaxBackoff,
+			InactivityProbe: a.InactivityProbe,
+		}
+		if a.DatapathID != "" {
+			d.System.Openflow.Agent.DatapathId = ygot.String(a.DatapathID)
+		}
+
+		switch a.FailureMode {
+		case FailureModeSecure:
+			d.System.Openflow.Agent.FailureMode = oc.OpenconfigOpenflow_FailureMode_SECURE
+		case FailureModeStandalone:
+			d.System.Openflow.Agent.FailureMode = oc.OpenconfigOpenflow_FailureMode_STANDALONE
+		}
 	}
 
 	j, err := ygot.EmitJSON(d, &ygot.EmitJSONConfig{
@@ -155,9 +433,163 @@ func (s *SystemBroker) GenerateConfig(config *Config) ([]byte, error) {
 	return []byte(j), nil
 }
 
+// interfaceType returns the IANA interface type of an OVS interface type. Interfaces backed by a network device of
+// the system are ethernet interfaces, all others are logical interfaces.
+func interfaceType(ovsType string) oc.E_IETFInterfaces_InterfaceType {
+	switch ovsType {
+	case "", "system", "dpdk":
+		return oc.IETFInterfaces_InterfaceType_ethernetCsmacd
+	case "geneve", "gre", "vxlan", "lisp", "stt", "erspan", "ip6erspan", "ip6gre":
+		return oc.IETFInterfaces_InterfaceType_tunnel
+	default:
+		return oc.IETFInterfaces_InterfaceType_other
+	}
+}
+
+// switchedVLAN returns the OpenConfig switched-vlan config of a port.
+func switchedVLAN(v *SwitchedVLAN) *oc.Interface_Ethernet_SwitchedVlan {
+	s := &oc.Interface_Ethernet_SwitchedVlan{}
+
+	switch v.Mode {
+	case VLANModeAccess:
+		s.InterfaceMode = oc.OpenconfigVlan_VlanModeType_ACCESS
+		if v.AccessVLAN != 0 {
+			s.AccessVlan = ygot.Uint16(v.AccessVLAN)
+		}
+	case VLANModeTrunk:
+		s.InterfaceMode = oc.OpenconfigVlan_VlanModeType_TRUNK
+		if v.NativeVLAN != 0 {
+			s.NativeVlan = ygot.Uint16(v.NativeVLAN)
+		}
+		for _, t := range v.TrunkVLANs {
+			s.TrunkVlans = append(s.TrunkVlans, &oc.Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16{Uint16: t})
+		}
+	}
+
+	return s
+}
+
+// collectQueueStatistics requests the statistics of the queues of the ports published with a QoS for qos and reports
+// whether the queues with statistics changed. The statistics are counters, so that their values are left to SAMPLE
+// subscriptions. Ports whose statistics cannot be requested are returned in the error.
+func (s *SystemBroker) collectQueueStatistics() (bool, error) {
+	config := s.OVSClient.Config
+	config.mu.RLock()
+	bridges := make(map[string]string)
+	for _, i := range config.ObjCache.Interfaces {
+		p := config.ObjCache.PortOfInterface(i)
+		if i.Bridge != "" && p != nil && len(p.Interfaces) == 1 && p.QoS != nil && len(p.QoS.Queues) > 0 {
+			bridges[p.Name] = i.Bridge
+		}
+	}
+	config.mu.RUnlock()
+
+	stats := make(map[string]map[uint32]*QueueStatistics)
+	var failed []string
+	for port, bridge := range bridges {
+		queues, err := s.OVSClient.QueueStatistics(bridge, port)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("port %v: %v", port, err))
+			continue
+		}
+		stats[port] = queues
+	}
+
+	s.polled.mu.Lock()
+	changed := !equalQueues(stats, s.polled.queueStats)
+	s.polled.queueStats = stats
+	s.polled.mu.Unlock()
+
+	return changed, collectError(failed)
+}
+
+// qos returns the QoS of a port with the statistics of its queues, as last collected by collectQueueStatistics. Queues
+// without statistics, such as those of a port without a datapath, are published without counters.
+func (s *SystemBroker) qos(p *Port) (*oc.Interface_Qos, error) {
+	q := &oc.Interface_Qos{}
+	if err := PublishRow(QoSTable, p.QoS, q); err != nil {
+		return nil, err
+	}
+
+	s.polled.mu.RLock()
+	stats := s.polled.queueStats[p.Name]
+	s.polled.mu.RUnlock()
+
+	for id, queue := range p.QoS.Queues {
+		n, err := q.NewQueue(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := PublishRow(QueueTable, queue, n); err != nil {
+			return nil, err
+		}
+
+		if stat, ok := stats[id]; ok {
+			n.Counters = &oc.Interface_Qos_Queue_Counters{
+				TxPackets: stat.TxPackets,
+				TxBytes:   stat.TxBytes,
+				TxErrors:  stat.TxErrors,
+			}
+		}
+	}
+
+	return q, nil
+}
+
+// ethernetSpeed returns the OpenConfig speed of an OVS link speed in bits per second.
+func ethernetSpeed(bps *uint64) oc.E_OpenconfigIfEthernet_ETHERNET_SPEED {
+	if bps == nil {
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_UNSET
+	}
+
+	switch *bps {
+	case 10000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10MB
+	case 100000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100MB
+	case 1000000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB
+	case 2500000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB
+	case 5000000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_5GB
+	case 10000000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB
+	case 25000000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB
+	case 40000000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB
+	case 50000000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB
+	case 100000000000:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB
+	default:
+		return oc.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN
+	}
+}
+
+// OVSConfigChangeCallback publishes a gNMI config generated from a snapshot of the OVS config. It must be called
+// without holding the lock of the OVS config, as it waits for the gNMI service, which may be applying a Set.
 func (s *SystemBroker) OVSConfigChangeCallback(ovsConfig *Config) error {
 	log.Debug("Received new change by OVS device")
-	gnmiConfig, err := s.GenerateConfig(ovsConfig)
+
+	return s.publish(ovsConfig, false)
+}
+
+// publish generates the gNMI config from a snapshot of ovsConfig and publishes it. Subscriptions which are sent on
+// changes are only notified if changed reports a change outside of the OVS config, or if the OVS config differs from
+// the one last notified in more than its counters. SAMPLE subscriptions pick up the config at their next sample.
+func (s *SystemBroker) publish(ovsConfig *Config, changed bool) error {
+	// Publishing is serialized, so that the config of an older snapshot never replaces that of a newer one.
+	s.publishMu.Lock()
+	defer s.publishMu.Unlock()
+
+	snapshot := ovsConfig.Snapshot()
+	state := withoutCounters(snapshot.ObjCache)
+	notify := changed || !reflect.DeepEqual(state, s.notified)
+
+	gnmiConfig, err := s.GenerateConfig(snapshot)
 	if err != nil {
 		log.Errorf("Unable to generate gNMI config from OVS config source: %v", err)
 		return err
@@ -166,9 +598,13 @@ func (s *SystemBroker) OVSConfigChangeCallback(ovsConfig *Config) error {
 	if s.GNXIService != nil {
 		s.GNXIService.OverwriteConfig(gnmiConfig)
 
-		select {
-		case s.GNXIService.ConfigUpdate <- true: // Send Config Update Notification, unless one already pending.
-		default:
+		if notify {
+			s.notified = state
+
+			select {
+			case s.GNXIService.ConfigUpdate <- true: // Send Config Update Notification, unless one already pending.
+			default:
+			}
 		}
 
 		log.Debugf("Using following config data: %s", gnmiConfig)
@@ -177,6 +613,108 @@ func (s *SystemBroker) OVSConfigChangeCallback(ovsConfig *Config) error {
 	return nil
 }
 
+// withoutCounters returns a copy of the cache without the counters OVS updates every few seconds, such as the
+// interface and STP statistics, so that comparing it only finds changes of the config and state.
+func withoutCounters(cache *ObjectCache) *ObjectCache {
+	c := CopyConfigObjectCache(cache)
+
+	for _, controller := range c.Controllers {
+		controller.Status.SecondsSinceConnect, controller.Status.SecondsSinceDisconnect = nil, nil
+	}
+
+	for _, i := range c.Interfaces {
+		i.Statistics = nil
+	}
+
+	for _, p := range c.Ports {
+		p.SpanningTree.BPDUSent, p.SpanningTree.BPDUReceived = nil, nil
+	}
+
+	for _, m := range c.Mirrors {
+		m.TxPackets, m.TxBytes = nil, nil
+	}
+
+	return c
+}
+
+// OVSConnectionStateCallback republishes the gNMI config, so that the ovsdb connection state is up to date while the
+// OVS client reconnects.
+func (s *SystemBroker) OVSConnectionStateCallback(state ConnectionState) {
+	log.Debugf("OVS connection state changed: %+v", state)
+
+	s.republish()
+}
+
+// republish generates the gNMI config again from the cached OVS config and publishes it to all subscriptions, for
+// changes outside of the OVS config such as the connection state.
+func (s *SystemBroker) republish() {
+	s.publish(s.OVSClient.Config, true)
+}
+
+// pollState requests the state OVSDB has no columns for from ovs-vswitchd at every interval and republishes the gNMI
+// config, so that the flows and LLDP neighbors are up to date while OVSDB reports no changes. Subscriptions sent on
+// changes are only notified if the polled state changed.
+func (s *SystemBroker) pollState(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if !s.OVSClient.IsConnected() || !s.OVSClient.Config.IsInitialized() {
+			continue
+		}
+
+		s.publish(s.OVSClient.Config, s.collect())
+	}
+}
+
+// collect runs the collectors of the polled state side by side, so that a collector waiting for ovs-vswitchd does not
+// hold up the others, and reports whether any of them changed the polled state. Each command they run is bounded by
+// the timeout of the executor.
+func (s *SystemBroker) collect() bool {
+	collectors := map[string]func() (bool, error){
+		"flows":            s.collectFlows,
+		"LLDP neighbors":   s.collectLLDPNeighbors,
+		"queue statistics": s.collectQueueStatistics,
+	}
+
+	var wg sync.WaitGroup
+	changes := make(chan bool, len(collectors))
+	for name, collector := range collectors {
+		wg.Add(1)
+		go func(name string, collector func() (bool, error)) {
+			defer wg.Done()
+			changed, err := collector()
+			if err != nil {
+				log.Warningf("Unable to poll %v: %v", name, err)
+			}
+			changes <- changed
+		}(name, collector)
+	}
+	wg.Wait()
+	close(changes)
+
+	changed := false
+	for c := range changes {
+		changed = changed || c
+	}
+
+	return changed
+}
+
+// collectError combines the failures of a collector, nil if there are none.
+func collectError(failed []string) error {
+	if len(failed) == 0 {
+		return nil
+	}
+
+	sort.Strings(failed)
+	return errors.New(strings.Join(failed, "; "))
+}
+
 func (s *SystemBroker) GNMIConfigSetupCallback(new ygot.ValidatedGoStruct) error {
 	log.Debug("Received initial config by gNMI target")
 
@@ -188,8 +726,11 @@ func (s *SystemBroker) GNMIConfigSetupCallback(new ygot.ValidatedGoStruct) error
 		return err
 	}
 
-	cache := CopyConfigObjectCache(s.OVSClient.Config.ObjCache)
-	OverwriteObjectCacheWithJSON(cache, jsonConfig)
+	cache := s.OVSClient.Config.Snapshot().ObjCache
+	if err := OverwriteObjectCacheWithJSON(cache, jsonConfig); err != nil {
+		log.Errorf("unable to apply initial gNMI config: %v", err)
+		return err
+	}
 	s.OVSClient.Config.OverwriteObjectCache(cache)
 
 	return nil
@@ -198,6 +739,17 @@ func (s *SystemBroker) GNMIConfigSetupCallback(new ygot.ValidatedGoStruct) error
 func (s *SystemBroker) GNMIConfigChangeCallback(new ygot.ValidatedGoStruct) error {
 	log.Debug("Received new change by gNMI target")
 
+	// The users are validated up front, but only applied once OVS has accepted the change, so that a failed Set does
+	// not leave its passwords in place.
+	var users []shared.LocalUser
+	if device, ok := new.(*oc.Device); ok {
+		var err error
+		if users, err = s.aaaUsers(device); err != nil {
+			log.Errorf("unable to sync AAA config: %v", err)
+			return err
+		}
+	}
+
 	jsonConfigNew, err := ygot.ConstructIETFJSON(new, &ygot.RFC7951JSONConfig{
 		AppendModuleName: true,
 	})
@@ -206,23 +758,40 @@ func (s *SystemBroker) GNMIConfigChangeCallback(new ygot.ValidatedGoStruct) erro
 		return err
 	}
 
-	prevCache := CopyConfigObjectCache(s.OVSClient.Config.ObjCache)
-	newCache := CopyConfigObjectCache(s.OVSClient.Config.ObjCache)
-	OverwriteObjectCacheWithJSON(newCache, jsonConfigNew)
+	prevCache := s.OVSClient.Config.Snapshot().ObjCache
+	newCache := CopyConfigObjectCache(prevCache)
+	if err := OverwriteObjectCacheWithJSON(newCache, jsonConfigNew); err != nil {
+		log.Errorf("unable to apply gNMI config: %v", err)
+		return err
+	}
 
 	s.OVSClient.Config.OverwriteObjectCache(newCache)
 
-	err = s.OVSClient.SyncChangesToRemote(prevCache, s.OVSClient.Config.ObjCache)
+	err = s.OVSClient.SyncChangesToRemote(prevCache, newCache)
 	if err != nil {
 		log.Errorf("unable to sync changes to OVS system: %v", err)
+		// Changes which have been applied are reported back by the monitor.
+		s.OVSClient.Config.OverwriteObjectCache(prevCache)
 		return err
 	}
 
+	usersChanged, err := s.syncAAAConfig(users)
+	if err != nil {
+		log.Errorf("unable to sync AAA config: %v", err)
+		return err
+	}
+
+	// The hashes of changed users are removed from the gNMI config tree once the Set has been applied to it.
+	if usersChanged {
+		go s.republish()
+	}
+
 	return nil
 }
 
 func (s *SystemBroker) GNOIRebootCallback() error {
 	s.GNXIService.LockService()
+	defer s.GNXIService.UnlockService()
 
 	log.Debug("Received OVS reboot request by GNOI target")
 	s.OVSClient.StopMonitoring()
@@ -237,8 +806,6 @@ func (s *SystemBroker) GNOIRebootCallback() error {
 	s.stopGNXIServiceChan <- true
 	s.startGNXIServiceChan <- true
 
-	defer s.GNXIService.UnlockService()
-
 	return nil
 }
 
@@ -267,3 +834,11 @@ func (s *SystemBroker) GNOIRotateCertificatesCallback(certID string) error {
 
 	return nil
 }
+
+// sortedStrings returns a sorted copy of a set of strings, so that the published leaf-list is stable.
+func sortedStrings(values []string) []string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+
+	return sorted
+}
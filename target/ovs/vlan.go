@@ -0,0 +1,231 @@
+/* Copyright 2019 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovs
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	VLANModeAccess = "ACCESS"
+	VLANModeTrunk  = "TRUNK"
+)
+
+// SwitchedVLAN is the VLAN configuration of a port as modelled by OpenConfig switched-vlan. Zero VLAN IDs are unset.
+type SwitchedVLAN struct {
+	Mode       string
+	AccessVLAN uint16
+	NativeVLAN uint16
+	TrunkVLANs []uint16
+}
+
+func (v *SwitchedVLAN) Equal(comp *SwitchedVLAN) bool {
+	if v == nil || comp == nil {
+		return v == comp
+	}
+
+	switch {
+	case v.Mode != comp.Mode:
+		return false
+	case v.AccessVLAN != comp.AccessVLAN:
+		return false
+	case v.NativeVLAN != comp.NativeVLAN:
+		return false
+	case len(v.TrunkVLANs) != len(comp.TrunkVLANs):
+		return false
+	}
+
+	for i := range v.TrunkVLANs {
+		if v.TrunkVLANs[i] != comp.TrunkVLANs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *SwitchedVLAN) String() string {
+	return fmt.Sprintf("SwitchedVLAN(Mode: \"%v\", AccessVLAN: \"%v\", NativeVLAN: \"%v\", TrunkVLANs: \"%v\")", v.Mode, v.AccessVLAN, v.NativeVLAN, v.TrunkVLANs)
+}
+
+// SwitchedVLAN returns the VLAN configuration of the port, or nil if the port has the default configuration, which
+// trunks all VLANs, or a VLAN mode such as dot1q-tunnel which OpenConfig cannot express. A port without vlan_mode is
+// an access port if it has a tag and a trunk port otherwise. The native-tagged and native-untagged modes are trunks
+// with a native VLAN.
+func (p *Port) SwitchedVLAN() *SwitchedVLAN {
+	mode := p.VLANMode
+	if mode == "" {
+		switch {
+		case p.Tag != nil:
+			mode = "access"
+		case len(p.Trunks) > 0:
+			mode = "trunk"
+		default:
+			return nil
+		}
+	}
+
+	switch mode {
+	case "access":
+		v := &SwitchedVLAN{Mode: VLANModeAccess}
+		if p.Tag != nil {
+			v.AccessVLAN = *p.Tag
+		}
+		return v
+	case "trunk", "native-tagged", "native-untagged":
+		v := &SwitchedVLAN{Mode: VLANModeTrunk, TrunkVLANs: sortVLANs(p.Trunks)}
+		if p.Tag != nil && mode != "trunk" {
+			v.NativeVLAN = *p.Tag
+		}
+		return v
+	default:
+		return nil
+	}
+}
+
+// SetSwitchedVLAN sets the VLAN columns of the port, nil restores the default configuration. A native VLAN is
+// configured as native-untagged, as OpenConfig strips its tag on transmission.
+func (p *Port) SetSwitchedVLAN(v *SwitchedVLAN) {
+	p.VLANMode, p.Tag, p.Trunks = "", nil, nil
+
+	switch {
+	case v == nil:
+	case v.Mode == VLANModeAccess:
+		p.VLANMode = "access"
+		if v.AccessVLAN != 0 {
+			tag := v.AccessVLAN
+			p.Tag = &tag
+		}
+	case v.Mode == VLANModeTrunk:
+		p.VLANMode = "trunk"
+		if v.NativeVLAN != 0 {
+			tag := v.NativeVLAN
+			p.VLANMode, p.Tag = "native-untagged", &tag
+		}
+		p.Trunks = sortVLANs(v.TrunkVLANs)
+	}
+}
+
+// ParseVLANRange parses a VLAN ID or a range of VLAN IDs such as 100..199.
+func ParseVLANRange(s string) ([]uint16, error) {
+	bounds := strings.SplitN(s, "..", 2)
+
+	var ids []uint64
+	for _, b := range bounds {
+		id, err := strconv.ParseUint(b, 10, 12)
+		if err != nil || id == 0 {
+			return nil, fmt.Errorf("invalid VLAN range %q", s)
+		}
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 1 {
+		return []uint16{uint16(ids[0])}, nil
+	}
+
+	if ids[0] > ids[1] {
+		return nil, fmt.Errorf("invalid VLAN range %q", s)
+	}
+
+	var vlans []uint16
+	for id := ids[0]; id <= ids[1]; id++ {
+		vlans = append(vlans, uint16(id))
+	}
+
+	return vlans, nil
+}
+
+// parseSwitchedVLAN reads the switched-vlan config of the IETF JSON tree, nil if it is not configured.
+func parseSwitchedVLAN(config map[string]interface{}) (*SwitchedVLAN, error) {
+	mode, ok := config["interface-mode"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	v := &SwitchedVLAN{Mode: mode}
+	switch mode {
+	case VLANModeAccess:
+		if access, ok := config["access-vlan"].(uint16); ok {
+			v.AccessVLAN = access
+		}
+	case VLANModeTrunk:
+		if native, ok := config["native-vlan"].(uint16); ok {
+			v.NativeVLAN = native
+		}
+
+		trunks, _ := config["trunk-vlans"].([]interface{})
+		for _, t := range trunks {
+			switch t := t.(type) {
+			case uint16:
+				v.TrunkVLANs = append(v.TrunkVLANs, t)
+			case string:
+				vlans, err := ParseVLANRange(t)
+				if err != nil {
+					return nil, err
+				}
+				v.TrunkVLANs = append(v.TrunkVLANs, vlans...)
+			default:
+				return nil, fmt.Errorf("invalid trunk VLAN %v", t)
+			}
+		}
+		v.TrunkVLANs = sortVLANs(v.TrunkVLANs)
+	default:
+		return nil, fmt.Errorf("unsupported interface mode %v", mode)
+	}
+
+	return v, nil
+}
+
+// vlanPort returns the port which holds the VLAN configuration of an interface. A bond port has no single interface
+// to configure it through, so it is left out. For a new interface the port it is going to be created with is added to
+// the cache.
+func (c *ObjectCache) vlanPort(interf *Interface) *Port {
+	if interf.uuid == "" {
+		if _, ok := c.Ports[interf.Name]; !ok {
+			c.Ports[interf.Name] = &Port{Name: interf.Name}
+		}
+		return c.Ports[interf.Name]
+	}
+
+	p := c.PortOfInterface(interf)
+	if p == nil || len(p.Interfaces) != 1 {
+		return nil
+	}
+
+	return p
+}
+
+// sortVLANs returns a sorted copy of the VLAN IDs without duplicates, so that sets of trunks can be compared.
+func sortVLANs(vlans []uint16) []uint16 {
+	if len(vlans) == 0 {
+		return nil
+	}
+
+	sorted := append([]uint16(nil), vlans...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	result := sorted[:1]
+	for _, id := range sorted[1:] {
+		if id != result[len(result)-1] {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}